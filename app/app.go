@@ -459,6 +459,7 @@ func New(
 		keys[paymentmoduletypes.StoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.AuthzKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	paymentModule := paymentmodule.NewAppModule(appCodec, app.PaymentKeeper, app.AccountKeeper, app.BankKeeper)
@@ -494,10 +495,6 @@ func New(
 		app.VirtualgroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	storageModule := storagemodule.NewAppModule(appCodec, app.StorageKeeper, app.AccountKeeper, app.BankKeeper, app.SpKeeper)
-
-	app.VirtualgroupKeeper.SetStorageKeeper(&app.StorageKeeper)
-	virtualgroupModule := virtualgroupmodule.NewAppModule(appCodec, app.VirtualgroupKeeper, app.SpKeeper)
 
 	app.ChallengeKeeper = *challengemodulekeeper.NewKeeper(
 		appCodec,
@@ -510,6 +507,13 @@ func New(
 		app.PaymentKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+
+	app.StorageKeeper.SetHooks(app.ChallengeKeeper.Hooks())
+	storageModule := storagemodule.NewAppModule(appCodec, app.StorageKeeper, app.AccountKeeper, app.BankKeeper, app.SpKeeper)
+
+	app.VirtualgroupKeeper.SetStorageKeeper(&app.StorageKeeper)
+	virtualgroupModule := virtualgroupmodule.NewAppModule(appCodec, app.VirtualgroupKeeper, app.SpKeeper)
+
 	challengeModule := challengemodule.NewAppModule(appCodec, app.ChallengeKeeper, app.AccountKeeper, app.BankKeeper)
 
 	/****  Module Options ****/
@@ -747,7 +751,7 @@ func (app *App) initBridge() {
 func (app *App) initStorage() {
 	storagemodulekeeper.RegisterCrossApps(app.StorageKeeper)
 	storagemodulekeeper.InitPaymentCheck(app.StorageKeeper, app.appConfig.PaymentCheck.Enabled,
-		app.appConfig.PaymentCheck.Interval)
+		app.appConfig.PaymentCheck.Interval, app.appConfig.PaymentCheck.QueryEnabled)
 }
 
 func (app *App) initGov() {
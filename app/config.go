@@ -21,8 +21,9 @@ type CrossChainConfig struct {
 }
 
 type PaymentCheckConfig struct {
-	Enabled  bool   `mapstructure:"enabled"`
-	Interval uint32 `mapstructure:"interval"`
+	Enabled      bool   `mapstructure:"enabled"`
+	Interval     uint32 `mapstructure:"interval"`
+	QueryEnabled bool   `mapstructure:"query-enabled"`
 }
 
 var CustomAppTemplate = serverconfig.DefaultConfigTemplate + `
@@ -45,6 +46,9 @@ dest-op-chain-id = {{ .CrossChain.DestOpChainId }}
 enabled = {{ .PaymentCheck.Enabled }}
 # interval - the block interval run check payment
 interval = {{ .PaymentCheck.Interval }}
+# query-enabled - the flag to serve the payment health query, it checks all the payment data in a query, so it is
+# only meant for the nodes of the operators and should not be enabled on public nodes
+query-enabled = {{ .PaymentCheck.QueryEnabled }}
 `
 
 func NewDefaultAppConfig() *AppConfig {
@@ -71,8 +75,9 @@ func NewDefaultAppConfig() *AppConfig {
 			DestOpChainId:  3,
 		},
 		PaymentCheck: PaymentCheckConfig{
-			Enabled:  false,
-			Interval: 100,
+			Enabled:      false,
+			Interval:     100,
+			QueryEnabled: false,
 		},
 	}
 }
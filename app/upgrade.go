@@ -7,10 +7,13 @@ import (
 	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	bridgemoduletypes "github.com/bnb-chain/greenfield/x/bridge/types"
+	challengemoduletypes "github.com/bnb-chain/greenfield/x/challenge/types"
 	paymentmodule "github.com/bnb-chain/greenfield/x/payment"
 	paymentmodulekeeper "github.com/bnb-chain/greenfield/x/payment/keeper"
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	spmoduletypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagemodulekeeper "github.com/bnb-chain/greenfield/x/storage/keeper"
 	storagemoduletypes "github.com/bnb-chain/greenfield/x/storage/types"
 	virtualgroupmodule "github.com/bnb-chain/greenfield/x/virtualgroup"
@@ -35,6 +38,7 @@ func (app *App) RegisterUpgradeHandlers(chainID string, serverCfg *serverconfig.
 	app.registerSerengetiUpgradeHandler()
 	app.registerErdosUpgradeHandler()
 	app.registerVeldUpgradeHandler()
+	app.registerGobiUpgradeHandler()
 	// app.register...()
 	// ...
 	return nil
@@ -279,3 +283,64 @@ func (app *App) registerVeldUpgradeHandler() {
 			return nil
 		})
 }
+
+func (app *App) registerGobiUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(gnfdtypes.Gobi,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+
+			// storage
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgSetBucketVersioning{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgDeleteObjectVersion{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgSetBucketLifecycle{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgSetRetention{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgSetLegalHold{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgComposeObject{}), 2.4e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgBatchCreateObjects{}), 1.2e4))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgBatchDeleteObjects{}), 1.2e4))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgMintReadVoucher{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgRedeemReadVoucher{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgSetBucketRequesterPays{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgPurchaseReaderQuota{}), 1.2e3))
+
+			// payment
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgSetBalanceAlert{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgEnableAutoTopUp{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgDisableAutoTopUp{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgSetSpendingBudget{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgTransferPaymentAccountOwnership{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgAcceptPaymentAccountOwnership{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgCancelDelayedWithdrawal{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgTransferReadVoucher{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgCreateMultisigPaymentAccount{}), 2e5))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgProposeMultisigAction{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&paymenttypes.MsgApproveMultisigProposal{}), 1.2e3))
+
+			// challenge
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&challengemoduletypes.MsgAppealChallenge{}), 1.2e3))
+
+			// sp
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&spmoduletypes.MsgScheduleMaintenance{}), 1.2e3))
+			app.GashubKeeper.SetMsgGasParams(ctx, *gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&spmoduletypes.MsgCancelScheduledMaintenance{}), 1.2e3))
+
+			// enable the bucket lifecycle rules and the batch messages, the params did not exist before
+			storageParams := app.StorageKeeper.GetParams(ctx)
+			storageParams.LifecycleDeletionMax = storagemoduletypes.DefaultLifecycleDeletionMax
+			storageParams.MaxBatchSize = storagemoduletypes.DefaultMaxBatchSize
+			if err := app.StorageKeeper.SetParams(ctx, storageParams); err != nil {
+				return nil, err
+			}
+			// the existing objects are indexed by name in the end blocks, listing by name scans the objects until then
+			app.StorageKeeper.StartObjectNameIndexBackfill(ctx)
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(gnfdtypes.Gobi,
+		func() error {
+			app.Logger().Info("Init Gobi upgrade")
+			return nil
+		})
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/app"
+	appparams "github.com/bnb-chain/greenfield/app/params"
+)
+
+// PaymentCheckCmd returns the debug command which runs the payment check of the storage module against the
+// application database of the local node.
+func PaymentCheckCmd(encodingConfig appparams.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-check",
+		Short: "Check the payment data of all buckets and objects against the stream records",
+		Long: `Check the payment data of all buckets and objects against the stream records at --height, the same as the
+payment check run by the storage module in EndBlock. The mismatched accounts, lock balance discrepancies and known
+lock balance issues are reported. The check scans the whole state, it is run against the application database of
+the local node in --home, which should be stopped first.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+				return err
+			}
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(flags.FlagHeight)
+			gnfdApp := app.New(serverCtx.Logger, db, nil, height == 0, homeDir, uint(1), encodingConfig, appConfig,
+				serverCtx.Viper)
+			if height != 0 {
+				if err := gnfdApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			ctx := gnfdApp.NewContext(true, tmproto.Header{ChainID: genDoc.ChainID, Height: gnfdApp.LastBlockHeight()})
+			report := gnfdApp.StorageKeeper.CheckPaymentHealth(ctx)
+
+			clientCtx := client.GetClientContextFromCmd(cmd).WithCodec(encodingConfig.Marshaler)
+			return clientCtx.PrintProto(report)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "The height to check the payment data at, the latest height by default")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// debugCmd returns the debug commands of the sdk with the greenfield specific ones.
+func debugCmd(encodingConfig appparams.EncodingConfig) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(PaymentCheckCmd(encodingConfig))
+	return cmd
+}
@@ -0,0 +1,52 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// Keeper defines the methods of the authz keeper needed to check and consume a grant.
+type Keeper interface {
+	GetGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (grant authz.Grant, found bool)
+	Update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated authz.Authorization) error
+	DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error
+}
+
+// CheckAuthorization checks whether the granter has authorized the grantee to execute the msg on its behalf, and
+// updates or deletes the grant as its authorization accepts the msg.
+func CheckAuthorization(ctx sdk.Context, k Keeper, grantee sdk.AccAddress, granter sdk.AccAddress, msg sdk.Msg) error {
+	grant, found := k.GetGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+	if !found {
+		return authz.ErrNoAuthorizationFound
+	}
+
+	if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if resp.Delete {
+		err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+	} else if resp.Updated != nil {
+		err = k.Update(ctx, grantee, granter, resp.Updated)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+package types
+
+// Gobi is the upgrade name for the Gobi upgrade. The earlier upgrade names are
+// declared in the cosmos-sdk fork; Gobi only gates greenfield modules, so it is
+// declared here and must be listed in the `[[upgrade]]` section of app.toml.
+const Gobi = "Gobi"
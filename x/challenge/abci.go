@@ -3,6 +3,7 @@ package challenge
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -25,6 +26,12 @@ func BeginBlocker(ctx sdk.Context, keeper k.Keeper) {
 		keeper.RemoveSlashUntil(ctx, height)
 	}
 
+	// delete the last challenged records which are not counted in the weights any more
+	keeper.PruneLastChallengedHeights(ctx, blockHeight)
+
+	// execute the slashes whose appeal period is ended
+	keeper.FinalizePendingSlashes(ctx, blockHeight)
+
 	// delete storage provider slash amount records
 	if blockHeight > 0 && blockHeight%params.SpSlashCountingWindow == 0 {
 		keeper.ClearSpSlashAmount(ctx)
@@ -47,93 +54,182 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 
 	expiredHeight := params.ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
 
-	events := make([]proto.Message, 0)                      // for events
-	objectMap := make(map[string]struct{})                  // for de-duplication
+	events := make([]proto.Message, 0)     // for events
+	objectMap := make(map[string]struct{}) // for de-duplication
+	issuedSpIds := make([]uint32, 0)       // for recording the issued challenges of the sps
+	issuedCounts := make(map[uint32]uint64)
 	iteration, maxIteration := uint64(0), 10*(needed-count) // to prevent endless loop
 	for count < needed && iteration < maxIteration {
-		iteration++
-		seed := k.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, iteration)
-
-		// random object info
-		objectId := k.RandomObjectId(seed, objectCount)
-		objectInfo, found := keeper.StorageKeeper.GetObjectInfoById(ctx, objectId)
-		if !found || objectInfo.ObjectStatus != storagetypes.OBJECT_STATUS_SEALED {
-			continue
-		}
-
-		// skip empty object
-		if objectInfo.PayloadSize == 0 {
-			continue
-		}
-
-		// random redundancy index (sp address)
-		var spOperatorId uint32
-
-		bucket, found := keeper.StorageKeeper.GetBucketInfo(ctx, objectInfo.BucketName)
-		if !found {
-			continue
-		}
-		gvg, found := keeper.StorageKeeper.GetObjectGVG(ctx, bucket.Id, objectInfo.LocalVirtualGroupId)
-		if !found {
-			continue
-		}
-		redundancyIndex := k.RandomRedundancyIndex(seed, uint64(len(gvg.SecondarySpIds)+1))
-		if redundancyIndex == types.RedundancyIndexPrimary { // primary sp
-			spOperatorId = gvg.PrimarySpId
+		var candidate *challengeCandidate
+		if params.ChallengeSelectionMode == types.CHALLENGE_SELECTION_WEIGHTED {
+			// draw a few candidates and select one of them by the weights
+			candidates := make([]*challengeCandidate, 0, types.WeightedChallengeCandidates)
+			weights := make([]uint64, 0, types.WeightedChallengeCandidates)
+			var seed []byte
+			for len(candidates) < types.WeightedChallengeCandidates && iteration < maxIteration {
+				iteration++
+				seed = k.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, iteration)
+				c, found := findChallengeCandidate(ctx, keeper, seed, objectCount, objectMap)
+				if !found {
+					continue
+				}
+				objectMap[c.mapKey] = struct{}{}
+				candidates = append(candidates, c)
+				weights = append(weights, keeper.ChallengeWeight(ctx, c.sp.Id, c.objectInfo.Id, c.segments))
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+			for _, c := range candidates { // the candidates not selected can be drawn again
+				delete(objectMap, c.mapKey)
+			}
+			candidate = candidates[k.RandomWeightedIndex(seed, weights)]
 		} else {
-			spOperatorId = gvg.SecondarySpIds[redundancyIndex]
+			iteration++
+			seed := k.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, iteration)
+			c, found := findChallengeCandidate(ctx, keeper, seed, objectCount, objectMap)
+			if !found {
+				continue
+			}
+			candidate = c
 		}
+		objectInfo, sp := candidate.objectInfo, candidate.sp
 
-		sp, found := keeper.SpKeeper.GetStorageProvider(ctx, spOperatorId)
-		if !found {
-			continue
-		}
-		if sp.Status != sptypes.STATUS_IN_SERVICE && sp.Status != sptypes.STATUS_GRACEFUL_EXITING && sp.Status != sptypes.STATUS_FORCED_EXITING {
-			continue
-		}
-
-		mapKey := fmt.Sprintf("%d-%s", spOperatorId, objectInfo.Id.String())
-		if _, ok := objectMap[mapKey]; ok { // already generated for this pair
-			continue
-		}
-
-		// check recent slash
-		if keeper.ExistsSlash(ctx, sp.Id, objectInfo.Id) {
-			continue
-		}
-
-		// random segment/piece index
-		segmentSize, err := keeper.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
-		if err != nil {
-			ctx.Logger().Error("fail to get segment size", "timestamp", objectInfo.GetLatestUpdatedTime(),
-				"err", err.Error())
-			continue
-		}
-		segments := k.CalculateSegments(objectInfo.PayloadSize, segmentSize)
-		segmentIndex := k.RandomSegmentIndex(seed, segments)
-
-		objectMap[mapKey] = struct{}{}
+		objectMap[candidate.mapKey] = struct{}{}
 
 		challengeId := keeper.GetChallengeId(ctx) + 1
 		keeper.SaveChallenge(ctx, types.Challenge{
-			Id:            challengeId,
-			ExpiredHeight: expiredHeight,
+			Id:             challengeId,
+			ExpiredHeight:  expiredHeight,
+			ComponentIndex: candidate.componentIndex,
 		})
+		if params.ChallengeSelectionMode == types.CHALLENGE_SELECTION_WEIGHTED {
+			keeper.SetLastChallengedHeight(ctx, sp.Id, objectInfo.Id, uint64(ctx.BlockHeight()))
+		}
+		if _, ok := issuedCounts[sp.Id]; !ok {
+			issuedSpIds = append(issuedSpIds, sp.Id)
+		}
+		issuedCounts[sp.Id]++
 		events = append(events, &types.EventStartChallenge{
 			ChallengeId:       challengeId,
 			ObjectId:          objectInfo.Id,
-			SegmentIndex:      segmentIndex,
+			SegmentIndex:      candidate.segmentIndex,
 			SpId:              sp.Id,
 			SpOperatorAddress: sp.OperatorAddress,
-			RedundancyIndex:   redundancyIndex,
+			RedundancyIndex:   candidate.redundancyIndex,
 			ChallengerAddress: "",
 			ExpiredHeight:     expiredHeight,
+			ComponentIndex:    candidate.componentIndex,
 		})
 
 		count++
 	}
+	for _, spId := range issuedSpIds {
+		keeper.SpKeeper.RecordChallengesIssued(ctx, spId, issuedCounts[spId])
+	}
 	err := ctx.EventManager().EmitTypedEvents(events...)
 	if err != nil {
 		ctx.Logger().Error("failed to emit challenge events", "err", err.Error())
 	}
 }
+
+// challengeCandidate is an object and storage provider pair which can be challenged.
+type challengeCandidate struct {
+	objectInfo      *storagetypes.ObjectInfo
+	componentIndex  uint32
+	sp              *sptypes.StorageProvider
+	redundancyIndex int32
+	segments        uint64
+	segmentIndex    uint32
+	mapKey          string
+}
+
+// findChallengeCandidate randomly picks an object, one of its storage providers and the segment to challenge by the
+// seed, it is not found if the pair cannot be challenged.
+func findChallengeCandidate(ctx sdk.Context, keeper k.Keeper, seed []byte, objectCount sdkmath.Uint,
+	objectMap map[string]struct{},
+) (*challengeCandidate, bool) {
+	// random object info
+	objectId := k.RandomObjectId(seed, objectCount)
+	objectInfo, found := keeper.StorageKeeper.GetObjectInfoById(ctx, objectId)
+	if !found || objectInfo.ObjectStatus != storagetypes.OBJECT_STATUS_SEALED {
+		return nil, false
+	}
+
+	// skip empty object
+	if objectInfo.PayloadSize == 0 {
+		return nil, false
+	}
+
+	// random redundancy index (sp address)
+	var spOperatorId uint32
+
+	bucket, found := keeper.StorageKeeper.GetBucketInfo(ctx, objectInfo.BucketName)
+	if !found {
+		return nil, false
+	}
+
+	// a composed object is challenged through one of its components, picked by the size
+	segmentSize, err := keeper.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
+	if err != nil {
+		ctx.Logger().Error("fail to get segment size", "timestamp", objectInfo.GetLatestUpdatedTime(),
+			"err", err.Error())
+		return nil, false
+	}
+	segments := k.CalculateSegments(objectInfo.PayloadSize, segmentSize)
+	content, componentIndex, _, err := keeper.LocateChallengedContent(ctx, objectInfo, k.RandomSegmentIndex(seed, segments))
+	if err != nil {
+		ctx.Logger().Error("fail to locate the challenged content", "object", objectInfo.Id, "err", err.Error())
+		return nil, false
+	}
+
+	gvg, found := keeper.StorageKeeper.GetObjectGVG(ctx, bucket.Id, content.LocalVirtualGroupId)
+	if !found {
+		return nil, false
+	}
+	redundancyIndex := k.RandomRedundancyIndex(seed, uint64(len(gvg.SecondarySpIds)+1))
+	if redundancyIndex == types.RedundancyIndexPrimary { // primary sp
+		spOperatorId = gvg.PrimarySpId
+	} else {
+		spOperatorId = gvg.SecondarySpIds[redundancyIndex]
+	}
+
+	sp, found := keeper.SpKeeper.GetStorageProvider(ctx, spOperatorId)
+	if !found {
+		return nil, false
+	}
+	if sp.Status != sptypes.STATUS_IN_SERVICE && sp.Status != sptypes.STATUS_GRACEFUL_EXITING && sp.Status != sptypes.STATUS_FORCED_EXITING {
+		return nil, false
+	}
+
+	mapKey := fmt.Sprintf("%d-%s", spOperatorId, objectInfo.Id.String())
+	if _, ok := objectMap[mapKey]; ok { // already generated for this pair
+		return nil, false
+	}
+
+	// check recent slash
+	if keeper.ExistsSlash(ctx, sp.Id, objectInfo.Id) {
+		return nil, false
+	}
+
+	// random segment/piece index
+	if objectInfo.IsComposed {
+		segmentSize, err = keeper.StorageKeeper.MaxSegmentSize(ctx, content.GetLatestUpdatedTime())
+		if err != nil {
+			ctx.Logger().Error("fail to get segment size", "timestamp", content.GetLatestUpdatedTime(),
+				"err", err.Error())
+			return nil, false
+		}
+		segments = k.CalculateSegments(content.PayloadSize, segmentSize)
+	}
+
+	return &challengeCandidate{
+		objectInfo:      objectInfo,
+		componentIndex:  componentIndex,
+		sp:              sp,
+		redundancyIndex: redundancyIndex,
+		segments:        segments,
+		segmentIndex:    k.RandomSegmentIndex(seed, segments),
+		mapKey:          mapKey,
+	}, true
+}
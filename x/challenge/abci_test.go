@@ -63,6 +63,9 @@ func (s *TestSuite) SetupTest() {
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	storageKeeper := types.NewMockStorageKeeper(ctrl)
 	spKeeper := types.NewMockSpKeeper(ctrl)
+	spKeeper.EXPECT().RecordChallengesIssued(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	spKeeper.EXPECT().RecordChallengeAttested(gomock.Any(), gomock.Any()).AnyTimes()
+	spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	stakingKeeper := types.NewMockStakingKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
 
@@ -221,4 +224,54 @@ func (s *TestSuite) TestEndBlocker_SuccessRandomChallenge() {
 	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
 	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
 	s.Require().True(preChallengeId == afterChallengeId-1)
+
+	// the challenged pair is not recorded in the uniform selection mode
+	_, found := s.challengeKeeper.GetLastChallengedHeight(s.ctx, sp.Id, existObject.Id)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestEndBlocker_WeightedRandomChallenge() {
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengeSelectionMode = types.CHALLENGE_SELECTION_WEIGHTED
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	s.storageKeeper.EXPECT().GetObjectInfoCount(gomock.Any()).Return(sdk.NewUint(100))
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(64),
+		BucketName:   "bucketname",
+		ObjectName:   "objectname",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Any()).
+		Return(existObject, true).AnyTimes()
+
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+		Id:         math.NewUint(10),
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 100, SecondarySpIds: []uint32{
+		1,
+	}}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+
+	sp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
+		Return(sp, true).AnyTimes()
+
+	s.ctx = s.ctx.WithBlockHeight(10)
+	preChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
+	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	s.Require().True(preChallengeId == afterChallengeId-1)
+
+	// the challenged pair is recorded for the weights of the following challenges
+	height, found := s.challengeKeeper.GetLastChallengedHeight(s.ctx, sp.Id, existObject.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(10), height)
 }
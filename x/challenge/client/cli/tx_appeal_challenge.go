@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func CmdAppealChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "appeal-challenge [challenge-id] [object-id] [piece-checksum] [sp-bls-signature]",
+		Short: "Appeal the pending slash of a challenge as the challenged storage provider",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChallengeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("challenge-id %s not a valid uint, please input a valid challenge-id", args[0])
+			}
+
+			argObjectId := sdkmath.NewUintFromString(args[1])
+
+			argPieceChecksum, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("piece-checksum %s not a hex encoded bytes, please input a valid piece-checksum", args[2])
+			}
+
+			argSpBlsSignature, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("sp-bls-signature %s not a hex encoded bytes, please input a valid sp-bls-signature", args[3])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAppealChallenge(
+				clientCtx.GetFromAddress(),
+				argChallengeId,
+				argObjectId,
+				argPieceChecksum,
+				argSpBlsSignature,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
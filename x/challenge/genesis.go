@@ -13,11 +13,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		panic(err)
 	}
+	for i := range genState.PendingSlashes {
+		k.SavePendingSlash(ctx, &genState.PendingSlashes[i])
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	for _, slash := range k.GetAllPendingSlashes(ctx) {
+		genesis.PendingSlashes = append(genesis.PendingSlashes, *slash)
+	}
 	return genesis
 }
@@ -20,12 +20,24 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		PendingSlashes: []types.PendingSlash{
+			{
+				ChallengeId:    1,
+				SpId:           1,
+				ObjectId:       sdk.NewUint(10),
+				SlashAmount:    sdk.NewInt(100),
+				Validators:     []string{"validator"},
+				FinalizeHeight: 20,
+				AttestHeight:   10,
+			},
+		},
 	}
 
 	k, ctx := makeKeeper(t)
 	challenge.InitGenesis(ctx, *k, genesisState)
 	got := challenge.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.PendingSlashes, got.PendingSlashes)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
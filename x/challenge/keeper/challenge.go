@@ -38,10 +38,26 @@ func (k Keeper) SaveChallenge(ctx sdk.Context, challenge types.Challenge) {
 
 	heightBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(heightBytes, challenge.ExpiredHeight)
+	// the component index is only kept for the challenges of composed objects, after the expired height.
+	if challenge.ComponentIndex != 0 {
+		heightBytes = binary.BigEndian.AppendUint32(heightBytes, challenge.ComponentIndex)
+	}
 
 	store.Set(getChallengeKeyBytes(challenge.Id), heightBytes)
 }
 
+// GetChallengeComponentIndex returns the index of the challenged component of a composed object, it is zero for
+// plain objects.
+func (k Keeper) GetChallengeComponentIndex(ctx sdk.Context, challengeId uint64) uint32 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
+
+	bz := store.Get(getChallengeKeyBytes(challengeId))
+	if len(bz) < 12 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(bz[8:])
+}
+
 // RemoveChallengeUntil removes challenges which are expired
 func (k Keeper) RemoveChallengeUntil(ctx sdk.Context, height uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
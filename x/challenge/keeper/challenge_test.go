@@ -28,6 +28,25 @@ func TestGetChallengeId(t *testing.T) {
 	require.True(t, keeper.GetChallengeId(ctx) == 100)
 }
 
+func TestChallengeComponentIndex(t *testing.T) {
+	keeper, ctx := makeKeeper(t)
+	keeper.SaveChallenge(ctx, types.Challenge{
+		Id:            1,
+		ExpiredHeight: 1000,
+	})
+	keeper.SaveChallenge(ctx, types.Challenge{
+		Id:             2,
+		ExpiredHeight:  1000,
+		ComponentIndex: 3,
+	})
+	require.Equal(t, uint32(0), keeper.GetChallengeComponentIndex(ctx, 1))
+	require.Equal(t, uint32(3), keeper.GetChallengeComponentIndex(ctx, 2))
+	require.Equal(t, uint32(0), keeper.GetChallengeComponentIndex(ctx, 3))
+
+	keeper.RemoveChallengeUntil(ctx, 1000)
+	require.False(t, keeper.ExistsChallenge(ctx, 2))
+}
+
 func TestAttestedChallenges(t *testing.T) {
 	keeper, ctx := makeKeeper(t)
 	params := types.DefaultParams()
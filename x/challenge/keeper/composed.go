@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// LocateChallengedContent maps a segment of the object payload to the content holding it. A composed object has no
+// local virtual group of its own, its content is kept by the components on their local virtual groups, so the
+// component holding the segment is challenged instead, and the segment index is remapped inside the component.
+func (k Keeper) LocateChallengedContent(ctx sdk.Context, objectInfo *storagetypes.ObjectInfo, segmentIndex uint32) (
+	content *storagetypes.ObjectInfo, componentIndex uint32, innerSegmentIndex uint32, err error,
+) {
+	if !objectInfo.IsComposed {
+		return objectInfo, 0, segmentIndex, nil
+	}
+
+	composedObject, found := k.StorageKeeper.GetComposedObject(ctx, objectInfo.Id)
+	if !found {
+		return nil, 0, 0, errors.Wrapf(types.ErrUnknownBucketObject, "cannot find the components of object %s", objectInfo.Id)
+	}
+	segmentSize, err := k.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
+	if err != nil {
+		return nil, 0, 0, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
+	}
+	idx, offset, found := composedObject.LocateOffset(uint64(segmentIndex) * segmentSize)
+	if !found {
+		return nil, 0, 0, types.ErrInvalidSegmentIndex
+	}
+
+	component := composedObject.Components[idx]
+	componentSegmentSize, err := k.StorageKeeper.MaxSegmentSize(ctx, component.UpdatedAt)
+	if err != nil {
+		return nil, 0, 0, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
+	}
+	return component.ToObjectInfo(objectInfo), uint32(idx), uint32(offset / componentSegmentSize), nil
+}
+
+// getChallengedContent returns the content challenged by a challenge on the object, it is the component at the
+// index for a composed object.
+func (k Keeper) getChallengedContent(ctx sdk.Context, objectInfo *storagetypes.ObjectInfo, componentIndex uint32) (
+	*storagetypes.ObjectInfo, error,
+) {
+	if !objectInfo.IsComposed {
+		return objectInfo, nil
+	}
+
+	composedObject, found := k.StorageKeeper.GetComposedObject(ctx, objectInfo.Id)
+	if !found || int(componentIndex) >= len(composedObject.Components) {
+		return nil, errors.Wrapf(types.ErrUnknownBucketObject, "cannot find component %d of object %s", componentIndex, objectInfo.Id)
+	}
+	return composedObject.Components[componentIndex].ToObjectInfo(objectInfo), nil
+}
@@ -67,3 +67,25 @@ func RandomRedundancyIndex(seed []byte, sps uint64) int32 {
 	index := new(big.Int).Mod(number, big.NewInt(int64(sps)))
 	return int32(index.Uint64()) - 1
 }
+
+// RandomWeightedIndex generates a random index of the weights, the probability of an index is proportional to its weight.
+func RandomWeightedIndex(seed []byte, weights []uint64) int {
+	total := new(big.Int)
+	for _, weight := range weights {
+		total.Add(total, new(big.Int).SetUint64(weight))
+	}
+	if total.Sign() == 0 {
+		return 0
+	}
+
+	number := new(big.Int).SetBytes(sdk.Keccak256(seed, []byte("weighted")))
+	number = new(big.Int).Mod(number, total)
+	for i, weight := range weights {
+		w := new(big.Int).SetUint64(weight)
+		if number.Cmp(w) < 0 {
+			return i
+		}
+		number.Sub(number, w)
+	}
+	return len(weights) - 1
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// Hooks is the wrapper of the keeper to receive the hooks of the storage module.
+type Hooks struct {
+	k Keeper
+}
+
+var _ storagetypes.StorageHooks = Hooks{}
+
+// Hooks returns the hooks of the storage module implemented by the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterObjectDeleted removes the last challenged records of the deleted object info.
+func (h Hooks) AfterObjectDeleted(ctx sdk.Context, objectId sdkmath.Uint) {
+	h.k.RemoveLastChallengedHeights(ctx, objectId)
+}
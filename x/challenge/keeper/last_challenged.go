@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// SetLastChallengedHeight records the height a pair of sp and object info is challenged, the previous record is
+// replaced in the queue ordered by height.
+func (k Keeper) SetLastChallengedHeight(ctx sdk.Context, spId uint32, objectId sdkmath.Uint, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedKeyPrefix)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedQueueKeyPrefix)
+
+	if lastHeight, found := k.GetLastChallengedHeight(ctx, spId, objectId); found {
+		queueStore.Delete(types.GetLastChallengedQueueKey(lastHeight, spId, objectId))
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+
+	store.Set(types.GetLastChallengedKey(spId, objectId), heightBytes)
+	queueStore.Set(types.GetLastChallengedQueueKey(height, spId, objectId), []byte{})
+}
+
+// GetLastChallengedHeight returns the height a pair of sp and object info is challenged last time.
+func (k Keeper) GetLastChallengedHeight(ctx sdk.Context, spId uint32, objectId sdkmath.Uint) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedKeyPrefix)
+
+	bz := store.Get(types.GetLastChallengedKey(spId, objectId))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// PruneLastChallengedHeights removes the records reaching WeightedChallengeAgeCap at the height, they are weighted the
+// same as the pairs never challenged.
+func (k Keeper) PruneLastChallengedHeights(ctx sdk.Context, height uint64) {
+	if height < types.WeightedChallengeAgeCap {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedKeyPrefix)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedQueueKeyPrefix)
+
+	iterator := queueStore.Iterator(nil, sdk.Uint64ToBigEndian(height-types.WeightedChallengeAgeCap+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		spId, objectId := types.ParseLastChallengedQueueKey(key)
+		store.Delete(types.GetLastChallengedKey(spId, objectId))
+		queueStore.Delete(key)
+	}
+}
+
+// RemoveLastChallengedHeights removes the records of an object info for all the sps.
+func (k Keeper) RemoveLastChallengedHeights(ctx sdk.Context, objectId sdkmath.Uint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedKeyPrefix)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastChallengedQueueKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetLastChallengedObjectPrefix(objectId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	var heights []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		heights = append(heights, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	for i, key := range keys {
+		store.Delete(key)
+		queueStore.Delete(append(sdk.Uint64ToBigEndian(heights[i]), key...))
+	}
+}
+
+// ChallengeWeight calculates the weight of a pair of sp and object info in the weighted selection mode, which is the
+// number of segments multiplied by the blocks since the pair is challenged last time. The blocks are capped by
+// WeightedChallengeAgeCap.
+func (k Keeper) ChallengeWeight(ctx sdk.Context, spId uint32, objectId sdkmath.Uint, segments uint64) uint64 {
+	age := types.WeightedChallengeAgeCap
+	height := uint64(ctx.BlockHeight())
+	if lastHeight, found := k.GetLastChallengedHeight(ctx, spId, objectId); found && height < lastHeight+types.WeightedChallengeAgeCap {
+		age = height - lastHeight
+	}
+	return segments * (age + 1)
+}
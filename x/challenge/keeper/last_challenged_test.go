@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/x/challenge/keeper"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func TestChallengeWeight(t *testing.T) {
+	k, ctx := makeKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	// the pair never challenged has the max weight
+	require.Equal(t, 3*(types.WeightedChallengeAgeCap+1), k.ChallengeWeight(ctx, 1, sdk.NewUint(1), 3))
+
+	k.SetLastChallengedHeight(ctx, 1, sdk.NewUint(1), 100)
+	ctx = ctx.WithBlockHeight(110)
+	require.Equal(t, uint64(3*11), k.ChallengeWeight(ctx, 1, sdk.NewUint(1), 3))
+	require.Equal(t, 3*(types.WeightedChallengeAgeCap+1), k.ChallengeWeight(ctx, 2, sdk.NewUint(1), 3))
+
+	// the record reaching the cap is weighted the same as the pair never challenged, and it is pruned
+	ctx = ctx.WithBlockHeight(int64(100 + types.WeightedChallengeAgeCap))
+	require.Equal(t, 3*(types.WeightedChallengeAgeCap+1), k.ChallengeWeight(ctx, 1, sdk.NewUint(1), 3))
+	k.SetLastChallengedHeight(ctx, 2, sdk.NewUint(1), 101)
+	k.PruneLastChallengedHeights(ctx, uint64(ctx.BlockHeight()))
+	_, found := k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(1))
+	require.False(t, found)
+	height, found := k.GetLastChallengedHeight(ctx, 2, sdk.NewUint(1))
+	require.True(t, found)
+	require.Equal(t, uint64(101), height)
+}
+
+func TestLastChallengedHeight(t *testing.T) {
+	k, ctx := makeKeeper(t)
+
+	// the record challenged again is moved in the queue
+	k.SetLastChallengedHeight(ctx, 1, sdk.NewUint(1), 100)
+	k.SetLastChallengedHeight(ctx, 1, sdk.NewUint(1), 200)
+	k.SetLastChallengedHeight(ctx, 2, sdk.NewUint(1), 100)
+	k.SetLastChallengedHeight(ctx, 1, sdk.NewUint(256), 100)
+	k.PruneLastChallengedHeights(ctx, 100+types.WeightedChallengeAgeCap)
+	height, found := k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(1))
+	require.True(t, found)
+	require.Equal(t, uint64(200), height)
+	_, found = k.GetLastChallengedHeight(ctx, 2, sdk.NewUint(1))
+	require.False(t, found)
+	_, found = k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(256))
+	require.False(t, found)
+
+	// the records of the deleted object are removed for all the sps
+	k.SetLastChallengedHeight(ctx, 2, sdk.NewUint(1), 300)
+	k.SetLastChallengedHeight(ctx, 1, sdk.NewUint(256), 300)
+	k.Hooks().AfterObjectDeleted(ctx, sdk.NewUint(1))
+	_, found = k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(1))
+	require.False(t, found)
+	_, found = k.GetLastChallengedHeight(ctx, 2, sdk.NewUint(1))
+	require.False(t, found)
+	_, found = k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(256))
+	require.True(t, found)
+
+	// the queue entries of the removed records are removed too, they do not prune the later records
+	k.SetLastChallengedHeight(ctx, 1, sdk.NewUint(1), 500)
+	k.PruneLastChallengedHeights(ctx, 300+types.WeightedChallengeAgeCap)
+	_, found = k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(256))
+	require.False(t, found)
+	height, found = k.GetLastChallengedHeight(ctx, 1, sdk.NewUint(1))
+	require.True(t, found)
+	require.Equal(t, uint64(500), height)
+}
+
+func TestRandomWeightedIndex(t *testing.T) {
+	randaoMix := sdk.Keccak256([]byte{1})
+	randaoMix = append(randaoMix, sdk.Keccak256([]byte{2})...)
+
+	require.Equal(t, 0, keeper.RandomWeightedIndex(randaoMix, []uint64{0, 0}))
+	counts := make([]int, 3)
+	for i := uint64(0); i < 100; i++ {
+		seed := keeper.SeedFromRandaoMix(randaoMix, i)
+		require.Equal(t, 1, keeper.RandomWeightedIndex(seed, []uint64{0, 5, 0}))
+		counts[keeper.RandomWeightedIndex(seed, []uint64{1, 1000, 1})]++
+	}
+	require.Greater(t, counts[1], 90)
+}
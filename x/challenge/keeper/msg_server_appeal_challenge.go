@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// AppealChallenge handles the appeal of a storage provider for a pending slash.
+// The slash is cancelled if the piece checksum matches the object info, and all the other storage providers of the
+// object's global virtual group confirm they retrieved the piece from the storage provider.
+func (k msgServer) AppealChallenge(goCtx context.Context, msg *types.MsgAppealChallenge) (*types.MsgAppealChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator := sdk.MustAccAddressFromHex(msg.Operator)
+	sp, found := k.SpKeeper.GetStorageProviderByOperatorAddr(ctx, operator)
+	if !found {
+		return nil, errors.Wrapf(types.ErrUnknownSp, "cannot find sp with operator address: %s", msg.Operator)
+	}
+
+	pendingSlash, found := k.GetPendingSlash(ctx, msg.ChallengeId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoPendingSlash, "challenge %d has no pending slash, it could be finalized", msg.ChallengeId)
+	}
+	if pendingSlash.SpId != sp.Id || !pendingSlash.ObjectId.Equal(msg.ObjectId) {
+		return nil, errors.Wrapf(types.ErrNoPendingSlash, "challenge %d has no pending slash for sp %d and object %s",
+			msg.ChallengeId, sp.Id, msg.ObjectId.String())
+	}
+
+	objectInfo, found := k.StorageKeeper.GetObjectInfoById(ctx, msg.ObjectId)
+	if !found {
+		return nil, types.ErrUnknownBucketObject
+	}
+	content, err := k.getChallengedContent(ctx, objectInfo, pendingSlash.ComponentIndex)
+	if err != nil {
+		return nil, err
+	}
+	checksumIndex := int(pendingSlash.RedundancyIndex + 1)
+	if checksumIndex >= len(content.Checksums) || !bytes.Equal(content.Checksums[checksumIndex], msg.PieceChecksum) {
+		return nil, errors.Wrap(types.ErrInvalidAppealEvidence, "piece checksum mismatches the object info")
+	}
+
+	bucketInfo, found := k.StorageKeeper.GetBucketInfo(ctx, objectInfo.BucketName)
+	if !found {
+		return nil, storagetypes.ErrNoSuchBucket.Wrapf("bucket not found when appeal")
+	}
+	gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, content.LocalVirtualGroupId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", content.LocalVirtualGroupId)
+	}
+
+	// verify the signature of the other storage providers
+	blsPubKeys := make([]bls.PublicKey, 0, len(gvg.SecondarySpIds)+1)
+	for _, spId := range append([]uint32{gvg.PrimarySpId}, gvg.SecondarySpIds...) {
+		if spId == sp.Id {
+			continue
+		}
+		otherSp, found := k.SpKeeper.GetStorageProvider(ctx, spId)
+		if !found {
+			return nil, errors.Wrapf(types.ErrUnknownSp, "cannot find storage provider: %d", spId)
+		}
+		blsPubKey, err := bls.PublicKeyFromBytes(otherSp.BlsKey)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidBlsPubKey, "BLS public key converts failed: %v", err)
+		}
+		blsPubKeys = append(blsPubKeys, blsPubKey)
+	}
+	if len(blsPubKeys) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidAppealEvidence, "no other storage provider can confirm the piece")
+	}
+	if err := gnfdtypes.VerifyBlsAggSignature(blsPubKeys, msg.GetBlsSignBytes(ctx.ChainID()), msg.SpBlsSignature); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidAppealEvidence, err.Error())
+	}
+
+	// cancel the slash
+	k.CancelPendingSlash(ctx, pendingSlash)
+	k.SpKeeper.RecordChallengeResult(ctx, sp.Id, true)
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventAppealChallenge{
+		ChallengeId: msg.ChallengeId,
+		SpId:        sp.Id,
+		ObjectId:    msg.ObjectId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppealChallengeResponse{}, nil
+}
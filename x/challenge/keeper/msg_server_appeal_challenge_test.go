@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/crypto/bls/common"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestAppealChallenge() {
+	s.ctx = s.ctx.WithBlockHeight(100)
+	pieceChecksum := []byte("piece checksum")
+	existBucket := &storagetypes.BucketInfo{
+		Id:         math.NewUint(10),
+		BucketName: "existbucket",
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		ObjectName:   "existobject",
+		BucketName:   existBucket.BucketName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500,
+		Checksums:    [][]byte{[]byte("primary checksum"), pieceChecksum},
+	}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(existObject.Id)).
+		Return(existObject, true).AnyTimes()
+
+	// the challenged sp is the first secondary sp, the others confirm the piece
+	sp := &sptypes.StorageProvider{Id: 2, OperatorAddress: sample.RandAccAddressHex()}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
+		Return(sp, true).AnyTimes()
+	blsKeys := make([]common.SecretKey, 0)
+	for _, id := range []uint32{1, 3} {
+		blsKey, _ := bls.RandKey()
+		blsKeys = append(blsKeys, blsKey)
+		s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Eq(id)).
+			Return(&sptypes.StorageProvider{Id: id, BlsKey: blsKey.PublicKey().Marshal()}, true).AnyTimes()
+	}
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Eq(existBucket.Id), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+
+	pendingSlash := &types.PendingSlash{
+		ChallengeId:     100,
+		SpId:            sp.Id,
+		ObjectId:        existObject.Id,
+		RedundancyIndex: 0,
+		SlashAmount:     math.NewInt(100),
+		Submitter:       sample.RandAccAddressHex(),
+		Validators:      []string{sample.RandAccAddressHex()},
+		FinalizeHeight:  uint64(s.ctx.BlockHeight()) + 10,
+		AttestHeight:    uint64(s.ctx.BlockHeight()),
+	}
+	s.challengeKeeper.SavePendingSlash(s.ctx, pendingSlash)
+	s.challengeKeeper.SaveSlash(s.ctx, types.Slash{SpId: sp.Id, ObjectId: existObject.Id, Height: uint64(s.ctx.BlockHeight())})
+	s.challengeKeeper.SetSpSlashAmount(s.ctx, sp.Id, math.NewInt(150))
+
+	newAppealMsg := func(challengeId uint64, checksum []byte, signers []common.SecretKey) *types.MsgAppealChallenge {
+		msg := types.NewMsgAppealChallenge(sample.RandAccAddress(), challengeId, existObject.Id, checksum, nil)
+		toSign := msg.GetBlsSignBytes(s.ctx.ChainID())
+		signatures := make([]common.Signature, 0, len(signers))
+		for _, signer := range signers {
+			signatures = append(signatures, signer.Sign(toSign[:]))
+		}
+		msg.SpBlsSignature = bls.AggregateSignatures(signatures).Marshal()
+		return msg
+	}
+
+	_, err := s.msgServer.AppealChallenge(s.ctx, newAppealMsg(99, pieceChecksum, blsKeys))
+	s.Require().ErrorIs(err, types.ErrNoPendingSlash)
+
+	_, err = s.msgServer.AppealChallenge(s.ctx, newAppealMsg(100, []byte("primary checksum"), blsKeys))
+	s.Require().ErrorIs(err, types.ErrInvalidAppealEvidence)
+
+	_, err = s.msgServer.AppealChallenge(s.ctx, newAppealMsg(100, pieceChecksum, blsKeys[:1]))
+	s.Require().ErrorIs(err, types.ErrInvalidAppealEvidence)
+
+	_, err = s.msgServer.AppealChallenge(s.ctx, newAppealMsg(100, pieceChecksum, blsKeys))
+	s.Require().NoError(err)
+
+	_, found := s.challengeKeeper.GetPendingSlash(s.ctx, 100)
+	s.Require().False(found)
+	s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, existObject.Id))
+	s.Require().Equal(math.NewInt(50), s.challengeKeeper.GetSpSlashAmount(s.ctx, sp.Id))
+
+	// the slash cannot be appealed again
+	_, err = s.msgServer.AppealChallenge(s.ctx, newAppealMsg(100, pieceChecksum, blsKeys))
+	s.Require().ErrorIs(err, types.ErrNoPendingSlash)
+
+	// the slash amount is not reverted after the counting window of the attestation is ended
+	pendingSlash.ChallengeId = 101
+	s.challengeKeeper.SavePendingSlash(s.ctx, pendingSlash)
+	s.challengeKeeper.SaveSlash(s.ctx, types.Slash{SpId: sp.Id, ObjectId: existObject.Id, Height: uint64(s.ctx.BlockHeight())})
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + int64(s.challengeKeeper.GetParams(s.ctx).SpSlashCountingWindow))
+	_, err = s.msgServer.AppealChallenge(s.ctx, newAppealMsg(101, pieceChecksum, blsKeys))
+	s.Require().NoError(err)
+	s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, existObject.Id))
+	s.Require().Equal(math.NewInt(50), s.challengeKeeper.GetSpSlashAmount(s.ctx, sp.Id))
+}
+
+func (s *TestSuite) TestFinalizePendingSlashes() {
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).
+		Return("BNB").AnyTimes()
+	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Eq(uint32(1)), gomock.Any()).
+		Return(nil).Times(1)
+	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Eq(uint32(3)), gomock.Any()).
+		Return(errors.New("insufficient deposit")).Times(1)
+
+	for i, finalizeHeight := range []uint64{10, 20, 10} {
+		s.challengeKeeper.SavePendingSlash(s.ctx, &types.PendingSlash{
+			ChallengeId:    uint64(i + 1),
+			SpId:           uint32(i + 1),
+			ObjectId:       math.NewUint(10),
+			SlashAmount:    math.NewInt(1e16),
+			Submitter:      sample.RandAccAddressHex(),
+			Validators:     []string{sample.RandAccAddressHex()},
+			FinalizeHeight: finalizeHeight,
+			AttestHeight:   5,
+		})
+		s.challengeKeeper.SaveSlash(s.ctx, types.Slash{SpId: uint32(i + 1), ObjectId: math.NewUint(10), Height: 5})
+		s.challengeKeeper.SetSpSlashAmount(s.ctx, uint32(i+1), math.NewInt(1e16))
+	}
+	s.Require().Len(s.challengeKeeper.GetAllPendingSlashes(s.ctx), 3)
+
+	// only the slash whose appeal period is ended is executed
+	s.challengeKeeper.FinalizePendingSlashes(s.ctx, 15)
+	_, found := s.challengeKeeper.GetPendingSlash(s.ctx, 1)
+	s.Require().False(found)
+	_, found = s.challengeKeeper.GetPendingSlash(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().True(s.challengeKeeper.ExistsSlash(s.ctx, 1, math.NewUint(10)))
+	s.Require().Equal(math.NewInt(1e16), s.challengeKeeper.GetSpSlashAmount(s.ctx, 1))
+
+	// the slash failed to be executed is reverted
+	_, found = s.challengeKeeper.GetPendingSlash(s.ctx, 3)
+	s.Require().False(found)
+	s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, 3, math.NewUint(10)))
+	s.Require().True(s.challengeKeeper.GetSpSlashAmount(s.ctx, 3).IsZero())
+}
@@ -77,11 +77,23 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 
 	spInState := k.StorageKeeper.MustGetPrimarySPForBucket(ctx, bucketInfo)
 
+	// a composed object is challenged through the component recorded in the challenge
+	componentIndex := k.GetChallengeComponentIndex(ctx, msg.ChallengeId)
+	content, err := k.getChallengedContent(ctx, objectInfo, componentIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	redundancyIndex := types.RedundancyIndexPrimary
 	if spInState.Id != sp.Id {
-		gvg, _ := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
+		gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, content.LocalVirtualGroupId)
+		if !found {
+			return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", content.LocalVirtualGroupId)
+		}
 		found = false
-		for _, id := range gvg.SecondarySpIds {
+		for i, id := range gvg.SecondarySpIds {
 			if id == sp.Id {
+				redundancyIndex = int32(i)
 				found = true
 				break
 			}
@@ -98,7 +110,7 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		}
 
 		// check slash amount
-		objectSize := objectInfo.PayloadSize
+		objectSize := content.PayloadSize
 		toSlashAmount := k.calculateSlashAmount(ctx, objectSize)
 
 		slashedAmount := k.GetSpSlashAmount(ctx, sp.Id)
@@ -111,8 +123,34 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 			}
 		}
 
-		// do slash & reward
-		err = k.doSlashAndRewards(ctx, msg.ChallengeId, msg.VoteResult, toSlashAmount, sp.Id, submitter, challenger, validators)
+		appealPeriod := k.GetParams(ctx).SlashAppealPeriod
+		if appealPeriod > 0 {
+			// the slash & reward will be done after the appeal period if the storage provider does not appeal
+			pendingSlash := &types.PendingSlash{
+				ChallengeId:       msg.ChallengeId,
+				SpId:              sp.Id,
+				ObjectId:          msg.ObjectId,
+				RedundancyIndex:   redundancyIndex,
+				SlashAmount:       toSlashAmount,
+				Submitter:         submitter.String(),
+				ChallengerAddress: msg.ChallengerAddress,
+				Validators:        validators,
+				FinalizeHeight:    uint64(ctx.BlockHeight()) + appealPeriod,
+				AttestHeight:      uint64(ctx.BlockHeight()),
+				ComponentIndex:    componentIndex,
+			}
+			k.SavePendingSlash(ctx, pendingSlash)
+			err = ctx.EventManager().EmitTypedEvents(&types.EventPendingSlash{
+				ChallengeId:    msg.ChallengeId,
+				SpId:           sp.Id,
+				ObjectId:       msg.ObjectId,
+				SlashAmount:    toSlashAmount.String(),
+				FinalizeHeight: pendingSlash.FinalizeHeight,
+			})
+		} else {
+			// do slash & reward
+			err = k.doSlashAndRewards(ctx, msg.ChallengeId, msg.VoteResult, toSlashAmount, sp.Id, submitter, challenger, validators)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -124,6 +162,9 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		}
 		k.SaveSlash(ctx, slash)
 		k.SetSpSlashAmount(ctx, sp.Id, slashedAmount.Add(toSlashAmount))
+		if appealPeriod == 0 {
+			k.SpKeeper.RecordChallengeResult(ctx, sp.Id, false)
+		}
 	} else {
 		// check whether it is a heartbeat attest
 		heartbeatInterval := k.GetParams(ctx).HeartbeatInterval
@@ -136,7 +177,9 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		if err != nil {
 			return nil, err
 		}
+		k.SpKeeper.RecordChallengeResult(ctx, sp.Id, true)
 	}
+	k.SpKeeper.RecordChallengeAttested(ctx, sp.Id)
 	k.AppendAttestedChallenge(ctx, &types.AttestedChallenge{
 		Id:     msg.ChallengeId,
 		Result: msg.VoteResult,
@@ -164,7 +207,7 @@ func (k msgServer) calculateSlashAmount(ctx sdk.Context, objectSize uint64) sdkm
 }
 
 // calculateSlashRewards calculates the rewards to challenger, submitter and validators when the total slash amount.
-func (k msgServer) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, challenger sdk.AccAddress, validators int64) (sdkmath.Int, sdkmath.Int, sdkmath.Int) {
+func (k Keeper) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, challenger sdk.AccAddress, validators int64) (sdkmath.Int, sdkmath.Int, sdkmath.Int) {
 	challengerReward := sdkmath.ZeroInt()
 	var eachValidatorReward sdkmath.Int
 
@@ -196,7 +239,7 @@ func (k msgServer) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, cha
 }
 
 // doSlashAndRewards will execute the slash, transfer the rewards and emit events.
-func (k msgServer) doSlashAndRewards(ctx sdk.Context, challengeId uint64, voteResult types.VoteResult, slashAmount sdkmath.Int,
+func (k Keeper) doSlashAndRewards(ctx sdk.Context, challengeId uint64, voteResult types.VoteResult, slashAmount sdkmath.Int,
 	spID uint32, submitter, challenger sdk.AccAddress, validators []string) error {
 
 	challengerReward, eachValidatorReward, submitterReward := sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()
@@ -326,3 +326,74 @@ func (s *TestSuite) TestAttest_Normal() {
 	_, err = s.msgServer.Attest(s.ctx, attestMsg3)
 	require.Error(s.T(), err)
 }
+
+func (s *TestSuite) TestAttest_AppealPeriod() {
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.SlashAppealPeriod = 100
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	// prepare challenge
+	challengeId := uint64(99)
+	s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{
+		Id: challengeId,
+	})
+
+	validSubmitter := sample.RandAccAddress()
+
+	blsKey, _ := bls.RandKey()
+	historicalInfo := stakingtypes.HistoricalInfo{
+		Header: tmproto.Header{},
+		Valset: []stakingtypes.Validator{{
+			BlsKey:            blsKey.PublicKey().Marshal(),
+			ChallengerAddress: validSubmitter.String(),
+		}},
+	}
+	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).
+		Return(historicalInfo, true).AnyTimes()
+
+	existBucket := &storagetypes.BucketInfo{
+		Id:         math.NewUint(10),
+		BucketName: "existbucket",
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		ObjectName:   "existobject",
+		BucketName:   existBucket.BucketName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(math.NewUint(10))).
+		Return(existObject, true).AnyTimes()
+
+	spOperatorAcc := sample.RandAccAddress()
+	sp := &sptypes.StorageProvider{Id: 1, OperatorAddress: spOperatorAcc.String()}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
+		Return(sp, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(sp).AnyTimes()
+	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	attestMsg := &types.MsgAttest{
+		Submitter:         validSubmitter.String(),
+		ChallengeId:       challengeId,
+		ObjectId:          existObject.Id,
+		SpOperatorAddress: spOperatorAcc.String(),
+		VoteResult:        types.CHALLENGE_SUCCEED,
+		VoteValidatorSet:  []uint64{1},
+	}
+	toSign := attestMsg.GetBlsSignBytes(s.ctx.ChainID())
+	attestMsg.VoteAggSignature = blsKey.Sign(toSign[:]).Marshal()
+	_, err := s.msgServer.Attest(s.ctx, attestMsg)
+	require.NoError(s.T(), err)
+
+	// the slash is pending for appeal
+	pendingSlash, found := s.challengeKeeper.GetPendingSlash(s.ctx, challengeId)
+	s.Require().True(found)
+	s.Require().Equal(sp.Id, pendingSlash.SpId)
+	s.Require().Equal(types.RedundancyIndexPrimary, pendingSlash.RedundancyIndex)
+	s.Require().Equal(uint64(s.ctx.BlockHeight())+100, pendingSlash.FinalizeHeight)
+	s.Require().Equal(uint64(s.ctx.BlockHeight()), pendingSlash.AttestHeight)
+	s.Require().Equal(params.SlashAmountMin, pendingSlash.SlashAmount)
+	s.Require().True(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, existObject.Id))
+}
@@ -41,18 +41,46 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		return nil, errors.Wrap(types.ErrInvalidSegmentIndex, "the object is empty, no segment")
 	}
 
+	// generate segment index, the segment of a composed object is located in one of its components
+	segmentSize, err := k.Keeper.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
+	}
+	segmentIndex := msg.SegmentIndex
+	segments := CalculateSegments(objectInfo.PayloadSize, segmentSize)
+	if msg.RandomIndex {
+		segmentIndex = RandomSegmentIndex(ctx.BlockHeader().RandaoMix, segments)
+	} else {
+		if uint64(segmentIndex) > segments-1 {
+			return nil, types.ErrInvalidSegmentIndex
+		}
+	}
+	content, componentIndex, segmentIndex, err := k.LocateChallengedContent(ctx, objectInfo, segmentIndex)
+	if err != nil {
+		return nil, err
+	}
+	if msg.RandomIndex && objectInfo.IsComposed {
+		segmentSize, err = k.Keeper.StorageKeeper.MaxSegmentSize(ctx, content.GetLatestUpdatedTime())
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
+		}
+		segments = CalculateSegments(content.PayloadSize, segmentSize)
+		segmentIndex = RandomSegmentIndex(ctx.BlockHeader().RandaoMix, segments)
+	}
+
 	// check whether the sp stores the object info, generate redundancy index
 	stored := false
 	redundancyIndex := types.RedundancyIndexPrimary
+	challengedSpId := sp.Id
 
 	if spOperator.Equals(sdk.MustAccAddressFromHex(sp.OperatorAddress)) {
 		stored = true
 	}
 
 	if !stored {
-		gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
+		gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, content.LocalVirtualGroupId)
 		if !found {
-			return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", objectInfo.LocalVirtualGroupId)
+			return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", content.LocalVirtualGroupId)
 		}
 
 		// check secondary sp
@@ -63,6 +91,7 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 			}
 			if spOperator.Equals(sdk.MustAccAddressFromHex(tmpSp.OperatorAddress)) {
 				redundancyIndex = int32(i)
+				challengedSpId = spId
 				stored = true
 				break
 			}
@@ -77,29 +106,20 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		return nil, types.ErrExistsRecentSlash
 	}
 
-	// generate segment index
-	segmentSize, err := k.Keeper.StorageKeeper.MaxSegmentSize(ctx, objectInfo.GetLatestUpdatedTime())
-	if err != nil {
-		return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
-	}
-	segmentIndex := msg.SegmentIndex
-	segments := CalculateSegments(objectInfo.PayloadSize, segmentSize)
-	if msg.RandomIndex {
-		segmentIndex = RandomSegmentIndex(ctx.BlockHeader().RandaoMix, segments)
-	} else {
-		if uint64(segmentIndex) > segments-1 {
-			return nil, types.ErrInvalidSegmentIndex
-		}
-	}
-
 	k.IncrChallengeCountCurrentBlock(ctx)
 	challengeId := k.GetChallengeId(ctx) + 1
 	expiredHeight := k.Keeper.GetParams(ctx).ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
 	k.SaveChallenge(ctx, types.Challenge{
-		Id:            challengeId,
-		ExpiredHeight: expiredHeight,
+		Id:             challengeId,
+		ExpiredHeight:  expiredHeight,
+		ComponentIndex: componentIndex,
 	})
 
+	if k.GetParams(ctx).ChallengeSelectionMode == types.CHALLENGE_SELECTION_WEIGHTED {
+		k.SetLastChallengedHeight(ctx, challengedSpId, objectInfo.Id, uint64(ctx.BlockHeight()))
+	}
+	k.SpKeeper.RecordChallengesIssued(ctx, challengedSpId, 1)
+
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStartChallenge{
 		ChallengeId:       challengeId,
 		ObjectId:          objectInfo.Id,
@@ -109,6 +129,7 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		RedundancyIndex:   redundancyIndex,
 		ChallengerAddress: challenger.String(),
 		ExpiredHeight:     expiredHeight,
+		ComponentIndex:    componentIndex,
 	}); err != nil {
 		return nil, err
 	}
@@ -212,3 +212,71 @@ func (s *TestSuite) TestSubmit() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSubmitComposedObject() {
+	primarySp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 100, OperatorAddress: sample.RandAccAddressHex()}
+	secondarySpAddr := sample.RandAccAddress()
+	secondarySp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 1, OperatorAddress: secondarySpAddr.String()}
+	otherSp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 2, OperatorAddress: sample.RandAccAddressHex()}
+	for _, sp := range []*sptypes.StorageProvider{primarySp, secondarySp, otherSp} {
+		s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Eq(sp.Id)).Return(sp, true).AnyTimes()
+	}
+
+	bucketName, objectName := "composedbucket", "composedobject"
+	bucket := &storagetypes.BucketInfo{BucketName: bucketName, Id: math.NewUint(1)}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(bucketName)).Return(bucket, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Eq(bucket)).Return(primarySp).AnyTimes()
+
+	object := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		BucketName:   bucketName,
+		ObjectName:   objectName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  25000,
+		IsComposed:   true,
+	}
+	s.storageKeeper.EXPECT().GetObjectInfo(gomock.Any(), gomock.Eq(bucketName), gomock.Eq(objectName)).
+		Return(object, true).AnyTimes()
+	s.storageKeeper.EXPECT().GetComposedObject(gomock.Any(), gomock.Eq(object.Id)).Return(&storagetypes.ComposedObject{
+		ObjectId: object.Id,
+		Components: []*storagetypes.ObjectComponent{
+			{ObjectId: math.NewUint(1), LocalVirtualGroupId: 1, PayloadSize: 12000, Offset: 0},
+			{ObjectId: math.NewUint(2), LocalVirtualGroupId: 2, PayloadSize: 13000, Offset: 12000},
+		},
+	}, true).AnyTimes()
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+
+	// the components are stored on different secondary sps
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Eq(bucket.Id), gomock.Eq(uint32(1))).
+		Return(&virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: primarySp.Id, SecondarySpIds: []uint32{otherSp.Id}}, true).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Eq(bucket.Id), gomock.Eq(uint32(2))).
+		Return(&virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: primarySp.Id, SecondarySpIds: []uint32{secondarySp.Id}}, true).AnyTimes()
+
+	// the first segment is in the first component, which is not stored on the sp
+	_, err := s.msgServer.Submit(s.ctx, &types.MsgSubmit{
+		Challenger:        sample.RandAccAddressHex(),
+		SpOperatorAddress: secondarySpAddr.String(),
+		BucketName:        bucketName,
+		ObjectName:        objectName,
+		SegmentIndex:      0,
+	})
+	s.Require().ErrorIs(err, types.ErrNotStoredOnSp)
+
+	// the last segment is in the second component
+	res, err := s.msgServer.Submit(s.ctx, &types.MsgSubmit{
+		Challenger:        sample.RandAccAddressHex(),
+		SpOperatorAddress: secondarySpAddr.String(),
+		BucketName:        bucketName,
+		ObjectName:        objectName,
+		SegmentIndex:      2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), s.challengeKeeper.GetChallengeComponentIndex(s.ctx, res.ChallengeId))
+
+	content, componentIndex, segmentIndex, err := s.challengeKeeper.LocateChallengedContent(s.ctx, object, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), componentIndex)
+	s.Require().Equal(uint32(0), segmentIndex)
+	s.Require().Equal(uint32(2), content.LocalVirtualGroupId)
+	s.Require().Equal(uint64(13000), content.PayloadSize)
+}
@@ -59,6 +59,9 @@ func (s *TestSuite) SetupTest() {
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	storageKeeper := types.NewMockStorageKeeper(ctrl)
 	spKeeper := types.NewMockSpKeeper(ctrl)
+	spKeeper.EXPECT().RecordChallengesIssued(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	spKeeper.EXPECT().RecordChallengeAttested(gomock.Any(), gomock.Any()).AnyTimes()
+	spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	stakingKeeper := types.NewMockStakingKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
 
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// SavePendingSlash puts a slash into the queue, it will be executed at the finalize height if it is not appealed.
+func (k Keeper) SavePendingSlash(ctx sdk.Context, slash *types.PendingSlash) {
+	store := ctx.KVStore(k.storeKey)
+
+	queueStore := prefix.NewStore(store, types.PendingSlashKeyPrefix)
+	queueStore.Set(getPendingSlashKeyBytes(slash.FinalizeHeight, slash.ChallengeId), k.cdc.MustMarshal(slash))
+
+	idStore := prefix.NewStore(store, types.PendingSlashByIdKeyPrefix)
+	idStore.Set(getChallengeKeyBytes(slash.ChallengeId), k.encodeUint64(slash.FinalizeHeight))
+}
+
+// GetPendingSlash gets the pending slash of a challenge
+func (k Keeper) GetPendingSlash(ctx sdk.Context, challengeId uint64) (*types.PendingSlash, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	idStore := prefix.NewStore(store, types.PendingSlashByIdKeyPrefix)
+	heightBz := idStore.Get(getChallengeKeyBytes(challengeId))
+	if heightBz == nil {
+		return nil, false
+	}
+
+	queueStore := prefix.NewStore(store, types.PendingSlashKeyPrefix)
+	bz := queueStore.Get(getPendingSlashKeyBytes(binary.BigEndian.Uint64(heightBz), challengeId))
+	if bz == nil {
+		return nil, false
+	}
+	var slash types.PendingSlash
+	k.cdc.MustUnmarshal(bz, &slash)
+	return &slash, true
+}
+
+// RemovePendingSlash removes a slash from the queue
+func (k Keeper) RemovePendingSlash(ctx sdk.Context, slash *types.PendingSlash) {
+	store := ctx.KVStore(k.storeKey)
+
+	queueStore := prefix.NewStore(store, types.PendingSlashKeyPrefix)
+	queueStore.Delete(getPendingSlashKeyBytes(slash.FinalizeHeight, slash.ChallengeId))
+
+	idStore := prefix.NewStore(store, types.PendingSlashByIdKeyPrefix)
+	idStore.Delete(getChallengeKeyBytes(slash.ChallengeId))
+}
+
+// GetAllPendingSlashes gets all the pending slashes ordered by the finalize height
+func (k Keeper) GetAllPendingSlashes(ctx sdk.Context) []*types.PendingSlash {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSlashKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	slashes := make([]*types.PendingSlash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var slash types.PendingSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &slash)
+		slashes = append(slashes, &slash)
+	}
+	return slashes
+}
+
+// FinalizePendingSlashes executes the pending slashes whose appeal period is ended at the height
+func (k Keeper) FinalizePendingSlashes(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSlashKeyPrefix)
+	iterator := store.Iterator(nil, k.encodeUint64(height+1))
+	defer iterator.Close()
+
+	slashes := make([]*types.PendingSlash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var slash types.PendingSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &slash)
+		slashes = append(slashes, &slash)
+	}
+
+	for _, slash := range slashes {
+		submitter := sdk.MustAccAddressFromHex(slash.Submitter)
+		challenger := sdk.AccAddress{}
+		if slash.ChallengerAddress != "" {
+			challenger = sdk.MustAccAddressFromHex(slash.ChallengerAddress)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		err := k.doSlashAndRewards(cacheCtx, slash.ChallengeId, types.CHALLENGE_SUCCEED, slash.SlashAmount, slash.SpId,
+			submitter, challenger, slash.Validators)
+		if err != nil {
+			ctx.Logger().Error("fail to execute pending slash", "challenge", slash.ChallengeId, "err", err.Error())
+			k.CancelPendingSlash(ctx, slash)
+			continue
+		}
+		k.RemovePendingSlash(ctx, slash)
+		write()
+		k.SpKeeper.RecordChallengeResult(ctx, slash.SpId, false)
+	}
+}
+
+// CancelPendingSlash removes a pending slash and reverts the slash record and the slash amount counted for the storage
+// provider when the challenge is attested, the slash amount is only reverted in the same counting window.
+func (k Keeper) CancelPendingSlash(ctx sdk.Context, slash *types.PendingSlash) {
+	k.RemovePendingSlash(ctx, slash)
+
+	if height, found := k.getSlashHeight(ctx, slash.SpId, slash.ObjectId); found && height == slash.AttestHeight {
+		k.RemoveSlash(ctx, slash.SpId, slash.ObjectId)
+	}
+
+	window := k.GetParams(ctx).SpSlashCountingWindow
+	if uint64(ctx.BlockHeight())/window != slash.AttestHeight/window {
+		return
+	}
+	slashedAmount := k.GetSpSlashAmount(ctx, slash.SpId)
+	if slashedAmount.GT(slash.SlashAmount) {
+		k.SetSpSlashAmount(ctx, slash.SpId, slashedAmount.Sub(slash.SlashAmount))
+	} else {
+		k.SetSpSlashAmount(ctx, slash.SpId, sdk.ZeroInt())
+	}
+}
+
+// getPendingSlashKeyBytes returns the byte representation of pending slash key
+func getPendingSlashKeyBytes(finalizeHeight, challengeId uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, finalizeHeight)
+	binary.BigEndian.PutUint64(bz[8:], challengeId)
+	return bz
+}
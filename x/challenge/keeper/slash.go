@@ -21,6 +21,13 @@ func (k Keeper) SaveSlash(ctx sdk.Context, slash types.Slash) {
 	store.Set(getSlashKeyBytes(slash.SpId, slash.ObjectId), heightBytes)
 }
 
+// RemoveSlash removes the slash of a pair of sp and object info
+func (k Keeper) RemoveSlash(ctx sdk.Context, spId uint32, objectId sdkmath.Uint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKeyPrefix)
+
+	store.Delete(getSlashKeyBytes(spId, objectId))
+}
+
 // RemoveSlashUntil removes slashes which are created earlier
 func (k Keeper) RemoveSlashUntil(ctx sdk.Context, height uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKeyPrefix)
@@ -42,6 +49,17 @@ func (k Keeper) ExistsSlash(ctx sdk.Context, spId uint32, objectId sdkmath.Uint)
 	return store.Has(getSlashKeyBytes(spId, objectId))
 }
 
+// getSlashHeight returns the height of the recent slash for a pair of sp and object info
+func (k Keeper) getSlashHeight(ctx sdk.Context, spId uint32, objectId sdkmath.Uint) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKeyPrefix)
+
+	bz := store.Get(getSlashKeyBytes(spId, objectId))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
 // getSlashKeyBytes returns the byte representation of Slash key
 func getSlashKeyBytes(spId uint32, objectId sdkmath.Uint) []byte {
 	idBytes := make([]byte, 4)
@@ -24,4 +24,6 @@ var (
 	ErrNotInturnChallenger     = errors.Register(ModuleName, 16, "challenger is not in turn")
 	ErrInvalidParams           = errors.Register(ModuleName, 17, "invalid params")
 	ErrCannotFindGVG           = errors.Register(ModuleName, 18, "fail to find global virtual group for the object")
+	ErrNoPendingSlash          = errors.Register(ModuleName, 20, "no pending slash for the challenge")
+	ErrInvalidAppealEvidence   = errors.Register(ModuleName, 21, "invalid appeal evidence")
 )
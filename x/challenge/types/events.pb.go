@@ -42,6 +42,9 @@ type EventStartChallenge struct {
 	ChallengerAddress string `protobuf:"bytes,7,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
 	// The challenge will be expired after this height
 	ExpiredHeight uint64 `protobuf:"varint,8,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty"`
+	// The index of the challenged component when the object is a composed object, the segment index is inside the
+	// component, whose pieces are stored under the component object id.
+	ComponentIndex uint32 `protobuf:"varint,9,opt,name=component_index,json=componentIndex,proto3" json:"component_index,omitempty"`
 }
 
 func (m *EventStartChallenge) Reset()         { *m = EventStartChallenge{} }
@@ -126,6 +129,13 @@ func (m *EventStartChallenge) GetExpiredHeight() uint64 {
 	return 0
 }
 
+func (m *EventStartChallenge) GetComponentIndex() uint32 {
+	if m != nil {
+		return m.ComponentIndex
+	}
+	return 0
+}
+
 // EventAttestChallenge to indicate a challenge has been attested.
 type EventAttestChallenge struct {
 	// The id of challenge.
@@ -244,49 +254,189 @@ func (m *EventAttestChallenge) GetValidatorRewardAmount() string {
 	return ""
 }
 
+// EventPendingSlash to indicate the slash of a succeed challenge is waiting for appeal.
+type EventPendingSlash struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider to be slashed.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The amount to be slashed from the storage provider.
+	SlashAmount string `protobuf:"bytes,4,opt,name=slash_amount,json=slashAmount,proto3" json:"slash_amount,omitempty"`
+	// The slash will be executed at this height if it is not appealed.
+	FinalizeHeight uint64 `protobuf:"varint,5,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
+}
+
+func (m *EventPendingSlash) Reset()         { *m = EventPendingSlash{} }
+func (m *EventPendingSlash) String() string { return proto.CompactTextString(m) }
+func (*EventPendingSlash) ProtoMessage()    {}
+func (*EventPendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{2}
+}
+func (m *EventPendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingSlash.Merge(m, src)
+}
+func (m *EventPendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingSlash proto.InternalMessageInfo
+
+func (m *EventPendingSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventPendingSlash) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventPendingSlash) GetSlashAmount() string {
+	if m != nil {
+		return m.SlashAmount
+	}
+	return ""
+}
+
+func (m *EventPendingSlash) GetFinalizeHeight() uint64 {
+	if m != nil {
+		return m.FinalizeHeight
+	}
+	return 0
+}
+
+// EventAppealChallenge to indicate a pending slash has been appealed by the storage provider.
+type EventAppealChallenge struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider which appeals.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+}
+
+func (m *EventAppealChallenge) Reset()         { *m = EventAppealChallenge{} }
+func (m *EventAppealChallenge) String() string { return proto.CompactTextString(m) }
+func (*EventAppealChallenge) ProtoMessage()    {}
+func (*EventAppealChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{3}
+}
+func (m *EventAppealChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAppealChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAppealChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAppealChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAppealChallenge.Merge(m, src)
+}
+func (m *EventAppealChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAppealChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAppealChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAppealChallenge proto.InternalMessageInfo
+
+func (m *EventAppealChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventAppealChallenge) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventStartChallenge)(nil), "greenfield.challenge.EventStartChallenge")
 	proto.RegisterType((*EventAttestChallenge)(nil), "greenfield.challenge.EventAttestChallenge")
+	proto.RegisterType((*EventPendingSlash)(nil), "greenfield.challenge.EventPendingSlash")
+	proto.RegisterType((*EventAppealChallenge)(nil), "greenfield.challenge.EventAppealChallenge")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/events.proto", fileDescriptor_e9eaa4bfadaa20f8) }
 
 var fileDescriptor_e9eaa4bfadaa20f8 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0xff, 0x5c, 0xfe, 0x66, 0x92, 0x94, 0xd6, 0x09, 0xd4, 0x14, 0xc9, 0x75, 0x8a, 0x90,
-	0xc2, 0x22, 0x89, 0x00, 0xa9, 0xea, 0x36, 0x45, 0x15, 0x8d, 0x58, 0x20, 0xb9, 0x82, 0x05, 0x1b,
-	0xcb, 0xf6, 0x1c, 0x6c, 0x23, 0x67, 0xc6, 0x9a, 0x99, 0x94, 0xf4, 0x2d, 0xe0, 0x5d, 0xfa, 0x10,
-	0x5d, 0x56, 0x5d, 0x21, 0x16, 0x15, 0x4a, 0xc4, 0x7b, 0x20, 0x8f, 0x27, 0x76, 0x82, 0x8a, 0x50,
-	0x76, 0x9e, 0xef, 0x32, 0xdf, 0xf1, 0x39, 0x73, 0x50, 0x37, 0x60, 0x00, 0xe4, 0x53, 0x04, 0x31,
-	0x1e, 0xfa, 0xa1, 0x1b, 0xc7, 0x40, 0x02, 0x18, 0xc2, 0x05, 0x10, 0xc1, 0x07, 0x09, 0xa3, 0x82,
-	0xea, 0x9d, 0x42, 0x32, 0xc8, 0x25, 0xfb, 0x8f, 0x7d, 0xca, 0x27, 0x94, 0x3b, 0x52, 0x33, 0xcc,
-	0x0e, 0x99, 0x61, 0xbf, 0x13, 0xd0, 0x80, 0x66, 0x78, 0xfa, 0xa5, 0x50, 0xeb, 0xde, 0x24, 0x71,
-	0x99, 0x80, 0xf2, 0x1d, 0x7e, 0x2b, 0xa3, 0xf6, 0x69, 0x9a, 0x7c, 0x2e, 0x5c, 0x26, 0x5e, 0x2f,
-	0x35, 0x7a, 0x17, 0x35, 0x73, 0x83, 0x13, 0x61, 0x43, 0xb3, 0xb4, 0x5e, 0xc5, 0x6e, 0xe4, 0xd8,
-	0x18, 0xeb, 0xc7, 0xa8, 0x4e, 0xbd, 0xcf, 0xe0, 0x8b, 0x94, 0xff, 0xcf, 0xd2, 0x7a, 0xf5, 0x93,
-	0x27, 0xd7, 0x77, 0x07, 0xa5, 0x1f, 0x77, 0x07, 0x95, 0xf7, 0x11, 0x11, 0xb7, 0x57, 0xfd, 0x86,
-	0xaa, 0x31, 0x3d, 0xda, 0x5b, 0x99, 0x7a, 0x8c, 0xf5, 0xa7, 0xa8, 0xc5, 0x21, 0x98, 0x00, 0x11,
-	0x4e, 0x44, 0x30, 0xcc, 0x8c, 0xb2, 0xa5, 0xf5, 0x5a, 0x76, 0x53, 0x81, 0xe3, 0x14, 0xd3, 0xdb,
-	0xa8, 0xca, 0x93, 0xf4, 0xea, 0x8a, 0x24, 0x2b, 0x3c, 0x19, 0x63, 0xfd, 0x0c, 0xb5, 0x79, 0xe2,
-	0xd0, 0x04, 0x98, 0x2b, 0x28, 0x73, 0x5c, 0x8c, 0x19, 0x70, 0x6e, 0x54, 0x65, 0xba, 0x71, 0x7b,
-	0xd5, 0xef, 0xa8, 0xc4, 0x51, 0xc6, 0x9c, 0x0b, 0x16, 0x91, 0xc0, 0xde, 0xe5, 0xc9, 0x3b, 0xe5,
-	0x51, 0x84, 0xfe, 0x1c, 0xed, 0x30, 0xc0, 0x53, 0x82, 0x5d, 0xe2, 0x5f, 0xaa, 0x32, 0x6a, 0x96,
-	0xd6, 0xab, 0xda, 0x0f, 0x0a, 0x3c, 0xab, 0xe4, 0x0d, 0xd2, 0xf3, 0xff, 0x2e, 0x32, 0xff, 0xff,
-	0x57, 0x66, 0xe1, 0x59, 0x66, 0x3e, 0x43, 0xdb, 0x30, 0x4b, 0x22, 0x06, 0xd8, 0x09, 0x21, 0x0a,
-	0x42, 0x61, 0x6c, 0xc9, 0xb6, 0xb6, 0x14, 0x7a, 0x26, 0xc1, 0xc3, 0x5f, 0x65, 0xd4, 0x91, 0x33,
-	0x19, 0x09, 0x01, 0x7c, 0xd3, 0xa1, 0xd4, 0x18, 0xf0, 0x69, 0x2c, 0xe4, 0x44, 0xb6, 0x5f, 0x5a,
-	0x83, 0xfb, 0x5e, 0xd2, 0xe0, 0x03, 0x15, 0x60, 0x4b, 0x9d, 0xad, 0xf4, 0x45, 0xbf, 0xcb, 0x2b,
-	0xfd, 0xee, 0xa2, 0x26, 0x8f, 0x5d, 0x1e, 0x3a, 0xee, 0x84, 0x4e, 0x89, 0x90, 0xb3, 0xa8, 0xdb,
-	0x0d, 0x89, 0x8d, 0x24, 0xf4, 0x97, 0xee, 0x54, 0x37, 0xef, 0xce, 0x31, 0x32, 0x56, 0x2e, 0x62,
-	0xf0, 0xc5, 0x65, 0x78, 0x99, 0x5b, 0x93, 0xb9, 0x8f, 0x0a, 0xde, 0x96, 0xb4, 0x2a, 0xe1, 0x14,
-	0xed, 0xf2, 0xa9, 0x37, 0x89, 0x84, 0xd8, 0x60, 0x3e, 0x3b, 0xb9, 0x65, 0x59, 0xc0, 0x11, 0xda,
-	0x2b, 0xae, 0x59, 0xcf, 0xdf, 0x92, 0xf9, 0x0f, 0x73, 0x7a, 0x2d, 0xfe, 0x08, 0xed, 0x5d, 0xb8,
-	0x71, 0x84, 0xe5, 0x93, 0x5c, 0xf7, 0xa1, 0xcc, 0x97, 0xd3, 0xab, 0xbe, 0x93, 0xb7, 0xd7, 0x73,
-	0x53, 0xbb, 0x99, 0x9b, 0xda, 0xcf, 0xb9, 0xa9, 0x7d, 0x5d, 0x98, 0xa5, 0x9b, 0x85, 0x59, 0xfa,
-	0xbe, 0x30, 0x4b, 0x1f, 0x5f, 0x04, 0x91, 0x08, 0xa7, 0xde, 0xc0, 0xa7, 0x93, 0xa1, 0x47, 0xbc,
-	0xbe, 0x1f, 0xba, 0x11, 0x19, 0xae, 0x2c, 0xf3, 0xec, 0xcf, 0x75, 0xf6, 0x6a, 0x72, 0x9f, 0x5f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x86, 0xd6, 0xb3, 0x28, 0x5d, 0x04, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0xc9, 0xcf, 0x47, 0x06, 0x08, 0x60, 0xf2, 0x95, 0x94, 0x4a, 0x21, 0xa4, 0xaa, 0x48,
+	0x17, 0x24, 0x6a, 0x2b, 0x21, 0xb6, 0x50, 0xa1, 0x12, 0x75, 0xd1, 0xca, 0xa8, 0x5d, 0x74, 0x63,
+	0x8d, 0x3d, 0x17, 0x7b, 0x2a, 0x7b, 0xc6, 0x9a, 0x99, 0x50, 0xe8, 0x13, 0x74, 0xd9, 0x87, 0x61,
+	0xd5, 0x27, 0x60, 0x49, 0x59, 0x55, 0x5d, 0xa0, 0x0a, 0xd4, 0xf7, 0xa8, 0x3c, 0xfe, 0x4b, 0x2a,
+	0xaa, 0x12, 0xa9, 0x3b, 0xfb, 0x9c, 0x7b, 0xe6, 0x5c, 0xdf, 0x73, 0x3d, 0x68, 0xc3, 0x13, 0x00,
+	0xec, 0x88, 0x42, 0x40, 0x06, 0xae, 0x8f, 0x83, 0x00, 0x98, 0x07, 0x03, 0x38, 0x06, 0xa6, 0x64,
+	0x3f, 0x12, 0x5c, 0x71, 0xb3, 0x59, 0x94, 0xf4, 0xf3, 0x92, 0xb5, 0xfb, 0x2e, 0x97, 0x21, 0x97,
+	0xb6, 0xae, 0x19, 0x24, 0x2f, 0x89, 0x60, 0xad, 0xe9, 0x71, 0x8f, 0x27, 0x78, 0xfc, 0x94, 0xa2,
+	0x9d, 0x5b, 0x9d, 0xd4, 0x69, 0x04, 0xa9, 0xae, 0xfb, 0xa5, 0x8c, 0x56, 0xf6, 0x63, 0xe7, 0x43,
+	0x85, 0x85, 0x7a, 0x9e, 0xd5, 0x98, 0x1b, 0x68, 0x3e, 0x17, 0xd8, 0x94, 0xb4, 0x8c, 0x8e, 0xd1,
+	0xab, 0x58, 0x73, 0x39, 0x36, 0x24, 0xe6, 0x0e, 0xaa, 0x73, 0xe7, 0x3d, 0xb8, 0x2a, 0xe6, 0x67,
+	0x3a, 0x46, 0xaf, 0xbe, 0xf7, 0xe0, 0xfc, 0x6a, 0xbd, 0xf4, 0xfd, 0x6a, 0xbd, 0xf2, 0x86, 0x32,
+	0x75, 0x79, 0xb6, 0x35, 0x97, 0xf6, 0x18, 0xbf, 0x5a, 0xb3, 0x49, 0xf5, 0x90, 0x98, 0x0f, 0xd1,
+	0x82, 0x04, 0x2f, 0x04, 0xa6, 0x6c, 0xca, 0x08, 0x9c, 0xb4, 0xca, 0x1d, 0xa3, 0xb7, 0x60, 0xcd,
+	0xa7, 0xe0, 0x30, 0xc6, 0xcc, 0x15, 0x54, 0x95, 0x51, 0x7c, 0x74, 0x45, 0x93, 0x15, 0x19, 0x0d,
+	0x89, 0x79, 0x80, 0x56, 0x64, 0x64, 0xf3, 0x08, 0x04, 0x56, 0x5c, 0xd8, 0x98, 0x10, 0x01, 0x52,
+	0xb6, 0xaa, 0xda, 0xbd, 0x75, 0x79, 0xb6, 0xd5, 0x4c, 0x1d, 0x77, 0x13, 0xe6, 0x50, 0x09, 0xca,
+	0x3c, 0x6b, 0x59, 0x46, 0xaf, 0x52, 0x4d, 0x4a, 0x98, 0x8f, 0xd1, 0x92, 0x00, 0x32, 0x62, 0x04,
+	0x33, 0xf7, 0x34, 0x6d, 0xa3, 0xd6, 0x31, 0x7a, 0x55, 0x6b, 0xb1, 0xc0, 0x93, 0x4e, 0x5e, 0x20,
+	0x33, 0xff, 0xee, 0xc2, 0xf3, 0xbf, 0xbf, 0x79, 0x16, 0x9a, 0xcc, 0xf3, 0x11, 0x6a, 0xc0, 0x49,
+	0x44, 0x05, 0x10, 0xdb, 0x07, 0xea, 0xf9, 0xaa, 0x35, 0xab, 0xc7, 0xba, 0x90, 0xa2, 0x07, 0x1a,
+	0x34, 0x37, 0xd1, 0xa2, 0xcb, 0xc3, 0x88, 0xb3, 0x62, 0x40, 0x75, 0x3d, 0x83, 0x46, 0x0e, 0xeb,
+	0xc6, 0xba, 0x3f, 0xcb, 0xa8, 0xa9, 0xc3, 0xdb, 0x55, 0x0a, 0xe4, 0xb4, 0xe9, 0xd5, 0x04, 0xc8,
+	0x51, 0xa0, 0x74, 0x74, 0x8d, 0xa7, 0x9d, 0xfe, 0x6d, 0x2b, 0xd7, 0x7f, 0xcb, 0x15, 0x58, 0xba,
+	0xce, 0x4a, 0xeb, 0x8b, 0x60, 0xca, 0x63, 0xc1, 0x6c, 0xa0, 0x79, 0x19, 0x60, 0xe9, 0xdb, 0x38,
+	0xe4, 0x23, 0xa6, 0x74, 0x68, 0x75, 0x6b, 0x4e, 0x63, 0xbb, 0x1a, 0xfa, 0xc3, 0x18, 0xab, 0xd3,
+	0x8f, 0x71, 0x07, 0xb5, 0xc6, 0x0e, 0x12, 0xf0, 0x01, 0x0b, 0x92, 0xf9, 0xd6, 0xb4, 0xef, 0xbd,
+	0x82, 0xb7, 0x34, 0x9d, 0xb6, 0xb0, 0x8f, 0x96, 0xe5, 0xc8, 0x09, 0xa9, 0x52, 0x53, 0x04, 0xb9,
+	0x94, 0x4b, 0xb2, 0x06, 0xb6, 0xd1, 0x6a, 0x71, 0xcc, 0xa4, 0xff, 0xac, 0xf6, 0xff, 0x3f, 0xa7,
+	0x27, 0xec, 0xb7, 0xd1, 0xea, 0x31, 0x0e, 0x28, 0xd1, 0xbb, 0x3b, 0xa9, 0x43, 0x89, 0x2e, 0xa7,
+	0xc7, 0x75, 0xdd, 0xaf, 0x06, 0x5a, 0xd6, 0x39, 0xbf, 0x06, 0x46, 0x28, 0xf3, 0x0e, 0xe3, 0xa9,
+	0xde, 0x25, 0xe4, 0x3c, 0xaa, 0x99, 0xb1, 0xa8, 0x26, 0xfe, 0xdb, 0xf2, 0x34, 0xff, 0xed, 0x1d,
+	0x42, 0xde, 0x44, 0x8b, 0x47, 0x94, 0xe1, 0x80, 0x7e, 0x84, 0x6c, 0xc7, 0xab, 0xba, 0xaf, 0x46,
+	0x06, 0x27, 0x4b, 0xde, 0xfd, 0x64, 0x64, 0xbb, 0x1b, 0x45, 0x80, 0x83, 0xa9, 0x76, 0xf7, 0xdf,
+	0x7e, 0xd6, 0xde, 0xcb, 0xf3, 0xeb, 0xb6, 0x71, 0x71, 0xdd, 0x36, 0x7e, 0x5c, 0xb7, 0x8d, 0xcf,
+	0x37, 0xed, 0xd2, 0xc5, 0x4d, 0xbb, 0xf4, 0xed, 0xa6, 0x5d, 0x7a, 0xf7, 0xc4, 0xa3, 0xca, 0x1f,
+	0x39, 0x7d, 0x97, 0x87, 0x03, 0x87, 0x39, 0x5b, 0xae, 0x8f, 0x29, 0x1b, 0x8c, 0x5d, 0xaa, 0x27,
+	0xbf, 0x5f, 0xab, 0x4e, 0x4d, 0xdf, 0xab, 0xcf, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x76, 0x40,
+	0x9c, 0x25, 0xe5, 0x05, 0x00, 0x00,
 }
 
 func (m *EventStartChallenge) Marshal() (dAtA []byte, err error) {
@@ -309,6 +459,11 @@ func (m *EventStartChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ComponentIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ComponentIndex))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.ExpiredHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiredHeight))
 		i--
@@ -441,6 +596,104 @@ func (m *EventAttestChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizeHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FinalizeHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SlashAmount) > 0 {
+		i -= len(m.SlashAmount)
+		copy(dAtA[i:], m.SlashAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAppealChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAppealChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAppealChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -483,6 +736,9 @@ func (m *EventStartChallenge) Size() (n int) {
 	if m.ExpiredHeight != 0 {
 		n += 1 + sovEvents(uint64(m.ExpiredHeight))
 	}
+	if m.ComponentIndex != 0 {
+		n += 1 + sovEvents(uint64(m.ComponentIndex))
+	}
 	return n
 }
 
@@ -528,6 +784,47 @@ func (m *EventAttestChallenge) Size() (n int) {
 	return n
 }
 
+func (m *EventPendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.SlashAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FinalizeHeight != 0 {
+		n += 1 + sovEvents(uint64(m.FinalizeHeight))
+	}
+	return n
+}
+
+func (m *EventAppealChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -756,6 +1053,25 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentIndex", wireType)
+			}
+			m.ComponentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComponentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1076,6 +1392,301 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPendingSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPendingSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeHeight", wireType)
+			}
+			m.FinalizeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppealChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppealChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppealChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,9 @@ type SpKeeper interface {
 	GetStorageProviderByOperatorAddr(ctx sdk.Context, opAddr sdk.AccAddress) (sp *sp.StorageProvider, found bool)
 	DepositDenomForSP(ctx sdk.Context) (res string)
 	Slash(ctx sdk.Context, spID uint32, rewardInfos []sp.RewardInfo) error
+	RecordChallengesIssued(ctx sdk.Context, spId uint32, count uint64)
+	RecordChallengeAttested(ctx sdk.Context, spId uint32)
+	RecordChallengeResult(ctx sdk.Context, spId uint32, passed bool)
 }
 
 type StakingKeeper interface {
@@ -27,6 +30,7 @@ type StorageKeeper interface {
 	GetObjectInfo(ctx sdk.Context, bucketName string, objectName string) (*storage.ObjectInfo, bool)
 	GetObjectInfoById(ctx sdk.Context, objectId sdkmath.Uint) (*storage.ObjectInfo, bool)
 	GetObjectInfoCount(ctx sdk.Context) sdkmath.Uint
+	GetComposedObject(ctx sdk.Context, objectId sdkmath.Uint) (*storage.ComposedObject, bool)
 	GetBucketInfo(ctx sdk.Context, bucketName string) (*storage.BucketInfo, bool)
 	MaxSegmentSize(ctx sdk.Context, timestamp int64) (res uint64, err error)
 	GetObjectGVG(ctx sdk.Context, bucketID sdkmath.Uint, lvgID uint32) (*types.GlobalVirtualGroup, bool)
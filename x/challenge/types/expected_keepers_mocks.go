@@ -84,6 +84,42 @@ func (mr *MockSpKeeperMockRecorder) GetStorageProviderByOperatorAddr(ctx, opAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageProviderByOperatorAddr", reflect.TypeOf((*MockSpKeeper)(nil).GetStorageProviderByOperatorAddr), ctx, opAddr)
 }
 
+// RecordChallengeAttested mocks base method.
+func (m *MockSpKeeper) RecordChallengeAttested(ctx types2.Context, spId uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordChallengeAttested", ctx, spId)
+}
+
+// RecordChallengeAttested indicates an expected call of RecordChallengeAttested.
+func (mr *MockSpKeeperMockRecorder) RecordChallengeAttested(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChallengeAttested", reflect.TypeOf((*MockSpKeeper)(nil).RecordChallengeAttested), ctx, spId)
+}
+
+// RecordChallengeResult mocks base method.
+func (m *MockSpKeeper) RecordChallengeResult(ctx types2.Context, spId uint32, passed bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordChallengeResult", ctx, spId, passed)
+}
+
+// RecordChallengeResult indicates an expected call of RecordChallengeResult.
+func (mr *MockSpKeeperMockRecorder) RecordChallengeResult(ctx, spId, passed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChallengeResult", reflect.TypeOf((*MockSpKeeper)(nil).RecordChallengeResult), ctx, spId, passed)
+}
+
+// RecordChallengesIssued mocks base method.
+func (m *MockSpKeeper) RecordChallengesIssued(ctx types2.Context, spId uint32, count uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordChallengesIssued", ctx, spId, count)
+}
+
+// RecordChallengesIssued indicates an expected call of RecordChallengesIssued.
+func (mr *MockSpKeeperMockRecorder) RecordChallengesIssued(ctx, spId, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChallengesIssued", reflect.TypeOf((*MockSpKeeper)(nil).RecordChallengesIssued), ctx, spId, count)
+}
+
 // Slash mocks base method.
 func (m *MockSpKeeper) Slash(ctx types2.Context, spID uint32, rewardInfos []types.RewardInfo) error {
 	m.ctrl.T.Helper()
@@ -188,6 +224,21 @@ func (mr *MockStorageKeeperMockRecorder) GetBucketInfo(ctx, bucketName interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketInfo", reflect.TypeOf((*MockStorageKeeper)(nil).GetBucketInfo), ctx, bucketName)
 }
 
+// GetComposedObject mocks base method.
+func (m *MockStorageKeeper) GetComposedObject(ctx types2.Context, objectId math.Uint) (*types0.ComposedObject, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComposedObject", ctx, objectId)
+	ret0, _ := ret[0].(*types0.ComposedObject)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetComposedObject indicates an expected call of GetComposedObject.
+func (mr *MockStorageKeeperMockRecorder) GetComposedObject(ctx, objectId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComposedObject", reflect.TypeOf((*MockStorageKeeper)(nil).GetComposedObject), ctx, objectId)
+}
+
 // GetObjectGVG mocks base method.
 func (m *MockStorageKeeper) GetObjectGVG(ctx types2.Context, bucketID math.Uint, lvgID uint32) (*types1.GlobalVirtualGroup, bool) {
 	m.ctrl.T.Helper()
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"encoding/binary"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "challenge"
@@ -48,4 +56,44 @@ var (
 
 	// SlashAmountKeyPrefix is the prefix to count the amount of Slash for a sp.
 	SlashAmountKeyPrefix = []byte{0x18}
+
+	// LastChallengedKeyPrefix is the prefix to record the height a pair of sp and object info is challenged last time.
+	LastChallengedKeyPrefix = []byte{0x19}
+
+	// PendingSlashKeyPrefix is the prefix of the queue of pending slashes, which are ordered by the finalize height.
+	PendingSlashKeyPrefix = []byte{0x1A}
+
+	// PendingSlashByIdKeyPrefix is the prefix to retrieve the finalize height of a pending slash by challenge id.
+	PendingSlashByIdKeyPrefix = []byte{0x1B}
+
+	// LastChallengedQueueKeyPrefix is the prefix of the queue of last challenged records, which are ordered by height.
+	LastChallengedQueueKeyPrefix = []byte{0x1C}
 )
+
+// objectIdLength is the length of the fixed size encoding of object id in the keys.
+const objectIdLength = 32
+
+// GetLastChallengedObjectPrefix returns the prefix of the last challenged records of an object info.
+func GetLastChallengedObjectPrefix(objectId sdkmath.Uint) []byte {
+	return objectId.BigInt().FillBytes(make([]byte, objectIdLength))
+}
+
+// GetLastChallengedKey returns the key of the last challenged record of a pair of sp and object info, the records are
+// grouped by object info so that they can be removed together with the object info.
+func GetLastChallengedKey(spId uint32, objectId sdkmath.Uint) []byte {
+	spIdBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(spIdBytes, spId)
+	return append(GetLastChallengedObjectPrefix(objectId), spIdBytes...)
+}
+
+// GetLastChallengedQueueKey returns the key of a last challenged record in the queue ordered by height.
+func GetLastChallengedQueueKey(height uint64, spId uint32, objectId sdkmath.Uint) []byte {
+	return append(sdk.Uint64ToBigEndian(height), GetLastChallengedKey(spId, objectId)...)
+}
+
+// ParseLastChallengedQueueKey parses the sp id and object id from a key of the last challenged queue.
+func ParseLastChallengedQueueKey(key []byte) (spId uint32, objectId sdkmath.Uint) {
+	objectId = sdkmath.NewUintFromBigInt(new(big.Int).SetBytes(key[8 : 8+objectIdLength]))
+	spId = binary.BigEndian.Uint32(key[8+objectIdLength:])
+	return spId, objectId
+}
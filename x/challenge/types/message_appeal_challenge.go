@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAppealChallenge = "appeal_challenge"
+
+var _ sdk.Msg = &MsgAppealChallenge{}
+
+func NewMsgAppealChallenge(operator sdk.AccAddress, challengeId uint64, objectId Uint, pieceChecksum []byte,
+	spBlsSignature []byte) *MsgAppealChallenge {
+	return &MsgAppealChallenge{
+		Operator:       operator.String(),
+		ChallengeId:    challengeId,
+		ObjectId:       objectId,
+		PieceChecksum:  pieceChecksum,
+		SpBlsSignature: spBlsSignature,
+	}
+}
+
+func (msg *MsgAppealChallenge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAppealChallenge) Type() string {
+	return TypeMsgAppealChallenge
+}
+
+func (msg *MsgAppealChallenge) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgAppealChallenge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAppealChallenge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if len(msg.PieceChecksum) == 0 {
+		return errors.Wrap(ErrInvalidAppealEvidence, "piece checksum cannot be empty")
+	}
+
+	if len(msg.SpBlsSignature) != BlsSignatureLength {
+		return errors.Wrap(ErrInvalidAppealEvidence, "length of storage providers' signature is invalid")
+	}
+
+	return nil
+}
+
+// GetBlsSignBytes returns the bytes signed by the other storage providers of the object's global virtual group.
+func (msg *MsgAppealChallenge) GetBlsSignBytes(chainId string) [32]byte {
+	challengeIdBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(challengeIdBz, msg.ChallengeId)
+
+	bs := make([]byte, 0)
+	bs = append(bs, []byte(chainId)...)
+	bs = append(bs, challengeIdBz...)
+	bs = append(bs, msg.ObjectId.Bytes()...)
+	bs = append(bs, msg.PieceChecksum...)
+	return sdk.Keccak256Hash(bs)
+}
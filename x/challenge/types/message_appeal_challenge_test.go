@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+)
+
+func TestMsgAppealChallenge_ValidateBasic(t *testing.T) {
+	var sig [96]byte
+	tests := []struct {
+		name string
+		msg  MsgAppealChallenge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAppealChallenge{
+				Operator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty piece checksum",
+			msg: MsgAppealChallenge{
+				Operator:       sample.RandAccAddressHex(),
+				SpBlsSignature: sig[:],
+			},
+			err: ErrInvalidAppealEvidence,
+		}, {
+			name: "invalid signature",
+			msg: MsgAppealChallenge{
+				Operator:       sample.RandAccAddressHex(),
+				PieceChecksum:  []byte("checksum"),
+				SpBlsSignature: []byte{1, 2, 3},
+			},
+			err: ErrInvalidAppealEvidence,
+		}, {
+			name: "valid message",
+			msg: MsgAppealChallenge{
+				Operator:       sample.RandAccAddressHex(),
+				PieceChecksum:  []byte("checksum"),
+				SpBlsSignature: sig[:],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -83,6 +83,16 @@ var (
 	DefaultSpSlashCountingWindow = uint64(43200) // about one day
 )
 
+var (
+	KeyChallengeSelectionMode     = []byte("ChallengeSelectionMode")
+	DefaultChallengeSelectionMode = CHALLENGE_SELECTION_UNIFORM
+)
+
+var (
+	KeySlashAppealPeriod            = []byte("SlashAppealPeriod")
+	DefaultSlashAppealPeriod uint64 = 0
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -104,6 +114,8 @@ func NewParams(
 	attestationKeptCount uint64,
 	spSlashMaxAmount math.Int,
 	spSlashCountingWindow uint64,
+	challengeSelectionMode ChallengeSelectionMode,
+	slashAppealPeriod uint64,
 ) Params {
 	return Params{
 		ChallengeCountPerBlock:    challengeCountPerBlock,
@@ -120,6 +132,8 @@ func NewParams(
 		AttestationKeptCount:      attestationKeptCount,
 		SpSlashMaxAmount:          spSlashMaxAmount,
 		SpSlashCountingWindow:     spSlashCountingWindow,
+		ChallengeSelectionMode:    challengeSelectionMode,
+		SlashAppealPeriod:         slashAppealPeriod,
 	}
 }
 
@@ -140,6 +154,8 @@ func DefaultParams() Params {
 		DefaultAttestationKeptCount,
 		DefaultSpSlashMaxAmount,
 		DefaultSpSlashCountingWindow,
+		DefaultChallengeSelectionMode,
+		DefaultSlashAppealPeriod,
 	)
 }
 
@@ -160,6 +176,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAttestationKeptCount, &p.AttestationKeptCount, validateAttestationKeptCount),
 		paramtypes.NewParamSetPair(KeySpSlashMaxAmount, &p.SpSlashMaxAmount, validateSpSlashMaxAmount),
 		paramtypes.NewParamSetPair(KeySpSlashCountingWindow, &p.SpSlashCountingWindow, validateSpSlashCountingWindow),
+		paramtypes.NewParamSetPair(KeyChallengeSelectionMode, &p.ChallengeSelectionMode, validateChallengeSelectionMode),
+		paramtypes.NewParamSetPair(KeySlashAppealPeriod, &p.SlashAppealPeriod, validateSlashAppealPeriod),
 	}
 }
 
@@ -229,6 +247,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateChallengeSelectionMode(p.ChallengeSelectionMode); err != nil {
+		return err
+	}
+
+	if err := validateSlashAppealPeriod(p.SlashAppealPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -450,3 +476,27 @@ func validateSpSlashCountingWindow(v interface{}) error {
 
 	return nil
 }
+
+// validateChallengeSelectionMode validates the ChallengeSelectionMode param
+func validateChallengeSelectionMode(v interface{}) error {
+	mode, ok := v.(ChallengeSelectionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := ChallengeSelectionMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("unknown challenge selection mode: %d", mode)
+	}
+
+	return nil
+}
+
+// validateSlashAppealPeriod validates the SlashAppealPeriod param
+func validateSlashAppealPeriod(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -25,6 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ChallengeSelectionMode defines how the objects and storage providers are selected for the random challenges.
+type ChallengeSelectionMode int32
+
+const (
+	// The objects and storage providers are selected uniformly.
+	CHALLENGE_SELECTION_UNIFORM ChallengeSelectionMode = 0
+	// The objects and storage providers are selected by the weight of payload size and the blocks since last challenged.
+	CHALLENGE_SELECTION_WEIGHTED ChallengeSelectionMode = 1
+)
+
+var ChallengeSelectionMode_name = map[int32]string{
+	0: "CHALLENGE_SELECTION_UNIFORM",
+	1: "CHALLENGE_SELECTION_WEIGHTED",
+}
+
+var ChallengeSelectionMode_value = map[string]int32{
+	"CHALLENGE_SELECTION_UNIFORM":  0,
+	"CHALLENGE_SELECTION_WEIGHTED": 1,
+}
+
+func (x ChallengeSelectionMode) String() string {
+	return proto.EnumName(ChallengeSelectionMode_name, int32(x))
+}
+
+func (ChallengeSelectionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2396367ee53edf57, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// Challenges which will be emitted in each block, including user submitted or randomly triggered.
@@ -55,6 +83,10 @@ type Params struct {
 	SpSlashMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=sp_slash_max_amount,json=spSlashMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sp_slash_max_amount"`
 	// The number of blocks to count how much a sp had been slashed.
 	SpSlashCountingWindow uint64 `protobuf:"varint,14,opt,name=sp_slash_counting_window,json=spSlashCountingWindow,proto3" json:"sp_slash_counting_window,omitempty" yaml:"sp_slash_counting_window"`
+	// The mode to select the objects and storage providers for the random challenges.
+	ChallengeSelectionMode ChallengeSelectionMode `protobuf:"varint,15,opt,name=challenge_selection_mode,json=challengeSelectionMode,proto3,enum=greenfield.challenge.ChallengeSelectionMode" json:"challenge_selection_mode,omitempty" yaml:"challenge_selection_mode"`
+	// The number of blocks a storage provider can appeal a succeed challenge before it is slashed, zero means no appeal.
+	SlashAppealPeriod uint64 `protobuf:"varint,16,opt,name=slash_appeal_period,json=slashAppealPeriod,proto3" json:"slash_appeal_period,omitempty" yaml:"slash_appeal_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -138,58 +170,82 @@ func (m *Params) GetSpSlashCountingWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetChallengeSelectionMode() ChallengeSelectionMode {
+	if m != nil {
+		return m.ChallengeSelectionMode
+	}
+	return CHALLENGE_SELECTION_UNIFORM
+}
+
+func (m *Params) GetSlashAppealPeriod() uint64 {
+	if m != nil {
+		return m.SlashAppealPeriod
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("greenfield.challenge.ChallengeSelectionMode", ChallengeSelectionMode_name, ChallengeSelectionMode_value)
 	proto.RegisterType((*Params)(nil), "greenfield.challenge.Params")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/params.proto", fileDescriptor_2396367ee53edf57) }
 
 var fileDescriptor_2396367ee53edf57 = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0xdf, 0xe3, 0xf1, 0xca, 0xd0, 0x22, 0x6a, 0xd2, 0x68, 0x02, 0x22, 0x06, 0xb7, 0x42,
-	0x6c, 0x48, 0x54, 0x75, 0x87, 0xba, 0x21, 0x74, 0x13, 0x51, 0x44, 0x64, 0xaa, 0x22, 0x55, 0x95,
-	0xac, 0x89, 0x73, 0x13, 0x8f, 0x62, 0xcf, 0x58, 0xf6, 0x04, 0x02, 0xeb, 0xb6, 0xeb, 0x2e, 0xbb,
-	0xec, 0x47, 0xf4, 0x23, 0x58, 0xa2, 0xae, 0xaa, 0x2e, 0xac, 0x0a, 0xfe, 0x20, 0x5f, 0x50, 0x79,
-	0xc6, 0x71, 0x4c, 0x02, 0x95, 0x50, 0x59, 0x25, 0xb9, 0xe7, 0xdc, 0x7b, 0xce, 0xcd, 0xcc, 0xbd,
-	0x83, 0xd6, 0xbb, 0x21, 0x00, 0xeb, 0x50, 0xf0, 0xda, 0x35, 0xc7, 0x25, 0x9e, 0x07, 0xac, 0x0b,
-	0xb5, 0x80, 0x84, 0xc4, 0x8f, 0xaa, 0x41, 0xc8, 0x05, 0xd7, 0x8b, 0x63, 0x4a, 0x35, 0xa3, 0x2c,
-	0x97, 0x1d, 0x1e, 0xf9, 0x3c, 0xb2, 0x25, 0xa7, 0xa6, 0x7e, 0xa8, 0x84, 0xe5, 0x62, 0x97, 0x77,
-	0xb9, 0x8a, 0x27, 0xdf, 0x54, 0xd4, 0x1c, 0xce, 0xa3, 0xd9, 0xa6, 0xac, 0xab, 0xdb, 0xa8, 0x9c,
-	0x15, 0xb2, 0x1d, 0xde, 0x67, 0xc2, 0x0e, 0x20, 0xb4, 0x5b, 0x1e, 0x77, 0x7a, 0x58, 0x5b, 0xd3,
-	0x36, 0x67, 0xea, 0xcf, 0x86, 0xb1, 0xb1, 0x76, 0x4a, 0x7c, 0x6f, 0xdb, 0xbc, 0x95, 0x6a, 0x5a,
-	0xa5, 0x0c, 0xdb, 0x4d, 0xa0, 0x26, 0x84, 0xf5, 0x04, 0xd0, 0x01, 0xad, 0x8c, 0xb3, 0x7a, 0x00,
-	0x81, 0x4d, 0x3c, 0x7a, 0x0c, 0x49, 0x2a, 0xe5, 0x6d, 0xfc, 0x8f, 0x94, 0xd8, 0x18, 0xc6, 0x86,
-	0x39, 0x29, 0x31, 0x45, 0x36, 0x2d, 0x9c, 0xa1, 0x7b, 0x00, 0xc1, 0x4e, 0x82, 0x35, 0x25, 0xa4,
-	0xbf, 0x47, 0x38, 0xf2, 0x48, 0xe4, 0xda, 0x0e, 0xe7, 0x1e, 0x65, 0x5d, 0x9b, 0x77, 0x3a, 0x23,
-	0x8d, 0x7f, 0xa5, 0xc6, 0xd3, 0x61, 0x6c, 0x18, 0x4a, 0xe3, 0x36, 0xa6, 0x69, 0x3d, 0x91, 0xd0,
-	0xae, 0x42, 0x0e, 0x3a, 0x9d, 0xb4, 0xfa, 0x07, 0x0d, 0x95, 0x54, 0x12, 0xf1, 0x65, 0xe3, 0x11,
-	0x3d, 0x03, 0x3b, 0x24, 0x02, 0xf0, 0xcc, 0x9a, 0xb6, 0x39, 0x57, 0x3f, 0x38, 0x8f, 0x8d, 0xc2,
-	0xcf, 0xd8, 0xd8, 0xe8, 0x52, 0xe1, 0xf6, 0x5b, 0x55, 0x87, 0xfb, 0xe9, 0x41, 0xa4, 0x1f, 0x5b,
-	0x51, 0xbb, 0x57, 0x13, 0xa7, 0x01, 0x44, 0xd5, 0x57, 0xe0, 0x0c, 0x63, 0x63, 0x35, 0x6f, 0x65,
-	0xb2, 0xaa, 0x69, 0x2d, 0x49, 0x60, 0x47, 0xc6, 0x0f, 0xe9, 0x19, 0x58, 0x44, 0x80, 0xde, 0x41,
-	0x8b, 0xd7, 0xf8, 0x3e, 0x65, 0xf8, 0x3f, 0xa9, 0xff, 0xf2, 0x0e, 0xfa, 0x0d, 0x26, 0xbe, 0x7f,
-	0xdb, 0x42, 0xe9, 0x3d, 0x69, 0x30, 0x61, 0x2d, 0xe4, 0xc4, 0xf6, 0x29, 0x9b, 0xd6, 0x21, 0x03,
-	0x3c, 0x7b, 0xdf, 0x3a, 0x64, 0xa0, 0x7f, 0xd4, 0x50, 0x29, 0x84, 0x13, 0x12, 0xb6, 0xed, 0x63,
-	0xe2, 0xd1, 0x36, 0x11, 0x3c, 0x4c, 0xfa, 0xa7, 0x1c, 0xff, 0xff, 0x77, 0x7f, 0xeb, 0xcd, 0x55,
-	0x4d, 0xab, 0xa8, 0x80, 0xb7, 0xa3, 0xb8, 0x95, 0x84, 0xf5, 0x4f, 0x63, 0x1f, 0x51, 0xbf, 0xe5,
-	0x53, 0x21, 0x60, 0xe4, 0xe3, 0x81, 0xf4, 0xd1, 0xbc, 0xb3, 0x8f, 0xca, 0x35, 0x1f, 0xd9, 0xb5,
-	0x9d, 0x34, 0x72, 0x38, 0x92, 0x53, 0x46, 0xce, 0xd0, 0xf2, 0x94, 0x0f, 0xe1, 0x86, 0x10, 0xb9,
-	0xdc, 0x6b, 0xe3, 0xb9, 0x7b, 0x38, 0x02, 0x3c, 0xa1, 0xfb, 0x66, 0x54, 0x5d, 0x7f, 0x8d, 0x74,
-	0x17, 0x48, 0x28, 0x5a, 0x40, 0x84, 0x4d, 0x99, 0x80, 0xf0, 0x98, 0x78, 0x18, 0xc9, 0xd9, 0x59,
-	0x1d, 0xc6, 0x46, 0x59, 0x75, 0x34, 0xcd, 0x31, 0xad, 0xc7, 0x59, 0xb0, 0x91, 0xc6, 0xf4, 0x0e,
-	0x5a, 0x21, 0x42, 0x40, 0x24, 0x92, 0xbe, 0x58, 0xc2, 0xed, 0x87, 0x6c, 0x5c, 0x76, 0x7e, 0x72,
-	0xec, 0xff, 0x40, 0x36, 0xad, 0x72, 0x0e, 0x6d, 0x48, 0x30, 0xd3, 0x39, 0x42, 0xa5, 0x7c, 0x6a,
-	0x0f, 0x02, 0xa1, 0x76, 0x13, 0x7e, 0x28, 0x25, 0xd6, 0xc7, 0x77, 0xe2, 0x66, 0x9e, 0x69, 0x15,
-	0x73, 0xc0, 0x1e, 0x04, 0x42, 0xee, 0x2f, 0xbd, 0x87, 0x96, 0xa2, 0xc0, 0x56, 0x63, 0xe0, 0x93,
-	0x41, 0x3a, 0x0a, 0xf8, 0xd1, 0x3d, 0x9c, 0xc1, 0x62, 0x14, 0x1c, 0x26, 0x75, 0xf7, 0xc9, 0x40,
-	0xcd, 0x82, 0xdc, 0x5e, 0x23, 0x31, 0xe9, 0x2a, 0xd9, 0x4b, 0x27, 0x94, 0xb5, 0xf9, 0x09, 0x5e,
-	0x98, 0xda, 0x5e, 0xb7, 0x30, 0x93, 0xed, 0xa5, 0x0a, 0xef, 0xa6, 0xc0, 0x91, 0x8c, 0x6f, 0xcf,
-	0x7c, 0xf9, 0x6a, 0x14, 0xea, 0x7b, 0xe7, 0x97, 0x15, 0xed, 0xe2, 0xb2, 0xa2, 0xfd, 0xba, 0xac,
-	0x68, 0x9f, 0xaf, 0x2a, 0x85, 0x8b, 0xab, 0x4a, 0xe1, 0xc7, 0x55, 0xa5, 0xf0, 0xee, 0x79, 0xae,
-	0x8b, 0x16, 0x6b, 0x6d, 0x39, 0x2e, 0xa1, 0xac, 0x96, 0x7b, 0x8d, 0x06, 0xb9, 0xf7, 0x48, 0x36,
-	0xd5, 0x9a, 0x95, 0x0f, 0xc9, 0x8b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x44, 0x79, 0xdf,
-	0xb4, 0x06, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x21, 0x14, 0x76, 0x60, 0x4b, 0xd6, 0x0d, 0x91, 0x93, 0xb2, 0x76, 0xd6, 0xa0, 0x55,
+	0x85, 0x68, 0x22, 0xe0, 0xb6, 0xe2, 0xd2, 0x64, 0xc3, 0x6e, 0xd4, 0x7c, 0xc9, 0x29, 0x54, 0x42,
+	0x48, 0xd6, 0xc4, 0x7e, 0x93, 0x58, 0xb1, 0x3d, 0x96, 0x3d, 0x69, 0xb3, 0x3d, 0x03, 0x42, 0xe2,
+	0xc2, 0x91, 0x23, 0x12, 0x7f, 0x81, 0x1f, 0xb1, 0xc7, 0x8a, 0x13, 0xe2, 0x60, 0xa1, 0xf6, 0x1f,
+	0xe4, 0x17, 0x20, 0xcf, 0xd8, 0x89, 0xf3, 0x85, 0x54, 0xd1, 0x53, 0x9c, 0xf7, 0x79, 0xde, 0xf7,
+	0x79, 0xc6, 0x9e, 0x79, 0x06, 0x3d, 0x19, 0xf9, 0x00, 0xee, 0xd0, 0x02, 0xdb, 0xac, 0x1a, 0x63,
+	0x6c, 0xdb, 0xe0, 0x8e, 0xa0, 0xea, 0x61, 0x1f, 0x3b, 0x41, 0xc5, 0xf3, 0x09, 0x25, 0x62, 0x7e,
+	0x49, 0xa9, 0x2c, 0x28, 0xa5, 0xa2, 0x41, 0x02, 0x87, 0x04, 0x3a, 0xe3, 0x54, 0xf9, 0x1f, 0xde,
+	0x50, 0xca, 0x8f, 0xc8, 0x88, 0xf0, 0x7a, 0xf4, 0xc4, 0xab, 0xea, 0xf5, 0x43, 0xb4, 0xd7, 0x63,
+	0x73, 0x45, 0x1d, 0x15, 0x17, 0x83, 0x74, 0x83, 0x4c, 0x5d, 0xaa, 0x7b, 0xe0, 0xeb, 0x03, 0x9b,
+	0x18, 0x13, 0x49, 0x28, 0x0b, 0x47, 0xd9, 0xda, 0xc7, 0xf3, 0x50, 0x29, 0xbf, 0xc2, 0x8e, 0xfd,
+	0x4c, 0xdd, 0x49, 0x55, 0xb5, 0xc2, 0x02, 0xab, 0x47, 0x50, 0x0f, 0xfc, 0x5a, 0x04, 0x88, 0x80,
+	0x0e, 0x97, 0x5d, 0x13, 0x00, 0x4f, 0xc7, 0xb6, 0x75, 0x01, 0x51, 0xab, 0x45, 0x4c, 0xe9, 0x0d,
+	0x26, 0xf1, 0x74, 0x1e, 0x2a, 0xea, 0xba, 0xc4, 0x06, 0x59, 0xd5, 0xa4, 0x05, 0x7a, 0x0a, 0xe0,
+	0x9d, 0x44, 0x58, 0x8f, 0x41, 0xe2, 0x77, 0x48, 0x0a, 0x6c, 0x1c, 0x8c, 0x75, 0x83, 0x10, 0xdb,
+	0x72, 0x47, 0x3a, 0x19, 0x0e, 0x13, 0x8d, 0x37, 0x99, 0xc6, 0x47, 0xf3, 0x50, 0x51, 0xb8, 0xc6,
+	0x2e, 0xa6, 0xaa, 0x7d, 0xc0, 0xa0, 0x3a, 0x47, 0xba, 0xc3, 0x61, 0x3c, 0xfd, 0x7b, 0x01, 0x15,
+	0x78, 0x13, 0x76, 0xd8, 0xc2, 0x03, 0xeb, 0x0a, 0x74, 0x1f, 0x53, 0x90, 0xb2, 0x65, 0xe1, 0xe8,
+	0x41, 0xad, 0xfb, 0x3a, 0x54, 0x32, 0x7f, 0x87, 0xca, 0xd3, 0x91, 0x45, 0xc7, 0xd3, 0x41, 0xc5,
+	0x20, 0x4e, 0xfc, 0x21, 0xe2, 0x9f, 0xe3, 0xc0, 0x9c, 0x54, 0xe9, 0x2b, 0x0f, 0x82, 0xca, 0x73,
+	0x30, 0xe6, 0xa1, 0xf2, 0x38, 0x6d, 0x65, 0x7d, 0xaa, 0xaa, 0x1d, 0x30, 0xe0, 0x84, 0xd5, 0xfb,
+	0xd6, 0x15, 0x68, 0x98, 0x82, 0x38, 0x44, 0xb9, 0x15, 0xbe, 0x63, 0xb9, 0xd2, 0x5b, 0x4c, 0xff,
+	0xcb, 0x3b, 0xe8, 0x37, 0x5d, 0xfa, 0xe7, 0x1f, 0xc7, 0x28, 0xde, 0x27, 0x4d, 0x97, 0x6a, 0xfb,
+	0x29, 0xb1, 0xb6, 0xe5, 0x6e, 0xea, 0xe0, 0x99, 0xb4, 0x77, 0xdf, 0x3a, 0x78, 0x26, 0xfe, 0x20,
+	0xa0, 0x82, 0x0f, 0x97, 0xd8, 0x37, 0xf5, 0x0b, 0x6c, 0x5b, 0x26, 0xa6, 0xc4, 0x8f, 0xd6, 0x6f,
+	0x11, 0xe9, 0xed, 0xff, 0xf7, 0x5a, 0xb7, 0x4f, 0x55, 0xb5, 0x3c, 0x07, 0xbe, 0x49, 0xea, 0x5a,
+	0x54, 0x16, 0x7f, 0x5c, 0xfa, 0x08, 0xa6, 0x03, 0xc7, 0xa2, 0x14, 0x12, 0x1f, 0xef, 0x30, 0x1f,
+	0xbd, 0x3b, 0xfb, 0x90, 0x57, 0x7c, 0x2c, 0xb6, 0xed, 0xba, 0x91, 0x7e, 0x22, 0xc7, 0x8d, 0x5c,
+	0xa1, 0xd2, 0x86, 0x0f, 0x3a, 0xf6, 0x21, 0x18, 0x13, 0xdb, 0x94, 0x1e, 0xdc, 0xc3, 0x27, 0x90,
+	0xd6, 0x74, 0xcf, 0x92, 0xe9, 0x62, 0x0b, 0x89, 0x63, 0xc0, 0x3e, 0x1d, 0x00, 0xa6, 0xba, 0xe5,
+	0x52, 0xf0, 0x2f, 0xb0, 0x2d, 0x21, 0x76, 0x76, 0x1e, 0xcf, 0x43, 0xa5, 0xc8, 0x57, 0xb4, 0xc9,
+	0x51, 0xb5, 0x47, 0x8b, 0x62, 0x33, 0xae, 0x89, 0x43, 0x74, 0x88, 0x29, 0x85, 0x80, 0x46, 0xeb,
+	0x72, 0x23, 0xee, 0xd4, 0x77, 0x97, 0x63, 0xdf, 0x5d, 0x3f, 0xf6, 0xff, 0x41, 0x56, 0xb5, 0x62,
+	0x0a, 0x6d, 0x32, 0x70, 0xa1, 0x73, 0x8e, 0x0a, 0xe9, 0xd6, 0x09, 0x78, 0x94, 0x67, 0x93, 0xf4,
+	0x1e, 0x93, 0x78, 0xb2, 0xdc, 0x13, 0xdb, 0x79, 0xaa, 0x96, 0x4f, 0x01, 0xa7, 0xe0, 0x51, 0x96,
+	0x5f, 0xe2, 0x04, 0x1d, 0x04, 0x9e, 0xce, 0x8f, 0x81, 0x83, 0x67, 0xf1, 0x51, 0x90, 0x1e, 0xde,
+	0xc3, 0x37, 0xc8, 0x05, 0x5e, 0x3f, 0x9a, 0xdb, 0xc6, 0x33, 0x7e, 0x16, 0x58, 0x7a, 0x25, 0x62,
+	0xcc, 0x55, 0x94, 0x4b, 0x97, 0x96, 0x6b, 0x92, 0x4b, 0x69, 0x7f, 0x23, 0xbd, 0x76, 0x30, 0xa3,
+	0xf4, 0xe2, 0x83, 0xeb, 0x31, 0x70, 0xce, 0xea, 0xe2, 0xcf, 0x02, 0x5a, 0x06, 0xa7, 0x1e, 0x80,
+	0x0d, 0x06, 0x7b, 0x09, 0x0e, 0x31, 0x41, 0x7a, 0xbf, 0x2c, 0x1c, 0xed, 0x7f, 0xfe, 0x69, 0x65,
+	0xdb, 0xcd, 0x52, 0xa9, 0x27, 0x4f, 0xfd, 0xa4, 0xa9, 0x4d, 0x4c, 0x48, 0x9b, 0xd9, 0x35, 0x37,
+	0x7d, 0x21, 0xac, 0x34, 0x8b, 0x1d, 0x74, 0x10, 0x87, 0x8b, 0xe7, 0x01, 0xb6, 0x93, 0x90, 0xce,
+	0xb1, 0x65, 0xca, 0xf3, 0x50, 0x29, 0xad, 0x24, 0x63, 0x9a, 0xa4, 0x6a, 0x8f, 0x78, 0x82, 0xb0,
+	0x22, 0xcf, 0xe6, 0x67, 0xd9, 0x5f, 0x7f, 0x53, 0x32, 0x9f, 0x60, 0x54, 0xd8, 0x6e, 0x56, 0x54,
+	0xd0, 0x61, 0xfd, 0xe5, 0x49, 0xab, 0xd5, 0xe8, 0xbc, 0x68, 0xe8, 0xfd, 0x46, 0xab, 0x51, 0x3f,
+	0x6b, 0x76, 0x3b, 0xfa, 0xd7, 0x9d, 0xe6, 0x57, 0x5d, 0xad, 0x9d, 0xcb, 0x88, 0x65, 0xf4, 0xe1,
+	0x36, 0xc2, 0x79, 0xa3, 0xf9, 0xe2, 0xe5, 0x59, 0xe3, 0x79, 0x4e, 0x28, 0x65, 0x7f, 0xfa, 0x5d,
+	0xce, 0xd4, 0x4e, 0x5f, 0xdf, 0xc8, 0xc2, 0xf5, 0x8d, 0x2c, 0xfc, 0x73, 0x23, 0x0b, 0xbf, 0xdc,
+	0xca, 0x99, 0xeb, 0x5b, 0x39, 0xf3, 0xd7, 0xad, 0x9c, 0xf9, 0xf6, 0xb3, 0xd4, 0x36, 0x18, 0xb8,
+	0x83, 0x63, 0x63, 0x8c, 0x2d, 0xb7, 0x9a, 0xba, 0xce, 0x67, 0xa9, 0x0b, 0x9d, 0xed, 0x8a, 0xc1,
+	0x1e, 0xbb, 0x89, 0xbf, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x41, 0x25, 0x95, 0xbc, 0xf5, 0x07,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -212,6 +268,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashAppealPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashAppealPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ChallengeSelectionMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeSelectionMode))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.SpSlashCountingWindow != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SpSlashCountingWindow))
 		i--
@@ -372,6 +440,12 @@ func (m *Params) Size() (n int) {
 	if m.SpSlashCountingWindow != 0 {
 		n += 1 + sovParams(uint64(m.SpSlashCountingWindow))
 	}
+	if m.ChallengeSelectionMode != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeSelectionMode))
+	}
+	if m.SlashAppealPeriod != 0 {
+		n += 2 + sovParams(uint64(m.SlashAppealPeriod))
+	}
 	return n
 }
 
@@ -781,6 +855,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSelectionMode", wireType)
+			}
+			m.ChallengeSelectionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeSelectionMode |= ChallengeSelectionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAppealPeriod", wireType)
+			}
+			m.SlashAppealPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashAppealPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
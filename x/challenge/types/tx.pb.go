@@ -307,6 +307,119 @@ func (m *MsgAttestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAttestResponse proto.InternalMessageInfo
 
+// MsgAppealChallenge defines the message for a storage provider to appeal a pending slash.
+type MsgAppealChallenge struct {
+	// The operator address of the challenged storage provider.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The id of the object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The fresh hash of the challenged piece, it should be the same as the one in the object's checksums.
+	PieceChecksum []byte `protobuf:"bytes,4,opt,name=piece_checksum,json=pieceChecksum,proto3" json:"piece_checksum,omitempty"`
+	// The aggregated BLS signature of the other storage providers of the object's global virtual group, which
+	// confirms they retrieved the piece from the storage provider and got the piece checksum.
+	SpBlsSignature []byte `protobuf:"bytes,5,opt,name=sp_bls_signature,json=spBlsSignature,proto3" json:"sp_bls_signature,omitempty"`
+}
+
+func (m *MsgAppealChallenge) Reset()         { *m = MsgAppealChallenge{} }
+func (m *MsgAppealChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealChallenge) ProtoMessage()    {}
+func (*MsgAppealChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{4}
+}
+func (m *MsgAppealChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealChallenge.Merge(m, src)
+}
+func (m *MsgAppealChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealChallenge proto.InternalMessageInfo
+
+func (m *MsgAppealChallenge) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgAppealChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *MsgAppealChallenge) GetPieceChecksum() []byte {
+	if m != nil {
+		return m.PieceChecksum
+	}
+	return nil
+}
+
+func (m *MsgAppealChallenge) GetSpBlsSignature() []byte {
+	if m != nil {
+		return m.SpBlsSignature
+	}
+	return nil
+}
+
+// MsgAppealChallengeResponse defines the response of MsgAppealChallenge.
+type MsgAppealChallengeResponse struct {
+}
+
+func (m *MsgAppealChallengeResponse) Reset()         { *m = MsgAppealChallengeResponse{} }
+func (m *MsgAppealChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealChallengeResponse) ProtoMessage()    {}
+func (*MsgAppealChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{5}
+}
+func (m *MsgAppealChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealChallengeResponse.Merge(m, src)
+}
+func (m *MsgAppealChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealChallengeResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -320,7 +433,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{4}
+	return fileDescriptor_516ed0ec90010e48, []int{6}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -371,7 +484,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{5}
+	return fileDescriptor_516ed0ec90010e48, []int{7}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,6 +518,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitResponse)(nil), "greenfield.challenge.MsgSubmitResponse")
 	proto.RegisterType((*MsgAttest)(nil), "greenfield.challenge.MsgAttest")
 	proto.RegisterType((*MsgAttestResponse)(nil), "greenfield.challenge.MsgAttestResponse")
+	proto.RegisterType((*MsgAppealChallenge)(nil), "greenfield.challenge.MsgAppealChallenge")
+	proto.RegisterType((*MsgAppealChallengeResponse)(nil), "greenfield.challenge.MsgAppealChallengeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.challenge.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.challenge.MsgUpdateParamsResponse")
 }
@@ -412,51 +527,57 @@ func init() {
 func init() { proto.RegisterFile("greenfield/challenge/tx.proto", fileDescriptor_516ed0ec90010e48) }
 
 var fileDescriptor_516ed0ec90010e48 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x4f, 0xdb, 0x3c,
-	0x18, 0xc7, 0x9b, 0xb6, 0x6f, 0x5f, 0xea, 0x16, 0x78, 0x31, 0x48, 0x84, 0xbe, 0x5b, 0x1b, 0x3a,
-	0x4d, 0xab, 0xa6, 0xb5, 0x11, 0x4c, 0x42, 0x88, 0x5b, 0x7b, 0x1a, 0x9a, 0xd8, 0xa6, 0x54, 0x70,
-	0xd8, 0x25, 0x72, 0x1a, 0xcf, 0xcd, 0xd6, 0xc4, 0x51, 0xec, 0x56, 0x70, 0xdd, 0x27, 0x98, 0x34,
-	0xed, 0x4b, 0xec, 0x30, 0xed, 0xc0, 0x87, 0xe0, 0x88, 0x38, 0x4d, 0x3b, 0xa0, 0x09, 0x0e, 0xfb,
-	0x1a, 0x53, 0x6c, 0x27, 0x2d, 0x10, 0x06, 0xa7, 0xc6, 0xcf, 0xf3, 0xf3, 0xf3, 0x7f, 0xfa, 0x7f,
-	0x6c, 0x83, 0x87, 0x24, 0xc2, 0x38, 0x78, 0xe7, 0xe1, 0x91, 0x6b, 0x0e, 0x86, 0x68, 0x34, 0xc2,
-	0x01, 0xc1, 0x26, 0x3f, 0xec, 0x84, 0x11, 0xe5, 0x14, 0xae, 0x4c, 0xd3, 0x9d, 0x34, 0x5d, 0x5b,
-	0x1d, 0x50, 0xe6, 0x53, 0x66, 0xfa, 0x8c, 0x98, 0x93, 0x8d, 0xf8, 0x47, 0xe2, 0xb5, 0x35, 0x99,
-	0xb0, 0xc5, 0xca, 0x94, 0x0b, 0x95, 0x5a, 0x21, 0x94, 0x50, 0x19, 0x8f, 0xbf, 0x54, 0x74, 0x3d,
-	0x53, 0x3e, 0x44, 0x11, 0xf2, 0x93, 0x8d, 0x46, 0x76, 0x87, 0x47, 0x21, 0x56, 0x44, 0xf3, 0x6b,
-	0x1e, 0x94, 0xf7, 0x18, 0xe9, 0x8f, 0x1d, 0xdf, 0xe3, 0x70, 0x1b, 0x80, 0x14, 0x8b, 0x74, 0xcd,
-	0xd0, 0x5a, 0xe5, 0x9e, 0x7e, 0x76, 0xdc, 0x5e, 0x51, 0xed, 0x74, 0x5d, 0x37, 0xc2, 0x8c, 0xf5,
-	0x79, 0xe4, 0x05, 0xc4, 0x9a, 0x61, 0xe1, 0x0b, 0xb0, 0xcc, 0x42, 0x9b, 0x86, 0x38, 0x42, 0x9c,
-	0x46, 0x36, 0x92, 0xa0, 0x9e, 0xbf, 0xa3, 0xc4, 0x12, 0x0b, 0x5f, 0xab, 0x3d, 0x2a, 0x01, 0x1b,
-	0xa0, 0xe2, 0x8c, 0x07, 0x1f, 0x30, 0xb7, 0x03, 0xe4, 0x63, 0xbd, 0x10, 0x57, 0xb0, 0x80, 0x0c,
-	0xbd, 0x42, 0x3e, 0x8e, 0x01, 0xea, 0xbc, 0xc7, 0x03, 0x05, 0x14, 0x25, 0x20, 0x43, 0x02, 0x78,
-	0x04, 0xe6, 0x19, 0x26, 0x3e, 0x0e, 0xb8, 0xed, 0x05, 0x2e, 0x3e, 0xd4, 0xff, 0x31, 0xb4, 0xd6,
-	0xbc, 0x55, 0x55, 0xc1, 0xdd, 0x38, 0x06, 0xd7, 0x41, 0x35, 0x42, 0x81, 0x4b, 0x7d, 0xc5, 0x94,
-	0x0c, 0xad, 0x35, 0x67, 0x55, 0x64, 0x4c, 0x20, 0x3b, 0x8b, 0x1f, 0x7f, 0x7f, 0x7f, 0x3a, 0xf3,
-	0x27, 0x9b, 0x5b, 0x60, 0x29, 0xf5, 0xca, 0xc2, 0x2c, 0xa4, 0x01, 0xc3, 0x71, 0xa1, 0x14, 0xb1,
-	0x3d, 0x57, 0xb8, 0x56, 0xb4, 0x2a, 0x69, 0x6c, 0xd7, 0x6d, 0x7e, 0x2b, 0x08, 0x93, 0xbb, 0x9c,
-	0x63, 0xc6, 0xe1, 0x16, 0x28, 0x33, 0x51, 0x82, 0xdf, 0xc3, 0xe3, 0x29, 0x7a, 0x43, 0x28, 0x7f,
-	0x43, 0x08, 0x6e, 0x83, 0xb2, 0xb2, 0xc6, 0x73, 0xa5, 0x73, 0xbd, 0xff, 0x4f, 0xce, 0x1b, 0xb9,
-	0x9f, 0xe7, 0x8d, 0xe2, 0xbe, 0x17, 0xf0, 0xb3, 0xe3, 0x76, 0x45, 0xc9, 0xc4, 0x4b, 0x6b, 0x4e,
-	0xd2, 0xbb, 0x2e, 0xec, 0x64, 0xcf, 0x4f, 0x9a, 0x9b, 0x31, 0xa5, 0x2e, 0xa8, 0x4c, 0x28, 0xc7,
-	0x76, 0x84, 0xd9, 0x78, 0xc4, 0x85, 0xc3, 0x0b, 0x9b, 0x46, 0x27, 0xeb, 0xc8, 0x77, 0x0e, 0x28,
-	0xc7, 0x96, 0xe0, 0x2c, 0x30, 0x49, 0xbf, 0x61, 0x1b, 0xc0, 0xa9, 0xb7, 0xa9, 0x62, 0x49, 0x2a,
-	0x4e, 0x33, 0x89, 0xe2, 0x33, 0x00, 0x85, 0xe2, 0x04, 0x8d, 0x3c, 0x57, 0x34, 0xc9, 0x30, 0xd7,
-	0xff, 0x35, 0x0a, 0xad, 0x92, 0xf5, 0x5f, 0x9c, 0x39, 0x48, 0x12, 0x7d, 0xcc, 0x53, 0x1a, 0x11,
-	0x62, 0x33, 0x8f, 0x04, 0x88, 0x8f, 0x23, 0xac, 0xcf, 0x19, 0x5a, 0xab, 0x2a, 0xe9, 0x2e, 0x21,
-	0xfd, 0x24, 0xbe, 0xb3, 0x10, 0x4f, 0x7a, 0x6a, 0x75, 0x73, 0x59, 0x0c, 0x5a, 0xce, 0x2b, 0x19,
-	0x74, 0xf3, 0x8b, 0x06, 0x16, 0xf7, 0x18, 0xd9, 0x0f, 0x5d, 0xc4, 0xf1, 0x1b, 0x71, 0xcd, 0xe2,
-	0x59, 0xa2, 0x31, 0x1f, 0xd2, 0xc8, 0xe3, 0x47, 0x77, 0xcf, 0x32, 0x45, 0xe1, 0x0e, 0x28, 0xc9,
-	0x8b, 0x2a, 0xa6, 0x58, 0xd9, 0x7c, 0x90, 0xed, 0x9c, 0x54, 0xe9, 0x15, 0xe3, 0x19, 0x5a, 0x6a,
-	0x87, 0x6a, 0x36, 0xad, 0xd5, 0x5c, 0x03, 0xab, 0xd7, 0xda, 0x4a, 0x5a, 0xde, 0xfc, 0x9c, 0x07,
-	0x85, 0x3d, 0x46, 0xa0, 0x05, 0x4a, 0xea, 0x86, 0x37, 0xb2, 0x85, 0xd2, 0x63, 0x5d, 0x7b, 0x72,
-	0x07, 0x90, 0x9e, 0x7b, 0x0b, 0x94, 0xd4, 0x81, 0xbe, 0xbd, 0xa6, 0x04, 0xfe, 0x52, 0xf3, 0xaa,
-	0xc5, 0xd0, 0x05, 0xd5, 0x2b, 0xf6, 0x3e, 0xbe, 0x75, 0xe3, 0x2c, 0x56, 0x6b, 0xdf, 0x0b, 0x4b,
-	0x54, 0x7a, 0x2f, 0x4f, 0x2e, 0xea, 0xda, 0xe9, 0x45, 0x5d, 0xfb, 0x75, 0x51, 0xd7, 0x3e, 0x5d,
-	0xd6, 0x73, 0xa7, 0x97, 0xf5, 0xdc, 0x8f, 0xcb, 0x7a, 0xee, 0xed, 0x06, 0xf1, 0xf8, 0x70, 0xec,
-	0x74, 0x06, 0xd4, 0x37, 0x9d, 0xc0, 0x69, 0x0f, 0x86, 0xc8, 0x0b, 0xcc, 0x99, 0x47, 0xf4, 0xf0,
-	0xfa, 0x33, 0xea, 0x94, 0xc4, 0x3b, 0xfa, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x8b,
-	0xeb, 0x8a, 0x0d, 0x06, 0x00, 0x00,
+	// 793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x3d, 0x4f, 0x1b, 0x49,
+	0x18, 0xc7, 0xbd, 0xb6, 0xf1, 0xd9, 0xe3, 0x17, 0x60, 0x40, 0xc2, 0xf8, 0x38, 0xdb, 0xf8, 0x84,
+	0xce, 0x3a, 0x9d, 0xed, 0x83, 0x3b, 0x21, 0x44, 0x67, 0xd3, 0x04, 0x45, 0x24, 0xd1, 0x5a, 0x50,
+	0xa4, 0x59, 0xed, 0xcb, 0x64, 0xbc, 0x61, 0xdf, 0xb4, 0x33, 0xb6, 0xa0, 0xa5, 0x49, 0x9b, 0x26,
+	0x5f, 0x22, 0x45, 0x94, 0x82, 0x0f, 0x41, 0x89, 0xa8, 0xa2, 0x14, 0x28, 0x82, 0x22, 0x5f, 0x23,
+	0xda, 0x99, 0xd9, 0xb1, 0x01, 0x13, 0xd3, 0xa4, 0xf2, 0xee, 0xf3, 0xfc, 0xe6, 0xf9, 0x3f, 0xfe,
+	0x3f, 0xb3, 0x33, 0xe0, 0x0f, 0x1c, 0x22, 0xe4, 0xbd, 0xb1, 0x91, 0x63, 0x75, 0xcc, 0x81, 0xee,
+	0x38, 0xc8, 0xc3, 0xa8, 0x43, 0x4f, 0xda, 0x41, 0xe8, 0x53, 0x1f, 0x2e, 0x8f, 0xd3, 0x6d, 0x99,
+	0xae, 0xac, 0x98, 0x3e, 0x71, 0x7d, 0xd2, 0x71, 0x09, 0xee, 0x8c, 0x36, 0xa3, 0x1f, 0x8e, 0x57,
+	0x56, 0x79, 0x42, 0x63, 0x6f, 0x1d, 0xfe, 0x22, 0x52, 0xcb, 0xd8, 0xc7, 0x3e, 0x8f, 0x47, 0x4f,
+	0x22, 0xba, 0x3e, 0x55, 0x3e, 0xd0, 0x43, 0xdd, 0x8d, 0x17, 0xd6, 0xa7, 0x77, 0x78, 0x1a, 0x20,
+	0x41, 0x34, 0x3e, 0x26, 0x41, 0xee, 0x80, 0xe0, 0xfe, 0xd0, 0x70, 0x6d, 0x0a, 0x77, 0x00, 0x90,
+	0x58, 0x58, 0x56, 0xea, 0x4a, 0x33, 0xd7, 0x2b, 0x5f, 0x9d, 0xb7, 0x96, 0x45, 0x3b, 0x5d, 0xcb,
+	0x0a, 0x11, 0x21, 0x7d, 0x1a, 0xda, 0x1e, 0x56, 0x27, 0x58, 0xf8, 0x0c, 0x2c, 0x91, 0x40, 0xf3,
+	0x03, 0x14, 0xea, 0xd4, 0x0f, 0x35, 0x9d, 0x83, 0xe5, 0xe4, 0x8c, 0x12, 0x8b, 0x24, 0x78, 0x29,
+	0xd6, 0x88, 0x04, 0xac, 0x81, 0xbc, 0x31, 0x34, 0x8f, 0x11, 0xd5, 0x3c, 0xdd, 0x45, 0xe5, 0x54,
+	0x54, 0x41, 0x05, 0x3c, 0xf4, 0x42, 0x77, 0x51, 0x04, 0xf8, 0xc6, 0x5b, 0x64, 0x0a, 0x20, 0xcd,
+	0x01, 0x1e, 0x62, 0xc0, 0x9f, 0xa0, 0x48, 0x10, 0x76, 0x91, 0x47, 0x35, 0xdb, 0xb3, 0xd0, 0x49,
+	0x79, 0xae, 0xae, 0x34, 0x8b, 0x6a, 0x41, 0x04, 0xf7, 0xa3, 0x18, 0x5c, 0x07, 0x85, 0x50, 0xf7,
+	0x2c, 0xdf, 0x15, 0x4c, 0xa6, 0xae, 0x34, 0xb3, 0x6a, 0x9e, 0xc7, 0x18, 0xb2, 0x3b, 0x7f, 0xf6,
+	0xfd, 0xf3, 0xdf, 0x13, 0x7f, 0xb2, 0xb1, 0x0d, 0x16, 0xa5, 0x57, 0x2a, 0x22, 0x81, 0xef, 0x11,
+	0x14, 0x15, 0x92, 0x88, 0x66, 0x5b, 0xcc, 0xb5, 0xb4, 0x9a, 0x97, 0xb1, 0x7d, 0xab, 0xf1, 0x29,
+	0xc5, 0x4c, 0xee, 0x52, 0x8a, 0x08, 0x85, 0xdb, 0x20, 0x47, 0x58, 0x09, 0xfa, 0x04, 0x8f, 0xc7,
+	0xe8, 0x03, 0xa1, 0xe4, 0x03, 0x21, 0xb8, 0x03, 0x72, 0xc2, 0x1a, 0xdb, 0xe2, 0xce, 0xf5, 0x7e,
+	0xbf, 0xb8, 0xae, 0x25, 0xbe, 0x5e, 0xd7, 0xd2, 0x87, 0xb6, 0x47, 0xaf, 0xce, 0x5b, 0x79, 0x21,
+	0x13, 0xbd, 0xaa, 0x59, 0x4e, 0xef, 0x5b, 0xb0, 0x3d, 0x7d, 0x7e, 0xdc, 0xdc, 0x29, 0x53, 0xea,
+	0x82, 0xfc, 0xc8, 0xa7, 0x48, 0x0b, 0x11, 0x19, 0x3a, 0x94, 0x39, 0x5c, 0xda, 0xaa, 0xb7, 0xa7,
+	0x6d, 0xf9, 0xf6, 0x91, 0x4f, 0x91, 0xca, 0x38, 0x15, 0x8c, 0xe4, 0x33, 0x6c, 0x01, 0x38, 0xf6,
+	0x56, 0x2a, 0x66, 0xb8, 0xe2, 0x38, 0x13, 0x2b, 0xfe, 0x03, 0x20, 0x53, 0x1c, 0xe9, 0x8e, 0x6d,
+	0xb1, 0x26, 0x09, 0xa2, 0xe5, 0xdf, 0xea, 0xa9, 0x66, 0x46, 0x5d, 0x88, 0x32, 0x47, 0x71, 0xa2,
+	0x8f, 0xa8, 0xa4, 0x75, 0x8c, 0x35, 0x62, 0x63, 0x4f, 0xa7, 0xc3, 0x10, 0x95, 0xb3, 0x75, 0xa5,
+	0x59, 0xe0, 0x74, 0x17, 0xe3, 0x7e, 0x1c, 0xdf, 0x2d, 0x45, 0x93, 0x1e, 0x5b, 0xdd, 0x58, 0x62,
+	0x83, 0xe6, 0xf3, 0x8a, 0x07, 0xdd, 0x78, 0x97, 0x04, 0x30, 0x8a, 0x06, 0x01, 0xd2, 0x9d, 0xbd,
+	0xb8, 0x3f, 0xf8, 0x3f, 0xc8, 0xc6, 0xb6, 0xcd, 0x9c, 0xa6, 0x24, 0x7f, 0xed, 0x30, 0x37, 0x40,
+	0x29, 0xb0, 0x91, 0x89, 0x34, 0x73, 0x80, 0xcc, 0x63, 0x32, 0x74, 0xd9, 0x1c, 0x0b, 0x6a, 0x91,
+	0x45, 0xf7, 0x44, 0x10, 0x36, 0xc1, 0x02, 0x09, 0x34, 0xc3, 0x21, 0x13, 0x0e, 0xcd, 0x31, 0xb0,
+	0x44, 0x82, 0x9e, 0x43, 0xc6, 0xfe, 0x14, 0x23, 0x7f, 0x64, 0xf3, 0x8d, 0x35, 0x50, 0x79, 0x68,
+	0x84, 0xf4, 0xe9, 0x83, 0x02, 0xe6, 0x0f, 0x08, 0x3e, 0x0c, 0x2c, 0x9d, 0xa2, 0x57, 0xec, 0x38,
+	0x8a, 0xf6, 0xbc, 0x3e, 0xa4, 0x03, 0x3f, 0xb4, 0xe9, 0xe9, 0xec, 0x3d, 0x2f, 0x51, 0xb8, 0x0b,
+	0x32, 0xfc, 0x40, 0x63, 0x06, 0xe5, 0xb7, 0xd6, 0xa6, 0xef, 0x30, 0xae, 0xd2, 0x4b, 0x47, 0xf6,
+	0xa8, 0x62, 0x85, 0x18, 0xaa, 0xac, 0xd5, 0x58, 0x05, 0x2b, 0xf7, 0xda, 0x8a, 0x5b, 0xde, 0x3a,
+	0x4b, 0x81, 0xd4, 0x01, 0xc1, 0x50, 0x05, 0x19, 0x71, 0x12, 0xd6, 0xa6, 0x0b, 0xc9, 0xcf, 0xbf,
+	0xf2, 0xd7, 0x0c, 0x40, 0x9e, 0x0f, 0x2a, 0xc8, 0x88, 0x0f, 0xff, 0xf1, 0x9a, 0x1c, 0xf8, 0x49,
+	0xcd, 0xbb, 0x5b, 0x11, 0xba, 0x60, 0xfe, 0xfe, 0x36, 0x6c, 0x3e, 0xbe, 0xf6, 0x2e, 0x59, 0xf9,
+	0xf7, 0xa9, 0xa4, 0x94, 0xb3, 0x40, 0xe1, 0xce, 0x34, 0x37, 0x1e, 0xad, 0x30, 0x89, 0x55, 0x5a,
+	0x4f, 0xc2, 0x62, 0x95, 0xde, 0xf3, 0x8b, 0x9b, 0xaa, 0x72, 0x79, 0x53, 0x55, 0xbe, 0xdd, 0x54,
+	0x95, 0xf7, 0xb7, 0xd5, 0xc4, 0xe5, 0x6d, 0x35, 0xf1, 0xe5, 0xb6, 0x9a, 0x78, 0xbd, 0x89, 0x6d,
+	0x3a, 0x18, 0x1a, 0x6d, 0xd3, 0x77, 0x3b, 0x86, 0x67, 0xb4, 0xcc, 0x81, 0x6e, 0x7b, 0x9d, 0x89,
+	0xbb, 0xed, 0xe4, 0xfe, 0xed, 0x66, 0x64, 0xd8, 0xf5, 0xf6, 0xdf, 0x8f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x54, 0xbe, 0xa3, 0x06, 0xa4, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -473,6 +594,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Submit(ctx context.Context, in *MsgSubmit, opts ...grpc.CallOption) (*MsgSubmitResponse, error)
 	Attest(ctx context.Context, in *MsgAttest, opts ...grpc.CallOption) (*MsgAttestResponse, error)
+	AppealChallenge(ctx context.Context, in *MsgAppealChallenge, opts ...grpc.CallOption) (*MsgAppealChallengeResponse, error)
 	// UpdateParams defines a governance operation for updating the x/challenge module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -506,6 +628,15 @@ func (c *msgClient) Attest(ctx context.Context, in *MsgAttest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) AppealChallenge(ctx context.Context, in *MsgAppealChallenge, opts ...grpc.CallOption) (*MsgAppealChallengeResponse, error) {
+	out := new(MsgAppealChallengeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/AppealChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/UpdateParams", in, out, opts...)
@@ -519,6 +650,7 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	Submit(context.Context, *MsgSubmit) (*MsgSubmitResponse, error)
 	Attest(context.Context, *MsgAttest) (*MsgAttestResponse, error)
+	AppealChallenge(context.Context, *MsgAppealChallenge) (*MsgAppealChallengeResponse, error)
 	// UpdateParams defines a governance operation for updating the x/challenge module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -536,6 +668,9 @@ func (*UnimplementedMsgServer) Submit(ctx context.Context, req *MsgSubmit) (*Msg
 func (*UnimplementedMsgServer) Attest(ctx context.Context, req *MsgAttest) (*MsgAttestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Attest not implemented")
 }
+func (*UnimplementedMsgServer) AppealChallenge(ctx context.Context, req *MsgAppealChallenge) (*MsgAppealChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealChallenge not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -580,6 +715,24 @@ func _Msg_Attest_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AppealChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Msg/AppealChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealChallenge(ctx, req.(*MsgAppealChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -610,6 +763,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Attest",
 			Handler:    _Msg_Attest_Handler,
 		},
+		{
+			MethodName: "AppealChallenge",
+			Handler:    _Msg_AppealChallenge_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -816,6 +973,88 @@ func (m *MsgAttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAppealChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpBlsSignature) > 0 {
+		i -= len(m.SpBlsSignature)
+		copy(dAtA[i:], m.SpBlsSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpBlsSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PieceChecksum) > 0 {
+		i -= len(m.PieceChecksum)
+		copy(dAtA[i:], m.PieceChecksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PieceChecksum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -978,6 +1217,41 @@ func (m *MsgAttestResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAppealChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PieceChecksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpBlsSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1648,6 +1922,259 @@ func (m *MsgAttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAppealChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PieceChecksum = append(m.PieceChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.PieceChecksum == nil {
+				m.PieceChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpBlsSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpBlsSignature = append(m.SpBlsSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpBlsSignature == nil {
+				m.SpBlsSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppealChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,3 +14,10 @@ const RedundancyIndexPrimary = int32(-1)
 
 // BlsSignatureLength defines the length of bls signature
 const BlsSignatureLength = 96
+
+// WeightedChallengeCandidates defines how many candidates are drawn for a challenge in the weighted selection mode
+const WeightedChallengeCandidates = 4
+
+// WeightedChallengeAgeCap defines the max number of blocks since last challenged counted in the weight of a candidate,
+// it is about 10 days, the pairs never challenged or challenged earlier are weighted the same.
+const WeightedChallengeAgeCap = uint64(432000)
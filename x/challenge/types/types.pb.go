@@ -115,6 +115,8 @@ type Challenge struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The height at which the challenge will be expired.
 	ExpiredHeight uint64 `protobuf:"varint,2,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty"`
+	// The index of the challenged component when the object is a composed object.
+	ComponentIndex uint32 `protobuf:"varint,3,opt,name=component_index,json=componentIndex,proto3" json:"component_index,omitempty"`
 }
 
 func (m *Challenge) Reset()         { *m = Challenge{} }
@@ -164,6 +166,13 @@ func (m *Challenge) GetExpiredHeight() uint64 {
 	return 0
 }
 
+func (m *Challenge) GetComponentIndex() uint32 {
+	if m != nil {
+		return m.ComponentIndex
+	}
+	return 0
+}
+
 // AttestedChallenge records the challenge which are attested.
 type AttestedChallenge struct {
 	// The id of the challenge.
@@ -284,46 +293,183 @@ func (m *AttestedChallengeIds) GetCursor() int64 {
 	return 0
 }
 
+// PendingSlash records a slash of succeed challenge which is waiting for the storage provider to appeal.
+type PendingSlash struct {
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider to be slashed.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The redundancy index of the challenged piece, which comes from the index of storage providers.
+	RedundancyIndex int32 `protobuf:"varint,4,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The amount to be slashed from the storage provider.
+	SlashAmount Int `protobuf:"bytes,5,opt,name=slash_amount,json=slashAmount,proto3,customtype=Int" json:"slash_amount"`
+	// The submitter of the challenge attestation.
+	Submitter string `protobuf:"bytes,6,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The challenger who submits the challenge, which can be empty.
+	ChallengerAddress string `protobuf:"bytes,7,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// The challenger addresses of the validators participated in the attestation.
+	Validators []string `protobuf:"bytes,8,rep,name=validators,proto3" json:"validators,omitempty"`
+	// The height at which the slash will be executed if it is not appealed.
+	FinalizeHeight uint64 `protobuf:"varint,9,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
+	// The height at which the challenge is attested, the slash amount is counted for the storage provider at the height.
+	AttestHeight uint64 `protobuf:"varint,10,opt,name=attest_height,json=attestHeight,proto3" json:"attest_height,omitempty"`
+	// The index of the challenged component when the object is a composed object.
+	ComponentIndex uint32 `protobuf:"varint,11,opt,name=component_index,json=componentIndex,proto3" json:"component_index,omitempty"`
+}
+
+func (m *PendingSlash) Reset()         { *m = PendingSlash{} }
+func (m *PendingSlash) String() string { return proto.CompactTextString(m) }
+func (*PendingSlash) ProtoMessage()    {}
+func (*PendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{4}
+}
+func (m *PendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSlash.Merge(m, src)
+}
+func (m *PendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSlash proto.InternalMessageInfo
+
+func (m *PendingSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *PendingSlash) GetFinalizeHeight() uint64 {
+	if m != nil {
+		return m.FinalizeHeight
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetAttestHeight() uint64 {
+	if m != nil {
+		return m.AttestHeight
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetComponentIndex() uint32 {
+	if m != nil {
+		return m.ComponentIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("greenfield.challenge.VoteResult", VoteResult_name, VoteResult_value)
 	proto.RegisterType((*Slash)(nil), "greenfield.challenge.Slash")
 	proto.RegisterType((*Challenge)(nil), "greenfield.challenge.Challenge")
 	proto.RegisterType((*AttestedChallenge)(nil), "greenfield.challenge.AttestedChallenge")
 	proto.RegisterType((*AttestedChallengeIds)(nil), "greenfield.challenge.AttestedChallengeIds")
+	proto.RegisterType((*PendingSlash)(nil), "greenfield.challenge.PendingSlash")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/types.proto", fileDescriptor_9c297f0764c47d40) }
 
 var fileDescriptor_9c297f0764c47d40 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xd5, 0xca, 0x8a, 0xa9, 0x27, 0xc4, 0x38, 0x5b, 0xb7, 0xb8, 0x2e, 0x28, 0xc2, 0x50, 0x6a,
-	0x0a, 0x91, 0x68, 0x7a, 0xc9, 0xad, 0xd8, 0x8e, 0x9a, 0x98, 0x9a, 0x1e, 0x36, 0xa4, 0x87, 0x42,
-	0x11, 0x96, 0x76, 0x2b, 0x6d, 0x51, 0xb4, 0x42, 0xbb, 0x86, 0xb4, 0x5f, 0x50, 0xe8, 0xa5, 0xff,
-	0xd0, 0x5f, 0xe8, 0x47, 0xe4, 0x18, 0x7a, 0x2a, 0x3d, 0x84, 0x62, 0xff, 0x48, 0x91, 0xb4, 0x8d,
-	0x43, 0x93, 0xdc, 0xe6, 0xbd, 0x79, 0xf3, 0xde, 0x30, 0x0c, 0x38, 0x71, 0xc1, 0x58, 0xf6, 0x81,
-	0xb3, 0x94, 0x7a, 0x51, 0x32, 0x4f, 0x53, 0x96, 0xc5, 0xcc, 0x53, 0x9f, 0x72, 0x26, 0xdd, 0xbc,
-	0x10, 0x4a, 0xe0, 0xee, 0x5a, 0xe1, 0x5e, 0x29, 0xfa, 0x8f, 0x22, 0x21, 0x4f, 0x85, 0x0c, 0x2a,
-	0x8d, 0x57, 0x83, 0x7a, 0xa0, 0xdf, 0x8d, 0x45, 0x2c, 0x6a, 0xbe, 0xac, 0x6a, 0x76, 0x90, 0xc1,
-	0xc6, 0x71, 0x3a, 0x97, 0x09, 0xbe, 0x0f, 0x1b, 0x32, 0x0f, 0x38, 0xed, 0x21, 0x07, 0x0d, 0xb7,
-	0x88, 0x25, 0xf3, 0x29, 0xc5, 0xfb, 0xd0, 0x12, 0xe1, 0x47, 0x16, 0xa9, 0xb2, 0x61, 0x3a, 0x68,
-	0xd8, 0x1a, 0x3f, 0x3e, 0xbf, 0xdc, 0x31, 0x7e, 0x5f, 0xee, 0x58, 0x27, 0x3c, 0x53, 0x3f, 0x7f,
-	0xec, 0x6e, 0xea, 0x90, 0x12, 0x92, 0x7b, 0xb5, 0x7a, 0x4a, 0xf1, 0x43, 0x68, 0x26, 0x8c, 0xc7,
-	0x89, 0xea, 0x35, 0x1c, 0x34, 0xb4, 0x88, 0x46, 0x83, 0x31, 0xb4, 0x26, 0xff, 0xb6, 0xc5, 0x6d,
-	0x30, 0x75, 0xa0, 0x45, 0x4c, 0x4e, 0xf1, 0x13, 0x68, 0xb3, 0xb3, 0x9c, 0x17, 0x8c, 0x06, 0x7a,
-	0xd8, 0xac, 0x7a, 0x5b, 0x9a, 0x3d, 0xaa, 0x3d, 0xde, 0xc3, 0xf6, 0x48, 0x29, 0x26, 0x15, 0xa3,
-	0x77, 0x7b, 0xed, 0x43, 0xb3, 0x60, 0x72, 0x91, 0xd6, 0x1e, 0xed, 0x3d, 0xc7, 0xbd, 0xed, 0x60,
-	0xee, 0x5b, 0xa1, 0x18, 0xa9, 0x74, 0x44, 0xeb, 0x07, 0x5f, 0x11, 0x74, 0x6f, 0xf8, 0x4f, 0xa9,
-	0xc4, 0x18, 0x2c, 0xc9, 0x3f, 0x33, 0x1d, 0x52, 0xd5, 0xf8, 0x10, 0xe0, 0xca, 0x4c, 0xf6, 0x4c,
-	0xa7, 0x31, 0xdc, 0xdc, 0x7b, 0x7a, 0x7b, 0xd4, 0x0d, 0x4f, 0x72, 0x6d, 0xb4, 0x3c, 0x58, 0xb4,
-	0x28, 0xa4, 0x28, 0xaa, 0x83, 0x35, 0x88, 0x46, 0xcf, 0x5e, 0x02, 0xac, 0x77, 0xc4, 0x5d, 0xe8,
-	0x4c, 0x8e, 0x46, 0xb3, 0x99, 0xff, 0xe6, 0xd0, 0x0f, 0x5e, 0x8d, 0xa6, 0x33, 0xff, 0xa0, 0x63,
-	0xe0, 0x07, 0xb0, 0xbd, 0x66, 0x8f, 0x4f, 0x26, 0x13, 0xdf, 0x3f, 0xe8, 0xa0, 0xbe, 0xf5, 0xe5,
-	0xbb, 0x6d, 0x8c, 0x5f, 0x9f, 0x2f, 0x6d, 0x74, 0xb1, 0xb4, 0xd1, 0x9f, 0xa5, 0x8d, 0xbe, 0xad,
-	0x6c, 0xe3, 0x62, 0x65, 0x1b, 0xbf, 0x56, 0xb6, 0xf1, 0xee, 0x79, 0xcc, 0x55, 0xb2, 0x08, 0xdd,
-	0x48, 0x9c, 0x7a, 0x61, 0x16, 0xee, 0x46, 0xc9, 0x9c, 0x67, 0xde, 0xb5, 0xcf, 0x3b, 0xfb, 0xff,
-	0xf7, 0xc2, 0x66, 0xf5, 0x35, 0x2f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x68, 0xaf, 0x39, 0x21,
-	0xa0, 0x02, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6a, 0x1b, 0x3b,
+	0x14, 0xc7, 0x3d, 0xfe, 0xba, 0xf1, 0xf1, 0x47, 0x1c, 0x5d, 0xdf, 0xcb, 0x24, 0x85, 0xc9, 0xd4,
+	0xa5, 0xc4, 0x2d, 0xd8, 0xa6, 0x29, 0x94, 0x2c, 0x0a, 0xc5, 0x71, 0xdc, 0xc4, 0x34, 0x94, 0x32,
+	0x21, 0x5d, 0x14, 0xca, 0x30, 0x1e, 0x29, 0x63, 0xb5, 0xb6, 0x64, 0x46, 0x72, 0x49, 0xf2, 0x04,
+	0x85, 0x6e, 0xfa, 0x0e, 0x7d, 0x85, 0x3c, 0x44, 0x96, 0x21, 0xab, 0xd2, 0x45, 0x28, 0xc9, 0xa2,
+	0xaf, 0x51, 0x46, 0x23, 0x7b, 0x42, 0x93, 0x2c, 0xba, 0xd3, 0xf9, 0xeb, 0x77, 0xce, 0x5f, 0xd2,
+	0x39, 0x08, 0xec, 0x20, 0x24, 0x84, 0x1d, 0x50, 0x32, 0xc2, 0x6d, 0x7f, 0xe8, 0x8d, 0x46, 0x84,
+	0x05, 0xa4, 0x2d, 0x8f, 0x26, 0x44, 0xb4, 0x26, 0x21, 0x97, 0x1c, 0xd5, 0x12, 0xa2, 0x35, 0x27,
+	0x56, 0x96, 0x7d, 0x2e, 0xc6, 0x5c, 0xb8, 0x8a, 0x69, 0xc7, 0x41, 0x9c, 0xb0, 0x52, 0x0b, 0x78,
+	0xc0, 0x63, 0x3d, 0x5a, 0xc5, 0x6a, 0x9d, 0x41, 0x6e, 0x6f, 0xe4, 0x89, 0x21, 0xfa, 0x17, 0x72,
+	0x62, 0xe2, 0x52, 0x6c, 0x1a, 0xb6, 0xd1, 0x28, 0x3b, 0x59, 0x31, 0xe9, 0x63, 0xb4, 0x01, 0x05,
+	0x3e, 0xf8, 0x40, 0x7c, 0x19, 0x6d, 0xa4, 0x6d, 0xa3, 0x51, 0xd8, 0xbc, 0x77, 0x7a, 0xb1, 0x9a,
+	0xfa, 0x71, 0xb1, 0x9a, 0xdd, 0xa7, 0x4c, 0x9e, 0x9f, 0x34, 0x8b, 0xda, 0x24, 0x0a, 0x9d, 0x85,
+	0x98, 0xee, 0x63, 0xf4, 0x3f, 0xe4, 0x87, 0x84, 0x06, 0x43, 0x69, 0x66, 0x6c, 0xa3, 0x91, 0x75,
+	0x74, 0x54, 0xff, 0x08, 0x85, 0xee, 0xec, 0xb4, 0xa8, 0x02, 0x69, 0x6d, 0x98, 0x75, 0xd2, 0x14,
+	0xa3, 0x87, 0x50, 0x21, 0x87, 0x13, 0x1a, 0x12, 0xec, 0xea, 0xe4, 0xb4, 0xda, 0x2b, 0x6b, 0x75,
+	0x47, 0x89, 0x68, 0x0d, 0x16, 0x7d, 0x3e, 0x9e, 0x70, 0x46, 0x98, 0x74, 0x29, 0xc3, 0xe4, 0x50,
+	0x99, 0x94, 0x9d, 0xca, 0x5c, 0xee, 0x47, 0x6a, 0xfd, 0x3d, 0x2c, 0x75, 0xa4, 0x24, 0x42, 0x12,
+	0x7c, 0xb7, 0xe9, 0x06, 0xe4, 0x43, 0x22, 0xa6, 0xa3, 0xd8, 0xac, 0xb2, 0x6e, 0xb7, 0x6e, 0x7b,
+	0xd9, 0xd6, 0x5b, 0x2e, 0x89, 0xa3, 0x38, 0x47, 0xf3, 0xf5, 0x2f, 0x06, 0xd4, 0x6e, 0xd4, 0xef,
+	0x63, 0x81, 0x10, 0x64, 0x05, 0x3d, 0x26, 0xda, 0x44, 0xad, 0xd1, 0x36, 0xc0, 0xbc, 0x98, 0x30,
+	0xd3, 0x76, 0xa6, 0x51, 0x5c, 0x5f, 0xbb, 0xdd, 0xea, 0x46, 0x4d, 0xe7, 0x5a, 0x6a, 0xf4, 0xb2,
+	0xfe, 0x34, 0x14, 0x3c, 0x54, 0x97, 0xce, 0x38, 0x3a, 0xaa, 0xff, 0xca, 0x40, 0xe9, 0x0d, 0x61,
+	0x98, 0xb2, 0x20, 0xee, 0xe8, 0x7d, 0x28, 0xcd, 0xd3, 0xdc, 0xf9, 0x95, 0x8b, 0x7e, 0x72, 0xd2,
+	0xa4, 0xe9, 0xe9, 0xbb, 0x9a, 0x9e, 0xf9, 0x9b, 0xa6, 0x3f, 0x82, 0x6a, 0x48, 0xf0, 0x94, 0x61,
+	0x8f, 0xf9, 0x47, 0xba, 0x33, 0x59, 0xdb, 0x68, 0xe4, 0x9c, 0xc5, 0x44, 0x57, 0xad, 0x41, 0xcf,
+	0xa1, 0x24, 0xa2, 0x53, 0xba, 0xde, 0x98, 0x4f, 0x99, 0x34, 0x73, 0xca, 0x67, 0x59, 0xfb, 0x64,
+	0xfa, 0xca, 0x06, 0xb4, 0x4d, 0x9f, 0x49, 0xa7, 0xa8, 0xf0, 0x8e, 0xa2, 0xd1, 0x33, 0x28, 0x88,
+	0xe9, 0x60, 0x4c, 0xa5, 0x24, 0xa1, 0x99, 0x57, 0xa9, 0xe6, 0xf9, 0x49, 0xb3, 0xa6, 0xf9, 0x0e,
+	0xc6, 0x21, 0x11, 0x62, 0x4f, 0x86, 0x94, 0x05, 0x4e, 0x82, 0xa2, 0x26, 0xa0, 0xf9, 0xf5, 0x43,
+	0xd7, 0x8b, 0x31, 0xf3, 0x9f, 0xa8, 0x80, 0xb3, 0x94, 0xec, 0xe8, 0x7c, 0x64, 0x01, 0x7c, 0xf2,
+	0x46, 0x14, 0x7b, 0x92, 0x87, 0xc2, 0x5c, 0xb0, 0x33, 0x8d, 0x82, 0x73, 0x4d, 0x89, 0x06, 0xf1,
+	0x80, 0x32, 0x6f, 0x44, 0x8f, 0xc9, 0x6c, 0x60, 0x0b, 0xea, 0x91, 0x2b, 0x33, 0x59, 0x4f, 0xec,
+	0x03, 0x28, 0x7b, 0xaa, 0xa9, 0x33, 0x0c, 0x14, 0x56, 0x8a, 0xc5, 0xbb, 0xc7, 0xba, 0x78, 0xdb,
+	0x58, 0x3f, 0x7e, 0x01, 0x90, 0x4c, 0x23, 0xaa, 0x41, 0xb5, 0xbb, 0xd3, 0xd9, 0xdd, 0xed, 0xbd,
+	0xde, 0xee, 0xb9, 0x2f, 0x3b, 0xfd, 0xdd, 0xde, 0x56, 0x35, 0x85, 0xfe, 0x83, 0xa5, 0x44, 0xdd,
+	0xdb, 0xef, 0x76, 0x7b, 0xbd, 0xad, 0xaa, 0xb1, 0x92, 0xfd, 0xfc, 0xcd, 0x4a, 0x6d, 0xbe, 0x3a,
+	0xbd, 0xb4, 0x8c, 0xb3, 0x4b, 0xcb, 0xf8, 0x79, 0x69, 0x19, 0x5f, 0xaf, 0xac, 0xd4, 0xd9, 0x95,
+	0x95, 0xfa, 0x7e, 0x65, 0xa5, 0xde, 0x3d, 0x09, 0xa8, 0x1c, 0x4e, 0x07, 0x2d, 0x9f, 0x8f, 0xdb,
+	0x03, 0x36, 0x68, 0xfa, 0x43, 0x8f, 0xb2, 0xf6, 0xb5, 0xcf, 0xe8, 0xf0, 0xcf, 0xef, 0x68, 0x90,
+	0x57, 0x1f, 0xc9, 0xd3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0xbf, 0x02, 0x94, 0xb3, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Slash) Marshal() (dAtA []byte, err error) {
@@ -389,6 +535,11 @@ func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ComponentIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ComponentIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ExpiredHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiredHeight))
 		i--
@@ -482,6 +633,102 @@ func (m *AttestedChallengeIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ComponentIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ComponentIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AttestHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AttestHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FinalizeHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FinalizeHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -522,6 +769,9 @@ func (m *Challenge) Size() (n int) {
 	if m.ExpiredHeight != 0 {
 		n += 1 + sovTypes(uint64(m.ExpiredHeight))
 	}
+	if m.ComponentIndex != 0 {
+		n += 1 + sovTypes(uint64(m.ComponentIndex))
+	}
 	return n
 }
 
@@ -561,6 +811,51 @@ func (m *AttestedChallengeIds) Size() (n int) {
 	return n
 }
 
+func (m *PendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.FinalizeHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FinalizeHeight))
+	}
+	if m.AttestHeight != 0 {
+		n += 1 + sovTypes(uint64(m.AttestHeight))
+	}
+	if m.ComponentIndex != 0 {
+		n += 1 + sovTypes(uint64(m.ComponentIndex))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -756,6 +1051,25 @@ func (m *Challenge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentIndex", wireType)
+			}
+			m.ComponentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComponentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -987,6 +1301,334 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+			}
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeHeight", wireType)
+			}
+			m.FinalizeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestHeight", wireType)
+			}
+			m.AttestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentIndex", wireType)
+			}
+			m.ComponentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComponentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
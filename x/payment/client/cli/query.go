@@ -30,6 +30,14 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdDynamicBalance())
 	cmd.AddCommand(CmdGetPaymentAccountsByOwner())
 	cmd.AddCommand(CmdListAutoSettleRecord())
+	cmd.AddCommand(CmdProjectedFreezeTime())
+	cmd.AddCommand(CmdAutoTopUp())
+	cmd.AddCommand(CmdSpendingBudget())
+	cmd.AddCommand(CmdBillingStatement())
+	cmd.AddCommand(CmdDelayedWithdrawals())
+	cmd.AddCommand(CmdReadVoucher())
+	cmd.AddCommand(CmdReadVouchersByHolder())
+	cmd.AddCommand(CmdMultisigProposals())
 
 	return cmd
 }
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"encoding/csv"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+const (
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+	FlagCSV       = "csv"
+)
+
+func CmdBillingStatement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statement [addr]",
+		Short: "Query the billing statement of a payment account for a time range",
+		Long: `Query the charges of the buckets paid by a payment account for a time range, broken down by bucket,
+receiver and read quota versus storage. The statement is printed as CSV with the --csv flag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddr := args[0]
+			startTime, _ := cmd.Flags().GetInt64(FlagStartTime)
+			endTime, _ := cmd.Flags().GetInt64(FlagEndTime)
+			asCSV, _ := cmd.Flags().GetBool(FlagCSV)
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBillingStatementRequest{
+				Addr:       reqAddr,
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BillingStatement(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			if !asCSV {
+				return clientCtx.PrintProto(res)
+			}
+			// the CSV statement covers all the buckets from the page on, the end time is fixed by the first page
+			params.EndTime = res.EndTime
+			for res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+				params.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: pageReq.Limit}
+				next, err := queryClient.BillingStatement(cmd.Context(), params)
+				if err != nil {
+					return err
+				}
+				res.Items = append(res.Items, next.Items...)
+				res.Total = res.Total.Add(next.Total)
+				res.Pagination = next.Pagination
+			}
+			return writeBillingStatementCSV(cmd, res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp the statement starts from, inclusive")
+	cmd.Flags().Int64(FlagEndTime, 0, "The unix timestamp the statement ends at, exclusive, 0 means the latest block time")
+	cmd.Flags().Bool(FlagCSV, false, "Print the statement as CSV")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func writeBillingStatementCSV(cmd *cobra.Command, res *types.QueryBillingStatementResponse) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+	records := [][]string{{"start_time", "end_time", "bucket_id", "to_address", "recipient", "category", "amount"}}
+	for _, item := range res.Items {
+		records = append(records, []string{
+			strconv.FormatInt(res.StartTime, 10),
+			strconv.FormatInt(res.EndTime, 10),
+			item.BucketId.String(),
+			item.ToAddress,
+			item.Recipient.String(),
+			item.Category.String(),
+			item.Amount.String(),
+		})
+	}
+	return w.WriteAll(records)
+}
@@ -129,6 +129,18 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryGetStreamRecordResponse{},
 		},
+		{
+			"query statement",
+			append(
+				[]string{
+					"statement",
+					sample.RandAccAddressHex(),
+					fmt.Sprintf("--%s=%d", cli.FlagStartTime, 1),
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryBillingStatementResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -34,6 +34,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDeposit())
 	cmd.AddCommand(CmdWithdraw())
 	cmd.AddCommand(CmdDisableRefund())
+	cmd.AddCommand(CmdSetBalanceAlert())
+	cmd.AddCommand(CmdEnableAutoTopUp())
+	cmd.AddCommand(CmdDisableAutoTopUp())
+	cmd.AddCommand(CmdSetSpendingBudget())
+	cmd.AddCommand(CmdTransferPaymentAccountOwnership())
+	cmd.AddCommand(CmdAcceptPaymentAccountOwnership())
+	cmd.AddCommand(CmdCancelDelayedWithdrawal())
+	cmd.AddCommand(CmdTransferReadVoucher())
+	cmd.AddCommand(CmdCreateMultisigPaymentAccount())
+	cmd.AddCommand(CmdProposeMultisigAction())
+	cmd.AddCommand(CmdApproveMultisigProposal())
 
 	return cmd
 }
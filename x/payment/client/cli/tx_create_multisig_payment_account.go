@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func CmdCreateMultisigPaymentAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-multisig-payment-account [owners] [threshold]",
+		Short: "Create a payment account controlled by the comma separated owners",
+		Long: `Create a payment account controlled by the comma separated owners. Withdrawing, disabling refund
+and binding buckets to the account take effect once a proposal is approved by threshold owners.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwners := strings.Split(args[0], ",")
+			argThreshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMultisigPaymentAccount(
+				clientCtx.GetFromAddress().String(),
+				argOwners,
+				uint32(argThreshold),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func CmdEnableAutoTopUp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-auto-top-up [addr] [source] [cap-per-period] [period] [min-runway]",
+		Short: "Top up the payment account from the source once its runway falls under the min runway in seconds",
+		Long: `Top up the payment account from the source once its runway falls under the min runway in seconds,
+at most cap-per-period in every period of seconds. The source is either the owner or another payment account of the owner.
+Topping up from the owner requires a grant of /greenfield.payment.MsgDeposit from the owner to the payment module account.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddr := args[0]
+			argSource := args[1]
+			argCapPerPeriod, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid cap per period: %s", args[2])
+			}
+			argPeriod, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argMinRunway, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableAutoTopUp(
+				clientCtx.GetFromAddress().String(),
+				argAddr,
+				argSource,
+				argCapPerPeriod,
+				argPeriod,
+				argMinRunway,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
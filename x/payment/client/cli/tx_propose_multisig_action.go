@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+const (
+	FlagTo      = "to"
+	FlagAmount  = "amount"
+	FlagBinder  = "binder"
+	FlagAllowed = "allowed"
+)
+
+func CmdProposeMultisigAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-multisig-action [payment-account] [withdraw|disable-refund|set-bucket-binder]",
+		Short: "Propose an action on a multisig payment account",
+		Long: `Propose an action on a multisig payment account, the proposal is approved by the proposer.
+A withdraw needs --to and --amount, a set-bucket-binder needs --binder and --allowed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPaymentAccount := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposer := clientCtx.GetFromAddress().String()
+
+			var msg *types.MsgProposeMultisigAction
+			switch args[1] {
+			case "withdraw":
+				to, _ := cmd.Flags().GetString(FlagTo)
+				amountStr, _ := cmd.Flags().GetString(FlagAmount)
+				amount, ok := sdkmath.NewIntFromString(amountStr)
+				if !ok {
+					return fmt.Errorf("invalid amount %s", amountStr)
+				}
+				msg = types.NewMsgProposeMultisigWithdraw(proposer, argPaymentAccount, to, amount)
+			case "disable-refund":
+				msg = types.NewMsgProposeMultisigDisableRefund(proposer, argPaymentAccount)
+			case "set-bucket-binder":
+				binder, _ := cmd.Flags().GetString(FlagBinder)
+				allowed, _ := cmd.Flags().GetBool(FlagAllowed)
+				msg = types.NewMsgProposeMultisigSetBucketBinder(proposer, argPaymentAccount, binder, allowed)
+			default:
+				return fmt.Errorf("unknown action %s", args[1])
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTo, "", "The receiver of a withdraw")
+	cmd.Flags().String(FlagAmount, "", "The amount of a withdraw")
+	cmd.Flags().String(FlagBinder, "", "The account allowed or disallowed to bind buckets to the payment account")
+	cmd.Flags().Bool(FlagAllowed, true, "Whether the binder is allowed to bind buckets to the payment account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
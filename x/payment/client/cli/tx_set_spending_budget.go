@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+const FlagPeriod = "period"
+
+func CmdSetSpendingBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-spending-budget [addr] [max-outflow-rate] [max-spend-per-period]",
+		Short: "Cap the total outflow rate and the cumulative spend per calendar period of the payment account, 0 means unlimited",
+		Long: `Cap the total outflow rate and the cumulative spend per calendar period of the payment account, 0 means unlimited.
+The spending budget is removed if both caps are 0. The flow rate limits of the buckets paid by the payment account are
+capped by the max outflow rate.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddr := args[0]
+			argMaxOutflowRate, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max outflow rate: %s", args[1])
+			}
+			argMaxSpendPerPeriod, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max spend per period: %s", args[2])
+			}
+			period, _ := cmd.Flags().GetString(FlagPeriod)
+			periodValue, ok := types.SpendingPeriod_value["SPENDING_PERIOD_"+strings.ToUpper(period)]
+			if !ok {
+				return fmt.Errorf("invalid period: %s", period)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSpendingBudget(
+				clientCtx.GetFromAddress().String(),
+				argAddr,
+				argMaxOutflowRate,
+				argMaxSpendPerPeriod,
+				types.SpendingPeriod(periodValue),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPeriod, "monthly", "The calendar period of the max spend, monthly or daily")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
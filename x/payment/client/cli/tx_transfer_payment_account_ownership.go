@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func CmdTransferPaymentAccountOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-payment-account-ownership [addr] [new-owner]",
+		Short: "Transfer the payment account to the new owner once accepted, omit the new owner to cancel the pending transfer",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddr := args[0]
+			argNewOwner := ""
+			if len(args) > 1 {
+				argNewOwner = args[1]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferPaymentAccountOwnership(
+				clientCtx.GetFromAddress().String(),
+				argAddr,
+				argNewOwner,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,11 +15,15 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FlagDelayedWithdrawalId = "delayed-withdrawal-id"
+
 func CmdWithdraw() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw [from] [amount]",
 		Short: "Broadcast message withdraw",
-		Args:  cobra.ExactArgs(2),
+		Long: `Withdraw from a stream account. Use an empty from to withdraw a delayed withdrawal once it is unlocked,
+the delayed withdrawal is chosen by --delayed-withdrawal-id, or the earliest one by default.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argFrom := args[0]
 			argAmount, ok := sdkmath.NewIntFromString(args[1])
@@ -37,6 +41,7 @@ func CmdWithdraw() *cobra.Command {
 				argFrom,
 				argAmount,
 			)
+			msg.DelayedWithdrawalId, _ = cmd.Flags().GetUint64(FlagDelayedWithdrawalId)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -44,6 +49,7 @@ func CmdWithdraw() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagDelayedWithdrawalId, 0, "The id of the delayed withdrawal to withdraw when from is empty")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1,6 +1,8 @@
 package payment
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bnb-chain/greenfield/x/payment/keeper"
@@ -25,6 +27,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.AutoSettleRecordList {
 		k.SetAutoSettleRecord(ctx, &elem)
 	}
+	// Set all the paymentAccountTransfer
+	for _, elem := range genState.PaymentAccountTransferList {
+		k.SetPaymentAccountTransfer(ctx, sdk.MustAccAddressFromHex(elem.Addr), sdk.MustAccAddressFromHex(elem.NewOwner))
+	}
+	// Index all the transferred paymentAccount by their owners
+	for _, elem := range genState.TransferredPaymentAccountList {
+		addr := sdk.MustAccAddressFromHex(elem)
+		paymentAccount, found := k.GetPaymentAccount(ctx, addr)
+		if !found {
+			panic(fmt.Sprintf("transferred payment account %s not found", elem))
+		}
+		k.SetPaymentAccountByOwner(ctx, sdk.MustAccAddressFromHex(paymentAccount.Owner), addr)
+	}
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
 		panic(err)
@@ -40,6 +55,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PaymentAccountCountList = k.GetAllPaymentAccountCount(ctx)
 	genesis.PaymentAccountList = k.GetAllPaymentAccount(ctx)
 	genesis.AutoSettleRecordList = k.GetAllAutoSettleRecord(ctx)
+	genesis.PaymentAccountTransferList = k.GetAllPaymentAccountTransfer(ctx)
+	for _, addr := range k.GetAllTransferredPaymentAccount(ctx) {
+		genesis.TransferredPaymentAccountList = append(genesis.TransferredPaymentAccountList, addr.String())
+	}
 
 	return genesis
 }
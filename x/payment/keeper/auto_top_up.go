@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdauthz "github.com/bnb-chain/greenfield/internal/authz"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// SetAutoTopUp set a specific autoTopUp in the store from its index
+func (k Keeper) SetAutoTopUp(ctx sdk.Context, autoTopUp *types.AutoTopUp) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoTopUpKeyPrefix)
+	key := types.AutoTopUpKey(
+		sdk.MustAccAddressFromHex(autoTopUp.Addr),
+	)
+
+	addr := autoTopUp.Addr
+	autoTopUp.Addr = ""
+	store.Set(key, k.cdc.MustMarshal(autoTopUp))
+
+	autoTopUp.Addr = addr
+}
+
+// GetAutoTopUp returns a autoTopUp from its index
+func (k Keeper) GetAutoTopUp(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) (*types.AutoTopUp, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoTopUpKeyPrefix)
+
+	b := store.Get(types.AutoTopUpKey(
+		addr,
+	))
+	if b == nil {
+		return nil, false
+	}
+
+	autoTopUp := &types.AutoTopUp{}
+	k.cdc.MustUnmarshal(b, autoTopUp)
+	autoTopUp.Addr = addr.String()
+	return autoTopUp, true
+}
+
+// RemoveAutoTopUp removes a autoTopUp and its pending top-up from the store
+func (k Keeper) RemoveAutoTopUp(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) {
+	autoTopUp, found := k.GetAutoTopUp(ctx, addr)
+	if !found {
+		return
+	}
+	if autoTopUp.TopUpTimestamp != 0 {
+		k.removeAutoTopUpRecord(ctx, autoTopUp.TopUpTimestamp, addr)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoTopUpKeyPrefix)
+	store.Delete(types.AutoTopUpKey(
+		addr,
+	))
+}
+
+func (k Keeper) setAutoTopUpRecord(ctx sdk.Context, timestamp int64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoTopUpRecordKeyPrefix)
+	store.Set(types.AutoTopUpRecordKey(timestamp, addr), []byte{0x00})
+}
+
+func (k Keeper) removeAutoTopUpRecord(ctx sdk.Context, timestamp int64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoTopUpRecordKeyPrefix)
+	store.Delete(types.AutoTopUpRecordKey(timestamp, addr))
+}
+
+// UpdateAutoTopUp reschedules the top-up of the payment account, if any, by the settle timestamp of the
+// payment account. The top-up is scheduled at the settle timestamp minus the min runway, and a frozen payment
+// account is topped up at once to resume it.
+func (k Keeper) UpdateAutoTopUp(ctx sdk.Context, streamRecord *types.StreamRecord) {
+	addr := sdk.MustAccAddressFromHex(streamRecord.Account)
+	autoTopUp, found := k.GetAutoTopUp(ctx, addr)
+	if !found {
+		return
+	}
+
+	var topUpTimestamp int64 = 0
+	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+		topUpTimestamp = ctx.BlockTime().Unix()
+	} else if streamRecord.SettleTimestamp != 0 {
+		topUpTimestamp = streamRecord.SettleTimestamp - int64(autoTopUp.MinRunway)
+		if topUpTimestamp <= 0 {
+			topUpTimestamp = 1
+		}
+	}
+	if topUpTimestamp == autoTopUp.TopUpTimestamp {
+		return
+	}
+
+	if autoTopUp.TopUpTimestamp != 0 {
+		k.removeAutoTopUpRecord(ctx, autoTopUp.TopUpTimestamp, addr)
+	}
+	if topUpTimestamp != 0 {
+		k.setAutoTopUpRecord(ctx, topUpTimestamp, addr)
+	}
+	autoTopUp.TopUpTimestamp = topUpTimestamp
+	k.SetAutoTopUp(ctx, autoTopUp)
+}
+
+// TopUpPaymentAccounts tops up the payment accounts whose runway falls under the min runway of their auto top-ups.
+// A failed top-up is not retried until the payment account is updated again.
+func (k Keeper) TopUpPaymentAccounts(ctx sdk.Context) {
+	currentTimestamp := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoTopUpRecordKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	max := k.GetParams(ctx).MaxAutoSettleFlowCount
+	var toTopUp []sdk.AccAddress
+	var timestamps []int64
+	for ; iterator.Valid() && uint64(len(toTopUp)) < max; iterator.Next() {
+		timestamp, addr := types.ParseAutoTopUpRecordKey(iterator.Key())
+		if timestamp > currentTimestamp {
+			break
+		}
+		toTopUp = append(toTopUp, addr)
+		timestamps = append(timestamps, timestamp)
+	}
+
+	for i, addr := range toTopUp {
+		k.removeAutoTopUpRecord(ctx, timestamps[i], addr)
+
+		// the top-up is reverted as a whole if it fails
+		cacheCtx, write := ctx.CacheContext()
+		err := k.topUp(cacheCtx, addr)
+		if err != nil {
+			ctx.Logger().Info("auto top-up failed", "address", addr.String(), "err", err.Error())
+			continue
+		}
+		write()
+	}
+}
+
+// topUp tops up the payment account from its auto top-up source, to bring the runway back to twice the min runway.
+// A frozen payment account is resumed by the top-up if possible.
+func (k Keeper) topUp(ctx sdk.Context, addr sdk.AccAddress) error {
+	autoTopUp, found := k.GetAutoTopUp(ctx, addr)
+	if !found {
+		return types.ErrAutoTopUpNotFound
+	}
+	paymentAccount, found := k.GetPaymentAccount(ctx, addr)
+	if !found {
+		return types.ErrPaymentAccountNotFound
+	}
+	streamRecord, found := k.GetStreamRecord(ctx, addr)
+	if !found {
+		return types.ErrStreamRecordNotFound
+	}
+
+	params := k.GetParams(ctx)
+	currentTimestamp := ctx.BlockTime().Unix()
+	targetRunway := int64(autoTopUp.MinRunway) * 2
+	amount := sdkmath.ZeroInt()
+	switch streamRecord.Status {
+	case types.STREAM_ACCOUNT_STATUS_ACTIVE:
+		if !streamRecord.NetflowRate.IsNegative() {
+			return nil
+		}
+		runway := streamRecord.SettleTimestamp - currentTimestamp
+		if runway < 0 {
+			runway = 0
+		}
+		amount = streamRecord.NetflowRate.Abs().MulRaw(targetRunway - runway)
+	case types.STREAM_ACCOUNT_STATUS_FROZEN:
+		totalRate := streamRecord.NetflowRate.Add(streamRecord.FrozenNetflowRate)
+		if !totalRate.IsNegative() {
+			return nil
+		}
+		// the balance to resume the payment account is locked as the buffer balance, and the payment account is
+		// frozen again when the runway falls under the forced settle time
+		duration := int64(params.VersionedParams.ReserveTime) + int64(params.ForcedSettleTime) + targetRunway
+		amount = totalRate.Abs().MulRaw(duration).Sub(streamRecord.StaticBalance)
+	default:
+		return types.ErrInvalidStreamAccountStatus
+	}
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	// the cap is reset at the beginning of each period
+	if currentTimestamp >= autoTopUp.PeriodStart+int64(autoTopUp.Period) {
+		autoTopUp.PeriodStart = currentTimestamp
+		autoTopUp.ToppedUp = sdkmath.ZeroInt()
+	}
+	amount = sdkmath.MinInt(amount, autoTopUp.CapPerPeriod.Sub(autoTopUp.ToppedUp))
+	if !amount.IsPositive() {
+		return fmt.Errorf("the cap per period %s is reached", autoTopUp.CapPerPeriod)
+	}
+	autoTopUp.ToppedUp = autoTopUp.ToppedUp.Add(amount)
+	k.SetAutoTopUp(ctx, autoTopUp)
+
+	source := sdk.MustAccAddressFromHex(autoTopUp.Source)
+	if sourceAccount, found := k.GetPaymentAccount(ctx, source); found {
+		if sourceAccount.Owner != paymentAccount.Owner {
+			return types.ErrInvalidAutoTopUpSource.Wrapf("the source %s is not owned by %s", autoTopUp.Source, paymentAccount.Owner)
+		}
+		sourceRecord, found := k.GetStreamRecord(ctx, source)
+		if !found || sourceRecord.Status != types.STREAM_ACCOUNT_STATUS_ACTIVE {
+			return types.ErrInvalidStreamAccountStatus.Wrapf("the source %s is not active", autoTopUp.Source)
+		}
+		// the source is not force settled by the top-up, it fails if the balance of the source is not enough
+		change := types.NewDefaultStreamRecordChangeWithAddr(source).WithStaticBalanceChange(amount.Neg())
+		err := k.UpdateStreamRecord(ctx.WithValue(types.ForceUpdateStreamRecordKey, false), sourceRecord, change)
+		if err != nil {
+			return err
+		}
+		k.SetStreamRecord(ctx, sourceRecord)
+	} else {
+		if autoTopUp.Source != paymentAccount.Owner {
+			return types.ErrInvalidAutoTopUpSource.Wrapf("the source %s is not the owner %s", autoTopUp.Source, paymentAccount.Owner)
+		}
+		// the owner authorizes the payment module to deposit on its behalf
+		msg := types.NewMsgDeposit(autoTopUp.Source, addr.String(), amount)
+		err := gnfdauthz.CheckAuthorization(ctx, k.authzKeeper, k.accountKeeper.GetModuleAddress(types.ModuleName), source, msg)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, amount))
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, source, types.ModuleName, coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE {
+		change := types.NewDefaultStreamRecordChangeWithAddr(addr).WithStaticBalanceChange(amount)
+		err := k.UpdateStreamRecord(ctx, streamRecord, change)
+		if err != nil {
+			return err
+		}
+		k.SetStreamRecord(ctx, streamRecord)
+	} else {
+		err := k.TryResumeStreamRecord(ctx, streamRecord, amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventAutoTopUp{
+		Addr:   addr.String(),
+		Source: autoTopUp.Source,
+		Amount: amount,
+	})
+}
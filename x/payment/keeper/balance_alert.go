@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// SetBalanceAlert set a specific balanceAlert in the store from its index
+func (k Keeper) SetBalanceAlert(ctx sdk.Context, balanceAlert *types.BalanceAlert) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertKeyPrefix)
+	key := types.BalanceAlertKey(
+		sdk.MustAccAddressFromHex(balanceAlert.Addr),
+	)
+
+	addr := balanceAlert.Addr
+	balanceAlert.Addr = ""
+	store.Set(key, k.cdc.MustMarshal(balanceAlert))
+
+	balanceAlert.Addr = addr
+}
+
+// GetBalanceAlert returns a balanceAlert from its index
+func (k Keeper) GetBalanceAlert(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) (*types.BalanceAlert, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertKeyPrefix)
+
+	b := store.Get(types.BalanceAlertKey(
+		addr,
+	))
+	if b == nil {
+		return nil, false
+	}
+
+	balanceAlert := &types.BalanceAlert{}
+	k.cdc.MustUnmarshal(b, balanceAlert)
+	balanceAlert.Addr = addr.String()
+	return balanceAlert, true
+}
+
+// RemoveBalanceAlert removes a balanceAlert and its pending warning from the store
+func (k Keeper) RemoveBalanceAlert(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) {
+	balanceAlert, found := k.GetBalanceAlert(ctx, addr)
+	if !found {
+		return
+	}
+	if balanceAlert.WarnTimestamp != 0 && !balanceAlert.Warned {
+		k.removeBalanceAlertWarnRecord(ctx, balanceAlert.WarnTimestamp, addr)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertKeyPrefix)
+	store.Delete(types.BalanceAlertKey(
+		addr,
+	))
+}
+
+func (k Keeper) setBalanceAlertWarnRecord(ctx sdk.Context, timestamp int64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertWarnKeyPrefix)
+	store.Set(types.BalanceAlertWarnKey(timestamp, addr), []byte{0x00})
+}
+
+func (k Keeper) removeBalanceAlertWarnRecord(ctx sdk.Context, timestamp int64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertWarnKeyPrefix)
+	store.Delete(types.BalanceAlertWarnKey(timestamp, addr))
+}
+
+// UpdateBalanceAlert reschedules the warning of the balance alert of the stream account, if any, by the new settle
+// timestamp of the stream account. A stream account is frozen at its settle timestamp, so the warning is scheduled
+// at the settle timestamp minus the threshold. The warning is emitted only once until the runway is back above the
+// threshold.
+func (k Keeper) UpdateBalanceAlert(ctx sdk.Context, addr sdk.AccAddress, settleTimestamp int64) {
+	balanceAlert, found := k.GetBalanceAlert(ctx, addr)
+	if !found {
+		return
+	}
+
+	var warnTimestamp int64 = 0
+	if settleTimestamp != 0 {
+		warnTimestamp = settleTimestamp - int64(balanceAlert.Threshold)
+		if warnTimestamp <= 0 {
+			warnTimestamp = 1
+		}
+	}
+	if warnTimestamp == balanceAlert.WarnTimestamp {
+		return
+	}
+
+	if balanceAlert.WarnTimestamp != 0 && !balanceAlert.Warned {
+		k.removeBalanceAlertWarnRecord(ctx, balanceAlert.WarnTimestamp, addr)
+	}
+	if warnTimestamp == 0 || warnTimestamp > ctx.BlockTime().Unix() {
+		balanceAlert.Warned = false
+	}
+	if warnTimestamp != 0 && !balanceAlert.Warned {
+		k.setBalanceAlertWarnRecord(ctx, warnTimestamp, addr)
+	}
+	balanceAlert.WarnTimestamp = warnTimestamp
+	k.SetBalanceAlert(ctx, balanceAlert)
+}
+
+// WarnLowBalances emits the low balance warnings of the stream accounts whose runway falls under the threshold of
+// their balance alerts.
+func (k Keeper) WarnLowBalances(ctx sdk.Context) {
+	currentTimestamp := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertWarnKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	max := k.GetParams(ctx).MaxAutoSettleFlowCount
+	var toWarn []sdk.AccAddress
+	for ; iterator.Valid() && uint64(len(toWarn)) < max; iterator.Next() {
+		timestamp, addr := types.ParseBalanceAlertWarnKey(iterator.Key())
+		if timestamp > currentTimestamp {
+			break
+		}
+		toWarn = append(toWarn, addr)
+	}
+
+	for _, addr := range toWarn {
+		balanceAlert, found := k.GetBalanceAlert(ctx, addr)
+		if !found { // should not happen
+			ctx.Logger().Error("warn low balance, balance alert not found", "address", addr.String())
+			continue
+		}
+		k.removeBalanceAlertWarnRecord(ctx, balanceAlert.WarnTimestamp, addr)
+		balanceAlert.Warned = true
+		k.SetBalanceAlert(ctx, balanceAlert)
+
+		streamRecord, found := k.GetStreamRecord(ctx, addr)
+		if !found || streamRecord.Status != types.STREAM_ACCOUNT_STATUS_ACTIVE {
+			continue
+		}
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventLowBalanceWarning{
+			Addr:            addr.String(),
+			Threshold:       balanceAlert.Threshold,
+			FreezeTimestamp: streamRecord.SettleTimestamp,
+		})
+	}
+}
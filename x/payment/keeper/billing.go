@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// SetBucketBill records the bill of a bucket taking effect from the current block time.
+// Nothing is recorded if the bill is the same as the latest one of the bucket.
+func (k Keeper) SetBucketBill(ctx sdk.Context, bucketBill *types.BucketBill) {
+	bucketBill.Timestamp = ctx.BlockTime().Unix()
+	addr := sdk.MustAccAddressFromHex(bucketBill.PaymentAddress)
+
+	latest, found := k.getLatestBucketBill(ctx, addr, bucketBill.BucketId)
+	if !found && len(bucketBill.Flows) == 0 {
+		return
+	}
+	if found && latest.Timestamp != bucketBill.Timestamp && billingFlowsEqual(latest.Flows, bucketBill.Flows) {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBillKeyPrefix)
+	b := k.cdc.MustMarshal(bucketBill)
+	store.Set(types.BucketBillKey(addr, bucketBill.BucketId, bucketBill.Timestamp), b)
+
+	k.pruneBucketBills(ctx, addr, bucketBill.BucketId, bucketBill.Timestamp-types.BucketBillRetention)
+}
+
+// pruneBucketBills removes the bills of the bucket superseded before the cutoff, the last bill before the cutoff is
+// kept since it is charged at the cutoff.
+func (k Keeper) pruneBucketBills(ctx sdk.Context, addr sdk.AccAddress, bucketId sdkmath.Uint, cutoff int64) {
+	if cutoff <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBillKeyPrefix)
+	iterator := store.Iterator(types.BucketBillKey(addr, bucketId, 0), types.BucketBillKey(addr, bucketId, cutoff+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+func (k Keeper) getLatestBucketBill(ctx sdk.Context, addr sdk.AccAddress, bucketId sdkmath.Uint) (*types.BucketBill, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBillKeyPrefix)
+	bucketPrefix := append(append([]byte{}, addr.Bytes()...), sdk.Uint64ToBigEndian(bucketId.Uint64())...)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, bucketPrefix)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+	var bucketBill types.BucketBill
+	k.cdc.MustUnmarshal(iterator.Value(), &bucketBill)
+	return &bucketBill, true
+}
+
+// setStreamRecordFrozen records that the stream record of the account is frozen or resumed from the current block
+// time, the bucket bills of the account are not charged while it is frozen.
+func (k Keeper) setStreamRecordFrozen(ctx sdk.Context, addr sdk.AccAddress, frozen bool) {
+	if !ctx.IsUpgraded(gnfdtypes.Gobi) {
+		return
+	}
+	now := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordFreezeKeyPrefix)
+	value := []byte{0}
+	if frozen {
+		value = []byte{1}
+	}
+	store.Set(types.StreamRecordFreezeKey(addr, now), value)
+
+	// the changes superseded before the retention are removed, the last one is kept since it is in effect then
+	cutoff := now - types.BucketBillRetention
+	if cutoff <= 0 {
+		return
+	}
+	iterator := store.Iterator(types.StreamRecordFreezeKey(addr, 0), types.StreamRecordFreezeKey(addr, cutoff+1))
+	defer iterator.Close()
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// getFrozenPeriods returns the periods in [startTime, endTime) during which the stream record of the account was
+// frozen, ordered by time.
+func (k Keeper) getFrozenPeriods(ctx sdk.Context, addr sdk.AccAddress, startTime, endTime int64) [][2]int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamRecordFreezeKeyPrefix)
+
+	var periods [][2]int64
+	frozenAt := int64(-1)
+	reverseIterator := store.ReverseIterator(types.StreamRecordFreezeKey(addr, 0), types.StreamRecordFreezeKey(addr, startTime+1))
+	if reverseIterator.Valid() && reverseIterator.Value()[0] == 1 {
+		frozenAt = startTime
+	}
+	reverseIterator.Close()
+
+	iterator := store.Iterator(types.StreamRecordFreezeKey(addr, startTime+1), types.StreamRecordFreezeKey(addr, endTime))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		timestamp := int64(sdk.BigEndianToUint64(iterator.Key()[len(addr):]))
+		frozen := iterator.Value()[0] == 1
+		if frozen && frozenAt < 0 {
+			frozenAt = timestamp
+		} else if !frozen && frozenAt >= 0 {
+			periods = append(periods, [2]int64{frozenAt, timestamp})
+			frozenAt = -1
+		}
+	}
+	if frozenAt >= 0 {
+		periods = append(periods, [2]int64{frozenAt, endTime})
+	}
+	return periods
+}
+
+// GetBillingStatement reconstructs the charges of the buckets paid by the payment account in [startTime, endTime)
+// from the recorded bucket bills. The charges are broken down by bucket, receiver and category, and paginated by
+// bucket. Nothing is charged while the stream record of the account is frozen.
+func (k Keeper) GetBillingStatement(ctx sdk.Context, addr sdk.AccAddress, startTime, endTime int64,
+	pageReq *query.PageRequest) ([]types.BillingStatementItem, sdkmath.Int, *query.PageResponse) {
+	var items []types.BillingStatementItem
+	itemIndexes := make(map[string]int)
+	frozenPeriods := k.getFrozenPeriods(ctx, addr, startTime, endTime)
+	accrue := func(bucketBill *types.BucketBill, to int64) {
+		from := bucketBill.Timestamp
+		if from < startTime {
+			from = startTime
+		}
+		if to > endTime {
+			to = endTime
+		}
+		if to <= from {
+			return
+		}
+		duration := to - from
+		for _, period := range frozenPeriods {
+			frozenFrom, frozenTo := period[0], period[1]
+			if frozenFrom < from {
+				frozenFrom = from
+			}
+			if frozenTo > to {
+				frozenTo = to
+			}
+			if frozenTo > frozenFrom {
+				duration -= frozenTo - frozenFrom
+			}
+		}
+		if duration <= 0 {
+			return
+		}
+		for _, flow := range bucketBill.Flows {
+			itemKey := bucketBill.BucketId.String() + "/" + flow.ToAddress + "/" + flow.Category.String()
+			index, ok := itemIndexes[itemKey]
+			if !ok {
+				index = len(items)
+				itemIndexes[itemKey] = index
+				items = append(items, types.BillingStatementItem{
+					BucketId:  bucketBill.BucketId,
+					ToAddress: flow.ToAddress,
+					Recipient: flow.Recipient,
+					Category:  flow.Category,
+					Amount:    sdkmath.ZeroInt(),
+				})
+			}
+			items[index].Amount = items[index].Amount.Add(flow.Rate.MulRaw(duration))
+		}
+	}
+
+	limit := uint64(query.DefaultLimit)
+	var bucketKey []byte
+	if pageReq != nil {
+		if pageReq.Limit != 0 {
+			limit = pageReq.Limit
+		}
+		bucketKey = pageReq.Key
+	}
+
+	// the buckets are iterated by id, and only the bills charged in the time range are read for each bucket
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBillKeyPrefix), addr.Bytes())
+	pageRes := &query.PageResponse{}
+	for count := uint64(0); ; count++ {
+		iterator := store.Iterator(bucketKey, nil)
+		if !iterator.Valid() {
+			iterator.Close()
+			break
+		}
+		bucketId := sdkmath.NewUint(sdk.BigEndianToUint64(iterator.Key()[:8]))
+		iterator.Close()
+		if count == limit {
+			pageRes.NextKey = sdk.Uint64ToBigEndian(bucketId.Uint64())
+			break
+		}
+
+		var prev *types.BucketBill
+		for _, bucketBill := range k.getBucketBillsInRange(ctx, addr, bucketId, startTime, endTime) {
+			bucketBill := bucketBill
+			if prev != nil {
+				accrue(prev, bucketBill.Timestamp)
+			}
+			prev = &bucketBill
+		}
+		if prev != nil {
+			accrue(prev, endTime)
+		}
+		bucketKey = sdk.Uint64ToBigEndian(bucketId.Uint64() + 1)
+		if bucketId.Uint64() == math.MaxUint64 {
+			break
+		}
+	}
+
+	total := sdkmath.ZeroInt()
+	for _, item := range items {
+		total = total.Add(item.Amount)
+	}
+	return items, total, pageRes
+}
+
+// getBucketBillsInRange returns the bills of the bucket charged in [startTime, endTime) ordered by time, the first
+// one is the last bill taking effect before or at the start time, if any.
+func (k Keeper) getBucketBillsInRange(ctx sdk.Context, addr sdk.AccAddress, bucketId sdkmath.Uint,
+	startTime, endTime int64) []types.BucketBill {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBillKeyPrefix)
+	from := types.BucketBillKey(addr, bucketId, startTime)
+	reverseIterator := store.ReverseIterator(types.BucketBillKey(addr, bucketId, 0), types.BucketBillKey(addr, bucketId, startTime+1))
+	if reverseIterator.Valid() {
+		from = reverseIterator.Key()
+	}
+	reverseIterator.Close()
+
+	var bucketBills []types.BucketBill
+	iterator := store.Iterator(from, types.BucketBillKey(addr, bucketId, endTime))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bucketBill types.BucketBill
+		k.cdc.MustUnmarshal(iterator.Value(), &bucketBill)
+		bucketBills = append(bucketBills, bucketBill)
+	}
+	return bucketBills
+}
+
+func billingFlowsEqual(a, b []types.BillingFlow) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ToAddress != b[i].ToAddress || !a[i].Rate.Equal(b[i].Rate) ||
+			a[i].Category != b[i].Category || a[i].Recipient != b[i].Recipient {
+			return false
+		}
+	}
+	return true
+}
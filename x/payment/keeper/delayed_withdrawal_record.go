@@ -2,19 +2,26 @@ package keeper
 
 import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
-// SetDelayedWithdrawalRecord set a specific delayedWithdrawal in the store from its index
+// SetDelayedWithdrawalRecord set a specific delayedWithdrawal in the store from its index,
+// the delayedWithdrawal with id 0 is the one created before the concurrent delayed withdrawals are supported
 func (k Keeper) SetDelayedWithdrawalRecord(ctx sdk.Context, delayedWithdrawalRecord *types.DelayedWithdrawalRecord) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
-	key := types.DelayedWithdrawalKey(
-		sdk.MustAccAddressFromHex(delayedWithdrawalRecord.Addr),
-	)
-
 	addr := delayedWithdrawalRecord.Addr
+	var store prefix.Store
+	var key []byte
+	if delayedWithdrawalRecord.Id == 0 {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
+		key = types.DelayedWithdrawalKey(sdk.MustAccAddressFromHex(addr))
+	} else {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalsKeyPrefix)
+		key = types.DelayedWithdrawalsKey(sdk.MustAccAddressFromHex(addr), delayedWithdrawalRecord.Id)
+	}
+
 	delayedWithdrawalRecord.Addr = ""
 	store.Set(key, k.cdc.MustMarshal(delayedWithdrawalRecord))
 
@@ -25,13 +32,18 @@ func (k Keeper) SetDelayedWithdrawalRecord(ctx sdk.Context, delayedWithdrawalRec
 func (k Keeper) GetDelayedWithdrawalRecord(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
+	id uint64,
 ) (*types.DelayedWithdrawalRecord, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
+	var b []byte
+	if id == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
+		b = store.Get(types.DelayedWithdrawalKey(addr))
+	} else {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalsKeyPrefix)
+		b = store.Get(types.DelayedWithdrawalsKey(addr, id))
+	}
 
-	delayedWithdrawal := &types.DelayedWithdrawalRecord{Addr: addr.String()}
-	b := store.Get(types.DelayedWithdrawalKey(
-		addr,
-	))
+	delayedWithdrawal := &types.DelayedWithdrawalRecord{Addr: addr.String(), Id: id}
 	if b == nil {
 		return delayedWithdrawal, false
 	}
@@ -41,13 +53,51 @@ func (k Keeper) GetDelayedWithdrawalRecord(
 	return delayedWithdrawal, true
 }
 
+// GetEarliestDelayedWithdrawalRecord returns the earliest created delayedWithdrawal of the address
+func (k Keeper) GetEarliestDelayedWithdrawalRecord(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) (*types.DelayedWithdrawalRecord, bool) {
+	if delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(ctx, addr, 0); found {
+		return delayedWithdrawal, true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalsKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, addr.Bytes())
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return &types.DelayedWithdrawalRecord{Addr: addr.String()}, false
+	}
+
+	var delayedWithdrawal types.DelayedWithdrawalRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &delayedWithdrawal)
+	delayedWithdrawal.Addr = addr.String()
+	return &delayedWithdrawal, true
+}
+
 // RemoveDelayedWithdrawalRecord removes a delayedWithdrawal from the store
 func (k Keeper) RemoveDelayedWithdrawalRecord(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
+	id uint64,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
-	store.Delete(types.DelayedWithdrawalKey(
-		addr,
-	))
+	if id == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalKeyPrefix)
+		store.Delete(types.DelayedWithdrawalKey(addr))
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalsKeyPrefix)
+	store.Delete(types.DelayedWithdrawalsKey(addr, id))
+}
+
+// nextDelayedWithdrawalId returns the id for a new delayedWithdrawal, the ids start from 1
+func (k Keeper) nextDelayedWithdrawalId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if b := store.Get(types.DelayedWithdrawalIdKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	id++
+	store.Set(types.DelayedWithdrawalIdKey, sdk.Uint64ToBigEndian(id))
+	return id
 }
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) BillingStatement(goCtx context.Context, req *types.QueryBillingStatementRequest) (*types.QueryBillingStatementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromHexUnsafe(req.Addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	// the charges after the current block time are not known yet
+	endTime := req.EndTime
+	if endTime == 0 || endTime > ctx.BlockTime().Unix() {
+		endTime = ctx.BlockTime().Unix()
+	}
+	if req.StartTime < 0 || req.StartTime >= endTime {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	// the bills are pruned after the retention
+	if req.StartTime < ctx.BlockTime().Unix()-types.BucketBillRetention {
+		return nil, status.Errorf(codes.InvalidArgument, "the bills before %d are pruned",
+			ctx.BlockTime().Unix()-types.BucketBillRetention)
+	}
+
+	items, total, pageRes := k.GetBillingStatement(ctx, addr, req.StartTime, endTime, req.Pagination)
+	return &types.QueryBillingStatementResponse{
+		StartTime:  req.StartTime,
+		EndTime:    endTime,
+		Items:      items,
+		Total:      total,
+		Pagination: pageRes,
+	}, nil
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -21,7 +23,7 @@ func (k Keeper) DelayedWithdrawal(goCtx context.Context, req *types.QueryDelayed
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid account")
 	}
-	delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(
+	delayedWithdrawal, found := k.GetEarliestDelayedWithdrawalRecord(
 		ctx,
 		account,
 	)
@@ -32,3 +34,45 @@ func (k Keeper) DelayedWithdrawal(goCtx context.Context, req *types.QueryDelayed
 
 	return &types.QueryDelayedWithdrawalResponse{DelayedWithdrawal: *delayedWithdrawal}, nil
 }
+
+func (k Keeper) DelayedWithdrawals(goCtx context.Context, req *types.QueryDelayedWithdrawalsRequest) (*types.QueryDelayedWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+
+	// the delayed withdrawal created before the concurrent delayed withdrawals are supported goes first
+	var delayedWithdrawals []types.DelayedWithdrawalRecord
+	if req.Pagination == nil || len(req.Pagination.Key) == 0 {
+		if delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(ctx, account, 0); found {
+			delayedWithdrawals = append(delayedWithdrawals, *delayedWithdrawal)
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedWithdrawalsKeyPrefix)
+	accountStore := prefix.NewStore(store, account.Bytes())
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key []byte, value []byte) error {
+		var delayedWithdrawal types.DelayedWithdrawalRecord
+		if err := k.cdc.Unmarshal(value, &delayedWithdrawal); err != nil {
+			return err
+		}
+		delayedWithdrawal.Addr = account.String()
+
+		delayedWithdrawals = append(delayedWithdrawals, delayedWithdrawal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelayedWithdrawalsResponse{DelayedWithdrawals: delayedWithdrawals, Pagination: pageRes}, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) MultisigProposals(goCtx context.Context, req *types.QueryMultisigProposalsRequest) (*types.QueryMultisigProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	paymentAccount, err := sdk.AccAddressFromHexUnsafe(req.PaymentAccount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment account")
+	}
+
+	var proposals []types.MultisigProposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalKeyPrefix)
+	accountStore := prefix.NewStore(store, paymentAccount.Bytes())
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(_ []byte, value []byte) error {
+		var proposal types.MultisigProposal
+		k.cdc.MustUnmarshal(value, &proposal)
+		proposals = append(proposals, proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMultisigProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
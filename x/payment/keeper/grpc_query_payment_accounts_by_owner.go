@@ -21,15 +21,12 @@ func (k Keeper) PaymentAccountsByOwner(goCtx context.Context, req *types.QueryPa
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
 	}
-	countRecord, found := k.GetPaymentAccountCount(ctx, owner)
+	_, found := k.GetPaymentAccountCount(ctx, owner)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
-	count := countRecord.Count
 	var paymentAccounts []string
-	var i uint64
-	for i = 0; i < count; i++ {
-		paymentAccount := k.DerivePaymentAccountAddress(owner, i)
+	for _, paymentAccount := range k.GetPaymentAccountsByOwner(ctx, owner) {
 		paymentAccounts = append(paymentAccounts, paymentAccount.String())
 	}
 
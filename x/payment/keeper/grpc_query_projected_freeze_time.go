@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) ProjectedFreezeTime(goCtx context.Context, req *types.QueryProjectedFreezeTimeRequest) (*types.QueryProjectedFreezeTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+	streamRecord, found := k.GetStreamRecord(ctx, account)
+	if !found {
+		return nil, types.ErrStreamRecordNotFound
+	}
+
+	currentTimestamp := ctx.BlockTime().Unix()
+	flowDelta := streamRecord.NetflowRate.MulRaw(currentTimestamp - streamRecord.CrudTimestamp)
+	dynamicBalance := streamRecord.StaticBalance.Add(flowDelta)
+
+	// the stream account is frozen once its balance can not pay for the forced settle time,
+	// the bank balance which may be transferred automatically is not counted.
+	var freezeTimestamp, runway int64
+	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE && streamRecord.NetflowRate.IsNegative() {
+		payDuration := dynamicBalance.Add(streamRecord.BufferBalance).Quo(streamRecord.NetflowRate.Abs())
+		runway = payDuration.Int64() - int64(k.GetParams(ctx).ForcedSettleTime)
+		if runway < 0 {
+			runway = 0
+		}
+		freezeTimestamp = currentTimestamp + runway
+	}
+
+	balanceAlert, _ := k.GetBalanceAlert(ctx, account)
+	return &types.QueryProjectedFreezeTimeResponse{
+		DynamicBalance:  dynamicBalance,
+		NetflowRate:     streamRecord.NetflowRate,
+		FreezeTimestamp: freezeTimestamp,
+		Runway:          runway,
+		Status:          streamRecord.Status,
+		BalanceAlert:    balanceAlert,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) ReadVoucher(goCtx context.Context, req *types.QueryReadVoucherRequest) (*types.QueryReadVoucherResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	readVoucher, found := k.GetReadVoucher(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryReadVoucherResponse{ReadVoucher: *readVoucher}, nil
+}
+
+func (k Keeper) ReadVouchersByHolder(goCtx context.Context, req *types.QueryReadVouchersByHolderRequest) (*types.QueryReadVouchersByHolderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	holder, err := sdk.AccAddressFromHexUnsafe(req.Holder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid holder")
+	}
+
+	var readVouchers []types.ReadVoucher
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherByHolderPrefix)
+	holderStore := prefix.NewStore(store, holder.Bytes())
+	pageRes, err := query.FilteredPaginate(holderStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		readVoucher, found := k.GetReadVoucher(ctx, sdk.BigEndianToUint64(key))
+		// the expired vouchers are not outstanding any more
+		if !found || ctx.BlockTime().Unix() > readVoucher.ExpireTime {
+			return false, nil
+		}
+		if accumulate {
+			readVouchers = append(readVouchers, *readVoucher)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReadVouchersByHolderResponse{ReadVouchers: readVouchers, Pagination: pageRes}, nil
+}
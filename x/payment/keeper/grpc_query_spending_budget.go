@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) SpendingBudget(goCtx context.Context, req *types.QuerySpendingBudgetRequest) (*types.QuerySpendingBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromHexUnsafe(req.Addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	spendingBudget, found := k.GetSpendingBudget(
+		ctx,
+		addr,
+	)
+
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	// the spent is accrued to the current block time
+	if streamRecord, found := k.GetStreamRecord(ctx, addr); found {
+		spendingBudget.Accrue(getOutflowRate(streamRecord), ctx.BlockTime().Unix())
+	}
+
+	return &types.QuerySpendingBudgetResponse{SpendingBudget: *spendingBudget}, nil
+}
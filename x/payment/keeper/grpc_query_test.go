@@ -6,10 +6,12 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -223,6 +225,10 @@ func TestPaymentAccountsByOwnerQuery(t *testing.T) {
 		Count: 2,
 	}
 	keeper.SetPaymentAccountCount(ctx, &record1)
+	for i := uint64(0); i < record1.Count; i++ {
+		addr := keeper.DerivePaymentAccountAddress(owner1, i).String()
+		keeper.SetPaymentAccount(ctx, &types.PaymentAccount{Addr: addr, Owner: owner1.String()})
+	}
 
 	response, err := keeper.PaymentAccountsByOwner(ctx, &types.QueryPaymentAccountsByOwnerRequest{
 		Owner: owner1.String(),
@@ -335,3 +341,107 @@ func TestDelayedWithdrawalQuery(t *testing.T) {
 	require.True(t, response.DelayedWithdrawal.Amount.Equal(delayedWithdrawal.Amount))
 	require.True(t, response.DelayedWithdrawal.UnlockTimestamp == delayedWithdrawal.UnlockTimestamp)
 }
+
+func TestBillingStatementQuery(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	addr := sample.RandAccAddress()
+	gvgFamily := sample.RandAccAddress().String()
+	taxPool := types.ValidatorTaxPoolAddress.String()
+	bill := func(bucketId uint64, flows ...types.BillingFlow) *types.BucketBill {
+		return &types.BucketBill{PaymentAddress: addr.String(), BucketId: sdkmath.NewUint(bucketId), Flows: flows}
+	}
+	readFlow := types.BillingFlow{ToAddress: gvgFamily, Rate: sdkmath.NewInt(10), Category: types.BILLING_CATEGORY_READ}
+	storeFlow := types.BillingFlow{ToAddress: gvgFamily, Rate: sdkmath.NewInt(20), Category: types.BILLING_CATEGORY_STORE}
+	taxFlow := types.BillingFlow{ToAddress: taxPool, Rate: sdkmath.NewInt(1), Category: types.BILLING_CATEGORY_READ,
+		Recipient: types.BILLING_RECIPIENT_VALIDATOR_TAX_POOL}
+
+	// bucket 1 is charged for read from 1000, and for store from 1100 until it is deleted at 1200
+	keeper.SetBucketBill(ctx, bill(1, readFlow, taxFlow))
+	keeper.SetBucketBill(ctx.WithBlockTime(time.Unix(1100, 0)), bill(1, readFlow, taxFlow, storeFlow))
+	keeper.SetBucketBill(ctx.WithBlockTime(time.Unix(1200, 0)), bill(1))
+	// bucket 2 is charged for store from 1150
+	keeper.SetBucketBill(ctx.WithBlockTime(time.Unix(1150, 0)), bill(2, storeFlow))
+
+	ctx = ctx.WithBlockTime(time.Unix(1300, 0))
+	response, err := keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1050})
+	require.NoError(t, err)
+	require.Equal(t, int64(1300), response.EndTime)
+	require.Len(t, response.Items, 4)
+	require.Equal(t, types.BILLING_CATEGORY_READ, response.Items[0].Category)
+	require.Equal(t, sdkmath.NewInt(10*150), response.Items[0].Amount)
+	require.Equal(t, types.BILLING_RECIPIENT_VALIDATOR_TAX_POOL, response.Items[1].Recipient)
+	require.Equal(t, sdkmath.NewInt(150), response.Items[1].Amount)
+	require.Equal(t, types.BILLING_CATEGORY_STORE, response.Items[2].Category)
+	require.Equal(t, sdkmath.NewInt(20*100), response.Items[2].Amount)
+	require.Equal(t, sdkmath.NewUint(2), response.Items[3].BucketId)
+	require.Equal(t, sdkmath.NewInt(20*150), response.Items[3].Amount)
+	require.Equal(t, sdkmath.NewInt(1500+150+2000+3000), response.Total)
+
+	_, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1300})
+	require.Error(t, err)
+
+	// the statement is paginated by bucket
+	response, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1050,
+		Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, response.Items, 3)
+	require.Equal(t, sdkmath.NewInt(1500+150+2000), response.Total)
+	response, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1050,
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, response.Items, 1)
+	require.Equal(t, sdkmath.NewUint(2), response.Items[0].BucketId)
+	require.Empty(t, response.Pagination.NextKey)
+
+	// the bills superseded before the retention are pruned, the statements of the retention are kept
+	now := 1200 + types.BucketBillRetention + 100
+	ctx = ctx.WithBlockTime(time.Unix(now, 0))
+	keeper.SetBucketBill(ctx, bill(1, storeFlow))
+	_, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1050})
+	require.Error(t, err)
+	response, err = keeper.BillingStatement(ctx.WithBlockTime(time.Unix(now+100, 0)),
+		&types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: now - 100})
+	require.NoError(t, err)
+	require.Len(t, response.Items, 2)
+	require.Equal(t, sdkmath.NewUint(1), response.Items[0].BucketId)
+	require.Equal(t, sdkmath.NewInt(20*100), response.Items[0].Amount)
+	require.Equal(t, sdkmath.NewInt(20*200), response.Items[1].Amount)
+}
+
+func TestBillingStatementQueryFrozen(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	upgradeChecker := func(ctx sdk.Context, name string) bool { return name == gnfdtypes.Gobi }
+	ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, upgradeChecker, ctx.Logger()).
+		WithBlockTime(time.Unix(1000, 0))
+
+	addr := sample.RandAccAddress()
+	storeFlow := types.BillingFlow{ToAddress: sample.RandAccAddress().String(), Rate: sdkmath.NewInt(20),
+		Category: types.BILLING_CATEGORY_STORE}
+	keeper.SetBucketBill(ctx, &types.BucketBill{PaymentAddress: addr.String(), BucketId: sdkmath.NewUint(1),
+		Flows: []types.BillingFlow{storeFlow}})
+
+	// the stream record is frozen from 1100 to 1200, the bucket is not charged in the meantime
+	streamRecord := types.NewStreamRecord(addr, 1000)
+	streamRecord.NetflowRate = sdkmath.NewInt(-20)
+	require.NoError(t, keeper.ForceSettle(ctx.WithBlockTime(time.Unix(1100, 0)), streamRecord))
+	streamRecord.FrozenNetflowRate = streamRecord.NetflowRate
+	streamRecord.NetflowRate = sdkmath.ZeroInt()
+	streamRecord.OutFlowCount = 1
+	keeper.SetStreamRecord(ctx, streamRecord)
+	keeper.SetOutFlow(ctx, addr, &types.OutFlow{ToAddress: storeFlow.ToAddress, Rate: storeFlow.Rate,
+		Status: types.OUT_FLOW_STATUS_FROZEN})
+	require.NoError(t, keeper.TryResumeStreamRecord(ctx.WithBlockTime(time.Unix(1200, 0)), streamRecord,
+		sdkmath.NewInt(1e10)))
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_ACTIVE, streamRecord.Status)
+
+	response, err := keeper.BillingStatement(ctx.WithBlockTime(time.Unix(1300, 0)),
+		&types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1000})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(20*200), response.Total)
+	response, err = keeper.BillingStatement(ctx.WithBlockTime(time.Unix(1300, 0)),
+		&types.QueryBillingStatementRequest{Addr: addr.String(), StartTime: 1150})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(20*100), response.Total)
+}
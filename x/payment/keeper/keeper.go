@@ -20,6 +20,7 @@ type (
 
 		bankKeeper    types.BankKeeper
 		accountKeeper types.AccountKeeper
+		authzKeeper   types.AuthzKeeper
 		authority     string
 	}
 )
@@ -29,6 +30,7 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	authzKeeper types.AuthzKeeper,
 	authority string,
 ) *Keeper {
 	return &Keeper{
@@ -36,6 +38,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		authzKeeper:   authzKeeper,
 		authority:     authority,
 	}
 }
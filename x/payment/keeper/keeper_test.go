@@ -18,6 +18,7 @@ import (
 type DepKeepers struct {
 	BankKeeper    *types.MockBankKeeper
 	AccountKeeper *types.MockAccountKeeper
+	AuthzKeeper   *types.MockAuthzKeeper
 }
 
 func makePaymentKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, DepKeepers) {
@@ -28,11 +29,13 @@ func makePaymentKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, DepKeepers) {
 	ctrl := gomock.NewController(t)
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	authzKeeper := types.NewMockAuthzKeeper(ctrl)
 	k := keeper.NewKeeper(
 		encCfg.Codec,
 		key,
 		bankKeeper,
 		accountKeeper,
+		authzKeeper,
 		authtypes.NewModuleAddress(types.ModuleName).String(),
 	)
 	err := k.SetParams(testCtx.Ctx, types.DefaultParams())
@@ -43,6 +46,7 @@ func makePaymentKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, DepKeepers) {
 	depKeepers := DepKeepers{
 		BankKeeper:    bankKeeper,
 		AccountKeeper: accountKeeper,
+		AuthzKeeper:   authzKeeper,
 	}
 
 	return k, testCtx.Ctx, depKeepers
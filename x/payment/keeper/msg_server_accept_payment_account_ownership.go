@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) AcceptPaymentAccountOwnership(goCtx context.Context, msg *types.MsgAcceptPaymentAccountOwnership) (*types.MsgAcceptPaymentAccountOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.Addr)
+	newOwner := sdk.MustAccAddressFromHex(msg.NewOwner)
+	pendingOwner, found := k.Keeper.GetPaymentAccountTransfer(ctx, addr)
+	if !found || !pendingOwner.Equals(newOwner) {
+		return nil, types.ErrPaymentAccountTransferNotFound
+	}
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+
+	err := k.Keeper.TransferPaymentAccountOwnership(ctx, paymentAccount, newOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptPaymentAccountOwnershipResponse{}, nil
+}
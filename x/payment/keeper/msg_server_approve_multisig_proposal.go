@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) ApproveMultisigProposal(goCtx context.Context, msg *types.MsgApproveMultisigProposal) (*types.MsgApproveMultisigProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.PaymentAccount)
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if !paymentAccount.IsMultisigOwner(msg.Approver) {
+		return nil, types.ErrNotMultisigOwner
+	}
+	proposal, found := k.Keeper.GetMultisigProposal(ctx, addr, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrMultisigProposalNotFound, "proposal %d of %s", msg.Id, msg.PaymentAccount)
+	}
+	if ctx.BlockTime().Unix() > proposal.ExpireTime {
+		return nil, errors.Wrapf(types.ErrMultisigProposalExpired, "proposal %d of %s expired at %d", msg.Id, msg.PaymentAccount, proposal.ExpireTime)
+	}
+	if proposal.IsApprovedBy(msg.Approver) {
+		return nil, types.ErrMultisigProposalApproved
+	}
+
+	executed, err := k.approveMultisigProposal(ctx, paymentAccount, proposal, msg.Approver)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgApproveMultisigProposalResponse{Executed: executed}, nil
+}
+
+// approveMultisigProposal records the approval of the owner, the proposal is executed and removed once it is approved
+// by threshold of the owners.
+func (k msgServer) approveMultisigProposal(ctx sdk.Context, paymentAccount *types.PaymentAccount,
+	proposal *types.MultisigProposal, owner string,
+) (bool, error) {
+	proposal.Approvals = append(proposal.Approvals, owner)
+	executed := uint32(len(proposal.Approvals)) >= paymentAccount.Threshold
+	if executed {
+		if err := k.executeMultisigProposal(ctx, paymentAccount, proposal); err != nil {
+			return false, err
+		}
+		k.Keeper.RemoveMultisigProposal(ctx, proposal)
+	} else {
+		k.Keeper.SetMultisigProposal(ctx, proposal)
+	}
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventMultisigProposalUpdate{
+		Id:             proposal.Id,
+		PaymentAccount: proposal.PaymentAccount,
+		Action:         proposal.Action,
+		Approvals:      proposal.Approvals,
+		Executed:       executed,
+	})
+	return executed, err
+}
+
+func (k msgServer) executeMultisigProposal(ctx sdk.Context, paymentAccount *types.PaymentAccount, proposal *types.MultisigProposal) error {
+	addr := sdk.MustAccAddressFromHex(paymentAccount.Addr)
+	switch proposal.Action {
+	case types.MULTISIG_ACTION_WITHDRAW:
+		if !paymentAccount.Refundable {
+			return types.ErrPaymentAccountAlreadyNonRefundable
+		}
+		streamRecord, found := k.Keeper.GetStreamRecord(ctx, addr)
+		if !found {
+			return types.ErrStreamRecordNotFound
+		}
+		if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+			return errors.Wrapf(types.ErrInvalidStreamAccountStatus, "stream record is frozen")
+		}
+		_, err := k.withdraw(ctx, sdk.MustAccAddressFromHex(proposal.To), streamRecord, proposal.Amount)
+		return err
+	case types.MULTISIG_ACTION_DISABLE_REFUND:
+		if !paymentAccount.Refundable {
+			return types.ErrPaymentAccountAlreadyNonRefundable
+		}
+		paymentAccount.Refundable = false
+		k.Keeper.SetPaymentAccount(ctx, paymentAccount)
+		return nil
+	case types.MULTISIG_ACTION_SET_BUCKET_BINDER:
+		k.Keeper.SetBucketBinder(ctx, addr, sdk.MustAccAddressFromHex(proposal.Binder), proposal.Allowed)
+		return nil
+	default:
+		return fmt.Errorf("unknown multisig action: %s", proposal.Action)
+	}
+}
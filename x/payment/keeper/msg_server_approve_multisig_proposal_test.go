@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestMultisigPaymentAccount() {
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+
+	owner1, owner2, owner3 := sample.RandAccAddress(), sample.RandAccAddress(), sample.RandAccAddress()
+	res, err := s.msgServer.CreateMultisigPaymentAccount(s.ctx, types.NewMsgCreateMultisigPaymentAccount(
+		owner1.String(), []string{owner1.String(), owner2.String(), owner3.String()}, 2))
+	s.Require().NoError(err)
+	paymentAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner1, 0)
+	s.Require().Equal(paymentAddr.String(), res.Addr)
+	countRecord, _ := s.paymentKeeper.GetPaymentAccountCount(s.ctx, owner1)
+	s.Require().Equal(uint64(1), countRecord.Count)
+
+	// no single owner controls the account
+	s.Require().False(s.paymentKeeper.IsPaymentAccountOwner(s.ctx, paymentAddr, owner1))
+	_, err = s.msgServer.DisableRefund(s.ctx, &types.MsgDisableRefund{Owner: owner1.String(), Addr: paymentAddr.String()})
+	s.Require().Error(err)
+	_, err = s.msgServer.ProposeMultisigAction(s.ctx, types.NewMsgProposeMultisigDisableRefund(
+		sample.RandAccAddress().String(), paymentAddr.String()))
+	s.Require().ErrorIs(err, types.ErrNotMultisigOwner)
+
+	// the bucket binder is set once the proposal is approved by threshold of the owners
+	binder := sample.RandAccAddress()
+	proposeRes, err := s.msgServer.ProposeMultisigAction(s.ctx, types.NewMsgProposeMultisigSetBucketBinder(
+		owner1.String(), paymentAddr.String(), binder.String(), true))
+	s.Require().NoError(err)
+	s.Require().False(proposeRes.Executed)
+	_, err = s.msgServer.ApproveMultisigProposal(s.ctx, types.NewMsgApproveMultisigProposal(owner1.String(), paymentAddr.String(), proposeRes.Id))
+	s.Require().ErrorIs(err, types.ErrMultisigProposalApproved)
+	queryRes, err := s.paymentKeeper.MultisigProposals(s.ctx, &types.QueryMultisigProposalsRequest{PaymentAccount: paymentAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Proposals, 1)
+	s.Require().False(s.paymentKeeper.IsPaymentAccountOwner(s.ctx, paymentAddr, binder))
+
+	approveRes, err := s.msgServer.ApproveMultisigProposal(s.ctx, types.NewMsgApproveMultisigProposal(owner2.String(), paymentAddr.String(), proposeRes.Id))
+	s.Require().NoError(err)
+	s.Require().True(approveRes.Executed)
+	s.Require().True(s.paymentKeeper.IsBucketBinder(s.ctx, paymentAddr, binder))
+	// the binder does not own the payment account
+	s.Require().False(s.paymentKeeper.IsPaymentAccountOwner(s.ctx, paymentAddr, binder))
+	queryRes, err = s.paymentKeeper.MultisigProposals(s.ctx, &types.QueryMultisigProposalsRequest{PaymentAccount: paymentAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Proposals, 0)
+	_, err = s.msgServer.ApproveMultisigProposal(s.ctx, types.NewMsgApproveMultisigProposal(owner3.String(), paymentAddr.String(), proposeRes.Id))
+	s.Require().ErrorIs(err, types.ErrMultisigProposalNotFound)
+
+	// withdraw to the receiver of the proposal
+	record := types.NewStreamRecord(paymentAddr, s.ctx.BlockTime().Unix())
+	record.StaticBalance = sdkmath.NewInt(1000)
+	s.paymentKeeper.SetStreamRecord(s.ctx, record)
+	receiver := sample.RandAccAddress()
+	proposeRes, err = s.msgServer.ProposeMultisigAction(s.ctx, types.NewMsgProposeMultisigWithdraw(
+		owner2.String(), paymentAddr.String(), receiver.String(), sdkmath.NewInt(300)))
+	s.Require().NoError(err)
+	approveRes, err = s.msgServer.ApproveMultisigProposal(s.ctx, types.NewMsgApproveMultisigProposal(owner3.String(), paymentAddr.String(), proposeRes.Id))
+	s.Require().NoError(err)
+	s.Require().True(approveRes.Executed)
+	record, _ = s.paymentKeeper.GetStreamRecord(s.ctx, paymentAddr)
+	s.Require().Equal(sdkmath.NewInt(700), record.StaticBalance)
+
+	// the proposal not approved by threshold of the owners expires and is pruned
+	proposeRes, err = s.msgServer.ProposeMultisigAction(s.ctx, types.NewMsgProposeMultisigDisableRefund(
+		owner1.String(), paymentAddr.String()))
+	s.Require().NoError(err)
+	expiredCtx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.MultisigProposalLifetime+1) * time.Second))
+	_, err = s.msgServer.ApproveMultisigProposal(expiredCtx, types.NewMsgApproveMultisigProposal(owner2.String(), paymentAddr.String(), proposeRes.Id))
+	s.Require().ErrorIs(err, types.ErrMultisigProposalExpired)
+	s.paymentKeeper.PruneExpiredMultisigProposals(s.ctx)
+	_, found := s.paymentKeeper.GetMultisigProposal(s.ctx, paymentAddr, proposeRes.Id)
+	s.Require().True(found)
+	s.paymentKeeper.PruneExpiredMultisigProposals(expiredCtx)
+	_, found = s.paymentKeeper.GetMultisigProposal(s.ctx, paymentAddr, proposeRes.Id)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestMultisigPaymentAccountsByOwner() {
+	owner1, owner2 := sample.RandAccAddress(), sample.RandAccAddress()
+	_, err := s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner1.String()))
+	s.Require().NoError(err)
+	res, err := s.msgServer.CreateMultisigPaymentAccount(s.ctx, types.NewMsgCreateMultisigPaymentAccount(
+		owner1.String(), []string{owner1.String(), owner2.String()}, 2))
+	s.Require().NoError(err)
+
+	// the multisig payment account is listed for its creator, no address which is not a payment account is listed
+	queryRes, err := s.paymentKeeper.PaymentAccountsByOwner(s.ctx, &types.QueryPaymentAccountsByOwnerRequest{Owner: owner1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.paymentKeeper.DerivePaymentAccountAddress(owner1, 0).String(), res.Addr}, queryRes.PaymentAccounts)
+	for _, addr := range queryRes.PaymentAccounts {
+		s.Require().True(s.paymentKeeper.IsPaymentAccount(s.ctx, sdk.MustAccAddressFromHex(addr)))
+	}
+
+	// the other owners of the multisig payment account do not own it by themselves
+	_, err = s.paymentKeeper.PaymentAccountsByOwner(s.ctx, &types.QueryPaymentAccountsByOwnerRequest{Owner: owner2.String()})
+	s.Require().Error(err)
+}
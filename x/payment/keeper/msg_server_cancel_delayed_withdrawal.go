@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) CancelDelayedWithdrawal(goCtx context.Context, msg *types.MsgCancelDelayedWithdrawal) (*types.MsgCancelDelayedWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	creator := sdk.MustAccAddressFromHex(msg.Creator)
+
+	delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(ctx, creator, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoDelayedWithdrawal, "delayed withdrawal %d not found %s", msg.Id, creator.String())
+	}
+	k.RemoveDelayedWithdrawalRecord(ctx, creator, msg.Id)
+
+	// return the amount to the static balance of the stream account withdrawn from
+	from := sdk.MustAccAddressFromHex(delayedWithdrawal.From)
+	streamRecord, found := k.GetStreamRecord(ctx, from)
+	if !found {
+		return nil, types.ErrStreamRecordNotFound
+	}
+	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+		err := k.TryResumeStreamRecord(ctx, streamRecord, delayedWithdrawal.Amount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		change := types.NewDefaultStreamRecordChangeWithAddr(from).WithStaticBalanceChange(delayedWithdrawal.Amount)
+		err := k.UpdateStreamRecord(ctx, streamRecord, change)
+		if err != nil {
+			return nil, err
+		}
+		k.SetStreamRecord(ctx, streamRecord)
+	}
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventCancelDelayedWithdrawal{
+		Addr:   creator.String(),
+		Id:     msg.Id,
+		From:   from.String(),
+		Amount: delayedWithdrawal.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelDelayedWithdrawalResponse{}, nil
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestCancelDelayedWithdrawal() {
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	upgradeChecker := func(ctx sdk.Context, name string) bool { return true }
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, upgradeChecker, s.ctx.Logger()).
+		WithBlockTime(time.Unix(1000, 0))
+
+	params := s.paymentKeeper.GetParams(ctx)
+	threshold := sdkmath.NewInt(100)
+	params.WithdrawTimeLockThreshold = &threshold
+	s.Require().NoError(s.paymentKeeper.SetParams(ctx, params))
+
+	owner := sample.RandAccAddress()
+	_, err := s.msgServer.CreatePaymentAccount(ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	paymentAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 0)
+	record := types.NewStreamRecord(paymentAddr, ctx.BlockTime().Unix())
+	record.StaticBalance = sdkmath.NewInt(1000)
+	s.paymentKeeper.SetStreamRecord(ctx, record)
+
+	// concurrent delayed withdrawals are created with ids
+	for i := uint64(1); i <= 2; i++ {
+		res, err := s.msgServer.Withdraw(ctx, types.NewMsgWithdraw(owner.String(), paymentAddr.String(), sdkmath.NewInt(300)))
+		s.Require().NoError(err)
+		s.Require().Equal(i, res.DelayedWithdrawalId)
+	}
+	listRes, err := s.paymentKeeper.DelayedWithdrawals(ctx, &types.QueryDelayedWithdrawalsRequest{Account: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(listRes.DelayedWithdrawals, 2)
+	s.Require().Equal(uint64(2), listRes.DelayedWithdrawals[1].Id)
+
+	// only the receiver can cancel the delayed withdrawal
+	_, err = s.msgServer.CancelDelayedWithdrawal(ctx, types.NewMsgCancelDelayedWithdrawal(sample.RandAccAddress().String(), 1))
+	s.Require().ErrorIs(err, types.ErrNoDelayedWithdrawal)
+	_, err = s.msgServer.CancelDelayedWithdrawal(ctx, types.NewMsgCancelDelayedWithdrawal(owner.String(), 1))
+	s.Require().NoError(err)
+	record, _ = s.paymentKeeper.GetStreamRecord(ctx, paymentAddr)
+	s.Require().Equal(sdkmath.NewInt(700), record.StaticBalance)
+	_, found := s.paymentKeeper.GetDelayedWithdrawalRecord(ctx, owner, 1)
+	s.Require().False(found)
+
+	// the rest is withdrawn once it is unlocked
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(params.WithdrawTimeLockDuration+1) * time.Second))
+	msg := types.NewMsgWithdraw(owner.String(), "", sdkmath.NewInt(300))
+	msg.DelayedWithdrawalId = 2
+	_, err = s.msgServer.Withdraw(ctx, msg)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CancelDelayedWithdrawal(ctx, types.NewMsgCancelDelayedWithdrawal(owner.String(), 2))
+	s.Require().ErrorIs(err, types.ErrNoDelayedWithdrawal)
+
+	// only a single delayed withdrawal is allowed before Gobi
+	upgradeChecker = func(ctx sdk.Context, name string) bool { return name != gnfdtypes.Gobi }
+	ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, upgradeChecker, ctx.Logger())
+	res, err := s.msgServer.Withdraw(ctx, types.NewMsgWithdraw(owner.String(), paymentAddr.String(), sdkmath.NewInt(300)))
+	s.Require().NoError(err)
+	s.Require().Zero(res.DelayedWithdrawalId)
+	_, err = s.msgServer.Withdraw(ctx, types.NewMsgWithdraw(owner.String(), paymentAddr.String(), sdkmath.NewInt(300)))
+	s.Require().ErrorIs(err, types.ErrExistsDelayedWithdrawal)
+	_, found = s.paymentKeeper.GetDelayedWithdrawalRecord(ctx, owner, 0)
+	s.Require().True(found)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// CreateMultisigPaymentAccount creates a payment account owned by itself, which is controlled by the proposals approved
+// by threshold of the owners. The address is derived from the creator, and it is counted and listed as a payment
+// account of the creator as CreatePaymentAccount does.
+func (k msgServer) CreateMultisigPaymentAccount(goCtx context.Context, msg *types.MsgCreateMultisigPaymentAccount) (*types.MsgCreateMultisigPaymentAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator := sdk.MustAccAddressFromHex(msg.Creator)
+	countRecord, _ := k.GetPaymentAccountCount(ctx, creator)
+	count := countRecord.Count
+	params := k.GetParams(ctx)
+	if count >= params.PaymentAccountCountLimit {
+		return nil, errorsmod.Wrapf(types.ErrReachPaymentAccountLimit, "current count: %d, limit: %d", count, params.PaymentAccountCountLimit)
+	}
+	paymentAccountAddr := k.nextPaymentAccountAddress(ctx, creator, count).String()
+	k.SetPaymentAccountCount(ctx, &types.PaymentAccountCount{
+		Owner: msg.Creator,
+		Count: count + 1,
+	})
+	k.SetPaymentAccount(ctx, &types.PaymentAccount{
+		Addr:       paymentAccountAddr,
+		Owner:      paymentAccountAddr,
+		Refundable: true,
+		Owners:     msg.Owners,
+		Threshold:  msg.Threshold,
+	})
+	return &types.MsgCreateMultisigPaymentAccountResponse{Addr: paymentAccountAddr}, nil
+}
@@ -21,7 +21,7 @@ func (k msgServer) CreatePaymentAccount(goCtx context.Context, msg *types.MsgCre
 	if count >= params.PaymentAccountCountLimit {
 		return nil, errorsmod.Wrapf(types.ErrReachPaymentAccountLimit, "current count: %d, limit: %d", count, params.PaymentAccountCountLimit)
 	}
-	paymentAccountAddr := k.DerivePaymentAccountAddress(creator, count).String()
+	paymentAccountAddr := k.nextPaymentAccountAddress(ctx, creator, count).String()
 	newCount := count + 1
 	k.SetPaymentAccountCount(ctx, &types.PaymentAccountCount{
 		Owner: msg.Creator,
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) DisableAutoTopUp(goCtx context.Context, msg *types.MsgDisableAutoTopUp) (*types.MsgDisableAutoTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.Addr)
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if paymentAccount.Owner != msg.Owner {
+		return nil, types.ErrNotPaymentAccountOwner
+	}
+
+	_, found = k.Keeper.GetAutoTopUp(ctx, addr)
+	if !found {
+		return nil, types.ErrAutoTopUpNotFound
+	}
+	k.Keeper.RemoveAutoTopUp(ctx, addr)
+	return &types.MsgDisableAutoTopUpResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) EnableAutoTopUp(goCtx context.Context, msg *types.MsgEnableAutoTopUp) (*types.MsgEnableAutoTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.Addr)
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if paymentAccount.Owner != msg.Owner {
+		return nil, types.ErrNotPaymentAccountOwner
+	}
+
+	// the source is either the owner or another payment account of the owner
+	if msg.Source != msg.Owner {
+		sourceAccount, found := k.Keeper.GetPaymentAccount(ctx, sdk.MustAccAddressFromHex(msg.Source))
+		if !found || sourceAccount.Owner != msg.Owner {
+			return nil, types.ErrInvalidAutoTopUpSource.Wrapf("the source %s is not a payment account of the owner", msg.Source)
+		}
+		// the balance of a non-refundable payment account can not be withdrawn through a refundable one
+		if !sourceAccount.Refundable && paymentAccount.Refundable {
+			return nil, types.ErrInvalidAutoTopUpSource.Wrapf("the source %s is non-refundable", msg.Source)
+		}
+	}
+
+	// reset the auto top-up, and schedule its top-up by the current settle timestamp
+	k.Keeper.RemoveAutoTopUp(ctx, addr)
+	k.Keeper.SetAutoTopUp(ctx, &types.AutoTopUp{
+		Addr:         msg.Addr,
+		Source:       msg.Source,
+		CapPerPeriod: msg.CapPerPeriod,
+		Period:       msg.Period,
+		MinRunway:    msg.MinRunway,
+		PeriodStart:  ctx.BlockTime().Unix(),
+		ToppedUp:     sdkmath.ZeroInt(),
+	})
+	streamRecord, found := k.Keeper.GetStreamRecord(ctx, addr)
+	if found {
+		k.Keeper.UpdateAutoTopUp(ctx, streamRecord)
+	}
+	return &types.MsgEnableAutoTopUpResponse{}, nil
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestAutoTopUp() {
+	// the payment accounts are topped up since Gobi
+	upgradeChecker := func(ctx sdk.Context, name string) bool { return name == gnfdtypes.Gobi }
+	s.ctx = sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, upgradeChecker, s.ctx.Logger()).
+		WithBlockTime(time.Now())
+	owner := sample.RandAccAddress()
+	_, err := s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	paymentAccountAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 0)
+	sourceAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 1)
+
+	s.accountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	params := s.paymentKeeper.GetParams(s.ctx)
+	rate := sdkmath.NewInt(100)
+	minRunway := uint64(1000)
+	capPerPeriod := rate.MulRaw(1500)
+	period := uint64(3600)
+
+	// only the owner can enable the auto top-up, from itself or its payment accounts
+	_, err = s.msgServer.EnableAutoTopUp(s.ctx, types.NewMsgEnableAutoTopUp(sample.RandAccAddress().String(),
+		paymentAccountAddr.String(), sourceAddr.String(), capPerPeriod, period, minRunway))
+	s.Require().ErrorIs(err, types.ErrNotPaymentAccountOwner)
+	_, err = s.msgServer.EnableAutoTopUp(s.ctx, types.NewMsgEnableAutoTopUp(owner.String(),
+		paymentAccountAddr.String(), sample.RandAccAddress().String(), capPerPeriod, period, minRunway))
+	s.Require().ErrorIs(err, types.ErrInvalidAutoTopUpSource)
+	_, err = s.msgServer.DisableAutoTopUp(s.ctx, types.NewMsgDisableAutoTopUp(owner.String(), paymentAccountAddr.String()))
+	s.Require().ErrorIs(err, types.ErrAutoTopUpNotFound)
+
+	// the payment account pays 100 per second, and is frozen after reserve time + 1000 seconds
+	balance := rate.MulRaw(int64(params.ForcedSettleTime) + int64(params.VersionedParams.ReserveTime) + 1000)
+	streamRecord := types.NewStreamRecord(paymentAccountAddr, s.ctx.BlockTime().Unix())
+	streamRecord.OutFlowCount = 1
+	s.paymentKeeper.SetStreamRecord(s.ctx, streamRecord)
+	_, err = s.paymentKeeper.UpdateStreamRecordByAddr(s.ctx, types.NewDefaultStreamRecordChangeWithAddr(paymentAccountAddr).
+		WithStaticBalanceChange(balance).WithRateChange(rate.Neg()))
+	s.Require().NoError(err)
+	sourceBalance := rate.MulRaw(10000)
+	sourceRecord := types.NewStreamRecord(sourceAddr, s.ctx.BlockTime().Unix())
+	sourceRecord.StaticBalance = sourceBalance
+	s.paymentKeeper.SetStreamRecord(s.ctx, sourceRecord)
+
+	_, err = s.msgServer.EnableAutoTopUp(s.ctx, types.NewMsgEnableAutoTopUp(owner.String(),
+		paymentAccountAddr.String(), sourceAddr.String(), capPerPeriod, period, minRunway))
+	s.Require().NoError(err)
+	autoTopUp, found := s.paymentKeeper.GetAutoTopUp(s.ctx, paymentAccountAddr)
+	s.Require().True(found)
+	streamRecord, _ = s.paymentKeeper.GetStreamRecord(s.ctx, paymentAccountAddr)
+	s.Require().Equal(streamRecord.SettleTimestamp-int64(minRunway), autoTopUp.TopUpTimestamp)
+
+	countTopUps := func(ctx sdk.Context) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "greenfield.payment.EventAutoTopUp" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// no top-up before the runway falls under the min runway
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(0, countTopUps(ctx))
+
+	// the top-up brings the runway back to twice the min runway
+	settleTimestamp := streamRecord.SettleTimestamp
+	ctx = s.ctx.WithBlockTime(time.Unix(autoTopUp.TopUpTimestamp, 0)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(1, countTopUps(ctx))
+	streamRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, paymentAccountAddr)
+	s.Require().Equal(settleTimestamp+int64(minRunway), streamRecord.SettleTimestamp)
+	sourceRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, sourceAddr)
+	s.Require().Equal(sourceBalance.Sub(rate.MulRaw(int64(minRunway))), sourceRecord.StaticBalance)
+
+	// the next top-up is capped by the cap per period
+	autoTopUp, _ = s.paymentKeeper.GetAutoTopUp(ctx, paymentAccountAddr)
+	ctx = ctx.WithBlockTime(time.Unix(autoTopUp.TopUpTimestamp, 0)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(1, countTopUps(ctx))
+	autoTopUp, _ = s.paymentKeeper.GetAutoTopUp(ctx, paymentAccountAddr)
+	s.Require().Equal(capPerPeriod, autoTopUp.ToppedUp)
+	sourceRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, sourceAddr)
+	s.Require().Equal(sourceBalance.Sub(capPerPeriod), sourceRecord.StaticBalance)
+
+	// no more top-up once the cap is reached
+	ctx = ctx.WithBlockTime(time.Unix(autoTopUp.TopUpTimestamp, 0)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(0, countTopUps(ctx))
+
+	// top up from the owner with the authorization of deposit
+	_, err = s.msgServer.EnableAutoTopUp(ctx, types.NewMsgEnableAutoTopUp(owner.String(),
+		paymentAccountAddr.String(), owner.String(), capPerPeriod, period, minRunway))
+	s.Require().NoError(err)
+	autoTopUp, _ = s.paymentKeeper.GetAutoTopUp(ctx, paymentAccountAddr)
+	ctx = ctx.WithBlockTime(time.Unix(autoTopUp.TopUpTimestamp, 0)).WithEventManager(sdk.NewEventManager())
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	expiration := ctx.BlockTime().Add(time.Hour)
+	grant, err := authz.NewGrant(ctx.BlockTime(), authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgDeposit{})), &expiration)
+	s.Require().NoError(err)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr).AnyTimes()
+	s.authzKeeper.EXPECT().GetGrant(gomock.Any(), moduleAddr, owner, sdk.MsgTypeURL(&types.MsgDeposit{})).
+		Return(grant, true).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName,
+		sdk.NewCoins(sdk.NewCoin(params.FeeDenom, rate.MulRaw(int64(minRunway))))).Return(nil).Times(1)
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(1, countTopUps(ctx))
+
+	_, err = s.msgServer.DisableAutoTopUp(ctx, types.NewMsgDisableAutoTopUp(owner.String(), paymentAccountAddr.String()))
+	s.Require().NoError(err)
+	_, found = s.paymentKeeper.GetAutoTopUp(ctx, paymentAccountAddr)
+	s.Require().False(found)
+
+	// the payment account is frozen without the auto top-up
+	receiver := sample.RandAccAddress()
+	s.paymentKeeper.SetOutFlow(ctx, paymentAccountAddr, &types.OutFlow{
+		ToAddress: receiver.String(),
+		Rate:      rate,
+		Status:    types.OUT_FLOW_STATUS_ACTIVE,
+	})
+	_, err = s.paymentKeeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(receiver).WithRateChange(rate))
+	s.Require().NoError(err)
+	streamRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, paymentAccountAddr)
+	ctx = ctx.WithBlockTime(time.Unix(streamRecord.SettleTimestamp, 0)).WithEventManager(sdk.NewEventManager()).
+		WithValue(types.ForceUpdateStreamRecordKey, true)
+	s.paymentKeeper.AutoSettle(ctx)
+	streamRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, paymentAccountAddr)
+	s.Require().Equal(types.STREAM_ACCOUNT_STATUS_FROZEN, streamRecord.Status)
+
+	// the frozen payment account is topped up at once, and resumed
+	duration := int64(params.VersionedParams.ReserveTime) + int64(params.ForcedSettleTime) + 2*int64(minRunway)
+	sourceRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, sourceAddr)
+	sourceRecord.StaticBalance = rate.MulRaw(duration)
+	s.paymentKeeper.SetStreamRecord(ctx, sourceRecord)
+	_, err = s.msgServer.EnableAutoTopUp(ctx, types.NewMsgEnableAutoTopUp(owner.String(),
+		paymentAccountAddr.String(), sourceAddr.String(), rate.MulRaw(duration), period, minRunway))
+	s.Require().NoError(err)
+	autoTopUp, _ = s.paymentKeeper.GetAutoTopUp(ctx, paymentAccountAddr)
+	s.Require().Equal(ctx.BlockTime().Unix(), autoTopUp.TopUpTimestamp)
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(1, countTopUps(ctx))
+	streamRecord, _ = s.paymentKeeper.GetStreamRecord(ctx, paymentAccountAddr)
+	s.Require().Equal(types.STREAM_ACCOUNT_STATUS_ACTIVE, streamRecord.Status)
+}
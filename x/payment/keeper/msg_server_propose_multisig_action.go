@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) ProposeMultisigAction(goCtx context.Context, msg *types.MsgProposeMultisigAction) (*types.MsgProposeMultisigActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, sdk.MustAccAddressFromHex(msg.PaymentAccount))
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if !paymentAccount.IsMultisigOwner(msg.Proposer) {
+		return nil, types.ErrNotMultisigOwner
+	}
+
+	proposal := &types.MultisigProposal{
+		PaymentAccount: paymentAccount.Addr,
+		Proposer:       msg.Proposer,
+		Action:         msg.Action,
+		To:             msg.To,
+		Amount:         msg.Amount,
+		Binder:         msg.Binder,
+		Allowed:        msg.Allowed,
+	}
+	id := k.Keeper.CreateMultisigProposal(ctx, proposal)
+	executed, err := k.approveMultisigProposal(ctx, paymentAccount, proposal, msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgProposeMultisigActionResponse{Id: id, Executed: executed}, nil
+}
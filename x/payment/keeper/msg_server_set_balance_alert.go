@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) SetBalanceAlert(goCtx context.Context, msg *types.MsgSetBalanceAlert) (*types.MsgSetBalanceAlertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.Addr)
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if paymentAccount.Owner != msg.Owner {
+		return nil, types.ErrNotPaymentAccountOwner
+	}
+
+	_, found = k.Keeper.GetBalanceAlert(ctx, addr)
+	if msg.Threshold == 0 {
+		if !found {
+			return nil, types.ErrBalanceAlertNotFound
+		}
+		k.Keeper.RemoveBalanceAlert(ctx, addr)
+		return &types.MsgSetBalanceAlertResponse{}, nil
+	}
+
+	// reset the balance alert, and schedule its warning by the current settle timestamp
+	k.Keeper.RemoveBalanceAlert(ctx, addr)
+	k.Keeper.SetBalanceAlert(ctx, &types.BalanceAlert{
+		Addr:      msg.Addr,
+		Threshold: msg.Threshold,
+	})
+	streamRecord, found := k.Keeper.GetStreamRecord(ctx, addr)
+	if found && streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE {
+		k.Keeper.UpdateBalanceAlert(ctx, addr, streamRecord.SettleTimestamp)
+	}
+	return &types.MsgSetBalanceAlertResponse{}, nil
+}
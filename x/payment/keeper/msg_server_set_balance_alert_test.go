@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestSetBalanceAlert() {
+	// the balance alerts are rescheduled and warned since Gobi
+	upgradeChecker := func(ctx sdk.Context, name string) bool { return name == gnfdtypes.Gobi }
+	s.ctx = sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, upgradeChecker, s.ctx.Logger()).
+		WithBlockTime(time.Now())
+	owner := sample.RandAccAddress()
+	_, err := s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	paymentAccountAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 0)
+
+	// only the owner of the payment account can set the balance alert
+	_, err = s.msgServer.SetBalanceAlert(s.ctx, types.NewMsgSetBalanceAlert(owner.String(), sample.RandAccAddress().String(), 100))
+	s.Require().ErrorIs(err, types.ErrPaymentAccountNotFound)
+	_, err = s.msgServer.SetBalanceAlert(s.ctx, types.NewMsgSetBalanceAlert(sample.RandAccAddress().String(), paymentAccountAddr.String(), 100))
+	s.Require().ErrorIs(err, types.ErrNotPaymentAccountOwner)
+	_, err = s.msgServer.SetBalanceAlert(s.ctx, types.NewMsgSetBalanceAlert(owner.String(), paymentAccountAddr.String(), 0))
+	s.Require().ErrorIs(err, types.ErrBalanceAlertNotFound)
+
+	// the payment account pays 100 per second, and is frozen after reserve time + 1000 seconds
+	params := s.paymentKeeper.GetParams(s.ctx)
+	rate := sdkmath.NewInt(100)
+	runway := int64(params.VersionedParams.ReserveTime) + 1000
+	balance := rate.MulRaw(int64(params.ForcedSettleTime) + runway)
+	streamRecord := types.NewStreamRecord(paymentAccountAddr, s.ctx.BlockTime().Unix())
+	streamRecord.OutFlowCount = 1
+	s.paymentKeeper.SetStreamRecord(s.ctx, streamRecord)
+	_, err = s.paymentKeeper.UpdateStreamRecordByAddr(s.ctx, types.NewDefaultStreamRecordChangeWithAddr(paymentAccountAddr).
+		WithStaticBalanceChange(balance).WithRateChange(rate.Neg()))
+	s.Require().NoError(err)
+
+	res, err := s.paymentKeeper.ProjectedFreezeTime(s.ctx, &types.QueryProjectedFreezeTimeRequest{Account: paymentAccountAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(runway, res.Runway)
+	s.Require().Equal(s.ctx.BlockTime().Unix()+runway, res.FreezeTimestamp)
+	s.Require().Nil(res.BalanceAlert)
+
+	threshold := uint64(500)
+	_, err = s.msgServer.SetBalanceAlert(s.ctx, types.NewMsgSetBalanceAlert(owner.String(), paymentAccountAddr.String(), threshold))
+	s.Require().NoError(err)
+	balanceAlert, found := s.paymentKeeper.GetBalanceAlert(s.ctx, paymentAccountAddr)
+	s.Require().True(found)
+	s.Require().Equal(res.FreezeTimestamp-int64(threshold), balanceAlert.WarnTimestamp)
+
+	countWarnings := func(ctx sdk.Context) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "greenfield.payment.EventLowBalanceWarning" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// no warning before the runway falls under the threshold
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(0, countWarnings(ctx))
+
+	// the warning is emitted only once
+	ctx = s.ctx.WithBlockTime(time.Unix(balanceAlert.WarnTimestamp, 0)).WithEventManager(sdk.NewEventManager())
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(1, countWarnings(ctx))
+	s.paymentKeeper.AutoSettle(ctx)
+	s.Require().Equal(1, countWarnings(ctx))
+	balanceAlert, _ = s.paymentKeeper.GetBalanceAlert(ctx, paymentAccountAddr)
+	s.Require().True(balanceAlert.Warned)
+
+	// the alert is rearmed once the runway is back above the threshold
+	_, err = s.paymentKeeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(paymentAccountAddr).
+		WithStaticBalanceChange(balance))
+	s.Require().NoError(err)
+	balanceAlert, _ = s.paymentKeeper.GetBalanceAlert(ctx, paymentAccountAddr)
+	s.Require().False(balanceAlert.Warned)
+	s.Require().Greater(balanceAlert.WarnTimestamp, ctx.BlockTime().Unix())
+
+	// remove the balance alert
+	_, err = s.msgServer.SetBalanceAlert(ctx, types.NewMsgSetBalanceAlert(owner.String(), paymentAccountAddr.String(), 0))
+	s.Require().NoError(err)
+	_, found = s.paymentKeeper.GetBalanceAlert(ctx, paymentAccountAddr)
+	s.Require().False(found)
+}
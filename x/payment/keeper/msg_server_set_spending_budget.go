@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) SetSpendingBudget(goCtx context.Context, msg *types.MsgSetSpendingBudget) (*types.MsgSetSpendingBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.Addr)
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if paymentAccount.Owner != msg.Owner {
+		return nil, types.ErrNotPaymentAccountOwner
+	}
+
+	spendingBudget, found := k.Keeper.GetSpendingBudget(ctx, addr)
+	if msg.MaxOutflowRate.IsZero() && msg.MaxSpendPerPeriod.IsZero() {
+		if !found {
+			return nil, types.ErrSpendingBudgetNotFound
+		}
+		k.Keeper.RemoveSpendingBudget(ctx, addr)
+		return &types.MsgSetSpendingBudgetResponse{}, nil
+	}
+
+	// the spent of the current period is kept unless the period is changed
+	now := ctx.BlockTime().Unix()
+	if found && spendingBudget.Period == msg.Period {
+		outflowRate := sdkmath.ZeroInt()
+		if streamRecord, found := k.Keeper.GetStreamRecord(ctx, addr); found {
+			outflowRate = getOutflowRate(streamRecord)
+		}
+		spendingBudget.Accrue(outflowRate, now)
+	} else {
+		periodStart, _ := msg.Period.PeriodRange(now)
+		spendingBudget = &types.SpendingBudget{
+			Addr:        msg.Addr,
+			Period:      msg.Period,
+			PeriodStart: periodStart,
+			Spent:       sdkmath.ZeroInt(),
+			LastUpdate:  now,
+		}
+	}
+	spendingBudget.MaxOutflowRate = msg.MaxOutflowRate
+	spendingBudget.MaxSpendPerPeriod = msg.MaxSpendPerPeriod
+	k.Keeper.SetSpendingBudget(ctx, spendingBudget)
+	return &types.MsgSetSpendingBudgetResponse{}, nil
+}
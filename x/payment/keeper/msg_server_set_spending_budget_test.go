@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestSetSpendingBudget() {
+	s.ctx = s.ctx.WithBlockTime(time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC))
+	owner := sample.RandAccAddress()
+	_, err := s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	paymentAccountAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 0)
+	streamRecord := types.NewStreamRecord(paymentAccountAddr, s.ctx.BlockTime().Unix())
+	streamRecord.StaticBalance = sdkmath.NewInt(1e15)
+	s.paymentKeeper.SetStreamRecord(s.ctx, streamRecord)
+
+	// only the owner of the payment account can set the spending budget
+	maxOutflowRate := sdkmath.NewInt(300)
+	secondsLeft := int64(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Sub(s.ctx.BlockTime()).Seconds())
+	maxSpend := sdkmath.NewInt(200).MulRaw(secondsLeft)
+	_, err = s.msgServer.SetSpendingBudget(s.ctx, types.NewMsgSetSpendingBudget(sample.RandAccAddress().String(),
+		paymentAccountAddr.String(), maxOutflowRate, maxSpend, types.SPENDING_PERIOD_MONTHLY))
+	s.Require().ErrorIs(err, types.ErrNotPaymentAccountOwner)
+	_, err = s.msgServer.SetSpendingBudget(s.ctx, types.NewMsgSetSpendingBudget(owner.String(),
+		paymentAccountAddr.String(), sdkmath.ZeroInt(), sdkmath.ZeroInt(), types.SPENDING_PERIOD_MONTHLY))
+	s.Require().ErrorIs(err, types.ErrSpendingBudgetNotFound)
+
+	_, err = s.msgServer.SetSpendingBudget(s.ctx, types.NewMsgSetSpendingBudget(owner.String(),
+		paymentAccountAddr.String(), maxOutflowRate, maxSpend, types.SPENDING_PERIOD_MONTHLY))
+	s.Require().NoError(err)
+
+	userFlows := func(rate int64) []types.UserFlows {
+		return []types.UserFlows{{
+			From:  paymentAccountAddr,
+			Flows: []types.OutFlow{{ToAddress: types.ValidatorTaxPoolAddress.String(), Rate: sdkmath.NewInt(rate)}},
+		}}
+	}
+
+	// the outflow rate is capped by the max outflow rate
+	err = s.paymentKeeper.ApplyUserFlowsList(s.ctx, userFlows(400))
+	s.Require().ErrorIs(err, types.ErrSpendingBudgetExceeded)
+	// the projected spend of the period is capped by the max spend
+	err = s.paymentKeeper.ApplyUserFlowsList(s.ctx, userFlows(250))
+	s.Require().ErrorIs(err, types.ErrSpendingBudgetExceeded)
+	err = s.paymentKeeper.ApplyUserFlowsList(s.ctx, userFlows(200))
+	s.Require().NoError(err)
+
+	// the spent is accrued, and the outflow rate can always be decreased
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	err = s.paymentKeeper.ApplyUserFlowsList(ctx, userFlows(1))
+	s.Require().ErrorIs(err, types.ErrSpendingBudgetExceeded)
+	err = s.paymentKeeper.ApplyUserFlowsList(ctx, userFlows(-100))
+	s.Require().NoError(err)
+	spendingBudget, found := s.paymentKeeper.GetSpendingBudget(ctx, paymentAccountAddr)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(200*86400), spendingBudget.Spent)
+
+	// the spent is accrued before any change of the outflow rate since Gobi
+	upgradeChecker := func(ctx sdk.Context, name string) bool { return name == gnfdtypes.Gobi }
+	ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, upgradeChecker, ctx.Logger()).
+		WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = s.paymentKeeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(paymentAccountAddr).
+		WithRateChange(sdkmath.NewInt(100)))
+	s.Require().NoError(err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err := s.paymentKeeper.SpendingBudget(ctx, &types.QuerySpendingBudgetRequest{Addr: paymentAccountAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(200*86400+100*3600), res.SpendingBudget.Spent)
+
+	// the spent is reset in a new period
+	ctx = ctx.WithBlockTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	res, err = s.paymentKeeper.SpendingBudget(ctx, &types.QuerySpendingBudgetRequest{Addr: paymentAccountAddr.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.SpendingBudget.Spent.IsZero())
+	s.Require().Equal(ctx.BlockTime().Unix(), res.SpendingBudget.PeriodStart)
+
+	// remove the spending budget
+	_, err = s.msgServer.SetSpendingBudget(ctx, types.NewMsgSetSpendingBudget(owner.String(),
+		paymentAccountAddr.String(), sdkmath.ZeroInt(), sdkmath.ZeroInt(), types.SPENDING_PERIOD_MONTHLY))
+	s.Require().NoError(err)
+	err = s.paymentKeeper.ApplyUserFlowsList(ctx, userFlows(400))
+	s.Require().NoError(err)
+}
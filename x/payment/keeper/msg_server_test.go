@@ -29,6 +29,7 @@ type TestSuite struct {
 
 	bankKeeper    *types.MockBankKeeper
 	accountKeeper *types.MockAccountKeeper
+	authzKeeper   *types.MockAuthzKeeper
 
 	ctx         sdk.Context
 	queryClient types.QueryClient
@@ -45,18 +46,21 @@ func (s *TestSuite) SetupTest() {
 
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	authzKeeper := types.NewMockAuthzKeeper(ctrl)
 
 	s.paymentKeeper = keeper.NewKeeper(
 		encCfg.Codec,
 		key,
 		bankKeeper,
 		accountKeeper,
+		authzKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	s.cdc = encCfg.Codec
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
+	s.authzKeeper = authzKeeper
 
 	err := s.paymentKeeper.SetParams(s.ctx, types.DefaultParams())
 	s.Require().NoError(err)
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) TransferPaymentAccountOwnership(goCtx context.Context, msg *types.MsgTransferPaymentAccountOwnership) (*types.MsgTransferPaymentAccountOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromHex(msg.Addr)
+	paymentAccount, found := k.Keeper.GetPaymentAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrPaymentAccountNotFound
+	}
+	if paymentAccount.Owner != msg.Owner {
+		return nil, types.ErrNotPaymentAccountOwner
+	}
+
+	// cancel the pending transfer
+	if msg.NewOwner == "" {
+		if _, found = k.Keeper.GetPaymentAccountTransfer(ctx, addr); !found {
+			return nil, types.ErrPaymentAccountTransferNotFound
+		}
+		k.Keeper.RemovePaymentAccountTransfer(ctx, addr)
+		return &types.MsgTransferPaymentAccountOwnershipResponse{}, nil
+	}
+
+	// the ownership is transferred once the new owner accepts it
+	k.Keeper.SetPaymentAccountTransfer(ctx, addr, sdk.MustAccAddressFromHex(msg.NewOwner))
+	return &types.MsgTransferPaymentAccountOwnershipResponse{}, nil
+}
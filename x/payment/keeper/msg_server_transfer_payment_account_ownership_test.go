@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/payment"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestTransferPaymentAccountOwnership() {
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+	owner := sample.RandAccAddress()
+	newOwner := sample.RandAccAddress()
+	_, err := s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	paymentAccountAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 0)
+	streamRecord := types.NewStreamRecord(paymentAccountAddr, s.ctx.BlockTime().Unix())
+	streamRecord.StaticBalance = sdkmath.NewInt(100)
+	s.paymentKeeper.SetStreamRecord(s.ctx, streamRecord)
+
+	// only the owner can transfer the payment account
+	_, err = s.msgServer.TransferPaymentAccountOwnership(s.ctx, types.NewMsgTransferPaymentAccountOwnership(
+		newOwner.String(), paymentAccountAddr.String(), newOwner.String()))
+	s.Require().ErrorIs(err, types.ErrNotPaymentAccountOwner)
+	_, err = s.msgServer.TransferPaymentAccountOwnership(s.ctx, types.NewMsgTransferPaymentAccountOwnership(
+		owner.String(), paymentAccountAddr.String(), newOwner.String()))
+	s.Require().NoError(err)
+
+	// only the pending new owner can accept it
+	_, err = s.msgServer.AcceptPaymentAccountOwnership(s.ctx, types.NewMsgAcceptPaymentAccountOwnership(
+		sample.RandAccAddress().String(), paymentAccountAddr.String()))
+	s.Require().ErrorIs(err, types.ErrPaymentAccountTransferNotFound)
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.AcceptPaymentAccountOwnership(ctx, types.NewMsgAcceptPaymentAccountOwnership(
+		newOwner.String(), paymentAccountAddr.String()))
+	s.Require().NoError(err)
+	s.Require().Equal("greenfield.payment.EventPaymentAccountUpdate", ctx.EventManager().Events()[0].Type)
+	_, err = s.msgServer.AcceptPaymentAccountOwnership(s.ctx, types.NewMsgAcceptPaymentAccountOwnership(
+		newOwner.String(), paymentAccountAddr.String()))
+	s.Require().ErrorIs(err, types.ErrPaymentAccountTransferNotFound)
+
+	// the stream record is kept, and the counts of both owners are updated
+	paymentAccount, _ := s.paymentKeeper.GetPaymentAccount(s.ctx, paymentAccountAddr)
+	s.Require().Equal(newOwner.String(), paymentAccount.Owner)
+	streamRecord, _ = s.paymentKeeper.GetStreamRecord(s.ctx, paymentAccountAddr)
+	s.Require().Equal(sdkmath.NewInt(100), streamRecord.StaticBalance)
+	ownerCount, _ := s.paymentKeeper.GetPaymentAccountCount(s.ctx, owner)
+	s.Require().Equal(uint64(1), ownerCount.Count)
+	newOwnerCount, _ := s.paymentKeeper.GetPaymentAccountCount(s.ctx, newOwner)
+	s.Require().Equal(uint64(1), newOwnerCount.Count)
+
+	// the addresses of the new payment accounts do not collide with the transferred one
+	_, err = s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(owner.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreatePaymentAccount(s.ctx, types.NewMsgCreatePaymentAccount(newOwner.String()))
+	s.Require().NoError(err)
+
+	res, err := s.paymentKeeper.PaymentAccountsByOwner(s.ctx, &types.QueryPaymentAccountsByOwnerRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{
+		s.paymentKeeper.DerivePaymentAccountAddress(owner, 1).String(),
+		s.paymentKeeper.DerivePaymentAccountAddress(owner, 2).String(),
+	}, res.PaymentAccounts)
+	res, err = s.paymentKeeper.PaymentAccountsByOwner(s.ctx, &types.QueryPaymentAccountsByOwnerRequest{Owner: newOwner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{
+		s.paymentKeeper.DerivePaymentAccountAddress(newOwner, 0).String(),
+		paymentAccountAddr.String(),
+	}, res.PaymentAccounts)
+
+	// the owner index and the pending transfers are exported in genesis
+	pendingAddr := s.paymentKeeper.DerivePaymentAccountAddress(owner, 1)
+	_, err = s.msgServer.TransferPaymentAccountOwnership(s.ctx, types.NewMsgTransferPaymentAccountOwnership(
+		owner.String(), pendingAddr.String(), newOwner.String()))
+	s.Require().NoError(err)
+	genesis := payment.ExportGenesis(s.ctx, *s.paymentKeeper)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Equal([]string{paymentAccountAddr.String()}, genesis.TransferredPaymentAccountList)
+	s.Require().Equal([]types.PaymentAccountTransfer{{Addr: pendingAddr.String(), NewOwner: newOwner.String()}},
+		genesis.PaymentAccountTransferList)
+	s.paymentKeeper.RemovePaymentAccountTransfer(s.ctx, pendingAddr)
+	payment.InitGenesis(s.ctx, *s.paymentKeeper, *genesis)
+	pendingOwner, found := s.paymentKeeper.GetPaymentAccountTransfer(s.ctx, pendingAddr)
+	s.Require().True(found)
+	s.Require().Equal(newOwner, pendingOwner)
+	_, err = s.msgServer.TransferPaymentAccountOwnership(s.ctx, types.NewMsgTransferPaymentAccountOwnership(
+		owner.String(), pendingAddr.String(), ""))
+	s.Require().NoError(err)
+
+	// transfer the payment account back to its creator
+	_, err = s.msgServer.TransferPaymentAccountOwnership(s.ctx, types.NewMsgTransferPaymentAccountOwnership(
+		newOwner.String(), paymentAccountAddr.String(), owner.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AcceptPaymentAccountOwnership(s.ctx, types.NewMsgAcceptPaymentAccountOwnership(
+		owner.String(), paymentAccountAddr.String()))
+	s.Require().NoError(err)
+	res, err = s.paymentKeeper.PaymentAccountsByOwner(s.ctx, &types.QueryPaymentAccountsByOwnerRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{
+		paymentAccountAddr.String(),
+		s.paymentKeeper.DerivePaymentAccountAddress(owner, 1).String(),
+		s.paymentKeeper.DerivePaymentAccountAddress(owner, 2).String(),
+	}, res.PaymentAccounts)
+}
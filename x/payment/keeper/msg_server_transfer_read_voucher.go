@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k msgServer) TransferReadVoucher(goCtx context.Context, msg *types.MsgTransferReadVoucher) (*types.MsgTransferReadVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	readVoucher, found := k.Keeper.GetReadVoucher(ctx, msg.Id)
+	if !found || readVoucher.Holder != msg.Holder {
+		return nil, types.ErrReadVoucherNotFound.Wrapf("read voucher %d of holder %s", msg.Id, msg.Holder)
+	}
+	if ctx.BlockTime().Unix() > readVoucher.ExpireTime {
+		return nil, types.ErrReadVoucherExpired.Wrapf("read voucher %d expired at %d", msg.Id, readVoucher.ExpireTime)
+	}
+	readVoucher.Holder = sdk.MustAccAddressFromHex(msg.To).String()
+	k.Keeper.SetReadVoucher(ctx, readVoucher)
+	return &types.MsgTransferReadVoucherResponse{}, nil
+}
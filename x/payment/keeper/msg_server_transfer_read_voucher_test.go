@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestTransferReadVoucher() {
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+	bucketId := sdkmath.NewUint(1)
+	issuer := sample.RandAccAddress()
+	holder := sample.RandAccAddress()
+	to := sample.RandAccAddress()
+	paymentAddr := sample.RandAccAddress()
+	receiver := sample.RandAccAddress()
+
+	id := s.paymentKeeper.CreateReadVoucher(ctx, &types.ReadVoucher{
+		Issuer:         issuer.String(),
+		BucketId:       bucketId,
+		Holder:         holder.String(),
+		ReadQuota:      100,
+		ExpireTime:     2000,
+		PaymentAddress: paymentAddr.String(),
+		Fee:            sdkmath.NewInt(1000),
+		TaxFee:         sdkmath.NewInt(100),
+	})
+	s.Require().Equal(uint64(1), id)
+
+	// only the holder can transfer the voucher
+	_, err := s.msgServer.TransferReadVoucher(ctx, types.NewMsgTransferReadVoucher(to.String(), id, holder.String()))
+	s.Require().ErrorIs(err, types.ErrReadVoucherNotFound)
+	_, err = s.msgServer.TransferReadVoucher(ctx, types.NewMsgTransferReadVoucher(holder.String(), id, to.String()))
+	s.Require().NoError(err)
+
+	res, err := s.paymentKeeper.ReadVouchersByHolder(ctx, &types.QueryReadVouchersByHolderRequest{Holder: holder.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.ReadVouchers, 0)
+	res, err = s.paymentKeeper.ReadVouchersByHolder(ctx, &types.QueryReadVouchersByHolderRequest{Holder: to.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.ReadVouchers, 1)
+	s.Require().Equal(to.String(), res.ReadVouchers[0].Holder)
+
+	// the voucher is redeemed by the bucket of it within the quota, with the next nonce
+	_, err = s.paymentKeeper.RedeemReadVoucher(ctx, id, sdkmath.NewUint(2), 10, 1, receiver)
+	s.Require().ErrorIs(err, types.ErrReadVoucherNotFound)
+	_, err = s.paymentKeeper.RedeemReadVoucher(ctx, id, bucketId, 101, 1, receiver)
+	s.Require().ErrorIs(err, types.ErrInsufficientReadVoucherQuota)
+	_, err = s.paymentKeeper.RedeemReadVoucher(ctx, id, bucketId, 60, 2, receiver)
+	s.Require().ErrorIs(err, types.ErrInvalidReadVoucherNonce)
+	readVoucher, err := s.paymentKeeper.RedeemReadVoucher(ctx, id, bucketId, 60, 1, receiver)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(60), readVoucher.UsedQuota)
+	s.Require().Equal(uint64(1), readVoucher.Nonce)
+	_, err = s.paymentKeeper.RedeemReadVoucher(ctx, id, bucketId, 10, 1, receiver)
+	s.Require().ErrorIs(err, types.ErrInvalidReadVoucherNonce)
+
+	// the prepaid fee and validator tax of the redeemed bytes are paid out
+	streamRecord, found := s.paymentKeeper.GetStreamRecord(ctx, receiver)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(600), streamRecord.StaticBalance)
+	streamRecord, found = s.paymentKeeper.GetStreamRecord(ctx, types.ValidatorTaxPoolAddress)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(60), streamRecord.StaticBalance)
+
+	// the expired voucher can not be redeemed or transferred, and is not listed
+	expiredCtx := ctx.WithBlockTime(time.Unix(2001, 0))
+	_, err = s.paymentKeeper.RedeemReadVoucher(expiredCtx, id, bucketId, 10, 2, receiver)
+	s.Require().ErrorIs(err, types.ErrReadVoucherExpired)
+	_, err = s.msgServer.TransferReadVoucher(expiredCtx, types.NewMsgTransferReadVoucher(to.String(), id, holder.String()))
+	s.Require().ErrorIs(err, types.ErrReadVoucherExpired)
+	res, err = s.paymentKeeper.ReadVouchersByHolder(expiredCtx, &types.QueryReadVouchersByHolderRequest{Holder: to.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.ReadVouchers, 0)
+
+	// the expired voucher is removed, and the unredeemed part is refunded to the payment account
+	s.paymentKeeper.ExpireReadVouchers(ctx)
+	_, found = s.paymentKeeper.GetReadVoucher(ctx, id)
+	s.Require().True(found)
+	s.paymentKeeper.ExpireReadVouchers(expiredCtx)
+	_, found = s.paymentKeeper.GetReadVoucher(ctx, id)
+	s.Require().False(found)
+	streamRecord, found = s.paymentKeeper.GetStreamRecord(ctx, paymentAddr)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(440), streamRecord.StaticBalance)
+
+	// the fully redeemed voucher is removed
+	id = s.paymentKeeper.CreateReadVoucher(ctx, &types.ReadVoucher{
+		Issuer:     issuer.String(),
+		BucketId:   bucketId,
+		Holder:     holder.String(),
+		ReadQuota:  100,
+		ExpireTime: 2000,
+	})
+	_, err = s.paymentKeeper.RedeemReadVoucher(ctx, id, bucketId, 100, 1, receiver)
+	s.Require().NoError(err)
+	_, found = s.paymentKeeper.GetReadVoucher(ctx, id)
+	s.Require().False(found)
+	s.paymentKeeper.ExpireReadVouchers(expiredCtx)
+}
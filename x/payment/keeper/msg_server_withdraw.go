@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -17,7 +18,16 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 
 	if ctx.IsUpgraded(upgradetypes.Nagqu) {
 		if msg.From == "" { // withdraw from the locked one
-			delayedWithdrawal, found := k.GetDelayedWithdrawalRecord(ctx, creator)
+			var delayedWithdrawal *types.DelayedWithdrawalRecord
+			var found bool
+			if !ctx.IsUpgraded(gnfdtypes.Gobi) {
+				// only a single delayed withdrawal is allowed before Gobi
+				delayedWithdrawal, found = k.GetDelayedWithdrawalRecord(ctx, creator, 0)
+			} else if msg.DelayedWithdrawalId == 0 {
+				delayedWithdrawal, found = k.GetEarliestDelayedWithdrawalRecord(ctx, creator)
+			} else {
+				delayedWithdrawal, found = k.GetDelayedWithdrawalRecord(ctx, creator, msg.DelayedWithdrawalId)
+			}
 			if !found {
 				return nil, errors.Wrapf(types.ErrNoDelayedWithdrawal, "delayed withdrawal not found %s", creator.String())
 			}
@@ -31,14 +41,14 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 				return nil, errors.Wrapf(types.ErrNotReachTimeLockDuration, "delayed withdrawal should be after %d", end)
 			}
 
-			k.RemoveDelayedWithdrawalRecord(ctx, creator)
+			k.RemoveDelayedWithdrawalRecord(ctx, creator, delayedWithdrawal.Id)
 			// withdraw it from module account directly
 			delayedFrom := sdk.MustAccAddressFromHex(delayedWithdrawal.From)
 			err := k.bankTransfer(ctx, creator, delayedFrom, msg.Amount)
 			if err != nil {
 				return nil, err
 			}
-			return &types.MsgWithdrawResponse{}, nil
+			return &types.MsgWithdrawResponse{DelayedWithdrawalId: delayedWithdrawal.Id}, nil
 		}
 	}
 
@@ -67,40 +77,50 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 			return nil, types.ErrPaymentAccountAlreadyNonRefundable
 		}
 	}
-	change := types.NewDefaultStreamRecordChangeWithAddr(from).WithStaticBalanceChange(msg.Amount.Neg())
-	err := k.UpdateStreamRecord(ctx, streamRecord, change)
+	delayedWithdrawalId, err := k.withdraw(ctx, creator, streamRecord, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
+	return &types.MsgWithdrawResponse{DelayedWithdrawalId: delayedWithdrawalId}, nil
+}
+
+// withdraw debits the amount from the stream record and sends it to the receiver, the withdrawal no less than the
+// time lock threshold is delayed and the id of the delayed withdrawal is returned.
+func (k msgServer) withdraw(ctx sdk.Context, receiver sdk.AccAddress, streamRecord *types.StreamRecord, amount math.Int) (uint64, error) {
+	from := sdk.MustAccAddressFromHex(streamRecord.Account)
+	change := types.NewDefaultStreamRecordChangeWithAddr(from).WithStaticBalanceChange(amount.Neg())
+	err := k.UpdateStreamRecord(ctx, streamRecord, change)
+	if err != nil {
+		return 0, err
+	}
 	k.SetStreamRecord(ctx, streamRecord)
 	if streamRecord.StaticBalance.IsNegative() {
-		return nil, errors.Wrapf(types.ErrInsufficientBalance, "static balance: %s after withdraw", streamRecord.StaticBalance)
+		return 0, errors.Wrapf(types.ErrInsufficientBalance, "static balance: %s after withdraw", streamRecord.StaticBalance)
 	}
 
 	if ctx.IsUpgraded(upgradetypes.Nagqu) {
 		params := k.GetParams(ctx)
-		if msg.Amount.GTE(*params.WithdrawTimeLockThreshold) {
-			// check whether there is delayed withdrawal, if there is delayed withdrawal, must withdraw it firstly
-			if _, found := k.GetDelayedWithdrawalRecord(ctx, creator); found {
-				return nil, errors.Wrapf(types.ErrExistsDelayedWithdrawal, "delayed withdrawal should be proceed firstly %s", creator.String())
-			}
+		if amount.GTE(*params.WithdrawTimeLockThreshold) {
 			delayedWithdrawal := &types.DelayedWithdrawalRecord{
-				Addr:            creator.String(),
-				Amount:          msg.Amount,
+				Addr:            receiver.String(),
+				Amount:          amount,
 				From:            from.String(),
 				UnlockTimestamp: ctx.BlockTime().Unix() + int64(params.WithdrawTimeLockDuration),
 			}
+			if ctx.IsUpgraded(gnfdtypes.Gobi) {
+				delayedWithdrawal.Id = k.nextDelayedWithdrawalId(ctx)
+			} else if _, found := k.GetDelayedWithdrawalRecord(ctx, receiver, 0); found {
+				// check whether there is delayed withdrawal, if there is delayed withdrawal, must withdraw it firstly
+				return 0, errors.Wrapf(types.ErrExistsDelayedWithdrawal, "delayed withdrawal should be proceed firstly %s", receiver.String())
+			}
 			k.SetDelayedWithdrawalRecord(ctx, delayedWithdrawal)
-			return &types.MsgWithdrawResponse{}, nil // user can query `DelayedWithdrawal` to find the details
+			// user can query `DelayedWithdrawals` to find the details
+			return delayedWithdrawal.Id, nil
 		}
 	}
 
 	// bank transfer
-	err = k.bankTransfer(ctx, creator, from, msg.Amount)
-	if err != nil {
-		return nil, err
-	}
-	return &types.MsgWithdrawResponse{}, nil
+	return 0, k.bankTransfer(ctx, receiver, from, amount)
 }
 
 func (k msgServer) bankTransfer(ctx sdk.Context, creator, from sdk.AccAddress, amount math.Int) error {
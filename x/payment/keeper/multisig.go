@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// CreateMultisigProposal assigns an id and an expire time to the proposal and stores it
+func (k Keeper) CreateMultisigProposal(ctx sdk.Context, proposal *types.MultisigProposal) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if b := store.Get(types.MultisigProposalIdKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	id++
+	store.Set(types.MultisigProposalIdKey, sdk.Uint64ToBigEndian(id))
+
+	proposal.Id = id
+	proposal.ExpireTime = ctx.BlockTime().Unix() + types.MultisigProposalLifetime
+	k.SetMultisigProposal(ctx, proposal)
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalExpiryPrefix)
+	expiryStore.Set(types.MultisigProposalExpiryKey(proposal.ExpireTime, sdk.MustAccAddressFromHex(proposal.PaymentAccount), id), []byte{0x00})
+	return id
+}
+
+// SetMultisigProposal set a specific multisig proposal in the store from its index
+func (k Keeper) SetMultisigProposal(ctx sdk.Context, proposal *types.MultisigProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalKeyPrefix)
+	key := types.MultisigProposalKey(sdk.MustAccAddressFromHex(proposal.PaymentAccount), proposal.Id)
+	store.Set(key, k.cdc.MustMarshal(proposal))
+}
+
+// GetMultisigProposal returns a multisig proposal from its index
+func (k Keeper) GetMultisigProposal(ctx sdk.Context, paymentAccount sdk.AccAddress, id uint64) (*types.MultisigProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalKeyPrefix)
+
+	b := store.Get(types.MultisigProposalKey(paymentAccount, id))
+	if b == nil {
+		return nil, false
+	}
+
+	var proposal types.MultisigProposal
+	k.cdc.MustUnmarshal(b, &proposal)
+	return &proposal, true
+}
+
+// RemoveMultisigProposal removes a multisig proposal with its entry in the expiry queue from the store
+func (k Keeper) RemoveMultisigProposal(ctx sdk.Context, proposal *types.MultisigProposal) {
+	paymentAccount := sdk.MustAccAddressFromHex(proposal.PaymentAccount)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalKeyPrefix)
+	store.Delete(types.MultisigProposalKey(paymentAccount, proposal.Id))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalExpiryPrefix)
+	expiryStore.Delete(types.MultisigProposalExpiryKey(proposal.ExpireTime, paymentAccount, proposal.Id))
+}
+
+// PruneExpiredMultisigProposals removes at most MaxExpiredMultisigProposalsPerBlock multisig proposals which are not
+// approved by threshold of the owners before they expire, the rest are removed in the following blocks.
+func (k Keeper) PruneExpiredMultisigProposals(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MultisigProposalExpiryPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().Unix())))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < types.MaxExpiredMultisigProposalsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		paymentAccount := sdk.AccAddress(key[8 : len(key)-8])
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+		proposal, found := k.GetMultisigProposal(ctx, paymentAccount, id)
+		if !found { // should not happen
+			ctx.Logger().Error("prune multisig proposal, proposal not found", "payment account", paymentAccount.String(), "id", id)
+			store.Delete(key)
+			continue
+		}
+		k.RemoveMultisigProposal(ctx, proposal)
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventMultisigProposalExpire{
+			Id:             id,
+			PaymentAccount: proposal.PaymentAccount,
+		})
+	}
+}
+
+// SetBucketBinder allows or disallows the binder to bind buckets to the multisig payment account
+func (k Keeper) SetBucketBinder(ctx sdk.Context, paymentAccount, binder sdk.AccAddress, allowed bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBinderKeyPrefix)
+	if allowed {
+		store.Set(types.BucketBinderKey(paymentAccount, binder), []byte{0x00})
+	} else {
+		store.Delete(types.BucketBinderKey(paymentAccount, binder))
+	}
+}
+
+// IsBucketBinder returns whether the binder is allowed to bind buckets to the multisig payment account
+func (k Keeper) IsBucketBinder(ctx sdk.Context, paymentAccount, binder sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketBinderKeyPrefix)
+	return store.Has(types.BucketBinderKey(paymentAccount, binder))
+}
@@ -23,6 +23,8 @@ func (k Keeper) SetPaymentAccount(ctx sdk.Context, paymentAccount *types.Payment
 		Addr:       addr,
 		Owner:      paymentAccount.Owner,
 		Refundable: paymentAccount.Refundable,
+		Owners:     paymentAccount.Owners,
+		Threshold:  paymentAccount.Threshold,
 	})
 }
 
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// SetPaymentAccountTransfer sets the pending new owner of a payment account
+func (k Keeper) SetPaymentAccountTransfer(ctx sdk.Context, addr, newOwner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountTransferPrefix)
+	store.Set(types.PaymentAccountTransferKey(addr), newOwner)
+}
+
+// GetPaymentAccountTransfer returns the pending new owner of a payment account
+func (k Keeper) GetPaymentAccountTransfer(ctx sdk.Context, addr sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountTransferPrefix)
+	b := store.Get(types.PaymentAccountTransferKey(addr))
+	if b == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(b), true
+}
+
+// RemovePaymentAccountTransfer removes the pending new owner of a payment account
+func (k Keeper) RemovePaymentAccountTransfer(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountTransferPrefix)
+	store.Delete(types.PaymentAccountTransferKey(addr))
+}
+
+// GetAllPaymentAccountTransfer returns all the pending transfers of payment accounts
+func (k Keeper) GetAllPaymentAccountTransfer(ctx sdk.Context) (list []types.PaymentAccountTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountTransferPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.PaymentAccountTransfer{
+			Addr:     sdk.AccAddress(iterator.Key()).String(),
+			NewOwner: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return
+}
+
+// SetPaymentAccountByOwner indexes the payment account by its owner, which is not the creator of it
+func (k Keeper) SetPaymentAccountByOwner(ctx sdk.Context, owner, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountByOwnerPrefix)
+	store.Set(types.PaymentAccountByOwnerKey(owner, addr), []byte{0x00})
+}
+
+// GetAllTransferredPaymentAccount returns all the payment accounts indexed by their owners
+func (k Keeper) GetAllTransferredPaymentAccount(ctx sdk.Context) (list []sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountByOwnerPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, sdk.AccAddress(iterator.Key()[sdk.EthAddressLength:]))
+	}
+	return
+}
+
+// TransferPaymentAccountOwnership transfers the payment account to the new owner. The address, the stream record and
+// the buckets bound to the payment account are kept, the payment account counts of both owners are updated.
+// The auto top-up of the payment account is removed since it is funded by the previous owner.
+func (k Keeper) TransferPaymentAccountOwnership(ctx sdk.Context, paymentAccount *types.PaymentAccount, newOwner sdk.AccAddress) error {
+	addr := sdk.MustAccAddressFromHex(paymentAccount.Addr)
+	owner := sdk.MustAccAddressFromHex(paymentAccount.Owner)
+
+	newOwnerCount, _ := k.GetPaymentAccountCount(ctx, newOwner)
+	params := k.GetParams(ctx)
+	if newOwnerCount.Count >= params.PaymentAccountCountLimit {
+		return errorsmod.Wrapf(types.ErrReachPaymentAccountLimit, "current count: %d, limit: %d", newOwnerCount.Count, params.PaymentAccountCountLimit)
+	}
+	k.SetPaymentAccountCount(ctx, &types.PaymentAccountCount{
+		Owner: newOwner.String(),
+		Count: newOwnerCount.Count + 1,
+	})
+	ownerCount, _ := k.GetPaymentAccountCount(ctx, owner)
+	k.SetPaymentAccountCount(ctx, &types.PaymentAccountCount{
+		Owner: owner.String(),
+		Count: ownerCount.Count - 1,
+	})
+
+	// the payment accounts not created by the owner are indexed by the owner
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountByOwnerPrefix)
+	store.Delete(types.PaymentAccountByOwnerKey(owner, addr))
+	if !k.isPaymentAccountCreator(ctx, addr, newOwner) {
+		k.SetPaymentAccountByOwner(ctx, newOwner, addr)
+	}
+
+	k.RemovePaymentAccountTransfer(ctx, addr)
+	k.RemoveAutoTopUp(ctx, addr)
+	paymentAccount.Owner = newOwner.String()
+	k.SetPaymentAccount(ctx, paymentAccount)
+	return nil
+}
+
+// GetPaymentAccountsByOwner returns the payment accounts owned by the owner, the ones created by the owner go first,
+// followed by the ones transferred to the owner. The multisig payment accounts created by the owner are owned by
+// themselves, they are listed with the created ones as they are counted for the owner.
+func (k Keeper) GetPaymentAccountsByOwner(ctx sdk.Context, owner sdk.AccAddress) []sdk.AccAddress {
+	var transferred []sdk.AccAddress
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PaymentAccountByOwnerPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, owner.Bytes())
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		transferred = append(transferred, sdk.AccAddress(iterator.Key()[len(owner):]))
+	}
+
+	// the created ones take the contiguous derived addresses from 0, the ones transferred to others are skipped
+	var paymentAccounts []sdk.AccAddress
+	for i := uint64(0); ; i++ {
+		addr := k.DerivePaymentAccountAddress(owner, i)
+		paymentAccount, found := k.GetPaymentAccount(ctx, addr)
+		if !found {
+			break
+		}
+		if paymentAccount.Owner != owner.String() && paymentAccount.Owner != paymentAccount.Addr {
+			continue
+		}
+		paymentAccounts = append(paymentAccounts, addr)
+	}
+	return append(paymentAccounts, transferred...)
+}
+
+// isPaymentAccountCreator returns whether the payment account is created by the owner
+func (k Keeper) isPaymentAccountCreator(ctx sdk.Context, addr, owner sdk.AccAddress) bool {
+	for i := uint64(0); ; i++ {
+		derived := k.DerivePaymentAccountAddress(owner, i)
+		if derived.Equals(addr) {
+			return true
+		}
+		if !k.IsPaymentAccount(ctx, derived) {
+			return false
+		}
+	}
+}
+
+// nextPaymentAccountAddress returns the first derived address of the owner which is not a payment account yet. The
+// payment accounts created by an owner always take the contiguous indexes from 0, but the count of the owner does not
+// match the number of them once the payment accounts are transferred, so the search starts from the count.
+func (k Keeper) nextPaymentAccountAddress(ctx sdk.Context, owner sdk.AccAddress, count uint64) sdk.AccAddress {
+	index := count
+	for index > 0 && !k.IsPaymentAccount(ctx, k.DerivePaymentAccountAddress(owner, index-1)) {
+		index--
+	}
+	for k.IsPaymentAccount(ctx, k.DerivePaymentAccountAddress(owner, index)) {
+		index++
+	}
+	return k.DerivePaymentAccountAddress(owner, index)
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// CreateReadVoucher assigns an id to the read voucher and stores it
+func (k Keeper) CreateReadVoucher(ctx sdk.Context, readVoucher *types.ReadVoucher) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if b := store.Get(types.ReadVoucherIdKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	id++
+	store.Set(types.ReadVoucherIdKey, sdk.Uint64ToBigEndian(id))
+
+	readVoucher.Id = id
+	k.SetReadVoucher(ctx, readVoucher)
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherExpiryPrefix)
+	expiryStore.Set(types.ReadVoucherExpiryKey(readVoucher.ExpireTime, id), []byte{0x00})
+	return id
+}
+
+// SetReadVoucher set a specific readVoucher in the store from its index, the fully redeemed one is removed
+func (k Keeper) SetReadVoucher(ctx sdk.Context, readVoucher *types.ReadVoucher) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherKeyPrefix)
+	holderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherByHolderPrefix)
+
+	// the holder index is moved if the voucher is transferred
+	if prev, found := k.GetReadVoucher(ctx, readVoucher.Id); found && prev.Holder != readVoucher.Holder {
+		holderStore.Delete(types.ReadVoucherByHolderKey(sdk.MustAccAddressFromHex(prev.Holder), readVoucher.Id))
+	}
+	holder := sdk.MustAccAddressFromHex(readVoucher.Holder)
+	if readVoucher.UsedQuota >= readVoucher.ReadQuota {
+		k.removeReadVoucher(ctx, readVoucher)
+	} else {
+		store.Set(types.ReadVoucherKey(readVoucher.Id), k.cdc.MustMarshal(readVoucher))
+		holderStore.Set(types.ReadVoucherByHolderKey(holder, readVoucher.Id), []byte{0x00})
+	}
+
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventReadVoucherUpdate{
+		Id:         readVoucher.Id,
+		Issuer:     readVoucher.Issuer,
+		BucketId:   readVoucher.BucketId,
+		Holder:     readVoucher.Holder,
+		ReadQuota:  readVoucher.ReadQuota,
+		UsedQuota:  readVoucher.UsedQuota,
+		ExpireTime: readVoucher.ExpireTime,
+	})
+}
+
+// GetReadVoucher returns a readVoucher from its index
+func (k Keeper) GetReadVoucher(
+	ctx sdk.Context,
+	id uint64,
+) (*types.ReadVoucher, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherKeyPrefix)
+
+	b := store.Get(types.ReadVoucherKey(id))
+	if b == nil {
+		return nil, false
+	}
+
+	var readVoucher types.ReadVoucher
+	k.cdc.MustUnmarshal(b, &readVoucher)
+	return &readVoucher, true
+}
+
+// removeReadVoucher removes the read voucher with its holder index and its entry in the expiry queue
+func (k Keeper) removeReadVoucher(ctx sdk.Context, readVoucher *types.ReadVoucher) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherKeyPrefix)
+	store.Delete(types.ReadVoucherKey(readVoucher.Id))
+	holderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherByHolderPrefix)
+	holderStore.Delete(types.ReadVoucherByHolderKey(sdk.MustAccAddressFromHex(readVoucher.Holder), readVoucher.Id))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherExpiryPrefix)
+	expiryStore.Delete(types.ReadVoucherExpiryKey(readVoucher.ExpireTime, readVoucher.Id))
+}
+
+// RedeemReadVoucher debits the read bytes from the read voucher of the bucket, the nonce must be the next one of the
+// voucher. The prepaid fee and validator tax of the read bytes are paid to the receiver and the validator tax pool.
+func (k Keeper) RedeemReadVoucher(ctx sdk.Context, id uint64, bucketId sdk.Uint, readBytes, nonce uint64,
+	receiver sdk.AccAddress) (*types.ReadVoucher, error) {
+	readVoucher, found := k.GetReadVoucher(ctx, id)
+	if !found || !readVoucher.BucketId.Equal(bucketId) {
+		return nil, types.ErrReadVoucherNotFound.Wrapf("read voucher %d of bucket %s", id, bucketId)
+	}
+	if ctx.BlockTime().Unix() > readVoucher.ExpireTime {
+		return nil, types.ErrReadVoucherExpired.Wrapf("read voucher %d expired at %d", id, readVoucher.ExpireTime)
+	}
+	if nonce != readVoucher.Nonce+1 {
+		return nil, types.ErrInvalidReadVoucherNonce.Wrapf("the next nonce of read voucher %d is %d", id, readVoucher.Nonce+1)
+	}
+	if readBytes > readVoucher.ReadQuota-readVoucher.UsedQuota {
+		return nil, types.ErrInsufficientReadVoucherQuota.Wrapf("read voucher %d has %d bytes left", id,
+			readVoucher.ReadQuota-readVoucher.UsedQuota)
+	}
+
+	fee := readVoucher.PaidFee(readVoucher.UsedQuota + readBytes).Sub(readVoucher.PaidFee(readVoucher.UsedQuota))
+	taxFee := readVoucher.PaidTaxFee(readVoucher.UsedQuota + readBytes).Sub(readVoucher.PaidTaxFee(readVoucher.UsedQuota))
+	if fee.IsPositive() {
+		_, err := k.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(receiver).WithStaticBalanceChange(fee))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if taxFee.IsPositive() {
+		_, err := k.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(types.ValidatorTaxPoolAddress).
+			WithStaticBalanceChange(taxFee))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	readVoucher.UsedQuota += readBytes
+	readVoucher.Nonce = nonce
+	k.SetReadVoucher(ctx, readVoucher)
+	return readVoucher, nil
+}
+
+// ExpireReadVouchers removes at most MaxExpiredReadVouchersPerBlock expired read vouchers, and refunds the unredeemed
+// part of the prepaid fee and validator tax of them to the payment accounts which prepaid them.
+func (k Keeper) ExpireReadVouchers(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadVoucherExpiryPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().Unix())))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < types.MaxExpiredReadVouchersPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		id := sdk.BigEndianToUint64(key[8:])
+		readVoucher, found := k.GetReadVoucher(ctx, id)
+		if !found { // should not happen
+			ctx.Logger().Error("expire read voucher, read voucher not found", "id", id)
+			store.Delete(key)
+			continue
+		}
+		k.removeReadVoucher(ctx, readVoucher)
+
+		refund := readVoucher.Unredeemed()
+		if refund.IsPositive() {
+			change := types.NewDefaultStreamRecordChangeWithAddr(sdk.MustAccAddressFromHex(readVoucher.PaymentAddress)).
+				WithStaticBalanceChange(refund)
+			_, err := k.UpdateStreamRecordByAddr(ctx, change)
+			if err != nil {
+				ctx.Logger().Error("expire read voucher, refund failed", "id", id, "err", err.Error())
+				panic("should not happen")
+			}
+		}
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventReadVoucherExpire{
+			Id:             id,
+			PaymentAddress: readVoucher.PaymentAddress,
+			Refund:         refund,
+		})
+	}
+}
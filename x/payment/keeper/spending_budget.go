@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// SetSpendingBudget set a specific spendingBudget in the store from its index
+func (k Keeper) SetSpendingBudget(ctx sdk.Context, spendingBudget *types.SpendingBudget) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendingBudgetKeyPrefix)
+	key := types.SpendingBudgetKey(
+		sdk.MustAccAddressFromHex(spendingBudget.Addr),
+	)
+
+	addr := spendingBudget.Addr
+	spendingBudget.Addr = ""
+	store.Set(key, k.cdc.MustMarshal(spendingBudget))
+
+	spendingBudget.Addr = addr
+}
+
+// GetSpendingBudget returns a spendingBudget from its index
+func (k Keeper) GetSpendingBudget(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) (*types.SpendingBudget, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendingBudgetKeyPrefix)
+
+	b := store.Get(types.SpendingBudgetKey(
+		addr,
+	))
+	if b == nil {
+		return nil, false
+	}
+
+	spendingBudget := &types.SpendingBudget{}
+	k.cdc.MustUnmarshal(b, spendingBudget)
+	spendingBudget.Addr = addr.String()
+	return spendingBudget, true
+}
+
+// RemoveSpendingBudget removes a spendingBudget from the store
+func (k Keeper) RemoveSpendingBudget(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendingBudgetKeyPrefix)
+	store.Delete(types.SpendingBudgetKey(
+		addr,
+	))
+}
+
+// getOutflowRate returns the total outflow rate of the stream account
+func getOutflowRate(streamRecord *types.StreamRecord) sdkmath.Int {
+	if !streamRecord.NetflowRate.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return streamRecord.NetflowRate.Neg()
+}
+
+// accrueSpendingBudget accrues the spent of the spending budget of the stream account, if any, at the current outflow
+// rate. It should be called before any change of the netflow rate of the stream account.
+func (k Keeper) accrueSpendingBudget(ctx sdk.Context, streamRecord *types.StreamRecord) {
+	spendingBudget, found := k.GetSpendingBudget(ctx, sdk.MustAccAddressFromHex(streamRecord.Account))
+	if !found {
+		return
+	}
+	spendingBudget.Accrue(getOutflowRate(streamRecord), ctx.BlockTime().Unix())
+	k.SetSpendingBudget(ctx, spendingBudget)
+}
+
+// applySpendingBudget accrues the spent of the spending budget of the stream account, if any, and rejects the rate
+// change which would increase the outflow rate past the budget.
+func (k Keeper) applySpendingBudget(ctx sdk.Context, streamRecord *types.StreamRecord, rateChange sdkmath.Int) error {
+	addr := sdk.MustAccAddressFromHex(streamRecord.Account)
+	spendingBudget, found := k.GetSpendingBudget(ctx, addr)
+	if !found {
+		return nil
+	}
+
+	outflowRate := getOutflowRate(streamRecord)
+	spendingBudget.Accrue(outflowRate, ctx.BlockTime().Unix())
+	if rateChange.IsPositive() {
+		err := spendingBudget.CheckOutflowRate(outflowRate.Add(rateChange))
+		if err != nil {
+			return err
+		}
+	}
+	k.SetSpendingBudget(ctx, spendingBudget)
+	return nil
+}
@@ -82,6 +82,10 @@ func (k Keeper) applyActiveUserFlows(ctx sdk.Context, userFlows types.UserFlows,
 		totalRate = totalRate.Add(flowChange.Rate)
 	}
 	streamRecordChange := types.NewDefaultStreamRecordChangeWithAddr(from).WithRateChange(totalRate.Neg())
+	err := k.applySpendingBudget(ctx, streamRecord, totalRate)
+	if err != nil {
+		return err
+	}
 	// storage fee preview
 	if ctx.IsCheckTx() {
 		reserveTime := k.GetParams(ctx).VersionedParams.ReserveTime
@@ -97,7 +101,7 @@ func (k Keeper) applyActiveUserFlows(ctx sdk.Context, userFlows types.UserFlows,
 		}
 		_ = ctx.EventManager().EmitTypedEvents(event)
 	}
-	err := k.UpdateStreamRecord(ctx, streamRecord, streamRecordChange)
+	err = k.UpdateStreamRecord(ctx, streamRecord, streamRecordChange)
 	if err != nil {
 		return fmt.Errorf("apply stream record changes for user failed: %w", err)
 	}
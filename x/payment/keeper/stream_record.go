@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -142,6 +143,9 @@ func (k Keeper) UpdateFrozenStreamRecord(ctx sdk.Context, streamRecord *types.St
 }
 
 func (k Keeper) UpdateStreamRecord(ctx sdk.Context, streamRecord *types.StreamRecord, change *types.StreamRecordChange) error {
+	if ctx.IsUpgraded(gnfdtypes.Gobi) && !change.RateChange.IsZero() {
+		k.accrueSpendingBudget(ctx, streamRecord)
+	}
 	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
 		return k.UpdateFrozenStreamRecord(ctx, streamRecord, change)
 	}
@@ -226,7 +230,13 @@ func (k Keeper) UpdateStreamRecord(ctx sdk.Context, streamRecord *types.StreamRe
 		settleTimestamp = currentTimestamp - int64(params.ForcedSettleTime) + payDuration.Int64()
 	}
 	k.UpdateAutoSettleRecord(ctx, sdk.MustAccAddressFromHex(streamRecord.Account), streamRecord.SettleTimestamp, settleTimestamp)
+	if ctx.IsUpgraded(gnfdtypes.Gobi) {
+		k.UpdateBalanceAlert(ctx, sdk.MustAccAddressFromHex(streamRecord.Account), settleTimestamp)
+	}
 	streamRecord.SettleTimestamp = settleTimestamp
+	if ctx.IsUpgraded(gnfdtypes.Gobi) {
+		k.UpdateAutoTopUp(ctx, streamRecord)
+	}
 	return nil
 }
 
@@ -252,6 +262,7 @@ func (k Keeper) ForceSettle(ctx sdk.Context, streamRecord *types.StreamRecord) e
 	streamRecord.StaticBalance = sdkmath.ZeroInt()
 	streamRecord.BufferBalance = sdkmath.ZeroInt()
 	streamRecord.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
+	k.setStreamRecordFrozen(ctx, sdk.MustAccAddressFromHex(streamRecord.Account), true)
 	// emit event
 	_ = ctx.EventManager().EmitTypedEvents(&types.EventForceSettle{
 		Addr:           streamRecord.Account,
@@ -261,6 +272,11 @@ func (k Keeper) ForceSettle(ctx sdk.Context, streamRecord *types.StreamRecord) e
 }
 
 func (k Keeper) AutoSettle(ctx sdk.Context) {
+	if ctx.IsUpgraded(gnfdtypes.Gobi) {
+		k.TopUpPaymentAccounts(ctx)
+		k.WarnLowBalances(ctx)
+	}
+
 	currentTimestamp := ctx.BlockTime().Unix()
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoSettleRecordKeyPrefix)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
@@ -351,6 +367,9 @@ func (k Keeper) AutoSettle(ctx sdk.Context) {
 			k.SetOutFlow(ctx, addr, &outFlow)
 		}
 
+		if ctx.IsUpgraded(gnfdtypes.Gobi) && !totalRate.IsZero() {
+			k.accrueSpendingBudget(ctx, streamRecord)
+		}
 		streamRecord.NetflowRate = streamRecord.NetflowRate.Add(totalRate)
 		streamRecord.FrozenNetflowRate = streamRecord.FrozenNetflowRate.Add(totalRate.Neg())
 
@@ -360,6 +379,9 @@ func (k Keeper) AutoSettle(ctx sdk.Context) {
 				panic("should not happen")
 			}
 			k.RemoveAutoSettleRecord(ctx, record.Timestamp, addr)
+			if ctx.IsUpgraded(gnfdtypes.Gobi) {
+				k.UpdateAutoTopUp(ctx, streamRecord)
+			}
 		}
 
 		k.SetStreamRecord(ctx, streamRecord)
@@ -398,11 +420,15 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 
 	ctx.Logger().Debug("try to resume stream account", "streamRecord.OutFlowCount", streamRecord.OutFlowCount, "params.MaxAutoResumeFlowCount", params.MaxAutoResumeFlowCount)
 	if streamRecord.OutFlowCount <= params.MaxAutoResumeFlowCount { //only rough judgement, resume directly
+		if ctx.IsUpgraded(gnfdtypes.Gobi) {
+			k.accrueSpendingBudget(ctx, streamRecord)
+		}
 		streamRecord.Status = types.STREAM_ACCOUNT_STATUS_ACTIVE
 		streamRecord.NetflowRate = totalRate
 		streamRecord.FrozenNetflowRate = sdkmath.ZeroInt()
 
 		addr := sdk.MustAccAddressFromHex(streamRecord.Account)
+		k.setStreamRecordFrozen(ctx, addr, false)
 		frozenFlowKey := types.OutFlowKey(addr, types.OUT_FLOW_STATUS_FROZEN, nil)
 		flowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowKeyPrefix)
 		flowIterator := flowStore.Iterator(frozenFlowKey, nil)
@@ -435,6 +461,10 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 
 		k.SetStreamRecord(ctx, streamRecord)
 		k.UpdateAutoSettleRecord(ctx, sdk.MustAccAddressFromHex(streamRecord.Account), prevSettleTime, streamRecord.SettleTimestamp)
+		if ctx.IsUpgraded(gnfdtypes.Gobi) {
+			k.UpdateBalanceAlert(ctx, addr, streamRecord.SettleTimestamp)
+			k.UpdateAutoTopUp(ctx, streamRecord)
+		}
 		return nil
 	} else { //enqueue for resume in end block
 		k.SetStreamRecord(ctx, streamRecord)
@@ -508,6 +538,9 @@ func (k Keeper) AutoResume(ctx sdk.Context) {
 			k.SetOutFlow(ctx, addr, &outFlow)
 		}
 
+		if ctx.IsUpgraded(gnfdtypes.Gobi) && !totalRate.IsZero() {
+			k.accrueSpendingBudget(ctx, streamRecord)
+		}
 		streamRecord.NetflowRate = streamRecord.NetflowRate.Add(totalRate.Neg())
 		streamRecord.FrozenNetflowRate = streamRecord.FrozenNetflowRate.Add(totalRate)
 		if !flowIterator.Valid() || finished {
@@ -516,6 +549,7 @@ func (k Keeper) AutoResume(ctx sdk.Context) {
 				panic("should not happen")
 			}
 			streamRecord.Status = types.STREAM_ACCOUNT_STATUS_ACTIVE
+			k.setStreamRecordFrozen(ctx, addr, false)
 			change := types.NewDefaultStreamRecordChangeWithAddr(addr)
 			err := k.UpdateStreamRecord(ctx, streamRecord, change)
 			if err != nil {
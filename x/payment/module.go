@@ -14,6 +14,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/client/cli"
 	"github.com/bnb-chain/greenfield/x/payment/keeper"
 	"github.com/bnb-chain/greenfield/x/payment/types"
@@ -158,5 +159,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	ctx = ctx.WithValue(types.ForceUpdateStreamRecordKey, true)
 	am.keeper.AutoResume(ctx)
 	am.keeper.AutoSettle(ctx)
+	if ctx.IsUpgraded(gnfdtypes.Gobi) {
+		am.keeper.ExpireReadVouchers(ctx)
+		am.keeper.PruneExpiredMultisigProposals(ctx)
+	}
 	return []abci.ValidatorUpdate{}
 }
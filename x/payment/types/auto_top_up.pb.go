@@ -0,0 +1,629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/auto_top_up.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AutoTopUp is the auto top-up config of a payment account
+type AutoTopUp struct {
+	// addr is the address of the payment account to top up
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// source is the address to top up from, either the owner or another payment account of the owner
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// cap_per_period is the max amount to top up in a period
+	CapPerPeriod github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=cap_per_period,json=capPerPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap_per_period"`
+	// period is the length of a period in seconds
+	Period uint64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	// min_runway is the runway in seconds to top up under, every top-up extends the runway by min_runway
+	MinRunway uint64 `protobuf:"varint,5,opt,name=min_runway,json=minRunway,proto3" json:"min_runway,omitempty"`
+	// top_up_timestamp is the unix timestamp when the runway falls under min_runway,
+	// 0 means the payment account is not going to be frozen
+	TopUpTimestamp int64 `protobuf:"varint,6,opt,name=top_up_timestamp,json=topUpTimestamp,proto3" json:"top_up_timestamp,omitempty"`
+	// period_start is the unix timestamp when the current period starts
+	PeriodStart int64 `protobuf:"varint,7,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// topped_up is the amount topped up in the current period
+	ToppedUp github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=topped_up,json=toppedUp,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"topped_up"`
+}
+
+func (m *AutoTopUp) Reset()         { *m = AutoTopUp{} }
+func (m *AutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*AutoTopUp) ProtoMessage()    {}
+func (*AutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3595223d348b5aa3, []int{0}
+}
+func (m *AutoTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoTopUp.Merge(m, src)
+}
+func (m *AutoTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoTopUp proto.InternalMessageInfo
+
+func (m *AutoTopUp) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *AutoTopUp) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *AutoTopUp) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *AutoTopUp) GetMinRunway() uint64 {
+	if m != nil {
+		return m.MinRunway
+	}
+	return 0
+}
+
+func (m *AutoTopUp) GetTopUpTimestamp() int64 {
+	if m != nil {
+		return m.TopUpTimestamp
+	}
+	return 0
+}
+
+func (m *AutoTopUp) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AutoTopUp)(nil), "greenfield.payment.AutoTopUp")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/auto_top_up.proto", fileDescriptor_3595223d348b5aa3)
+}
+
+var fileDescriptor_3595223d348b5aa3 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x6e, 0xd4, 0x30,
+	0x18, 0xc7, 0x2f, 0xdc, 0x11, 0x1a, 0x53, 0x55, 0xc8, 0xaa, 0x90, 0xa9, 0x44, 0x7a, 0x20, 0x84,
+	0x32, 0x90, 0x04, 0x89, 0x95, 0xa5, 0xdd, 0x6e, 0xab, 0xd2, 0x76, 0x80, 0xc5, 0x72, 0x62, 0x93,
+	0x5a, 0x10, 0xdb, 0xb2, 0xbf, 0x08, 0xee, 0x2d, 0x78, 0x12, 0xa6, 0x3e, 0x44, 0xc7, 0xaa, 0x13,
+	0x62, 0xa8, 0xd0, 0xdd, 0x8b, 0xa0, 0xd8, 0x06, 0xba, 0xb1, 0x30, 0x44, 0xf1, 0xf7, 0xf7, 0xcf,
+	0xff, 0xcf, 0xfe, 0xf4, 0x47, 0x2f, 0x7a, 0x2b, 0x84, 0xfa, 0x20, 0xc5, 0x27, 0x5e, 0x1b, 0xb6,
+	0x1e, 0x84, 0x82, 0x9a, 0x8d, 0xa0, 0x29, 0x68, 0x43, 0x47, 0x53, 0x19, 0xab, 0x41, 0x63, 0xfc,
+	0x97, 0xaa, 0x22, 0x75, 0xf0, 0xa4, 0xd3, 0x6e, 0xd0, 0x8e, 0x7a, 0xa2, 0x0e, 0x45, 0xc0, 0x0f,
+	0xf6, 0x7b, 0xdd, 0xeb, 0xa0, 0x4f, 0xab, 0xa0, 0x3e, 0xff, 0x36, 0x47, 0xd9, 0xd1, 0x08, 0xfa,
+	0x4c, 0x9b, 0x73, 0x83, 0x5f, 0xa1, 0x05, 0xe3, 0xdc, 0x92, 0x64, 0x99, 0x14, 0xd9, 0x31, 0xb9,
+	0xb9, 0x2c, 0xf7, 0xa3, 0xc7, 0x11, 0xe7, 0x56, 0x38, 0x77, 0x0a, 0x56, 0xaa, 0xbe, 0xf1, 0x14,
+	0x7e, 0x8d, 0x52, 0xa7, 0x47, 0xdb, 0x09, 0x72, 0xef, 0x1f, 0x7c, 0xe4, 0x70, 0x8b, 0xf6, 0x3a,
+	0x66, 0xa8, 0x11, 0x76, 0xfa, 0xa4, 0xe6, 0x64, 0xee, 0x4f, 0xbe, 0xbd, 0xba, 0x3d, 0x9c, 0xfd,
+	0xb8, 0x3d, 0x7c, 0xd9, 0x4b, 0xb8, 0x18, 0xdb, 0xaa, 0xd3, 0x43, 0xbc, 0x7c, 0xfc, 0x95, 0x8e,
+	0x7f, 0xac, 0x61, 0x6d, 0x84, 0xab, 0x56, 0x0a, 0x6e, 0x2e, 0x4b, 0x14, 0xfb, 0xac, 0x14, 0x34,
+	0xbb, 0x1d, 0x33, 0x27, 0xc2, 0x9e, 0x78, 0x47, 0xfc, 0x18, 0xa5, 0xd1, 0x7b, 0xb1, 0x4c, 0x8a,
+	0x45, 0x13, 0x2b, 0xfc, 0x14, 0xa1, 0x41, 0x2a, 0x6a, 0x47, 0xf5, 0x99, 0xad, 0xc9, 0x7d, 0xbf,
+	0x97, 0x0d, 0x52, 0x35, 0x5e, 0xc0, 0x05, 0x7a, 0x14, 0xa6, 0x4b, 0x41, 0x0e, 0xc2, 0x01, 0x1b,
+	0x0c, 0x49, 0x97, 0x49, 0x31, 0x6f, 0xf6, 0x60, 0x9a, 0xcd, 0xd9, 0x6f, 0x15, 0x3f, 0x43, 0xbb,
+	0xc1, 0x92, 0x3a, 0x60, 0x16, 0xc8, 0x03, 0x4f, 0x3d, 0x0c, 0xda, 0xe9, 0x24, 0xe1, 0x77, 0x28,
+	0x03, 0x6d, 0x8c, 0xe0, 0x74, 0x34, 0x64, 0xe7, 0x3f, 0x3c, 0x71, 0x27, 0xd8, 0x9d, 0x9b, 0xe3,
+	0xd5, 0xd5, 0x26, 0x4f, 0xae, 0x37, 0x79, 0xf2, 0x73, 0x93, 0x27, 0x5f, 0xb7, 0xf9, 0xec, 0x7a,
+	0x9b, 0xcf, 0xbe, 0x6f, 0xf3, 0xd9, 0xfb, 0xfa, 0x8e, 0x73, 0xab, 0xda, 0xb2, 0xbb, 0x60, 0x52,
+	0xd5, 0x77, 0xa2, 0xf4, 0xe5, 0x4f, 0x98, 0x7c, 0x9b, 0x36, 0xf5, 0x11, 0x78, 0xf3, 0x2b, 0x00,
+	0x00, 0xff, 0xff, 0x2c, 0xe8, 0xbd, 0x48, 0x6f, 0x02, 0x00, 0x00,
+}
+
+func (m *AutoTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ToppedUp.Size()
+		i -= size
+		if _, err := m.ToppedUp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.PeriodStart != 0 {
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TopUpTimestamp != 0 {
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(m.TopUpTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinRunway != 0 {
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(m.MinRunway))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Period != 0 {
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CapPerPeriod.Size()
+		i -= size
+		if _, err := m.CapPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAutoTopUp(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutoTopUp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutoTopUp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAutoTopUp(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAutoTopUp(uint64(l))
+	}
+	l = m.CapPerPeriod.Size()
+	n += 1 + l + sovAutoTopUp(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovAutoTopUp(uint64(m.Period))
+	}
+	if m.MinRunway != 0 {
+		n += 1 + sovAutoTopUp(uint64(m.MinRunway))
+	}
+	if m.TopUpTimestamp != 0 {
+		n += 1 + sovAutoTopUp(uint64(m.TopUpTimestamp))
+	}
+	if m.PeriodStart != 0 {
+		n += 1 + sovAutoTopUp(uint64(m.PeriodStart))
+	}
+	l = m.ToppedUp.Size()
+	n += 1 + l + sovAutoTopUp(uint64(l))
+	return n
+}
+
+func sovAutoTopUp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutoTopUp(x uint64) (n int) {
+	return sovAutoTopUp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoTopUp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapPerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CapPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRunway", wireType)
+			}
+			m.MinRunway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRunway |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpTimestamp", wireType)
+			}
+			m.TopUpTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopUpTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			m.PeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToppedUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToppedUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoTopUp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoTopUp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutoTopUp(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutoTopUp
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoTopUp
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutoTopUp
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutoTopUp
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutoTopUp
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutoTopUp        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutoTopUp          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutoTopUp = fmt.Errorf("proto: unexpected end of group")
+)
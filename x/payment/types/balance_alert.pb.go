@@ -0,0 +1,441 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/balance_alert.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BalanceAlert is the low balance alert of a payment account
+type BalanceAlert struct {
+	// addr is the address of the payment account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// threshold is the runway in seconds, the low balance warning is emitted once the runway falls under it
+	Threshold uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// warn_timestamp is the unix timestamp when the runway falls under the threshold,
+	// 0 means the payment account is not going to be frozen
+	WarnTimestamp int64 `protobuf:"varint,3,opt,name=warn_timestamp,json=warnTimestamp,proto3" json:"warn_timestamp,omitempty"`
+	// warned defines whether the warning has been emitted, it is reset once the runway is back above the threshold
+	Warned bool `protobuf:"varint,4,opt,name=warned,proto3" json:"warned,omitempty"`
+}
+
+func (m *BalanceAlert) Reset()         { *m = BalanceAlert{} }
+func (m *BalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*BalanceAlert) ProtoMessage()    {}
+func (*BalanceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffb8fa8b511f1df, []int{0}
+}
+func (m *BalanceAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceAlert.Merge(m, src)
+}
+func (m *BalanceAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceAlert proto.InternalMessageInfo
+
+func (m *BalanceAlert) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *BalanceAlert) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *BalanceAlert) GetWarnTimestamp() int64 {
+	if m != nil {
+		return m.WarnTimestamp
+	}
+	return 0
+}
+
+func (m *BalanceAlert) GetWarned() bool {
+	if m != nil {
+		return m.Warned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*BalanceAlert)(nil), "greenfield.payment.BalanceAlert")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/balance_alert.proto", fileDescriptor_dffb8fa8b511f1df)
+}
+
+var fileDescriptor_dffb8fa8b511f1df = []byte{
+	// 270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcd, 0x4a, 0xc4, 0x30,
+	0x14, 0x85, 0x27, 0x4e, 0x19, 0x9c, 0xa0, 0x2e, 0x82, 0x48, 0x15, 0x09, 0x45, 0x50, 0xba, 0x70,
+	0x9a, 0x85, 0x4f, 0x30, 0xdd, 0xb9, 0xad, 0xae, 0xdc, 0x94, 0xb4, 0xb9, 0xb6, 0x85, 0x26, 0x29,
+	0x49, 0x44, 0xe7, 0x2d, 0xdc, 0xfa, 0x1e, 0x3e, 0x84, 0xcb, 0xc1, 0x95, 0x4b, 0x69, 0x5f, 0x44,
+	0xfa, 0xa3, 0xe3, 0xf2, 0x7c, 0x7c, 0xf7, 0xc0, 0x3d, 0xf8, 0xaa, 0x30, 0x00, 0xea, 0xb1, 0x82,
+	0x5a, 0xb0, 0x86, 0x6f, 0x24, 0x28, 0xc7, 0x32, 0x5e, 0x73, 0x95, 0x43, 0xca, 0x6b, 0x30, 0x2e,
+	0x6a, 0x8c, 0x76, 0x9a, 0x90, 0x9d, 0x17, 0x4d, 0xde, 0xd9, 0x69, 0xae, 0xad, 0xd4, 0x36, 0x1d,
+	0x0c, 0x36, 0x86, 0x51, 0xbf, 0x78, 0x43, 0xf8, 0x20, 0x1e, 0x6b, 0xd6, 0x7d, 0x0b, 0xb9, 0xc6,
+	0x1e, 0x17, 0xc2, 0xf8, 0x28, 0x40, 0xe1, 0x32, 0xf6, 0x3f, 0xdf, 0x57, 0xc7, 0xd3, 0xc1, 0x5a,
+	0x08, 0x03, 0xd6, 0xde, 0x39, 0x53, 0xa9, 0x22, 0x19, 0x2c, 0x72, 0x8e, 0x97, 0xae, 0x34, 0x60,
+	0x4b, 0x5d, 0x0b, 0x7f, 0x2f, 0x40, 0xa1, 0x97, 0xec, 0x00, 0xb9, 0xc4, 0x47, 0xcf, 0xdc, 0xa8,
+	0xd4, 0x55, 0x12, 0xac, 0xe3, 0xb2, 0xf1, 0xe7, 0x01, 0x0a, 0xe7, 0xc9, 0x61, 0x4f, 0xef, 0x7f,
+	0x21, 0x39, 0xc1, 0x8b, 0x1e, 0x80, 0xf0, 0xbd, 0x00, 0x85, 0xfb, 0xc9, 0x94, 0xe2, 0xdb, 0x8f,
+	0x96, 0xa2, 0x6d, 0x4b, 0xd1, 0x77, 0x4b, 0xd1, 0x6b, 0x47, 0x67, 0xdb, 0x8e, 0xce, 0xbe, 0x3a,
+	0x3a, 0x7b, 0x60, 0x45, 0xe5, 0xca, 0xa7, 0x2c, 0xca, 0xb5, 0x64, 0x99, 0xca, 0x56, 0x79, 0xc9,
+	0x2b, 0xc5, 0xfe, 0x2d, 0xf4, 0xf2, 0xb7, 0x91, 0xdb, 0x34, 0x60, 0xb3, 0xc5, 0xf0, 0xed, 0xcd,
+	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x4a, 0xc4, 0x7f, 0x46, 0x01, 0x00, 0x00,
+}
+
+func (m *BalanceAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Warned {
+		i--
+		if m.Warned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WarnTimestamp != 0 {
+		i = encodeVarintBalanceAlert(dAtA, i, uint64(m.WarnTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintBalanceAlert(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintBalanceAlert(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBalanceAlert(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBalanceAlert(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BalanceAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovBalanceAlert(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovBalanceAlert(uint64(m.Threshold))
+	}
+	if m.WarnTimestamp != 0 {
+		n += 1 + sovBalanceAlert(uint64(m.WarnTimestamp))
+	}
+	if m.Warned {
+		n += 2
+	}
+	return n
+}
+
+func sovBalanceAlert(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBalanceAlert(x uint64) (n int) {
+	return sovBalanceAlert(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BalanceAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalanceAlert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalanceAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarnTimestamp", wireType)
+			}
+			m.WarnTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarnTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Warned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalanceAlert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalanceAlert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBalanceAlert(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBalanceAlert
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBalanceAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBalanceAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBalanceAlert
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBalanceAlert
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBalanceAlert
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBalanceAlert        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBalanceAlert          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBalanceAlert = fmt.Errorf("proto: unexpected end of group")
+)
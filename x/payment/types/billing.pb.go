@@ -0,0 +1,1189 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/billing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BillingCategory defines what a bucket flow is charged for.
+type BillingCategory int32
+
+const (
+	// BILLING_CATEGORY_STORE defines the store fee of the objects in the bucket.
+	BILLING_CATEGORY_STORE BillingCategory = 0
+	// BILLING_CATEGORY_READ defines the fee of the charged read quota of the bucket.
+	BILLING_CATEGORY_READ BillingCategory = 1
+)
+
+var BillingCategory_name = map[int32]string{
+	0: "BILLING_CATEGORY_STORE",
+	1: "BILLING_CATEGORY_READ",
+}
+
+var BillingCategory_value = map[string]int32{
+	"BILLING_CATEGORY_STORE": 0,
+	"BILLING_CATEGORY_READ":  1,
+}
+
+func (x BillingCategory) String() string {
+	return proto.EnumName(BillingCategory_name, int32(x))
+}
+
+func (BillingCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_24744cd0333599c6, []int{0}
+}
+
+// BillingRecipient defines the kind of the receiver of a bucket flow.
+type BillingRecipient int32
+
+const (
+	// BILLING_RECIPIENT_GVG_FAMILY defines the virtual payment account of the global virtual group family of the bucket.
+	BILLING_RECIPIENT_GVG_FAMILY BillingRecipient = 0
+	// BILLING_RECIPIENT_GVG defines the virtual payment account of a global virtual group.
+	BILLING_RECIPIENT_GVG BillingRecipient = 1
+	// BILLING_RECIPIENT_VALIDATOR_TAX_POOL defines the validator tax pool.
+	BILLING_RECIPIENT_VALIDATOR_TAX_POOL BillingRecipient = 2
+)
+
+var BillingRecipient_name = map[int32]string{
+	0: "BILLING_RECIPIENT_GVG_FAMILY",
+	1: "BILLING_RECIPIENT_GVG",
+	2: "BILLING_RECIPIENT_VALIDATOR_TAX_POOL",
+}
+
+var BillingRecipient_value = map[string]int32{
+	"BILLING_RECIPIENT_GVG_FAMILY":         0,
+	"BILLING_RECIPIENT_GVG":                1,
+	"BILLING_RECIPIENT_VALIDATOR_TAX_POOL": 2,
+}
+
+func (x BillingRecipient) String() string {
+	return proto.EnumName(BillingRecipient_name, int32(x))
+}
+
+func (BillingRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_24744cd0333599c6, []int{1}
+}
+
+// BillingFlow is an out flow of a bucket bill
+type BillingFlow struct {
+	// to_address is the address receiving the flow
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// rate is the flow rate
+	Rate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rate"`
+	// category is what the flow is charged for
+	Category BillingCategory `protobuf:"varint,3,opt,name=category,proto3,enum=greenfield.payment.BillingCategory" json:"category,omitempty"`
+	// recipient is the kind of the receiver
+	Recipient BillingRecipient `protobuf:"varint,4,opt,name=recipient,proto3,enum=greenfield.payment.BillingRecipient" json:"recipient,omitempty"`
+}
+
+func (m *BillingFlow) Reset()         { *m = BillingFlow{} }
+func (m *BillingFlow) String() string { return proto.CompactTextString(m) }
+func (*BillingFlow) ProtoMessage()    {}
+func (*BillingFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24744cd0333599c6, []int{0}
+}
+func (m *BillingFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BillingFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingFlow.Merge(m, src)
+}
+func (m *BillingFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingFlow proto.InternalMessageInfo
+
+func (m *BillingFlow) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *BillingFlow) GetCategory() BillingCategory {
+	if m != nil {
+		return m.Category
+	}
+	return BILLING_CATEGORY_STORE
+}
+
+func (m *BillingFlow) GetRecipient() BillingRecipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return BILLING_RECIPIENT_GVG_FAMILY
+}
+
+// BucketBill is the bill of a bucket paid by a payment account since the timestamp,
+// it is recorded every time the bill of the bucket is changed.
+type BucketBill struct {
+	// payment_address is the address of the payment account of the bucket
+	PaymentAddress string `protobuf:"bytes,1,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// bucket_id is the id of the bucket
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// timestamp is the unix timestamp when the bill takes effect
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// flows are the out flows of the bill, empty when the bucket is no longer charged
+	Flows []BillingFlow `protobuf:"bytes,4,rep,name=flows,proto3" json:"flows"`
+}
+
+func (m *BucketBill) Reset()         { *m = BucketBill{} }
+func (m *BucketBill) String() string { return proto.CompactTextString(m) }
+func (*BucketBill) ProtoMessage()    {}
+func (*BucketBill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24744cd0333599c6, []int{1}
+}
+func (m *BucketBill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketBill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketBill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BucketBill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketBill.Merge(m, src)
+}
+func (m *BucketBill) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketBill) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketBill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketBill proto.InternalMessageInfo
+
+func (m *BucketBill) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *BucketBill) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BucketBill) GetFlows() []BillingFlow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+// BillingStatementItem is the amount charged by a bucket to a receiver for a category in a billing statement
+type BillingStatementItem struct {
+	// bucket_id is the id of the bucket
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// to_address is the address receiving the charges
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// recipient is the kind of the receiver
+	Recipient BillingRecipient `protobuf:"varint,3,opt,name=recipient,proto3,enum=greenfield.payment.BillingRecipient" json:"recipient,omitempty"`
+	// category is what the charges are for
+	Category BillingCategory `protobuf:"varint,4,opt,name=category,proto3,enum=greenfield.payment.BillingCategory" json:"category,omitempty"`
+	// amount is the charged amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *BillingStatementItem) Reset()         { *m = BillingStatementItem{} }
+func (m *BillingStatementItem) String() string { return proto.CompactTextString(m) }
+func (*BillingStatementItem) ProtoMessage()    {}
+func (*BillingStatementItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24744cd0333599c6, []int{2}
+}
+func (m *BillingStatementItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingStatementItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingStatementItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BillingStatementItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingStatementItem.Merge(m, src)
+}
+func (m *BillingStatementItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingStatementItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingStatementItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingStatementItem proto.InternalMessageInfo
+
+func (m *BillingStatementItem) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *BillingStatementItem) GetRecipient() BillingRecipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return BILLING_RECIPIENT_GVG_FAMILY
+}
+
+func (m *BillingStatementItem) GetCategory() BillingCategory {
+	if m != nil {
+		return m.Category
+	}
+	return BILLING_CATEGORY_STORE
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.payment.BillingCategory", BillingCategory_name, BillingCategory_value)
+	proto.RegisterEnum("greenfield.payment.BillingRecipient", BillingRecipient_name, BillingRecipient_value)
+	proto.RegisterType((*BillingFlow)(nil), "greenfield.payment.BillingFlow")
+	proto.RegisterType((*BucketBill)(nil), "greenfield.payment.BucketBill")
+	proto.RegisterType((*BillingStatementItem)(nil), "greenfield.payment.BillingStatementItem")
+}
+
+func init() { proto.RegisterFile("greenfield/payment/billing.proto", fileDescriptor_24744cd0333599c6) }
+
+var fileDescriptor_24744cd0333599c6 = []byte{
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5f, 0x4b, 0xdb, 0x50,
+	0x14, 0x4f, 0xda, 0x28, 0xf6, 0x0a, 0x5a, 0x2e, 0x6e, 0xc4, 0x22, 0xb1, 0x38, 0xd9, 0x8a, 0xd0,
+	0x04, 0xdc, 0xc3, 0x1e, 0x36, 0x36, 0x12, 0xad, 0x25, 0xd0, 0x59, 0xb9, 0xcd, 0x64, 0x6e, 0x0f,
+	0x21, 0x7f, 0xae, 0x31, 0xd8, 0xe4, 0x96, 0xe4, 0x16, 0xd7, 0xa7, 0x3d, 0xba, 0xc7, 0x7d, 0x81,
+	0x3d, 0xed, 0x2b, 0xf8, 0x21, 0x7c, 0x14, 0x9f, 0xc6, 0x1e, 0x64, 0xb4, 0x5f, 0x64, 0x24, 0xb9,
+	0xb5, 0xc6, 0x82, 0x14, 0xf1, 0xa9, 0xbd, 0xe7, 0xfc, 0x7e, 0xbf, 0x7b, 0xce, 0xef, 0x9e, 0x1c,
+	0x50, 0xf5, 0x22, 0x8c, 0xc3, 0x63, 0x1f, 0x77, 0x5d, 0xa5, 0x67, 0x0d, 0x02, 0x1c, 0x52, 0xc5,
+	0xf6, 0xbb, 0x5d, 0x3f, 0xf4, 0xe4, 0x5e, 0x44, 0x28, 0x81, 0x70, 0x82, 0x90, 0x19, 0xa2, 0xb2,
+	0xea, 0x90, 0x38, 0x20, 0xb1, 0x99, 0x22, 0x94, 0xec, 0x90, 0xc1, 0x2b, 0x2b, 0x1e, 0xf1, 0x48,
+	0x16, 0x4f, 0xfe, 0x65, 0xd1, 0x8d, 0x5f, 0x05, 0xb0, 0xa8, 0x65, 0xb2, 0x7b, 0x5d, 0x72, 0x06,
+	0xdf, 0x00, 0x40, 0x89, 0x69, 0xb9, 0x6e, 0x84, 0xe3, 0x58, 0xe4, 0xab, 0x7c, 0xad, 0xa4, 0x89,
+	0xd7, 0x17, 0xf5, 0x15, 0xa6, 0xa5, 0x66, 0x99, 0x0e, 0x8d, 0xfc, 0xd0, 0x43, 0x25, 0x4a, 0x58,
+	0x00, 0x1e, 0x00, 0x21, 0xb2, 0x28, 0x16, 0x0b, 0x29, 0xe5, 0xdd, 0xe5, 0xcd, 0x3a, 0xf7, 0xf7,
+	0x66, 0xfd, 0xa5, 0xe7, 0xd3, 0x93, 0xbe, 0x2d, 0x3b, 0x24, 0x60, 0xd5, 0xb0, 0x9f, 0x7a, 0xec,
+	0x9e, 0x2a, 0x74, 0xd0, 0xc3, 0xb1, 0xac, 0x87, 0xf4, 0xfa, 0xa2, 0x0e, 0xd8, 0x05, 0x7a, 0x48,
+	0x51, 0xaa, 0x04, 0x3f, 0x80, 0x05, 0xc7, 0xa2, 0xd8, 0x23, 0xd1, 0x40, 0x2c, 0x56, 0xf9, 0xda,
+	0xd2, 0xf6, 0x0b, 0x79, 0xba, 0x65, 0x99, 0x55, 0xbf, 0xc3, 0xa0, 0xe8, 0x96, 0x04, 0x35, 0x50,
+	0x8a, 0xb0, 0xe3, 0xf7, 0x7c, 0x1c, 0x52, 0x51, 0x48, 0x15, 0x36, 0x1f, 0x50, 0x40, 0x63, 0x2c,
+	0x9a, 0xd0, 0x36, 0xce, 0x0b, 0x00, 0x68, 0x7d, 0xe7, 0x14, 0xd3, 0x04, 0x05, 0x55, 0xb0, 0xcc,
+	0x58, 0x33, 0x7b, 0xb4, 0xc4, 0x08, 0x63, 0xa3, 0xbe, 0x82, 0x92, 0x9d, 0x0a, 0x9a, 0xbe, 0xcb,
+	0xdc, 0x7a, 0xcf, 0xdc, 0x7a, 0x35, 0x83, 0x5b, 0x9f, 0xfc, 0xd4, 0xae, 0x45, 0x76, 0x57, 0x72,
+	0x44, 0x0b, 0x99, 0xa0, 0xee, 0xc2, 0x35, 0x50, 0xa2, 0x7e, 0x80, 0x63, 0x6a, 0x05, 0xbd, 0xd4,
+	0xb4, 0x22, 0x9a, 0x04, 0xe0, 0x5b, 0x30, 0x77, 0xdc, 0x25, 0x67, 0xb1, 0x28, 0x54, 0x8b, 0xb5,
+	0xc5, 0xed, 0xf5, 0x07, 0xcc, 0x48, 0x86, 0x41, 0x13, 0x92, 0xba, 0x50, 0xc6, 0xd9, 0x38, 0x2f,
+	0x82, 0x15, 0x96, 0xec, 0x50, 0x8b, 0xe2, 0x04, 0xad, 0x53, 0x1c, 0xe4, 0x1b, 0xe2, 0x9f, 0xb8,
+	0xa1, 0xfc, 0x3c, 0x16, 0x66, 0x9f, 0xc7, 0xdc, 0xe3, 0x17, 0x1f, 0xf5, 0xf8, 0xb9, 0x09, 0x14,
+	0x1e, 0x33, 0x81, 0x06, 0x98, 0xb7, 0x02, 0xd2, 0x0f, 0xa9, 0x38, 0xf7, 0x04, 0x9f, 0x05, 0xd3,
+	0xda, 0xda, 0x07, 0xcb, 0xf7, 0xae, 0x84, 0x15, 0xf0, 0x5c, 0xd3, 0x5b, 0x2d, 0x7d, 0xbf, 0x69,
+	0xee, 0xa8, 0x46, 0xa3, 0xd9, 0x46, 0x47, 0x66, 0xc7, 0x68, 0xa3, 0x46, 0x99, 0x83, 0xab, 0xe0,
+	0xd9, 0x54, 0x0e, 0x35, 0xd4, 0xdd, 0x32, 0x5f, 0x11, 0x7e, 0xfc, 0x96, 0xb8, 0xad, 0xef, 0xa0,
+	0x7c, 0xdf, 0x05, 0x58, 0x05, 0x6b, 0x63, 0x12, 0x6a, 0xec, 0xe8, 0x07, 0x7a, 0x63, 0xdf, 0x30,
+	0x9b, 0x87, 0x4d, 0x73, 0x4f, 0xfd, 0xa8, 0xb7, 0x8e, 0xf2, 0xb2, 0x39, 0x44, 0x99, 0x87, 0x35,
+	0xb0, 0x39, 0x9d, 0x3a, 0x54, 0x5b, 0xfa, 0xae, 0x6a, 0xb4, 0x91, 0x69, 0xa8, 0x9f, 0xcd, 0x83,
+	0x76, 0xbb, 0x55, 0x2e, 0x64, 0x05, 0x68, 0xfa, 0xe5, 0x50, 0xe2, 0xaf, 0x86, 0x12, 0xff, 0x6f,
+	0x28, 0xf1, 0x3f, 0x47, 0x12, 0x77, 0x35, 0x92, 0xb8, 0x3f, 0x23, 0x89, 0xfb, 0xa2, 0xdc, 0x31,
+	0xca, 0x0e, 0xed, 0xba, 0x73, 0x62, 0xf9, 0xa1, 0x72, 0x67, 0x35, 0x7e, 0xbb, 0x5d, 0x8e, 0xa9,
+	0x6b, 0xf6, 0x7c, 0xba, 0xd6, 0x5e, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x20, 0xd0, 0x1a,
+	0x3f, 0x05, 0x00, 0x00,
+}
+
+func (m *BillingFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recipient != 0 {
+		i = encodeVarintBilling(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Category != 0 {
+		i = encodeVarintBilling(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBilling(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBilling(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketBill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketBill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketBill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBilling(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintBilling(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBilling(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintBilling(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BillingStatementItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingStatementItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingStatementItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBilling(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Category != 0 {
+		i = encodeVarintBilling(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Recipient != 0 {
+		i = encodeVarintBilling(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBilling(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBilling(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBilling(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBilling(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BillingFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBilling(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovBilling(uint64(l))
+	if m.Category != 0 {
+		n += 1 + sovBilling(uint64(m.Category))
+	}
+	if m.Recipient != 0 {
+		n += 1 + sovBilling(uint64(m.Recipient))
+	}
+	return n
+}
+
+func (m *BucketBill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovBilling(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovBilling(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovBilling(uint64(m.Timestamp))
+	}
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovBilling(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BillingStatementItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovBilling(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBilling(uint64(l))
+	}
+	if m.Recipient != 0 {
+		n += 1 + sovBilling(uint64(m.Recipient))
+	}
+	if m.Category != 0 {
+		n += 1 + sovBilling(uint64(m.Category))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBilling(uint64(l))
+	return n
+}
+
+func sovBilling(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBilling(x uint64) (n int) {
+	return sovBilling(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BillingFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBilling
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BillingFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BillingFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= BillingCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= BillingRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBilling(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketBill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBilling
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketBill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketBill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, BillingFlow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBilling(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BillingStatementItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBilling
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BillingStatementItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BillingStatementItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= BillingRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= BillingCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBilling
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBilling(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBilling
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBilling(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBilling
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBilling
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBilling
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBilling
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBilling
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBilling        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBilling          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBilling = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,6 +12,17 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "payment/Deposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "payment/Withdraw", nil)
 	cdc.RegisterConcrete(&MsgDisableRefund{}, "payment/DisableRefund", nil)
+	cdc.RegisterConcrete(&MsgSetBalanceAlert{}, "payment/SetBalanceAlert", nil)
+	cdc.RegisterConcrete(&MsgEnableAutoTopUp{}, "payment/EnableAutoTopUp", nil)
+	cdc.RegisterConcrete(&MsgDisableAutoTopUp{}, "payment/DisableAutoTopUp", nil)
+	cdc.RegisterConcrete(&MsgSetSpendingBudget{}, "payment/SetSpendingBudget", nil)
+	cdc.RegisterConcrete(&MsgTransferPaymentAccountOwnership{}, "payment/TransferPaymentAccountOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptPaymentAccountOwnership{}, "payment/AcceptPaymentAccountOwnership", nil)
+	cdc.RegisterConcrete(&MsgCancelDelayedWithdrawal{}, "payment/CancelDelayedWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgTransferReadVoucher{}, "payment/TransferReadVoucher", nil)
+	cdc.RegisterConcrete(&MsgCreateMultisigPaymentAccount{}, "payment/CreateMultisigPaymentAccount", nil)
+	cdc.RegisterConcrete(&MsgProposeMultisigAction{}, "payment/ProposeMultisigAction", nil)
+	cdc.RegisterConcrete(&MsgApproveMultisigProposal{}, "payment/ApproveMultisigProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +41,39 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBalanceAlert{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgEnableAutoTopUp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDisableAutoTopUp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetSpendingBudget{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferPaymentAccountOwnership{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptPaymentAccountOwnership{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelDelayedWithdrawal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferReadVoucher{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateMultisigPaymentAccount{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeMultisigAction{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgApproveMultisigProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
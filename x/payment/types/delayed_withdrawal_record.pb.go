@@ -34,6 +34,9 @@ type DelayedWithdrawalRecord struct {
 	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
 	// unlock timestamp is the unix timestamp to unlock the withdrawal
 	UnlockTimestamp int64 `protobuf:"varint,4,opt,name=unlock_timestamp,json=unlockTimestamp,proto3" json:"unlock_timestamp,omitempty"`
+	// id is the id of the delayed withdrawal, 0 is the id of the only delayed withdrawal of the address
+	// created before the concurrent delayed withdrawals are supported
+	Id uint64 `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *DelayedWithdrawalRecord) Reset()         { *m = DelayedWithdrawalRecord{} }
@@ -90,6 +93,13 @@ func (m *DelayedWithdrawalRecord) GetUnlockTimestamp() int64 {
 	return 0
 }
 
+func (m *DelayedWithdrawalRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DelayedWithdrawalRecord)(nil), "greenfield.payment.DelayedWithdrawalRecord")
 }
@@ -99,27 +109,28 @@ func init() {
 }
 
 var fileDescriptor_237dd2860d399f1a = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x37, 0x06, 0xf6, 0xa2, 0x94, 0x81, 0x75, 0x87, 0x6e, 0x78, 0x90, 0x09, 0xb6,
-	0x05, 0xbd, 0x7a, 0x71, 0x78, 0xd9, 0xb5, 0x0e, 0x04, 0x2f, 0x25, 0x6d, 0xb2, 0x2e, 0xac, 0x49,
-	0x4a, 0x92, 0x31, 0xf7, 0x16, 0x3e, 0xcc, 0x1e, 0x62, 0xc7, 0xb1, 0x93, 0x78, 0x18, 0xb2, 0xbd,
-	0x83, 0x67, 0x69, 0x12, 0xe7, 0x6e, 0x9e, 0x92, 0x7c, 0x7c, 0xff, 0xef, 0xfb, 0x91, 0xbf, 0x7b,
-	0x57, 0x08, 0x8c, 0xd9, 0x98, 0xe0, 0x12, 0xc5, 0x15, 0x5c, 0x50, 0xcc, 0x54, 0x8c, 0x70, 0x09,
-	0x17, 0x18, 0xa5, 0x73, 0xa2, 0x26, 0x48, 0xc0, 0x39, 0x2c, 0x53, 0x81, 0x73, 0x2e, 0x50, 0x54,
-	0x09, 0xae, 0xb8, 0xe7, 0xfd, 0xcd, 0x44, 0x76, 0xa6, 0x73, 0x99, 0x73, 0x49, 0xb9, 0x4c, 0xb5,
-	0x23, 0x36, 0x0f, 0x63, 0xef, 0xb4, 0x0b, 0x5e, 0x70, 0xa3, 0xd7, 0x37, 0xa3, 0x5e, 0x7d, 0x03,
-	0xf7, 0xe2, 0xc9, 0x14, 0xbd, 0x1c, 0x7a, 0x12, 0x5d, 0xe3, 0xdd, 0xba, 0x4d, 0x88, 0x90, 0xf0,
-	0x41, 0x0f, 0xf4, 0x4f, 0x07, 0xfe, 0x66, 0x19, 0xb6, 0x6d, 0xe2, 0x23, 0x42, 0x02, 0x4b, 0xf9,
-	0xac, 0x04, 0x61, 0x45, 0xa2, 0x5d, 0xde, 0xc8, 0x6d, 0x41, 0xca, 0x67, 0x4c, 0xf9, 0x27, 0xda,
-	0xff, 0xb0, 0xda, 0x76, 0x9d, 0xcf, 0x6d, 0xf7, 0xba, 0x20, 0x6a, 0x32, 0xcb, 0xa2, 0x9c, 0x53,
-	0x0b, 0x64, 0x8f, 0x50, 0xa2, 0x69, 0xac, 0x16, 0x15, 0x96, 0xd1, 0x90, 0xa9, 0xcd, 0x32, 0x74,
-	0x6d, 0xfa, 0x90, 0xa9, 0xc4, 0x66, 0xd5, 0x0c, 0x63, 0xc1, 0xa9, 0xdf, 0xf8, 0x8f, 0xa1, 0x76,
-	0x79, 0x37, 0xee, 0xf9, 0x8c, 0x95, 0x3c, 0x9f, 0xa6, 0x8a, 0x50, 0x2c, 0x15, 0xa4, 0x95, 0xdf,
-	0xec, 0x81, 0x7e, 0x23, 0x39, 0x33, 0xfa, 0xe8, 0x57, 0x1e, 0x0c, 0x57, 0xbb, 0x00, 0xac, 0x77,
-	0x01, 0xf8, 0xda, 0x05, 0xe0, 0x7d, 0x1f, 0x38, 0xeb, 0x7d, 0xe0, 0x7c, 0xec, 0x03, 0xe7, 0x35,
-	0x3e, 0x02, 0xce, 0x58, 0x16, 0xe6, 0x13, 0x48, 0x58, 0x7c, 0xb4, 0xa0, 0xb7, 0xc3, 0x8a, 0x34,
-	0x7d, 0xd6, 0xd2, 0x5f, 0x79, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xd9, 0xf0, 0x28, 0xc5,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xb4, 0x7f, 0xa5, 0xdf, 0x03, 0xa0, 0xa8, 0x12, 0xa1, 0x43, 0x5a, 0x31, 0xa0,
+	0x22, 0x91, 0x44, 0x82, 0x95, 0x85, 0x8a, 0xa5, 0x6b, 0xa8, 0x84, 0xc4, 0x12, 0x39, 0xb1, 0x9b,
+	0x5a, 0x8d, 0xed, 0xc8, 0x76, 0x55, 0xfa, 0x02, 0xcc, 0x3c, 0x4c, 0x1f, 0xa2, 0x63, 0xd5, 0x09,
+	0x31, 0x54, 0xa8, 0x7d, 0x11, 0x94, 0xc4, 0x94, 0x6e, 0x4c, 0xb6, 0x8f, 0xbe, 0x7b, 0xcf, 0xb9,
+	0xbe, 0xf0, 0x36, 0x93, 0x84, 0xf0, 0x31, 0x25, 0x39, 0x0e, 0x0b, 0xb4, 0x60, 0x84, 0xeb, 0x10,
+	0x93, 0x1c, 0x2d, 0x08, 0x8e, 0xe7, 0x54, 0x4f, 0xb0, 0x44, 0x73, 0x94, 0xc7, 0x92, 0xa4, 0x42,
+	0xe2, 0xa0, 0x90, 0x42, 0x0b, 0xc7, 0xf9, 0xad, 0x09, 0x4c, 0x4d, 0xe7, 0x22, 0x15, 0x8a, 0x09,
+	0x15, 0x57, 0x44, 0x58, 0x3f, 0x6a, 0xbc, 0xd3, 0xce, 0x44, 0x26, 0x6a, 0xbd, 0xbc, 0xd5, 0xea,
+	0xe5, 0x9b, 0x0d, 0xcf, 0x1f, 0x6b, 0xa3, 0xe7, 0x83, 0x4f, 0x54, 0xd9, 0x38, 0x37, 0xb0, 0x89,
+	0x30, 0x96, 0x2e, 0xe8, 0x81, 0xfe, 0xff, 0x81, 0xbb, 0x59, 0xfa, 0x6d, 0xd3, 0xf1, 0x01, 0x63,
+	0x49, 0x94, 0x7a, 0xd2, 0x92, 0xf2, 0x2c, 0xaa, 0x28, 0x67, 0x04, 0x5b, 0x88, 0x89, 0x19, 0xd7,
+	0xae, 0x5d, 0xf1, 0xf7, 0xab, 0x6d, 0xd7, 0xfa, 0xdc, 0x76, 0xaf, 0x32, 0xaa, 0x27, 0xb3, 0x24,
+	0x48, 0x05, 0x33, 0x81, 0xcc, 0xe1, 0x2b, 0x3c, 0x0d, 0xf5, 0xa2, 0x20, 0x2a, 0x18, 0x72, 0xbd,
+	0x59, 0xfa, 0xd0, 0x74, 0x1f, 0x72, 0x1d, 0x99, 0x5e, 0x65, 0x86, 0xb1, 0x14, 0xcc, 0x6d, 0xfc,
+	0x95, 0xa1, 0xa4, 0x9c, 0x6b, 0x78, 0x36, 0xe3, 0xb9, 0x48, 0xa7, 0xb1, 0xa6, 0x8c, 0x28, 0x8d,
+	0x58, 0xe1, 0x36, 0x7b, 0xa0, 0xdf, 0x88, 0x4e, 0x6b, 0x7d, 0xf4, 0x23, 0x3b, 0x27, 0xd0, 0xa6,
+	0xd8, 0xfd, 0xd7, 0x03, 0xfd, 0x66, 0x64, 0x53, 0x3c, 0x18, 0xae, 0x76, 0x1e, 0x58, 0xef, 0x3c,
+	0xf0, 0xb5, 0xf3, 0xc0, 0xfb, 0xde, 0xb3, 0xd6, 0x7b, 0xcf, 0xfa, 0xd8, 0x7b, 0xd6, 0x4b, 0x78,
+	0x34, 0x40, 0xc2, 0x13, 0x3f, 0x9d, 0x20, 0xca, 0xc3, 0xa3, 0x85, 0xbd, 0x1e, 0x56, 0x56, 0x4d,
+	0x93, 0xb4, 0xaa, 0xaf, 0xbd, 0xfb, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x59, 0x24, 0x7b, 0x56, 0xd5,
 	0x01, 0x00, 0x00,
 }
 
@@ -143,6 +154,11 @@ func (m *DelayedWithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintDelayedWithdrawalRecord(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.UnlockTimestamp != 0 {
 		i = encodeVarintDelayedWithdrawalRecord(dAtA, i, uint64(m.UnlockTimestamp))
 		i--
@@ -205,6 +221,9 @@ func (m *DelayedWithdrawalRecord) Size() (n int) {
 	if m.UnlockTimestamp != 0 {
 		n += 1 + sovDelayedWithdrawalRecord(uint64(m.UnlockTimestamp))
 	}
+	if m.Id != 0 {
+		n += 1 + sovDelayedWithdrawalRecord(uint64(m.Id))
+	}
 	return n
 }
 
@@ -360,6 +379,25 @@ func (m *DelayedWithdrawalRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelayedWithdrawalRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelayedWithdrawalRecord(dAtA[iNdEx:])
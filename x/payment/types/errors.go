@@ -20,4 +20,18 @@ var (
 	ErrIncorrectWithdrawAmount            = errorsmod.Register(ModuleName, 1211, "the withdrawal amount is not equal to the delayed one")
 	ErrNotReachTimeLockDuration           = errorsmod.Register(ModuleName, 1212, "the withdrawal does not reach to the delayed duration")
 	ErrExistsDelayedWithdrawal            = errorsmod.Register(ModuleName, 1213, "delayed withdrawal already exists")
+	ErrBalanceAlertNotFound               = errorsmod.Register(ModuleName, 1214, "balance alert not found")
+	ErrAutoTopUpNotFound                  = errorsmod.Register(ModuleName, 1215, "auto top-up not found")
+	ErrInvalidAutoTopUpSource             = errorsmod.Register(ModuleName, 1216, "invalid auto top-up source")
+	ErrSpendingBudgetNotFound             = errorsmod.Register(ModuleName, 1217, "spending budget not found")
+	ErrSpendingBudgetExceeded             = errorsmod.Register(ModuleName, 1218, "spending budget exceeded")
+	ErrPaymentAccountTransferNotFound     = errorsmod.Register(ModuleName, 1219, "payment account transfer not found")
+	ErrReadVoucherNotFound                = errorsmod.Register(ModuleName, 1220, "read voucher not found")
+	ErrReadVoucherExpired                 = errorsmod.Register(ModuleName, 1221, "read voucher expired")
+	ErrInsufficientReadVoucherQuota       = errorsmod.Register(ModuleName, 1222, "insufficient read quota of the read voucher")
+	ErrNotMultisigOwner                   = errorsmod.Register(ModuleName, 1223, "not an owner of the multisig payment account")
+	ErrMultisigProposalNotFound           = errorsmod.Register(ModuleName, 1224, "multisig proposal not found")
+	ErrMultisigProposalApproved           = errorsmod.Register(ModuleName, 1225, "multisig proposal already approved by the owner")
+	ErrInvalidReadVoucherNonce            = errorsmod.Register(ModuleName, 1226, "invalid nonce of the read voucher")
+	ErrMultisigProposalExpired            = errorsmod.Register(ModuleName, 1227, "multisig proposal expired")
 )
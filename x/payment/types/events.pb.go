@@ -57,6 +57,10 @@ type EventPaymentAccountUpdate struct {
 	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	// whether the payment account is refundable
 	Refundable bool `protobuf:"varint,3,opt,name=refundable,proto3" json:"refundable,omitempty"`
+	// the owner addresses of a multisig payment account
+	Owners []string `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners,omitempty"`
+	// the number of the owners required to approve a proposal of a multisig payment account
+	Threshold uint32 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (m *EventPaymentAccountUpdate) Reset()         { *m = EventPaymentAccountUpdate{} }
@@ -113,6 +117,20 @@ func (m *EventPaymentAccountUpdate) GetRefundable() bool {
 	return false
 }
 
+func (m *EventPaymentAccountUpdate) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *EventPaymentAccountUpdate) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 // Stream Payment Record of a stream account
 type EventStreamRecordUpdate struct {
 	// account address
@@ -361,6 +379,221 @@ func (m *EventWithdraw) GetFrom() string {
 	return ""
 }
 
+type EventCancelDelayedWithdrawal struct {
+	// addr is the address of the receive account of the delayed withdrawal
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// id is the id of the delayed withdrawal
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// from is the address of the stream account the amount is returned to
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// amount is the amount returned to the static balance
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventCancelDelayedWithdrawal) Reset()         { *m = EventCancelDelayedWithdrawal{} }
+func (m *EventCancelDelayedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*EventCancelDelayedWithdrawal) ProtoMessage()    {}
+func (*EventCancelDelayedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{5}
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelDelayedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelDelayedWithdrawal.Merge(m, src)
+}
+func (m *EventCancelDelayedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelDelayedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelDelayedWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelDelayedWithdrawal proto.InternalMessageInfo
+
+func (m *EventCancelDelayedWithdrawal) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventCancelDelayedWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCancelDelayedWithdrawal) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+// EventReadVoucherUpdate is emitted when a read voucher is minted, transferred or redeemed
+type EventReadVoucherUpdate struct {
+	// id is the id of the voucher
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// issuer is the address of the bucket owner who minted the voucher
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// bucket_id is the id of the bucket to read from
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// holder is the address of the account the voucher belongs to
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// read_quota is the prepaid read quota in bytes
+	ReadQuota uint64 `protobuf:"varint,5,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+	// used_quota is the read quota in bytes already redeemed
+	UsedQuota uint64 `protobuf:"varint,6,opt,name=used_quota,json=usedQuota,proto3" json:"used_quota,omitempty"`
+	// expire_time is the unix timestamp after which the voucher can not be redeemed
+	ExpireTime int64 `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (m *EventReadVoucherUpdate) Reset()         { *m = EventReadVoucherUpdate{} }
+func (m *EventReadVoucherUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventReadVoucherUpdate) ProtoMessage()    {}
+func (*EventReadVoucherUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{6}
+}
+func (m *EventReadVoucherUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReadVoucherUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReadVoucherUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReadVoucherUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReadVoucherUpdate.Merge(m, src)
+}
+func (m *EventReadVoucherUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReadVoucherUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReadVoucherUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReadVoucherUpdate proto.InternalMessageInfo
+
+func (m *EventReadVoucherUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventReadVoucherUpdate) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *EventReadVoucherUpdate) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventReadVoucherUpdate) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
+	}
+	return 0
+}
+
+func (m *EventReadVoucherUpdate) GetUsedQuota() uint64 {
+	if m != nil {
+		return m.UsedQuota
+	}
+	return 0
+}
+
+func (m *EventReadVoucherUpdate) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+// EventReadVoucherExpire is emitted when a read voucher expires and the unredeemed part of it is refunded
+type EventReadVoucherExpire struct {
+	// id is the id of the voucher
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payment_address is the address of the payment account the refund is paid to
+	PaymentAddress string `protobuf:"bytes,2,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// refund is the refunded fee and validator tax
+	Refund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=refund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refund"`
+}
+
+func (m *EventReadVoucherExpire) Reset()         { *m = EventReadVoucherExpire{} }
+func (m *EventReadVoucherExpire) String() string { return proto.CompactTextString(m) }
+func (*EventReadVoucherExpire) ProtoMessage()    {}
+func (*EventReadVoucherExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{7}
+}
+func (m *EventReadVoucherExpire) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReadVoucherExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReadVoucherExpire.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReadVoucherExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReadVoucherExpire.Merge(m, src)
+}
+func (m *EventReadVoucherExpire) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReadVoucherExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReadVoucherExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReadVoucherExpire proto.InternalMessageInfo
+
+func (m *EventReadVoucherExpire) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventReadVoucherExpire) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
 // emit when upload/cancel/delete object, used for frontend to preview the fee changed
 // only emit in tx simulation
 type EventFeePreview struct {
@@ -373,7 +606,7 @@ func (m *EventFeePreview) Reset()         { *m = EventFeePreview{} }
 func (m *EventFeePreview) String() string { return proto.CompactTextString(m) }
 func (*EventFeePreview) ProtoMessage()    {}
 func (*EventFeePreview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_befcc80e27bc8df9, []int{5}
+	return fileDescriptor_befcc80e27bc8df9, []int{8}
 }
 func (m *EventFeePreview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,140 +649,444 @@ func (m *EventFeePreview) GetFeePreviewType() FeePreviewType {
 	return FEE_PREVIEW_TYPE_PRELOCKED_FEE
 }
 
-func init() {
-	proto.RegisterEnum("greenfield.payment.FeePreviewType", FeePreviewType_name, FeePreviewType_value)
-	proto.RegisterType((*EventPaymentAccountUpdate)(nil), "greenfield.payment.EventPaymentAccountUpdate")
-	proto.RegisterType((*EventStreamRecordUpdate)(nil), "greenfield.payment.EventStreamRecordUpdate")
-	proto.RegisterType((*EventForceSettle)(nil), "greenfield.payment.EventForceSettle")
-	proto.RegisterType((*EventDeposit)(nil), "greenfield.payment.EventDeposit")
-	proto.RegisterType((*EventWithdraw)(nil), "greenfield.payment.EventWithdraw")
-	proto.RegisterType((*EventFeePreview)(nil), "greenfield.payment.EventFeePreview")
+// EventLowBalanceWarning is emitted once when the runway of a payment account falls under the threshold of its balance alert
+type EventLowBalanceWarning struct {
+	// address of the payment account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// threshold of the balance alert in seconds
+	Threshold uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// projected freeze timestamp of the payment account
+	FreezeTimestamp int64 `protobuf:"varint,3,opt,name=freeze_timestamp,json=freezeTimestamp,proto3" json:"freeze_timestamp,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/payment/events.proto", fileDescriptor_befcc80e27bc8df9) }
+func (m *EventLowBalanceWarning) Reset()         { *m = EventLowBalanceWarning{} }
+func (m *EventLowBalanceWarning) String() string { return proto.CompactTextString(m) }
+func (*EventLowBalanceWarning) ProtoMessage()    {}
+func (*EventLowBalanceWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{9}
+}
+func (m *EventLowBalanceWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLowBalanceWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLowBalanceWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLowBalanceWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLowBalanceWarning.Merge(m, src)
+}
+func (m *EventLowBalanceWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLowBalanceWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLowBalanceWarning.DiscardUnknown(m)
+}
 
-var fileDescriptor_befcc80e27bc8df9 = []byte{
-	// 724 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x00, 0x01, 0xe6, 0x42, 0xc8, 0xf5, 0x45, 0xba, 0x01, 0xe9, 0x1a, 0x88, 0x74,
-	0x69, 0x5a, 0x35, 0x89, 0x44, 0xb7, 0x95, 0x2a, 0x52, 0x8c, 0x14, 0x15, 0xd1, 0xc8, 0x09, 0x45,
-	0xad, 0x54, 0x59, 0x13, 0xfb, 0x38, 0x58, 0xc4, 0x33, 0xd6, 0x78, 0x4c, 0x4a, 0x9f, 0xa0, 0xcb,
-	0x6e, 0xbb, 0xee, 0xa2, 0x2f, 0xc0, 0xb2, 0xdd, 0xb3, 0x44, 0xac, 0x2a, 0x16, 0xa8, 0x82, 0x55,
-	0xdf, 0xa2, 0xf2, 0xcc, 0x24, 0x04, 0x81, 0x14, 0x5a, 0x85, 0x55, 0x32, 0x27, 0x7f, 0xfe, 0xff,
-	0x3b, 0xe3, 0x33, 0x1e, 0xb4, 0xd4, 0x66, 0x00, 0xc4, 0xf3, 0xa1, 0xe3, 0x56, 0x42, 0x7c, 0x18,
-	0x00, 0xe1, 0x15, 0x38, 0x00, 0xc2, 0xa3, 0x72, 0xc8, 0x28, 0xa7, 0xba, 0x7e, 0x25, 0x28, 0x2b,
-	0xc1, 0xe2, 0x82, 0x43, 0xa3, 0x80, 0x46, 0xb6, 0x50, 0x54, 0xe4, 0x42, 0xca, 0x17, 0xe7, 0xdb,
-	0xb4, 0x4d, 0x65, 0x3d, 0xf9, 0xa6, 0xaa, 0x2b, 0xb7, 0xa4, 0xd0, 0x98, 0xdb, 0x5e, 0x87, 0x76,
-	0x95, 0x64, 0xf5, 0x16, 0x49, 0xc4, 0x19, 0xe0, 0xc0, 0x66, 0xe0, 0x50, 0xe6, 0x4a, 0x5d, 0xe1,
-	0x93, 0x86, 0x16, 0xcc, 0x04, 0xb0, 0x2e, 0x45, 0xeb, 0x8e, 0x43, 0x63, 0xc2, 0x77, 0x42, 0x17,
-	0x73, 0xd0, 0x1f, 0xa3, 0x71, 0xec, 0xba, 0x2c, 0xaf, 0x2d, 0x6b, 0xc5, 0xe9, 0x6a, 0xfe, 0xf4,
-	0xa8, 0x34, 0xaf, 0xf0, 0xd6, 0x5d, 0x97, 0x41, 0x14, 0x35, 0x38, 0xf3, 0x49, 0xdb, 0x12, 0x2a,
-	0xbd, 0x8c, 0x26, 0x68, 0x97, 0x00, 0xcb, 0xa7, 0x87, 0xc8, 0xa5, 0x4c, 0x37, 0x10, 0x62, 0xe0,
-	0xc5, 0xc4, 0xc5, 0xad, 0x0e, 0xe4, 0xc7, 0x96, 0xb5, 0xe2, 0x94, 0x35, 0x50, 0x29, 0x9c, 0x4d,
-	0xa0, 0x7f, 0x05, 0x5b, 0x43, 0x80, 0x5b, 0x82, 0x5b, 0x91, 0xad, 0xa1, 0x49, 0x2c, 0x51, 0x87,
-	0xc2, 0xf5, 0x84, 0xfa, 0xff, 0x28, 0xeb, 0xb0, 0xd8, 0xb5, 0xb9, 0x1f, 0x40, 0xc4, 0x71, 0x10,
-	0x0a, 0xd0, 0x31, 0x6b, 0x36, 0xa9, 0x36, 0x7b, 0x45, 0xdd, 0x46, 0x33, 0x04, 0x78, 0xb2, 0x97,
-	0x36, 0xc3, 0x5c, 0x82, 0x4d, 0x57, 0x9f, 0x1e, 0x9f, 0x2f, 0xa5, 0xce, 0xce, 0x97, 0x56, 0xdb,
-	0x3e, 0xdf, 0x8b, 0x5b, 0x65, 0x87, 0x06, 0xea, 0x51, 0xa9, 0x8f, 0x52, 0xe4, 0xee, 0x57, 0xf8,
-	0x61, 0x08, 0x51, 0xb9, 0x46, 0xf8, 0xe9, 0x51, 0x09, 0x29, 0x9a, 0x1a, 0xe1, 0xd6, 0x5f, 0xca,
-	0xd1, 0x4a, 0xd8, 0x3b, 0xe8, 0x1f, 0x8f, 0xd1, 0xf7, 0x40, 0xec, 0x6b, 0x39, 0xe3, 0x23, 0xc8,
-	0xf9, 0x5b, 0x1a, 0x6f, 0x0f, 0xa4, 0x39, 0x28, 0x1b, 0x71, 0xcc, 0x7d, 0xc7, 0x6e, 0xe1, 0x0e,
-	0x26, 0x0e, 0xe4, 0x27, 0x46, 0x10, 0x34, 0x2b, 0x3d, 0xab, 0xd2, 0x32, 0x09, 0x69, 0xc5, 0x9e,
-	0x07, 0xac, 0x1f, 0x92, 0x19, 0x45, 0x88, 0xf4, 0xec, 0x85, 0xd8, 0x68, 0xa6, 0x43, 0x9d, 0xfd,
-	0x7e, 0xc4, 0xe4, 0x28, 0x1e, 0x4c, 0xe2, 0xd8, 0x0b, 0x78, 0x86, 0x32, 0x49, 0x5b, 0x71, 0x94,
-	0x9f, 0x5a, 0xd6, 0x8a, 0xd9, 0xb5, 0x07, 0xe5, 0x9b, 0xa7, 0xb5, 0x2c, 0x87, 0x51, 0x9d, 0x93,
-	0x86, 0x90, 0x5b, 0xea, 0x6f, 0xfa, 0x43, 0x94, 0x8b, 0x80, 0xf3, 0x0e, 0x0c, 0xcc, 0xd8, 0xb4,
-	0x98, 0xb1, 0x39, 0x59, 0xef, 0x4f, 0x59, 0xe1, 0x8b, 0x86, 0x72, 0x62, 0xb8, 0x37, 0x29, 0x73,
-	0xa0, 0x21, 0x7e, 0xfd, 0xcd, 0xf3, 0x06, 0x48, 0xb9, 0xba, 0xfd, 0x2d, 0x49, 0x8f, 0x60, 0x4b,
-	0xb2, 0xca, 0x54, 0xed, 0x4a, 0xe1, 0xab, 0x86, 0x66, 0x04, 0xe9, 0x06, 0x84, 0x34, 0xf2, 0x79,
-	0x42, 0xe9, 0x31, 0x1a, 0x0c, 0xa7, 0x4c, 0x54, 0x7a, 0x11, 0xa5, 0x39, 0x1d, 0xfa, 0x4a, 0x48,
-	0x73, 0xaa, 0x37, 0x51, 0x06, 0x07, 0xe2, 0x48, 0x8f, 0xe2, 0xc8, 0x29, 0xaf, 0xc2, 0x37, 0x0d,
-	0xcd, 0x0a, 0xfc, 0x5d, 0x9f, 0xef, 0xb9, 0x0c, 0x77, 0x15, 0x91, 0x76, 0x07, 0xa2, 0x5e, 0xa7,
-	0xe9, 0x3b, 0x75, 0x7a, 0x3f, 0xfc, 0x3f, 0x35, 0x34, 0x27, 0x07, 0x05, 0xa0, 0xce, 0xe0, 0xc0,
-	0x87, 0xee, 0x1f, 0xbd, 0xfd, 0xb6, 0x50, 0xce, 0x03, 0xb0, 0x43, 0x69, 0x61, 0x27, 0xb1, 0xa2,
-	0xaf, 0xec, 0x5a, 0xe1, 0xb6, 0x31, 0xbf, 0x4a, 0x6b, 0x1e, 0x86, 0x60, 0x65, 0xbd, 0x6b, 0xeb,
-	0xfb, 0xe9, 0xf5, 0xd1, 0x5b, 0x94, 0xbd, 0x9e, 0xab, 0x17, 0x90, 0xb1, 0x69, 0x9a, 0x76, 0xdd,
-	0x32, 0x5f, 0xd5, 0xcc, 0x5d, 0xbb, 0xf9, 0xba, 0x2e, 0x16, 0x5b, 0x2f, 0x9f, 0xbf, 0x30, 0x37,
-	0xec, 0x4d, 0xd3, 0xcc, 0xa5, 0xf4, 0x15, 0xf4, 0xdf, 0x0d, 0xcd, 0xce, 0xf6, 0x80, 0x44, 0x5b,
-	0x1c, 0xff, 0xf0, 0xd9, 0x48, 0x55, 0x6b, 0xc7, 0x17, 0x86, 0x76, 0x72, 0x61, 0x68, 0x3f, 0x2e,
-	0x0c, 0xed, 0xe3, 0xa5, 0x91, 0x3a, 0xb9, 0x34, 0x52, 0xdf, 0x2f, 0x8d, 0xd4, 0x9b, 0xca, 0x00,
-	0x76, 0x8b, 0xb4, 0x4a, 0xce, 0x1e, 0xf6, 0x49, 0x65, 0xe0, 0x0e, 0x7d, 0xd7, 0xbf, 0x45, 0x45,
-	0x0f, 0xad, 0x8c, 0xb8, 0x3e, 0x9f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x68, 0xb8, 0x45, 0x52,
-	0xf1, 0x07, 0x00, 0x00,
+var xxx_messageInfo_EventLowBalanceWarning proto.InternalMessageInfo
+
+func (m *EventLowBalanceWarning) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *EventPaymentAccountUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventLowBalanceWarning) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPaymentAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventLowBalanceWarning) GetFreezeTimestamp() int64 {
+	if m != nil {
+		return m.FreezeTimestamp
+	}
+	return 0
 }
 
-func (m *EventPaymentAccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Refundable {
-		i--
-		if m.Refundable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// EventAutoTopUp is emitted when a payment account is topped up from its auto top-up source
+type EventAutoTopUp struct {
+	// address of the payment account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// address of the source
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// the amount topped up
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventAutoTopUp) Reset()         { *m = EventAutoTopUp{} }
+func (m *EventAutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*EventAutoTopUp) ProtoMessage()    {}
+func (*EventAutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{10}
+}
+func (m *EventAutoTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventAutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoTopUp.Merge(m, src)
+}
+func (m *EventAutoTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoTopUp proto.InternalMessageInfo
+
+func (m *EventAutoTopUp) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventStreamRecordUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAutoTopUp) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventStreamRecordUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMultisigProposalUpdate is emitted when a proposal of a multisig payment account is proposed, approved or executed
+type EventMultisigProposalUpdate struct {
+	// id is the unique id of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payment_account is the address of the multisig payment account
+	PaymentAccount string `protobuf:"bytes,2,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+	// action is the type of the action to execute
+	Action MultisigActionType `protobuf:"varint,3,opt,name=action,proto3,enum=greenfield.payment.MultisigActionType" json:"action,omitempty"`
+	// approvals are the owners approved the proposal
+	Approvals []string `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// executed defines whether the proposal is executed
+	Executed bool `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
-func (m *EventStreamRecordUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettleTimestamp != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SettleTimestamp))
-		i--
-		dAtA[i] = 0x48
-	}
+func (m *EventMultisigProposalUpdate) Reset()         { *m = EventMultisigProposalUpdate{} }
+func (m *EventMultisigProposalUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventMultisigProposalUpdate) ProtoMessage()    {}
+func (*EventMultisigProposalUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{11}
+}
+func (m *EventMultisigProposalUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultisigProposalUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultisigProposalUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultisigProposalUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultisigProposalUpdate.Merge(m, src)
+}
+func (m *EventMultisigProposalUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultisigProposalUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultisigProposalUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultisigProposalUpdate proto.InternalMessageInfo
+
+func (m *EventMultisigProposalUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventMultisigProposalUpdate) GetPaymentAccount() string {
+	if m != nil {
+		return m.PaymentAccount
+	}
+	return ""
+}
+
+func (m *EventMultisigProposalUpdate) GetAction() MultisigActionType {
+	if m != nil {
+		return m.Action
+	}
+	return MULTISIG_ACTION_WITHDRAW
+}
+
+func (m *EventMultisigProposalUpdate) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *EventMultisigProposalUpdate) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// EventMultisigProposalExpire is emitted when a proposal of a multisig payment account is pruned after it expires
+type EventMultisigProposalExpire struct {
+	// id is the unique id of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payment_account is the address of the multisig payment account
+	PaymentAccount string `protobuf:"bytes,2,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+}
+
+func (m *EventMultisigProposalExpire) Reset()         { *m = EventMultisigProposalExpire{} }
+func (m *EventMultisigProposalExpire) String() string { return proto.CompactTextString(m) }
+func (*EventMultisigProposalExpire) ProtoMessage()    {}
+func (*EventMultisigProposalExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{12}
+}
+func (m *EventMultisigProposalExpire) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultisigProposalExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultisigProposalExpire.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultisigProposalExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultisigProposalExpire.Merge(m, src)
+}
+func (m *EventMultisigProposalExpire) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultisigProposalExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultisigProposalExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultisigProposalExpire proto.InternalMessageInfo
+
+func (m *EventMultisigProposalExpire) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventMultisigProposalExpire) GetPaymentAccount() string {
+	if m != nil {
+		return m.PaymentAccount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.payment.FeePreviewType", FeePreviewType_name, FeePreviewType_value)
+	proto.RegisterType((*EventPaymentAccountUpdate)(nil), "greenfield.payment.EventPaymentAccountUpdate")
+	proto.RegisterType((*EventStreamRecordUpdate)(nil), "greenfield.payment.EventStreamRecordUpdate")
+	proto.RegisterType((*EventForceSettle)(nil), "greenfield.payment.EventForceSettle")
+	proto.RegisterType((*EventDeposit)(nil), "greenfield.payment.EventDeposit")
+	proto.RegisterType((*EventWithdraw)(nil), "greenfield.payment.EventWithdraw")
+	proto.RegisterType((*EventCancelDelayedWithdrawal)(nil), "greenfield.payment.EventCancelDelayedWithdrawal")
+	proto.RegisterType((*EventReadVoucherUpdate)(nil), "greenfield.payment.EventReadVoucherUpdate")
+	proto.RegisterType((*EventReadVoucherExpire)(nil), "greenfield.payment.EventReadVoucherExpire")
+	proto.RegisterType((*EventFeePreview)(nil), "greenfield.payment.EventFeePreview")
+	proto.RegisterType((*EventLowBalanceWarning)(nil), "greenfield.payment.EventLowBalanceWarning")
+	proto.RegisterType((*EventAutoTopUp)(nil), "greenfield.payment.EventAutoTopUp")
+	proto.RegisterType((*EventMultisigProposalUpdate)(nil), "greenfield.payment.EventMultisigProposalUpdate")
+	proto.RegisterType((*EventMultisigProposalExpire)(nil), "greenfield.payment.EventMultisigProposalExpire")
+}
+
+func init() { proto.RegisterFile("greenfield/payment/events.proto", fileDescriptor_befcc80e27bc8df9) }
+
+var fileDescriptor_befcc80e27bc8df9 = []byte{
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x4f, 0x1b, 0x57,
+	0x14, 0xf6, 0xd8, 0xc6, 0xc1, 0x07, 0x18, 0xe8, 0x34, 0x6a, 0x1d, 0x9a, 0x18, 0x62, 0xa9, 0x84,
+	0x56, 0xc5, 0x46, 0x54, 0xea, 0xaa, 0x4a, 0x05, 0xc1, 0x48, 0xa8, 0x34, 0xa5, 0x03, 0x04, 0xb5,
+	0x55, 0x35, 0xba, 0x9e, 0x39, 0xb6, 0x47, 0x8c, 0xe7, 0x4e, 0xef, 0xdc, 0xe1, 0x91, 0x75, 0x17,
+	0xdd, 0xb5, 0xff, 0xa1, 0x8b, 0xfe, 0x81, 0x2c, 0xdb, 0x45, 0x37, 0x15, 0xcb, 0x28, 0xab, 0x2a,
+	0x8b, 0x28, 0x82, 0x55, 0xff, 0x45, 0x75, 0x1f, 0x7e, 0x20, 0x08, 0xb6, 0x53, 0xb3, 0x82, 0x39,
+	0xf7, 0xf3, 0x39, 0xdf, 0x77, 0xe6, 0x3c, 0xee, 0xc0, 0x5c, 0x83, 0x21, 0x86, 0x75, 0x1f, 0x03,
+	0xaf, 0x12, 0x91, 0x93, 0x16, 0x86, 0xbc, 0x82, 0x87, 0x18, 0xf2, 0xb8, 0x1c, 0x31, 0xca, 0xa9,
+	0x65, 0x75, 0x01, 0x65, 0x0d, 0x98, 0xbd, 0xe3, 0xd2, 0xb8, 0x45, 0x63, 0x47, 0x22, 0x2a, 0xea,
+	0x41, 0xc1, 0x67, 0x6f, 0x37, 0x68, 0x83, 0x2a, 0xbb, 0xf8, 0x4f, 0x5b, 0xef, 0x5f, 0x11, 0xa5,
+	0x95, 0x04, 0xdc, 0x8f, 0xfd, 0xc6, 0x35, 0x10, 0x9a, 0x70, 0xa7, 0x1e, 0xd0, 0x23, 0x0d, 0x59,
+	0xb8, 0x02, 0x12, 0x73, 0x86, 0xa4, 0xe5, 0x30, 0x74, 0x29, 0xf3, 0x14, 0xae, 0xf4, 0xaf, 0x01,
+	0x77, 0xaa, 0x42, 0xc3, 0xb6, 0x02, 0xad, 0xba, 0x2e, 0x4d, 0x42, 0xbe, 0x17, 0x79, 0x84, 0xa3,
+	0xf5, 0x09, 0x64, 0x89, 0xe7, 0xb1, 0x82, 0x31, 0x6f, 0x2c, 0xe6, 0xd7, 0x0a, 0x2f, 0x9e, 0x2d,
+	0xdd, 0xd6, 0x0a, 0x56, 0x3d, 0x8f, 0x61, 0x1c, 0xef, 0x70, 0xe6, 0x87, 0x0d, 0x5b, 0xa2, 0xac,
+	0x32, 0x8c, 0xd1, 0xa3, 0x10, 0x59, 0x21, 0xdd, 0x07, 0xae, 0x60, 0x56, 0x11, 0x80, 0x61, 0x3d,
+	0x09, 0x3d, 0x52, 0x0b, 0xb0, 0x90, 0x99, 0x37, 0x16, 0xc7, 0xed, 0x1e, 0x8b, 0xb5, 0x0c, 0x39,
+	0x09, 0x8c, 0x0b, 0xd9, 0xf9, 0xcc, 0xb5, 0x0e, 0x35, 0xce, 0xba, 0x0b, 0x79, 0xde, 0x64, 0x18,
+	0x37, 0x69, 0xe0, 0x15, 0xc6, 0xe6, 0x8d, 0xc5, 0x29, 0xbb, 0x6b, 0x28, 0xbd, 0x1c, 0x83, 0xf7,
+	0xa5, 0xd6, 0x1d, 0x99, 0x08, 0x5b, 0xe6, 0x41, 0x2b, 0x5d, 0x81, 0x5b, 0x44, 0x49, 0xef, 0x2b,
+	0xb6, 0x0d, 0xb4, 0x3e, 0x04, 0xd3, 0x65, 0x89, 0xe7, 0x70, 0xbf, 0x85, 0x31, 0x27, 0xad, 0x48,
+	0x0a, 0xcf, 0xd8, 0x53, 0xc2, 0xba, 0xdb, 0x36, 0x5a, 0x0e, 0x4c, 0x86, 0xc8, 0xc5, 0xbb, 0x71,
+	0x18, 0xe1, 0x4a, 0x68, 0x7e, 0xed, 0xf3, 0xd3, 0x57, 0x73, 0xa9, 0x97, 0xaf, 0xe6, 0x16, 0x1a,
+	0x3e, 0x6f, 0x26, 0xb5, 0xb2, 0x4b, 0x5b, 0xba, 0x3a, 0xf4, 0x9f, 0xa5, 0xd8, 0x3b, 0xa8, 0xf0,
+	0x93, 0x08, 0xe3, 0xf2, 0x66, 0xc8, 0x5f, 0x3c, 0x5b, 0x02, 0xcd, 0x66, 0x33, 0xe4, 0xf6, 0x84,
+	0xf6, 0x68, 0x0b, 0xee, 0x01, 0xbc, 0x5b, 0x67, 0xf4, 0x29, 0x86, 0xce, 0x85, 0x38, 0xd9, 0x11,
+	0xc4, 0x79, 0x47, 0x39, 0x7e, 0xdc, 0x13, 0xcd, 0x05, 0x33, 0xe6, 0x84, 0xfb, 0xae, 0x53, 0x23,
+	0x01, 0x09, 0x5d, 0x94, 0x89, 0xfe, 0xbf, 0x81, 0xa6, 0x94, 0xcf, 0x35, 0xe5, 0x52, 0x04, 0xa9,
+	0x25, 0xf5, 0x3a, 0xb2, 0x4e, 0x90, 0xdc, 0x28, 0x82, 0x28, 0x9f, 0xed, 0x20, 0x0e, 0x4c, 0x06,
+	0xd4, 0x3d, 0xe8, 0x84, 0xb8, 0x35, 0x8a, 0x17, 0x23, 0x3c, 0xb6, 0x03, 0x7c, 0x01, 0x39, 0x21,
+	0x2b, 0x89, 0x0b, 0xe3, 0xf3, 0xc6, 0xa2, 0xb9, 0xf2, 0xa0, 0x7c, 0x79, 0x40, 0x94, 0x55, 0x31,
+	0xea, 0xbe, 0xdb, 0x91, 0x70, 0x5b, 0xff, 0xcc, 0xfa, 0x08, 0x66, 0x62, 0xe4, 0x3c, 0xc0, 0x9e,
+	0x1a, 0xcb, 0xcb, 0x1a, 0x9b, 0x56, 0xf6, 0x4e, 0x95, 0x95, 0x7e, 0x37, 0x60, 0x46, 0x16, 0xf7,
+	0x06, 0x65, 0x2e, 0xee, 0xc8, 0xd3, 0x21, 0xfb, 0x17, 0x41, 0x7b, 0xf5, 0x3a, 0x29, 0x49, 0x8f,
+	0x20, 0x25, 0xa6, 0x76, 0xaa, 0xb3, 0x52, 0xfa, 0xc3, 0x80, 0x49, 0xc9, 0x74, 0x1d, 0x23, 0x1a,
+	0xfb, 0x5c, 0xb0, 0xac, 0x33, 0xda, 0xea, 0xcf, 0x52, 0xa0, 0xac, 0x45, 0x48, 0x73, 0xda, 0x77,
+	0xc4, 0xa4, 0x39, 0xb5, 0x76, 0x21, 0x47, 0x5a, 0xb2, 0xa5, 0x47, 0xd1, 0x72, 0xda, 0x57, 0xe9,
+	0x4f, 0x03, 0xa6, 0x24, 0xfd, 0x7d, 0x9f, 0x37, 0x3d, 0x46, 0x8e, 0x34, 0x23, 0x63, 0x00, 0x46,
+	0x6d, 0xa5, 0xe9, 0x81, 0x94, 0xde, 0x0c, 0xff, 0xd7, 0x06, 0xdc, 0x95, 0xfc, 0x1f, 0x89, 0xb7,
+	0x11, 0xac, 0x63, 0x40, 0x4e, 0xd0, 0x6b, 0x8b, 0x21, 0xc1, 0x90, 0x45, 0x63, 0x42, 0xda, 0xf7,
+	0xa4, 0xa0, 0xac, 0x9d, 0xf6, 0xbd, 0x8e, 0xc4, 0xcc, 0x90, 0x12, 0xb3, 0x23, 0x94, 0x78, 0x9a,
+	0x86, 0xf7, 0xa4, 0x44, 0x1b, 0x89, 0xf7, 0x84, 0x26, 0x6e, 0x13, 0x99, 0x9e, 0xf3, 0x8a, 0xae,
+	0xd1, 0xa1, 0xbb, 0x0c, 0x39, 0x3f, 0x8e, 0x93, 0x01, 0x96, 0x96, 0xc6, 0x59, 0xdf, 0x43, 0xbe,
+	0x96, 0xb8, 0x07, 0xc8, 0x1d, 0xdf, 0xd3, 0x2a, 0x1f, 0x6a, 0xd6, 0x0f, 0x06, 0x60, 0xbd, 0xe7,
+	0x4b, 0xda, 0x13, 0x3a, 0x86, 0x78, 0xb4, 0xc7, 0x95, 0xc3, 0x4d, 0x49, 0x47, 0xac, 0x2a, 0x64,
+	0x3a, 0x1f, 0xd7, 0xd0, 0x51, 0x38, 0xeb, 0x9e, 0x58, 0xa2, 0xc4, 0x73, 0x7e, 0x4c, 0x28, 0x27,
+	0x72, 0x14, 0x67, 0xed, 0xbc, 0xb0, 0x7c, 0x23, 0x0c, 0xe2, 0x38, 0x89, 0xb1, 0x7d, 0x9c, 0x53,
+	0xc7, 0xc2, 0xa2, 0x8e, 0xe7, 0x60, 0x02, 0x8f, 0x23, 0x9f, 0xa9, 0x01, 0x23, 0x27, 0x60, 0xc6,
+	0x06, 0x65, 0x12, 0xb3, 0xa5, 0xf4, 0x97, 0x71, 0x39, 0x95, 0x55, 0x79, 0x7c, 0x29, 0x95, 0xab,
+	0x30, 0xad, 0x67, 0x9a, 0x43, 0x14, 0xd5, 0xbe, 0x39, 0x35, 0xf5, 0x0f, 0xb4, 0x55, 0x94, 0x83,
+	0xda, 0xff, 0xa3, 0xa9, 0x78, 0xe5, 0x4b, 0xdc, 0x71, 0xa6, 0xd5, 0x68, 0x44, 0xdc, 0x66, 0x78,
+	0xe8, 0xe3, 0xd1, 0x5b, 0xed, 0xfb, 0x2d, 0x98, 0xa9, 0x23, 0x3a, 0x91, 0x72, 0xe1, 0x88, 0xb0,
+	0x52, 0xa1, 0xb9, 0x52, 0xba, 0x6a, 0xb0, 0x77, 0xa3, 0xed, 0x9e, 0x44, 0x68, 0x9b, 0xf5, 0x0b,
+	0xcf, 0x37, 0xd4, 0xdd, 0xbf, 0xb4, 0xdf, 0xd7, 0x16, 0x3d, 0xd2, 0x03, 0x77, 0x9f, 0xb0, 0xd0,
+	0x0f, 0x1b, 0x43, 0xf6, 0xf5, 0x85, 0xab, 0x94, 0x6a, 0xef, 0xae, 0x41, 0x2c, 0xa6, 0x3a, 0x43,
+	0x7c, 0xda, 0xbb, 0x98, 0x32, 0x6a, 0x31, 0x29, 0x7b, 0x77, 0x31, 0xfd, 0x6d, 0x80, 0x29, 0x19,
+	0xad, 0x26, 0x9c, 0xee, 0xd2, 0x68, 0x2f, 0x1a, 0x92, 0xc9, 0x32, 0xe4, 0x62, 0x9a, 0xb0, 0xce,
+	0x36, 0xba, 0xa6, 0x27, 0x14, 0xee, 0x86, 0x52, 0xfb, 0x53, 0x1a, 0x3e, 0x90, 0x42, 0xbe, 0xd2,
+	0xb7, 0xf1, 0x6d, 0x46, 0x23, 0x1a, 0x93, 0xe0, 0x0d, 0xa3, 0xa5, 0xb7, 0x1f, 0x74, 0xa9, 0x0d,
+	0xdc, 0x0f, 0xba, 0xe2, 0x1e, 0x42, 0x8e, 0xb8, 0xdc, 0xa7, 0xa1, 0x14, 0x62, 0xae, 0x2c, 0x5c,
+	0x55, 0x67, 0x6d, 0x3a, 0xab, 0x12, 0x29, 0x6b, 0x4d, 0xff, 0xca, 0xfa, 0x0c, 0xf2, 0x24, 0x8a,
+	0x18, 0x3d, 0x24, 0x41, 0xff, 0x4b, 0x74, 0x17, 0x6a, 0xcd, 0xc2, 0x38, 0x1e, 0xa3, 0x9b, 0x70,
+	0x54, 0xd7, 0xe8, 0x71, 0xbb, 0xf3, 0x5c, 0x8a, 0xde, 0x90, 0x85, 0x01, 0xa6, 0xc2, 0xdb, 0x65,
+	0xe1, 0xe3, 0x1f, 0xc0, 0xbc, 0xd8, 0x4b, 0x56, 0x09, 0x8a, 0x1b, 0xd5, 0xaa, 0xb3, 0x6d, 0x57,
+	0x9f, 0x6c, 0x56, 0xf7, 0x9d, 0xdd, 0x6f, 0xb7, 0xe5, 0xc3, 0xd6, 0xd7, 0x8f, 0xbe, 0xac, 0xae,
+	0x3b, 0x1b, 0xd5, 0xea, 0x4c, 0xca, 0xba, 0x0f, 0xf7, 0x2e, 0x61, 0xf6, 0x1e, 0xf7, 0x40, 0x8c,
+	0xd9, 0xec, 0xcf, 0xbf, 0x15, 0x53, 0x6b, 0x9b, 0xa7, 0x67, 0x45, 0xe3, 0xf9, 0x59, 0xd1, 0x78,
+	0x7d, 0x56, 0x34, 0x7e, 0x3d, 0x2f, 0xa6, 0x9e, 0x9f, 0x17, 0x53, 0xff, 0x9c, 0x17, 0x53, 0xdf,
+	0x55, 0x7a, 0xea, 0xa5, 0x16, 0xd6, 0x96, 0xdc, 0x26, 0xf1, 0xc3, 0x4a, 0xcf, 0x97, 0xd5, 0x71,
+	0xe7, 0xdb, 0x4a, 0x16, 0x4f, 0x2d, 0x27, 0x3f, 0xaa, 0x3e, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff,
+	0xcc, 0x5b, 0x3c, 0xb6, 0x2a, 0x0e, 0x00, 0x00,
+}
+
+func (m *EventPaymentAccountUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentAccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Refundable {
+		i--
+		if m.Refundable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStreamRecordUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStreamRecordUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStreamRecordUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettleTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettleTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Status != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
@@ -754,7 +1291,7 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelDelayedWithdrawal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -764,12 +1301,12 @@ func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventFeePreview) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelDelayedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelDelayedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -783,163 +1320,1576 @@ func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.FeePreviewType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FeePreviewType))
+	dAtA[i] = 0x22
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventReadVoucherUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventPaymentAccountUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Refundable {
-		n += 2
-	}
-	return n
+
+func (m *EventReadVoucherUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStreamRecordUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventReadVoucherUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExpireTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.CrudTimestamp != 0 {
-		n += 1 + sovEvents(uint64(m.CrudTimestamp))
+	if m.UsedQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UsedQuota))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.NetflowRate.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.FrozenNetflowRate.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.StaticBalance.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.BufferBalance.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.LockBalance.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.ReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.SettleTimestamp != 0 {
-		n += 1 + sovEvents(uint64(m.SettleTimestamp))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *EventForceSettle) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReadVoucherExpire) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReadVoucherExpire) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReadVoucherExpire) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Refund.Size()
+		i -= size
+		if _, err := m.Refund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.SettledBalance.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeePreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.FeePreviewType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FeePreviewType))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdraw) Size() (n int) {
+func (m *EventLowBalanceWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLowBalanceWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLowBalanceWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreezeTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FreezeTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultisigProposalUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultisigProposalUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultisigProposalUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultisigProposalExpire) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultisigProposalExpire) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultisigProposalExpire) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPaymentAccountUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.To)
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.From)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
+	if m.Refundable {
+		n += 2
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEvents(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *EventStreamRecordUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CrudTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.CrudTimestamp))
+	}
+	l = m.NetflowRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.FrozenNetflowRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.StaticBalance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.BufferBalance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.LockBalance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.SettleTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.SettleTimestamp))
+	}
+	return n
+}
+
+func (m *EventForceSettle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SettledBalance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCancelDelayedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventReadVoucherUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ReadQuota))
+	}
+	if m.UsedQuota != 0 {
+		n += 1 + sovEvents(uint64(m.UsedQuota))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovEvents(uint64(m.ExpireTime))
+	}
+	return n
+}
+
+func (m *EventReadVoucherExpire) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFeePreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FeePreviewType != 0 {
+		n += 1 + sovEvents(uint64(m.FeePreviewType))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventLowBalanceWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEvents(uint64(m.Threshold))
+	}
+	if m.FreezeTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.FreezeTimestamp))
+	}
+	return n
+}
+
+func (m *EventAutoTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventMultisigProposalUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEvents(uint64(m.Action))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMultisigProposalExpire) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPaymentAccountUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPaymentAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refundable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamRecordUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamRecordUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrudTimestamp", wireType)
+			}
+			m.CrudTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrudTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StreamAccountStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleTimestamp", wireType)
+			}
+			m.SettleTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettleTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForceSettle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForceSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventFeePreview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.FeePreviewType != 0 {
-		n += 1 + sovEvents(uint64(m.FeePreviewType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCancelDelayedWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,10 +2912,10 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentAccountUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelDelayedWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelDelayedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1001,8 +2951,27 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1030,13 +2999,13 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refundable", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1046,12 +3015,26 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Refundable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1073,7 +3056,7 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventReadVoucherUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1096,17 +3079,17 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamRecordUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReadVoucherUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamRecordUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReadVoucherUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1116,29 +3099,16 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrudTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			m.CrudTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1148,14 +3118,27 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CrudTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,13 +3166,13 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1217,13 +3200,137 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
+			}
+			m.ReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedQuota", wireType)
+			}
+			m.UsedQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReadVoucherExpire) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReadVoucherExpire: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReadVoucherExpire: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1251,13 +3358,11 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1285,13 +3390,63 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeePreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeePreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeePreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1319,15 +3474,13 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePreviewType", wireType)
 			}
-			m.Status = 0
+			m.FeePreviewType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1337,16 +3490,16 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= StreamAccountStatus(b&0x7F) << shift
+				m.FeePreviewType |= FeePreviewType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettleTimestamp", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.SettleTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1356,11 +3509,26 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettleTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1382,7 +3550,7 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
+func (m *EventLowBalanceWarning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,10 +3573,10 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventForceSettle: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLowBalanceWarning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventForceSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLowBalanceWarning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1444,10 +3612,10 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1457,26 +3625,30 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Threshold |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeTimestamp", wireType)
 			}
-			if err := m.SettledBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FreezeTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreezeTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1498,7 +3670,7 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventAutoTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,15 +3693,15 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAutoTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAutoTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,11 +3729,11 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1589,7 +3761,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1646,7 +3818,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventMultisigProposalUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,17 +3841,17 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultisigProposalUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultisigProposalUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1689,27 +3861,14 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,11 +3896,30 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= MultisigActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1769,10 +3947,28 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1794,7 +3990,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFeePreview) Unmarshal(dAtA []byte) error {
+func (m *EventMultisigProposalExpire) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,49 +4013,17 @@ func (m *EventFeePreview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFeePreview: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultisigProposalExpire: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFeePreview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultisigProposalExpire: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePreviewType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.FeePreviewType = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1869,14 +4033,14 @@ func (m *EventFeePreview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeePreviewType |= FeePreviewType(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1904,9 +4068,7 @@ func (m *EventFeePreview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
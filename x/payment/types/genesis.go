@@ -12,11 +12,13 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		StreamRecordList:        []StreamRecord{},
-		PaymentAccountCountList: []PaymentAccountCount{},
-		PaymentAccountList:      []PaymentAccount{},
-		AutoSettleRecordList:    []AutoSettleRecord{},
-		Params:                  DefaultParams(),
+		StreamRecordList:              []StreamRecord{},
+		PaymentAccountCountList:       []PaymentAccountCount{},
+		PaymentAccountList:            []PaymentAccount{},
+		AutoSettleRecordList:          []AutoSettleRecord{},
+		PaymentAccountTransferList:    []PaymentAccountTransfer{},
+		TransferredPaymentAccountList: []string{},
+		Params:                        DefaultParams(),
 	}
 }
 
@@ -65,5 +67,33 @@ func (gs GenesisState) Validate() error {
 		autoSettleRecordIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index in paymentAccountTransfer
+	paymentAccountTransferIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PaymentAccountTransferList {
+		index := string(PaymentAccountTransferKey(sdk.MustAccAddressFromHex(elem.Addr)))
+		if _, ok := paymentAccountTransferIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for paymentAccountTransfer")
+		}
+		if _, ok := paymentAccountIndexMap[index]; !ok {
+			return fmt.Errorf("payment account of paymentAccountTransfer not found")
+		}
+		paymentAccountTransferIndexMap[index] = struct{}{}
+	}
+
+	// Check the transferred paymentAccount are unique and exist
+	transferredPaymentAccountIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TransferredPaymentAccountList {
+		index := string(PaymentAccountKey(sdk.MustAccAddressFromHex(elem)))
+		if _, ok := transferredPaymentAccountIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transferredPaymentAccount")
+		}
+		if _, ok := paymentAccountIndexMap[index]; !ok {
+			return fmt.Errorf("payment account of transferredPaymentAccount not found")
+		}
+		transferredPaymentAccountIndexMap[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,10 @@ type GenesisState struct {
 	PaymentAccountCountList []PaymentAccountCount `protobuf:"bytes,3,rep,name=payment_account_count_list,json=paymentAccountCountList,proto3" json:"payment_account_count_list"`
 	PaymentAccountList      []PaymentAccount      `protobuf:"bytes,4,rep,name=payment_account_list,json=paymentAccountList,proto3" json:"payment_account_list"`
 	AutoSettleRecordList    []AutoSettleRecord    `protobuf:"bytes,5,rep,name=auto_settle_record_list,json=autoSettleRecordList,proto3" json:"auto_settle_record_list"`
+	// the pending transfers of the ownership of payment accounts
+	PaymentAccountTransferList []PaymentAccountTransfer `protobuf:"bytes,6,rep,name=payment_account_transfer_list,json=paymentAccountTransferList,proto3" json:"payment_account_transfer_list"`
+	// the addresses of the payment accounts transferred to owners other than their creators
+	TransferredPaymentAccountList []string `protobuf:"bytes,7,rep,name=transferred_payment_account_list,json=transferredPaymentAccountList,proto3" json:"transferred_payment_account_list,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +104,20 @@ func (m *GenesisState) GetAutoSettleRecordList() []AutoSettleRecord {
 	return nil
 }
 
+func (m *GenesisState) GetPaymentAccountTransferList() []PaymentAccountTransfer {
+	if m != nil {
+		return m.PaymentAccountTransferList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferredPaymentAccountList() []string {
+	if m != nil {
+		return m.TransferredPaymentAccountList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "greenfield.payment.GenesisState")
 }
@@ -107,30 +125,34 @@ func init() {
 func init() { proto.RegisterFile("greenfield/payment/genesis.proto", fileDescriptor_88f7a8547128dee5) }
 
 var fileDescriptor_88f7a8547128dee5 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x6b, 0xfa, 0x40,
-	0x14, 0xc7, 0x93, 0x9f, 0xfe, 0x1c, 0x62, 0x87, 0x72, 0x08, 0x4a, 0x86, 0x18, 0xa4, 0xb4, 0x42,
-	0x69, 0x02, 0x76, 0xe9, 0xaa, 0x1d, 0x4a, 0xa1, 0x43, 0xd1, 0x4e, 0x2e, 0xe1, 0x12, 0xaf, 0x31,
-	0xc5, 0xe4, 0x42, 0xee, 0x05, 0xea, 0x7f, 0xd1, 0x3f, 0xcb, 0x6e, 0x8e, 0x9d, 0x4a, 0xd1, 0x7f,
-	0xa4, 0xf8, 0x72, 0x6d, 0x13, 0x3d, 0x70, 0xc9, 0x85, 0xbb, 0xcf, 0x7d, 0x3f, 0xef, 0x1e, 0xcf,
-	0xb0, 0xc3, 0x8c, 0xb1, 0xe4, 0x39, 0x62, 0x8b, 0x99, 0x9b, 0xd2, 0x65, 0xcc, 0x12, 0x70, 0x43,
-	0x96, 0x30, 0x11, 0x09, 0x27, 0xcd, 0x38, 0x70, 0x42, 0xfe, 0x08, 0x47, 0x12, 0x66, 0x2b, 0xe4,
-	0x21, 0xc7, 0x63, 0x77, 0xf7, 0x57, 0x90, 0xe6, 0xa5, 0x22, 0x8b, 0xe6, 0xc0, 0x3d, 0xc1, 0x00,
-	0x16, 0xcc, 0xcb, 0x58, 0xc0, 0xb3, 0x99, 0x84, 0xbb, 0x0a, 0x38, 0xa5, 0x19, 0x8d, 0xa5, 0xd7,
-	0xec, 0x2b, 0x01, 0x5c, 0x3d, 0x1a, 0x04, 0x3c, 0x4f, 0x40, 0x92, 0xce, 0x71, 0xd2, 0x2b, 0xf3,
-	0xe7, 0x0a, 0x5e, 0x40, 0xc6, 0x68, 0x5c, 0x29, 0xb1, 0xf7, 0x5e, 0x33, 0x4e, 0xee, 0x8a, 0x5e,
-	0x4c, 0x80, 0x02, 0x23, 0x37, 0x46, 0xa3, 0x28, 0xb1, 0xa3, 0xdb, 0x7a, 0xbf, 0x39, 0x28, 0x9b,
-	0x7f, 0x7a, 0xe3, 0x3c, 0x22, 0x31, 0xaa, 0xaf, 0x3e, 0xbb, 0xda, 0x58, 0xf2, 0xe4, 0xc9, 0x20,
-	0x15, 0x83, 0xb7, 0x88, 0x04, 0x74, 0xfe, 0xd9, 0xb5, 0x7e, 0x73, 0x60, 0xab, 0x52, 0x26, 0x48,
-	0x8f, 0x11, 0x96, 0x59, 0xa7, 0xa2, 0xb4, 0xf7, 0x10, 0x09, 0x20, 0x2f, 0x86, 0xa9, 0x7c, 0x67,
-	0x91, 0x5e, 0xc3, 0xf4, 0x0b, 0x75, 0x8d, 0xb8, 0x0e, 0x8b, 0x4b, 0xb7, 0xbb, 0x8f, 0x94, 0xb4,
-	0xd3, 0xc3, 0x23, 0x74, 0x4d, 0x8d, 0xd6, 0xbe, 0x0b, 0x2d, 0x75, 0xb4, 0xf4, 0x8e, 0x5b, 0xa4,
-	0x80, 0x54, 0x05, 0x98, 0x4d, 0x8d, 0xf6, 0xe1, 0x9c, 0x14, 0xf1, 0xff, 0x31, 0xfe, 0x4c, 0x15,
-	0x3f, 0xcc, 0x81, 0x4f, 0xf0, 0x46, 0xa5, 0x4d, 0x2d, 0xba, 0xb7, 0xbf, 0x53, 0x8c, 0xee, 0x57,
-	0x1b, 0x4b, 0x5f, 0x6f, 0x2c, 0xfd, 0x6b, 0x63, 0xe9, 0x6f, 0x5b, 0x4b, 0x5b, 0x6f, 0x2d, 0xed,
-	0x63, 0x6b, 0x69, 0x53, 0x37, 0x8c, 0x60, 0x9e, 0xfb, 0x4e, 0xc0, 0x63, 0xd7, 0x4f, 0xfc, 0xab,
-	0x60, 0x4e, 0xa3, 0xc4, 0x2d, 0x8d, 0xc8, 0xeb, 0xef, 0x90, 0xc0, 0x32, 0x65, 0xc2, 0x6f, 0xe0,
-	0x74, 0x5c, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x05, 0x75, 0x66, 0xef, 0x3b, 0x03, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0xaa, 0xda, 0x40,
+	0x14, 0x87, 0x93, 0xfa, 0xa7, 0x74, 0xec, 0xa2, 0x04, 0x41, 0x09, 0x18, 0x83, 0x94, 0x36, 0xb4,
+	0x34, 0x01, 0xbb, 0xe9, 0x56, 0xbb, 0x90, 0x42, 0x17, 0xa2, 0xae, 0xdc, 0x84, 0x49, 0x1c, 0x63,
+	0x8a, 0xc9, 0x84, 0x99, 0x09, 0xd4, 0x27, 0xe8, 0xb6, 0x8f, 0xe5, 0xd2, 0xe5, 0x5d, 0x5d, 0x2e,
+	0xfa, 0x22, 0x17, 0x4f, 0xc6, 0x7b, 0x13, 0x1d, 0x70, 0x93, 0x09, 0x33, 0xdf, 0xfc, 0xbe, 0x33,
+	0x87, 0x83, 0xec, 0x88, 0x11, 0x92, 0xae, 0x63, 0xb2, 0x5d, 0x79, 0x19, 0xde, 0x25, 0x24, 0x15,
+	0x5e, 0x44, 0x52, 0xc2, 0x63, 0xee, 0x66, 0x8c, 0x0a, 0x6a, 0x18, 0xaf, 0x84, 0x2b, 0x09, 0xb3,
+	0x1d, 0xd1, 0x88, 0xc2, 0xb1, 0x77, 0xfe, 0x2b, 0x48, 0xf3, 0xab, 0x22, 0x0b, 0xe7, 0x82, 0xfa,
+	0x9c, 0x08, 0xb1, 0x25, 0x3e, 0x23, 0x21, 0x65, 0x2b, 0x09, 0xf7, 0x15, 0x70, 0x86, 0x19, 0x4e,
+	0xa4, 0xd7, 0x74, 0x94, 0x00, 0xac, 0x3e, 0x0e, 0x43, 0x9a, 0xa7, 0x42, 0x92, 0xee, 0x7d, 0xd2,
+	0x2f, 0xf3, 0x9f, 0x14, 0x3c, 0x17, 0x8c, 0xe0, 0xa4, 0x52, 0xe2, 0xe0, 0x5f, 0x03, 0xbd, 0x9f,
+	0x14, 0xbd, 0x98, 0x0b, 0x2c, 0x88, 0xf1, 0x03, 0x35, 0x8b, 0x12, 0xbb, 0xba, 0xad, 0x3b, 0xad,
+	0x61, 0xd9, 0x7c, 0xe9, 0x8d, 0x3b, 0x05, 0x62, 0x5c, 0xdf, 0x3f, 0xf6, 0xb5, 0x99, 0xe4, 0x8d,
+	0x05, 0x32, 0x2a, 0x06, 0x7f, 0x1b, 0x73, 0xd1, 0x7d, 0x63, 0xd7, 0x9c, 0xd6, 0xd0, 0x56, 0xa5,
+	0xcc, 0x81, 0x9e, 0x01, 0x2c, 0xb3, 0x3e, 0xf0, 0xd2, 0xde, 0xef, 0x98, 0x0b, 0xe3, 0x0f, 0x32,
+	0x95, 0xef, 0x2c, 0xd2, 0x6b, 0x90, 0xfe, 0x59, 0x5d, 0x23, 0xac, 0xa3, 0xe2, 0xd2, 0xcf, 0xf3,
+	0x47, 0x4a, 0x3a, 0xd9, 0xed, 0x11, 0xb8, 0x96, 0xa8, 0x7d, 0xed, 0x02, 0x4b, 0x1d, 0x2c, 0x83,
+	0xfb, 0x16, 0x29, 0x30, 0xaa, 0x02, 0xc8, 0xc6, 0xa8, 0x73, 0x3b, 0x27, 0x45, 0x7c, 0x03, 0xe2,
+	0x3f, 0xaa, 0xe2, 0x47, 0xb9, 0xa0, 0x73, 0xb8, 0x51, 0x69, 0x53, 0x1b, 0x5f, 0xed, 0x83, 0x82,
+	0xa3, 0xde, 0x75, 0xf9, 0x82, 0xe1, 0x94, 0xaf, 0x09, 0x2b, 0x44, 0x4d, 0x10, 0x7d, 0xb9, 0xff,
+	0x8e, 0x85, 0xbc, 0x26, 0x75, 0x66, 0xa6, 0x3c, 0x05, 0xe9, 0x04, 0xd9, 0x17, 0x09, 0x23, 0x2b,
+	0x5f, 0xd9, 0xbf, 0xb7, 0x76, 0xcd, 0x79, 0x37, 0xeb, 0x95, 0xb8, 0xe9, 0x4d, 0x83, 0xc6, 0xbf,
+	0xf6, 0x47, 0x4b, 0x3f, 0x1c, 0x2d, 0xfd, 0xe9, 0x68, 0xe9, 0xff, 0x4f, 0x96, 0x76, 0x38, 0x59,
+	0xda, 0xc3, 0xc9, 0xd2, 0x96, 0x5e, 0x14, 0x8b, 0x4d, 0x1e, 0xb8, 0x21, 0x4d, 0xbc, 0x20, 0x0d,
+	0xbe, 0x85, 0x1b, 0x1c, 0xa7, 0x5e, 0x69, 0xc0, 0xff, 0xbe, 0x8c, 0xb8, 0xd8, 0x65, 0x84, 0x07,
+	0x4d, 0x98, 0xed, 0xef, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x00, 0xf9, 0x21, 0xf9, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -153,6 +175,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferredPaymentAccountList) > 0 {
+		for iNdEx := len(m.TransferredPaymentAccountList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TransferredPaymentAccountList[iNdEx])
+			copy(dAtA[i:], m.TransferredPaymentAccountList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TransferredPaymentAccountList[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PaymentAccountTransferList) > 0 {
+		for iNdEx := len(m.PaymentAccountTransferList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentAccountTransferList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.AutoSettleRecordList) > 0 {
 		for iNdEx := len(m.AutoSettleRecordList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -265,6 +310,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PaymentAccountTransferList) > 0 {
+		for _, e := range m.PaymentAccountTransferList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferredPaymentAccountList) > 0 {
+		for _, s := range m.TransferredPaymentAccountList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -472,6 +529,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountTransferList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccountTransferList = append(m.PaymentAccountTransferList, PaymentAccountTransfer{})
+			if err := m.PaymentAccountTransferList[len(m.PaymentAccountTransferList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredPaymentAccountList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferredPaymentAccountList = append(m.TransferredPaymentAccountList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
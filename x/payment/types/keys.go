@@ -31,6 +31,25 @@ var (
 	ParamsKey                    = []byte{0x07}
 	VersionedParamsKeyPrefix     = []byte{0x08}
 	DelayedWithdrawalKeyPrefix   = []byte{0x09}
+	BalanceAlertKeyPrefix        = []byte{0x10}
+	BalanceAlertWarnKeyPrefix    = []byte{0x11}
+	AutoTopUpKeyPrefix           = []byte{0x12}
+	AutoTopUpRecordKeyPrefix     = []byte{0x13}
+	SpendingBudgetKeyPrefix      = []byte{0x14}
+	PaymentAccountTransferPrefix = []byte{0x15}
+	PaymentAccountByOwnerPrefix  = []byte{0x16}
+	BucketBillKeyPrefix          = []byte{0x17}
+	DelayedWithdrawalsKeyPrefix  = []byte{0x18}
+	DelayedWithdrawalIdKey       = []byte{0x19}
+	ReadVoucherKeyPrefix         = []byte{0x1A}
+	ReadVoucherByHolderPrefix    = []byte{0x1B}
+	ReadVoucherIdKey             = []byte{0x1C}
+	MultisigProposalKeyPrefix    = []byte{0x1D}
+	MultisigProposalIdKey        = []byte{0x1E}
+	BucketBinderKeyPrefix        = []byte{0x1F}
+	ReadVoucherExpiryPrefix      = []byte{0x20}
+	MultisigProposalExpiryPrefix = []byte{0x21}
+	StreamRecordFreezeKeyPrefix  = []byte{0x22}
 )
 
 // AutoSettleRecordKey returns the store key to retrieve a AutoSettleRecord from the index fields
@@ -142,9 +161,167 @@ func VersionedParamsKey(timestamp int64) []byte {
 	return append(ParamsKey, bz...)
 }
 
+// DelayedWithdrawalsKey returns the store key to retrieve a DelayedWithdrawal with id from the index fields
+func DelayedWithdrawalsKey(
+	addr sdk.AccAddress,
+	id uint64,
+) []byte {
+	return append(append([]byte{}, addr.Bytes()...), sdk.Uint64ToBigEndian(id)...)
+}
+
 // DelayedWithdrawalKey returns the store key to retrieve a DelayedWithdrawal from the index fields
 func DelayedWithdrawalKey(
 	account sdk.AccAddress,
 ) []byte {
 	return account
 }
+
+// BalanceAlertKey returns the store key to retrieve a BalanceAlert from the index fields
+func BalanceAlertKey(
+	addr sdk.AccAddress,
+) []byte {
+	return addr
+}
+
+// BalanceAlertWarnKey returns the store key of a balance alert to warn at the timestamp
+func BalanceAlertWarnKey(
+	timestamp int64,
+	addr sdk.AccAddress,
+) []byte {
+	var key []byte
+
+	timestampBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestampBytes, uint64(timestamp))
+	key = append(key, timestampBytes...)
+
+	addrBytes := []byte(addr)
+	key = append(key, addrBytes...)
+
+	return key
+}
+
+func ParseBalanceAlertWarnKey(key []byte) (timestamp int64, addr sdk.AccAddress) {
+	timestamp = int64(binary.BigEndian.Uint64(key[0:8]))
+	addr = sdk.AccAddress(key[8:])
+	return
+}
+
+// AutoTopUpKey returns the store key to retrieve an AutoTopUp from the index fields
+func AutoTopUpKey(
+	addr sdk.AccAddress,
+) []byte {
+	return addr
+}
+
+// AutoTopUpRecordKey returns the store key of a payment account to top up at the timestamp
+func AutoTopUpRecordKey(
+	timestamp int64,
+	addr sdk.AccAddress,
+) []byte {
+	var key []byte
+
+	timestampBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestampBytes, uint64(timestamp))
+	key = append(key, timestampBytes...)
+
+	addrBytes := []byte(addr)
+	key = append(key, addrBytes...)
+
+	return key
+}
+
+func ParseAutoTopUpRecordKey(key []byte) (timestamp int64, addr sdk.AccAddress) {
+	timestamp = int64(binary.BigEndian.Uint64(key[0:8]))
+	addr = sdk.AccAddress(key[8:])
+	return
+}
+
+// SpendingBudgetKey returns the store key to retrieve a SpendingBudget from the index fields
+func SpendingBudgetKey(
+	addr sdk.AccAddress,
+) []byte {
+	return addr
+}
+
+// PaymentAccountTransferKey returns the store key to retrieve the pending new owner of a payment account
+func PaymentAccountTransferKey(
+	addr sdk.AccAddress,
+) []byte {
+	return addr
+}
+
+// PaymentAccountByOwnerKey returns the store key of a payment account transferred to the owner
+func PaymentAccountByOwnerKey(
+	owner sdk.AccAddress,
+	addr sdk.AccAddress,
+) []byte {
+	return append(owner.Bytes(), addr.Bytes()...)
+}
+
+// BucketBillKey returns the store key to retrieve a BucketBill from the index fields
+func BucketBillKey(
+	addr sdk.AccAddress,
+	bucketId sdkmath.Uint,
+	timestamp int64,
+) []byte {
+	key := append([]byte{}, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(bucketId.Uint64())...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// StreamRecordFreezeKey returns the store key of a freeze or resume of the stream record of the account
+func StreamRecordFreezeKey(
+	addr sdk.AccAddress,
+	timestamp int64,
+) []byte {
+	return append(append([]byte{}, addr.Bytes()...), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// ReadVoucherKey returns the store key to retrieve a ReadVoucher from the index fields
+func ReadVoucherKey(
+	id uint64,
+) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// ReadVoucherByHolderKey returns the store key of a ReadVoucher held by the holder
+func ReadVoucherByHolderKey(
+	holder sdk.AccAddress,
+	id uint64,
+) []byte {
+	return append(append([]byte{}, holder.Bytes()...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ReadVoucherExpiryKey returns the store key of a ReadVoucher in the expiry queue
+func ReadVoucherExpiryKey(
+	expireTime int64,
+	id uint64,
+) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expireTime)), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MultisigProposalKey returns the store key of a MultisigProposal of the payment account
+func MultisigProposalKey(
+	paymentAccount sdk.AccAddress,
+	id uint64,
+) []byte {
+	return append(append([]byte{}, paymentAccount.Bytes()...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MultisigProposalExpiryKey returns the store key of a MultisigProposal in the expiry queue
+func MultisigProposalExpiryKey(
+	expireTime int64,
+	paymentAccount sdk.AccAddress,
+	id uint64,
+) []byte {
+	key := append(sdk.Uint64ToBigEndian(uint64(expireTime)), paymentAccount.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// BucketBinderKey returns the store key of an account allowed to bind buckets to the multisig payment account
+func BucketBinderKey(
+	paymentAccount sdk.AccAddress,
+	binder sdk.AccAddress,
+) []byte {
+	return append(append([]byte{}, paymentAccount.Bytes()...), binder.Bytes()...)
+}
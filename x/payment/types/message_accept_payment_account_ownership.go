@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptPaymentAccountOwnership = "accept_payment_account_ownership"
+
+var _ sdk.Msg = &MsgAcceptPaymentAccountOwnership{}
+
+func NewMsgAcceptPaymentAccountOwnership(newOwner string, addr string) *MsgAcceptPaymentAccountOwnership {
+	return &MsgAcceptPaymentAccountOwnership{
+		NewOwner: newOwner,
+		Addr:     addr,
+	}
+}
+
+func (msg *MsgAcceptPaymentAccountOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptPaymentAccountOwnership) Type() string {
+	return TypeMsgAcceptPaymentAccountOwnership
+}
+
+func (msg *MsgAcceptPaymentAccountOwnership) GetSigners() []sdk.AccAddress {
+	newOwner, err := sdk.AccAddressFromHexUnsafe(msg.NewOwner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{newOwner}
+}
+
+func (msg *MsgAcceptPaymentAccountOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptPaymentAccountOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.NewOwner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment address (%s)", err)
+	}
+	return nil
+}
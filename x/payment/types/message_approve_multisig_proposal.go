@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApproveMultisigProposal = "approve_multisig_proposal"
+
+var _ sdk.Msg = &MsgApproveMultisigProposal{}
+
+func NewMsgApproveMultisigProposal(approver, paymentAccount string, id uint64) *MsgApproveMultisigProposal {
+	return &MsgApproveMultisigProposal{
+		Approver:       approver,
+		PaymentAccount: paymentAccount,
+		Id:             id,
+	}
+}
+
+func (msg *MsgApproveMultisigProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveMultisigProposal) Type() string {
+	return TypeMsgApproveMultisigProposal
+}
+
+func (msg *MsgApproveMultisigProposal) GetSigners() []sdk.AccAddress {
+	approver, err := sdk.AccAddressFromHexUnsafe(msg.Approver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{approver}
+}
+
+func (msg *MsgApproveMultisigProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveMultisigProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Approver)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid approver address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.PaymentAccount)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment account address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelDelayedWithdrawal = "cancel_delayed_withdrawal"
+
+var _ sdk.Msg = &MsgCancelDelayedWithdrawal{}
+
+func NewMsgCancelDelayedWithdrawal(creator string, id uint64) *MsgCancelDelayedWithdrawal {
+	return &MsgCancelDelayedWithdrawal{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelDelayedWithdrawal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelDelayedWithdrawal) Type() string {
+	return TypeMsgCancelDelayedWithdrawal
+}
+
+func (msg *MsgCancelDelayedWithdrawal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelDelayedWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelDelayedWithdrawal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateMultisigPaymentAccount = "create_multisig_payment_account"
+
+	// MaxMultisigOwners is the max number of the owners of a multisig payment account
+	MaxMultisigOwners = 20
+)
+
+var _ sdk.Msg = &MsgCreateMultisigPaymentAccount{}
+
+func NewMsgCreateMultisigPaymentAccount(creator string, owners []string, threshold uint32) *MsgCreateMultisigPaymentAccount {
+	return &MsgCreateMultisigPaymentAccount{
+		Creator:   creator,
+		Owners:    owners,
+		Threshold: threshold,
+	}
+}
+
+func (msg *MsgCreateMultisigPaymentAccount) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateMultisigPaymentAccount) Type() string {
+	return TypeMsgCreateMultisigPaymentAccount
+}
+
+func (msg *MsgCreateMultisigPaymentAccount) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateMultisigPaymentAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateMultisigPaymentAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Owners) == 0 || len(msg.Owners) > MaxMultisigOwners {
+		return errors.Wrapf(ErrInvalidParams, "the number of the owners should be in [1, %d]", MaxMultisigOwners)
+	}
+	owners := make(map[string]bool, len(msg.Owners))
+	for _, owner := range msg.Owners {
+		ownerAcc, err := sdk.AccAddressFromHexUnsafe(owner)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+		}
+		// the owners are compared in the same format as the signers of the proposals
+		if owner != ownerAcc.String() {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "owner address %s is not checksummed", owner)
+		}
+		if owners[owner] {
+			return errors.Wrapf(ErrInvalidParams, "duplicated owner %s", owner)
+		}
+		owners[owner] = true
+	}
+	if msg.Threshold == 0 || msg.Threshold > uint32(len(msg.Owners)) {
+		return errors.Wrapf(ErrInvalidParams, "the threshold should be in [1, %d]", len(msg.Owners))
+	}
+	return nil
+}
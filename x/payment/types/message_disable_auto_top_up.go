@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDisableAutoTopUp = "disable_auto_top_up"
+
+var _ sdk.Msg = &MsgDisableAutoTopUp{}
+
+func NewMsgDisableAutoTopUp(owner string, addr string) *MsgDisableAutoTopUp {
+	return &MsgDisableAutoTopUp{
+		Owner: owner,
+		Addr:  addr,
+	}
+}
+
+func (msg *MsgDisableAutoTopUp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDisableAutoTopUp) Type() string {
+	return TypeMsgDisableAutoTopUp
+}
+
+func (msg *MsgDisableAutoTopUp) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgDisableAutoTopUp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDisableAutoTopUp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgEnableAutoTopUp = "enable_auto_top_up"
+
+var _ sdk.Msg = &MsgEnableAutoTopUp{}
+
+func NewMsgEnableAutoTopUp(owner string, addr string, source string, capPerPeriod sdkmath.Int, period uint64,
+	minRunway uint64,
+) *MsgEnableAutoTopUp {
+	return &MsgEnableAutoTopUp{
+		Owner:        owner,
+		Addr:         addr,
+		Source:       source,
+		CapPerPeriod: capPerPeriod,
+		Period:       period,
+		MinRunway:    minRunway,
+	}
+}
+
+func (msg *MsgEnableAutoTopUp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEnableAutoTopUp) Type() string {
+	return TypeMsgEnableAutoTopUp
+}
+
+func (msg *MsgEnableAutoTopUp) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgEnableAutoTopUp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEnableAutoTopUp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Source)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address (%s)", err)
+	}
+	if msg.Source == msg.Addr {
+		return errors.Wrapf(ErrInvalidAutoTopUpSource, "the payment account can not top up from itself")
+	}
+	if msg.CapPerPeriod.IsNil() || !msg.CapPerPeriod.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid cap per period")
+	}
+	if msg.Period == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period")
+	}
+	if msg.MinRunway == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min runway")
+	}
+	return nil
+}
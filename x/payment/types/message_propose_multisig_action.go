@@ -0,0 +1,94 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgProposeMultisigAction = "propose_multisig_action"
+
+var _ sdk.Msg = &MsgProposeMultisigAction{}
+
+func NewMsgProposeMultisigWithdraw(proposer, paymentAccount, to string, amount sdkmath.Int) *MsgProposeMultisigAction {
+	return &MsgProposeMultisigAction{
+		Proposer:       proposer,
+		PaymentAccount: paymentAccount,
+		Action:         MULTISIG_ACTION_WITHDRAW,
+		To:             to,
+		Amount:         amount,
+	}
+}
+
+func NewMsgProposeMultisigDisableRefund(proposer, paymentAccount string) *MsgProposeMultisigAction {
+	return &MsgProposeMultisigAction{
+		Proposer:       proposer,
+		PaymentAccount: paymentAccount,
+		Action:         MULTISIG_ACTION_DISABLE_REFUND,
+		Amount:         sdkmath.ZeroInt(),
+	}
+}
+
+func NewMsgProposeMultisigSetBucketBinder(proposer, paymentAccount, binder string, allowed bool) *MsgProposeMultisigAction {
+	return &MsgProposeMultisigAction{
+		Proposer:       proposer,
+		PaymentAccount: paymentAccount,
+		Action:         MULTISIG_ACTION_SET_BUCKET_BINDER,
+		Amount:         sdkmath.ZeroInt(),
+		Binder:         binder,
+		Allowed:        allowed,
+	}
+}
+
+func (msg *MsgProposeMultisigAction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProposeMultisigAction) Type() string {
+	return TypeMsgProposeMultisigAction
+}
+
+func (msg *MsgProposeMultisigAction) GetSigners() []sdk.AccAddress {
+	proposer, err := sdk.AccAddressFromHexUnsafe(msg.Proposer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{proposer}
+}
+
+func (msg *MsgProposeMultisigAction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProposeMultisigAction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Proposer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.PaymentAccount)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment account address (%s)", err)
+	}
+
+	switch msg.Action {
+	case MULTISIG_ACTION_WITHDRAW:
+		_, err = sdk.AccAddressFromHexUnsafe(msg.To)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid to address (%s)", err)
+		}
+		if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+			return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+		}
+	case MULTISIG_ACTION_DISABLE_REFUND:
+	case MULTISIG_ACTION_SET_BUCKET_BINDER:
+		_, err = sdk.AccAddressFromHexUnsafe(msg.Binder)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid binder address (%s)", err)
+		}
+	default:
+		return errors.Wrapf(ErrInvalidParams, "unknown multisig action %s", msg.Action)
+	}
+	return nil
+}
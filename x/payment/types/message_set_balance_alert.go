@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetBalanceAlert = "set_balance_alert"
+
+var _ sdk.Msg = &MsgSetBalanceAlert{}
+
+func NewMsgSetBalanceAlert(owner string, addr string, threshold uint64) *MsgSetBalanceAlert {
+	return &MsgSetBalanceAlert{
+		Owner:     owner,
+		Addr:      addr,
+		Threshold: threshold,
+	}
+}
+
+func (msg *MsgSetBalanceAlert) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBalanceAlert) Type() string {
+	return TypeMsgSetBalanceAlert
+}
+
+func (msg *MsgSetBalanceAlert) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgSetBalanceAlert) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBalanceAlert) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetSpendingBudget = "set_spending_budget"
+
+var _ sdk.Msg = &MsgSetSpendingBudget{}
+
+func NewMsgSetSpendingBudget(owner string, addr string, maxOutflowRate sdkmath.Int, maxSpendPerPeriod sdkmath.Int,
+	period SpendingPeriod,
+) *MsgSetSpendingBudget {
+	return &MsgSetSpendingBudget{
+		Owner:             owner,
+		Addr:              addr,
+		MaxOutflowRate:    maxOutflowRate,
+		MaxSpendPerPeriod: maxSpendPerPeriod,
+		Period:            period,
+	}
+}
+
+func (msg *MsgSetSpendingBudget) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetSpendingBudget) Type() string {
+	return TypeMsgSetSpendingBudget
+}
+
+func (msg *MsgSetSpendingBudget) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgSetSpendingBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetSpendingBudget) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment address (%s)", err)
+	}
+	if msg.MaxOutflowRate.IsNil() || msg.MaxOutflowRate.IsNegative() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max outflow rate")
+	}
+	if msg.MaxSpendPerPeriod.IsNil() || msg.MaxSpendPerPeriod.IsNegative() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max spend per period")
+	}
+	if _, ok := SpendingPeriod_name[int32(msg.Period)]; !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferPaymentAccountOwnership = "transfer_payment_account_ownership"
+
+var _ sdk.Msg = &MsgTransferPaymentAccountOwnership{}
+
+func NewMsgTransferPaymentAccountOwnership(owner string, addr string, newOwner string) *MsgTransferPaymentAccountOwnership {
+	return &MsgTransferPaymentAccountOwnership{
+		Owner:    owner,
+		Addr:     addr,
+		NewOwner: newOwner,
+	}
+}
+
+func (msg *MsgTransferPaymentAccountOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferPaymentAccountOwnership) Type() string {
+	return TypeMsgTransferPaymentAccountOwnership
+}
+
+func (msg *MsgTransferPaymentAccountOwnership) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgTransferPaymentAccountOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferPaymentAccountOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payment address (%s)", err)
+	}
+	if msg.NewOwner != "" {
+		_, err = sdk.AccAddressFromHexUnsafe(msg.NewOwner)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+		}
+		if msg.NewOwner == msg.Owner {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "the new owner is the same as the owner")
+		}
+		if msg.NewOwner == msg.Addr {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "the payment account can not own itself")
+		}
+	}
+	return nil
+}
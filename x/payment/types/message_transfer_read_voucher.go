@@ -0,0 +1,55 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferReadVoucher = "transfer_read_voucher"
+
+var _ sdk.Msg = &MsgTransferReadVoucher{}
+
+func NewMsgTransferReadVoucher(holder string, id uint64, to string) *MsgTransferReadVoucher {
+	return &MsgTransferReadVoucher{
+		Holder: holder,
+		Id:     id,
+		To:     to,
+	}
+}
+
+func (msg *MsgTransferReadVoucher) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferReadVoucher) Type() string {
+	return TypeMsgTransferReadVoucher
+}
+
+func (msg *MsgTransferReadVoucher) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromHexUnsafe(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgTransferReadVoucher) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferReadVoucher) ValidateBasic() error {
+	holder, err := sdk.AccAddressFromHexUnsafe(msg.Holder)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address (%s)", err)
+	}
+	to, err := sdk.AccAddressFromHexUnsafe(msg.To)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid to address (%s)", err)
+	}
+	if holder.Equals(to) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "the voucher is already held by %s", msg.To)
+	}
+	return nil
+}
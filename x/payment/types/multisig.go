@@ -0,0 +1,26 @@
+package types
+
+// IsMultisig returns whether the payment account is controlled by the approvals of multiple owners
+func (m *PaymentAccount) IsMultisig() bool {
+	return len(m.Owners) > 0
+}
+
+// IsMultisigOwner returns whether the address is one of the owners of the multisig payment account
+func (m *PaymentAccount) IsMultisigOwner(addr string) bool {
+	for _, owner := range m.Owners {
+		if owner == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApprovedBy returns whether the proposal is approved by the owner
+func (m *MultisigProposal) IsApprovedBy(owner string) bool {
+	for _, approval := range m.Approvals {
+		if approval == owner {
+			return true
+		}
+	}
+	return false
+}
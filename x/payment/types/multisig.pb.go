@@ -0,0 +1,785 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/multisig.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultisigActionType defines the action a proposal of a multisig payment account executes
+type MultisigActionType int32
+
+const (
+	// withdraw the amount from the payment account to the receiver
+	MULTISIG_ACTION_WITHDRAW MultisigActionType = 0
+	// set the payment account as non-refundable
+	MULTISIG_ACTION_DISABLE_REFUND MultisigActionType = 1
+	// allow or disallow the binder to bind its buckets to the payment account
+	MULTISIG_ACTION_SET_BUCKET_BINDER MultisigActionType = 2
+)
+
+var MultisigActionType_name = map[int32]string{
+	0: "MULTISIG_ACTION_WITHDRAW",
+	1: "MULTISIG_ACTION_DISABLE_REFUND",
+	2: "MULTISIG_ACTION_SET_BUCKET_BINDER",
+}
+
+var MultisigActionType_value = map[string]int32{
+	"MULTISIG_ACTION_WITHDRAW":          0,
+	"MULTISIG_ACTION_DISABLE_REFUND":    1,
+	"MULTISIG_ACTION_SET_BUCKET_BINDER": 2,
+}
+
+func (x MultisigActionType) String() string {
+	return proto.EnumName(MultisigActionType_name, int32(x))
+}
+
+func (MultisigActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a69f3c43ddc4919d, []int{0}
+}
+
+// MultisigProposal is an action of a multisig payment account waiting for the approvals of the owners,
+// it is executed once it is approved by threshold of the owners.
+type MultisigProposal struct {
+	// id is the unique id of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payment_account is the address of the multisig payment account
+	PaymentAccount string `protobuf:"bytes,2,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+	// proposer is the owner who proposes the action
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// action is the type of the action to execute
+	Action MultisigActionType `protobuf:"varint,4,opt,name=action,proto3,enum=greenfield.payment.MultisigActionType" json:"action,omitempty"`
+	// to is the receiver of the withdrawal, for MULTISIG_ACTION_WITHDRAW
+	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount to withdraw, for MULTISIG_ACTION_WITHDRAW
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// binder is the account to allow or disallow binding buckets, for MULTISIG_ACTION_SET_BUCKET_BINDER
+	Binder string `protobuf:"bytes,7,opt,name=binder,proto3" json:"binder,omitempty"`
+	// allowed defines whether the binder is allowed, for MULTISIG_ACTION_SET_BUCKET_BINDER
+	Allowed bool `protobuf:"varint,8,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// approvals are the owners approved the proposal
+	Approvals []string `protobuf:"bytes,9,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// expire_time is the unix time after which the proposal can not be approved and is pruned
+	ExpireTime int64 `protobuf:"varint,10,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (m *MultisigProposal) Reset()         { *m = MultisigProposal{} }
+func (m *MultisigProposal) String() string { return proto.CompactTextString(m) }
+func (*MultisigProposal) ProtoMessage()    {}
+func (*MultisigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a69f3c43ddc4919d, []int{0}
+}
+func (m *MultisigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigProposal.Merge(m, src)
+}
+func (m *MultisigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigProposal proto.InternalMessageInfo
+
+func (m *MultisigProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MultisigProposal) GetPaymentAccount() string {
+	if m != nil {
+		return m.PaymentAccount
+	}
+	return ""
+}
+
+func (m *MultisigProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MultisigProposal) GetAction() MultisigActionType {
+	if m != nil {
+		return m.Action
+	}
+	return MULTISIG_ACTION_WITHDRAW
+}
+
+func (m *MultisigProposal) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultisigProposal) GetBinder() string {
+	if m != nil {
+		return m.Binder
+	}
+	return ""
+}
+
+func (m *MultisigProposal) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *MultisigProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *MultisigProposal) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.payment.MultisigActionType", MultisigActionType_name, MultisigActionType_value)
+	proto.RegisterType((*MultisigProposal)(nil), "greenfield.payment.MultisigProposal")
+}
+
+func init() { proto.RegisterFile("greenfield/payment/multisig.proto", fileDescriptor_a69f3c43ddc4919d) }
+
+var fileDescriptor_a69f3c43ddc4919d = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0x69, 0xed, 0xb6, 0x23, 0xd4, 0x32, 0xac, 0x30, 0x16, 0x49, 0xb3, 0x0b, 0x4a,
+	0x50, 0x9a, 0x88, 0x8a, 0x47, 0x21, 0xfd, 0xa3, 0x06, 0x77, 0xab, 0xa4, 0x29, 0x0b, 0x5e, 0x42,
+	0xfe, 0x8c, 0xe9, 0x60, 0x32, 0x13, 0x92, 0xa9, 0x6e, 0xcf, 0x5e, 0x3c, 0xfa, 0x1d, 0xfc, 0x0a,
+	0xfb, 0x21, 0xf6, 0xb8, 0xec, 0x49, 0x3c, 0x2c, 0xd2, 0x7e, 0x00, 0xbf, 0x82, 0x6c, 0x92, 0xdd,
+	0x95, 0x5d, 0xb0, 0xa7, 0xe4, 0x7d, 0xf3, 0x7b, 0x9e, 0x27, 0xef, 0x30, 0x2f, 0xdc, 0x89, 0x32,
+	0x42, 0xd8, 0x47, 0x4a, 0xe2, 0xd0, 0x48, 0xbd, 0x65, 0x42, 0x98, 0x30, 0x92, 0x45, 0x2c, 0x68,
+	0x4e, 0x23, 0x3d, 0xcd, 0xb8, 0xe0, 0x08, 0x5d, 0x21, 0x7a, 0x85, 0x74, 0xef, 0x05, 0x3c, 0x4f,
+	0x78, 0xee, 0x16, 0x84, 0x51, 0x16, 0x25, 0xde, 0xdd, 0x8e, 0x78, 0xc4, 0xcb, 0xfe, 0xf9, 0x5b,
+	0xd9, 0xdd, 0xfd, 0x53, 0x83, 0x9d, 0xfd, 0xca, 0xf7, 0x7d, 0xc6, 0x53, 0x9e, 0x7b, 0x31, 0x6a,
+	0x43, 0x99, 0x86, 0x18, 0xa8, 0x40, 0xab, 0xdb, 0x32, 0x0d, 0x91, 0x09, 0xef, 0x54, 0x01, 0xae,
+	0x17, 0x04, 0x7c, 0xc1, 0x04, 0x96, 0x55, 0xa0, 0xb5, 0x06, 0xf8, 0xf4, 0xa8, 0xbf, 0x5d, 0xa5,
+	0x98, 0x61, 0x98, 0x91, 0x3c, 0x9f, 0x8a, 0x8c, 0xb2, 0xc8, 0x6e, 0x57, 0x02, 0xb3, 0xe4, 0xd1,
+	0x73, 0xd8, 0x4c, 0x0b, 0x7b, 0x92, 0xe1, 0xda, 0x06, 0xed, 0x25, 0x89, 0x5e, 0xc2, 0x86, 0x17,
+	0x08, 0xca, 0x19, 0xae, 0xab, 0x40, 0x6b, 0x3f, 0x7d, 0xa8, 0xdf, 0x9c, 0x59, 0xbf, 0xf8, 0x7d,
+	0xb3, 0x20, 0x9d, 0x65, 0x4a, 0xec, 0x4a, 0x85, 0x34, 0x28, 0x0b, 0x8e, 0x6f, 0x6d, 0xc8, 0x93,
+	0x05, 0x47, 0x43, 0xd8, 0xf0, 0x92, 0x62, 0xb2, 0x46, 0x41, 0x3f, 0x3e, 0x3e, 0xeb, 0x49, 0xbf,
+	0xce, 0x7a, 0x77, 0x4b, 0x45, 0x1e, 0x7e, 0xd2, 0x29, 0x37, 0x12, 0x4f, 0xcc, 0x75, 0x8b, 0x89,
+	0xd3, 0xa3, 0x3e, 0xac, 0xac, 0x2c, 0x26, 0xec, 0x4a, 0x8a, 0x9e, 0xc0, 0x86, 0x4f, 0x59, 0x48,
+	0x32, 0xbc, 0xb5, 0x21, 0xb2, 0xe2, 0x10, 0x86, 0x5b, 0x5e, 0x1c, 0xf3, 0x2f, 0x24, 0xc4, 0x4d,
+	0x15, 0x68, 0x4d, 0xfb, 0xa2, 0x44, 0x2f, 0x60, 0xcb, 0x4b, 0xd3, 0x8c, 0x7f, 0xf6, 0xe2, 0x1c,
+	0xb7, 0xd4, 0xda, 0x7f, 0xed, 0xae, 0x50, 0xd4, 0x83, 0xb7, 0xc9, 0x61, 0x4a, 0x33, 0xe2, 0x0a,
+	0x9a, 0x10, 0x0c, 0x55, 0xa0, 0xd5, 0x6c, 0x58, 0xb6, 0x1c, 0x9a, 0x90, 0x47, 0x5f, 0x01, 0x44,
+	0x37, 0x8f, 0x0c, 0xdd, 0x87, 0x78, 0x7f, 0xb6, 0xe7, 0x58, 0x53, 0xeb, 0xb5, 0x6b, 0x0e, 0x1d,
+	0xeb, 0xdd, 0xc4, 0x3d, 0xb0, 0x9c, 0x37, 0x23, 0xdb, 0x3c, 0xe8, 0x48, 0x68, 0x17, 0x2a, 0xd7,
+	0xbf, 0x8e, 0xac, 0xa9, 0x39, 0xd8, 0x1b, 0xbb, 0xf6, 0xf8, 0xd5, 0x6c, 0x32, 0xea, 0x00, 0xf4,
+	0x00, 0xee, 0x5c, 0x67, 0xa6, 0x63, 0xc7, 0x1d, 0xcc, 0x86, 0x6f, 0xcf, 0x1f, 0xd6, 0x64, 0x34,
+	0xb6, 0x3b, 0x72, 0xb7, 0xfe, 0xed, 0x87, 0x22, 0x0d, 0xac, 0xe3, 0x95, 0x02, 0x4e, 0x56, 0x0a,
+	0xf8, 0xbd, 0x52, 0xc0, 0xf7, 0xb5, 0x22, 0x9d, 0xac, 0x15, 0xe9, 0xe7, 0x5a, 0x91, 0x3e, 0x18,
+	0x11, 0x15, 0xf3, 0x85, 0xaf, 0x07, 0x3c, 0x31, 0x7c, 0xe6, 0xf7, 0x83, 0xb9, 0x47, 0x99, 0xf1,
+	0xcf, 0x3a, 0x1c, 0x5e, 0x2e, 0x84, 0x58, 0xa6, 0x24, 0xf7, 0x1b, 0xc5, 0x4d, 0x7e, 0xf6, 0x37,
+	0x00, 0x00, 0xff, 0xff, 0x19, 0xd4, 0xfc, 0x64, 0x33, 0x03, 0x00, 0x00,
+}
+
+func (m *MultisigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintMultisig(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Binder) > 0 {
+		i -= len(m.Binder)
+		copy(dAtA[i:], m.Binder)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Binder)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultisig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Action != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultisig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultisigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMultisig(uint64(m.Id))
+	}
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovMultisig(uint64(m.Action))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMultisig(uint64(l))
+	l = len(m.Binder)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovMultisig(uint64(m.ExpireTime))
+	}
+	return n
+}
+
+func sovMultisig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultisig(x uint64) (n int) {
+	return sovMultisig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultisigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= MultisigActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultisig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultisig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultisig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultisig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultisig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultisig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultisig = fmt.Errorf("proto: unexpected end of group")
+)
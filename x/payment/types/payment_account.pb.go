@@ -31,6 +31,11 @@ type PaymentAccount struct {
 	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	// whether the payment account is refundable
 	Refundable bool `protobuf:"varint,3,opt,name=refundable,proto3" json:"refundable,omitempty"`
+	// the owner addresses of a multisig payment account, the owner of which is the payment account itself.
+	// The withdrawals, disabling refund and bucket bindings of it are approved by threshold of the owners.
+	Owners []string `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners,omitempty"`
+	// the number of the owners required to approve a proposal of a multisig payment account
+	Threshold uint32 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (m *PaymentAccount) Reset()         { *m = PaymentAccount{} }
@@ -87,8 +92,78 @@ func (m *PaymentAccount) GetRefundable() bool {
 	return false
 }
 
+func (m *PaymentAccount) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *PaymentAccount) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// PaymentAccountTransfer defines a pending transfer of the ownership of a payment account
+type PaymentAccountTransfer struct {
+	// the address of the payment account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// the pending new owner of the payment account
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *PaymentAccountTransfer) Reset()         { *m = PaymentAccountTransfer{} }
+func (m *PaymentAccountTransfer) String() string { return proto.CompactTextString(m) }
+func (*PaymentAccountTransfer) ProtoMessage()    {}
+func (*PaymentAccountTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1cfac7f45dc467, []int{1}
+}
+func (m *PaymentAccountTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentAccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentAccountTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentAccountTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentAccountTransfer.Merge(m, src)
+}
+func (m *PaymentAccountTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentAccountTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentAccountTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentAccountTransfer proto.InternalMessageInfo
+
+func (m *PaymentAccountTransfer) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PaymentAccountTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PaymentAccount)(nil), "greenfield.payment.PaymentAccount")
+	proto.RegisterType((*PaymentAccountTransfer)(nil), "greenfield.payment.PaymentAccountTransfer")
 }
 
 func init() {
@@ -96,22 +171,27 @@ func init() {
 }
 
 var fileDescriptor_9b1cfac7f45dc467 = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0x2f, 0x4a, 0x4d,
-	0xcd, 0x4b, 0xcb, 0x4c, 0xcd, 0x49, 0xd1, 0x2f, 0x48, 0xac, 0xcc, 0x4d, 0xcd, 0x2b, 0x81, 0xd1,
-	0xf1, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42,
-	0x08, 0x95, 0x7a, 0x50, 0x15, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x15,
-	0xfa, 0x10, 0x0e, 0x44, 0xb9, 0x52, 0x1f, 0x23, 0x17, 0x5f, 0x00, 0x44, 0x99, 0x23, 0xc4, 0x1c,
-	0x21, 0x1d, 0x2e, 0x96, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89,
-	0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x5a, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a,
-	0x32, 0xf3, 0xd2, 0x83, 0xc0, 0xaa, 0x84, 0xf4, 0xb8, 0x58, 0xf3, 0xcb, 0xf3, 0x52, 0x8b, 0x24,
-	0x98, 0x08, 0x28, 0x87, 0x28, 0x13, 0x92, 0xe3, 0xe2, 0x2a, 0x4a, 0x4d, 0x2b, 0xcd, 0x4b, 0x49,
-	0x4c, 0xca, 0x49, 0x95, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x08, 0x42, 0x12, 0x71, 0xf2, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa4, 0xbc, 0x24, 0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xa4,
-	0x80, 0xa9, 0x80, 0x07, 0x4d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x8b, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x66, 0x74, 0xc1, 0x3d, 0x01, 0x00, 0x00,
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd1, 0x4d, 0x4b, 0xc3, 0x30,
+	0x18, 0x07, 0xf0, 0xc5, 0xbd, 0xb0, 0x05, 0xf4, 0x10, 0x44, 0xa2, 0x48, 0x28, 0x3b, 0xf5, 0xe0,
+	0x5a, 0x41, 0xfc, 0x00, 0xdb, 0xcd, 0x93, 0x32, 0x3d, 0x79, 0x19, 0x69, 0xf3, 0x6c, 0x2d, 0xac,
+	0x49, 0x49, 0x52, 0xea, 0x0e, 0x7e, 0x07, 0x3f, 0x8c, 0x1f, 0xc2, 0xe3, 0xf0, 0xe4, 0x49, 0xa4,
+	0xfd, 0x22, 0x62, 0x5b, 0xdd, 0xbc, 0xf8, 0x72, 0x0a, 0x79, 0xf8, 0x3d, 0x0f, 0xfc, 0xf9, 0x63,
+	0x77, 0xa1, 0x01, 0xe4, 0x3c, 0x86, 0xa5, 0xf0, 0x53, 0xbe, 0x4a, 0x40, 0xda, 0xcf, 0x77, 0xc6,
+	0xc3, 0x50, 0x65, 0xd2, 0x7a, 0xa9, 0x56, 0x56, 0x11, 0xb2, 0x91, 0x5e, 0x23, 0x8e, 0x0e, 0x43,
+	0x65, 0x12, 0x65, 0x66, 0x95, 0xf0, 0xeb, 0x4f, 0xcd, 0x87, 0xaf, 0x08, 0xef, 0x5d, 0xd5, 0x6c,
+	0x5c, 0xdf, 0x21, 0x27, 0xb8, 0xc3, 0x85, 0xd0, 0x14, 0x39, 0xc8, 0x1d, 0x4c, 0xe8, 0xf3, 0xe3,
+	0x68, 0xbf, 0x59, 0x19, 0x0b, 0xa1, 0xc1, 0x98, 0x6b, 0xab, 0x63, 0xb9, 0x98, 0x56, 0x8a, 0x78,
+	0xb8, 0xab, 0x72, 0x09, 0x9a, 0xee, 0xfc, 0xc2, 0x6b, 0x46, 0x18, 0xc6, 0x1a, 0xe6, 0x99, 0x14,
+	0x3c, 0x58, 0x02, 0x6d, 0x3b, 0xc8, 0xed, 0x4f, 0xb7, 0x26, 0xe4, 0x14, 0xf7, 0x2a, 0x68, 0x68,
+	0xc7, 0x69, 0xff, 0x78, 0xb0, 0x71, 0xe4, 0x18, 0x0f, 0x6c, 0xa4, 0xc1, 0x44, 0x6a, 0x29, 0x68,
+	0xd7, 0x41, 0xee, 0xee, 0x74, 0x33, 0x18, 0xde, 0xe3, 0x83, 0xef, 0xf9, 0x6e, 0x34, 0x97, 0x66,
+	0x0e, 0xfa, 0x9f, 0x39, 0xcf, 0xf1, 0x40, 0x42, 0x3e, 0xfb, 0x5b, 0xd6, 0xbe, 0x84, 0xfc, 0xf2,
+	0x43, 0x4e, 0x2e, 0x9e, 0x0a, 0x86, 0xd6, 0x05, 0x43, 0x6f, 0x05, 0x43, 0x0f, 0x25, 0x6b, 0xad,
+	0x4b, 0xd6, 0x7a, 0x29, 0x59, 0xeb, 0xd6, 0x5f, 0xc4, 0x36, 0xca, 0x02, 0x2f, 0x54, 0x89, 0x1f,
+	0xc8, 0x60, 0x14, 0x46, 0x3c, 0x96, 0xfe, 0x56, 0xcf, 0x77, 0x5f, 0x4d, 0xdb, 0x55, 0x0a, 0x26,
+	0xe8, 0x55, 0x8d, 0x9d, 0xbd, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x4a, 0x97, 0xbb, 0x0c, 0x02,
+	0x00, 0x00,
 }
 
 func (m *PaymentAccount) Marshal() (dAtA []byte, err error) {
@@ -134,6 +214,20 @@ func (m *PaymentAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintPaymentAccount(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintPaymentAccount(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Refundable {
 		i--
 		if m.Refundable {
@@ -161,6 +255,43 @@ func (m *PaymentAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PaymentAccountTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentAccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentAccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintPaymentAccount(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPaymentAccount(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPaymentAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPaymentAccount(v)
 	base := offset
@@ -189,6 +320,32 @@ func (m *PaymentAccount) Size() (n int) {
 	if m.Refundable {
 		n += 2
 	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovPaymentAccount(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovPaymentAccount(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *PaymentAccountTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPaymentAccount(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovPaymentAccount(uint64(l))
+	}
 	return n
 }
 
@@ -311,6 +468,171 @@ func (m *PaymentAccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Refundable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymentAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentAccountTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymentAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentAccountTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentAccountTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPaymentAccount(dAtA[iNdEx:])
@@ -1227,1412 +1227,1881 @@ func (m *QueryDelayedWithdrawalResponse) GetDelayedWithdrawal() DelayedWithdrawa
 	return DelayedWithdrawalRecord{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.payment.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.payment.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.payment.QueryParamsByTimestampRequest")
-	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.payment.QueryParamsByTimestampResponse")
-	proto.RegisterType((*QueryOutFlowsRequest)(nil), "greenfield.payment.QueryOutFlowsRequest")
-	proto.RegisterType((*QueryOutFlowsResponse)(nil), "greenfield.payment.QueryOutFlowsResponse")
-	proto.RegisterType((*QueryGetStreamRecordRequest)(nil), "greenfield.payment.QueryGetStreamRecordRequest")
-	proto.RegisterType((*QueryGetStreamRecordResponse)(nil), "greenfield.payment.QueryGetStreamRecordResponse")
-	proto.RegisterType((*QueryStreamRecordsRequest)(nil), "greenfield.payment.QueryStreamRecordsRequest")
-	proto.RegisterType((*QueryStreamRecordsResponse)(nil), "greenfield.payment.QueryStreamRecordsResponse")
-	proto.RegisterType((*QueryPaymentAccountCountRequest)(nil), "greenfield.payment.QueryPaymentAccountCountRequest")
-	proto.RegisterType((*QueryPaymentAccountCountResponse)(nil), "greenfield.payment.QueryPaymentAccountCountResponse")
-	proto.RegisterType((*QueryPaymentAccountCountsRequest)(nil), "greenfield.payment.QueryPaymentAccountCountsRequest")
-	proto.RegisterType((*QueryPaymentAccountCountsResponse)(nil), "greenfield.payment.QueryPaymentAccountCountsResponse")
-	proto.RegisterType((*QueryPaymentAccountRequest)(nil), "greenfield.payment.QueryPaymentAccountRequest")
-	proto.RegisterType((*QueryPaymentAccountResponse)(nil), "greenfield.payment.QueryPaymentAccountResponse")
-	proto.RegisterType((*QueryPaymentAccountsRequest)(nil), "greenfield.payment.QueryPaymentAccountsRequest")
-	proto.RegisterType((*QueryPaymentAccountsResponse)(nil), "greenfield.payment.QueryPaymentAccountsResponse")
-	proto.RegisterType((*QueryDynamicBalanceRequest)(nil), "greenfield.payment.QueryDynamicBalanceRequest")
-	proto.RegisterType((*QueryDynamicBalanceResponse)(nil), "greenfield.payment.QueryDynamicBalanceResponse")
-	proto.RegisterType((*QueryPaymentAccountsByOwnerRequest)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerRequest")
-	proto.RegisterType((*QueryPaymentAccountsByOwnerResponse)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerResponse")
-	proto.RegisterType((*QueryAutoSettleRecordsRequest)(nil), "greenfield.payment.QueryAutoSettleRecordsRequest")
-	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "greenfield.payment.QueryAutoSettleRecordsResponse")
-	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalRequest")
-	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalResponse")
+type QueryDelayedWithdrawalsRequest struct {
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/payment/query.proto", fileDescriptor_f62e6684473ccf4a) }
-
-var fileDescriptor_f62e6684473ccf4a = []byte{
-	// 1424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0x02, 0x09, 0xe4, 0x05, 0x12, 0x3c, 0x09, 0x28, 0x98, 0xd4, 0x81, 0x2d, 0x4a, 0x02,
-	0x21, 0xde, 0xc4, 0x29, 0x04, 0xaa, 0x52, 0x89, 0x14, 0x81, 0x50, 0x55, 0x05, 0x4c, 0x25, 0x24,
-	0xaa, 0x6a, 0x3b, 0xde, 0x1d, 0x1c, 0x37, 0xf6, 0xae, 0xd9, 0x1d, 0x93, 0x5a, 0x51, 0x2e, 0x48,
-	0xed, 0x19, 0xa9, 0xb7, 0x1e, 0x2b, 0xb5, 0xaa, 0xda, 0x1e, 0x91, 0x7a, 0x68, 0xaf, 0x95, 0x38,
-	0xd2, 0xf6, 0x52, 0xf5, 0x80, 0x2a, 0xd2, 0x3f, 0xa4, 0xf2, 0xec, 0x5b, 0x67, 0x3f, 0x66, 0xd7,
-	0x6b, 0xea, 0x5e, 0x12, 0xef, 0xce, 0xfb, 0xf8, 0xfd, 0xde, 0x9b, 0x37, 0xf3, 0xde, 0x42, 0xa1,
-	0xea, 0x30, 0x66, 0x3d, 0xac, 0xb1, 0xba, 0xa9, 0x35, 0x69, 0xbb, 0xc1, 0x2c, 0xae, 0x3d, 0x6a,
-	0x31, 0xa7, 0x5d, 0x6c, 0x3a, 0x36, 0xb7, 0x09, 0xd9, 0x5f, 0x2f, 0xe2, 0x7a, 0xfe, 0x82, 0x61,
-	0xbb, 0x0d, 0xdb, 0xd5, 0x2a, 0xd4, 0x65, 0x9e, 0xb0, 0xf6, 0x78, 0xa5, 0xc2, 0x38, 0x5d, 0xd1,
-	0x9a, 0xb4, 0x5a, 0xb3, 0x28, 0xaf, 0xd9, 0x96, 0xa7, 0x9f, 0x3f, 0xe5, 0xc9, 0xea, 0xe2, 0x49,
-	0xf3, 0x1e, 0x70, 0x69, 0xaa, 0x6a, 0x57, 0x6d, 0xef, 0x7d, 0xe7, 0x17, 0xbe, 0x9d, 0xa9, 0xda,
-	0x76, 0xb5, 0xce, 0x34, 0xda, 0xac, 0x69, 0xd4, 0xb2, 0x6c, 0x2e, 0xac, 0xf9, 0x3a, 0x8b, 0x12,
-	0xb8, 0xb4, 0xc5, 0x6d, 0xdd, 0x65, 0x9c, 0xd7, 0x99, 0xee, 0x30, 0xc3, 0x76, 0x4c, 0x14, 0x2e,
-	0x49, 0x84, 0x4d, 0x56, 0xa7, 0x6d, 0x66, 0xea, 0xdb, 0x35, 0xbe, 0x69, 0x3a, 0x74, 0x9b, 0xd6,
-	0xc3, 0x3a, 0x67, 0x25, 0x3a, 0x76, 0x8b, 0xeb, 0x0f, 0xeb, 0xf6, 0x36, 0x8a, 0xcc, 0x4a, 0x44,
-	0x9a, 0xd4, 0xa1, 0x0d, 0x1f, 0xe4, 0x82, 0x54, 0x40, 0xfc, 0xd7, 0xa9, 0x61, 0xd8, 0x2d, 0x8b,
-	0xa3, 0x64, 0xb1, 0xb7, 0xa4, 0x1e, 0x94, 0x9f, 0x93, 0xc8, 0xbb, 0xdc, 0x61, 0xb4, 0x11, 0x62,
-	0xa1, 0x4e, 0x01, 0xb9, 0xdb, 0xc9, 0xcb, 0x1d, 0x01, 0xab, 0xcc, 0x1e, 0xb5, 0x98, 0xcb, 0xd5,
-	0x0d, 0x98, 0x0c, 0xbd, 0x75, 0x9b, 0xb6, 0xe5, 0x32, 0x72, 0x05, 0x46, 0x3c, 0xf8, 0xd3, 0xca,
-	0x19, 0x65, 0x61, 0xac, 0x14, 0x44, 0xe5, 0xe7, 0xbc, 0xe8, 0xe9, 0xac, 0x1f, 0x7a, 0xfe, 0x72,
-	0x76, 0xa8, 0x8c, 0xf2, 0xea, 0x35, 0x78, 0x23, 0x60, 0x70, 0xbd, 0xfd, 0x61, 0xad, 0xc1, 0x5c,
-	0x4e, 0x1b, 0x4d, 0xf4, 0x48, 0x66, 0x60, 0x94, 0xfb, 0xef, 0x84, 0xf5, 0x83, 0xe5, 0xfd, 0x17,
-	0xea, 0x03, 0x28, 0x24, 0xa9, 0xff, 0x67, 0x68, 0xcb, 0x30, 0x25, 0x6c, 0x6f, 0xb4, 0xf8, 0xcd,
-	0xba, 0xbd, 0xed, 0xc7, 0x80, 0x4c, 0xc3, 0x61, 0x0c, 0xac, 0x30, 0x39, 0x5a, 0xf6, 0x1f, 0xd5,
-	0xfb, 0x70, 0x22, 0xa2, 0x81, 0x20, 0xde, 0x85, 0x51, 0x7f, 0x07, 0x74, 0x70, 0x1c, 0x5c, 0x18,
-	0x2b, 0x9d, 0x96, 0xe1, 0x40, 0x45, 0x04, 0x72, 0xc4, 0x46, 0x3b, 0xea, 0x1a, 0x9c, 0x16, 0x86,
-	0x6f, 0x31, 0x7e, 0x4f, 0xe4, 0xaa, 0x2c, 0x52, 0xd5, 0x1b, 0xd1, 0x16, 0xcc, 0xc8, 0x15, 0x11,
-	0xd8, 0xfb, 0x70, 0x2c, 0x94, 0x7c, 0x0c, 0xd2, 0x19, 0x19, 0xb8, 0xa0, 0x01, 0x44, 0x78, 0xd4,
-	0x0d, 0xbc, 0x53, 0x0d, 0x38, 0x25, 0x9c, 0x05, 0x05, 0xbb, 0x51, 0xbb, 0x09, 0xb0, 0x5f, 0xd9,
-	0xe8, 0x66, 0xae, 0x88, 0xd5, 0xdc, 0x39, 0x06, 0x8a, 0xde, 0x99, 0x81, 0xc7, 0x40, 0xf1, 0x0e,
-	0xad, 0x32, 0xd4, 0x2d, 0x07, 0x34, 0xd5, 0x67, 0x0a, 0xe4, 0x65, 0x5e, 0x90, 0xd0, 0x07, 0x30,
-	0x1e, 0x22, 0xe4, 0x87, 0x3b, 0x2b, 0xa3, 0x63, 0x41, 0x46, 0x2e, 0xb9, 0x15, 0x42, 0x7d, 0x40,
-	0xa0, 0x9e, 0xef, 0x89, 0xda, 0xc3, 0x12, 0x82, 0xbd, 0x06, 0xb3, 0xb8, 0x51, 0x85, 0xeb, 0xeb,
-	0x5e, 0x7e, 0xde, 0xeb, 0xfc, 0xf1, 0x23, 0x34, 0x05, 0xc3, 0xf6, 0xb6, 0xc5, 0x1c, 0xcc, 0xa1,
-	0xf7, 0xa0, 0x7e, 0xae, 0xc0, 0x99, 0x64, 0x4d, 0x64, 0x4d, 0xe1, 0x84, 0xb4, 0xe6, 0x31, 0xce,
-	0xf3, 0xf2, 0x3d, 0x1f, 0xb3, 0x87, 0x31, 0x98, 0x6c, 0xc6, 0x97, 0xd4, 0x4f, 0x93, 0x61, 0x0c,
-	0x3c, 0xc7, 0xbf, 0x29, 0x70, 0x36, 0xc5, 0x19, 0x92, 0x36, 0xe0, 0xa4, 0x94, 0xb4, 0x9f, 0xf2,
-	0x3e, 0x59, 0x4f, 0x49, 0x58, 0x0f, 0x70, 0x03, 0x2c, 0xe3, 0xb6, 0x0d, 0x03, 0xf0, 0x23, 0x47,
-	0xe0, 0x10, 0x35, 0x4d, 0x3f, 0xf5, 0xe2, 0xb7, 0xda, 0xc4, 0xa2, 0x8f, 0x6a, 0x20, 0xfd, 0xbb,
-	0x30, 0x11, 0xa1, 0x8f, 0x11, 0x57, 0x7b, 0xf3, 0x46, 0xca, 0xe3, 0x61, 0xca, 0x2a, 0x93, 0x7a,
-	0x1c, 0x78, 0x7a, 0x7f, 0x51, 0xf0, 0x54, 0x8a, 0xf9, 0x41, 0x6a, 0xf7, 0xe0, 0x78, 0x84, 0x9a,
-	0x9f, 0xd3, 0xec, 0xdc, 0x26, 0xc2, 0xdc, 0x06, 0x98, 0xc9, 0xcb, 0x98, 0xc9, 0x1b, 0x6d, 0x8b,
-	0x36, 0x6a, 0xc6, 0x3a, 0xad, 0x53, 0xcb, 0x60, 0xbd, 0xcf, 0xe2, 0x2f, 0x86, 0x31, 0xbc, 0x51,
-	0x45, 0x64, 0xcd, 0x60, 0xc2, 0xf4, 0x56, 0xf4, 0x8a, 0xb7, 0xe4, 0x59, 0x58, 0x7f, 0xa7, 0x43,
-	0xe8, 0xaf, 0x97, 0xb3, 0x73, 0xd5, 0x1a, 0xdf, 0x6c, 0x55, 0x8a, 0x86, 0xdd, 0xc0, 0x36, 0x08,
-	0xff, 0x2d, 0xb9, 0xe6, 0x96, 0xc6, 0xdb, 0x4d, 0xe6, 0x16, 0x6f, 0x5b, 0xfc, 0xf7, 0x67, 0x4b,
-	0x80, 0xb4, 0x6e, 0x5b, 0xbc, 0x3c, 0x6e, 0x86, 0xdc, 0xc5, 0x8f, 0xfc, 0x03, 0xaf, 0x7f, 0xe4,
-	0x93, 0x45, 0xc8, 0x19, 0x2d, 0xc7, 0xe9, 0x64, 0x6a, 0xff, 0x96, 0x3e, 0x28, 0x6e, 0xe9, 0xe3,
-	0xb8, 0xd0, 0xbd, 0x92, 0x89, 0x0e, 0x47, 0x2b, 0xd4, 0xda, 0xea, 0xb2, 0x3b, 0x34, 0x00, 0x76,
-	0x63, 0x1d, 0x8b, 0x3e, 0xb5, 0x1a, 0xe4, 0xe8, 0x63, 0x5a, 0xab, 0xd3, 0x4a, 0x9d, 0x75, 0xbd,
-	0x0c, 0x0f, 0xc0, 0xcb, 0xf1, 0xae, 0x59, 0xdf, 0xd5, 0x47, 0x00, 0x75, 0xdb, 0xd8, 0x62, 0xa6,
-	0xfe, 0x90, 0xb1, 0xe9, 0x91, 0x01, 0xf8, 0x18, 0xf5, 0xec, 0xdd, 0x64, 0x8c, 0x7c, 0x0c, 0x63,
-	0xc6, 0x26, 0xb5, 0xaa, 0x4c, 0x77, 0x28, 0x67, 0xd3, 0x87, 0x07, 0x60, 0x1d, 0x3c, 0x83, 0x65,
-	0xca, 0x99, 0xfa, 0x36, 0xa8, 0xb2, 0xf2, 0x5b, 0x6f, 0x6f, 0x74, 0x6e, 0x9c, 0xf4, 0xeb, 0x68,
-	0x03, 0xde, 0x4c, 0xd5, 0xc5, 0xbd, 0xbc, 0x00, 0xd1, 0xfa, 0x13, 0x05, 0x3c, 0x1a, 0x2b, 0x4b,
-	0xb5, 0x8a, 0x0d, 0xe0, 0xf5, 0x16, 0xb7, 0xef, 0x89, 0x0e, 0xfc, 0x7f, 0x6a, 0x1c, 0x7e, 0x55,
-	0xb0, 0x57, 0x94, 0x78, 0x42, 0xd4, 0x0f, 0x60, 0x32, 0x3e, 0x09, 0xf8, 0x47, 0xcf, 0x39, 0x59,
-	0x81, 0x44, 0x6d, 0x61, 0x91, 0xe4, 0x68, 0xd4, 0xc7, 0xe0, 0x8e, 0x9f, 0xab, 0x18, 0xb0, 0x1b,
-	0xde, 0x18, 0x72, 0xbf, 0x3b, 0x85, 0xf4, 0x3e, 0x81, 0x9e, 0xf8, 0x21, 0x90, 0xe8, 0x62, 0x08,
-	0x3e, 0x01, 0x12, 0x9f, 0x6f, 0x30, 0xea, 0x8b, 0xb2, 0x08, 0x48, 0x4c, 0x05, 0x03, 0x61, 0x46,
-	0x97, 0x4b, 0x3f, 0xe6, 0x60, 0x58, 0x80, 0x20, 0xbb, 0x30, 0xe2, 0x35, 0xde, 0x64, 0x4e, 0x66,
-	0x39, 0x3e, 0x7e, 0xe4, 0xe7, 0x7b, 0xca, 0x79, 0x34, 0x54, 0xf5, 0xc9, 0x1f, 0xff, 0x7c, 0x79,
-	0x60, 0x86, 0xe4, 0xb5, 0xc4, 0x49, 0x8b, 0x7c, 0xaf, 0x40, 0x2e, 0x36, 0x37, 0x90, 0x95, 0x1e,
-	0x2e, 0xe2, 0x23, 0x4a, 0xbe, 0xd4, 0x8f, 0x0a, 0x02, 0x2c, 0x0a, 0x80, 0x0b, 0x64, 0x2e, 0x19,
-	0xa0, 0xb6, 0xd3, 0x3d, 0x52, 0x77, 0xc9, 0x53, 0x05, 0x8e, 0xf8, 0x63, 0x05, 0x59, 0x48, 0x74,
-	0x18, 0x99, 0x55, 0xf2, 0xe7, 0x33, 0x48, 0x22, 0x22, 0x4d, 0x20, 0x3a, 0x4f, 0xe6, 0xb5, 0x94,
-	0xf9, 0xd5, 0xd5, 0x76, 0x70, 0x33, 0xed, 0x92, 0x6f, 0x15, 0x38, 0x1a, 0xbc, 0x20, 0x88, 0x96,
-	0xe8, 0x4c, 0x3e, 0xb7, 0xe4, 0x97, 0xb3, 0x2b, 0x20, 0xc8, 0x55, 0x01, 0x72, 0x89, 0x2c, 0x6a,
-	0xbd, 0xc6, 0xd8, 0x00, 0xd0, 0xaf, 0x14, 0x38, 0x16, 0x9a, 0x16, 0xc8, 0x52, 0xa2, 0x63, 0xd9,
-	0xec, 0x92, 0x2f, 0x66, 0x15, 0x47, 0x94, 0x17, 0x04, 0xca, 0x73, 0x44, 0xed, 0x89, 0xd2, 0x25,
-	0x3f, 0x2b, 0x30, 0x29, 0x69, 0x4a, 0xc9, 0x6a, 0xca, 0xa6, 0x4a, 0x1a, 0x21, 0xf2, 0x6f, 0xf5,
-	0xa7, 0x84, 0x70, 0xaf, 0x0a, 0xb8, 0xab, 0x64, 0x45, 0xcb, 0xfa, 0x2d, 0x41, 0xdb, 0x11, 0xb7,
-	0xc1, 0x2e, 0xf9, 0x49, 0x81, 0x29, 0x59, 0x93, 0x4e, 0xfa, 0x42, 0xd2, 0x0d, 0xf4, 0xa5, 0x3e,
-	0xb5, 0x90, 0x40, 0x49, 0x10, 0xb8, 0x48, 0x2e, 0x64, 0x26, 0xe0, 0x92, 0x6f, 0x14, 0x18, 0x0f,
-	0x1b, 0x25, 0xc5, 0x8c, 0xde, 0x7d, 0xb4, 0x5a, 0x66, 0xf9, 0xd7, 0xc0, 0xa9, 0xed, 0x74, 0x86,
-	0x80, 0x5d, 0xf2, 0xb5, 0x02, 0x13, 0x91, 0xcb, 0x96, 0x64, 0x75, 0xec, 0xf6, 0x2e, 0xb4, 0x84,
-	0x16, 0x5c, 0xbd, 0x28, 0xa0, 0xce, 0x91, 0x73, 0x19, 0xa0, 0xba, 0xe4, 0x3b, 0x05, 0xc6, 0xc3,
-	0x5d, 0x6d, 0x4a, 0x30, 0xa5, 0x7d, 0x73, 0x4a, 0x30, 0xe5, 0xed, 0xb2, 0x7a, 0x49, 0x20, 0xd4,
-	0xc8, 0x92, 0x0c, 0x61, 0xa4, 0x91, 0x0e, 0x1c, 0x06, 0xcf, 0x15, 0x38, 0x29, 0x6f, 0x5e, 0xc8,
-	0xe5, 0xac, 0x51, 0x0a, 0x77, 0x4a, 0xf9, 0xb5, 0xbe, 0xf5, 0x90, 0xc2, 0x35, 0x41, 0x61, 0x8d,
-	0x5c, 0xca, 0x12, 0x64, 0xbd, 0xd2, 0xd6, 0x45, 0xd5, 0x75, 0x8b, 0xef, 0x07, 0x05, 0x72, 0xb1,
-	0x66, 0x26, 0xe5, 0x02, 0x4b, 0x6a, 0xb1, 0x52, 0x2e, 0xb0, 0xc4, 0x5e, 0x29, 0xfd, 0xba, 0x90,
-	0x74, 0x51, 0xe4, 0x99, 0x02, 0xb9, 0x58, 0xb3, 0x90, 0x82, 0x36, 0xa9, 0xbf, 0x49, 0x41, 0x9b,
-	0xd8, 0xd6, 0xa8, 0x57, 0x04, 0xda, 0x12, 0x59, 0xd6, 0x32, 0x7d, 0xd0, 0xdd, 0xdf, 0x2f, 0xeb,
-	0xb7, 0x9f, 0xbf, 0x2a, 0x28, 0x2f, 0x5e, 0x15, 0x94, 0xbf, 0x5f, 0x15, 0x94, 0xa7, 0x7b, 0x85,
-	0xa1, 0x17, 0x7b, 0x85, 0xa1, 0x3f, 0xf7, 0x0a, 0x43, 0x0f, 0xb4, 0x40, 0x23, 0x5e, 0xb1, 0x2a,
-	0x4b, 0xc6, 0x26, 0xad, 0x59, 0x41, 0xfb, 0x9f, 0x75, 0x3d, 0x88, 0xae, 0xbc, 0x32, 0x22, 0xbe,
-	0xac, 0xae, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x73, 0x20, 0x3f, 0x0e, 0x31, 0x17, 0x00, 0x00,
+func (m *QueryDelayedWithdrawalsRequest) Reset()         { *m = QueryDelayedWithdrawalsRequest{} }
+func (m *QueryDelayedWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryDelayedWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{26}
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedWithdrawalsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDelayedWithdrawalsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByTimestamp queries the parameter of the module by timestamp.
-	ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
-	// Queries our flows by account.
-	OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error)
-	// Queries a stream record by account.
-	StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error)
-	// Queries all stream records.
-	StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error)
-	// Queries the count of payment account by owner.
-	PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error)
-	// Queries all counts of payment account for all owners.
-	PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error)
-	// Queries a payment account by payment account address.
-	PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error)
-	// Queries all payment accounts.
-	PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error)
-	// Queries dynamic balance of a payment account.
-	DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error)
-	// Queries all payment accounts by a owner.
-	PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error)
-	// Queries all auto settle records.
-	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
-	// Queries delayed withdrawal of a account.
-	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+func (m *QueryDelayedWithdrawalsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDelayedWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryDelayedWithdrawalsResponse struct {
+	DelayedWithdrawals []DelayedWithdrawalRecord `protobuf:"bytes,1,rep,name=delayed_withdrawals,json=delayedWithdrawals,proto3" json:"delayed_withdrawals"`
+	Pagination         *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelayedWithdrawalsResponse) Reset()         { *m = QueryDelayedWithdrawalsResponse{} }
+func (m *QueryDelayedWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryDelayedWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{27}
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedWithdrawalsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
-	out := new(QueryParamsByTimestampResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ParamsByTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelayedWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedWithdrawalsResponse) GetDelayedWithdrawals() []DelayedWithdrawalRecord {
+	if m != nil {
+		return m.DelayedWithdrawals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error) {
-	out := new(QueryOutFlowsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/OutFlows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelayedWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error) {
-	out := new(QueryGetStreamRecordResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryProjectedFreezeTimeRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (c *queryClient) StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error) {
-	out := new(QueryStreamRecordsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedFreezeTimeRequest) Reset()         { *m = QueryProjectedFreezeTimeRequest{} }
+func (m *QueryProjectedFreezeTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedFreezeTimeRequest) ProtoMessage()    {}
+func (*QueryProjectedFreezeTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{28}
+}
+func (m *QueryProjectedFreezeTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedFreezeTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedFreezeTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProjectedFreezeTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedFreezeTimeRequest.Merge(m, src)
+}
+func (m *QueryProjectedFreezeTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedFreezeTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedFreezeTimeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error) {
-	out := new(QueryPaymentAccountCountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProjectedFreezeTimeRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedFreezeTimeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error) {
-	out := new(QueryPaymentAccountCountsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryProjectedFreezeTimeResponse struct {
+	// dynamic balance is static balance + flowDelta
+	DynamicBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=dynamic_balance,json=dynamicBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dynamic_balance"`
+	// the net flow rate of the stream account
+	NetflowRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=netflow_rate,json=netflowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"netflow_rate"`
+	// the unix timestamp when the stream account is projected to be frozen,
+	// 0 means the stream account is not going to be frozen or it is frozen already
+	FreezeTimestamp int64 `protobuf:"varint,3,opt,name=freeze_timestamp,json=freezeTimestamp,proto3" json:"freeze_timestamp,omitempty"`
+	// the seconds left before the stream account is frozen
+	Runway int64 `protobuf:"varint,4,opt,name=runway,proto3" json:"runway,omitempty"`
+	// the status of the stream account
+	Status StreamAccountStatus `protobuf:"varint,5,opt,name=status,proto3,enum=greenfield.payment.StreamAccountStatus" json:"status,omitempty"`
+	// the balance alert of the stream account, if any
+	BalanceAlert *BalanceAlert `protobuf:"bytes,6,opt,name=balance_alert,json=balanceAlert,proto3" json:"balance_alert,omitempty"`
+}
+
+func (m *QueryProjectedFreezeTimeResponse) Reset()         { *m = QueryProjectedFreezeTimeResponse{} }
+func (m *QueryProjectedFreezeTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedFreezeTimeResponse) ProtoMessage()    {}
+func (*QueryProjectedFreezeTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{29}
+}
+func (m *QueryProjectedFreezeTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedFreezeTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedFreezeTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProjectedFreezeTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedFreezeTimeResponse.Merge(m, src)
+}
+func (m *QueryProjectedFreezeTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedFreezeTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedFreezeTimeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error) {
-	out := new(QueryPaymentAccountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProjectedFreezeTimeResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedFreezeTimeResponse) GetFreezeTimestamp() int64 {
+	if m != nil {
+		return m.FreezeTimestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error) {
-	out := new(QueryPaymentAccountsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedFreezeTimeResponse) GetRunway() int64 {
+	if m != nil {
+		return m.Runway
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error) {
-	out := new(QueryDynamicBalanceResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DynamicBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedFreezeTimeResponse) GetStatus() StreamAccountStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return STREAM_ACCOUNT_STATUS_ACTIVE
 }
 
-func (c *queryClient) PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error) {
-	out := new(QueryPaymentAccountsByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountsByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedFreezeTimeResponse) GetBalanceAlert() *BalanceAlert {
+	if m != nil {
+		return m.BalanceAlert
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error) {
-	out := new(QueryAutoSettleRecordsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoSettleRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAutoTopUpRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error) {
-	out := new(QueryDelayedWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DelayedWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAutoTopUpRequest) Reset()         { *m = QueryAutoTopUpRequest{} }
+func (m *QueryAutoTopUpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoTopUpRequest) ProtoMessage()    {}
+func (*QueryAutoTopUpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{30}
+}
+func (m *QueryAutoTopUpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoTopUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoTopUpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAutoTopUpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoTopUpRequest.Merge(m, src)
+}
+func (m *QueryAutoTopUpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoTopUpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoTopUpRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByTimestamp queries the parameter of the module by timestamp.
-	ParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
-	// Queries our flows by account.
-	OutFlows(context.Context, *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error)
-	// Queries a stream record by account.
-	StreamRecord(context.Context, *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error)
-	// Queries all stream records.
-	StreamRecords(context.Context, *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error)
-	// Queries the count of payment account by owner.
-	PaymentAccountCount(context.Context, *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error)
-	// Queries all counts of payment account for all owners.
-	PaymentAccountCounts(context.Context, *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error)
-	// Queries a payment account by payment account address.
-	PaymentAccount(context.Context, *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error)
-	// Queries all payment accounts.
-	PaymentAccounts(context.Context, *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error)
-	// Queries dynamic balance of a payment account.
-	DynamicBalance(context.Context, *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error)
-	// Queries all payment accounts by a owner.
-	PaymentAccountsByOwner(context.Context, *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error)
-	// Queries all auto settle records.
-	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
-	// Queries delayed withdrawal of a account.
-	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+var xxx_messageInfo_QueryAutoTopUpRequest proto.InternalMessageInfo
+
+func (m *QueryAutoTopUpRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryAutoTopUpResponse struct {
+	AutoTopUp AutoTopUp `protobuf:"bytes,1,opt,name=auto_top_up,json=autoTopUp,proto3" json:"auto_top_up"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAutoTopUpResponse) Reset()         { *m = QueryAutoTopUpResponse{} }
+func (m *QueryAutoTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoTopUpResponse) ProtoMessage()    {}
+func (*QueryAutoTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{31}
 }
-func (*UnimplementedQueryServer) ParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByTimestamp not implemented")
+func (m *QueryAutoTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OutFlows(ctx context.Context, req *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutFlows not implemented")
+func (m *QueryAutoTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) StreamRecord(ctx context.Context, req *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamRecord not implemented")
+func (m *QueryAutoTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoTopUpResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StreamRecords(ctx context.Context, req *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+func (m *QueryAutoTopUpResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PaymentAccountCount(ctx context.Context, req *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCount not implemented")
+func (m *QueryAutoTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoTopUpResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PaymentAccountCounts(ctx context.Context, req *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCounts not implemented")
+
+var xxx_messageInfo_QueryAutoTopUpResponse proto.InternalMessageInfo
+
+func (m *QueryAutoTopUpResponse) GetAutoTopUp() AutoTopUp {
+	if m != nil {
+		return m.AutoTopUp
+	}
+	return AutoTopUp{}
 }
-func (*UnimplementedQueryServer) PaymentAccount(ctx context.Context, req *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccount not implemented")
+
+type QuerySpendingBudgetRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
-func (*UnimplementedQueryServer) PaymentAccounts(ctx context.Context, req *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccounts not implemented")
+
+func (m *QuerySpendingBudgetRequest) Reset()         { *m = QuerySpendingBudgetRequest{} }
+func (m *QuerySpendingBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendingBudgetRequest) ProtoMessage()    {}
+func (*QuerySpendingBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{32}
 }
-func (*UnimplementedQueryServer) DynamicBalance(ctx context.Context, req *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DynamicBalance not implemented")
+func (m *QuerySpendingBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PaymentAccountsByOwner(ctx context.Context, req *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountsByOwner not implemented")
+func (m *QuerySpendingBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendingBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleRecords not implemented")
+func (m *QuerySpendingBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendingBudgetRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
+func (m *QuerySpendingBudgetRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySpendingBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendingBudgetRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QuerySpendingBudgetRequest proto.InternalMessageInfo
+
+func (m *QuerySpendingBudgetRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/ParamsByTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySpendingBudgetResponse struct {
+	SpendingBudget SpendingBudget `protobuf:"bytes,1,opt,name=spending_budget,json=spendingBudget,proto3" json:"spending_budget"`
 }
 
-func _Query_OutFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutFlowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySpendingBudgetResponse) Reset()         { *m = QuerySpendingBudgetResponse{} }
+func (m *QuerySpendingBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendingBudgetResponse) ProtoMessage()    {}
+func (*QuerySpendingBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{33}
+}
+func (m *QuerySpendingBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendingBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendingBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutFlows(ctx, in)
+}
+func (m *QuerySpendingBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendingBudgetResponse.Merge(m, src)
+}
+func (m *QuerySpendingBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendingBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendingBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendingBudgetResponse proto.InternalMessageInfo
+
+func (m *QuerySpendingBudgetResponse) GetSpendingBudget() SpendingBudget {
+	if m != nil {
+		return m.SpendingBudget
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/OutFlows",
+	return SpendingBudget{}
+}
+
+type QueryBillingStatementRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// start_time is the unix timestamp the statement starts from, inclusive
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix timestamp the statement ends at, exclusive, 0 means the current block time
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// pagination defines an optional pagination over the buckets, the key of which is the bucket id
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBillingStatementRequest) Reset()         { *m = QueryBillingStatementRequest{} }
+func (m *QueryBillingStatementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingStatementRequest) ProtoMessage()    {}
+func (*QueryBillingStatementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{34}
+}
+func (m *QueryBillingStatementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBillingStatementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingStatementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutFlows(ctx, req.(*QueryOutFlowsRequest))
+}
+func (m *QueryBillingStatementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingStatementRequest.Merge(m, src)
+}
+func (m *QueryBillingStatementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBillingStatementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingStatementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBillingStatementRequest proto.InternalMessageInfo
+
+func (m *QueryBillingStatementRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_StreamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStreamRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBillingStatementRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).StreamRecord(ctx, in)
+	return 0
+}
+
+func (m *QueryBillingStatementRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/StreamRecord",
+	return 0
+}
+
+func (m *QueryBillingStatementRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StreamRecord(ctx, req.(*QueryGetStreamRecordRequest))
+	return nil
+}
+
+type QueryBillingStatementResponse struct {
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// items are the charges broken down by bucket, receiver and category
+	Items []BillingStatementItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	// total is the total amount charged by the buckets of the page
+	Total      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	Pagination *query.PageResponse                    `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBillingStatementResponse) Reset()         { *m = QueryBillingStatementResponse{} }
+func (m *QueryBillingStatementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingStatementResponse) ProtoMessage()    {}
+func (*QueryBillingStatementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{35}
+}
+func (m *QueryBillingStatementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBillingStatementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingStatementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBillingStatementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingStatementResponse.Merge(m, src)
+}
+func (m *QueryBillingStatementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBillingStatementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingStatementResponse.DiscardUnknown(m)
 }
 
-func _Query_StreamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStreamRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBillingStatementResponse proto.InternalMessageInfo
+
+func (m *QueryBillingStatementResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).StreamRecords(ctx, in)
+	return 0
+}
+
+func (m *QueryBillingStatementResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/StreamRecords",
+	return 0
+}
+
+func (m *QueryBillingStatementResponse) GetItems() []BillingStatementItem {
+	if m != nil {
+		return m.Items
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StreamRecords(ctx, req.(*QueryStreamRecordsRequest))
+	return nil
+}
+
+func (m *QueryBillingStatementResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PaymentAccountCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccountCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountCount(ctx, req.(*QueryPaymentAccountCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryReadVoucherRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_PaymentAccountCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountCountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountCounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccountCounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountCounts(ctx, req.(*QueryPaymentAccountCountsRequest))
+func (m *QueryReadVoucherRequest) Reset()         { *m = QueryReadVoucherRequest{} }
+func (m *QueryReadVoucherRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReadVoucherRequest) ProtoMessage()    {}
+func (*QueryReadVoucherRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{36}
+}
+func (m *QueryReadVoucherRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadVoucherRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadVoucherRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReadVoucherRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadVoucherRequest.Merge(m, src)
+}
+func (m *QueryReadVoucherRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadVoucherRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadVoucherRequest.DiscardUnknown(m)
 }
 
-func _Query_PaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccount(ctx, req.(*QueryPaymentAccountRequest))
+var xxx_messageInfo_QueryReadVoucherRequest proto.InternalMessageInfo
+
+func (m *QueryReadVoucherRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PaymentAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccounts(ctx, req.(*QueryPaymentAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryReadVoucherResponse struct {
+	ReadVoucher ReadVoucher `protobuf:"bytes,1,opt,name=read_voucher,json=readVoucher,proto3" json:"read_voucher"`
 }
 
-func _Query_DynamicBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDynamicBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DynamicBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/DynamicBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DynamicBalance(ctx, req.(*QueryDynamicBalanceRequest))
+func (m *QueryReadVoucherResponse) Reset()         { *m = QueryReadVoucherResponse{} }
+func (m *QueryReadVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReadVoucherResponse) ProtoMessage()    {}
+func (*QueryReadVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{37}
+}
+func (m *QueryReadVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReadVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadVoucherResponse.Merge(m, src)
+}
+func (m *QueryReadVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadVoucherResponse.DiscardUnknown(m)
 }
 
-func _Query_PaymentAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountsByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReadVoucherResponse proto.InternalMessageInfo
+
+func (m *QueryReadVoucherResponse) GetReadVoucher() ReadVoucher {
+	if m != nil {
+		return m.ReadVoucher
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountsByOwner(ctx, in)
+	return ReadVoucher{}
+}
+
+type QueryReadVouchersByHolderRequest struct {
+	Holder     string             `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReadVouchersByHolderRequest) Reset()         { *m = QueryReadVouchersByHolderRequest{} }
+func (m *QueryReadVouchersByHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReadVouchersByHolderRequest) ProtoMessage()    {}
+func (*QueryReadVouchersByHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{38}
+}
+func (m *QueryReadVouchersByHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadVouchersByHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadVouchersByHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccountsByOwner",
+}
+func (m *QueryReadVouchersByHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadVouchersByHolderRequest.Merge(m, src)
+}
+func (m *QueryReadVouchersByHolderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadVouchersByHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadVouchersByHolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReadVouchersByHolderRequest proto.InternalMessageInfo
+
+func (m *QueryReadVouchersByHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountsByOwner(ctx, req.(*QueryPaymentAccountsByOwnerRequest))
+	return ""
+}
+
+func (m *QueryReadVouchersByHolderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AutoSettleRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAutoSettleRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryReadVouchersByHolderResponse struct {
+	// read_vouchers are the vouchers of the holder not expired yet
+	ReadVouchers []ReadVoucher       `protobuf:"bytes,1,rep,name=read_vouchers,json=readVouchers,proto3" json:"read_vouchers"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReadVouchersByHolderResponse) Reset()         { *m = QueryReadVouchersByHolderResponse{} }
+func (m *QueryReadVouchersByHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReadVouchersByHolderResponse) ProtoMessage()    {}
+func (*QueryReadVouchersByHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{39}
+}
+func (m *QueryReadVouchersByHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadVouchersByHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadVouchersByHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AutoSettleRecords(ctx, in)
+}
+func (m *QueryReadVouchersByHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadVouchersByHolderResponse.Merge(m, src)
+}
+func (m *QueryReadVouchersByHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadVouchersByHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadVouchersByHolderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReadVouchersByHolderResponse proto.InternalMessageInfo
+
+func (m *QueryReadVouchersByHolderResponse) GetReadVouchers() []ReadVoucher {
+	if m != nil {
+		return m.ReadVouchers
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/AutoSettleRecords",
+	return nil
+}
+
+func (m *QueryReadVouchersByHolderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AutoSettleRecords(ctx, req.(*QueryAutoSettleRecordsRequest))
+	return nil
+}
+
+type QueryMultisigProposalsRequest struct {
+	PaymentAccount string             `protobuf:"bytes,1,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMultisigProposalsRequest) Reset()         { *m = QueryMultisigProposalsRequest{} }
+func (m *QueryMultisigProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultisigProposalsRequest) ProtoMessage()    {}
+func (*QueryMultisigProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{40}
+}
+func (m *QueryMultisigProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultisigProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultisigProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMultisigProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultisigProposalsRequest.Merge(m, src)
+}
+func (m *QueryMultisigProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultisigProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultisigProposalsRequest.DiscardUnknown(m)
 }
 
-func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelayedWithdrawalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultisigProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryMultisigProposalsRequest) GetPaymentAccount() string {
+	if m != nil {
+		return m.PaymentAccount
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelayedWithdrawal(ctx, in)
+	return ""
+}
+
+func (m *QueryMultisigProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/DelayedWithdrawal",
+	return nil
+}
+
+type QueryMultisigProposalsResponse struct {
+	Proposals  []MultisigProposal  `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMultisigProposalsResponse) Reset()         { *m = QueryMultisigProposalsResponse{} }
+func (m *QueryMultisigProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultisigProposalsResponse) ProtoMessage()    {}
+func (*QueryMultisigProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{41}
+}
+func (m *QueryMultisigProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultisigProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultisigProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelayedWithdrawal(ctx, req.(*QueryDelayedWithdrawalRequest))
+}
+func (m *QueryMultisigProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultisigProposalsResponse.Merge(m, src)
+}
+func (m *QueryMultisigProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultisigProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultisigProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultisigProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryMultisigProposalsResponse) GetProposals() []MultisigProposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.payment.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByTimestamp",
-			Handler:    _Query_ParamsByTimestamp_Handler,
-		},
-		{
-			MethodName: "OutFlows",
-			Handler:    _Query_OutFlows_Handler,
-		},
-		{
-			MethodName: "StreamRecord",
-			Handler:    _Query_StreamRecord_Handler,
-		},
-		{
-			MethodName: "StreamRecords",
-			Handler:    _Query_StreamRecords_Handler,
-		},
-		{
-			MethodName: "PaymentAccountCount",
-			Handler:    _Query_PaymentAccountCount_Handler,
-		},
-		{
-			MethodName: "PaymentAccountCounts",
-			Handler:    _Query_PaymentAccountCounts_Handler,
-		},
-		{
-			MethodName: "PaymentAccount",
-			Handler:    _Query_PaymentAccount_Handler,
-		},
-		{
-			MethodName: "PaymentAccounts",
-			Handler:    _Query_PaymentAccounts_Handler,
-		},
-		{
-			MethodName: "DynamicBalance",
-			Handler:    _Query_DynamicBalance_Handler,
-		},
-		{
-			MethodName: "PaymentAccountsByOwner",
-			Handler:    _Query_PaymentAccountsByOwner_Handler,
-		},
-		{
-			MethodName: "AutoSettleRecords",
-			Handler:    _Query_AutoSettleRecords_Handler,
-		},
-		{
-			MethodName: "DelayedWithdrawal",
-			Handler:    _Query_DelayedWithdrawal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/payment/query.proto",
+func (m *QueryMultisigProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.payment.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.payment.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.payment.QueryParamsByTimestampRequest")
+	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.payment.QueryParamsByTimestampResponse")
+	proto.RegisterType((*QueryOutFlowsRequest)(nil), "greenfield.payment.QueryOutFlowsRequest")
+	proto.RegisterType((*QueryOutFlowsResponse)(nil), "greenfield.payment.QueryOutFlowsResponse")
+	proto.RegisterType((*QueryGetStreamRecordRequest)(nil), "greenfield.payment.QueryGetStreamRecordRequest")
+	proto.RegisterType((*QueryGetStreamRecordResponse)(nil), "greenfield.payment.QueryGetStreamRecordResponse")
+	proto.RegisterType((*QueryStreamRecordsRequest)(nil), "greenfield.payment.QueryStreamRecordsRequest")
+	proto.RegisterType((*QueryStreamRecordsResponse)(nil), "greenfield.payment.QueryStreamRecordsResponse")
+	proto.RegisterType((*QueryPaymentAccountCountRequest)(nil), "greenfield.payment.QueryPaymentAccountCountRequest")
+	proto.RegisterType((*QueryPaymentAccountCountResponse)(nil), "greenfield.payment.QueryPaymentAccountCountResponse")
+	proto.RegisterType((*QueryPaymentAccountCountsRequest)(nil), "greenfield.payment.QueryPaymentAccountCountsRequest")
+	proto.RegisterType((*QueryPaymentAccountCountsResponse)(nil), "greenfield.payment.QueryPaymentAccountCountsResponse")
+	proto.RegisterType((*QueryPaymentAccountRequest)(nil), "greenfield.payment.QueryPaymentAccountRequest")
+	proto.RegisterType((*QueryPaymentAccountResponse)(nil), "greenfield.payment.QueryPaymentAccountResponse")
+	proto.RegisterType((*QueryPaymentAccountsRequest)(nil), "greenfield.payment.QueryPaymentAccountsRequest")
+	proto.RegisterType((*QueryPaymentAccountsResponse)(nil), "greenfield.payment.QueryPaymentAccountsResponse")
+	proto.RegisterType((*QueryDynamicBalanceRequest)(nil), "greenfield.payment.QueryDynamicBalanceRequest")
+	proto.RegisterType((*QueryDynamicBalanceResponse)(nil), "greenfield.payment.QueryDynamicBalanceResponse")
+	proto.RegisterType((*QueryPaymentAccountsByOwnerRequest)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerRequest")
+	proto.RegisterType((*QueryPaymentAccountsByOwnerResponse)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerResponse")
+	proto.RegisterType((*QueryAutoSettleRecordsRequest)(nil), "greenfield.payment.QueryAutoSettleRecordsRequest")
+	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "greenfield.payment.QueryAutoSettleRecordsResponse")
+	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalRequest")
+	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalResponse")
+	proto.RegisterType((*QueryDelayedWithdrawalsRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalsRequest")
+	proto.RegisterType((*QueryDelayedWithdrawalsResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalsResponse")
+	proto.RegisterType((*QueryProjectedFreezeTimeRequest)(nil), "greenfield.payment.QueryProjectedFreezeTimeRequest")
+	proto.RegisterType((*QueryProjectedFreezeTimeResponse)(nil), "greenfield.payment.QueryProjectedFreezeTimeResponse")
+	proto.RegisterType((*QueryAutoTopUpRequest)(nil), "greenfield.payment.QueryAutoTopUpRequest")
+	proto.RegisterType((*QueryAutoTopUpResponse)(nil), "greenfield.payment.QueryAutoTopUpResponse")
+	proto.RegisterType((*QuerySpendingBudgetRequest)(nil), "greenfield.payment.QuerySpendingBudgetRequest")
+	proto.RegisterType((*QuerySpendingBudgetResponse)(nil), "greenfield.payment.QuerySpendingBudgetResponse")
+	proto.RegisterType((*QueryBillingStatementRequest)(nil), "greenfield.payment.QueryBillingStatementRequest")
+	proto.RegisterType((*QueryBillingStatementResponse)(nil), "greenfield.payment.QueryBillingStatementResponse")
+	proto.RegisterType((*QueryReadVoucherRequest)(nil), "greenfield.payment.QueryReadVoucherRequest")
+	proto.RegisterType((*QueryReadVoucherResponse)(nil), "greenfield.payment.QueryReadVoucherResponse")
+	proto.RegisterType((*QueryReadVouchersByHolderRequest)(nil), "greenfield.payment.QueryReadVouchersByHolderRequest")
+	proto.RegisterType((*QueryReadVouchersByHolderResponse)(nil), "greenfield.payment.QueryReadVouchersByHolderResponse")
+	proto.RegisterType((*QueryMultisigProposalsRequest)(nil), "greenfield.payment.QueryMultisigProposalsRequest")
+	proto.RegisterType((*QueryMultisigProposalsResponse)(nil), "greenfield.payment.QueryMultisigProposalsResponse")
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("greenfield/payment/query.proto", fileDescriptor_f62e6684473ccf4a) }
+
+var fileDescriptor_f62e6684473ccf4a = []byte{
+	// 2244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0xf8, 0x2b, 0xd9, 0xe3, 0xef, 0x6b, 0xd7, 0x38, 0x5b, 0xc7, 0x76, 0x87, 0xd4, 0x1f,
+	0x71, 0xbc, 0x13, 0xdb, 0x4d, 0x93, 0xb6, 0x14, 0xf0, 0x36, 0xa4, 0x0d, 0xa8, 0x4a, 0xba, 0x2e,
+	0x54, 0x0a, 0xaa, 0x86, 0xbb, 0x3b, 0xd7, 0xeb, 0x69, 0x76, 0x67, 0xb6, 0x33, 0x77, 0x63, 0x16,
+	0xcb, 0x2f, 0x95, 0xe0, 0xb9, 0x82, 0x07, 0x24, 0x1e, 0x91, 0xf8, 0x50, 0x11, 0x4f, 0x04, 0x51,
+	0x89, 0xf2, 0x88, 0x94, 0x27, 0x54, 0xca, 0x0b, 0xe2, 0x21, 0x42, 0x09, 0x7f, 0x08, 0x9a, 0x3b,
+	0x67, 0xd6, 0xf3, 0x71, 0x67, 0x76, 0x6c, 0x96, 0xbe, 0x24, 0xde, 0x99, 0x73, 0xee, 0xf9, 0x9d,
+	0x73, 0xcf, 0x3d, 0xe7, 0xdc, 0xdf, 0x2e, 0x2c, 0xd6, 0x1d, 0xc6, 0xac, 0x7d, 0x93, 0x35, 0x0c,
+	0xad, 0x45, 0x3b, 0x4d, 0x66, 0x71, 0xed, 0xc3, 0x36, 0x73, 0x3a, 0xa5, 0x96, 0x63, 0x73, 0x9b,
+	0x90, 0x93, 0xf7, 0x25, 0x7c, 0x5f, 0xbc, 0x52, 0xb3, 0xdd, 0xa6, 0xed, 0x6a, 0x55, 0xea, 0x32,
+	0x5f, 0x58, 0x7b, 0xb8, 0x55, 0x65, 0x9c, 0x6e, 0x69, 0x2d, 0x5a, 0x37, 0x2d, 0xca, 0x4d, 0xdb,
+	0xf2, 0xf5, 0x8b, 0x17, 0x7d, 0x59, 0x5d, 0x7c, 0xd2, 0xfc, 0x0f, 0xf8, 0x6a, 0xb6, 0x6e, 0xd7,
+	0x6d, 0xff, 0xb9, 0xf7, 0x17, 0x3e, 0x5d, 0xa8, 0xdb, 0x76, 0xbd, 0xc1, 0x34, 0xda, 0x32, 0x35,
+	0x6a, 0x59, 0x36, 0x17, 0xab, 0x05, 0x3a, 0x1b, 0x12, 0xb8, 0xb4, 0xcd, 0x6d, 0xdd, 0x65, 0x9c,
+	0x37, 0x98, 0xee, 0xb0, 0x9a, 0xed, 0x18, 0x28, 0x7c, 0x39, 0x4d, 0x98, 0xdb, 0x2d, 0xbd, 0xdd,
+	0x42, 0xa9, 0x15, 0x89, 0x54, 0x95, 0x36, 0xa8, 0x55, 0x63, 0x3a, 0x6d, 0x30, 0x87, 0xa3, 0xdc,
+	0xb2, 0x4c, 0xce, 0x6c, 0x34, 0x4c, 0xab, 0x8e, 0x12, 0xdb, 0x12, 0x09, 0x83, 0x35, 0x68, 0x87,
+	0x19, 0xfa, 0xa1, 0xc9, 0x0f, 0x0c, 0x87, 0x1e, 0xd2, 0x46, 0x14, 0xe3, 0x0b, 0x12, 0x9d, 0x66,
+	0xbb, 0xc1, 0x4d, 0xd7, 0xac, 0x67, 0x88, 0xd8, 0x6d, 0xae, 0xef, 0x37, 0xec, 0x43, 0x14, 0x59,
+	0x92, 0x88, 0xb4, 0xa8, 0x43, 0x9b, 0x41, 0xdc, 0xd6, 0xa4, 0x02, 0xe2, 0x7f, 0x9d, 0xd6, 0x6a,
+	0x76, 0xdb, 0x0a, 0xdc, 0x2c, 0xf5, 0x96, 0xd4, 0xc3, 0xf2, 0x2f, 0x4a, 0xe4, 0x1d, 0x46, 0x0d,
+	0xfd, 0xa1, 0xdd, 0xae, 0x1d, 0x30, 0x27, 0x03, 0x80, 0xdb, 0x62, 0x96, 0x61, 0x5a, 0x75, 0xbd,
+	0xda, 0x36, 0xea, 0x8c, 0x67, 0xec, 0x87, 0xcb, 0x1d, 0x46, 0x9b, 0x91, 0xc8, 0xa9, 0xb3, 0x40,
+	0xde, 0xf1, 0x72, 0xef, 0x9e, 0xf0, 0xb3, 0xc2, 0x3e, 0x6c, 0x33, 0x97, 0xab, 0x77, 0x61, 0x26,
+	0xf2, 0xd4, 0x6d, 0xd9, 0x96, 0xcb, 0xc8, 0x4d, 0x18, 0xf1, 0xe3, 0x31, 0xaf, 0x2c, 0x2b, 0x6b,
+	0xa3, 0xdb, 0x61, 0x37, 0x83, 0xbc, 0x2e, 0xf9, 0x3a, 0xe5, 0xa1, 0xc7, 0x4f, 0x96, 0xce, 0x55,
+	0x50, 0x5e, 0x7d, 0x1d, 0x2e, 0x85, 0x16, 0x2c, 0x77, 0xde, 0x35, 0x9b, 0xcc, 0xe5, 0xb4, 0xd9,
+	0x42, 0x8b, 0x64, 0x01, 0x0a, 0x3c, 0x78, 0x26, 0x56, 0x1f, 0xac, 0x9c, 0x3c, 0x50, 0xef, 0xc3,
+	0x62, 0x9a, 0xfa, 0xff, 0x0c, 0xed, 0x1a, 0xcc, 0x8a, 0xb5, 0xef, 0xb6, 0xf9, 0xed, 0x86, 0x7d,
+	0x18, 0xc4, 0x80, 0xcc, 0xc3, 0x79, 0xdc, 0x29, 0xb1, 0x64, 0xa1, 0x12, 0x7c, 0x54, 0xdf, 0x83,
+	0xe7, 0x62, 0x1a, 0x08, 0xe2, 0xeb, 0x50, 0x08, 0x52, 0xca, 0xc3, 0x31, 0xb8, 0x36, 0xba, 0xfd,
+	0xbc, 0x0c, 0x07, 0x2a, 0x22, 0x90, 0x0b, 0x36, 0xae, 0xa3, 0xde, 0x80, 0xe7, 0xc5, 0xc2, 0x6f,
+	0x32, 0xbe, 0x27, 0xf6, 0xaa, 0x22, 0xb6, 0xaa, 0x37, 0xa2, 0x07, 0xb0, 0x20, 0x57, 0x44, 0x60,
+	0xdf, 0x81, 0xf1, 0xc8, 0xe6, 0x63, 0x90, 0x96, 0x65, 0xe0, 0xc2, 0x0b, 0x20, 0xc2, 0x31, 0x37,
+	0xf4, 0x4c, 0xad, 0xc1, 0x45, 0x61, 0x2c, 0x2c, 0xd8, 0x8d, 0xda, 0x6d, 0x80, 0x93, 0xea, 0x85,
+	0x66, 0x56, 0x4a, 0x58, 0xb1, 0xbc, 0x52, 0x57, 0xf2, 0xeb, 0x22, 0x96, 0xba, 0xd2, 0x3d, 0x5a,
+	0x67, 0xa8, 0x5b, 0x09, 0x69, 0xaa, 0x8f, 0x14, 0x28, 0xca, 0xac, 0xa0, 0x43, 0x6f, 0xc3, 0x44,
+	0xc4, 0xa1, 0x20, 0xdc, 0x79, 0x3d, 0x1a, 0x0f, 0x7b, 0xe4, 0x92, 0x37, 0x23, 0xa8, 0x07, 0x04,
+	0xea, 0xd5, 0x9e, 0xa8, 0x7d, 0x2c, 0x11, 0xd8, 0x37, 0x60, 0x09, 0x13, 0x55, 0x98, 0xde, 0xf5,
+	0xf7, 0xe7, 0x0d, 0xef, 0x9f, 0x20, 0x42, 0xb3, 0x30, 0x6c, 0x1f, 0x5a, 0xcc, 0xc1, 0x3d, 0xf4,
+	0x3f, 0xa8, 0x3f, 0x56, 0x60, 0x39, 0x5d, 0x13, 0xbd, 0xa6, 0xf0, 0x9c, 0xb4, 0x88, 0x60, 0x9c,
+	0x57, 0xe5, 0x39, 0x9f, 0x58, 0x0f, 0x63, 0x30, 0xd3, 0x4a, 0xbe, 0x52, 0x3f, 0x48, 0x87, 0xd1,
+	0xf7, 0x3d, 0xfe, 0xbb, 0x02, 0x2f, 0x64, 0x18, 0x43, 0xa7, 0x6b, 0x30, 0x27, 0x75, 0x3a, 0xd8,
+	0xf2, 0x53, 0x7a, 0x3d, 0x2b, 0xf1, 0xba, 0x8f, 0x09, 0x70, 0x0d, 0xd3, 0x36, 0x0a, 0x20, 0x88,
+	0x1c, 0x81, 0x21, 0x6a, 0x18, 0xc1, 0xd6, 0x8b, 0xbf, 0xd5, 0x16, 0x1e, 0xfa, 0xb8, 0x06, 0xba,
+	0xff, 0x0e, 0x4c, 0xc6, 0xdc, 0xc7, 0x88, 0xab, 0xbd, 0xfd, 0x46, 0x97, 0x27, 0xa2, 0x2e, 0xab,
+	0x4c, 0x6a, 0xb1, 0xef, 0xdb, 0xfb, 0x99, 0x82, 0x55, 0x29, 0x61, 0x07, 0x5d, 0xdb, 0x83, 0xa9,
+	0x98, 0x6b, 0xc1, 0x9e, 0xe6, 0xf7, 0x6d, 0x32, 0xea, 0x5b, 0x1f, 0x77, 0xf2, 0x65, 0xdc, 0xc9,
+	0x5b, 0x1d, 0x8b, 0x36, 0xcd, 0x5a, 0xd9, 0x1f, 0x66, 0x7a, 0xd7, 0xe2, 0x9f, 0x0c, 0x63, 0x78,
+	0xe3, 0x8a, 0xe8, 0x35, 0x83, 0x49, 0xc3, 0x7f, 0xa3, 0xe3, 0x80, 0xe4, 0xaf, 0x50, 0xfe, 0x9a,
+	0xe7, 0xd0, 0xbf, 0x9e, 0x2c, 0xad, 0xd4, 0x4d, 0x7e, 0xd0, 0xae, 0x96, 0x6a, 0x76, 0x13, 0x47,
+	0x3d, 0xfc, 0x6f, 0xd3, 0x35, 0x1e, 0x68, 0xbc, 0xd3, 0x62, 0x6e, 0xe9, 0x8e, 0xc5, 0xbf, 0x78,
+	0xb4, 0x09, 0xe8, 0xd6, 0x1d, 0x8b, 0x57, 0x26, 0x8c, 0x88, 0xb9, 0x64, 0xc9, 0x1f, 0x38, 0x7b,
+	0xc9, 0x27, 0x1b, 0x30, 0x5d, 0x6b, 0x3b, 0x8e, 0xb7, 0x53, 0x27, 0x5d, 0x7a, 0x50, 0x74, 0xe9,
+	0x29, 0x7c, 0xd1, 0x6d, 0xc9, 0x44, 0x87, 0xb1, 0x2a, 0xb5, 0x1e, 0x74, 0xbd, 0x1b, 0xea, 0x83,
+	0x77, 0xa3, 0xde, 0x8a, 0x81, 0x6b, 0x26, 0x4c, 0xd3, 0x87, 0xd4, 0x6c, 0xd0, 0x6a, 0x83, 0x75,
+	0xad, 0x0c, 0xf7, 0xc1, 0xca, 0x54, 0x77, 0xd9, 0xc0, 0xd4, 0xf7, 0x01, 0x1a, 0x76, 0xed, 0x01,
+	0x33, 0xf4, 0x7d, 0xc6, 0xe6, 0x47, 0xfa, 0x60, 0xa3, 0xe0, 0xaf, 0x77, 0x9b, 0x31, 0xf2, 0x3e,
+	0x8c, 0xd6, 0x0e, 0xa8, 0x55, 0x67, 0xba, 0x43, 0x39, 0x9b, 0x3f, 0xdf, 0x87, 0xd5, 0xc1, 0x5f,
+	0xb0, 0x42, 0x39, 0x53, 0x5f, 0x05, 0x55, 0x76, 0xfc, 0xca, 0x9d, 0xbb, 0x5e, 0xc7, 0xc9, 0x6e,
+	0x47, 0x77, 0xe1, 0xab, 0x99, 0xba, 0x98, 0xcb, 0x6b, 0x10, 0x3f, 0x7f, 0xe2, 0x00, 0x17, 0x12,
+	0xc7, 0x52, 0xad, 0xe3, 0x00, 0xb8, 0xdb, 0xe6, 0xf6, 0x9e, 0xb8, 0x65, 0xfc, 0x9f, 0x06, 0x87,
+	0xbf, 0x2a, 0x38, 0x2b, 0x4a, 0x2c, 0x21, 0xea, 0xfb, 0x30, 0x93, 0xbc, 0xed, 0x04, 0xa5, 0xe7,
+	0xb2, 0xec, 0x80, 0xc4, 0xd7, 0xc2, 0x43, 0x32, 0x4d, 0xe3, 0x36, 0xfa, 0x57, 0x7e, 0x5e, 0xc1,
+	0x80, 0xdd, 0xf2, 0xaf, 0x3e, 0xef, 0x75, 0x6f, 0x3e, 0xbd, 0x2b, 0xd0, 0x47, 0x41, 0x08, 0x24,
+	0xba, 0x18, 0x82, 0x1f, 0x00, 0x49, 0xde, 0xa9, 0x30, 0xea, 0x1b, 0xb2, 0x08, 0x48, 0x96, 0x0a,
+	0x07, 0xc2, 0x88, 0xbf, 0xce, 0x00, 0xd1, 0x7b, 0xc2, 0x8e, 0x25, 0xc3, 0xc0, 0x99, 0x93, 0xe1,
+	0x6f, 0x0a, 0xce, 0x63, 0x32, 0x10, 0x18, 0x8a, 0x2a, 0xcc, 0x24, 0x43, 0x11, 0x64, 0xc3, 0x19,
+	0x62, 0x41, 0x12, 0xb1, 0xe8, 0x63, 0x56, 0xbc, 0x16, 0xcc, 0x97, 0x8e, 0xfd, 0x01, 0xab, 0x71,
+	0x66, 0xdc, 0x76, 0x18, 0xfb, 0x11, 0xf3, 0x8a, 0x6f, 0xef, 0xbc, 0xf8, 0xe3, 0x60, 0x30, 0xdc,
+	0xc9, 0xb4, 0xbf, 0xdc, 0xf6, 0xa4, 0xc3, 0x98, 0xc5, 0xb8, 0x77, 0x53, 0xf2, 0x8b, 0xdf, 0x40,
+	0x3f, 0x9a, 0x04, 0xae, 0xe8, 0x55, 0x3f, 0xb2, 0x0e, 0x53, 0xfb, 0xc2, 0xbb, 0x44, 0xc7, 0x9a,
+	0xdc, 0xef, 0x7a, 0xed, 0x37, 0xac, 0x39, 0x18, 0x71, 0xda, 0xd6, 0x21, 0xed, 0x88, 0x56, 0x35,
+	0x58, 0xc1, 0x4f, 0xe4, 0x1b, 0x30, 0xe2, 0x72, 0xca, 0xdb, 0xae, 0x68, 0x2e, 0x13, 0xf2, 0x49,
+	0xd3, 0xef, 0x9d, 0x58, 0xe7, 0xf6, 0x84, 0x78, 0x05, 0xd5, 0xc8, 0xb7, 0x60, 0x3c, 0xc2, 0x81,
+	0x88, 0x06, 0x92, 0xd2, 0x83, 0x31, 0x30, 0xbb, 0x9e, 0x5c, 0x65, 0xac, 0x1a, 0xfa, 0xa4, 0x6e,
+	0xe0, 0x7d, 0xd3, 0xab, 0x42, 0xef, 0xda, 0xad, 0xef, 0xb6, 0xb2, 0xc6, 0xc9, 0xf7, 0x61, 0x2e,
+	0x2e, 0x8c, 0x3b, 0xfb, 0x06, 0x8c, 0x86, 0x78, 0x1b, 0x3c, 0xec, 0x97, 0xd2, 0xca, 0x9d, 0xd0,
+	0xc5, 0x94, 0x2e, 0xd0, 0xe0, 0x41, 0x77, 0xbe, 0xdd, 0x43, 0xd6, 0xa1, 0x2c, 0x48, 0x87, 0x3c,
+	0xf3, 0x6d, 0x5c, 0xe3, 0x64, 0xbe, 0x8d, 0x31, 0x18, 0x59, 0xf3, 0x6d, 0x74, 0x91, 0x60, 0xbe,
+	0x75, 0x23, 0x4f, 0xbd, 0xbb, 0xa3, 0x3f, 0x78, 0x96, 0x7d, 0x62, 0xc9, 0xdb, 0x15, 0xe6, 0xa9,
+	0x67, 0xc0, 0x24, 0x97, 0x00, 0x5c, 0x4e, 0x1d, 0x7f, 0xc0, 0x11, 0xe9, 0x38, 0x58, 0x29, 0x88,
+	0x27, 0x5e, 0xa2, 0x90, 0x8b, 0x70, 0x81, 0x59, 0x86, 0xff, 0xd2, 0x4f, 0xa3, 0xf3, 0xcc, 0x32,
+	0xc4, 0xab, 0x68, 0xb1, 0x1a, 0x3a, 0xfb, 0xbc, 0x3c, 0x80, 0x25, 0x3f, 0x09, 0x1b, 0x63, 0x15,
+	0xc5, 0xa8, 0x64, 0x61, 0x1c, 0x88, 0x62, 0xbc, 0x05, 0xc3, 0x26, 0x67, 0x4d, 0x77, 0x7e, 0x50,
+	0x94, 0xb5, 0x35, 0x69, 0x06, 0xc6, 0xcc, 0xde, 0xe1, 0xac, 0x89, 0x11, 0xf6, 0x95, 0x49, 0x05,
+	0x86, 0xb9, 0xcd, 0x69, 0xa3, 0x2f, 0x23, 0x9d, 0xbf, 0x54, 0xac, 0x34, 0x0e, 0x9f, 0xbd, 0x34,
+	0xae, 0xc3, 0x57, 0x44, 0xf4, 0x2a, 0x8c, 0x1a, 0xdf, 0xf3, 0x59, 0xb3, 0x60, 0xbf, 0x27, 0x60,
+	0xc0, 0xf4, 0x39, 0x8f, 0xa1, 0xca, 0x80, 0x69, 0xa8, 0x06, 0xcc, 0x27, 0x45, 0x31, 0xc6, 0x6f,
+	0xc1, 0x58, 0x98, 0x78, 0xc3, 0x64, 0x5c, 0x92, 0x05, 0x2c, 0xa4, 0x8e, 0x71, 0x1a, 0x75, 0x4e,
+	0x1e, 0x79, 0x1d, 0x70, 0x39, 0x6e, 0xc6, 0x2d, 0x77, 0xde, 0xb2, 0x1b, 0xc6, 0x09, 0xb4, 0x39,
+	0x18, 0x39, 0x10, 0x0f, 0x30, 0x19, 0xf1, 0x53, 0xdf, 0x3a, 0xe0, 0xa7, 0xc1, 0x1d, 0x5b, 0x0e,
+	0x02, 0x9d, 0xfe, 0x36, 0x8c, 0x87, 0x9d, 0x0e, 0xba, 0x5f, 0x4e, 0xaf, 0xc7, 0x42, 0x5e, 0xf7,
+	0xb1, 0xd7, 0x7d, 0xa2, 0xe0, 0x79, 0x78, 0x1b, 0x99, 0xdc, 0x7b, 0x8e, 0xdd, 0xb2, 0xdd, 0xd0,
+	0x00, 0xb1, 0x2b, 0xbf, 0x1b, 0x17, 0xca, 0xf3, 0x5f, 0x3c, 0xda, 0x9c, 0x45, 0x93, 0xbb, 0x86,
+	0xe1, 0x30, 0xd7, 0xdd, 0xe3, 0x8e, 0x69, 0xd5, 0xe3, 0x77, 0xe1, 0xbe, 0xc5, 0xf9, 0x0f, 0xc1,
+	0xb8, 0x23, 0x01, 0xdb, 0xcd, 0xac, 0x42, 0x2b, 0x78, 0x98, 0x35, 0x6c, 0xc6, 0x57, 0x08, 0x8a,
+	0x70, 0x57, 0xb9, 0x6f, 0x21, 0xde, 0xfe, 0xcd, 0x02, 0x0c, 0x0b, 0xd4, 0xe4, 0x18, 0x46, 0x7c,
+	0x76, 0x94, 0xac, 0xc8, 0x30, 0x25, 0x39, 0xe2, 0xe2, 0x6a, 0x4f, 0x39, 0xdf, 0xa0, 0xaa, 0x7e,
+	0xf4, 0x8f, 0xff, 0xfc, 0x6c, 0x60, 0x81, 0x14, 0xb5, 0x54, 0x7e, 0x9d, 0x7c, 0xa2, 0xc0, 0x74,
+	0x82, 0xdc, 0x25, 0x5b, 0x3d, 0x4c, 0x24, 0x79, 0xe4, 0xe2, 0xf6, 0x69, 0x54, 0x10, 0x60, 0x49,
+	0x00, 0x5c, 0x23, 0x2b, 0xe9, 0x00, 0xb5, 0xa3, 0xee, 0x14, 0x71, 0x4c, 0x3e, 0x56, 0xe0, 0x42,
+	0xc0, 0xfd, 0x92, 0xb5, 0x54, 0x83, 0x31, 0x42, 0xb9, 0xb8, 0x9e, 0x43, 0x12, 0x11, 0x69, 0x02,
+	0xd1, 0x3a, 0x59, 0xd5, 0x32, 0xbe, 0xb5, 0x70, 0xb5, 0x23, 0xcc, 0xfa, 0x63, 0xf2, 0x6b, 0x05,
+	0xc6, 0xc2, 0xb7, 0x78, 0xa2, 0xa5, 0x1a, 0x93, 0x93, 0xcb, 0xc5, 0x6b, 0xf9, 0x15, 0x10, 0xe4,
+	0x8e, 0x00, 0xb9, 0x49, 0x36, 0xb4, 0x5e, 0xdf, 0x35, 0x84, 0x80, 0xfe, 0x42, 0x81, 0xf1, 0x08,
+	0xa5, 0x4b, 0x36, 0x53, 0x0d, 0xcb, 0x08, 0xe6, 0x62, 0x29, 0xaf, 0x38, 0xa2, 0xbc, 0x22, 0x50,
+	0x5e, 0x26, 0x6a, 0x4f, 0x94, 0x2e, 0xf9, 0xb3, 0x02, 0x33, 0x12, 0xe6, 0x90, 0xec, 0x64, 0x24,
+	0x55, 0x1a, 0xcf, 0x5b, 0x7c, 0xe9, 0x74, 0x4a, 0x08, 0xf7, 0x15, 0x01, 0x77, 0x87, 0x6c, 0x69,
+	0x79, 0xbf, 0x41, 0xd2, 0x8e, 0xc4, 0x95, 0xfd, 0x98, 0xfc, 0x49, 0x81, 0x59, 0x19, 0x93, 0x4a,
+	0x4e, 0x85, 0xa4, 0x1b, 0xe8, 0xeb, 0xa7, 0xd4, 0x42, 0x07, 0xb6, 0x85, 0x03, 0x57, 0xc9, 0x95,
+	0xdc, 0x0e, 0xb8, 0xe4, 0x57, 0x0a, 0x4c, 0x44, 0x17, 0x25, 0xa5, 0x9c, 0xd6, 0x03, 0xb4, 0x5a,
+	0x6e, 0xf9, 0x33, 0xe0, 0xd4, 0x8e, 0xbc, 0x11, 0xf1, 0x98, 0xfc, 0x52, 0x81, 0xc9, 0x18, 0x23,
+	0x42, 0xf2, 0x1a, 0x76, 0x7b, 0x1f, 0xb4, 0x14, 0x9e, 0x54, 0xbd, 0x2a, 0xa0, 0xae, 0x90, 0xcb,
+	0x39, 0xa0, 0xba, 0xe4, 0xb7, 0x0a, 0x4c, 0x44, 0xa9, 0xc7, 0x8c, 0x60, 0x4a, 0xc9, 0xcd, 0x8c,
+	0x60, 0xca, 0x39, 0x4d, 0xf5, 0xba, 0x40, 0xa8, 0x91, 0x4d, 0x19, 0xc2, 0xd8, 0x75, 0x32, 0x54,
+	0x0c, 0x1e, 0x2b, 0x30, 0x27, 0x67, 0x98, 0xc8, 0xcb, 0x79, 0xa3, 0x14, 0xa5, 0xb3, 0x8a, 0x37,
+	0x4e, 0xad, 0x87, 0x2e, 0xbc, 0x2e, 0x5c, 0xb8, 0x41, 0xae, 0xe7, 0x09, 0xb2, 0x5e, 0xed, 0xe8,
+	0xe2, 0xd4, 0x75, 0x0f, 0xdf, 0xef, 0x14, 0x98, 0x4e, 0x30, 0x4e, 0x19, 0x0d, 0x2c, 0x8d, 0x07,
+	0xcb, 0x68, 0x60, 0xa9, 0x84, 0x56, 0x76, 0xbb, 0x90, 0x50, 0x5d, 0xe4, 0x91, 0x02, 0xd3, 0x09,
+	0x16, 0x23, 0x03, 0x6d, 0x1a, 0x09, 0x95, 0x81, 0x36, 0x95, 0x7b, 0x52, 0x6f, 0x0a, 0xb4, 0xdb,
+	0xe4, 0x9a, 0x96, 0xeb, 0x9b, 0xfe, 0x50, 0xbe, 0x7c, 0xaa, 0x00, 0x49, 0x32, 0x39, 0xe4, 0x14,
+	0x20, 0xba, 0x61, 0xde, 0x39, 0x95, 0x4e, 0x9e, 0xe2, 0x2c, 0x21, 0x91, 0x42, 0xd0, 0xff, 0xe2,
+	0xb5, 0x96, 0x24, 0xed, 0x92, 0xd5, 0x5a, 0x52, 0x29, 0x9e, 0xac, 0xd6, 0x92, 0xce, 0xec, 0xa8,
+	0xaf, 0x09, 0xf4, 0xd7, 0xc9, 0x8e, 0x34, 0xc3, 0x03, 0x45, 0x3d, 0xc4, 0x9a, 0x84, 0xf0, 0xff,
+	0x54, 0x81, 0x42, 0x97, 0x16, 0x20, 0xeb, 0x99, 0x49, 0x1a, 0xe6, 0x28, 0x8a, 0x57, 0xf2, 0x88,
+	0xe6, 0x19, 0xc4, 0x42, 0xdc, 0x45, 0x50, 0x8f, 0xbd, 0xbe, 0x11, 0x65, 0x04, 0x32, 0x4a, 0x9d,
+	0x94, 0xb1, 0xc8, 0x28, 0x75, 0x72, 0xbe, 0x22, 0xbb, 0x6f, 0xc4, 0x98, 0x8c, 0x00, 0xe7, 0xef,
+	0x15, 0x98, 0x8a, 0xdf, 0xae, 0x49, 0x7a, 0x1f, 0x48, 0xa1, 0x2d, 0x8a, 0x5b, 0xa7, 0xd0, 0x40,
+	0xb4, 0x2f, 0x09, 0xb4, 0x25, 0x72, 0x55, 0x4b, 0xff, 0xdd, 0x8d, 0xee, 0x06, 0x6a, 0x01, 0xde,
+	0x9f, 0x2b, 0x30, 0x1a, 0xba, 0xe6, 0x91, 0x8d, 0x54, 0xc3, 0xc9, 0xcb, 0x76, 0xf1, 0x6a, 0x3e,
+	0x61, 0x04, 0xb8, 0x29, 0x00, 0xae, 0x92, 0x17, 0xb5, 0x1e, 0xbf, 0x80, 0xd1, 0x8e, 0x4c, 0xc3,
+	0x9f, 0x71, 0x64, 0x37, 0xd9, 0x8c, 0x19, 0x27, 0xe3, 0xf6, 0x9d, 0x31, 0xe3, 0x64, 0x5d, 0x97,
+	0xb3, 0x73, 0x20, 0x72, 0x91, 0xd6, 0x8e, 0xfc, 0xfb, 0xfc, 0x31, 0xf9, 0x4c, 0x81, 0xe9, 0xc4,
+	0xdd, 0x30, 0xa3, 0xe4, 0xa6, 0x5d, 0x7a, 0x33, 0x4a, 0x6e, 0xea, 0xd5, 0x53, 0xfd, 0xa6, 0x00,
+	0xfc, 0x2a, 0xb9, 0xa9, 0x65, 0xfc, 0x50, 0x4a, 0xef, 0x5e, 0x30, 0xb5, 0xa3, 0x58, 0xc3, 0x3b,
+	0x2e, 0xdf, 0x79, 0xfc, 0x74, 0x51, 0xf9, 0xfc, 0xe9, 0xa2, 0xf2, 0xef, 0xa7, 0x8b, 0xca, 0xc7,
+	0xcf, 0x16, 0xcf, 0x7d, 0xfe, 0x6c, 0xf1, 0xdc, 0x3f, 0x9f, 0x2d, 0x9e, 0xbb, 0xaf, 0x85, 0xd8,
+	0x9f, 0xaa, 0x55, 0xdd, 0xac, 0x1d, 0x50, 0xd3, 0x0a, 0xdb, 0xf9, 0x61, 0xd7, 0x92, 0xa0, 0x82,
+	0xaa, 0x23, 0xe2, 0x97, 0x47, 0x3b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x6a, 0xe1, 0x92,
+	0x35, 0x27, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByTimestamp queries the parameter of the module by timestamp.
+	ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
+	// Queries our flows by account.
+	OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error)
+	// Queries a stream record by account.
+	StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error)
+	// Queries all stream records.
+	StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error)
+	// Queries the count of payment account by owner.
+	PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error)
+	// Queries all counts of payment account for all owners.
+	PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error)
+	// Queries a payment account by payment account address.
+	PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error)
+	// Queries all payment accounts.
+	PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error)
+	// Queries dynamic balance of a payment account.
+	DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error)
+	// Queries all payment accounts by a owner.
+	PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error)
+	// Queries all auto settle records.
+	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
+	// Queries delayed withdrawal of a account.
+	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+	// Queries all the pending delayed withdrawals of a account.
+	DelayedWithdrawals(ctx context.Context, in *QueryDelayedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalsResponse, error)
+	// Queries the projected freeze time of a stream account with its balance alert.
+	ProjectedFreezeTime(ctx context.Context, in *QueryProjectedFreezeTimeRequest, opts ...grpc.CallOption) (*QueryProjectedFreezeTimeResponse, error)
+	// Queries the auto top-up config of a payment account.
+	AutoTopUp(ctx context.Context, in *QueryAutoTopUpRequest, opts ...grpc.CallOption) (*QueryAutoTopUpResponse, error)
+	// Queries the spending budget of a payment account.
+	SpendingBudget(ctx context.Context, in *QuerySpendingBudgetRequest, opts ...grpc.CallOption) (*QuerySpendingBudgetResponse, error)
+	// Queries the billing statement of a payment account for a time range.
+	BillingStatement(ctx context.Context, in *QueryBillingStatementRequest, opts ...grpc.CallOption) (*QueryBillingStatementResponse, error)
+	// Queries a read voucher by its id.
+	ReadVoucher(ctx context.Context, in *QueryReadVoucherRequest, opts ...grpc.CallOption) (*QueryReadVoucherResponse, error)
+	// Queries the outstanding read vouchers of a holder.
+	ReadVouchersByHolder(ctx context.Context, in *QueryReadVouchersByHolderRequest, opts ...grpc.CallOption) (*QueryReadVouchersByHolderResponse, error)
+	// Queries the proposals of a multisig payment account waiting for approvals.
+	MultisigProposals(ctx context.Context, in *QueryMultisigProposalsRequest, opts ...grpc.CallOption) (*QueryMultisigProposalsResponse, error)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
+	out := new(QueryParamsByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ParamsByTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error) {
+	out := new(QueryOutFlowsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/OutFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error) {
+	out := new(QueryGetStreamRecordResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecord", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error) {
+	out := new(QueryStreamRecordsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error) {
+	out := new(QueryPaymentAccountCountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error) {
+	out := new(QueryPaymentAccountCountsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error) {
+	out := new(QueryPaymentAccountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryOutFlowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error) {
+	out := new(QueryPaymentAccountsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOutFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOutFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error) {
+	out := new(QueryDynamicBalanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DynamicBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOutFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOutFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OutFlows) > 0 {
-		for iNdEx := len(m.OutFlows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetStreamRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error) {
+	out := new(QueryPaymentAccountsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountsByOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetStreamRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetStreamRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error) {
+	out := new(QueryAutoSettleRecordsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoSettleRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetStreamRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error) {
+	out := new(QueryDelayedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DelayedWithdrawal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetStreamRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetStreamRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) DelayedWithdrawals(ctx context.Context, in *QueryDelayedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalsResponse, error) {
+	out := new(QueryDelayedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DelayedWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStreamRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProjectedFreezeTime(ctx context.Context, in *QueryProjectedFreezeTimeRequest, opts ...grpc.CallOption) (*QueryProjectedFreezeTimeResponse, error) {
+	out := new(QueryProjectedFreezeTimeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ProjectedFreezeTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStreamRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryStreamRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AutoTopUp(ctx context.Context, in *QueryAutoTopUpRequest, opts ...grpc.CallOption) (*QueryAutoTopUpResponse, error) {
+	out := new(QueryAutoTopUpResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStreamRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SpendingBudget(ctx context.Context, in *QuerySpendingBudgetRequest, opts ...grpc.CallOption) (*QuerySpendingBudgetResponse, error) {
+	out := new(QuerySpendingBudgetResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/SpendingBudget", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStreamRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryStreamRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StreamRecords) > 0 {
-		for iNdEx := len(m.StreamRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) BillingStatement(ctx context.Context, in *QueryBillingStatementRequest, opts ...grpc.CallOption) (*QueryBillingStatementResponse, error) {
+	out := new(QueryBillingStatementResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/BillingStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ReadVoucher(ctx context.Context, in *QueryReadVoucherRequest, opts ...grpc.CallOption) (*QueryReadVoucherResponse, error) {
+	out := new(QueryReadVoucherResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ReadVoucher", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ReadVouchersByHolder(ctx context.Context, in *QueryReadVouchersByHolderRequest, opts ...grpc.CallOption) (*QueryReadVouchersByHolderResponse, error) {
+	out := new(QueryReadVouchersByHolderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ReadVouchersByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MultisigProposals(ctx context.Context, in *QueryMultisigProposalsRequest, opts ...grpc.CallOption) (*QueryMultisigProposalsResponse, error) {
+	out := new(QueryMultisigProposalsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/MultisigProposals", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentAccountCount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByTimestamp queries the parameter of the module by timestamp.
+	ParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	// Queries our flows by account.
+	OutFlows(context.Context, *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error)
+	// Queries a stream record by account.
+	StreamRecord(context.Context, *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error)
+	// Queries all stream records.
+	StreamRecords(context.Context, *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error)
+	// Queries the count of payment account by owner.
+	PaymentAccountCount(context.Context, *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error)
+	// Queries all counts of payment account for all owners.
+	PaymentAccountCounts(context.Context, *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error)
+	// Queries a payment account by payment account address.
+	PaymentAccount(context.Context, *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error)
+	// Queries all payment accounts.
+	PaymentAccounts(context.Context, *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error)
+	// Queries dynamic balance of a payment account.
+	DynamicBalance(context.Context, *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error)
+	// Queries all payment accounts by a owner.
+	PaymentAccountsByOwner(context.Context, *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error)
+	// Queries all auto settle records.
+	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
+	// Queries delayed withdrawal of a account.
+	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+	// Queries all the pending delayed withdrawals of a account.
+	DelayedWithdrawals(context.Context, *QueryDelayedWithdrawalsRequest) (*QueryDelayedWithdrawalsResponse, error)
+	// Queries the projected freeze time of a stream account with its balance alert.
+	ProjectedFreezeTime(context.Context, *QueryProjectedFreezeTimeRequest) (*QueryProjectedFreezeTimeResponse, error)
+	// Queries the auto top-up config of a payment account.
+	AutoTopUp(context.Context, *QueryAutoTopUpRequest) (*QueryAutoTopUpResponse, error)
+	// Queries the spending budget of a payment account.
+	SpendingBudget(context.Context, *QuerySpendingBudgetRequest) (*QuerySpendingBudgetResponse, error)
+	// Queries the billing statement of a payment account for a time range.
+	BillingStatement(context.Context, *QueryBillingStatementRequest) (*QueryBillingStatementResponse, error)
+	// Queries a read voucher by its id.
+	ReadVoucher(context.Context, *QueryReadVoucherRequest) (*QueryReadVoucherResponse, error)
+	// Queries the outstanding read vouchers of a holder.
+	ReadVouchersByHolder(context.Context, *QueryReadVouchersByHolderRequest) (*QueryReadVouchersByHolderResponse, error)
+	// Queries the proposals of a multisig payment account waiting for approvals.
+	MultisigProposals(context.Context, *QueryMultisigProposalsRequest) (*QueryMultisigProposalsResponse, error)
 }
 
-func (m *QueryPaymentAccountCountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPaymentAccountCountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryPaymentAccountCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByTimestamp not implemented")
 }
-
-func (m *QueryPaymentAccountCountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OutFlows(ctx context.Context, req *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutFlows not implemented")
+}
+func (*UnimplementedQueryServer) StreamRecord(ctx context.Context, req *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecord not implemented")
+}
+func (*UnimplementedQueryServer) StreamRecords(ctx context.Context, req *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountCount(ctx context.Context, req *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCount not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountCounts(ctx context.Context, req *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCounts not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccount(ctx context.Context, req *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccount not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccounts(ctx context.Context, req *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccounts not implemented")
+}
+func (*UnimplementedQueryServer) DynamicBalance(ctx context.Context, req *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicBalance not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountsByOwner(ctx context.Context, req *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleRecords not implemented")
+}
+func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
+}
+func (*UnimplementedQueryServer) DelayedWithdrawals(ctx context.Context, req *QueryDelayedWithdrawalsRequest) (*QueryDelayedWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedFreezeTime(ctx context.Context, req *QueryProjectedFreezeTimeRequest) (*QueryProjectedFreezeTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedFreezeTime not implemented")
+}
+func (*UnimplementedQueryServer) AutoTopUp(ctx context.Context, req *QueryAutoTopUpRequest) (*QueryAutoTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoTopUp not implemented")
+}
+func (*UnimplementedQueryServer) SpendingBudget(ctx context.Context, req *QuerySpendingBudgetRequest) (*QuerySpendingBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendingBudget not implemented")
+}
+func (*UnimplementedQueryServer) BillingStatement(ctx context.Context, req *QueryBillingStatementRequest) (*QueryBillingStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillingStatement not implemented")
+}
+func (*UnimplementedQueryServer) ReadVoucher(ctx context.Context, req *QueryReadVoucherRequest) (*QueryReadVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadVoucher not implemented")
+}
+func (*UnimplementedQueryServer) ReadVouchersByHolder(ctx context.Context, req *QueryReadVouchersByHolderRequest) (*QueryReadVouchersByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadVouchersByHolder not implemented")
+}
+func (*UnimplementedQueryServer) MultisigProposals(ctx context.Context, req *QueryMultisigProposalsRequest) (*QueryMultisigProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigProposals not implemented")
 }
 
-func (m *QueryPaymentAccountCountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPaymentAccountCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PaymentAccountCounts) > 0 {
-		for iNdEx := len(m.PaymentAccountCounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentAccountCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByTimestampRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPaymentAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByTimestamp(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/ParamsByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutFlowsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/OutFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutFlows(ctx, req.(*QueryOutFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StreamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStreamRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/StreamRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecord(ctx, req.(*QueryGetStreamRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentAccount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_StreamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/StreamRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecords(ctx, req.(*QueryStreamRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccountCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountCountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccountCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountCount(ctx, req.(*QueryPaymentAccountCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PaymentAccountCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccountCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountCounts(ctx, req.(*QueryPaymentAccountCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_PaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccount(ctx, req.(*QueryPaymentAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccounts(ctx, req.(*QueryPaymentAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DynamicBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/DynamicBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicBalance(ctx, req.(*QueryDynamicBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_PaymentAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PaymentAccounts) > 0 {
-		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountsByOwner(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccountsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountsByOwner(ctx, req.(*QueryPaymentAccountsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AutoSettleRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoSettleRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AutoSettleRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/AutoSettleRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoSettleRecords(ctx, req.(*QueryAutoSettleRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/DelayedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedWithdrawal(ctx, req.(*QueryDelayedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func _Query_DelayedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/DelayedWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedWithdrawals(ctx, req.(*QueryDelayedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProjectedFreezeTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedFreezeTimeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedFreezeTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/ProjectedFreezeTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedFreezeTime(ctx, req.(*QueryProjectedFreezeTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AutoTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoTopUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/AutoTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoTopUp(ctx, req.(*QueryAutoTopUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ChangeRate.Size()
-		i -= size
-		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_SpendingBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendingBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LockedFee.Size()
-		i -= size
-		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).SpendingBudget(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.AvailableBalance.Size()
-		i -= size
-		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/SpendingBudget",
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BankBalance.Size()
-		i -= size
-		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendingBudget(ctx, req.(*QuerySpendingBudgetRequest))
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.CurrentTimestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTimestamp))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BillingStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBillingStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).BillingStatement(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DynamicBalance.Size()
-		i -= size
-		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/BillingStatement",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BillingStatement(ctx, req.(*QueryBillingStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+func _Query_ReadVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReadVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReadVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/ReadVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReadVoucher(ctx, req.(*QueryReadVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReadVouchersByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReadVouchersByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReadVouchersByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/ReadVouchersByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReadVouchersByHolder(ctx, req.(*QueryReadVouchersByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultisigProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultisigProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultisigProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/MultisigProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultisigProposals(ctx, req.(*QueryMultisigProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.payment.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByTimestamp",
+			Handler:    _Query_ParamsByTimestamp_Handler,
+		},
+		{
+			MethodName: "OutFlows",
+			Handler:    _Query_OutFlows_Handler,
+		},
+		{
+			MethodName: "StreamRecord",
+			Handler:    _Query_StreamRecord_Handler,
+		},
+		{
+			MethodName: "StreamRecords",
+			Handler:    _Query_StreamRecords_Handler,
+		},
+		{
+			MethodName: "PaymentAccountCount",
+			Handler:    _Query_PaymentAccountCount_Handler,
+		},
+		{
+			MethodName: "PaymentAccountCounts",
+			Handler:    _Query_PaymentAccountCounts_Handler,
+		},
+		{
+			MethodName: "PaymentAccount",
+			Handler:    _Query_PaymentAccount_Handler,
+		},
+		{
+			MethodName: "PaymentAccounts",
+			Handler:    _Query_PaymentAccounts_Handler,
+		},
+		{
+			MethodName: "DynamicBalance",
+			Handler:    _Query_DynamicBalance_Handler,
+		},
+		{
+			MethodName: "PaymentAccountsByOwner",
+			Handler:    _Query_PaymentAccountsByOwner_Handler,
+		},
+		{
+			MethodName: "AutoSettleRecords",
+			Handler:    _Query_AutoSettleRecords_Handler,
+		},
+		{
+			MethodName: "DelayedWithdrawal",
+			Handler:    _Query_DelayedWithdrawal_Handler,
+		},
+		{
+			MethodName: "DelayedWithdrawals",
+			Handler:    _Query_DelayedWithdrawals_Handler,
+		},
+		{
+			MethodName: "ProjectedFreezeTime",
+			Handler:    _Query_ProjectedFreezeTime_Handler,
+		},
+		{
+			MethodName: "AutoTopUp",
+			Handler:    _Query_AutoTopUp_Handler,
+		},
+		{
+			MethodName: "SpendingBudget",
+			Handler:    _Query_SpendingBudget_Handler,
+		},
+		{
+			MethodName: "BillingStatement",
+			Handler:    _Query_BillingStatement_Handler,
+		},
+		{
+			MethodName: "ReadVoucher",
+			Handler:    _Query_ReadVoucher_Handler,
+		},
+		{
+			MethodName: "ReadVouchersByHolder",
+			Handler:    _Query_ReadVouchersByHolder_Handler,
+		},
+		{
+			MethodName: "MultisigProposals",
+			Handler:    _Query_MultisigProposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/payment/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2642,27 +3111,20 @@ func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2672,29 +3134,30 @@ func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PaymentAccounts[iNdEx])
-			copy(dAtA[i:], m.PaymentAccounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccounts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2704,32 +3167,25 @@ func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2739,32 +3195,83 @@ func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.AutoSettleRecords) > 0 {
-		for iNdEx := len(m.AutoSettleRecords) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutFlows) > 0 {
+		for iNdEx := len(m.OutFlows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AutoSettleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2778,7 +3285,7 @@ func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetStreamRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2788,12 +3295,12 @@ func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelayedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2808,7 +3315,7 @@ func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetStreamRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2818,18 +3325,18 @@ func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.DelayedWithdrawal.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2841,384 +3348,3602 @@ func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStreamRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStreamRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOutFlowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStreamRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutFlowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStreamRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutFlows) > 0 {
-		for _, e := range m.OutFlows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.StreamRecords) > 0 {
+		for iNdEx := len(m.StreamRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStreamRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetStreamRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StreamRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStreamRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStreamRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.StreamRecords) > 0 {
-		for _, e := range m.StreamRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PaymentAccountCount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PaymentAccountCount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountCountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountCountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccountCounts) > 0 {
-		for _, e := range m.PaymentAccountCounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PaymentAccountCounts) > 0 {
+		for iNdEx := len(m.PaymentAccountCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentAccountCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PaymentAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for _, e := range m.PaymentAccounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDynamicBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PaymentAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDynamicBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DynamicBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.StreamRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.CurrentTimestamp != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTimestamp))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.BankBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AvailableBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LockedFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ChangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PaymentAccounts) > 0 {
+		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for _, s := range m.PaymentAccounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChangeRate.Size()
+		i -= size
+		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LockedFee.Size()
+		i -= size
+		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AvailableBalance.Size()
+		i -= size
+		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BankBalance.Size()
+		i -= size
+		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DynamicBalance.Size()
+		i -= size
+		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PaymentAccounts[iNdEx])
+			copy(dAtA[i:], m.PaymentAccounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AutoSettleRecords) > 0 {
+		for iNdEx := len(m.AutoSettleRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoSettleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelayedWithdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelayedWithdrawals) > 0 {
+		for iNdEx := len(m.DelayedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedFreezeTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedFreezeTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedFreezeTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedFreezeTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedFreezeTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedFreezeTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BalanceAlert != nil {
+		{
+			size, err := m.BalanceAlert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Runway != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Runway))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FreezeTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FreezeTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.NetflowRate.Size()
+		i -= size
+		if _, err := m.NetflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DynamicBalance.Size()
+		i -= size
+		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoTopUpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoTopUpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoTopUpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AutoTopUp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendingBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendingBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendingBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendingBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendingBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendingBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpendingBudget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingStatementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingStatementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingStatementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingStatementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingStatementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingStatementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReadVoucherRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadVoucherRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadVoucherRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReadVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReadVoucher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReadVouchersByHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadVouchersByHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadVouchersByHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReadVouchersByHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadVouchersByHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadVouchersByHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReadVouchers) > 0 {
+		for iNdEx := len(m.ReadVouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReadVouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultisigProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultisigProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultisigProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultisigProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultisigProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultisigProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryParamsByTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutFlows) > 0 {
+		for _, e := range m.OutFlows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetStreamRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStreamRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StreamRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamRecords) > 0 {
+		for _, e := range m.StreamRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAccountCount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccountCounts) > 0 {
+		for _, e := range m.PaymentAccountCounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for _, e := range m.PaymentAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDynamicBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDynamicBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StreamRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTimestamp))
+	}
+	l = m.BankBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AvailableBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for _, s := range m.PaymentAccounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoSettleRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoSettleRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoSettleRecords) > 0 {
+		for _, e := range m.AutoSettleRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelayedWithdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelayedWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelayedWithdrawals) > 0 {
+		for _, e := range m.DelayedWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedFreezeTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedFreezeTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetflowRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FreezeTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.FreezeTimestamp))
+	}
+	if m.Runway != 0 {
+		n += 1 + sovQuery(uint64(m.Runway))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.BalanceAlert != nil {
+		l = m.BalanceAlert.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoTopUpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AutoTopUp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpendingBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendingBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendingBudget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBillingStatementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBillingStatementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReadVoucherRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryReadVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReadVoucher.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReadVouchersByHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReadVouchersByHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReadVouchers) > 0 {
+		for _, e := range m.ReadVouchers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultisigProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultisigProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutFlows = append(m.OutFlows, OutFlow{})
+			if err := m.OutFlows[len(m.OutFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStreamRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStreamRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStreamRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStreamRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamRecords = append(m.StreamRecords, StreamRecord{})
+			if err := m.StreamRecords[len(m.StreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentAccountCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccountCounts = append(m.PaymentAccountCounts, PaymentAccountCount{})
+			if err := m.PaymentAccountCounts[len(m.PaymentAccountCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryAutoSettleRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAutoSettleRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AutoSettleRecords) > 0 {
-		for _, e := range m.AutoSettleRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccounts = append(m.PaymentAccounts, PaymentAccount{})
+			if err := m.PaymentAccounts[len(m.PaymentAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDelayedWithdrawalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.DelayedWithdrawal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,12 +6966,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDynamicBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDynamicBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3268,7 +7025,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,15 +7048,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDynamicBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDynamicBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3326,65 +7117,136 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTimestamp", wireType)
+			}
+			m.CurrentTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3394,11 +7256,26 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3420,7 +7297,7 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3443,17 +7320,17 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3463,24 +7340,23 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3503,7 +7379,7 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3526,15 +7402,15 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutFlowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3562,7 +7438,7 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccounts = append(m.PaymentAccounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3585,7 +7461,7 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,15 +7484,15 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutFlowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutFlows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3643,8 +7519,10 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutFlows = append(m.OutFlows, OutFlow{})
-			if err := m.OutFlows[len(m.OutFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3669,7 +7547,7 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3692,17 +7570,17 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStreamRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStreamRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3712,23 +7590,61 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AutoSettleRecords = append(m.AutoSettleRecords, AutoSettleRecord{})
+			if err := m.AutoSettleRecords[len(m.AutoSettleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3751,7 +7667,7 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3774,17 +7690,17 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStreamRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStreamRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3794,24 +7710,23 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3834,7 +7749,7 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3857,15 +7772,15 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3892,10 +7807,7 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelayedWithdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3920,7 +7832,7 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3943,17 +7855,17 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3963,25 +7875,23 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamRecords = append(m.StreamRecords, StreamRecord{})
-			if err := m.StreamRecords[len(m.StreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4013,7 +7923,7 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4040,7 +7950,7 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4063,17 +7973,17 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4083,77 +7993,29 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.DelayedWithdrawals = append(m.DelayedWithdrawals, DelayedWithdrawalRecord{})
+			if err := m.DelayedWithdrawals[len(m.DelayedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4180,7 +8042,10 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentAccountCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4205,7 +8070,7 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedFreezeTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4228,17 +8093,17 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedFreezeTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedFreezeTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4248,27 +8113,23 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4291,7 +8152,7 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedFreezeTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4314,17 +8175,51 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedFreezeTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedFreezeTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4334,29 +8229,86 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccountCounts = append(m.PaymentAccountCounts, PaymentAccountCount{})
-			if err := m.PaymentAccountCounts[len(m.PaymentAccountCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeTimestamp", wireType)
+			}
+			m.FreezeTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreezeTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runway", wireType)
+			}
+			m.Runway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runway |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StreamAccountStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAlert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4383,10 +8335,10 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.BalanceAlert == nil {
+				m.BalanceAlert = &BalanceAlert{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BalanceAlert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4411,7 +8363,7 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoTopUpRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4434,10 +8386,10 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoTopUpRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoTopUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4493,7 +8445,7 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4516,15 +8468,15 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoTopUp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4551,7 +8503,7 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoTopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4576,7 +8528,7 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendingBudgetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4599,17 +8551,17 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendingBudgetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendingBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4619,27 +8571,23 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4662,7 +8610,7 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendingBudgetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4685,49 +8633,15 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendingBudgetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendingBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentAccounts = append(m.PaymentAccounts, PaymentAccount{})
-			if err := m.PaymentAccounts[len(m.PaymentAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingBudget", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4754,10 +8668,7 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpendingBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4782,7 +8693,7 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingStatementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4805,15 +8716,15 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDynamicBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBillingStatementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDynamicBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBillingStatementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4841,63 +8752,13 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDynamicBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDynamicBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4907,29 +8768,33 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4953,18 +8818,71 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBillingStatementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBillingStatementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBillingStatementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.CurrentTimestamp = 0
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4974,16 +8892,16 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTimestamp |= int64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4993,31 +8911,16 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5027,29 +8930,29 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, BillingStatementItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5077,15 +8980,15 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5095,23 +8998,25 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5136,7 +9041,7 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReadVoucherRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5159,17 +9064,17 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReadVoucherRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReadVoucherRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5179,24 +9084,11 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5218,7 +9110,7 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReadVoucherResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5241,17 +9133,17 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReadVoucherResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReadVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadVoucher", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5261,23 +9153,24 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccounts = append(m.PaymentAccounts, string(dAtA[iNdEx:postIndex]))
+			if err := m.ReadVoucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5300,7 +9193,7 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReadVouchersByHolderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,13 +9216,45 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReadVouchersByHolderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReadVouchersByHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5386,7 +9311,7 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReadVouchersByHolderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5409,15 +9334,15 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReadVouchersByHolderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReadVouchersByHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadVouchers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5444,8 +9369,8 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoSettleRecords = append(m.AutoSettleRecords, AutoSettleRecord{})
-			if err := m.AutoSettleRecords[len(m.AutoSettleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReadVouchers = append(m.ReadVouchers, ReadVoucher{})
+			if err := m.ReadVouchers[len(m.ReadVouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5506,7 +9431,7 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMultisigProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5529,15 +9454,15 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultisigProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultisigProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5565,7 +9490,43 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5588,7 +9549,7 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMultisigProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5611,15 +9572,15 @@ func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultisigProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultisigProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5646,7 +9607,44 @@ func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelayedWithdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, MultisigProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// PaidFee returns the part of the prepaid fee paid for the used quota
+func (v *ReadVoucher) PaidFee(usedQuota uint64) sdkmath.Int {
+	return paidPart(v.Fee, usedQuota, v.ReadQuota)
+}
+
+// PaidTaxFee returns the part of the prepaid validator tax paid for the used quota
+func (v *ReadVoucher) PaidTaxFee(usedQuota uint64) sdkmath.Int {
+	return paidPart(v.TaxFee, usedQuota, v.ReadQuota)
+}
+
+// Unredeemed returns the part of the prepaid fee and validator tax not paid yet
+func (v *ReadVoucher) Unredeemed() sdkmath.Int {
+	unredeemed := sdkmath.ZeroInt()
+	if !v.Fee.IsNil() {
+		unredeemed = unredeemed.Add(v.Fee.Sub(v.PaidFee(v.UsedQuota)))
+	}
+	if !v.TaxFee.IsNil() {
+		unredeemed = unredeemed.Add(v.TaxFee.Sub(v.PaidTaxFee(v.UsedQuota)))
+	}
+	return unredeemed
+}
+
+func paidPart(prepaid sdkmath.Int, usedQuota, readQuota uint64) sdkmath.Int {
+	if prepaid.IsNil() || readQuota == 0 {
+		return sdkmath.ZeroInt()
+	}
+	return prepaid.Mul(sdkmath.NewIntFromUint64(usedQuota)).Quo(sdkmath.NewIntFromUint64(readQuota))
+}
@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/read_voucher.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReadVoucher is a prepaid read quota of a bucket held by an account, the downloads of the holder
+// are debited from the voucher instead of the charged read quota of the bucket.
+type ReadVoucher struct {
+	// id is the unique id of the voucher
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// issuer is the address of the bucket owner who minted the voucher
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// bucket_id is the id of the bucket to read from
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// holder is the address of the account the voucher belongs to
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// read_quota is the prepaid read quota in bytes
+	ReadQuota uint64 `protobuf:"varint,5,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+	// used_quota is the read quota in bytes already redeemed
+	UsedQuota uint64 `protobuf:"varint,6,opt,name=used_quota,json=usedQuota,proto3" json:"used_quota,omitempty"`
+	// expire_time is the unix timestamp after which the voucher can not be redeemed
+	ExpireTime int64 `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// nonce is the nonce of the last redemption signed by the holder
+	Nonce uint64 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// payment_address is the address of the payment account which prepaid the voucher,
+	// the unredeemed part of the prepaid fee is refunded to it once the voucher expires
+	PaymentAddress string `protobuf:"bytes,9,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// fee is the prepaid fee to the primary sp, paid in proportion to the redeemed read quota
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+	// tax_fee is the prepaid validator tax, paid in proportion to the redeemed read quota
+	TaxFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=tax_fee,json=taxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tax_fee"`
+}
+
+func (m *ReadVoucher) Reset()         { *m = ReadVoucher{} }
+func (m *ReadVoucher) String() string { return proto.CompactTextString(m) }
+func (*ReadVoucher) ProtoMessage()    {}
+func (*ReadVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cf9b86065b71fc6, []int{0}
+}
+func (m *ReadVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadVoucher.Merge(m, src)
+}
+func (m *ReadVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadVoucher proto.InternalMessageInfo
+
+func (m *ReadVoucher) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReadVoucher) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *ReadVoucher) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *ReadVoucher) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
+	}
+	return 0
+}
+
+func (m *ReadVoucher) GetUsedQuota() uint64 {
+	if m != nil {
+		return m.UsedQuota
+	}
+	return 0
+}
+
+func (m *ReadVoucher) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *ReadVoucher) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ReadVoucher) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ReadVoucher)(nil), "greenfield.payment.ReadVoucher")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/read_voucher.proto", fileDescriptor_9cf9b86065b71fc6)
+}
+
+var fileDescriptor_9cf9b86065b71fc6 = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x34, 0x6d, 0x2e, 0x52, 0x91, 0x4e, 0x19, 0x8e, 0x4a, 0x38, 0x11, 0x12, 0x90,
+	0x25, 0x31, 0x12, 0x2b, 0x42, 0x6a, 0x07, 0xa4, 0x2c, 0x48, 0x18, 0xca, 0x00, 0x83, 0x75, 0xf6,
+	0xbd, 0xda, 0xa7, 0xd6, 0x77, 0xe6, 0xee, 0x8c, 0xdc, 0xff, 0x82, 0x3f, 0xa6, 0x2b, 0x7b, 0xc7,
+	0xaa, 0x13, 0x62, 0xa8, 0x50, 0xf2, 0x8f, 0xa0, 0xfb, 0x01, 0x74, 0x0b, 0x03, 0x93, 0xfd, 0xbe,
+	0xf7, 0xbd, 0xef, 0x7d, 0xfe, 0xfc, 0xd0, 0x93, 0x52, 0x01, 0x88, 0x33, 0x0e, 0x17, 0x2c, 0x69,
+	0xe8, 0x65, 0x0d, 0xc2, 0x24, 0x0a, 0x28, 0xcb, 0xbe, 0xc8, 0xb6, 0xa8, 0x40, 0xad, 0x1a, 0x25,
+	0x8d, 0xc4, 0xf8, 0x2f, 0x6d, 0x15, 0x68, 0x47, 0x0f, 0x0b, 0xa9, 0x6b, 0xa9, 0x33, 0xc7, 0x48,
+	0x7c, 0xe1, 0xe9, 0x47, 0xd3, 0x52, 0x96, 0xd2, 0xe3, 0xf6, 0xcd, 0xa3, 0x8f, 0xbf, 0x0d, 0xd1,
+	0x24, 0x05, 0xca, 0x3e, 0x78, 0x69, 0x7c, 0x88, 0xfa, 0x9c, 0x91, 0x68, 0x1e, 0x2d, 0x86, 0x69,
+	0x9f, 0x33, 0xfc, 0x1c, 0x8d, 0xb8, 0xd6, 0x2d, 0x28, 0xd2, 0x9f, 0x47, 0x8b, 0xf1, 0x09, 0xb9,
+	0xbd, 0x5a, 0x4e, 0x83, 0xee, 0x31, 0x63, 0x0a, 0xb4, 0x7e, 0x67, 0x14, 0x17, 0x65, 0x1a, 0x78,
+	0xf8, 0x13, 0x1a, 0xe7, 0x6d, 0x71, 0x0e, 0x26, 0xe3, 0x8c, 0x0c, 0xdc, 0xd0, 0xab, 0xeb, 0xbb,
+	0x59, 0xef, 0xc7, 0xdd, 0xec, 0x59, 0xc9, 0x4d, 0xd5, 0xe6, 0xab, 0x42, 0xd6, 0xc1, 0x5b, 0x78,
+	0x2c, 0x35, 0x3b, 0x4f, 0xcc, 0x65, 0x03, 0x7a, 0x75, 0xca, 0x85, 0xb9, 0xbd, 0x5a, 0x4e, 0xc2,
+	0x0e, 0x5b, 0xa6, 0x07, 0x5e, 0x70, 0xed, 0xec, 0x54, 0xf2, 0x82, 0x81, 0x22, 0xc3, 0x5d, 0x76,
+	0x3c, 0x0f, 0x3f, 0x42, 0xc8, 0x65, 0xf7, 0xb9, 0x95, 0x86, 0x92, 0x3d, 0xf7, 0x61, 0x63, 0x8b,
+	0xbc, 0xb5, 0x80, 0x6d, 0xb7, 0x1a, 0x7e, 0xb7, 0x47, 0xbe, 0x6d, 0x11, 0xdf, 0x9e, 0xa1, 0x09,
+	0x74, 0x0d, 0x57, 0x90, 0x19, 0x5e, 0x03, 0xd9, 0x9f, 0x47, 0x8b, 0x41, 0x8a, 0x3c, 0xf4, 0x9e,
+	0xd7, 0x80, 0xa7, 0x68, 0x4f, 0x48, 0x51, 0x00, 0x39, 0x70, 0xa3, 0xbe, 0xc0, 0xc7, 0xe8, 0x41,
+	0xf8, 0x23, 0x19, 0xf5, 0xae, 0xc8, 0x78, 0x87, 0xdf, 0xc3, 0x30, 0x10, 0x50, 0xfc, 0x06, 0x0d,
+	0xce, 0x00, 0x08, 0x72, 0x63, 0x2f, 0x43, 0x80, 0x4f, 0xff, 0x21, 0xc0, 0xb5, 0xcb, 0x0f, 0x85,
+	0x25, 0x6b, 0x61, 0x52, 0x2b, 0x84, 0x4f, 0xd1, 0xbe, 0xa1, 0x5d, 0x66, 0x35, 0x27, 0xff, 0x41,
+	0x73, 0x64, 0x68, 0xf7, 0x1a, 0xe0, 0x64, 0x7d, 0xbd, 0x89, 0xa3, 0x9b, 0x4d, 0x1c, 0xfd, 0xdc,
+	0xc4, 0xd1, 0xd7, 0x6d, 0xdc, 0xbb, 0xd9, 0xc6, 0xbd, 0xef, 0xdb, 0xb8, 0xf7, 0x31, 0xb9, 0xa7,
+	0x9b, 0x8b, 0x7c, 0x59, 0x54, 0x94, 0x8b, 0xe4, 0xde, 0x69, 0x77, 0x7f, 0x8e, 0xdb, 0x2d, 0xc9,
+	0x47, 0xee, 0x22, 0x5f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x64, 0x93, 0xe7, 0xc8, 0xff, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ReadVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxFee.Size()
+		i -= size
+		if _, err := m.TaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReadVoucher(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReadVoucher(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintReadVoucher(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintReadVoucher(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpireTime != 0 {
+		i = encodeVarintReadVoucher(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UsedQuota != 0 {
+		i = encodeVarintReadVoucher(dAtA, i, uint64(m.UsedQuota))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReadQuota != 0 {
+		i = encodeVarintReadVoucher(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintReadVoucher(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReadVoucher(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintReadVoucher(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReadVoucher(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReadVoucher(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReadVoucher(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReadVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReadVoucher(uint64(m.Id))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovReadVoucher(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovReadVoucher(uint64(l))
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovReadVoucher(uint64(l))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovReadVoucher(uint64(m.ReadQuota))
+	}
+	if m.UsedQuota != 0 {
+		n += 1 + sovReadVoucher(uint64(m.UsedQuota))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovReadVoucher(uint64(m.ExpireTime))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovReadVoucher(uint64(m.Nonce))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovReadVoucher(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovReadVoucher(uint64(l))
+	l = m.TaxFee.Size()
+	n += 1 + l + sovReadVoucher(uint64(l))
+	return n
+}
+
+func sovReadVoucher(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReadVoucher(x uint64) (n int) {
+	return sovReadVoucher(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReadVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReadVoucher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
+			}
+			m.ReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedQuota", wireType)
+			}
+			m.UsedQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReadVoucher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReadVoucher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReadVoucher(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReadVoucher
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReadVoucher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReadVoucher
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReadVoucher
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReadVoucher
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReadVoucher        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReadVoucher          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReadVoucher = fmt.Errorf("proto: unexpected end of group")
+)
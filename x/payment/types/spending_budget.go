@@ -0,0 +1,58 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// PeriodRange returns the start and the end of the calendar period including the timestamp, in UTC.
+func (p SpendingPeriod) PeriodRange(timestamp int64) (start int64, end int64) {
+	t := time.Unix(timestamp, 0).UTC()
+	var startTime, endTime time.Time
+	switch p {
+	case SPENDING_PERIOD_DAILY:
+		startTime = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		endTime = startTime.AddDate(0, 0, 1)
+	default:
+		startTime = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		endTime = startTime.AddDate(0, 1, 0)
+	}
+	return startTime.Unix(), endTime.Unix()
+}
+
+// Accrue adds the outflow at the rate since the last update to the spent of the current period, the spent is reset
+// once a new period starts.
+func (b *SpendingBudget) Accrue(rate sdkmath.Int, timestamp int64) {
+	periodStart, _ := b.Period.PeriodRange(timestamp)
+	if periodStart != b.PeriodStart {
+		b.PeriodStart = periodStart
+		b.Spent = sdkmath.ZeroInt()
+	}
+	from := b.LastUpdate
+	if from < b.PeriodStart {
+		from = b.PeriodStart
+	}
+	if timestamp > from && rate.IsPositive() {
+		b.Spent = b.Spent.Add(rate.MulRaw(timestamp - from))
+	}
+	b.LastUpdate = timestamp
+}
+
+// CheckOutflowRate checks the outflow rate against the budget, assuming the payment account keeps the rate till the
+// end of the current period. Accrue should be called before.
+func (b *SpendingBudget) CheckOutflowRate(rate sdkmath.Int) error {
+	if b.MaxOutflowRate.IsPositive() && rate.GT(b.MaxOutflowRate) {
+		return ErrSpendingBudgetExceeded.Wrapf("the outflow rate %s is greater than the max outflow rate %s",
+			rate, b.MaxOutflowRate)
+	}
+	if b.MaxSpendPerPeriod.IsPositive() {
+		_, periodEnd := b.Period.PeriodRange(b.LastUpdate)
+		projected := b.Spent.Add(rate.MulRaw(periodEnd - b.LastUpdate))
+		if projected.GT(b.MaxSpendPerPeriod) {
+			return ErrSpendingBudgetExceeded.Wrapf("the projected spend %s of the period is greater than the max spend %s",
+				projected, b.MaxSpendPerPeriod)
+		}
+	}
+	return nil
+}
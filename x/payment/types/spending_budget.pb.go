@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/spending_budget.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SpendingPeriod defines the calendar period of a spending budget, in UTC.
+type SpendingPeriod int32
+
+const (
+	// SPENDING_PERIOD_MONTHLY defines the calendar month.
+	SPENDING_PERIOD_MONTHLY SpendingPeriod = 0
+	// SPENDING_PERIOD_DAILY defines the calendar day.
+	SPENDING_PERIOD_DAILY SpendingPeriod = 1
+)
+
+var SpendingPeriod_name = map[int32]string{
+	0: "SPENDING_PERIOD_MONTHLY",
+	1: "SPENDING_PERIOD_DAILY",
+}
+
+var SpendingPeriod_value = map[string]int32{
+	"SPENDING_PERIOD_MONTHLY": 0,
+	"SPENDING_PERIOD_DAILY":   1,
+}
+
+func (x SpendingPeriod) String() string {
+	return proto.EnumName(SpendingPeriod_name, int32(x))
+}
+
+func (SpendingPeriod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4f5ac12db79a023e, []int{0}
+}
+
+// SpendingBudget is the spending budget of a payment account
+type SpendingBudget struct {
+	// addr is the address of the payment account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// max_outflow_rate is the max total outflow rate of the payment account, 0 means unlimited.
+	// The sum of the flow rate limits of the buckets paid by the payment account is also capped by it.
+	MaxOutflowRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_outflow_rate,json=maxOutflowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_outflow_rate"`
+	// max_spend_per_period is the max cumulative outflow of the payment account in a period, 0 means unlimited
+	MaxSpendPerPeriod github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_spend_per_period,json=maxSpendPerPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_spend_per_period"`
+	// period is the calendar period of max_spend_per_period
+	Period SpendingPeriod `protobuf:"varint,4,opt,name=period,proto3,enum=greenfield.payment.SpendingPeriod" json:"period,omitempty"`
+	// period_start is the unix timestamp when the current period starts
+	PeriodStart int64 `protobuf:"varint,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// spent is the cumulative outflow in the current period until last_update
+	Spent github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=spent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spent"`
+	// last_update is the unix timestamp when spent is updated
+	LastUpdate int64 `protobuf:"varint,7,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+}
+
+func (m *SpendingBudget) Reset()         { *m = SpendingBudget{} }
+func (m *SpendingBudget) String() string { return proto.CompactTextString(m) }
+func (*SpendingBudget) ProtoMessage()    {}
+func (*SpendingBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5ac12db79a023e, []int{0}
+}
+func (m *SpendingBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendingBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingBudget.Merge(m, src)
+}
+func (m *SpendingBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingBudget proto.InternalMessageInfo
+
+func (m *SpendingBudget) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SpendingBudget) GetPeriod() SpendingPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return SPENDING_PERIOD_MONTHLY
+}
+
+func (m *SpendingBudget) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *SpendingBudget) GetLastUpdate() int64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.payment.SpendingPeriod", SpendingPeriod_name, SpendingPeriod_value)
+	proto.RegisterType((*SpendingBudget)(nil), "greenfield.payment.SpendingBudget")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/spending_budget.proto", fileDescriptor_4f5ac12db79a023e)
+}
+
+var fileDescriptor_4f5ac12db79a023e = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0x8e, 0x69, 0x57, 0x84, 0x87, 0xaa, 0x62, 0x15, 0x91, 0x15, 0x29, 0x2d, 0x3b, 0xa0, 0x0a,
+	0xd1, 0x44, 0x82, 0x1b, 0xe2, 0xb2, 0xaa, 0x13, 0x44, 0x1a, 0x6d, 0x95, 0xc2, 0x61, 0x5c, 0x2c,
+	0xa7, 0x76, 0xb3, 0x88, 0xc6, 0x8e, 0x6c, 0x57, 0x74, 0x6f, 0xc0, 0x91, 0x77, 0xe0, 0x15, 0xf6,
+	0x10, 0x3b, 0x4e, 0x3b, 0x21, 0x0e, 0x13, 0x6a, 0x4f, 0xbc, 0x05, 0x8a, 0x6d, 0xd8, 0x04, 0xd7,
+	0x1d, 0xa2, 0x38, 0x5f, 0xbe, 0xff, 0xfb, 0xfc, 0x7f, 0xff, 0x0f, 0xfb, 0x99, 0x64, 0x8c, 0x2f,
+	0x72, 0xb6, 0xa4, 0x51, 0x49, 0x4e, 0x0b, 0xc6, 0x75, 0xa4, 0x4a, 0xc6, 0x69, 0xce, 0x33, 0x9c,
+	0xae, 0x68, 0xc6, 0x74, 0x58, 0x4a, 0xa1, 0x05, 0x42, 0xd7, 0xcc, 0xd0, 0x31, 0x3b, 0x7b, 0x73,
+	0xa1, 0x0a, 0xa1, 0xb0, 0x61, 0x44, 0xf6, 0xc3, 0xd2, 0x3b, 0xed, 0x4c, 0x64, 0xc2, 0xe2, 0xd5,
+	0xc9, 0xa2, 0xfb, 0xbf, 0x6a, 0xb0, 0x39, 0x73, 0xf2, 0x43, 0xa3, 0x8e, 0x9e, 0xc3, 0x3a, 0xa1,
+	0x54, 0xfa, 0xa0, 0x07, 0xfa, 0xf7, 0x86, 0xfe, 0xe5, 0xd9, 0xa0, 0xed, 0x84, 0x0e, 0x28, 0x95,
+	0x4c, 0xa9, 0x99, 0x96, 0x39, 0xcf, 0x12, 0xc3, 0x42, 0x0b, 0xd8, 0x2a, 0xc8, 0x1a, 0x8b, 0x95,
+	0x5e, 0x2c, 0xc5, 0x67, 0x2c, 0x89, 0x66, 0xfe, 0x1d, 0x53, 0xf9, 0xfa, 0xfc, 0xaa, 0xeb, 0xfd,
+	0xb8, 0xea, 0x3e, 0xcd, 0x72, 0x7d, 0xb2, 0x4a, 0xc3, 0xb9, 0x28, 0xdc, 0x8d, 0xdc, 0x6b, 0xa0,
+	0xe8, 0xa7, 0x48, 0x9f, 0x96, 0x4c, 0x85, 0x31, 0xd7, 0x97, 0x67, 0x03, 0xe8, 0x7c, 0x62, 0xae,
+	0x93, 0x66, 0x41, 0xd6, 0x13, 0x2b, 0x9a, 0x10, 0xcd, 0x50, 0x01, 0xdb, 0x95, 0x8f, 0x89, 0x02,
+	0x97, 0x4c, 0x56, 0x4f, 0x2e, 0xa8, 0x5f, 0xbb, 0x05, 0xaf, 0x07, 0x05, 0x59, 0x9b, 0x10, 0xa6,
+	0x4c, 0x4e, 0x8d, 0x2c, 0x7a, 0x05, 0x1b, 0xce, 0xa0, 0xde, 0x03, 0xfd, 0xe6, 0x8b, 0xfd, 0xf0,
+	0xff, 0xb4, 0xc3, 0x3f, 0xc1, 0xd9, 0x9a, 0xc4, 0x55, 0xa0, 0x27, 0xf0, 0xbe, 0x3d, 0x61, 0xa5,
+	0x89, 0xd4, 0xfe, 0x4e, 0x0f, 0xf4, 0x6b, 0xc9, 0xae, 0xc5, 0x66, 0x15, 0x84, 0x12, 0xb8, 0x53,
+	0x75, 0xa2, 0xfd, 0xc6, 0x2d, 0x5c, 0xdf, 0x4a, 0xa1, 0x2e, 0xdc, 0x5d, 0x12, 0xa5, 0xf1, 0xaa,
+	0xa4, 0xd5, 0x10, 0xee, 0x1a, 0x57, 0x58, 0x41, 0x1f, 0x0c, 0xf2, 0x6c, 0x7c, 0x3d, 0x6a, 0xd7,
+	0xe5, 0x63, 0xf8, 0x68, 0x36, 0x3d, 0x1c, 0x8f, 0xe2, 0xf1, 0x1b, 0x3c, 0x3d, 0x4c, 0xe2, 0xc9,
+	0x08, 0xbf, 0x9b, 0x8c, 0xdf, 0xbf, 0x3d, 0x3a, 0x6e, 0x79, 0x68, 0x0f, 0x3e, 0xfc, 0xf7, 0xe7,
+	0xe8, 0x20, 0x3e, 0x3a, 0x6e, 0x81, 0x4e, 0xfd, 0xcb, 0xb7, 0xc0, 0x1b, 0xc6, 0xe7, 0x9b, 0x00,
+	0x5c, 0x6c, 0x02, 0xf0, 0x73, 0x13, 0x80, 0xaf, 0xdb, 0xc0, 0xbb, 0xd8, 0x06, 0xde, 0xf7, 0x6d,
+	0xe0, 0x7d, 0x8c, 0x6e, 0xf4, 0x91, 0xf2, 0x74, 0x30, 0x3f, 0x21, 0x39, 0x8f, 0x6e, 0x6c, 0xf6,
+	0xfa, 0xef, 0x6e, 0x9b, 0xa6, 0xd2, 0x86, 0xd9, 0xc6, 0x97, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
+	0xe9, 0x33, 0x19, 0x7d, 0xfe, 0x02, 0x00, 0x00,
+}
+
+func (m *SpendingBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdate != 0 {
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(m.LastUpdate))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.PeriodStart != 0 {
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Period != 0 {
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxSpendPerPeriod.Size()
+		i -= size
+		if _, err := m.MaxSpendPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxOutflowRate.Size()
+		i -= size
+		if _, err := m.MaxOutflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintSpendingBudget(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSpendingBudget(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSpendingBudget(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SpendingBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovSpendingBudget(uint64(l))
+	}
+	l = m.MaxOutflowRate.Size()
+	n += 1 + l + sovSpendingBudget(uint64(l))
+	l = m.MaxSpendPerPeriod.Size()
+	n += 1 + l + sovSpendingBudget(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovSpendingBudget(uint64(m.Period))
+	}
+	if m.PeriodStart != 0 {
+		n += 1 + sovSpendingBudget(uint64(m.PeriodStart))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovSpendingBudget(uint64(l))
+	if m.LastUpdate != 0 {
+		n += 1 + sovSpendingBudget(uint64(m.LastUpdate))
+	}
+	return n
+}
+
+func sovSpendingBudget(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSpendingBudget(x uint64) (n int) {
+	return sovSpendingBudget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SpendingBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendingBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendingBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendingBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpendPerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpendPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= SpendingPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			m.PeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendingBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendingBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSpendingBudget(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSpendingBudget
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpendingBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSpendingBudget
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSpendingBudget
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSpendingBudget
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSpendingBudget        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSpendingBudget          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSpendingBudget = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -304,6 +305,9 @@ type MsgWithdraw struct {
 	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 	// amount is the amount to withdraw
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// delayed_withdrawal_id is the id of the delayed withdrawal to withdraw when from is empty,
+	// 0 means the earliest delayed withdrawal of the creator
+	DelayedWithdrawalId uint64 `protobuf:"varint,4,opt,name=delayed_withdrawal_id,json=delayedWithdrawalId,proto3" json:"delayed_withdrawal_id,omitempty"`
 }
 
 func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
@@ -353,7 +357,16 @@ func (m *MsgWithdraw) GetFrom() string {
 	return ""
 }
 
+func (m *MsgWithdraw) GetDelayedWithdrawalId() uint64 {
+	if m != nil {
+		return m.DelayedWithdrawalId
+	}
+	return 0
+}
+
 type MsgWithdrawResponse struct {
+	// delayed_withdrawal_id is the id of the delayed withdrawal created, if the withdrawal is delayed
+	DelayedWithdrawalId uint64 `protobuf:"varint,1,opt,name=delayed_withdrawal_id,json=delayedWithdrawalId,proto3" json:"delayed_withdrawal_id,omitempty"`
 }
 
 func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
@@ -389,6 +402,13 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+func (m *MsgWithdrawResponse) GetDelayedWithdrawalId() uint64 {
+	if m != nil {
+		return m.DelayedWithdrawalId
+	}
+	return 0
+}
+
 type MsgDisableRefund struct {
 	// owner is the message signer for MsgDisableRefund and the address of the payment account owner
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -479,757 +499,5529 @@ func (m *MsgDisableRefundResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDisableRefundResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.payment.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.payment.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreatePaymentAccount)(nil), "greenfield.payment.MsgCreatePaymentAccount")
-	proto.RegisterType((*MsgCreatePaymentAccountResponse)(nil), "greenfield.payment.MsgCreatePaymentAccountResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "greenfield.payment.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "greenfield.payment.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "greenfield.payment.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "greenfield.payment.MsgWithdrawResponse")
-	proto.RegisterType((*MsgDisableRefund)(nil), "greenfield.payment.MsgDisableRefund")
-	proto.RegisterType((*MsgDisableRefundResponse)(nil), "greenfield.payment.MsgDisableRefundResponse")
+type MsgSetBalanceAlert struct {
+	// owner is the message signer for MsgSetBalanceAlert and the address of the payment account owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// addr is the address of the payment account to set the balance alert
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// threshold is the runway in seconds to warn under, 0 means removing the balance alert
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/payment/tx.proto", fileDescriptor_a2b4041b20abde0a) }
+func (m *MsgSetBalanceAlert) Reset()         { *m = MsgSetBalanceAlert{} }
+func (m *MsgSetBalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBalanceAlert) ProtoMessage()    {}
+func (*MsgSetBalanceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{10}
+}
+func (m *MsgSetBalanceAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBalanceAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBalanceAlert.Merge(m, src)
+}
+func (m *MsgSetBalanceAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBalanceAlert.DiscardUnknown(m)
+}
 
-var fileDescriptor_a2b4041b20abde0a = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0x12, 0x41,
-	0x1c, 0x65, 0xa1, 0x52, 0xfb, 0x6b, 0xfd, 0x93, 0x15, 0x53, 0xba, 0x26, 0x4b, 0x45, 0x53, 0x89,
-	0x96, 0xdd, 0x48, 0x13, 0x63, 0x88, 0x97, 0xa2, 0x17, 0x0e, 0x24, 0x8a, 0x35, 0x26, 0x7a, 0xd0,
-	0x65, 0x77, 0x58, 0x36, 0x76, 0x67, 0x36, 0x33, 0x83, 0x85, 0xc4, 0x93, 0x9f, 0xc0, 0xc4, 0x2f,
-	0xe2, 0xa1, 0x1f, 0xa2, 0x27, 0xd3, 0xd4, 0x8b, 0xf1, 0xd0, 0x18, 0x38, 0x78, 0xf4, 0x2b, 0x98,
-	0xd9, 0x9d, 0x5d, 0x28, 0x42, 0xa9, 0x3d, 0x78, 0x5a, 0x66, 0xde, 0xfb, 0xbd, 0xf7, 0x7b, 0x93,
-	0xf9, 0x0d, 0x70, 0xc3, 0xa5, 0x08, 0xe1, 0xb6, 0x87, 0x76, 0x1d, 0x33, 0xb0, 0xfa, 0x3e, 0xc2,
-	0xdc, 0xe4, 0x3d, 0x23, 0xa0, 0x84, 0x13, 0x55, 0x1d, 0x81, 0x86, 0x04, 0xb5, 0x55, 0x9b, 0x30,
-	0x9f, 0x30, 0xd3, 0x67, 0xae, 0xf9, 0xfe, 0xbe, 0xf8, 0x44, 0x64, 0x6d, 0x2d, 0x02, 0xde, 0x84,
-	0x2b, 0x33, 0x5a, 0x48, 0x28, 0xe7, 0x12, 0x97, 0x44, 0xfb, 0xe2, 0x97, 0xdc, 0x2d, 0x4c, 0xb1,
-	0x0e, 0x2c, 0x6a, 0xf9, 0xb2, 0xac, 0xf8, 0x59, 0x81, 0x2b, 0x0d, 0xe6, 0xbe, 0x08, 0x1c, 0x8b,
-	0xa3, 0xa7, 0x21, 0xa2, 0x3e, 0x80, 0x25, 0xab, 0xcb, 0x3b, 0x84, 0x7a, 0xbc, 0x9f, 0x57, 0xd6,
-	0x95, 0xd2, 0x52, 0x2d, 0x7f, 0xb4, 0x5f, 0xce, 0x49, 0xbf, 0x6d, 0xc7, 0xa1, 0x88, 0xb1, 0xe7,
-	0x9c, 0x7a, 0xd8, 0x6d, 0x8e, 0xa8, 0xea, 0x43, 0xc8, 0x46, 0xda, 0xf9, 0xf4, 0xba, 0x52, 0x5a,
-	0xae, 0x68, 0xc6, 0xdf, 0xd9, 0x8c, 0xc8, 0xa3, 0xb6, 0x70, 0x70, 0x5c, 0x48, 0x35, 0x25, 0xbf,
-	0x7a, 0xf9, 0xe3, 0xaf, 0x2f, 0x77, 0x47, 0x4a, 0xc5, 0x35, 0x58, 0x9d, 0x68, 0xaa, 0x89, 0x58,
-	0x40, 0x30, 0x43, 0xc5, 0xd7, 0x21, 0xf4, 0x98, 0xa2, 0x10, 0x0a, 0x35, 0xb7, 0x6d, 0x9b, 0x74,
-	0x31, 0x57, 0x2b, 0xb0, 0x68, 0x8b, 0x7d, 0x42, 0xe7, 0x76, 0x1d, 0x13, 0xab, 0x2b, 0xc2, 0x39,
-	0x5e, 0x15, 0x6f, 0x42, 0x61, 0x86, 0x78, 0xe2, 0xff, 0x55, 0x01, 0x68, 0x30, 0xf7, 0x09, 0x0a,
-	0x08, 0xf3, 0xce, 0xe5, 0xa9, 0x96, 0x20, 0xcd, 0x49, 0x78, 0x46, 0xa7, 0xd1, 0xd3, 0x9c, 0xa8,
-	0x3b, 0x90, 0xb5, 0x7c, 0x61, 0x9f, 0xcf, 0x84, 0xec, 0x47, 0xe2, 0xd4, 0x7e, 0x1c, 0x17, 0x36,
-	0x5c, 0x8f, 0x77, 0xba, 0x2d, 0xc3, 0x26, 0xbe, 0xbc, 0x05, 0xf2, 0x53, 0x66, 0xce, 0x3b, 0x93,
-	0xf7, 0x03, 0xc4, 0x8c, 0x3a, 0xe6, 0x47, 0xfb, 0x65, 0x90, 0xda, 0x75, 0xcc, 0x9b, 0x52, 0x6b,
-	0x22, 0x73, 0x0e, 0xd4, 0x51, 0x9e, 0x24, 0xe6, 0x37, 0x05, 0x96, 0x1b, 0xcc, 0x7d, 0xe9, 0xf1,
-	0x8e, 0x43, 0xad, 0xbd, 0x73, 0xe5, 0xdc, 0x84, 0x85, 0x36, 0x25, 0xfe, 0xdc, 0xa4, 0x21, 0xeb,
-	0xbf, 0x64, 0xbd, 0x0e, 0xd7, 0xc6, 0x42, 0x25, 0x61, 0x3f, 0xc0, 0x55, 0x71, 0x04, 0x1e, 0xb3,
-	0x5a, 0xbb, 0xa8, 0x89, 0xda, 0x5d, 0xec, 0xa8, 0x06, 0x5c, 0x20, 0x7b, 0x18, 0xcd, 0x8f, 0x1b,
-	0xd1, 0x44, 0x58, 0xcb, 0x71, 0xe8, 0xfc, 0xb0, 0x82, 0x55, 0x05, 0xd1, 0x56, 0x54, 0x59, 0xd4,
-	0x20, 0x3f, 0xe9, 0x1e, 0x77, 0x56, 0xf9, 0x9d, 0x81, 0x4c, 0x83, 0xb9, 0xea, 0x5b, 0x58, 0x39,
-	0x31, 0xa2, 0xb7, 0xa6, 0x8d, 0xd6, 0xc4, 0xc8, 0x68, 0xf7, 0xce, 0x40, 0x8a, 0x9d, 0xd4, 0x1e,
-	0xe4, 0xa6, 0x0e, 0xd5, 0x2c, 0x91, 0x69, 0x64, 0x6d, 0xeb, 0x1f, 0xc8, 0x89, 0xf3, 0x33, 0x58,
-	0x8c, 0xa7, 0x49, 0x9f, 0x51, 0x2f, 0x71, 0x6d, 0xe3, 0x74, 0x3c, 0x91, 0xdc, 0x81, 0x8b, 0xc9,
-	0xcd, 0x2d, 0xcc, 0xa8, 0x89, 0x09, 0xda, 0x9d, 0x39, 0x84, 0x44, 0xd5, 0x86, 0x4b, 0x27, 0xef,
-	0xc8, 0xed, 0x59, 0xed, 0x8c, 0xb3, 0xb4, 0xcd, 0xb3, 0xb0, 0x62, 0x93, 0x5a, 0xfd, 0x60, 0xa0,
-	0x2b, 0x87, 0x03, 0x5d, 0xf9, 0x39, 0xd0, 0x95, 0x4f, 0x43, 0x3d, 0x75, 0x38, 0xd4, 0x53, 0xdf,
-	0x87, 0x7a, 0xea, 0x95, 0x39, 0x36, 0x08, 0x2d, 0xdc, 0x2a, 0xdb, 0x1d, 0xcb, 0xc3, 0xe6, 0xd8,
-	0x03, 0xdf, 0x1b, 0xfd, 0xbb, 0x88, 0xa9, 0x68, 0x65, 0xc3, 0x27, 0x7e, 0xeb, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x89, 0xdc, 0x13, 0x0e, 0x80, 0x06, 0x00, 0x00,
+var xxx_messageInfo_MsgSetBalanceAlert proto.InternalMessageInfo
+
+func (m *MsgSetBalanceAlert) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetBalanceAlert) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetBalanceAlert) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/payment module parameters.
-	// The authority is defined in the keeper.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreatePaymentAccount(ctx context.Context, in *MsgCreatePaymentAccount, opts ...grpc.CallOption) (*MsgCreatePaymentAccountResponse, error)
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	DisableRefund(ctx context.Context, in *MsgDisableRefund, opts ...grpc.CallOption) (*MsgDisableRefundResponse, error)
+type MsgSetBalanceAlertResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetBalanceAlertResponse) Reset()         { *m = MsgSetBalanceAlertResponse{} }
+func (m *MsgSetBalanceAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBalanceAlertResponse) ProtoMessage()    {}
+func (*MsgSetBalanceAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{11}
+}
+func (m *MsgSetBalanceAlertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBalanceAlertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBalanceAlertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBalanceAlertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBalanceAlertResponse.Merge(m, src)
+}
+func (m *MsgSetBalanceAlertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBalanceAlertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBalanceAlertResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetBalanceAlertResponse proto.InternalMessageInfo
+
+type MsgEnableAutoTopUp struct {
+	// owner is the message signer for MsgEnableAutoTopUp and the address of the payment account owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// addr is the address of the payment account to top up
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// source is the address to top up from, either the owner or another payment account of the owner.
+	// Topping up from the owner requires the owner to grant the payment module to deposit on its behalf.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// cap_per_period is the max amount to top up in a period
+	CapPerPeriod github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cap_per_period,json=capPerPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap_per_period"`
+	// period is the length of a period in seconds
+	Period uint64 `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	// min_runway is the runway in seconds to top up under
+	MinRunway uint64 `protobuf:"varint,6,opt,name=min_runway,json=minRunway,proto3" json:"min_runway,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEnableAutoTopUp) Reset()         { *m = MsgEnableAutoTopUp{} }
+func (m *MsgEnableAutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAutoTopUp) ProtoMessage()    {}
+func (*MsgEnableAutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{12}
+}
+func (m *MsgEnableAutoTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAutoTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgEnableAutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAutoTopUp.Merge(m, src)
+}
+func (m *MsgEnableAutoTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAutoTopUp.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreatePaymentAccount(ctx context.Context, in *MsgCreatePaymentAccount, opts ...grpc.CallOption) (*MsgCreatePaymentAccountResponse, error) {
-	out := new(MsgCreatePaymentAccountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CreatePaymentAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgEnableAutoTopUp proto.InternalMessageInfo
+
+func (m *MsgEnableAutoTopUp) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEnableAutoTopUp) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEnableAutoTopUp) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DisableRefund(ctx context.Context, in *MsgDisableRefund, opts ...grpc.CallOption) (*MsgDisableRefundResponse, error) {
-	out := new(MsgDisableRefundResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/DisableRefund", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEnableAutoTopUp) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the x/payment module parameters.
-	// The authority is defined in the keeper.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreatePaymentAccount(context.Context, *MsgCreatePaymentAccount) (*MsgCreatePaymentAccountResponse, error)
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	DisableRefund(context.Context, *MsgDisableRefund) (*MsgDisableRefundResponse, error)
+func (m *MsgEnableAutoTopUp) GetMinRunway() uint64 {
+	if m != nil {
+		return m.MinRunway
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgEnableAutoTopUpResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgEnableAutoTopUpResponse) Reset()         { *m = MsgEnableAutoTopUpResponse{} }
+func (m *MsgEnableAutoTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAutoTopUpResponse) ProtoMessage()    {}
+func (*MsgEnableAutoTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{13}
 }
-func (*UnimplementedMsgServer) CreatePaymentAccount(ctx context.Context, req *MsgCreatePaymentAccount) (*MsgCreatePaymentAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentAccount not implemented")
+func (m *MsgEnableAutoTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgEnableAutoTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAutoTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgEnableAutoTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAutoTopUpResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DisableRefund(ctx context.Context, req *MsgDisableRefund) (*MsgDisableRefundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableRefund not implemented")
+func (m *MsgEnableAutoTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAutoTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAutoTopUpResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgEnableAutoTopUpResponse proto.InternalMessageInfo
+
+type MsgDisableAutoTopUp struct {
+	// owner is the message signer for MsgDisableAutoTopUp and the address of the payment account owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// addr is the address of the payment account to disable the auto top-up
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDisableAutoTopUp) Reset()         { *m = MsgDisableAutoTopUp{} }
+func (m *MsgDisableAutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableAutoTopUp) ProtoMessage()    {}
+func (*MsgDisableAutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{14}
+}
+func (m *MsgDisableAutoTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableAutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableAutoTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDisableAutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableAutoTopUp.Merge(m, src)
+}
+func (m *MsgDisableAutoTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableAutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableAutoTopUp.DiscardUnknown(m)
 }
 
-func _Msg_CreatePaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePaymentAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePaymentAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Msg/CreatePaymentAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePaymentAccount(ctx, req.(*MsgCreatePaymentAccount))
+var xxx_messageInfo_MsgDisableAutoTopUp proto.InternalMessageInfo
+
+func (m *MsgDisableAutoTopUp) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Msg/Deposit",
+func (m *MsgDisableAutoTopUp) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgDisableAutoTopUpResponse struct {
 }
 
-func _Msg_DisableRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisableRefund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisableRefund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Msg/DisableRefund",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisableRefund(ctx, req.(*MsgDisableRefund))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgDisableAutoTopUpResponse) Reset()         { *m = MsgDisableAutoTopUpResponse{} }
+func (m *MsgDisableAutoTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableAutoTopUpResponse) ProtoMessage()    {}
+func (*MsgDisableAutoTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{15}
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.payment.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreatePaymentAccount",
-			Handler:    _Msg_CreatePaymentAccount_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "DisableRefund",
-			Handler:    _Msg_DisableRefund_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/payment/tx.proto",
+func (m *MsgDisableAutoTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDisableAutoTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableAutoTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDisableAutoTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableAutoTopUpResponse.Merge(m, src)
+}
+func (m *MsgDisableAutoTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableAutoTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableAutoTopUpResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDisableAutoTopUpResponse proto.InternalMessageInfo
+
+type MsgSetSpendingBudget struct {
+	// owner is the message signer for MsgSetSpendingBudget and the address of the payment account owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// addr is the address of the payment account to set the spending budget
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// max_outflow_rate is the max total outflow rate of the payment account, 0 means unlimited
+	MaxOutflowRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_outflow_rate,json=maxOutflowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_outflow_rate"`
+	// max_spend_per_period is the max cumulative outflow of the payment account in a period, 0 means unlimited.
+	// The spending budget is removed if both max_outflow_rate and max_spend_per_period are 0.
+	MaxSpendPerPeriod github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_spend_per_period,json=maxSpendPerPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_spend_per_period"`
+	// period is the calendar period of max_spend_per_period
+	Period SpendingPeriod `protobuf:"varint,5,opt,name=period,proto3,enum=greenfield.payment.SpendingPeriod" json:"period,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetSpendingBudget) Reset()         { *m = MsgSetSpendingBudget{} }
+func (m *MsgSetSpendingBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendingBudget) ProtoMessage()    {}
+func (*MsgSetSpendingBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{16}
+}
+func (m *MsgSetSpendingBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendingBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendingBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetSpendingBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendingBudget.Merge(m, src)
+}
+func (m *MsgSetSpendingBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendingBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendingBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSpendingBudget proto.InternalMessageInfo
+
+func (m *MsgSetSpendingBudget) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSpendingBudget) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSpendingBudget) GetPeriod() SpendingPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return SPENDING_PERIOD_MONTHLY
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetSpendingBudgetResponse struct {
 }
 
-func (m *MsgCreatePaymentAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSpendingBudgetResponse) Reset()         { *m = MsgSetSpendingBudgetResponse{} }
+func (m *MsgSetSpendingBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendingBudgetResponse) ProtoMessage()    {}
+func (*MsgSetSpendingBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{17}
+}
+func (m *MsgSetSpendingBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendingBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendingBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreatePaymentAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSpendingBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendingBudgetResponse.Merge(m, src)
+}
+func (m *MsgSetSpendingBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendingBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendingBudgetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePaymentAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetSpendingBudgetResponse proto.InternalMessageInfo
+
+type MsgTransferPaymentAccountOwnership struct {
+	// owner is the message signer for MsgTransferPaymentAccountOwnership and the address of the payment account owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// addr is the address of the payment account to transfer
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// new_owner is the address of the new owner, who has to accept the ownership.
+	// An empty new_owner cancels the pending transfer.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *MsgCreatePaymentAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferPaymentAccountOwnership) Reset()         { *m = MsgTransferPaymentAccountOwnership{} }
+func (m *MsgTransferPaymentAccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPaymentAccountOwnership) ProtoMessage()    {}
+func (*MsgTransferPaymentAccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{18}
+}
+func (m *MsgTransferPaymentAccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPaymentAccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPaymentAccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTransferPaymentAccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPaymentAccountOwnership.Merge(m, src)
+}
+func (m *MsgTransferPaymentAccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPaymentAccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPaymentAccountOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferPaymentAccountOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferPaymentAccountOwnership) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgCreatePaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferPaymentAccountOwnership) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferPaymentAccountOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTransferPaymentAccountOwnershipResponse struct {
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgTransferPaymentAccountOwnershipResponse) Reset() {
+	*m = MsgTransferPaymentAccountOwnershipResponse{}
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTransferPaymentAccountOwnershipResponse) ProtoMessage() {}
+func (*MsgTransferPaymentAccountOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{19}
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPaymentAccountOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPaymentAccountOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPaymentAccountOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPaymentAccountOwnershipResponse proto.InternalMessageInfo
+
+type MsgAcceptPaymentAccountOwnership struct {
+	// new_owner is the message signer for MsgAcceptPaymentAccountOwnership and the address of the new owner
+	NewOwner string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// addr is the address of the payment account to accept
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (m *MsgAcceptPaymentAccountOwnership) Reset()         { *m = MsgAcceptPaymentAccountOwnership{} }
+func (m *MsgAcceptPaymentAccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPaymentAccountOwnership) ProtoMessage()    {}
+func (*MsgAcceptPaymentAccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{20}
+}
+func (m *MsgAcceptPaymentAccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPaymentAccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPaymentAccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptPaymentAccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPaymentAccountOwnership.Merge(m, src)
+}
+func (m *MsgAcceptPaymentAccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPaymentAccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPaymentAccountOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptPaymentAccountOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptPaymentAccountOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptPaymentAccountOwnership) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgAcceptPaymentAccountOwnershipResponse struct {
 }
 
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptPaymentAccountOwnershipResponse) Reset() {
+	*m = MsgAcceptPaymentAccountOwnershipResponse{}
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPaymentAccountOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptPaymentAccountOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{21}
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPaymentAccountOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPaymentAccountOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPaymentAccountOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcceptPaymentAccountOwnershipResponse proto.InternalMessageInfo
+
+type MsgCancelDelayedWithdrawal struct {
+	// creator is the message signer for MsgCancelDelayedWithdrawal and the address of the receive account
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the id of the delayed withdrawal to cancel
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCancelDelayedWithdrawal) Reset()         { *m = MsgCancelDelayedWithdrawal{} }
+func (m *MsgCancelDelayedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedWithdrawal) ProtoMessage()    {}
+func (*MsgCancelDelayedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{22}
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedWithdrawal.Merge(m, src)
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedWithdrawal.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelDelayedWithdrawal proto.InternalMessageInfo
+
+func (m *MsgCancelDelayedWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelDelayedWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgCancelDelayedWithdrawalResponse struct {
 }
 
-func (m *MsgDisableRefund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelDelayedWithdrawalResponse) Reset()         { *m = MsgCancelDelayedWithdrawalResponse{} }
+func (m *MsgCancelDelayedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelDelayedWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{23}
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDisableRefund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelDelayedWithdrawalResponse proto.InternalMessageInfo
+
+type MsgTransferReadVoucher struct {
+	// holder is the message signer for MsgTransferReadVoucher and the address of the voucher holder
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// id is the id of the voucher to transfer
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// to is the address of the new holder
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (m *MsgDisableRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgTransferReadVoucher) Reset()         { *m = MsgTransferReadVoucher{} }
+func (m *MsgTransferReadVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferReadVoucher) ProtoMessage()    {}
+func (*MsgTransferReadVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{24}
+}
+func (m *MsgTransferReadVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferReadVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferReadVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferReadVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferReadVoucher.Merge(m, src)
+}
+func (m *MsgTransferReadVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferReadVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferReadVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferReadVoucher proto.InternalMessageInfo
+
+func (m *MsgTransferReadVoucher) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDisableRefundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferReadVoucher) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDisableRefundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferReadVoucher) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
 }
 
-func (m *MsgDisableRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgTransferReadVoucherResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferReadVoucherResponse) Reset()         { *m = MsgTransferReadVoucherResponse{} }
+func (m *MsgTransferReadVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferReadVoucherResponse) ProtoMessage()    {}
+func (*MsgTransferReadVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{25}
+}
+func (m *MsgTransferReadVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferReadVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferReadVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferReadVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferReadVoucherResponse.Merge(m, src)
+}
+func (m *MsgTransferReadVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferReadVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferReadVoucherResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferReadVoucherResponse proto.InternalMessageInfo
+
+type MsgCreateMultisigPaymentAccount struct {
+	// creator is the message signer for MsgCreateMultisigPaymentAccount, the payment account address is derived from it
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// owners are the owner addresses of the multisig payment account
+	Owners []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+	// threshold is the number of the owners required to approve a proposal
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *MsgCreateMultisigPaymentAccount) Reset()         { *m = MsgCreateMultisigPaymentAccount{} }
+func (m *MsgCreateMultisigPaymentAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultisigPaymentAccount) ProtoMessage()    {}
+func (*MsgCreateMultisigPaymentAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{26}
+}
+func (m *MsgCreateMultisigPaymentAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMultisigPaymentAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultisigPaymentAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCreateMultisigPaymentAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultisigPaymentAccount.Merge(m, src)
+}
+func (m *MsgCreateMultisigPaymentAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultisigPaymentAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultisigPaymentAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMultisigPaymentAccount proto.InternalMessageInfo
+
+func (m *MsgCreateMultisigPaymentAccount) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateMultisigPaymentAccount) GetOwners() []string {
+	if m != nil {
+		return m.Owners
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgCreatePaymentAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateMultisigPaymentAccount) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+type MsgCreateMultisigPaymentAccountResponse struct {
+	// addr is the address of the created payment account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (m *MsgCreateMultisigPaymentAccountResponse) Reset() {
+	*m = MsgCreateMultisigPaymentAccountResponse{}
+}
+func (m *MsgCreateMultisigPaymentAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultisigPaymentAccountResponse) ProtoMessage()    {}
+func (*MsgCreateMultisigPaymentAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{27}
+}
+func (m *MsgCreateMultisigPaymentAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMultisigPaymentAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultisigPaymentAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCreateMultisigPaymentAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultisigPaymentAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateMultisigPaymentAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultisigPaymentAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultisigPaymentAccountResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePaymentAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCreateMultisigPaymentAccountResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMultisigPaymentAccountResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgProposeMultisigAction struct {
+	// proposer is the message signer for MsgProposeMultisigAction and an owner of the payment account,
+	// the proposal is approved by the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// payment_account is the address of the multisig payment account
+	PaymentAccount string `protobuf:"bytes,2,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+	// action is the type of the action to execute
+	Action MultisigActionType `protobuf:"varint,3,opt,name=action,proto3,enum=greenfield.payment.MultisigActionType" json:"action,omitempty"`
+	// to is the receiver of the withdrawal, for MULTISIG_ACTION_WITHDRAW
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount to withdraw, for MULTISIG_ACTION_WITHDRAW
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// binder is the account to allow or disallow binding buckets, for MULTISIG_ACTION_SET_BUCKET_BINDER
+	Binder string `protobuf:"bytes,6,opt,name=binder,proto3" json:"binder,omitempty"`
+	// allowed defines whether the binder is allowed, for MULTISIG_ACTION_SET_BUCKET_BINDER
+	Allowed bool `protobuf:"varint,7,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *MsgProposeMultisigAction) Reset()         { *m = MsgProposeMultisigAction{} }
+func (m *MsgProposeMultisigAction) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeMultisigAction) ProtoMessage()    {}
+func (*MsgProposeMultisigAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{28}
+}
+func (m *MsgProposeMultisigAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeMultisigAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeMultisigAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgProposeMultisigAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeMultisigAction.Merge(m, src)
+}
+func (m *MsgProposeMultisigAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeMultisigAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeMultisigAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeMultisigAction proto.InternalMessageInfo
+
+func (m *MsgProposeMultisigAction) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgProposeMultisigAction) GetPaymentAccount() string {
+	if m != nil {
+		return m.PaymentAccount
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeMultisigAction) GetAction() MultisigActionType {
+	if m != nil {
+		return m.Action
 	}
-	var l int
-	_ = l
-	return n
+	return MULTISIG_ACTION_WITHDRAW
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeMultisigAction) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgProposeMultisigAction) GetBinder() string {
+	if m != nil {
+		return m.Binder
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgProposeMultisigAction) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return false
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgProposeMultisigActionResponse struct {
+	// id is the id of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// executed defines whether the proposal is executed already, when the threshold is one
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgProposeMultisigActionResponse) Reset()         { *m = MsgProposeMultisigActionResponse{} }
+func (m *MsgProposeMultisigActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeMultisigActionResponse) ProtoMessage()    {}
+func (*MsgProposeMultisigActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{29}
+}
+func (m *MsgProposeMultisigActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeMultisigActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeMultisigActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgProposeMultisigActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeMultisigActionResponse.Merge(m, src)
+}
+func (m *MsgProposeMultisigActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeMultisigActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeMultisigActionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDisableRefund) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgProposeMultisigActionResponse proto.InternalMessageInfo
+
+func (m *MsgProposeMultisigActionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgProposeMultisigActionResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+type MsgApproveMultisigProposal struct {
+	// approver is the message signer for MsgApproveMultisigProposal and an owner of the payment account
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	// payment_account is the address of the multisig payment account
+	PaymentAccount string `protobuf:"bytes,2,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+	// id is the id of the proposal to approve
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgApproveMultisigProposal) Reset()         { *m = MsgApproveMultisigProposal{} }
+func (m *MsgApproveMultisigProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMultisigProposal) ProtoMessage()    {}
+func (*MsgApproveMultisigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{30}
+}
+func (m *MsgApproveMultisigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveMultisigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMultisigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveMultisigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMultisigProposal.Merge(m, src)
+}
+func (m *MsgApproveMultisigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMultisigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMultisigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMultisigProposal proto.InternalMessageInfo
+
+func (m *MsgApproveMultisigProposal) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *MsgApproveMultisigProposal) GetPaymentAccount() string {
+	if m != nil {
+		return m.PaymentAccount
+	}
+	return ""
+}
+
+func (m *MsgApproveMultisigProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgApproveMultisigProposalResponse struct {
+	// executed defines whether the proposal is executed with the approval
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgApproveMultisigProposalResponse) Reset()         { *m = MsgApproveMultisigProposalResponse{} }
+func (m *MsgApproveMultisigProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMultisigProposalResponse) ProtoMessage()    {}
+func (*MsgApproveMultisigProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2b4041b20abde0a, []int{31}
+}
+func (m *MsgApproveMultisigProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveMultisigProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMultisigProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveMultisigProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMultisigProposalResponse.Merge(m, src)
+}
+func (m *MsgApproveMultisigProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMultisigProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMultisigProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMultisigProposalResponse proto.InternalMessageInfo
+
+func (m *MsgApproveMultisigProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.payment.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.payment.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreatePaymentAccount)(nil), "greenfield.payment.MsgCreatePaymentAccount")
+	proto.RegisterType((*MsgCreatePaymentAccountResponse)(nil), "greenfield.payment.MsgCreatePaymentAccountResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "greenfield.payment.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "greenfield.payment.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "greenfield.payment.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "greenfield.payment.MsgWithdrawResponse")
+	proto.RegisterType((*MsgDisableRefund)(nil), "greenfield.payment.MsgDisableRefund")
+	proto.RegisterType((*MsgDisableRefundResponse)(nil), "greenfield.payment.MsgDisableRefundResponse")
+	proto.RegisterType((*MsgSetBalanceAlert)(nil), "greenfield.payment.MsgSetBalanceAlert")
+	proto.RegisterType((*MsgSetBalanceAlertResponse)(nil), "greenfield.payment.MsgSetBalanceAlertResponse")
+	proto.RegisterType((*MsgEnableAutoTopUp)(nil), "greenfield.payment.MsgEnableAutoTopUp")
+	proto.RegisterType((*MsgEnableAutoTopUpResponse)(nil), "greenfield.payment.MsgEnableAutoTopUpResponse")
+	proto.RegisterType((*MsgDisableAutoTopUp)(nil), "greenfield.payment.MsgDisableAutoTopUp")
+	proto.RegisterType((*MsgDisableAutoTopUpResponse)(nil), "greenfield.payment.MsgDisableAutoTopUpResponse")
+	proto.RegisterType((*MsgSetSpendingBudget)(nil), "greenfield.payment.MsgSetSpendingBudget")
+	proto.RegisterType((*MsgSetSpendingBudgetResponse)(nil), "greenfield.payment.MsgSetSpendingBudgetResponse")
+	proto.RegisterType((*MsgTransferPaymentAccountOwnership)(nil), "greenfield.payment.MsgTransferPaymentAccountOwnership")
+	proto.RegisterType((*MsgTransferPaymentAccountOwnershipResponse)(nil), "greenfield.payment.MsgTransferPaymentAccountOwnershipResponse")
+	proto.RegisterType((*MsgAcceptPaymentAccountOwnership)(nil), "greenfield.payment.MsgAcceptPaymentAccountOwnership")
+	proto.RegisterType((*MsgAcceptPaymentAccountOwnershipResponse)(nil), "greenfield.payment.MsgAcceptPaymentAccountOwnershipResponse")
+	proto.RegisterType((*MsgCancelDelayedWithdrawal)(nil), "greenfield.payment.MsgCancelDelayedWithdrawal")
+	proto.RegisterType((*MsgCancelDelayedWithdrawalResponse)(nil), "greenfield.payment.MsgCancelDelayedWithdrawalResponse")
+	proto.RegisterType((*MsgTransferReadVoucher)(nil), "greenfield.payment.MsgTransferReadVoucher")
+	proto.RegisterType((*MsgTransferReadVoucherResponse)(nil), "greenfield.payment.MsgTransferReadVoucherResponse")
+	proto.RegisterType((*MsgCreateMultisigPaymentAccount)(nil), "greenfield.payment.MsgCreateMultisigPaymentAccount")
+	proto.RegisterType((*MsgCreateMultisigPaymentAccountResponse)(nil), "greenfield.payment.MsgCreateMultisigPaymentAccountResponse")
+	proto.RegisterType((*MsgProposeMultisigAction)(nil), "greenfield.payment.MsgProposeMultisigAction")
+	proto.RegisterType((*MsgProposeMultisigActionResponse)(nil), "greenfield.payment.MsgProposeMultisigActionResponse")
+	proto.RegisterType((*MsgApproveMultisigProposal)(nil), "greenfield.payment.MsgApproveMultisigProposal")
+	proto.RegisterType((*MsgApproveMultisigProposalResponse)(nil), "greenfield.payment.MsgApproveMultisigProposalResponse")
+}
+
+func init() { proto.RegisterFile("greenfield/payment/tx.proto", fileDescriptor_a2b4041b20abde0a) }
+
+var fileDescriptor_a2b4041b20abde0a = []byte{
+	// 1516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xd1, 0x6f, 0xd3, 0x56,
+	0x17, 0xaf, 0x93, 0x90, 0xb6, 0x07, 0x08, 0x60, 0x0a, 0x04, 0x53, 0xd2, 0xe2, 0x0f, 0x41, 0x54,
+	0x68, 0xc2, 0x17, 0xf8, 0xd0, 0xa7, 0x0e, 0xa1, 0xa5, 0xb0, 0x87, 0x3e, 0x64, 0x74, 0xa6, 0x0c,
+	0x69, 0x7b, 0xc8, 0x6e, 0xec, 0x5b, 0xc7, 0xc2, 0xf6, 0xb5, 0xec, 0x1b, 0x92, 0x6a, 0x93, 0xa6,
+	0xed, 0x65, 0xaf, 0x4c, 0x3c, 0x4c, 0x93, 0xb6, 0xff, 0x61, 0xd2, 0xf8, 0x17, 0x26, 0x21, 0x4d,
+	0x9a, 0x10, 0x4f, 0xd3, 0x1e, 0xd0, 0x04, 0x9a, 0xf6, 0x6f, 0x4c, 0xbe, 0xb6, 0x6f, 0xe2, 0xd4,
+	0x8e, 0xd3, 0x42, 0xf7, 0x50, 0xa5, 0xf6, 0xfd, 0xdd, 0x73, 0x7e, 0xe7, 0x77, 0xce, 0xbd, 0xf7,
+	0xdc, 0x04, 0xce, 0xe9, 0x2e, 0xc6, 0xf6, 0xb6, 0x81, 0x4d, 0xad, 0xee, 0xa0, 0x1d, 0x0b, 0xdb,
+	0xb4, 0x4e, 0x07, 0x35, 0xc7, 0x25, 0x94, 0x88, 0xe2, 0x70, 0xb0, 0x16, 0x0e, 0x4a, 0x67, 0x54,
+	0xe2, 0x59, 0xc4, 0xab, 0x5b, 0x9e, 0x5e, 0x7f, 0xfc, 0x5f, 0xff, 0x23, 0x00, 0x4b, 0x67, 0x83,
+	0x81, 0x36, 0x7b, 0xaa, 0x07, 0x0f, 0xe1, 0xd0, 0x82, 0x4e, 0x74, 0x12, 0xbc, 0xf7, 0xff, 0x0b,
+	0xdf, 0x5e, 0x48, 0x70, 0x6d, 0xf5, 0x4c, 0x6a, 0x78, 0x46, 0x64, 0x73, 0x29, 0x01, 0xe2, 0x20,
+	0x17, 0x59, 0x91, 0xe5, 0x6a, 0x02, 0xc0, 0x73, 0xb0, 0xad, 0x19, 0xb6, 0xde, 0xee, 0xf4, 0x34,
+	0x1d, 0xd3, 0x00, 0x29, 0x3f, 0x15, 0xe0, 0x58, 0xcb, 0xd3, 0x1f, 0x38, 0x1a, 0xa2, 0x78, 0x93,
+	0xd9, 0x10, 0x6f, 0xc2, 0x3c, 0xea, 0xd1, 0x2e, 0x71, 0x0d, 0xba, 0x53, 0x16, 0x96, 0x85, 0xea,
+	0xfc, 0x7a, 0xf9, 0xe5, 0xb3, 0xd5, 0x85, 0x90, 0x7c, 0x53, 0xd3, 0x5c, 0xec, 0x79, 0xf7, 0xa9,
+	0x6b, 0xd8, 0xba, 0x32, 0x84, 0x8a, 0xff, 0x87, 0x62, 0xc0, 0xa2, 0x9c, 0x5b, 0x16, 0xaa, 0x87,
+	0x1b, 0x52, 0x6d, 0xb7, 0x50, 0xb5, 0xc0, 0xc7, 0x7a, 0xe1, 0xf9, 0xab, 0xa5, 0x19, 0x25, 0xc4,
+	0xaf, 0x95, 0xbe, 0xfe, 0xfb, 0xa7, 0x95, 0xa1, 0x25, 0xf9, 0x2c, 0x9c, 0x19, 0x23, 0xa5, 0x60,
+	0xcf, 0x21, 0xb6, 0x87, 0xe5, 0x4f, 0xd9, 0xd0, 0x1d, 0x17, 0xb3, 0x21, 0x66, 0xb3, 0xa9, 0xaa,
+	0xa4, 0x67, 0x53, 0xb1, 0x01, 0xb3, 0xaa, 0xff, 0x9e, 0xb8, 0x99, 0xac, 0x23, 0xe0, 0xda, 0x11,
+	0xdf, 0x73, 0xf4, 0x24, 0x5f, 0x80, 0xa5, 0x14, 0xe3, 0xdc, 0xff, 0x6f, 0x02, 0x40, 0xcb, 0xd3,
+	0xef, 0x62, 0x87, 0x78, 0xc6, 0xbe, 0x7c, 0x8a, 0x55, 0xc8, 0x51, 0xc2, 0x34, 0x9a, 0x04, 0xcf,
+	0x51, 0x22, 0x6e, 0x41, 0x11, 0x59, 0xbe, 0xfb, 0x72, 0x9e, 0xa1, 0x6f, 0xf9, 0xaa, 0xfd, 0xf1,
+	0x6a, 0xe9, 0x92, 0x6e, 0xd0, 0x6e, 0xaf, 0x53, 0x53, 0x89, 0x15, 0x96, 0x54, 0xf8, 0xb1, 0xea,
+	0x69, 0x8f, 0xea, 0x74, 0xc7, 0xc1, 0x5e, 0x6d, 0xc3, 0xa6, 0x2f, 0x9f, 0xad, 0x42, 0x68, 0x7b,
+	0xc3, 0xa6, 0x4a, 0x68, 0x6b, 0x2c, 0xe6, 0x05, 0x10, 0x87, 0xf1, 0xf0, 0x30, 0xbf, 0xc9, 0xc1,
+	0xe1, 0x96, 0xa7, 0x3f, 0x34, 0x68, 0x57, 0x73, 0x51, 0x7f, 0x5f, 0x71, 0x5e, 0x85, 0xc2, 0xb6,
+	0x4b, 0xac, 0xcc, 0x48, 0x19, 0xea, 0x60, 0x62, 0x15, 0x1b, 0x70, 0x4a, 0xc3, 0x26, 0xda, 0xc1,
+	0x5a, 0xbb, 0x1f, 0xc6, 0x82, 0xcc, 0xb6, 0xa1, 0x95, 0x0b, 0xcb, 0x42, 0xb5, 0xa0, 0x9c, 0x0c,
+	0x07, 0x1f, 0xf2, 0xb1, 0x0d, 0x6d, 0x4c, 0x9f, 0x0d, 0x38, 0x39, 0x22, 0x44, 0x24, 0x50, 0xba,
+	0x61, 0x21, 0xd5, 0xb0, 0xfc, 0x05, 0x1c, 0xf7, 0xa5, 0x36, 0x3c, 0xd4, 0x31, 0xb1, 0x82, 0xb7,
+	0x7b, 0xb6, 0x26, 0xd6, 0xe0, 0x10, 0xe9, 0xdb, 0x38, 0x5b, 0xd6, 0x00, 0xe6, 0x8b, 0x8a, 0x34,
+	0xcd, 0xcd, 0x16, 0xd5, 0x47, 0xad, 0x81, 0x1f, 0x4a, 0x30, 0x53, 0x96, 0xa0, 0x3c, 0xee, 0x9d,
+	0xa7, 0xfb, 0x07, 0x81, 0x55, 0xc1, 0x7d, 0x4c, 0xd7, 0x91, 0x89, 0x6c, 0x15, 0x37, 0x4d, 0xec,
+	0xd2, 0x83, 0x25, 0x27, 0x2e, 0xc2, 0x3c, 0xed, 0xba, 0xd8, 0xeb, 0x12, 0x53, 0x63, 0x49, 0x2f,
+	0x28, 0xc3, 0x17, 0x31, 0xea, 0x8b, 0x20, 0xed, 0x66, 0xc7, 0xc9, 0xff, 0x9a, 0x63, 0xe4, 0x3f,
+	0xb0, 0xfd, 0xc0, 0x9a, 0x3d, 0x4a, 0xb6, 0x88, 0xf3, 0xc0, 0x39, 0x60, 0xf2, 0xd7, 0xa0, 0xe8,
+	0x91, 0x9e, 0xab, 0xe2, 0xb0, 0x5c, 0xd3, 0xf1, 0x21, 0x4e, 0xec, 0x40, 0x49, 0x45, 0x4e, 0xdb,
+	0xc1, 0xae, 0xff, 0x67, 0x90, 0xa0, 0x06, 0xdf, 0xb6, 0xd0, 0x8f, 0xa8, 0xc8, 0xd9, 0xc4, 0xee,
+	0x26, 0xb3, 0x28, 0x9e, 0x86, 0x62, 0x68, 0xfb, 0x10, 0xd3, 0x33, 0x7c, 0x12, 0xcf, 0x03, 0x58,
+	0x86, 0xdd, 0x76, 0x7b, 0x76, 0x1f, 0xed, 0x94, 0x8b, 0x81, 0xd6, 0x96, 0x61, 0x2b, 0xec, 0x45,
+	0x82, 0xd6, 0x63, 0x62, 0x72, 0xad, 0xbf, 0x64, 0xab, 0x21, 0x2c, 0xa2, 0x7f, 0x49, 0xeb, 0x18,
+	0xbd, 0xf3, 0x70, 0x2e, 0x81, 0x00, 0xe7, 0xf7, 0x34, 0x0f, 0x0b, 0x41, 0xa9, 0xdc, 0x0f, 0xcf,
+	0xbb, 0x75, 0x76, 0xdc, 0x1d, 0x70, 0x35, 0x6c, 0xc3, 0x71, 0x0b, 0x0d, 0xda, 0xa4, 0x47, 0xb7,
+	0x4d, 0xd2, 0x6f, 0xbb, 0x88, 0xe2, 0x77, 0xb2, 0x8d, 0x95, 0x2c, 0x34, 0xb8, 0x17, 0x18, 0x55,
+	0x10, 0xc5, 0xa2, 0x05, 0x0b, 0xbe, 0x1f, 0x76, 0x96, 0xbf, 0xeb, 0x4a, 0x3a, 0x61, 0xa1, 0x01,
+	0x13, 0x6d, 0x58, 0x4e, 0x6b, 0xb1, 0x72, 0x2a, 0x35, 0xe4, 0xa4, 0x13, 0x3d, 0x12, 0x3a, 0x98,
+	0x13, 0x95, 0x5c, 0x2c, 0x69, 0x15, 0x58, 0x4c, 0x4a, 0x0a, 0xcf, 0xda, 0x2f, 0x02, 0xc8, 0x2d,
+	0x4f, 0xdf, 0x72, 0x91, 0xed, 0x6d, 0x63, 0x37, 0x7e, 0xf4, 0xde, 0xf3, 0x4d, 0x78, 0x5d, 0xe3,
+	0xa0, 0x57, 0xf4, 0xff, 0x60, 0xde, 0xc6, 0xfd, 0x76, 0xe0, 0x21, 0x6b, 0x51, 0xcf, 0xd9, 0xb8,
+	0xcf, 0x88, 0xc5, 0xe2, 0xbc, 0x0a, 0x2b, 0xd9, 0x61, 0xf0, 0xa8, 0xbf, 0x13, 0x60, 0xb9, 0xe5,
+	0xe9, 0x4d, 0x55, 0xc5, 0x0e, 0x4d, 0x8b, 0x39, 0xc6, 0x4a, 0x98, 0x96, 0xd5, 0x1e, 0x17, 0x58,
+	0xd0, 0x7f, 0x71, 0x3f, 0xf2, 0x0a, 0x54, 0xb3, 0x88, 0xf1, 0x28, 0x6c, 0xb6, 0x5f, 0xdc, 0xf1,
+	0xb7, 0x65, 0xf3, 0xee, 0xf8, 0xa1, 0xb7, 0xaf, 0xbe, 0xa1, 0x04, 0x39, 0x43, 0x63, 0xcc, 0x0b,
+	0x4a, 0xce, 0x18, 0x3f, 0x8f, 0x2f, 0xb2, 0x52, 0x49, 0xf1, 0xc7, 0x59, 0x7d, 0x2b, 0xc0, 0xe9,
+	0x91, 0x54, 0x28, 0x18, 0x69, 0x1f, 0x93, 0x9e, 0xda, 0xc5, 0x6c, 0xe7, 0xf6, 0x0f, 0x98, 0x29,
+	0xe4, 0x0c, 0x71, 0xe3, 0x84, 0xc2, 0x06, 0x2e, 0x9f, 0xdd, 0xc0, 0xad, 0x1d, 0xf6, 0xa9, 0x87,
+	0x66, 0xe4, 0x65, 0xa8, 0x24, 0x53, 0xe2, 0xac, 0x7f, 0x16, 0x46, 0x1a, 0xd0, 0x56, 0xd8, 0xf4,
+	0xbf, 0x7d, 0x97, 0xeb, 0x87, 0xcc, 0x12, 0xeb, 0x77, 0xe6, 0xf9, 0xc9, 0x21, 0x07, 0xb8, 0xdd,
+	0x67, 0xf3, 0xd1, 0xd1, 0xb3, 0x39, 0x9e, 0x91, 0x87, 0x70, 0x39, 0x83, 0x34, 0xef, 0x9a, 0xa2,
+	0xb2, 0x14, 0xa6, 0x29, 0x4b, 0xf9, 0xc7, 0x3c, 0x6b, 0x59, 0x36, 0x5d, 0xe2, 0x10, 0x8f, 0x9b,
+	0x6e, 0xaa, 0xd4, 0x20, 0xb6, 0x78, 0x03, 0xe6, 0x9c, 0x60, 0x60, 0x8a, 0x75, 0x11, 0x21, 0xc5,
+	0x26, 0x1c, 0x0b, 0xf7, 0xad, 0x36, 0x0a, 0xb8, 0x65, 0x2e, 0x91, 0x92, 0x13, 0x4f, 0xc0, 0x6d,
+	0x28, 0x22, 0x46, 0x81, 0xe9, 0x52, 0x6a, 0x5c, 0x4a, 0xda, 0x14, 0xe3, 0x64, 0xb7, 0x76, 0x1c,
+	0xac, 0x84, 0xb3, 0xc2, 0xea, 0x29, 0x4c, 0xd1, 0xfe, 0xdf, 0xe1, 0x2d, 0xf1, 0x21, 0x86, 0xbe,
+	0x12, 0xee, 0xef, 0xa7, 0x82, 0x19, 0x9e, 0xf6, 0xa8, 0x66, 0x90, 0xba, 0x85, 0x68, 0x77, 0x42,
+	0x07, 0x7c, 0x0d, 0x8a, 0x1d, 0xc3, 0xf6, 0xcb, 0xbd, 0x98, 0x55, 0xee, 0x01, 0x4e, 0x2c, 0xc3,
+	0x2c, 0x32, 0x4d, 0xd2, 0xc7, 0x5a, 0x79, 0x76, 0x59, 0xa8, 0xce, 0x29, 0xd1, 0xe3, 0xda, 0x51,
+	0x3f, 0xef, 0x5c, 0x4c, 0xf9, 0x43, 0xb6, 0x7f, 0x25, 0xa6, 0x87, 0x67, 0x3c, 0x58, 0x3b, 0x02,
+	0x5f, 0x3b, 0x12, 0xcc, 0xe1, 0x01, 0x56, 0x7b, 0x14, 0x07, 0x2b, 0x6a, 0x4e, 0xe1, 0xcf, 0xf2,
+	0x33, 0x81, 0xed, 0x25, 0x4d, 0xc7, 0x71, 0xc9, 0xe3, 0x61, 0x29, 0x31, 0xfb, 0xc8, 0xf4, 0x33,
+	0x8e, 0x82, 0xa1, 0x29, 0x32, 0x1e, 0x21, 0xdf, 0x45, 0xc6, 0x83, 0x18, 0xf2, 0x7c, 0x43, 0x0a,
+	0x64, 0x88, 0x3c, 0xc8, 0xef, 0xb3, 0x1d, 0x29, 0x85, 0x35, 0x17, 0x62, 0x34, 0x70, 0x21, 0x1e,
+	0x78, 0xe3, 0xaf, 0x12, 0xe4, 0x5b, 0x9e, 0x2e, 0x7e, 0x06, 0x47, 0x62, 0x37, 0xf1, 0xff, 0x24,
+	0x96, 0x56, 0xfc, 0x66, 0x2c, 0x5d, 0x99, 0x02, 0xc4, 0x59, 0x0c, 0x60, 0x21, 0xf1, 0xee, 0x9c,
+	0x66, 0x24, 0x09, 0x2c, 0x5d, 0xdf, 0x03, 0x98, 0x7b, 0xfe, 0x08, 0x66, 0xa3, 0x4b, 0x73, 0x25,
+	0x65, 0x7e, 0x38, 0x2e, 0x5d, 0x9a, 0x3c, 0xce, 0x4d, 0x6e, 0xc1, 0x1c, 0xbf, 0xa0, 0x2e, 0xa5,
+	0xcc, 0x89, 0x00, 0xd2, 0xe5, 0x0c, 0x00, 0xb7, 0xaa, 0xc2, 0xd1, 0xf8, 0x15, 0xed, 0x62, 0x1a,
+	0x9d, 0x51, 0x94, 0x74, 0x75, 0x1a, 0x14, 0x77, 0x62, 0xc0, 0xb1, 0xf1, 0xcb, 0x56, 0x5a, 0xd4,
+	0x63, 0x38, 0xa9, 0x36, 0x1d, 0x6e, 0xd4, 0xd5, 0xf8, 0xd5, 0x28, 0xcd, 0xd5, 0x18, 0x2e, 0xd5,
+	0x55, 0xca, 0xed, 0x40, 0x34, 0xe1, 0xf8, 0xae, 0xab, 0xc1, 0xe5, 0xc9, 0xba, 0x0c, 0x9d, 0xd5,
+	0xa7, 0x04, 0x72, 0x6f, 0x04, 0x4e, 0xec, 0xee, 0xf3, 0xab, 0xe9, 0xea, 0xc4, 0x91, 0xd2, 0xb5,
+	0x69, 0x91, 0xdc, 0xe1, 0xf7, 0x02, 0x2c, 0x65, 0xf5, 0xa8, 0x37, 0x53, 0xac, 0x66, 0xcc, 0x93,
+	0x6e, 0xef, 0x6f, 0x1e, 0xe7, 0xf6, 0x54, 0x80, 0xf3, 0x93, 0x3b, 0xc9, 0x1b, 0x29, 0x1e, 0x26,
+	0xce, 0x92, 0x6e, 0xed, 0x67, 0x16, 0x67, 0xf5, 0x95, 0x00, 0x67, 0xd2, 0x5a, 0xc3, 0xb4, 0xe2,
+	0x4a, 0xc1, 0x4b, 0x37, 0xf7, 0x86, 0xe7, 0x1c, 0x7a, 0x70, 0x32, 0xa9, 0x0d, 0x5c, 0xc9, 0x10,
+	0x7c, 0x04, 0x2b, 0x35, 0xa6, 0xc7, 0x72, 0xb7, 0x4f, 0x04, 0x58, 0x9c, 0xd8, 0xc8, 0x4d, 0xde,
+	0x45, 0x93, 0x27, 0x49, 0xef, 0xed, 0x63, 0x12, 0xa7, 0xf4, 0x39, 0x9c, 0x4a, 0xee, 0xa5, 0xd2,
+	0xf6, 0xae, 0x44, 0xb4, 0x74, 0x63, 0x2f, 0xe8, 0x58, 0x29, 0xa4, 0x9d, 0xec, 0x69, 0xa5, 0x90,
+	0x82, 0x4f, 0x2d, 0x85, 0x8c, 0x33, 0x78, 0x7d, 0xe3, 0xf9, 0xeb, 0x8a, 0xf0, 0xe2, 0x75, 0x45,
+	0xf8, 0xf3, 0x75, 0x45, 0x78, 0xf2, 0xa6, 0x32, 0xf3, 0xe2, 0x4d, 0x65, 0xe6, 0xf7, 0x37, 0x95,
+	0x99, 0x4f, 0xea, 0x23, 0x57, 0xe6, 0x8e, 0xdd, 0x59, 0x55, 0xbb, 0xc8, 0xb0, 0xeb, 0x23, 0x5f,
+	0xa3, 0x0f, 0x86, 0xbf, 0x03, 0xf8, 0xf7, 0xe7, 0x4e, 0x91, 0x7d, 0x7f, 0x7e, 0xfd, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xa3, 0xb1, 0xb4, 0x2e, 0x2a, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/payment module parameters.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreatePaymentAccount(ctx context.Context, in *MsgCreatePaymentAccount, opts ...grpc.CallOption) (*MsgCreatePaymentAccountResponse, error)
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	DisableRefund(ctx context.Context, in *MsgDisableRefund, opts ...grpc.CallOption) (*MsgDisableRefundResponse, error)
+	SetBalanceAlert(ctx context.Context, in *MsgSetBalanceAlert, opts ...grpc.CallOption) (*MsgSetBalanceAlertResponse, error)
+	EnableAutoTopUp(ctx context.Context, in *MsgEnableAutoTopUp, opts ...grpc.CallOption) (*MsgEnableAutoTopUpResponse, error)
+	DisableAutoTopUp(ctx context.Context, in *MsgDisableAutoTopUp, opts ...grpc.CallOption) (*MsgDisableAutoTopUpResponse, error)
+	SetSpendingBudget(ctx context.Context, in *MsgSetSpendingBudget, opts ...grpc.CallOption) (*MsgSetSpendingBudgetResponse, error)
+	TransferPaymentAccountOwnership(ctx context.Context, in *MsgTransferPaymentAccountOwnership, opts ...grpc.CallOption) (*MsgTransferPaymentAccountOwnershipResponse, error)
+	AcceptPaymentAccountOwnership(ctx context.Context, in *MsgAcceptPaymentAccountOwnership, opts ...grpc.CallOption) (*MsgAcceptPaymentAccountOwnershipResponse, error)
+	CancelDelayedWithdrawal(ctx context.Context, in *MsgCancelDelayedWithdrawal, opts ...grpc.CallOption) (*MsgCancelDelayedWithdrawalResponse, error)
+	TransferReadVoucher(ctx context.Context, in *MsgTransferReadVoucher, opts ...grpc.CallOption) (*MsgTransferReadVoucherResponse, error)
+	CreateMultisigPaymentAccount(ctx context.Context, in *MsgCreateMultisigPaymentAccount, opts ...grpc.CallOption) (*MsgCreateMultisigPaymentAccountResponse, error)
+	ProposeMultisigAction(ctx context.Context, in *MsgProposeMultisigAction, opts ...grpc.CallOption) (*MsgProposeMultisigActionResponse, error)
+	ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposal, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePaymentAccount(ctx context.Context, in *MsgCreatePaymentAccount, opts ...grpc.CallOption) (*MsgCreatePaymentAccountResponse, error) {
+	out := new(MsgCreatePaymentAccountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CreatePaymentAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableRefund(ctx context.Context, in *MsgDisableRefund, opts ...grpc.CallOption) (*MsgDisableRefundResponse, error) {
+	out := new(MsgDisableRefundResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/DisableRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBalanceAlert(ctx context.Context, in *MsgSetBalanceAlert, opts ...grpc.CallOption) (*MsgSetBalanceAlertResponse, error) {
+	out := new(MsgSetBalanceAlertResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/SetBalanceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableAutoTopUp(ctx context.Context, in *MsgEnableAutoTopUp, opts ...grpc.CallOption) (*MsgEnableAutoTopUpResponse, error) {
+	out := new(MsgEnableAutoTopUpResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/EnableAutoTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableAutoTopUp(ctx context.Context, in *MsgDisableAutoTopUp, opts ...grpc.CallOption) (*MsgDisableAutoTopUpResponse, error) {
+	out := new(MsgDisableAutoTopUpResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/DisableAutoTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendingBudget(ctx context.Context, in *MsgSetSpendingBudget, opts ...grpc.CallOption) (*MsgSetSpendingBudgetResponse, error) {
+	out := new(MsgSetSpendingBudgetResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/SetSpendingBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferPaymentAccountOwnership(ctx context.Context, in *MsgTransferPaymentAccountOwnership, opts ...grpc.CallOption) (*MsgTransferPaymentAccountOwnershipResponse, error) {
+	out := new(MsgTransferPaymentAccountOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/TransferPaymentAccountOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPaymentAccountOwnership(ctx context.Context, in *MsgAcceptPaymentAccountOwnership, opts ...grpc.CallOption) (*MsgAcceptPaymentAccountOwnershipResponse, error) {
+	out := new(MsgAcceptPaymentAccountOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/AcceptPaymentAccountOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDelayedWithdrawal(ctx context.Context, in *MsgCancelDelayedWithdrawal, opts ...grpc.CallOption) (*MsgCancelDelayedWithdrawalResponse, error) {
+	out := new(MsgCancelDelayedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CancelDelayedWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferReadVoucher(ctx context.Context, in *MsgTransferReadVoucher, opts ...grpc.CallOption) (*MsgTransferReadVoucherResponse, error) {
+	out := new(MsgTransferReadVoucherResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/TransferReadVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMultisigPaymentAccount(ctx context.Context, in *MsgCreateMultisigPaymentAccount, opts ...grpc.CallOption) (*MsgCreateMultisigPaymentAccountResponse, error) {
+	out := new(MsgCreateMultisigPaymentAccountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/CreateMultisigPaymentAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeMultisigAction(ctx context.Context, in *MsgProposeMultisigAction, opts ...grpc.CallOption) (*MsgProposeMultisigActionResponse, error) {
+	out := new(MsgProposeMultisigActionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/ProposeMultisigAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposal, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error) {
+	out := new(MsgApproveMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Msg/ApproveMultisigProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/payment module parameters.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreatePaymentAccount(context.Context, *MsgCreatePaymentAccount) (*MsgCreatePaymentAccountResponse, error)
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	DisableRefund(context.Context, *MsgDisableRefund) (*MsgDisableRefundResponse, error)
+	SetBalanceAlert(context.Context, *MsgSetBalanceAlert) (*MsgSetBalanceAlertResponse, error)
+	EnableAutoTopUp(context.Context, *MsgEnableAutoTopUp) (*MsgEnableAutoTopUpResponse, error)
+	DisableAutoTopUp(context.Context, *MsgDisableAutoTopUp) (*MsgDisableAutoTopUpResponse, error)
+	SetSpendingBudget(context.Context, *MsgSetSpendingBudget) (*MsgSetSpendingBudgetResponse, error)
+	TransferPaymentAccountOwnership(context.Context, *MsgTransferPaymentAccountOwnership) (*MsgTransferPaymentAccountOwnershipResponse, error)
+	AcceptPaymentAccountOwnership(context.Context, *MsgAcceptPaymentAccountOwnership) (*MsgAcceptPaymentAccountOwnershipResponse, error)
+	CancelDelayedWithdrawal(context.Context, *MsgCancelDelayedWithdrawal) (*MsgCancelDelayedWithdrawalResponse, error)
+	TransferReadVoucher(context.Context, *MsgTransferReadVoucher) (*MsgTransferReadVoucherResponse, error)
+	CreateMultisigPaymentAccount(context.Context, *MsgCreateMultisigPaymentAccount) (*MsgCreateMultisigPaymentAccountResponse, error)
+	ProposeMultisigAction(context.Context, *MsgProposeMultisigAction) (*MsgProposeMultisigActionResponse, error)
+	ApproveMultisigProposal(context.Context, *MsgApproveMultisigProposal) (*MsgApproveMultisigProposalResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreatePaymentAccount(ctx context.Context, req *MsgCreatePaymentAccount) (*MsgCreatePaymentAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentAccount not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) DisableRefund(ctx context.Context, req *MsgDisableRefund) (*MsgDisableRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableRefund not implemented")
+}
+func (*UnimplementedMsgServer) SetBalanceAlert(ctx context.Context, req *MsgSetBalanceAlert) (*MsgSetBalanceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBalanceAlert not implemented")
+}
+func (*UnimplementedMsgServer) EnableAutoTopUp(ctx context.Context, req *MsgEnableAutoTopUp) (*MsgEnableAutoTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAutoTopUp not implemented")
+}
+func (*UnimplementedMsgServer) DisableAutoTopUp(ctx context.Context, req *MsgDisableAutoTopUp) (*MsgDisableAutoTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAutoTopUp not implemented")
+}
+func (*UnimplementedMsgServer) SetSpendingBudget(ctx context.Context, req *MsgSetSpendingBudget) (*MsgSetSpendingBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingBudget not implemented")
+}
+func (*UnimplementedMsgServer) TransferPaymentAccountOwnership(ctx context.Context, req *MsgTransferPaymentAccountOwnership) (*MsgTransferPaymentAccountOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPaymentAccountOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptPaymentAccountOwnership(ctx context.Context, req *MsgAcceptPaymentAccountOwnership) (*MsgAcceptPaymentAccountOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPaymentAccountOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CancelDelayedWithdrawal(ctx context.Context, req *MsgCancelDelayedWithdrawal) (*MsgCancelDelayedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) TransferReadVoucher(ctx context.Context, req *MsgTransferReadVoucher) (*MsgTransferReadVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferReadVoucher not implemented")
+}
+func (*UnimplementedMsgServer) CreateMultisigPaymentAccount(ctx context.Context, req *MsgCreateMultisigPaymentAccount) (*MsgCreateMultisigPaymentAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigPaymentAccount not implemented")
+}
+func (*UnimplementedMsgServer) ProposeMultisigAction(ctx context.Context, req *MsgProposeMultisigAction) (*MsgProposeMultisigActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeMultisigAction not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMultisigProposal(ctx context.Context, req *MsgApproveMultisigProposal) (*MsgApproveMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMultisigProposal not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/CreatePaymentAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentAccount(ctx, req.(*MsgCreatePaymentAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/DisableRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableRefund(ctx, req.(*MsgDisableRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBalanceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBalanceAlert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBalanceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/SetBalanceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBalanceAlert(ctx, req.(*MsgSetBalanceAlert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableAutoTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableAutoTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableAutoTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/EnableAutoTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableAutoTopUp(ctx, req.(*MsgEnableAutoTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableAutoTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableAutoTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableAutoTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/DisableAutoTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableAutoTopUp(ctx, req.(*MsgDisableAutoTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendingBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendingBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendingBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/SetSpendingBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendingBudget(ctx, req.(*MsgSetSpendingBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferPaymentAccountOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPaymentAccountOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPaymentAccountOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/TransferPaymentAccountOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPaymentAccountOwnership(ctx, req.(*MsgTransferPaymentAccountOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPaymentAccountOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPaymentAccountOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPaymentAccountOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/AcceptPaymentAccountOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPaymentAccountOwnership(ctx, req.(*MsgAcceptPaymentAccountOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDelayedWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDelayedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/CancelDelayedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDelayedWithdrawal(ctx, req.(*MsgCancelDelayedWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferReadVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferReadVoucher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferReadVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/TransferReadVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferReadVoucher(ctx, req.(*MsgTransferReadVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMultisigPaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMultisigPaymentAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMultisigPaymentAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/CreateMultisigPaymentAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMultisigPaymentAccount(ctx, req.(*MsgCreateMultisigPaymentAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeMultisigAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeMultisigAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeMultisigAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/ProposeMultisigAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeMultisigAction(ctx, req.(*MsgProposeMultisigAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMultisigProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMultisigProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Msg/ApproveMultisigProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMultisigProposal(ctx, req.(*MsgApproveMultisigProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.payment.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreatePaymentAccount",
+			Handler:    _Msg_CreatePaymentAccount_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "DisableRefund",
+			Handler:    _Msg_DisableRefund_Handler,
+		},
+		{
+			MethodName: "SetBalanceAlert",
+			Handler:    _Msg_SetBalanceAlert_Handler,
+		},
+		{
+			MethodName: "EnableAutoTopUp",
+			Handler:    _Msg_EnableAutoTopUp_Handler,
+		},
+		{
+			MethodName: "DisableAutoTopUp",
+			Handler:    _Msg_DisableAutoTopUp_Handler,
+		},
+		{
+			MethodName: "SetSpendingBudget",
+			Handler:    _Msg_SetSpendingBudget_Handler,
+		},
+		{
+			MethodName: "TransferPaymentAccountOwnership",
+			Handler:    _Msg_TransferPaymentAccountOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptPaymentAccountOwnership",
+			Handler:    _Msg_AcceptPaymentAccountOwnership_Handler,
+		},
+		{
+			MethodName: "CancelDelayedWithdrawal",
+			Handler:    _Msg_CancelDelayedWithdrawal_Handler,
+		},
+		{
+			MethodName: "TransferReadVoucher",
+			Handler:    _Msg_TransferReadVoucher_Handler,
+		},
+		{
+			MethodName: "CreateMultisigPaymentAccount",
+			Handler:    _Msg_CreateMultisigPaymentAccount_Handler,
+		},
+		{
+			MethodName: "ProposeMultisigAction",
+			Handler:    _Msg_ProposeMultisigAction_Handler,
+		},
+		{
+			MethodName: "ApproveMultisigProposal",
+			Handler:    _Msg_ApproveMultisigProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/payment/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayedWithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayedWithdrawalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayedWithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayedWithdrawalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBalanceAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBalanceAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBalanceAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBalanceAlertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBalanceAlertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBalanceAlertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAutoTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAutoTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAutoTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinRunway != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinRunway))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CapPerPeriod.Size()
+		i -= size
+		if _, err := m.CapPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAutoTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAutoTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAutoTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAutoTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAutoTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAutoTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAutoTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAutoTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAutoTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendingBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendingBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendingBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxSpendPerPeriod.Size()
+		i -= size
+		if _, err := m.MaxSpendPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxOutflowRate.Size()
+		i -= size
+		if _, err := m.MaxOutflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendingBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendingBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendingBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPaymentAccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPaymentAccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPaymentAccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPaymentAccountOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPaymentAccountOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPaymentAccountOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPaymentAccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPaymentAccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPaymentAccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPaymentAccountOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPaymentAccountOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPaymentAccountOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferReadVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferReadVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferReadVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferReadVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferReadVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferReadVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultisigPaymentAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultisigPaymentAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultisigPaymentAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultisigPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultisigPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultisigPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeMultisigAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeMultisigAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeMultisigAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Binder) > 0 {
+		i -= len(m.Binder)
+		copy(dAtA[i:], m.Binder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Binder)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeMultisigActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeMultisigActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeMultisigActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMultisigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMultisigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMultisigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePaymentAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePaymentAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DelayedWithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.DelayedWithdrawalId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedWithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.DelayedWithdrawalId))
+	}
+	return n
+}
+
+func (m *MsgDisableRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBalanceAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetBalanceAlertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableAutoTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CapPerPeriod.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	if m.MinRunway != 0 {
+		n += 1 + sovTx(uint64(m.MinRunway))
+	}
+	return n
+}
+
+func (m *MsgEnableAutoTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableAutoTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableAutoTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSpendingBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxOutflowRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSpendPerPeriod.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *MsgSetSpendingBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferPaymentAccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferPaymentAccountOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptPaymentAccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptPaymentAccountOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDelayedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDelayedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferReadVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferReadVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMultisigPaymentAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgCreateMultisigPaymentAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeMultisigAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Binder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgProposeMultisigActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApproveMultisigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveMultisigProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawalId", wireType)
+			}
+			m.DelayedWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedWithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawalId", wireType)
+			}
+			m.DelayedWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedWithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBalanceAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBalanceAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBalanceAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBalanceAlertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBalanceAlertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBalanceAlertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableAutoTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAutoTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAutoTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapPerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CapPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRunway", wireType)
+			}
+			m.MinRunway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRunway |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableAutoTopUpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAutoTopUpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAutoTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableAutoTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableAutoTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableAutoTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableAutoTopUpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableAutoTopUpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableAutoTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendingBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendingBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendingBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpendPerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpendPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= SpendingPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendingBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendingBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendingBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferPaymentAccountOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPaymentAccountOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPaymentAccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferPaymentAccountOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPaymentAccountOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPaymentAccountOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDisableRefundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptPaymentAccountOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1252,15 +6044,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptPaymentAccountOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptPaymentAccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1288,13 +6080,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1304,25 +6096,175 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPaymentAccountOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPaymentAccountOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPaymentAccountOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDelayedWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1344,7 +6286,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,10 +6309,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1394,7 +6336,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferReadVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,19 +6355,70 @@ func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferReadVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferReadVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1453,7 +6446,7 @@ func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1476,7 +6469,7 @@ func (m *MsgCreatePaymentAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferReadVoucherResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,10 +6492,10 @@ func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferReadVoucherResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferReadVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1526,7 +6519,7 @@ func (m *MsgCreatePaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMultisigPaymentAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,10 +6542,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMultisigPaymentAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMultisigPaymentAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1589,7 +6582,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1617,13 +6610,13 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1633,26 +6626,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1674,7 +6652,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMultisigPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1697,12 +6675,44 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMultisigPaymentAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMultisigPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1724,7 +6734,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeMultisigAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,15 +6757,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeMultisigAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeMultisigAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1783,11 +6793,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1815,9 +6825,60 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= MultisigActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1851,6 +6912,58 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1872,7 +6985,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeMultisigActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,12 +7008,51 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeMultisigActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeMultisigActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1922,7 +7074,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveMultisigProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,15 +7097,15 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableRefund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveMultisigProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveMultisigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1981,11 +7133,11 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Approver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2013,8 +7165,27 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2036,7 +7207,7 @@ func (m *MsgDisableRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableRefundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveMultisigProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2059,12 +7230,32 @@ func (m *MsgDisableRefundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableRefundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveMultisigProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
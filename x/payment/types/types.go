@@ -16,6 +16,17 @@ const (
 	ForceUpdateStreamRecordKey = "force_update_stream_record"
 )
 
+const (
+	// BucketBillRetention is how long the bucket bills are kept for the billing statements, in seconds
+	BucketBillRetention int64 = 90 * 24 * 60 * 60
+	// MultisigProposalLifetime is how long a proposal of a multisig payment account can be approved, in seconds
+	MultisigProposalLifetime int64 = 7 * 24 * 60 * 60
+	// MaxExpiredReadVouchersPerBlock bounds the expired read vouchers removed and refunded in each end block
+	MaxExpiredReadVouchersPerBlock uint64 = 100
+	// MaxExpiredMultisigProposalsPerBlock bounds the expired multisig proposals removed in each end block
+	MaxExpiredMultisigProposalsPerBlock uint64 = 100
+)
+
 const (
 	// GovernanceAddressLackBalanceLabel is the metrics label to notify that the governance account has no enough balance
 	GovernanceAddressLackBalanceLabel = "governance_address_lack_balance"
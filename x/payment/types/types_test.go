@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -23,3 +24,15 @@ func TestStreamRecordChange(t *testing.T) {
 	src3 := NewDefaultStreamRecordChangeWithAddr(addr).WithRateChange(sdkmath.ZeroInt()).WithStaticBalanceChange(sdkmath.NewIntFromUint64(111))
 	t.Logf("src3: %+v", src3)
 }
+
+func TestSpendingPeriodRange(t *testing.T) {
+	timestamp := time.Date(2024, 2, 29, 13, 14, 15, 0, time.UTC).Unix()
+
+	start, end := SPENDING_PERIOD_DAILY.PeriodRange(timestamp)
+	require.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC).Unix(), start)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Unix(), end)
+
+	start, end = SPENDING_PERIOD_MONTHLY.PeriodRange(timestamp)
+	require.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), start)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Unix(), end)
+}
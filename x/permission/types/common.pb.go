@@ -172,6 +172,9 @@ type Statement struct {
 	ExpirationTime *time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 	// limit_size defines the total data size that is allowed to operate. If not explicitly specified, it means it will not limit.
 	LimitSize *common.UInt64Value `protobuf:"bytes,5,opt,name=limit_size,json=limitSize,proto3" json:"limit_size,omitempty"`
+	// condition defines the extra conditions that must all be satisfied for the statement to take effect.
+	// If not explicitly specified, the statement is evaluated without conditions.
+	Condition *StatementCondition `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
 }
 
 func (m *Statement) Reset()         { *m = Statement{} }
@@ -242,6 +245,96 @@ func (m *Statement) GetLimitSize() *common.UInt64Value {
 	return nil
 }
 
+func (m *Statement) GetCondition() *StatementCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+// StatementCondition defines the IAM-style condition keys of a statement. Every specified key must be matched,
+// otherwise the statement is ignored during evaluation.
+type StatementCondition struct {
+	// not_before defines the block time from which the statement takes effect.
+	NotBefore *time.Time `protobuf:"bytes,1,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	// not_after defines the block time after which the statement no longer takes effect.
+	NotAfter *time.Time `protobuf:"bytes,2,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after,omitempty"`
+	// content_types defines the allowed content types of the object, e.g. "image/png" or "image/*".
+	ContentTypes []string `protobuf:"bytes,3,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	// object_name_prefixes defines the allowed prefixes of the object name.
+	ObjectNamePrefixes []string `protobuf:"bytes,4,rep,name=object_name_prefixes,json=objectNamePrefixes,proto3" json:"object_name_prefixes,omitempty"`
+	// max_payload_size defines the maximum payload size of the object in bytes.
+	MaxPayloadSize *common.UInt64Value `protobuf:"bytes,5,opt,name=max_payload_size,json=maxPayloadSize,proto3" json:"max_payload_size,omitempty"`
+}
+
+func (m *StatementCondition) Reset()         { *m = StatementCondition{} }
+func (m *StatementCondition) String() string { return proto.CompactTextString(m) }
+func (*StatementCondition) ProtoMessage()    {}
+func (*StatementCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33a4d646aee30990, []int{1}
+}
+func (m *StatementCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatementCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatementCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatementCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementCondition.Merge(m, src)
+}
+func (m *StatementCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatementCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatementCondition proto.InternalMessageInfo
+
+func (m *StatementCondition) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *StatementCondition) GetNotAfter() *time.Time {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
+func (m *StatementCondition) GetContentTypes() []string {
+	if m != nil {
+		return m.ContentTypes
+	}
+	return nil
+}
+
+func (m *StatementCondition) GetObjectNamePrefixes() []string {
+	if m != nil {
+		return m.ObjectNamePrefixes
+	}
+	return nil
+}
+
+func (m *StatementCondition) GetMaxPayloadSize() *common.UInt64Value {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return nil
+}
+
 // Principal define the roles that can be grant permissions to. Currently, it can be account or group.
 type Principal struct {
 	Type PrincipalType `protobuf:"varint,1,opt,name=type,proto3,enum=greenfield.permission.PrincipalType" json:"type,omitempty"`
@@ -256,7 +349,7 @@ func (m *Principal) Reset()         { *m = Principal{} }
 func (m *Principal) String() string { return proto.CompactTextString(m) }
 func (*Principal) ProtoMessage()    {}
 func (*Principal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33a4d646aee30990, []int{1}
+	return fileDescriptor_33a4d646aee30990, []int{2}
 }
 func (m *Principal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,6 +397,7 @@ func init() {
 	proto.RegisterEnum("greenfield.permission.Effect", Effect_name, Effect_value)
 	proto.RegisterEnum("greenfield.permission.PrincipalType", PrincipalType_name, PrincipalType_value)
 	proto.RegisterType((*Statement)(nil), "greenfield.permission.Statement")
+	proto.RegisterType((*StatementCondition)(nil), "greenfield.permission.StatementCondition")
 	proto.RegisterType((*Principal)(nil), "greenfield.permission.Principal")
 }
 
@@ -312,52 +406,61 @@ func init() {
 }
 
 var fileDescriptor_33a4d646aee30990 = []byte{
-	// 710 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4b, 0x4e, 0xdb, 0x40,
-	0x18, 0x8e, 0x93, 0x10, 0xc8, 0x00, 0xc1, 0x1d, 0xa0, 0x35, 0x29, 0x38, 0x29, 0xea, 0x22, 0x42,
-	0x6a, 0x2c, 0xa5, 0x0f, 0x55, 0xea, 0xca, 0x71, 0x26, 0xc8, 0xad, 0xb1, 0x2d, 0xc7, 0x69, 0xa1,
-	0x5d, 0x58, 0x8e, 0x3b, 0x09, 0x23, 0xc5, 0x0f, 0xd9, 0x4e, 0x0b, 0x9c, 0xa0, 0x4b, 0xee, 0xd0,
-	0x13, 0xf4, 0x10, 0x95, 0x58, 0xb2, 0xec, 0xaa, 0xad, 0xe0, 0x22, 0x95, 0x1f, 0x79, 0x95, 0xb0,
-	0xf3, 0x7c, 0xaf, 0xf9, 0xff, 0xdf, 0x33, 0x03, 0xf6, 0x07, 0x3e, 0xc6, 0x4e, 0x9f, 0xe0, 0xe1,
-	0x67, 0xce, 0xc3, 0xbe, 0x4d, 0x82, 0x80, 0xb8, 0x0e, 0x67, 0xb9, 0xb6, 0xed, 0x3a, 0x75, 0xcf,
-	0x77, 0x43, 0x17, 0x6e, 0x4f, 0x35, 0xf5, 0xa9, 0xa6, 0xbc, 0x63, 0xb9, 0x81, 0xed, 0x06, 0x46,
-	0x2c, 0xe2, 0x92, 0x45, 0xe2, 0x28, 0x6f, 0x0d, 0xdc, 0x81, 0x9b, 0xe0, 0xd1, 0x57, 0x8a, 0x56,
-	0x06, 0xae, 0x3b, 0x18, 0x62, 0x2e, 0x5e, 0xf5, 0x46, 0x7d, 0x2e, 0x24, 0x36, 0x0e, 0x42, 0xd3,
-	0xf6, 0x26, 0x82, 0x69, 0x31, 0x49, 0x05, 0xdc, 0x57, 0xdf, 0xf4, 0x3c, 0xec, 0x27, 0x82, 0xfd,
-	0x1f, 0x59, 0x50, 0xec, 0x84, 0x66, 0x88, 0x6d, 0xec, 0x84, 0xf0, 0x25, 0x28, 0xe0, 0x7e, 0x1f,
-	0x5b, 0x21, 0x43, 0x55, 0xa9, 0x5a, 0xa9, 0xb1, 0x57, 0x5f, 0x58, 0x68, 0x1d, 0xc5, 0x22, 0x2d,
-	0x15, 0xc3, 0x37, 0x60, 0xd9, 0xb4, 0x42, 0xe2, 0x3a, 0x01, 0x93, 0xad, 0xe6, 0x6a, 0xa5, 0xc6,
-	0x93, 0x7b, 0x7c, 0x7c, 0xac, 0xd2, 0xcf, 0x3d, 0xac, 0x8d, 0x1d, 0x70, 0x17, 0x14, 0x7d, 0x1c,
-	0xb8, 0x23, 0xdf, 0xc2, 0x01, 0x93, 0xab, 0xe6, 0x6a, 0x45, 0x6d, 0x0a, 0xc0, 0x23, 0xb0, 0x81,
-	0xcf, 0x3c, 0xe2, 0x9b, 0x91, 0xd8, 0x88, 0xda, 0x63, 0xf2, 0x55, 0xaa, 0xb6, 0xda, 0x28, 0xd7,
-	0x93, 0xde, 0xeb, 0xe3, 0xde, 0xeb, 0xfa, 0xb8, 0xf7, 0xe6, 0xca, 0xd5, 0xef, 0x0a, 0x75, 0xf9,
-	0xa7, 0x42, 0x69, 0xa5, 0xa9, 0x39, 0xa2, 0xa1, 0x00, 0xc0, 0x90, 0xd8, 0x24, 0x34, 0x02, 0x72,
-	0x81, 0x99, 0xa5, 0x38, 0x89, 0x9d, 0x2d, 0x36, 0xfd, 0x4d, 0x5d, 0xd1, 0x09, 0x5f, 0xbd, 0x78,
-	0x6f, 0x0e, 0x47, 0xb8, 0x99, 0x8f, 0xd2, 0xb4, 0x62, 0xec, 0xeb, 0x90, 0x0b, 0xbc, 0xff, 0x09,
-	0x14, 0x55, 0x9f, 0x38, 0x16, 0xf1, 0xcc, 0x21, 0x7c, 0x0d, 0xf2, 0xe1, 0xb9, 0x87, 0xd3, 0x81,
-	0x3d, 0xbd, 0xa7, 0xf1, 0x89, 0x3e, 0xee, 0x3d, 0x76, 0xc0, 0x2d, 0xb0, 0xf4, 0x25, 0xda, 0x80,
-	0xc9, 0x56, 0xa9, 0x5a, 0x51, 0x4b, 0x16, 0x07, 0x3f, 0x73, 0x00, 0x4c, 0xc7, 0x04, 0x1f, 0x02,
-	0xc8, 0x0b, 0xba, 0xa8, 0xc8, 0x46, 0x57, 0xee, 0xa8, 0x48, 0x10, 0xdb, 0x22, 0x6a, 0xd1, 0x19,
-	0xb8, 0x07, 0x76, 0xc6, 0xb8, 0xda, 0xe2, 0x75, 0x64, 0x34, 0xbb, 0xc2, 0x3b, 0xa4, 0x1b, 0xa2,
-	0xdc, 0x56, 0x68, 0x0a, 0x32, 0x60, 0x2b, 0xa5, 0x5b, 0x48, 0x42, 0x13, 0x9a, 0xce, 0xce, 0x30,
-	0x82, 0x86, 0x22, 0xa3, 0xd2, 0x7c, 0x8b, 0x04, 0x9d, 0xce, 0xdd, 0xf5, 0xa4, 0x4c, 0x7e, 0xa6,
-	0x08, 0x41, 0x51, 0x4f, 0xc6, 0xf8, 0x12, 0xdc, 0x06, 0x0f, 0x52, 0xfc, 0x10, 0xe9, 0x63, 0xb8,
-	0x00, 0x77, 0xc0, 0x76, 0x0a, 0xa3, 0x63, 0x24, 0x74, 0xa7, 0x49, 0xcb, 0x33, 0x49, 0x92, 0xd8,
-	0x99, 0x58, 0x56, 0x20, 0x0b, 0xca, 0xf3, 0xed, 0x1c, 0x6a, 0x4a, 0x57, 0x35, 0x8e, 0xd0, 0x51,
-	0x13, 0x69, 0x74, 0x11, 0x3e, 0x02, 0x9b, 0xf3, 0xb5, 0xc5, 0x3c, 0x0d, 0xee, 0xce, 0x21, 0x89,
-	0x4c, 0xe6, 0xb0, 0x7a, 0x97, 0x4e, 0x72, 0xd1, 0xb1, 0xae, 0xf1, 0xf4, 0x1a, 0xdc, 0x05, 0xcc,
-	0x22, 0x3a, 0x36, 0xaf, 0xc3, 0x2a, 0xd8, 0x5d, 0x98, 0x2d, 0x28, 0xb2, 0x8e, 0x64, 0x9d, 0x2e,
-	0xc1, 0x4d, 0xb0, 0x91, 0x2a, 0xf4, 0x13, 0x15, 0x19, 0xbc, 0x24, 0xd1, 0x56, 0x39, 0xff, 0xed,
-	0x3b, 0x9b, 0x39, 0x10, 0x41, 0x21, 0xb9, 0x25, 0x51, 0xcf, 0xa8, 0xdd, 0x8e, 0x8c, 0xf3, 0xbf,
-	0x90, 0x06, 0x6b, 0x29, 0xce, 0x4b, 0x92, 0xf2, 0x81, 0xa6, 0xe0, 0x06, 0x58, 0x4d, 0x91, 0x16,
-	0x92, 0x4f, 0xe8, 0x6c, 0x1a, 0x35, 0x02, 0xeb, 0x73, 0xe7, 0x27, 0x9a, 0x96, 0xaa, 0x89, 0xb2,
-	0x20, 0xaa, 0xbc, 0x94, 0xec, 0x3c, 0x9f, 0x5c, 0x01, 0x8f, 0xff, 0xe3, 0x0f, 0xe5, 0x76, 0xcb,
-	0xe0, 0x05, 0x41, 0xe9, 0xca, 0x3a, 0x4d, 0x45, 0x63, 0x59, 0x24, 0x48, 0x86, 0x9a, 0x6e, 0xdb,
-	0x94, 0xae, 0x6e, 0x58, 0xea, 0xfa, 0x86, 0xa5, 0xfe, 0xde, 0xb0, 0xd4, 0xe5, 0x2d, 0x9b, 0xb9,
-	0xbe, 0x65, 0x33, 0xbf, 0x6e, 0xd9, 0xcc, 0xc7, 0xc6, 0x80, 0x84, 0xa7, 0xa3, 0x5e, 0x74, 0x59,
-	0xb8, 0x9e, 0xd3, 0x7b, 0x66, 0x9d, 0x9a, 0xc4, 0xe1, 0x66, 0x9e, 0x9a, 0xb3, 0xd9, 0x97, 0x2f,
-	0x3a, 0xec, 0x41, 0xaf, 0x10, 0xdf, 0xd3, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x40, 0x89,
-	0xbf, 0xe3, 0x1f, 0x05, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4b, 0x6f, 0xe3, 0x44,
+	0x1c, 0x8f, 0x93, 0x34, 0xdb, 0xfc, 0xdb, 0xa6, 0x66, 0xb6, 0x05, 0x37, 0x74, 0xdd, 0x50, 0x38,
+	0x94, 0x95, 0x48, 0x50, 0x79, 0x08, 0x89, 0x93, 0xe3, 0x4c, 0x2a, 0x43, 0xea, 0x58, 0xae, 0x03,
+	0x5b, 0x38, 0x58, 0x8e, 0x3b, 0xc9, 0x1a, 0xc5, 0x1e, 0xcb, 0x9e, 0x40, 0xba, 0x27, 0x8e, 0x1c,
+	0xf7, 0x3b, 0xf0, 0x51, 0x10, 0xd2, 0x1e, 0xf7, 0xc8, 0x09, 0x50, 0xfb, 0x45, 0xd0, 0x8c, 0x9d,
+	0x17, 0x6d, 0xa5, 0xdd, 0x5b, 0xe6, 0xf7, 0xf2, 0xff, 0x31, 0x76, 0xe0, 0x78, 0x9c, 0x10, 0x12,
+	0x8d, 0x02, 0x32, 0xb9, 0x6a, 0xc5, 0x24, 0x09, 0x83, 0x34, 0x0d, 0x68, 0xd4, 0xf2, 0x69, 0x18,
+	0xd2, 0xa8, 0x19, 0x27, 0x94, 0x51, 0xb4, 0xbf, 0xd4, 0x34, 0x97, 0x9a, 0xfa, 0x81, 0x4f, 0xd3,
+	0x90, 0xa6, 0xae, 0x10, 0xb5, 0xb2, 0x43, 0xe6, 0xa8, 0xef, 0x8d, 0xe9, 0x98, 0x66, 0x38, 0xff,
+	0x95, 0xa3, 0x47, 0x63, 0x4a, 0xc7, 0x13, 0xd2, 0x12, 0xa7, 0xe1, 0x74, 0xd4, 0x62, 0x41, 0x48,
+	0x52, 0xe6, 0x85, 0xf1, 0x42, 0xb0, 0x2c, 0x26, 0xab, 0xa0, 0xf5, 0x4b, 0xe2, 0xc5, 0x31, 0x49,
+	0x32, 0xc1, 0xf1, 0xaf, 0x25, 0xa8, 0x5e, 0x30, 0x8f, 0x91, 0x90, 0x44, 0x0c, 0x7d, 0x01, 0x15,
+	0x32, 0x1a, 0x11, 0x9f, 0x29, 0x52, 0x43, 0x3a, 0xa9, 0x9d, 0x3e, 0x69, 0xde, 0x5b, 0x68, 0x13,
+	0x0b, 0x91, 0x9d, 0x8b, 0xd1, 0xd7, 0xf0, 0xc8, 0xf3, 0x59, 0x40, 0xa3, 0x54, 0x29, 0x36, 0x4a,
+	0x27, 0xb5, 0xd3, 0x0f, 0x1e, 0xf0, 0x69, 0x42, 0xe5, 0x5c, 0xc7, 0xc4, 0x9e, 0x3b, 0xd0, 0x21,
+	0x54, 0x13, 0x92, 0xd2, 0x69, 0xe2, 0x93, 0x54, 0x29, 0x35, 0x4a, 0x27, 0x55, 0x7b, 0x09, 0xa0,
+	0x73, 0xd8, 0x25, 0xb3, 0x38, 0x48, 0x3c, 0x2e, 0x76, 0x79, 0x7b, 0x4a, 0xb9, 0x21, 0x9d, 0x6c,
+	0x9d, 0xd6, 0x9b, 0x59, 0xef, 0xcd, 0x79, 0xef, 0x4d, 0x67, 0xde, 0x7b, 0x7b, 0xf3, 0xd5, 0xdf,
+	0x47, 0xd2, 0xcb, 0x7f, 0x8e, 0x24, 0xbb, 0xb6, 0x34, 0x73, 0x1a, 0xe9, 0x00, 0x93, 0x20, 0x0c,
+	0x98, 0x9b, 0x06, 0x2f, 0x88, 0xb2, 0x21, 0x92, 0xd4, 0xd5, 0x62, 0xf3, 0x35, 0x0d, 0x8c, 0x88,
+	0x7d, 0xf9, 0xf9, 0x77, 0xde, 0x64, 0x4a, 0xda, 0x65, 0x9e, 0x66, 0x57, 0x85, 0xef, 0x22, 0x78,
+	0x41, 0xd0, 0x39, 0x54, 0x7d, 0x1a, 0x5d, 0x05, 0x3c, 0x55, 0xa9, 0x88, 0x8c, 0x8f, 0x1f, 0x68,
+	0x78, 0x31, 0x5a, 0x7d, 0x6e, 0x98, 0xc7, 0x2d, 0x12, 0x8e, 0xff, 0x28, 0x02, 0xba, 0xab, 0xe3,
+	0xa5, 0x46, 0x94, 0xb9, 0x43, 0x32, 0xa2, 0x09, 0x11, 0xfb, 0x78, 0xd3, 0xa6, 0xab, 0x11, 0x65,
+	0x6d, 0x61, 0x43, 0x1a, 0xf0, 0x83, 0xeb, 0x8d, 0x18, 0x49, 0x94, 0xe2, 0x5b, 0x64, 0x6c, 0x46,
+	0x94, 0x69, 0xdc, 0x85, 0x3e, 0x84, 0x1d, 0x9f, 0x46, 0x8c, 0x44, 0xcc, 0x65, 0xd7, 0xf1, 0x62,
+	0x47, 0xdb, 0x39, 0xc8, 0x97, 0x99, 0xa2, 0x4f, 0x61, 0x8f, 0x0e, 0x7f, 0x22, 0x3e, 0x73, 0x23,
+	0x2f, 0x24, 0x6e, 0x9c, 0x90, 0x51, 0x30, 0x23, 0xa9, 0x52, 0x16, 0x5a, 0x94, 0x71, 0xa6, 0x17,
+	0x12, 0x2b, 0x67, 0x90, 0x09, 0x72, 0xe8, 0xcd, 0xdc, 0xd8, 0xbb, 0x9e, 0x50, 0xef, 0xea, 0xed,
+	0xf7, 0x51, 0x0b, 0xbd, 0x99, 0x95, 0x99, 0xf9, 0x52, 0x8e, 0x7f, 0x84, 0xaa, 0x95, 0x04, 0x91,
+	0x1f, 0xc4, 0xde, 0x04, 0x7d, 0x05, 0x65, 0x5e, 0x6b, 0x7e, 0x8b, 0x3f, 0x7a, 0x60, 0x39, 0x0b,
+	0xbd, 0xb8, 0x90, 0xc2, 0x81, 0xf6, 0x60, 0xe3, 0x67, 0xfe, 0x14, 0x31, 0xac, 0xaa, 0x9d, 0x1d,
+	0x9e, 0xfe, 0x59, 0x02, 0x58, 0xde, 0x5d, 0xf4, 0x2e, 0x20, 0x4d, 0x77, 0x8c, 0xbe, 0xe9, 0x0e,
+	0xcc, 0x0b, 0x0b, 0xeb, 0x46, 0xd7, 0xc0, 0x1d, 0xb9, 0x80, 0x9e, 0xc0, 0xc1, 0x1c, 0xb7, 0x3a,
+	0x9a, 0x83, 0xdd, 0xf6, 0x40, 0xff, 0x16, 0x3b, 0xae, 0x61, 0x76, 0xfb, 0xb2, 0x84, 0x14, 0xd8,
+	0xcb, 0xe9, 0x0e, 0xee, 0xe1, 0x05, 0x2d, 0x17, 0x57, 0x18, 0xdd, 0xc6, 0xdc, 0xd8, 0x6f, 0x7f,
+	0x83, 0x75, 0x47, 0x2e, 0xdd, 0xf5, 0xe4, 0x4c, 0x79, 0xa5, 0x08, 0xbd, 0x6f, 0x5d, 0xce, 0xf1,
+	0x0d, 0xb4, 0x0f, 0xef, 0xe4, 0xf8, 0x19, 0x76, 0xe6, 0x70, 0x05, 0x1d, 0xc0, 0x7e, 0x0e, 0xe3,
+	0x67, 0x58, 0x1f, 0x2c, 0x93, 0x1e, 0xad, 0x24, 0xf5, 0x8c, 0x8b, 0x85, 0x65, 0x13, 0xa9, 0x50,
+	0x5f, 0x6f, 0xe7, 0xcc, 0xee, 0x0f, 0x2c, 0xf7, 0x1c, 0x9f, 0xb7, 0xb1, 0x2d, 0x57, 0xd1, 0x7b,
+	0xf0, 0x78, 0xbd, 0x36, 0xc1, 0xcb, 0x70, 0x77, 0x0e, 0x59, 0x64, 0x36, 0x87, 0xad, 0xbb, 0x74,
+	0x96, 0x8b, 0x9f, 0x39, 0xb6, 0x26, 0x6f, 0xa3, 0x43, 0x50, 0xee, 0xa3, 0x85, 0x79, 0x07, 0x35,
+	0xe0, 0xf0, 0xde, 0x6c, 0xbd, 0x6f, 0x3a, 0xd8, 0x74, 0xe4, 0x1a, 0x7a, 0x0c, 0xbb, 0xb9, 0xc2,
+	0xb9, 0xb4, 0xb0, 0xab, 0xf5, 0x7a, 0xb2, 0x5f, 0x2f, 0xff, 0xf6, 0xbb, 0x5a, 0x78, 0x6a, 0x40,
+	0x25, 0xfb, 0x74, 0xf1, 0x9e, 0x71, 0xb7, 0xcb, 0x8d, 0xeb, 0x2b, 0x94, 0x61, 0x3b, 0xc7, 0xb5,
+	0x5e, 0xaf, 0xff, 0xbd, 0x2c, 0xa1, 0x5d, 0xd8, 0xca, 0x91, 0x0e, 0x36, 0x2f, 0xe5, 0x62, 0x1e,
+	0x35, 0x85, 0x9d, 0xb5, 0xfb, 0xc3, 0xa7, 0x65, 0xd9, 0x86, 0xa9, 0x1b, 0x96, 0xd6, 0xcb, 0x9e,
+	0xbc, 0x9e, 0x7c, 0x04, 0xef, 0xff, 0x8f, 0x3f, 0x33, 0xbb, 0x1d, 0x57, 0xd3, 0xf5, 0xfe, 0xc0,
+	0x74, 0x64, 0x89, 0x8f, 0xe5, 0x3e, 0x41, 0x36, 0xd4, 0xfc, 0xb1, 0xed, 0xde, 0xab, 0x1b, 0x55,
+	0x7a, 0x7d, 0xa3, 0x4a, 0xff, 0xde, 0xa8, 0xd2, 0xcb, 0x5b, 0xb5, 0xf0, 0xfa, 0x56, 0x2d, 0xfc,
+	0x75, 0xab, 0x16, 0x7e, 0x38, 0x1d, 0x07, 0xec, 0xf9, 0x74, 0xc8, 0xdf, 0x98, 0xd6, 0x30, 0x1a,
+	0x7e, 0xe2, 0x3f, 0xf7, 0x82, 0xa8, 0xb5, 0xf2, 0xfd, 0x9f, 0xad, 0xfe, 0x1d, 0x89, 0x57, 0x79,
+	0x58, 0x11, 0xdf, 0x80, 0xcf, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x79, 0xc4, 0x5d, 0xb4,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Statement) Marshal() (dAtA []byte, err error) {
@@ -380,6 +483,18 @@ func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LimitSize != nil {
 		{
 			size, err := m.LimitSize.MarshalToSizedBuffer(dAtA[:i])
@@ -393,12 +508,12 @@ func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if m.ExpirationTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintCommon(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintCommon(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -412,20 +527,20 @@ func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Actions) > 0 {
-		dAtA4 := make([]byte, len(m.Actions)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Actions)*10)
+		var j4 int
 		for _, num := range m.Actions {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintCommon(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintCommon(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -437,6 +552,79 @@ func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StatementCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatementCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatementCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPayloadSize != nil {
+		{
+			size, err := m.MaxPayloadSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObjectNamePrefixes) > 0 {
+		for iNdEx := len(m.ObjectNamePrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectNamePrefixes[iNdEx])
+			copy(dAtA[i:], m.ObjectNamePrefixes[iNdEx])
+			i = encodeVarintCommon(dAtA, i, uint64(len(m.ObjectNamePrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContentTypes) > 0 {
+		for iNdEx := len(m.ContentTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContentTypes[iNdEx])
+			copy(dAtA[i:], m.ContentTypes[iNdEx])
+			i = encodeVarintCommon(dAtA, i, uint64(len(m.ContentTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NotAfter != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintCommon(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NotBefore != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintCommon(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Principal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -513,6 +701,43 @@ func (m *Statement) Size() (n int) {
 		l = m.LimitSize.Size()
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	return n
+}
+
+func (m *StatementCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter)
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if len(m.ContentTypes) > 0 {
+		for _, s := range m.ContentTypes {
+			l = len(s)
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if len(m.ObjectNamePrefixes) > 0 {
+		for _, s := range m.ObjectNamePrefixes {
+			l = len(s)
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if m.MaxPayloadSize != nil {
+		l = m.MaxPayloadSize.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
 	return n
 }
 
@@ -759,6 +984,264 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Condition == nil {
+				m.Condition = &StatementCondition{}
+			}
+			if err := m.Condition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotAfter == nil {
+				m.NotAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentTypes = append(m.ContentTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectNamePrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectNamePrefixes = append(m.ObjectNamePrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxPayloadSize == nil {
+				m.MaxPayloadSize = &common.UInt64Value{}
+			}
+			if err := m.MaxPayloadSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+)
+
+const (
+	// MaxConditionContentTypes is the maximum number of content types allowed in a statement condition
+	MaxConditionContentTypes = 10
+	// MaxConditionObjectNamePrefixes is the maximum number of object name prefixes allowed in a statement condition
+	MaxConditionObjectNamePrefixes = 10
+	// MaxConditionObjectNamePrefixLength is the maximum length of an object name prefix, same as the object name
+	MaxConditionObjectNamePrefixLength = 1024
+
+	// ContentTypeWildcard matches any sub-type of a content type, e.g. "image/*"
+	ContentTypeWildcard = "*"
+)
+
+// MatchTime checks whether the block time falls into the time window of the condition.
+// A nil condition always matches.
+func (c *StatementCondition) MatchTime(blockTime time.Time) bool {
+	if c == nil {
+		return true
+	}
+	if c.NotBefore != nil && blockTime.Before(*c.NotBefore) {
+		return false
+	}
+	if c.NotAfter != nil && blockTime.After(*c.NotAfter) {
+		return false
+	}
+	return true
+}
+
+// Match checks whether the object related condition keys are satisfied by the verify options.
+// If the condition requires a key that is not provided by the options, it fails closed: a Deny
+// statement matches and an Allow statement does not.
+// A nil condition always matches.
+func (c *StatementCondition) Match(opts *VerifyOptions, effect Effect) bool {
+	if c == nil {
+		return true
+	}
+	missing := effect == EFFECT_DENY
+	if len(c.ContentTypes) != 0 {
+		if opts == nil || opts.ContentType == "" {
+			return missing
+		}
+		isMatch := false
+		for _, ct := range c.ContentTypes {
+			if matchContentType(ct, opts.ContentType) {
+				isMatch = true
+				break
+			}
+		}
+		if !isMatch {
+			return false
+		}
+	}
+	if len(c.ObjectNamePrefixes) != 0 {
+		if opts == nil || opts.ObjectName == "" {
+			return missing
+		}
+		isMatch := false
+		for _, prefix := range c.ObjectNamePrefixes {
+			if strings.HasPrefix(opts.ObjectName, prefix) {
+				isMatch = true
+				break
+			}
+		}
+		if !isMatch {
+			return false
+		}
+	}
+	if c.MaxPayloadSize != nil {
+		if opts == nil || opts.PayloadSize == nil {
+			return missing
+		}
+		if *opts.PayloadSize > c.MaxPayloadSize.GetValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *StatementCondition) ValidateBasic(resType resource.ResourceType) error {
+	if c.NotBefore != nil && c.NotAfter != nil && !c.NotBefore.Before(*c.NotAfter) {
+		return ErrInvalidStatement.Wrap("The condition not_before should be earlier than not_after.")
+	}
+	hasObjectKeys := len(c.ContentTypes) != 0 || len(c.ObjectNamePrefixes) != 0 || c.MaxPayloadSize != nil
+	if resType == resource.RESOURCE_TYPE_GROUP && hasObjectKeys {
+		return ErrInvalidStatement.Wrap("Only the time window condition can be used on group.")
+	}
+	if len(c.ContentTypes) > MaxConditionContentTypes {
+		return ErrInvalidStatement.Wrapf("The number of condition content types exceeds the limit %d.", MaxConditionContentTypes)
+	}
+	for _, ct := range c.ContentTypes {
+		if err := validateContentTypePattern(ct); err != nil {
+			return err
+		}
+	}
+	if len(c.ObjectNamePrefixes) > MaxConditionObjectNamePrefixes {
+		return ErrInvalidStatement.Wrapf("The number of condition object name prefixes exceeds the limit %d.", MaxConditionObjectNamePrefixes)
+	}
+	for _, prefix := range c.ObjectNamePrefixes {
+		if prefix == "" || len(prefix) > MaxConditionObjectNamePrefixLength {
+			return ErrInvalidStatement.Wrapf("Invalid condition object name prefix: %s", prefix)
+		}
+	}
+	if c.MaxPayloadSize != nil && c.MaxPayloadSize.GetValue() == 0 {
+		return ErrInvalidStatement.Wrap("The condition max_payload_size should be greater than 0.")
+	}
+	return nil
+}
+
+// validateContentTypePattern checks the pattern is in the form of "type/subtype", "type/*" or "*/*".
+func validateContentTypePattern(pattern string) error {
+	typ, subType, found := strings.Cut(pattern, "/")
+	if !found || typ == "" || subType == "" || strings.Contains(subType, "/") {
+		return ErrInvalidStatement.Wrapf("Invalid condition content type: %s", pattern)
+	}
+	if typ == ContentTypeWildcard && subType != ContentTypeWildcard {
+		return ErrInvalidStatement.Wrapf("Invalid condition content type: %s", pattern)
+	}
+	return nil
+}
+
+// matchContentType matches the content type against the pattern case-insensitively. The parameters
+// of the content type(e.g. "; charset=utf-8") are ignored.
+func matchContentType(pattern, contentType string) bool {
+	contentType, _, _ = strings.Cut(contentType, ";")
+	contentType = strings.TrimSpace(contentType)
+	typ, subType, _ := strings.Cut(pattern, "/")
+	if typ == ContentTypeWildcard {
+		return true
+	}
+	if subType == ContentTypeWildcard {
+		return len(contentType) > len(typ) && strings.EqualFold(contentType[:len(typ)+1], typ+"/")
+	}
+	return strings.EqualFold(pattern, contentType)
+}
@@ -285,3 +285,236 @@ func TestPolicy_SubResource(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicy_Condition(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+	payloadSize := uint64(1024)
+	bigPayloadSize := uint64(2048)
+	opts := &types.VerifyOptions{
+		ObjectName:  "partner/photo.png",
+		ContentType: "image/png",
+		PayloadSize: &payloadSize,
+	}
+	tests := []struct {
+		name         string
+		condition    *types.StatementCondition
+		opts         *types.VerifyOptions
+		expectEffect types.Effect
+	}{
+		{
+			name:         "in_time_window",
+			condition:    &types.StatementCondition{NotBefore: &before, NotAfter: &after},
+			opts:         opts,
+			expectEffect: types.EFFECT_ALLOW,
+		},
+		{
+			name:         "before_time_window",
+			condition:    &types.StatementCondition{NotBefore: &after},
+			opts:         opts,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "after_time_window",
+			condition:    &types.StatementCondition{NotAfter: &before},
+			opts:         opts,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "content_type_wildcard_matched",
+			condition:    &types.StatementCondition{ContentTypes: []string{"image/*"}},
+			opts:         opts,
+			expectEffect: types.EFFECT_ALLOW,
+		},
+		{
+			name:         "content_type_not_matched",
+			condition:    &types.StatementCondition{ContentTypes: []string{"video/*", "image/jpeg"}},
+			opts:         opts,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "object_name_prefix_matched",
+			condition:    &types.StatementCondition{ObjectNamePrefixes: []string{"partner/"}},
+			opts:         opts,
+			expectEffect: types.EFFECT_ALLOW,
+		},
+		{
+			name:         "object_name_prefix_not_matched",
+			condition:    &types.StatementCondition{ObjectNamePrefixes: []string{"internal/"}},
+			opts:         opts,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "payload_size_matched",
+			condition:    &types.StatementCondition{MaxPayloadSize: &common.UInt64Value{Value: bigPayloadSize}},
+			opts:         opts,
+			expectEffect: types.EFFECT_ALLOW,
+		},
+		{
+			name:         "payload_size_exceeded",
+			condition:    &types.StatementCondition{MaxPayloadSize: &common.UInt64Value{Value: payloadSize - 1}},
+			opts:         opts,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "condition_without_options",
+			condition:    &types.StatementCondition{ContentTypes: []string{"image/*"}},
+			opts:         nil,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := sample.RandAccAddress()
+			policy := types.Policy{
+				Principal:    types.NewPrincipalWithAccount(user),
+				ResourceType: resource.RESOURCE_TYPE_BUCKET,
+				ResourceId:   math.OneUint(),
+				Statements: []*types.Statement{
+					{
+						Effect:    types.EFFECT_ALLOW,
+						Actions:   []types.ActionType{types.ACTION_GET_OBJECT},
+						Condition: tt.condition,
+					},
+				},
+			}
+			effect, _ := policy.Eval(types.ACTION_GET_OBJECT, now, tt.opts)
+			require.Equal(t, tt.expectEffect, effect)
+		})
+	}
+}
+
+func TestStatement_ValidateCondition(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	tests := []struct {
+		name      string
+		resType   resource.ResourceType
+		condition *types.StatementCondition
+		expectErr bool
+	}{
+		{
+			name:      "valid_condition",
+			resType:   resource.RESOURCE_TYPE_BUCKET,
+			condition: &types.StatementCondition{NotBefore: &now, NotAfter: &later, ContentTypes: []string{"image/*", "*/*"}, ObjectNamePrefixes: []string{"a/"}},
+		},
+		{
+			name:      "invalid_time_window",
+			resType:   resource.RESOURCE_TYPE_BUCKET,
+			condition: &types.StatementCondition{NotBefore: &later, NotAfter: &now},
+			expectErr: true,
+		},
+		{
+			name:      "invalid_content_type",
+			resType:   resource.RESOURCE_TYPE_BUCKET,
+			condition: &types.StatementCondition{ContentTypes: []string{"image"}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid_wildcard_content_type",
+			resType:   resource.RESOURCE_TYPE_OBJECT,
+			condition: &types.StatementCondition{ContentTypes: []string{"*/png"}},
+			expectErr: true,
+		},
+		{
+			name:      "empty_prefix",
+			resType:   resource.RESOURCE_TYPE_BUCKET,
+			condition: &types.StatementCondition{ObjectNamePrefixes: []string{""}},
+			expectErr: true,
+		},
+		{
+			name:      "zero_max_payload_size",
+			resType:   resource.RESOURCE_TYPE_BUCKET,
+			condition: &types.StatementCondition{MaxPayloadSize: &common.UInt64Value{Value: 0}},
+			expectErr: true,
+		},
+		{
+			name:      "object_condition_on_group",
+			resType:   resource.RESOURCE_TYPE_GROUP,
+			condition: &types.StatementCondition{ContentTypes: []string{"image/*"}},
+			expectErr: true,
+		},
+		{
+			name:      "time_condition_on_group",
+			resType:   resource.RESOURCE_TYPE_GROUP,
+			condition: &types.StatementCondition{NotAfter: &later},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := types.ACTION_GET_OBJECT
+			if tt.resType == resource.RESOURCE_TYPE_GROUP {
+				action = types.ACTION_UPDATE_GROUP_MEMBER
+			}
+			s := &types.Statement{
+				Effect:    types.EFFECT_ALLOW,
+				Actions:   []types.ActionType{action},
+				Condition: tt.condition,
+			}
+			err := s.ValidateBasic(tt.resType)
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicy_DenyConditionFailsClosed(t *testing.T) {
+	now := time.Now()
+	payloadSize := uint64(1024)
+	tests := []struct {
+		name         string
+		condition    *types.StatementCondition
+		opts         *types.VerifyOptions
+		expectEffect types.Effect
+	}{
+		{
+			name:         "missing_content_type",
+			condition:    &types.StatementCondition{ContentTypes: []string{"image/*"}},
+			opts:         nil,
+			expectEffect: types.EFFECT_DENY,
+		},
+		{
+			name:         "missing_object_name",
+			condition:    &types.StatementCondition{ObjectNamePrefixes: []string{"internal/"}},
+			opts:         &types.VerifyOptions{ContentType: "image/png"},
+			expectEffect: types.EFFECT_DENY,
+		},
+		{
+			name:         "missing_payload_size",
+			condition:    &types.StatementCondition{MaxPayloadSize: &common.UInt64Value{Value: payloadSize}},
+			opts:         &types.VerifyOptions{ObjectName: "internal/a"},
+			expectEffect: types.EFFECT_DENY,
+		},
+		{
+			name:         "provided_key_not_matched",
+			condition:    &types.StatementCondition{ObjectNamePrefixes: []string{"internal/"}},
+			opts:         &types.VerifyOptions{ObjectName: "public/a"},
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := types.Policy{
+				Principal:    types.NewPrincipalWithAccount(sample.RandAccAddress()),
+				ResourceType: resource.RESOURCE_TYPE_BUCKET,
+				ResourceId:   math.OneUint(),
+				Statements: []*types.Statement{
+					{
+						Effect:    types.EFFECT_DENY,
+						Actions:   []types.ActionType{types.ACTION_GET_OBJECT},
+						Condition: tt.condition,
+					},
+				},
+			}
+			effect, _ := policy.Eval(types.ACTION_GET_OBJECT, now, tt.opts)
+			require.Equal(t, tt.expectEffect, effect)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"regexp"
+	"strconv"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// DefaultRegexCacheSize is the default number of compiled resource patterns kept in a RegexCache.
+const DefaultRegexCacheSize = 4096
+
+// RegexCache is a bounded cache of the compiled resource patterns of statements, the least recently used
+// patterns are evicted once the cache is full. It is safe for concurrent use, and a nil cache compiles
+// the patterns every time.
+type RegexCache struct {
+	cache *lru.Cache
+}
+
+func NewRegexCache(size int) *RegexCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &RegexCache{cache: cache}
+}
+
+// Compile returns the compiled regexp of the pattern, it is compiled only if it is not in the cache.
+func (c *RegexCache) Compile(pattern string) (*regexp.Regexp, error) {
+	if c == nil {
+		return regexp.Compile(pattern)
+	}
+	if reg, ok := c.cache.Get(pattern); ok {
+		return reg.(*regexp.Regexp), nil
+	}
+	reg, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(pattern, reg)
+	return reg, nil
+}
+
+// MustCompile is like Compile but panics if the pattern cannot be parsed.
+func (c *RegexCache) MustCompile(pattern string) *regexp.Regexp {
+	reg, err := c.Compile(pattern)
+	if err != nil {
+		panic(`regexp: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return reg
+}
+
+// Len returns the number of the cached patterns.
+func (c *RegexCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.cache.Len()
+}
+
+// NormalizeResources returns the resources with the duplicated ones removed, the order is kept.
+func NormalizeResources(resources []string) []string {
+	if resources == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(resources))
+	normalized := make([]string, 0, len(resources))
+	for _, res := range resources {
+		if seen[res] {
+			continue
+		}
+		seen[res] = true
+		normalized = append(normalized, res)
+	}
+	return normalized
+}
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	types2 "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	"github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+func TestRegexCache_Compile(t *testing.T) {
+	cache := types.NewRegexCache(2)
+
+	reg1, err := cache.Compile("grn:o:bucket/a*")
+	require.NoError(t, err)
+	reg2, err := cache.Compile("grn:o:bucket/a*")
+	require.NoError(t, err)
+	require.Same(t, reg1, reg2)
+	require.Equal(t, 1, cache.Len())
+
+	_, err = cache.Compile("grn:o:bucket/(")
+	require.Error(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	// the cache is bounded
+	_, err = cache.Compile("grn:o:bucket/b*")
+	require.NoError(t, err)
+	_, err = cache.Compile("grn:o:bucket/c*")
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.Len())
+
+	// a nil cache compiles the pattern every time
+	var nilCache *types.RegexCache
+	reg, err := nilCache.Compile("grn:o:bucket/a*")
+	require.NoError(t, err)
+	require.True(t, reg.MatchString("grn:o:bucket/abc"))
+	require.Equal(t, 0, nilCache.Len())
+}
+
+func TestNormalizeResources(t *testing.T) {
+	require.Nil(t, types.NormalizeResources(nil))
+	require.Equal(t, []string{"b", "a"}, types.NormalizeResources([]string{"b", "a", "b", "a"}))
+}
+
+func TestStatement_MalformedResource(t *testing.T) {
+	bucketName := "bucket"
+	opts := &types.VerifyOptions{Resource: types2.NewObjectGRN(bucketName, "xxx").String()}
+
+	allow := &types.Statement{
+		Effect:    types.EFFECT_ALLOW,
+		Actions:   []types.ActionType{types.ACTION_GET_OBJECT},
+		Resources: []string{types2.NewObjectGRN(bucketName, "(").String()},
+	}
+	require.Panics(t, func() { allow.Eval(types.ACTION_GET_OBJECT, opts) })
+
+	deny := &types.Statement{
+		Effect:    types.EFFECT_DENY,
+		Actions:   []types.ActionType{types.ACTION_GET_OBJECT},
+		Resources: []string{types2.NewObjectGRN(bucketName, "(").String()},
+	}
+	require.Panics(t, func() { deny.Eval(types.ACTION_GET_OBJECT, opts) })
+}
+
+func newWildcardPolicy(bucketName string, num int) *types.Policy {
+	statements := make([]*types.Statement, 0, num)
+	for i := 0; i < num; i++ {
+		statements = append(statements, &types.Statement{
+			Effect:    types.EFFECT_ALLOW,
+			Actions:   []types.ActionType{types.ACTION_GET_OBJECT},
+			Resources: []string{types2.NewObjectGRN(bucketName, fmt.Sprintf("prefix_%d/*", i)).String()},
+		})
+	}
+	return &types.Policy{
+		Principal:    types.NewPrincipalWithAccount(sample.RandAccAddress()),
+		ResourceType: resource.RESOURCE_TYPE_BUCKET,
+		ResourceId:   math.OneUint(),
+		Statements:   statements,
+	}
+}
+
+func benchmarkPolicyEval(b *testing.B, num int, cache *types.RegexCache) {
+	bucketName := "bucket"
+	policy := newWildcardPolicy(bucketName, num)
+	// the last statement is matched, so that all the statements are evaluated
+	opts := &types.VerifyOptions{
+		Resource:   types2.NewObjectGRN(bucketName, fmt.Sprintf("prefix_%d/object", num-1)).String(),
+		RegexCache: cache,
+	}
+	now := time.Now()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		effect, _ := policy.Eval(types.ACTION_GET_OBJECT, now, opts)
+		if effect != types.EFFECT_ALLOW {
+			b.Fatalf("unexpected effect %s", effect)
+		}
+	}
+}
+
+func BenchmarkPolicyEval_Uncached100(b *testing.B) {
+	benchmarkPolicyEval(b, 100, nil)
+}
+
+func BenchmarkPolicyEval_Cached100(b *testing.B) {
+	benchmarkPolicyEval(b, 100, types.NewRegexCache(types.DefaultRegexCacheSize))
+}
+
+func BenchmarkPolicyEval_Uncached500(b *testing.B) {
+	benchmarkPolicyEval(b, 500, nil)
+}
+
+func BenchmarkPolicyEval_Cached500(b *testing.B) {
+	benchmarkPolicyEval(b, 500, types.NewRegexCache(types.DefaultRegexCacheSize))
+}
@@ -21,6 +21,14 @@ type (
 type VerifyOptions struct {
 	Resource   string
 	WantedSize *uint64
+
+	// The following fields describe the object being operated, they are used to evaluate the statement conditions.
+	ObjectName  string
+	ContentType string
+	PayloadSize *uint64
+
+	// RegexCache is used to reuse the compiled resource patterns, the patterns are compiled every time if it is nil.
+	RegexCache *RegexCache
 }
 
 var (
@@ -77,25 +85,50 @@ var (
 // Eval is used to evaluate the execution results of permission policies.
 // First, each policy has an expiration time. If it has expired, EFFECT_UNSPECIFIED will be returned, indicating that it cannot be evaluated and further verification is required.
 // Next, each statement in the policy needs to be checked, which includes verifying:
-// 1. Whether the statement has expired,
+// 1. Whether the statement has expired or the block time is out of the time window of its condition,
 // 2. Whether the limit size has been exceeded,
 // 3. Whether the resource in the statement matches the input resource name,
-// 4. Whether the action in the statement matches the input action.
+// 4. Whether the object related conditions of the statement are satisfied,
+// 5. Whether the action in the statement matches the input action.
 // Finally, in the verification process, based on the effect check
 // 1. if there is an explicit Deny, return EFFECT_DENY;
 // 2. if there is an explicit Allowed, record the flag and continue execution;
 // 3. after all statements have been checked, if the flag is true, return EFFECT_ALLOW; otherwise return EFFECT_UNSPECIFIED.
 func (p *Policy) Eval(action ActionType, blockTime time.Time, opts *VerifyOptions) (Effect, *Policy) {
+	return p.EvalWithTrace(action, blockTime, opts, nil)
+}
+
+// EvalTrace records how a policy is evaluated, it is used to explain the permission decision.
+type EvalTrace struct {
+	// Expired indicates the policy has expired
+	Expired bool
+	// SkippedStatements are the indexes of the statements which have expired or are out of their time window
+	SkippedStatements []int
+	// MatchedStatement is the index of the statement which decides the effect, -1 if no statement matched
+	MatchedStatement int
+}
+
+// EvalWithTrace works the same as Eval, besides it records the evaluation details into the trace if it is not nil.
+func (p *Policy) EvalWithTrace(action ActionType, blockTime time.Time, opts *VerifyOptions, trace *EvalTrace) (Effect, *Policy) {
+	if trace != nil {
+		trace.MatchedStatement = -1
+	}
 	// 1. the policy is expired, need delete
 	if p.ExpirationTime != nil && p.ExpirationTime.Before(blockTime) {
 		// Notice: We do not actively delete policies that expire for users.
+		if trace != nil {
+			trace.Expired = true
+		}
 		return EFFECT_UNSPECIFIED, nil
 	}
 	allowed := false
 	updated := false
 	// 2. check all the statements
 	for i, s := range p.Statements {
-		if s.ExpirationTime != nil && s.ExpirationTime.Before(blockTime) {
+		if (s.ExpirationTime != nil && s.ExpirationTime.Before(blockTime)) || !s.Condition.MatchTime(blockTime) {
+			if trace != nil {
+				trace.SkippedStatements = append(trace.SkippedStatements, i)
+			}
 			continue
 		}
 		e, updatedStatement := s.Eval(action, opts)
@@ -105,8 +138,14 @@ func (p *Policy) Eval(action ActionType, blockTime time.Time, opts *VerifyOption
 			p.Statements[i] = updatedStatement
 		}
 		if e == EFFECT_DENY {
+			if trace != nil {
+				trace.MatchedStatement = i
+			}
 			return EFFECT_DENY, nil
 		} else if e == EFFECT_ALLOW {
+			if trace != nil && !allowed {
+				trace.MatchedStatement = i
+			}
 			allowed = true
 		}
 	}
@@ -139,10 +178,9 @@ func (s *Statement) Eval(action ActionType, opts *VerifyOptions) (Effect, *State
 	if opts != nil && opts.Resource != "" && s.Resources != nil {
 		isMatch := false
 		for _, res := range s.Resources {
-			reg := regexp.MustCompile(res)
-			if reg == nil {
-				continue
-			}
+			// Malformed patterns are rejected when the policy is put, the ones stored before the validation
+			// was introduced keep failing the evaluation as they always did.
+			reg := opts.RegexCache.MustCompile(res)
 			matchRes := reg.MatchString(opts.Resource)
 			if matchRes {
 				isMatch = matchRes
@@ -153,6 +191,10 @@ func (s *Statement) Eval(action ActionType, opts *VerifyOptions) (Effect, *State
 			return EFFECT_UNSPECIFIED, nil
 		}
 	}
+	// If the statement has conditions, all of them should be satisfied by the object being operated.
+	if !s.Condition.Match(opts, s.Effect) {
+		return EFFECT_UNSPECIFIED, nil
+	}
 
 	for _, act := range s.Actions {
 		if act == action || act == ACTION_TYPE_ALL {
@@ -214,10 +256,17 @@ func (s *Statement) ValidateBasic(resType resource.ResourceType) error {
 	default:
 		return ErrInvalidStatement.Wrap("unknown resource type.")
 	}
+	if s.Condition != nil {
+		return s.Condition.ValidateBasic(resType)
+	}
 	return nil
 }
 
 func (s *Statement) ValidateRuntime(ctx sdk.Context, resType resource.ResourceType) error {
+	if s.Condition != nil && !ctx.IsUpgraded(gnfd.Gobi) {
+		return ErrInvalidStatement.Wrap("The Condition option is not supported yet.")
+	}
+
 	if ctx.IsUpgraded(upgradetypes.Nagqu) {
 		switch resType {
 		case resource.RESOURCE_TYPE_BUCKET:
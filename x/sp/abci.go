@@ -10,6 +10,8 @@ import (
 )
 
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ProcessScheduledMaintenances(ctx)
+
 	if ctx.BlockHeight()%types.MaintenanceRecordsGCFrequencyInBlocks == 0 {
 		k.ForceUpdateMaintenanceRecords(ctx)
 	}
@@ -31,6 +31,9 @@ func GetQueryCmd() *cobra.Command {
 		CmdMaintenanceRecordsBySPOperatorAddress(),
 		CmdStorageProviderPrice(),
 		CmdStorageProviderGlobalPrice(),
+		CmdStorageProviderReputation(),
+		CmdStorageProviderReputations(),
+		CmdScheduledMaintenances(),
 	)
 
 	return cmd
@@ -230,3 +233,120 @@ func CmdStorageProviderGlobalPrice() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdStorageProviderReputation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-provider-reputation [sp-id]",
+		Short: "Query the reputation of the storage provider with specify sp id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqSpID := args[0]
+			spID, err := strconv.ParseUint(reqSpID, 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryStorageProviderReputationRequest{
+				Id: uint32(spID),
+			}
+
+			res, err := queryClient.StorageProviderReputation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStorageProviderReputations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-provider-reputations",
+		Short: "Query the reputations of all storage providers",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryStorageProviderReputationsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.StorageProviderReputations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdScheduledMaintenances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-maintenances [sp-id]",
+		Short: "Query the upcoming maintenance windows of all storage providers or the one with specify sp id",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spID := uint64(0)
+			if len(args) == 1 {
+				spID, err = strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryScheduledMaintenancesRequest{
+				SpId:       uint32(spID),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ScheduledMaintenances(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
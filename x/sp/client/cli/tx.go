@@ -39,6 +39,8 @@ func GetTxCmd() *cobra.Command {
 		CmdGrantDepositAuthorization(),
 		CmdUpdateStorageProviderStatus(),
 		CmdUpdateStorageProviderStoragePrice(),
+		CmdScheduleMaintenance(),
+		CmdCancelScheduledMaintenance(),
 	)
 
 	return spTxCmd
@@ -770,3 +772,72 @@ Examples:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdScheduleMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-maintenance [sp-address] [start-time] [duration]",
+		Short: "Schedule a future maintenance window of a storage provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`schedule a maintenance window, the storage provider enters STATUS_IN_MAINTENANCE at the start time (unix timestamp) and turns back to STATUS_IN_SERVICE after the duration in second automatically.
+
+Examples:
+ $ %s tx %s schedule-maintenance 0x.... 1700000000 3600
+	`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			duration, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgScheduleMaintenance(spAddress, startTime, duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelScheduledMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-maintenance [sp-address] [start-time]",
+		Short: "Cancel a maintenance window of a storage provider which is not started",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelScheduledMaintenance(spAddress, startTime)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
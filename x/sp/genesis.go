@@ -24,6 +24,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.StorageProviders = k.GetAllStorageProviders(ctx)
 	genesis.SpStoragePriceList = k.GetAllSpStoragePrice(ctx)
+	genesis.SpReputations = k.GetAllStorageProviderReputations(ctx)
+	genesis.ScheduledMaintenances = k.GetAllScheduledMaintenances(ctx)
+	genesis.OngoingMaintenances = k.GetAllOngoingMaintenances(ctx)
 
 	return genesis
 }
@@ -54,6 +54,20 @@ func TestGenesis(t *testing.T) {
 				Status:          types.STATUS_IN_SERVICE,
 			},
 		},
+		SpReputations: []types.SpReputation{
+			{
+				SpId: 1,
+				Windows: []types.SpReputationWindow{
+					{StartTime: 1, ChallengesIssued: 4, ChallengesAttested: 2, ChallengesFailed: 1},
+				},
+			},
+		},
+		ScheduledMaintenances: []types.ScheduledMaintenance{
+			{SpId: 1, StartTime: 2000, Duration: 3600},
+		},
+		OngoingMaintenances: []types.ScheduledMaintenance{
+			{SpId: 2, StartTime: 1000, Duration: 3600},
+		},
 	}
 
 	ctx := testCtx.Ctx
@@ -61,6 +75,10 @@ func TestGenesis(t *testing.T) {
 	sp.InitGenesis(ctx, *k, genesisState)
 	got := sp.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.SpReputations, got.SpReputations)
+	require.Equal(t, genesisState.ScheduledMaintenances, got.ScheduledMaintenances)
+	require.Equal(t, genesisState.OngoingMaintenances, got.OngoingMaintenances)
+	require.Equal(t, genesisState.ScheduledMaintenances, k.GetScheduledMaintenances(ctx, 1))
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
@@ -21,6 +21,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic("invalid initialization storage provider status in genesis block")
 		}
 	}
+	for i := range genState.SpReputations {
+		k.SetStorageProviderReputation(ctx, &genState.SpReputations[i])
+	}
+	for i := range genState.ScheduledMaintenances {
+		k.SetScheduledMaintenance(ctx, &genState.ScheduledMaintenances[i])
+	}
+	for i := range genState.OngoingMaintenances {
+		k.SetOngoingMaintenance(ctx, &genState.OngoingMaintenances[i])
+	}
 
 	depositCoins := sdk.NewCoins(sdk.NewCoin(genState.Params.DepositDenom, depositAmount))
 
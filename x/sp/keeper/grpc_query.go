@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -138,3 +139,94 @@ func (k Keeper) StorageProviderMaintenanceRecordsByOperatorAddress(goCtx context
 	}
 	return &types.QueryStorageProviderMaintenanceRecordsResponse{Records: records}, nil
 }
+
+func (k Keeper) StorageProviderReputation(goCtx context.Context, req *types.QueryStorageProviderReputationRequest) (*types.QueryStorageProviderReputationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetStorageProvider(ctx, req.Id); !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+	now := ctx.BlockTime().Unix()
+	reputation, found := k.GetStorageProviderReputation(ctx, req.Id)
+	if !found {
+		reputation = &types.SpReputation{SpId: req.Id}
+	}
+	return &types.QueryStorageProviderReputationResponse{
+		Summary: reputation.Summary(now),
+		Windows: reputation.ActiveWindows(now),
+	}, nil
+}
+
+func (k Keeper) StorageProviderReputations(goCtx context.Context, req *types.QueryStorageProviderReputationsRequest) (*types.QueryStorageProviderReputationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the storage providers without any service event are listed as well
+	now := ctx.BlockTime().Unix()
+	spStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageProviderKey)
+	summaries, pageRes, err := query.GenericFilteredPaginate(k.cdc, spStore, req.Pagination, func(key []byte, val *types.StorageProvider) (*types.SpReputationSummary, error) {
+		reputation, found := k.GetStorageProviderReputation(ctx, val.Id)
+		if !found {
+			reputation = &types.SpReputation{SpId: val.Id}
+		}
+		summary := reputation.Summary(now)
+		return &summary, nil
+	}, func() *types.StorageProvider {
+		return &types.StorageProvider{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryStorageProviderReputationsResponse{Pagination: pageRes}
+	for _, summary := range summaries {
+		res.Summaries = append(res.Summaries, *summary)
+	}
+	return res, nil
+}
+
+func (k Keeper) ScheduledMaintenances(goCtx context.Context, req *types.QueryScheduledMaintenancesRequest) (*types.QueryScheduledMaintenancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.QueryScheduledMaintenancesResponse{}
+	if req.SpId != 0 {
+		// the windows of a storage provider are found by the index
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.ScheduledMaintenanceBySpPrefix,
+			types.GetScheduledMaintenanceBySpPrefix(req.SpId)...))
+		pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+			window, found := k.getScheduledMaintenance(ctx, req.SpId, int64(binary.BigEndian.Uint64(key)))
+			if found {
+				res.Windows = append(res.Windows, *window)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Pagination = pageRes
+		return res, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var window types.ScheduledMaintenance
+		if err := k.cdc.Unmarshal(value, &window); err != nil {
+			return err
+		}
+		res.Windows = append(res.Windows, window)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
@@ -439,3 +439,51 @@ func (k msgServer) UpdateSpStatus(goCtx context.Context, msg *types.MsgUpdateSto
 	}
 	return &types.MsgUpdateStorageProviderStatusResponse{}, nil
 }
+
+// ScheduleMaintenance registers a future maintenance window for the storage provider.
+func (k msgServer) ScheduleMaintenance(goCtx context.Context, msg *types.MsgScheduleMaintenance) (*types.MsgScheduleMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
+	sp, found := k.GetStorageProviderByOperatorAddr(ctx, operatorAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if err := k.Keeper.ScheduleMaintenance(ctx, sp, msg.StartTime, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventScheduleMaintenance{
+		SpId:      sp.Id,
+		StartTime: msg.StartTime,
+		Duration:  msg.Duration,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgScheduleMaintenanceResponse{}, nil
+}
+
+// CancelScheduledMaintenance cancels a maintenance window not started yet for the storage provider.
+func (k msgServer) CancelScheduledMaintenance(goCtx context.Context, msg *types.MsgCancelScheduledMaintenance) (*types.MsgCancelScheduledMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
+	sp, found := k.GetStorageProviderByOperatorAddr(ctx, operatorAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if err := k.Keeper.CancelScheduledMaintenance(ctx, sp, msg.StartTime); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventCancelScheduledMaintenance{
+		SpId:      sp.Id,
+		StartTime: msg.StartTime,
+		Reason:    "cancelled by storage provider",
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelScheduledMaintenanceResponse{}, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (k Keeper) GetStorageProviderReputation(ctx sdk.Context, spId uint32) (*types.SpReputation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageProviderReputationKey(k.spSequence.EncodeSequence(spId)))
+	if bz == nil {
+		return nil, false
+	}
+	var reputation types.SpReputation
+	k.cdc.MustUnmarshal(bz, &reputation)
+	return &reputation, true
+}
+
+// SetStorageProviderReputation sets the reputation of the storage provider, it is used by genesis.
+func (k Keeper) SetStorageProviderReputation(ctx sdk.Context, reputation *types.SpReputation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStorageProviderReputationKey(k.spSequence.EncodeSequence(reputation.SpId)), k.cdc.MustMarshal(reputation))
+}
+
+// GetAllStorageProviderReputations returns the reputations of all storage providers.
+func (k Keeper) GetAllStorageProviderReputations(ctx sdk.Context) (list []types.SpReputation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageProviderReputationPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reputation types.SpReputation
+		k.cdc.MustUnmarshal(iterator.Value(), &reputation)
+		list = append(list, reputation)
+	}
+	return
+}
+
+// RecordChallengesIssued records the challenges issued against the storage provider.
+func (k Keeper) RecordChallengesIssued(ctx sdk.Context, spId uint32, count uint64) {
+	k.updateReputation(ctx, spId, func(window *types.SpReputationWindow) {
+		window.ChallengesIssued += count
+	})
+}
+
+// RecordChallengeAttested records a challenge of the storage provider attested by the validators.
+func (k Keeper) RecordChallengeAttested(ctx sdk.Context, spId uint32) {
+	k.updateReputation(ctx, spId, func(window *types.SpReputationWindow) {
+		window.ChallengesAttested++
+	})
+}
+
+// RecordChallengeResult records the result of a challenge of the storage provider, it fails the challenge if it is
+// slashed and passes it if it is a heartbeat or the slash is appealed.
+func (k Keeper) RecordChallengeResult(ctx sdk.Context, spId uint32, passed bool) {
+	k.updateReputation(ctx, spId, func(window *types.SpReputationWindow) {
+		if passed {
+			window.ChallengesPassed++
+		} else {
+			window.ChallengesFailed++
+		}
+	})
+}
+
+// RecordDiscontinue records a discontinue bucket or object request of the storage provider.
+func (k Keeper) RecordDiscontinue(ctx sdk.Context, spId uint32) {
+	k.updateReputation(ctx, spId, func(window *types.SpReputationWindow) {
+		window.Discontinues++
+	})
+}
+
+// RecordForcedExit records that the storage provider is forced to exit.
+func (k Keeper) RecordForcedExit(ctx sdk.Context, spId uint32) {
+	k.updateReputation(ctx, spId, func(window *types.SpReputationWindow) {
+		window.ForcedExits++
+	})
+}
+
+func (k Keeper) recordMaintenanceOverrun(ctx sdk.Context, spId uint32) {
+	k.updateReputation(ctx, spId, func(window *types.SpReputationWindow) {
+		window.MaintenanceOverruns++
+	})
+}
+
+// updateReputation applies the update to the window of the current block time, the windows older than the rolling
+// windows are purged at the same time. The reputation is only tracked since Gobi.
+func (k Keeper) updateReputation(ctx sdk.Context, spId uint32, update func(window *types.SpReputationWindow)) {
+	if !ctx.IsUpgraded(gnfdtypes.Gobi) {
+		return
+	}
+
+	now := ctx.BlockTime().Unix()
+	reputation, found := k.GetStorageProviderReputation(ctx, spId)
+	if !found {
+		reputation = &types.SpReputation{SpId: spId}
+	}
+	reputation.Windows = reputation.ActiveWindows(now)
+
+	start := types.ReputationWindowStart(now)
+	if len(reputation.Windows) == 0 || reputation.Windows[len(reputation.Windows)-1].StartTime != start {
+		reputation.Windows = append(reputation.Windows, types.SpReputationWindow{StartTime: start})
+	}
+	update(&reputation.Windows[len(reputation.Windows)-1])
+	k.SetStorageProviderReputation(ctx, reputation)
+}
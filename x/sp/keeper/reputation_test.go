@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestStorageProviderReputation() {
+	k := s.spKeeper
+	sp := &types.StorageProvider{Id: 100, OperatorAddress: sample.RandAccAddress().String()}
+	k.SetStorageProvider(s.ctx, sp)
+
+	// the reputation is not recorded before Gobi
+	k.RecordChallengesIssued(s.ctx, sp.Id, 1)
+	_, found := k.GetStorageProviderReputation(s.ctx, sp.Id)
+	s.Require().False(found)
+
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == gnfdtypes.Gobi
+	}, s.ctx.Logger()).WithBlockTime(time.Unix(10*types.ReputationWindowDuration, 0))
+
+	// the storage provider without any service event has the max score
+	res, err := k.StorageProviderReputation(ctx, &types.QueryStorageProviderReputationRequest{Id: sp.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(types.MaxReputationScore, res.Summary.Score)
+	_, err = k.StorageProviderReputation(ctx, &types.QueryStorageProviderReputationRequest{Id: 101})
+	s.Require().ErrorIs(err, types.ErrStorageProviderNotFound)
+
+	k.RecordChallengesIssued(ctx, sp.Id, 4)
+	k.RecordChallengeAttested(ctx, sp.Id)
+	k.RecordChallengeAttested(ctx, sp.Id)
+	k.RecordChallengeResult(ctx, sp.Id, true)
+	k.RecordChallengeResult(ctx, sp.Id, false)
+
+	// the maintenance lasting longer than requested is an overrun
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.ReputationWindowDuration) * time.Second))
+	s.Require().NoError(k.UpdateToInMaintenance(ctx, sp, 100))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(200 * time.Second))
+	k.UpdateToInService(ctx, sp)
+	k.RecordDiscontinue(ctx, sp.Id)
+
+	res, err = k.StorageProviderReputation(ctx, &types.QueryStorageProviderReputationRequest{Id: sp.Id})
+	s.Require().NoError(err)
+	s.Require().Len(res.Windows, 2)
+	s.Require().Equal(uint64(4), res.Summary.Total.ChallengesIssued)
+	s.Require().Equal(uint64(1), res.Summary.Total.ChallengesPassed)
+	s.Require().Equal(uint64(1), res.Summary.Total.ChallengesFailed)
+	s.Require().Equal(uint64(2), res.Summary.Total.ChallengesAttested)
+	s.Require().Equal(uint32(5000), res.Summary.AttestationParticipation)
+	s.Require().Equal(uint64(1), res.Summary.Total.MaintenanceOverruns)
+	s.Require().Equal(uint64(1), res.Summary.Total.Discontinues)
+	s.Require().Equal(types.MaxReputationScore-1250-1000-10, res.Summary.Score)
+
+	listRes, err := k.StorageProviderReputations(ctx, &types.QueryStorageProviderReputationsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(listRes.Summaries, 1)
+	s.Require().Equal(res.Summary, listRes.Summaries[0])
+
+	// the windows out of the rolling windows are not counted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.ReputationWindowDuration*(types.ReputationWindowCount-1)) * time.Second))
+	k.RecordForcedExit(ctx, sp.Id)
+	res, err = k.StorageProviderReputation(ctx, &types.QueryStorageProviderReputationRequest{Id: sp.Id})
+	s.Require().NoError(err)
+	s.Require().Len(res.Windows, 2)
+	s.Require().Equal(uint64(0), res.Summary.Total.ChallengesIssued)
+	s.Require().Equal(uint64(1), res.Summary.Total.MaintenanceOverruns)
+	s.Require().Equal(uint64(1), res.Summary.Total.ForcedExits)
+	reputation, _ := k.GetStorageProviderReputation(ctx, sp.Id)
+	s.Require().Len(reputation.Windows, 2)
+}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// ScheduleMaintenance registers a future maintenance window of the storage provider. The windows of a storage provider
+// cannot overlap, and the total duration of them and the used maintenance time is bounded by the maintenance quota.
+func (k Keeper) ScheduleMaintenance(ctx sdk.Context, sp *types.StorageProvider, startTime, duration int64) error {
+	now := ctx.BlockTime().Unix()
+	if startTime <= now {
+		return errors.Wrapf(types.ErrInvalidMaintenanceSchedule, "start time %d should be later than %d", startTime, now)
+	}
+	if startTime > now+types.MaxMaintenanceScheduleAhead {
+		return errors.Wrapf(types.ErrInvalidMaintenanceSchedule, "start time %d should not be later than %d",
+			startTime, now+types.MaxMaintenanceScheduleAhead)
+	}
+
+	windows := k.GetScheduledMaintenances(ctx, sp.Id)
+	if len(windows) >= types.MaxScheduledMaintenances {
+		return errors.Wrapf(types.ErrInvalidMaintenanceSchedule, "at most %d maintenance windows can be scheduled",
+			types.MaxScheduledMaintenances)
+	}
+	totalTime := k.usedMaintenanceTime(ctx, sp) + duration
+	for _, window := range windows {
+		if startTime < window.StartTime+window.Duration && window.StartTime < startTime+duration {
+			return errors.Wrapf(types.ErrInvalidMaintenanceSchedule, "overlap with the maintenance window starting at %d",
+				window.StartTime)
+		}
+		totalTime += window.Duration
+	}
+	params := k.GetParams(ctx)
+	quota := params.GetMaintenanceDurationQuota()
+	if totalTime > quota {
+		return errors.Wrapf(types.ErrInvalidMaintenanceSchedule, "not enough quota, quota=%d is less than requested=%d",
+			quota, totalTime)
+	}
+
+	k.SetScheduledMaintenance(ctx, &types.ScheduledMaintenance{
+		SpId:      sp.Id,
+		StartTime: startTime,
+		Duration:  duration,
+	})
+	return nil
+}
+
+// SetScheduledMaintenance puts a maintenance window not started into the queue ordered by start time, and indexes it
+// by the storage provider.
+func (k Keeper) SetScheduledMaintenance(ctx sdk.Context, window *types.ScheduledMaintenance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	store.Set(types.GetScheduledMaintenanceKey(window.StartTime, window.SpId), k.cdc.MustMarshal(window))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenanceBySpPrefix)
+	indexStore.Set(types.GetScheduledMaintenanceBySpKey(window.SpId, window.StartTime), []byte{})
+}
+
+// SetOngoingMaintenance puts a maintenance window in progress into the queue ordered by end time.
+func (k Keeper) SetOngoingMaintenance(ctx sdk.Context, window *types.ScheduledMaintenance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenanceExitPrefix)
+	store.Set(types.GetScheduledMaintenanceKey(window.StartTime+window.Duration, window.SpId), k.cdc.MustMarshal(window))
+}
+
+// CancelScheduledMaintenance removes a maintenance window not started yet.
+func (k Keeper) CancelScheduledMaintenance(ctx sdk.Context, sp *types.StorageProvider, startTime int64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	key := types.GetScheduledMaintenanceKey(startTime, sp.Id)
+	if !store.Has(key) {
+		return errors.Wrapf(types.ErrScheduledMaintenanceNotFound, "no maintenance window starting at %d", startTime)
+	}
+	store.Delete(key)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenanceBySpPrefix)
+	indexStore.Delete(types.GetScheduledMaintenanceBySpKey(sp.Id, startTime))
+	return nil
+}
+
+// GetScheduledMaintenances returns the maintenance windows not started of the storage provider ordered by start time.
+func (k Keeper) GetScheduledMaintenances(ctx sdk.Context, spId uint32) []types.ScheduledMaintenance {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenanceBySpPrefix)
+	iter := storetypes.KVStorePrefixIterator(indexStore, types.GetScheduledMaintenanceBySpPrefix(spId))
+	defer iter.Close()
+
+	windows := make([]types.ScheduledMaintenance, 0)
+	for ; iter.Valid(); iter.Next() {
+		window, found := k.getScheduledMaintenance(ctx, spId, int64(binary.BigEndian.Uint64(iter.Key()[4:])))
+		if found {
+			windows = append(windows, *window)
+		}
+	}
+	return windows
+}
+
+// getScheduledMaintenance returns the maintenance window not started of the storage provider at the start time.
+func (k Keeper) getScheduledMaintenance(ctx sdk.Context, spId uint32, startTime int64) (*types.ScheduledMaintenance, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenancePrefix)
+	bz := store.Get(types.GetScheduledMaintenanceKey(startTime, spId))
+	if bz == nil {
+		return nil, false
+	}
+	var window types.ScheduledMaintenance
+	k.cdc.MustUnmarshal(bz, &window)
+	return &window, true
+}
+
+// GetAllScheduledMaintenances returns all the maintenance windows not started ordered by start time.
+func (k Keeper) GetAllScheduledMaintenances(ctx sdk.Context) []types.ScheduledMaintenance {
+	return k.getAllMaintenances(ctx, types.ScheduledMaintenancePrefix)
+}
+
+// GetAllOngoingMaintenances returns all the maintenance windows in progress ordered by end time.
+func (k Keeper) GetAllOngoingMaintenances(ctx sdk.Context) []types.ScheduledMaintenance {
+	return k.getAllMaintenances(ctx, types.ScheduledMaintenanceExitPrefix)
+}
+
+func (k Keeper) getAllMaintenances(ctx sdk.Context, keyPrefix []byte) []types.ScheduledMaintenance {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iter.Close()
+
+	windows := make([]types.ScheduledMaintenance, 0)
+	for ; iter.Valid(); iter.Next() {
+		var window types.ScheduledMaintenance
+		k.cdc.MustUnmarshal(iter.Value(), &window)
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// ProcessScheduledMaintenances turns the storage providers back to in service when their maintenance windows end,
+// and turns the storage providers to in maintenance when their maintenance windows start.
+func (k Keeper) ProcessScheduledMaintenances(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	for _, window := range k.popScheduledMaintenances(ctx, types.ScheduledMaintenanceExitPrefix, now) {
+		sp, found := k.GetStorageProvider(ctx, window.SpId)
+		if !found || sp.Status != types.STATUS_IN_MAINTENANCE {
+			continue
+		}
+		// the storage provider could have turned back to in service and started another maintenance by itself
+		lastRecord, found := k.lastMaintenanceRecord(ctx, sp)
+		if !found || lastRecord.RequestAt != window.StartTime || lastRecord.ActualDuration != 0 {
+			continue
+		}
+		k.updateToInService(ctx, sp, window.StartTime+window.Duration)
+		k.SetStorageProvider(ctx, sp)
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
+			SpId:      sp.Id,
+			SpAddress: sp.OperatorAddress,
+			PreStatus: types.STATUS_IN_MAINTENANCE.String(),
+			NewStatus: types.STATUS_IN_SERVICE.String(),
+		})
+	}
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledMaintenanceBySpPrefix)
+	for _, window := range k.popScheduledMaintenances(ctx, types.ScheduledMaintenancePrefix, now) {
+		indexStore.Delete(types.GetScheduledMaintenanceBySpKey(window.SpId, window.StartTime))
+		sp, found := k.GetStorageProvider(ctx, window.SpId)
+		if !found {
+			continue
+		}
+		if sp.Status != types.STATUS_IN_SERVICE {
+			_ = ctx.EventManager().EmitTypedEvents(&types.EventCancelScheduledMaintenance{
+				SpId:      window.SpId,
+				StartTime: window.StartTime,
+				Reason:    "storage provider is in status " + sp.Status.String(),
+			})
+			continue
+		}
+		if err := k.UpdateToInMaintenance(ctx, sp, window.Duration); err != nil {
+			_ = ctx.EventManager().EmitTypedEvents(&types.EventCancelScheduledMaintenance{
+				SpId:      window.SpId,
+				StartTime: window.StartTime,
+				Reason:    err.Error(),
+			})
+			continue
+		}
+		k.SetStorageProvider(ctx, sp)
+		k.SetOngoingMaintenance(ctx, &types.ScheduledMaintenance{
+			SpId:      sp.Id,
+			StartTime: now,
+			Duration:  window.Duration,
+		})
+		_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
+			SpId:      sp.Id,
+			SpAddress: sp.OperatorAddress,
+			PreStatus: types.STATUS_IN_SERVICE.String(),
+			NewStatus: types.STATUS_IN_MAINTENANCE.String(),
+		})
+	}
+}
+
+// popScheduledMaintenances removes and returns the maintenance windows under the prefix which are due at the time.
+func (k Keeper) popScheduledMaintenances(ctx sdk.Context, keyPrefix []byte, timestamp int64) []types.ScheduledMaintenance {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, types.GetScheduledMaintenanceKey(timestamp+1, 0))
+	defer iter.Close()
+
+	windows := make([]types.ScheduledMaintenance, 0)
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		var window types.ScheduledMaintenance
+		k.cdc.MustUnmarshal(iter.Value(), &window)
+		windows = append(windows, window)
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return windows
+}
+
+// usedMaintenanceTime returns the total maintenance time in the kept maintenance records of the storage provider.
+func (k Keeper) usedMaintenanceTime(ctx sdk.Context, sp *types.StorageProvider) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress)))
+	if bz == nil {
+		return 0
+	}
+	var stats types.SpMaintenanceStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	usedTime := int64(0)
+	for _, record := range stats.Records {
+		usedTime += record.GetActualDuration()
+	}
+	return usedTime
+}
+
+// lastMaintenanceRecord returns the latest maintenance record of the storage provider.
+func (k Keeper) lastMaintenanceRecord(ctx sdk.Context, sp *types.StorageProvider) (*types.MaintenanceRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress)))
+	if bz == nil {
+		return nil, false
+	}
+	var stats types.SpMaintenanceStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	if len(stats.Records) == 0 {
+		return nil, false
+	}
+	return stats.Records[len(stats.Records)-1], true
+}
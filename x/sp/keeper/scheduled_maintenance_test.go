@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestScheduledMaintenance() {
+	k := s.spKeeper
+	now := int64(1000000)
+	ctx := s.ctx.WithBlockTime(time.Unix(now, 0)).WithBlockHeight(100)
+	operatorAddr := sample.RandAccAddress()
+	sp := &types.StorageProvider{Id: 100, OperatorAddress: operatorAddr.String(), Status: types.STATUS_IN_SERVICE}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+
+	// the window should be in the future, without overlap and within the quota
+	_, err := s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, now, 3600))
+	s.Require().ErrorIs(err, types.ErrInvalidMaintenanceSchedule)
+	_, err = s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, now+types.MaxMaintenanceScheduleAhead+1, 3600))
+	s.Require().ErrorIs(err, types.ErrInvalidMaintenanceSchedule)
+	startTime := now + 1000
+	_, err = s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, startTime, 3600))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, startTime+1800, 3600))
+	s.Require().ErrorIs(err, types.ErrInvalidMaintenanceSchedule)
+	_, err = s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, startTime+10000, types.DefaultMaintenanceDurationQuota-3600+1))
+	s.Require().ErrorIs(err, types.ErrInvalidMaintenanceSchedule)
+	_, err = s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, startTime+10000, 3600))
+	s.Require().NoError(err)
+
+	res, err := k.ScheduledMaintenances(ctx, &types.QueryScheduledMaintenancesRequest{SpId: sp.Id})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ScheduledMaintenance{
+		{SpId: sp.Id, StartTime: startTime, Duration: 3600},
+		{SpId: sp.Id, StartTime: startTime + 10000, Duration: 3600},
+	}, res.Windows)
+	res, err = k.ScheduledMaintenances(ctx, &types.QueryScheduledMaintenancesRequest{SpId: sp.Id + 1})
+	s.Require().NoError(err)
+	s.Require().Len(res.Windows, 0)
+
+	// the windows of the other storage providers are not counted
+	k.SetScheduledMaintenance(ctx, &types.ScheduledMaintenance{SpId: sp.Id + 1, StartTime: startTime + 20000, Duration: 3600})
+	s.Require().Len(k.GetScheduledMaintenances(ctx, sp.Id), 2)
+	s.Require().Len(k.GetScheduledMaintenances(ctx, sp.Id+1), 1)
+	res, err = k.ScheduledMaintenances(ctx, &types.QueryScheduledMaintenancesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Windows, 3)
+	_, err = s.msgServer.CancelScheduledMaintenance(ctx, types.NewMsgCancelScheduledMaintenance(operatorAddr, startTime+20000))
+	s.Require().ErrorIs(err, types.ErrScheduledMaintenanceNotFound)
+	s.Require().NoError(k.CancelScheduledMaintenance(ctx, &types.StorageProvider{Id: sp.Id + 1}, startTime+20000))
+	s.Require().Len(k.GetScheduledMaintenances(ctx, sp.Id+1), 0)
+
+	_, err = s.msgServer.CancelScheduledMaintenance(ctx, types.NewMsgCancelScheduledMaintenance(operatorAddr, startTime+10000))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CancelScheduledMaintenance(ctx, types.NewMsgCancelScheduledMaintenance(operatorAddr, startTime+10000))
+	s.Require().ErrorIs(err, types.ErrScheduledMaintenanceNotFound)
+
+	// the storage provider enters maintenance at the start time
+	ctx = ctx.WithBlockTime(time.Unix(startTime-1, 0))
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(types.STATUS_IN_SERVICE, sp.Status)
+
+	ctx = ctx.WithBlockTime(time.Unix(startTime, 0))
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(types.STATUS_IN_MAINTENANCE, sp.Status)
+	res, err = k.ScheduledMaintenances(ctx, &types.QueryScheduledMaintenancesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Windows, 0)
+	s.Require().Len(k.GetScheduledMaintenances(ctx, sp.Id), 0)
+	s.Require().Equal([]types.ScheduledMaintenance{
+		{SpId: sp.Id, StartTime: startTime, Duration: 3600},
+	}, k.GetAllOngoingMaintenances(ctx))
+
+	// and turns back to in service when the window ends, without an overrun
+	ctx = ctx.WithBlockTime(time.Unix(startTime+3605, 0))
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(types.STATUS_IN_SERVICE, sp.Status)
+	s.Require().Len(k.GetAllOngoingMaintenances(ctx), 0)
+	records, err := k.StorageProviderMaintenanceRecordsByOperatorAddress(ctx, &types.QueryStorageProviderMaintenanceRecordsRequest{
+		OperatorAddress: operatorAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(records.Records, 1)
+	s.Require().Equal(int64(3600), records.Records[0].ActualDuration)
+	_, found := k.GetStorageProviderReputation(ctx, sp.Id)
+	s.Require().False(found)
+
+	// the window is dropped if the storage provider is not in service at the start time
+	_, err = s.msgServer.ScheduleMaintenance(ctx, types.NewMsgScheduleMaintenance(operatorAddr, startTime+5000, 3600))
+	s.Require().NoError(err)
+	sp.Status = types.STATUS_IN_JAILED
+	k.SetStorageProvider(ctx, sp)
+	ctx = ctx.WithBlockTime(time.Unix(startTime+5000, 0))
+	k.ProcessScheduledMaintenances(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(types.STATUS_IN_JAILED, sp.Status)
+	s.Require().Len(k.GetScheduledMaintenances(ctx, sp.Id), 0)
+}
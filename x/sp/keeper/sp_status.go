@@ -50,6 +50,11 @@ func (k Keeper) UpdateToInMaintenance(ctx sdk.Context, sp *types.StorageProvider
 }
 
 func (k Keeper) UpdateToInService(ctx sdk.Context, sp *types.StorageProvider) {
+	k.updateToInService(ctx, sp, ctx.BlockTime().Unix())
+}
+
+// updateToInService turns the storage provider back to in service, the maintenance is regarded as ended at endTime.
+func (k Keeper) updateToInService(ctx sdk.Context, sp *types.StorageProvider, endTime int64) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress))
 	bz := store.Get(key)
@@ -59,8 +64,11 @@ func (k Keeper) UpdateToInService(ctx sdk.Context, sp *types.StorageProvider) {
 		size := len(stats.Records)
 		if size != 0 {
 			lastRecord := stats.Records[size-1]
-			lastRecord.ActualDuration = ctx.BlockTime().Unix() - lastRecord.RequestAt
+			lastRecord.ActualDuration = endTime - lastRecord.RequestAt
 			store.Set(key, k.cdc.MustMarshal(&stats))
+			if lastRecord.ActualDuration > lastRecord.RequestDuration {
+				k.recordMaintenanceOverrun(ctx, sp.Id)
+			}
 		}
 	}
 	sp.Status = types.STATUS_IN_SERVICE
@@ -88,6 +96,7 @@ func (k Keeper) ForceUpdateMaintenanceRecords(ctx sdk.Context) {
 						store.Set(key, k.cdc.MustMarshal(&stats))
 						sp.Status = types.STATUS_IN_SERVICE
 						k.SetStorageProvider(ctx, sp)
+						k.recordMaintenanceOverrun(ctx, sp.Id)
 						changed = true
 						_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
 							SpId:      sp.Id,
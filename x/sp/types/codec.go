@@ -15,6 +15,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateSpStoragePrice{}, "sp/UpdateSpStoragePrice", nil)
 	cdc.RegisterConcrete(&DepositAuthorization{}, "sp/DepositAuthorization", nil)
 	cdc.RegisterConcrete(&MsgUpdateStorageProviderStatus{}, "sp/UpdateSpStatus", nil)
+	cdc.RegisterConcrete(&MsgScheduleMaintenance{}, "sp/ScheduleMaintenance", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledMaintenance{}, "sp/CancelScheduledMaintenance", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -33,6 +35,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateStorageProviderStatus{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgScheduleMaintenance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelScheduledMaintenance{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
@@ -30,4 +30,7 @@ var (
 	ErrSignerEmpty         = errors.Register(ModuleName, 41, "signer is empty")
 	ErrInvalidEndpointURL  = errors.Register(ModuleName, 42, "Invalid endpoint url")
 	ErrSignerNotSPOperator = errors.Register(ModuleName, 43, "signer is not sp operator account")
+
+	ErrInvalidMaintenanceSchedule   = errors.Register(ModuleName, 44, "invalid maintenance schedule")
+	ErrScheduledMaintenanceNotFound = errors.Register(ModuleName, 45, "scheduled maintenance not found")
 )
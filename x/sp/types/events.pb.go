@@ -544,6 +544,135 @@ func (m *EventUpdateStorageProviderStatus) GetNewStatus() string {
 	return ""
 }
 
+// EventScheduleMaintenance is emitted when a storage provider registers a maintenance window.
+type EventScheduleMaintenance struct {
+	// sp_id defines the identifier of storage provider which generated on-chain
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// start_time defines the timestamp to enter maintenance mode
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration defines the maintenance duration in seconds
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *EventScheduleMaintenance) Reset()         { *m = EventScheduleMaintenance{} }
+func (m *EventScheduleMaintenance) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleMaintenance) ProtoMessage()    {}
+func (*EventScheduleMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{6}
+}
+func (m *EventScheduleMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduleMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleMaintenance.Merge(m, src)
+}
+func (m *EventScheduleMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleMaintenance proto.InternalMessageInfo
+
+func (m *EventScheduleMaintenance) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventScheduleMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EventScheduleMaintenance) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// EventCancelScheduledMaintenance is emitted when a maintenance window is cancelled by the storage provider,
+// or it cannot be started at the start time.
+type EventCancelScheduledMaintenance struct {
+	// sp_id defines the identifier of storage provider which generated on-chain
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// start_time defines the start timestamp of the maintenance window
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// reason defines why the maintenance window is cancelled
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventCancelScheduledMaintenance) Reset()         { *m = EventCancelScheduledMaintenance{} }
+func (m *EventCancelScheduledMaintenance) String() string { return proto.CompactTextString(m) }
+func (*EventCancelScheduledMaintenance) ProtoMessage()    {}
+func (*EventCancelScheduledMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{7}
+}
+func (m *EventCancelScheduledMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelScheduledMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelScheduledMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelScheduledMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelScheduledMaintenance.Merge(m, src)
+}
+func (m *EventCancelScheduledMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelScheduledMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelScheduledMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelScheduledMaintenance proto.InternalMessageInfo
+
+func (m *EventCancelScheduledMaintenance) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventCancelScheduledMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EventCancelScheduledMaintenance) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateStorageProvider)(nil), "greenfield.sp.EventCreateStorageProvider")
 	proto.RegisterType((*EventEditStorageProvider)(nil), "greenfield.sp.EventEditStorageProvider")
@@ -551,64 +680,70 @@ func init() {
 	proto.RegisterType((*EventSpStoragePriceUpdate)(nil), "greenfield.sp.EventSpStoragePriceUpdate")
 	proto.RegisterType((*EventGlobalSpStorePriceUpdate)(nil), "greenfield.sp.EventGlobalSpStorePriceUpdate")
 	proto.RegisterType((*EventUpdateStorageProviderStatus)(nil), "greenfield.sp.EventUpdateStorageProviderStatus")
+	proto.RegisterType((*EventScheduleMaintenance)(nil), "greenfield.sp.EventScheduleMaintenance")
+	proto.RegisterType((*EventCancelScheduledMaintenance)(nil), "greenfield.sp.EventCancelScheduledMaintenance")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/events.proto", fileDescriptor_685cbfa50fdf0841) }
 
 var fileDescriptor_685cbfa50fdf0841 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xf6, 0x5a, 0x3f, 0xb6, 0x46, 0x56, 0xdc, 0xae, 0x13, 0x74, 0x2d, 0xc0, 0x8a, 0xa0, 0x02,
-	0x81, 0x51, 0x40, 0x12, 0x92, 0x1e, 0x72, 0x68, 0x51, 0x20, 0xb6, 0x82, 0x22, 0xe8, 0xa5, 0x5d,
-	0xb5, 0x97, 0x16, 0xc5, 0x82, 0xbb, 0x1c, 0x6f, 0x88, 0x48, 0x24, 0x4b, 0x52, 0x4e, 0x7d, 0xef,
-	0x03, 0xe4, 0xde, 0x77, 0xe8, 0x29, 0xc7, 0x3e, 0x40, 0x8e, 0x41, 0x4e, 0x45, 0x0f, 0x41, 0x61,
-	0x1f, 0xfa, 0x1a, 0xc5, 0x72, 0xb9, 0xd2, 0x4a, 0x30, 0x20, 0xd4, 0x56, 0x4e, 0x36, 0x39, 0xfc,
-	0xbe, 0x19, 0x7e, 0xf3, 0x71, 0xb4, 0xd0, 0x4e, 0x15, 0x22, 0x3f, 0x63, 0x38, 0xa1, 0x43, 0x2d,
-	0x87, 0x78, 0x8e, 0xdc, 0xe8, 0x81, 0x54, 0xc2, 0x08, 0xbf, 0xb5, 0x88, 0x0d, 0xb4, 0x6c, 0x77,
-	0x12, 0xa1, 0xa7, 0x42, 0x0f, 0x63, 0xa2, 0x71, 0x78, 0xfe, 0x30, 0x46, 0x43, 0x1e, 0x0e, 0x13,
-	0xc1, 0x78, 0x7e, 0xbc, 0x7d, 0x98, 0xc7, 0x23, 0xbb, 0x1a, 0xe6, 0x0b, 0x17, 0xba, 0x9b, 0x8a,
-	0x54, 0xe4, 0xfb, 0xd9, 0x7f, 0x05, 0x60, 0x39, 0xb7, 0xb9, 0x90, 0xe8, 0x00, 0xbd, 0xdf, 0x6b,
-	0xd0, 0x7e, 0x9a, 0xd5, 0x72, 0xaa, 0x90, 0x18, 0x1c, 0x1b, 0xa1, 0x48, 0x8a, 0xdf, 0x2a, 0x71,
-	0xce, 0x28, 0x2a, 0xff, 0x00, 0x6a, 0x5a, 0x46, 0x8c, 0x06, 0x5e, 0xd7, 0x3b, 0x6e, 0x85, 0x55,
-	0x2d, 0x9f, 0x51, 0xff, 0x31, 0x80, 0x96, 0x11, 0xa1, 0x54, 0xa1, 0xd6, 0xc1, 0x76, 0xd7, 0x3b,
-	0x6e, 0x9c, 0x04, 0xef, 0x5e, 0xf7, 0xef, 0xba, 0x52, 0x9e, 0xe4, 0x91, 0xb1, 0x51, 0x8c, 0xa7,
-	0x61, 0x43, 0x4b, 0xb7, 0xe1, 0x3f, 0x81, 0xfd, 0xb3, 0x19, 0xa7, 0x8c, 0xa7, 0x73, 0x74, 0x65,
-	0x0d, 0xfa, 0x8e, 0x03, 0x14, 0x14, 0x5f, 0xc0, 0x9e, 0x46, 0x32, 0x99, 0xe3, 0xab, 0x6b, 0xf0,
-	0xcd, 0xec, 0x74, 0x01, 0x3e, 0x85, 0x8f, 0x88, 0x94, 0x4a, 0x9c, 0x97, 0x08, 0x6a, 0x6b, 0x08,
-	0xf6, 0x0b, 0x44, 0x41, 0xf2, 0x18, 0x20, 0x4d, 0xe6, 0xf0, 0xfa, 0xba, 0xdb, 0xa7, 0x49, 0x01,
-	0x7c, 0x06, 0x07, 0x53, 0xc2, 0xb8, 0x41, 0x4e, 0x78, 0x82, 0x73, 0x86, 0x9d, 0x35, 0x0c, 0x7e,
-	0x09, 0x54, 0x50, 0xb5, 0x61, 0x17, 0x39, 0x95, 0x82, 0x71, 0x13, 0xec, 0x66, 0xf8, 0x70, 0xbe,
-	0xf6, 0xbf, 0x82, 0x96, 0x11, 0x86, 0x4c, 0x22, 0x8a, 0x52, 0x68, 0x66, 0x82, 0x46, 0xd7, 0x3b,
-	0x6e, 0x3e, 0x3a, 0x1c, 0x38, 0xf6, 0xcc, 0x55, 0x03, 0xe7, 0xaa, 0xc1, 0xa9, 0x60, 0x3c, 0xdc,
-	0xb3, 0xe7, 0x47, 0xf9, 0x71, 0xbf, 0x0f, 0x75, 0x6d, 0x88, 0x99, 0xe9, 0x00, 0xba, 0xde, 0xf1,
-	0x9d, 0x47, 0xf7, 0x06, 0x4b, 0xee, 0x1c, 0x8c, 0x6d, 0x30, 0x74, 0x87, 0xfc, 0x13, 0x68, 0x52,
-	0xd4, 0x89, 0x62, 0xd2, 0x30, 0xc1, 0x83, 0xa6, 0x4d, 0xd6, 0x5e, 0xc1, 0x8c, 0x16, 0x27, 0x4e,
-	0xaa, 0x6f, 0xde, 0xdf, 0xdf, 0x0a, 0xcb, 0x20, 0xff, 0x13, 0xd8, 0x89, 0x27, 0x3a, 0x7a, 0x81,
-	0x17, 0xc1, 0x9e, 0xbd, 0x4d, 0x3d, 0x9e, 0xe8, 0x6f, 0xf0, 0xa2, 0xf7, 0x6f, 0x05, 0x02, 0xeb,
-	0xce, 0xa7, 0x94, 0x99, 0x0f, 0xeb, 0xcd, 0xb2, 0xa4, 0x95, 0x15, 0x49, 0x57, 0xee, 0x58, 0xbd,
-	0xc9, 0x1d, 0x57, 0x8d, 0x5b, 0xbb, 0xad, 0x71, 0xeb, 0xb7, 0x33, 0xee, 0xce, 0xad, 0x8d, 0xbb,
-	0x7b, 0x03, 0xe3, 0x96, 0x3a, 0xdd, 0x58, 0xea, 0xf4, 0x2b, 0x0f, 0xf6, 0x6c, 0xa7, 0x0b, 0x1b,
-	0x5e, 0x33, 0x2b, 0xbc, 0xff, 0x39, 0x2b, 0x02, 0xd8, 0x29, 0xde, 0x80, 0x35, 0x42, 0x58, 0x2c,
-	0xfd, 0x4f, 0x57, 0xdf, 0x48, 0xde, 0xf1, 0xa5, 0x87, 0xd0, 0xfb, 0x73, 0x1b, 0x0e, 0x6d, 0x49,
-	0x63, 0x39, 0xb7, 0x1e, 0x4b, 0xf0, 0x07, 0x49, 0x89, 0xc1, 0xeb, 0xdd, 0xf7, 0x00, 0xf6, 0x67,
-	0x36, 0x1c, 0x19, 0x36, 0xc5, 0x48, 0x63, 0x62, 0x33, 0x57, 0xc2, 0x56, 0xbe, 0xfd, 0x3d, 0x9b,
-	0xe2, 0x18, 0x13, 0xff, 0x27, 0x00, 0x85, 0x84, 0x46, 0x32, 0x23, 0x74, 0x33, 0xf0, 0xcb, 0xcc,
-	0x33, 0x7f, 0xbf, 0xbf, 0xff, 0x20, 0x65, 0xe6, 0xf9, 0x2c, 0x1e, 0x24, 0x62, 0xea, 0x66, 0xbb,
-	0xfb, 0xd3, 0xd7, 0xf4, 0x85, 0x9b, 0xdd, 0x23, 0x4c, 0xde, 0xbd, 0xee, 0x83, 0x53, 0x61, 0x84,
-	0x49, 0xd8, 0xc8, 0xf8, 0x6c, 0x7d, 0x59, 0x11, 0x67, 0x0a, 0x31, 0xb2, 0x19, 0x7e, 0x99, 0x09,
-	0x43, 0xac, 0x63, 0xab, 0x61, 0x2b, 0xdb, 0x0e, 0x91, 0xd0, 0xef, 0xb2, 0x4d, 0xff, 0x67, 0x68,
-	0x6a, 0x23, 0x14, 0xba, 0x2a, 0x6a, 0x1b, 0xa8, 0x02, 0x2c, 0xa1, 0x2d, 0xa3, 0xf7, 0x5b, 0x05,
-	0x8e, 0xac, 0x7c, 0x5f, 0x4f, 0x44, 0x4c, 0x26, 0xb9, 0x88, 0x4b, 0x12, 0x5e, 0xa3, 0x96, 0xb7,
-	0x5e, 0xad, 0xed, 0xcd, 0xaa, 0x35, 0x81, 0x03, 0xa9, 0xd8, 0x94, 0xa8, 0x8b, 0xa8, 0xac, 0xc6,
-	0x26, 0x7a, 0xf2, 0xb1, 0x23, 0x5e, 0x5c, 0xdc, 0x97, 0x70, 0x4f, 0x63, 0x22, 0x38, 0x5d, 0xcd,
-	0x57, 0xdd, 0x40, 0xbe, 0x83, 0x39, 0xf5, 0x22, 0x63, 0xef, 0x0f, 0x0f, 0xba, 0xb6, 0x0d, 0xb9,
-	0xe8, 0x2b, 0x43, 0x34, 0x1f, 0xe6, 0x1b, 0x1e, 0xa5, 0x47, 0x00, 0x52, 0x61, 0xe4, 0x7e, 0x45,
-	0xf2, 0xa7, 0xd5, 0x90, 0x0a, 0x5d, 0xb2, 0x23, 0x00, 0x8e, 0x2f, 0x8b, 0x70, 0x35, 0x0f, 0x73,
-	0x7c, 0x99, 0x87, 0x4f, 0x46, 0x6f, 0x2e, 0x3b, 0xde, 0xdb, 0xcb, 0x8e, 0xf7, 0xcf, 0x65, 0xc7,
-	0x7b, 0x75, 0xd5, 0xd9, 0x7a, 0x7b, 0xd5, 0xd9, 0xfa, 0xeb, 0xaa, 0xb3, 0xf5, 0xe3, 0x67, 0x25,
-	0x55, 0x62, 0x1e, 0xf7, 0x93, 0xe7, 0x84, 0xf1, 0x61, 0xe9, 0xdb, 0xe6, 0xd7, 0xf9, 0xd7, 0x4d,
-	0x5c, 0xb7, 0x9f, 0x37, 0x9f, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x8d, 0xb2, 0x3d, 0x77,
-	0x09, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x13, 0x3f, 0xc7, 0x0d, 0x6c, 0x5a, 0xd8, 0x58, 0x8a, 0x63, 0x19, 0xa9,
+	0x8a, 0x90, 0x6c, 0xab, 0xe5, 0xd0, 0x03, 0x08, 0xa9, 0x89, 0x2b, 0x54, 0x21, 0x24, 0x58, 0xc3,
+	0x05, 0x84, 0x56, 0xe3, 0x9d, 0x97, 0xcd, 0xd0, 0xf5, 0xcc, 0x30, 0x33, 0x4e, 0xc9, 0x9d, 0x0f,
+	0xd0, 0x3b, 0xdf, 0x81, 0x53, 0x8f, 0x7c, 0x80, 0x1e, 0xab, 0x9e, 0x10, 0x87, 0x0a, 0x25, 0x07,
+	0xbe, 0x06, 0xda, 0xd9, 0xd9, 0xf5, 0x1f, 0x45, 0xb2, 0x68, 0xcc, 0xc9, 0x9e, 0x79, 0xef, 0xf7,
+	0x7b, 0x6f, 0xde, 0xfb, 0xcd, 0xdb, 0x81, 0x56, 0xa2, 0x10, 0xf9, 0x19, 0xc3, 0x94, 0x0e, 0xb4,
+	0x1c, 0xe0, 0x05, 0x72, 0xa3, 0xfb, 0x52, 0x09, 0x23, 0xfc, 0xe6, 0xcc, 0xd6, 0xd7, 0xb2, 0xd5,
+	0x8e, 0x85, 0x9e, 0x08, 0x3d, 0x18, 0x13, 0x8d, 0x83, 0x8b, 0x07, 0x63, 0x34, 0xe4, 0xc1, 0x20,
+	0x16, 0x8c, 0xe7, 0xee, 0xad, 0x83, 0xdc, 0x1e, 0xd9, 0xd5, 0x20, 0x5f, 0x38, 0xd3, 0xdd, 0x44,
+	0x24, 0x22, 0xdf, 0xcf, 0xfe, 0x15, 0x80, 0xc5, 0xd8, 0xe6, 0x52, 0xa2, 0x03, 0x74, 0x7f, 0xdb,
+	0x82, 0xd6, 0x93, 0x2c, 0x97, 0x53, 0x85, 0xc4, 0xe0, 0xc8, 0x08, 0x45, 0x12, 0xfc, 0x5a, 0x89,
+	0x0b, 0x46, 0x51, 0xf9, 0xfb, 0xb0, 0xa5, 0x65, 0xc4, 0x68, 0xe0, 0x75, 0xbc, 0xe3, 0x66, 0x58,
+	0xd5, 0xf2, 0x29, 0xf5, 0x1f, 0x01, 0x68, 0x19, 0x11, 0x4a, 0x15, 0x6a, 0x1d, 0x6c, 0x76, 0xbc,
+	0xe3, 0xfa, 0x49, 0xf0, 0xe6, 0x65, 0xef, 0xae, 0x4b, 0xe5, 0x71, 0x6e, 0x19, 0x19, 0xc5, 0x78,
+	0x12, 0xd6, 0xb5, 0x74, 0x1b, 0xfe, 0x63, 0xd8, 0x3b, 0x9b, 0x72, 0xca, 0x78, 0x52, 0xa2, 0x2b,
+	0x2b, 0xd0, 0x77, 0x1c, 0xa0, 0xa0, 0xf8, 0x14, 0x76, 0x35, 0x92, 0xb4, 0xc4, 0x57, 0x57, 0xe0,
+	0x1b, 0x99, 0x77, 0x01, 0x3e, 0x85, 0xf7, 0x88, 0x94, 0x4a, 0x5c, 0xcc, 0x11, 0x6c, 0xad, 0x20,
+	0xd8, 0x2b, 0x10, 0x05, 0xc9, 0x23, 0x80, 0x24, 0x2e, 0xe1, 0xb5, 0x55, 0xa7, 0x4f, 0xe2, 0x02,
+	0xf8, 0x14, 0xf6, 0x27, 0x84, 0x71, 0x83, 0x9c, 0xf0, 0x18, 0x4b, 0x86, 0xed, 0x15, 0x0c, 0xfe,
+	0x1c, 0xa8, 0xa0, 0x6a, 0xc1, 0x0e, 0x72, 0x2a, 0x05, 0xe3, 0x26, 0xd8, 0xc9, 0xf0, 0x61, 0xb9,
+	0xf6, 0x3f, 0x87, 0xa6, 0x11, 0x86, 0xa4, 0x11, 0x45, 0x29, 0x34, 0x33, 0x41, 0xbd, 0xe3, 0x1d,
+	0x37, 0x1e, 0x1e, 0xf4, 0x1d, 0x7b, 0xa6, 0xaa, 0xbe, 0x53, 0x55, 0xff, 0x54, 0x30, 0x1e, 0xee,
+	0x5a, 0xff, 0x61, 0xee, 0xee, 0xf7, 0xa0, 0xa6, 0x0d, 0x31, 0x53, 0x1d, 0x40, 0xc7, 0x3b, 0xbe,
+	0xf3, 0xf0, 0x5e, 0x7f, 0x41, 0x9d, 0xfd, 0x91, 0x35, 0x86, 0xce, 0xc9, 0x3f, 0x81, 0x06, 0x45,
+	0x1d, 0x2b, 0x26, 0x0d, 0x13, 0x3c, 0x68, 0xd8, 0x60, 0xad, 0x25, 0xcc, 0x70, 0xe6, 0x71, 0x52,
+	0x7d, 0xf5, 0xf6, 0x68, 0x23, 0x9c, 0x07, 0xf9, 0x1f, 0xc2, 0xf6, 0x38, 0xd5, 0xd1, 0x33, 0xbc,
+	0x0c, 0x76, 0xed, 0x69, 0x6a, 0xe3, 0x54, 0x7f, 0x89, 0x97, 0xdd, 0x7f, 0x2a, 0x10, 0x58, 0x75,
+	0x3e, 0xa1, 0xcc, 0xfc, 0xbf, 0xda, 0x9c, 0x2f, 0x69, 0x65, 0xa9, 0xa4, 0x4b, 0x67, 0xac, 0xbe,
+	0xcb, 0x19, 0x97, 0x85, 0xbb, 0x75, 0x5b, 0xe1, 0xd6, 0x6e, 0x27, 0xdc, 0xed, 0x5b, 0x0b, 0x77,
+	0xe7, 0x1d, 0x84, 0x3b, 0xd7, 0xe9, 0xfa, 0x42, 0xa7, 0x5f, 0x78, 0xb0, 0x6b, 0x3b, 0x5d, 0xc8,
+	0xf0, 0x86, 0x59, 0xe1, 0xfd, 0xc7, 0x59, 0x11, 0xc0, 0x76, 0x71, 0x07, 0xac, 0x10, 0xc2, 0x62,
+	0xe9, 0x7f, 0xb4, 0x7c, 0x47, 0xf2, 0x8e, 0x2f, 0x5c, 0x84, 0xee, 0x1f, 0x9b, 0x70, 0x60, 0x53,
+	0x1a, 0xc9, 0x52, 0x7a, 0x2c, 0xc6, 0xef, 0x24, 0x25, 0x06, 0x6f, 0x56, 0xdf, 0x7d, 0xd8, 0x9b,
+	0x5a, 0x73, 0x64, 0xd8, 0x04, 0x23, 0x8d, 0xb1, 0x8d, 0x5c, 0x09, 0x9b, 0xf9, 0xf6, 0xb7, 0x6c,
+	0x82, 0x23, 0x8c, 0xfd, 0x1f, 0x00, 0x14, 0x12, 0x1a, 0xc9, 0x8c, 0xd0, 0xcd, 0xc0, 0xcf, 0x32,
+	0xcd, 0xfc, 0xf5, 0xf6, 0xe8, 0x7e, 0xc2, 0xcc, 0xf9, 0x74, 0xdc, 0x8f, 0xc5, 0xc4, 0xcd, 0x76,
+	0xf7, 0xd3, 0xd3, 0xf4, 0x99, 0x9b, 0xdd, 0x43, 0x8c, 0xdf, 0xbc, 0xec, 0x81, 0xab, 0xc2, 0x10,
+	0xe3, 0xb0, 0x9e, 0xf1, 0xd9, 0xfc, 0xb2, 0x24, 0xce, 0x14, 0x62, 0x64, 0x23, 0xfc, 0x3c, 0x15,
+	0x86, 0x58, 0xc5, 0x56, 0xc3, 0x66, 0xb6, 0x1d, 0x22, 0xa1, 0xdf, 0x64, 0x9b, 0xfe, 0x8f, 0xd0,
+	0xd0, 0x46, 0x28, 0x74, 0x59, 0x6c, 0xad, 0x21, 0x0b, 0xb0, 0x84, 0x36, 0x8d, 0xee, 0xaf, 0x15,
+	0x38, 0xb4, 0xe5, 0xfb, 0x22, 0x15, 0x63, 0x92, 0xe6, 0x45, 0x5c, 0x28, 0xe1, 0x0d, 0xd5, 0xf2,
+	0x56, 0x57, 0x6b, 0x73, 0xbd, 0xd5, 0x4a, 0x61, 0x5f, 0x2a, 0x36, 0x21, 0xea, 0x32, 0x9a, 0xaf,
+	0xc6, 0x3a, 0x7a, 0xf2, 0xbe, 0x23, 0x9e, 0x1d, 0xdc, 0x97, 0x70, 0x4f, 0x63, 0x2c, 0x38, 0x5d,
+	0x8e, 0x57, 0x5d, 0x43, 0xbc, 0xfd, 0x92, 0x7a, 0x16, 0xb1, 0xfb, 0xbb, 0x07, 0x1d, 0xdb, 0x86,
+	0xbc, 0xe8, 0x4b, 0x43, 0x34, 0x1f, 0xe6, 0x6b, 0x1e, 0xa5, 0x87, 0x00, 0x52, 0x61, 0xe4, 0xbe,
+	0x22, 0xf9, 0xd5, 0xaa, 0x4b, 0x85, 0x2e, 0xd8, 0x21, 0x00, 0xc7, 0xe7, 0x85, 0xb9, 0x9a, 0x9b,
+	0x39, 0x3e, 0xcf, 0xcd, 0xdd, 0x9f, 0xdc, 0xc8, 0x1f, 0xc5, 0xe7, 0x48, 0xa7, 0x29, 0x7e, 0x35,
+	0x9b, 0x22, 0x37, 0xe7, 0x79, 0x08, 0xa0, 0x0d, 0x51, 0xc6, 0xaa, 0xc8, 0xdd, 0xb7, 0xba, 0xdd,
+	0xc9, 0x04, 0x94, 0x0d, 0x76, 0x3a, 0x55, 0xc4, 0x4e, 0xee, 0x8a, 0x35, 0x96, 0xeb, 0xee, 0x04,
+	0x8e, 0xf2, 0xc7, 0x4f, 0xc6, 0x9e, 0x16, 0x11, 0xe9, 0x6d, 0x43, 0x7e, 0x00, 0x35, 0x85, 0x44,
+	0xbb, 0x80, 0xf5, 0xd0, 0xad, 0x4e, 0x86, 0xaf, 0xae, 0xda, 0xde, 0xeb, 0xab, 0xb6, 0xf7, 0xf7,
+	0x55, 0xdb, 0x7b, 0x71, 0xdd, 0xde, 0x78, 0x7d, 0xdd, 0xde, 0xf8, 0xf3, 0xba, 0xbd, 0xf1, 0xfd,
+	0xc7, 0x73, 0x0d, 0x1f, 0xf3, 0x71, 0x2f, 0x3e, 0x27, 0x8c, 0x0f, 0xe6, 0x9e, 0x6d, 0xbf, 0x94,
+	0x0f, 0xb7, 0x71, 0xcd, 0xbe, 0xdc, 0x3e, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x1f, 0xbf,
+	0x0c, 0x52, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventCreateStorageProvider) Marshal() (dAtA []byte, err error) {
@@ -1018,6 +1153,84 @@ func (m *EventUpdateStorageProviderStatus) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *EventScheduleMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelScheduledMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelScheduledMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelScheduledMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1209,6 +1422,43 @@ func (m *EventUpdateStorageProviderStatus) Size() (n int) {
 	return n
 }
 
+func (m *EventScheduleMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *EventCancelScheduledMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2611,6 +2861,233 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventScheduleMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelScheduledMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelScheduledMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelScheduledMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
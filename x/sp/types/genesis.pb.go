@@ -29,6 +29,11 @@ type GenesisState struct {
 	// this used by starport scaffolding # genesis/proto/state
 	StorageProviders   []StorageProvider `protobuf:"bytes,2,rep,name=storage_providers,json=storageProviders,proto3" json:"storage_providers"`
 	SpStoragePriceList []SpStoragePrice  `protobuf:"bytes,3,rep,name=sp_storage_price_list,json=spStoragePriceList,proto3" json:"sp_storage_price_list"`
+	SpReputations      []SpReputation    `protobuf:"bytes,4,rep,name=sp_reputations,json=spReputations,proto3" json:"sp_reputations"`
+	// scheduled_maintenances defines the maintenance windows not started.
+	ScheduledMaintenances []ScheduledMaintenance `protobuf:"bytes,5,rep,name=scheduled_maintenances,json=scheduledMaintenances,proto3" json:"scheduled_maintenances"`
+	// ongoing_maintenances defines the maintenance windows in progress, the start time is the actual one.
+	OngoingMaintenances []ScheduledMaintenance `protobuf:"bytes,6,rep,name=ongoing_maintenances,json=ongoingMaintenances,proto3" json:"ongoing_maintenances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +90,27 @@ func (m *GenesisState) GetSpStoragePriceList() []SpStoragePrice {
 	return nil
 }
 
+func (m *GenesisState) GetSpReputations() []SpReputation {
+	if m != nil {
+		return m.SpReputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledMaintenances() []ScheduledMaintenance {
+	if m != nil {
+		return m.ScheduledMaintenances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOngoingMaintenances() []ScheduledMaintenance {
+	if m != nil {
+		return m.OngoingMaintenances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "greenfield.sp.GenesisState")
 }
@@ -92,25 +118,31 @@ func init() {
 func init() { proto.RegisterFile("greenfield/sp/genesis.proto", fileDescriptor_3cf352e27d3a7d62) }
 
 var fileDescriptor_3cf352e27d3a7d62 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xb3, 0x56, 0x7a, 0x48, 0x15, 0x34, 0x58, 0xa8, 0x11, 0xd7, 0xe2, 0xa9, 0x08, 0x66,
-	0xa1, 0x7d, 0x83, 0x22, 0x78, 0xf1, 0x50, 0x2d, 0x78, 0xf0, 0x12, 0x36, 0xe9, 0xb8, 0x5d, 0x68,
-	0xb3, 0xcb, 0xce, 0x2a, 0xfa, 0x16, 0x3e, 0x56, 0x8f, 0x3d, 0x7a, 0x12, 0x49, 0xee, 0x3e, 0x83,
-	0x98, 0x2c, 0xad, 0xdb, 0xdb, 0x30, 0xdf, 0x3f, 0xdf, 0xc0, 0x1f, 0x9e, 0x09, 0x03, 0x50, 0x3c,
-	0x4b, 0x58, 0xcc, 0x18, 0x6a, 0x26, 0xa0, 0x00, 0x94, 0x98, 0x68, 0xa3, 0xac, 0x8a, 0x0e, 0xb7,
-	0x30, 0x41, 0x1d, 0x9f, 0x08, 0x25, 0x54, 0x4d, 0xd8, 0xdf, 0xd4, 0x84, 0xe2, 0xd8, 0x37, 0x68,
-	0x6e, 0xf8, 0xd2, 0x09, 0xe2, 0x53, 0x9f, 0xd9, 0x77, 0x0d, 0x0e, 0x5d, 0xfe, 0x90, 0xf0, 0xe0,
-	0xb6, 0xf9, 0x36, 0xb5, 0xdc, 0x42, 0x34, 0x0a, 0xdb, 0xcd, 0x6d, 0x8f, 0xf4, 0xc9, 0xa0, 0x33,
-	0xec, 0x26, 0xde, 0xf7, 0x64, 0x52, 0xc3, 0xf1, 0xfe, 0xea, 0xeb, 0x22, 0x78, 0x70, 0xd1, 0xe8,
-	0x3e, 0x3c, 0x46, 0xab, 0x0c, 0x17, 0x90, 0x6a, 0xa3, 0x5e, 0xe5, 0x0c, 0x0c, 0xf6, 0xf6, 0xfa,
-	0xad, 0x41, 0x67, 0x48, 0x77, 0xee, 0xa7, 0x4d, 0x6e, 0xe2, 0x62, 0x4e, 0x74, 0x84, 0xfe, 0x1a,
-	0xa3, 0xc7, 0xb0, 0x8b, 0x3a, 0xdd, 0x5a, 0x65, 0x0e, 0xe9, 0x42, 0xa2, 0xed, 0xb5, 0x6a, 0xed,
-	0xf9, 0xae, 0x56, 0x6f, 0xc4, 0x32, 0x07, 0x67, 0x8d, 0xd0, 0xdb, 0xde, 0x49, 0xb4, 0xe3, 0x9b,
-	0x55, 0x49, 0xc9, 0xba, 0xa4, 0xe4, 0xbb, 0xa4, 0xe4, 0xa3, 0xa2, 0xc1, 0xba, 0xa2, 0xc1, 0x67,
-	0x45, 0x83, 0xa7, 0x2b, 0x21, 0xed, 0xfc, 0x25, 0x4b, 0x72, 0xb5, 0x64, 0x59, 0x91, 0x5d, 0xe7,
-	0x73, 0x2e, 0x0b, 0xf6, 0xaf, 0xba, 0xb7, 0x4d, 0x79, 0x59, 0xbb, 0x6e, 0x6f, 0xf4, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0x47, 0x77, 0x55, 0xb8, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd2, 0x41, 0x6b, 0x1a, 0x41,
+	0x14, 0x07, 0xf0, 0xdd, 0x6a, 0x3d, 0x8c, 0xb5, 0xb4, 0x53, 0x2d, 0xdb, 0x95, 0x6e, 0xa5, 0xbd,
+	0x48, 0xa1, 0xbb, 0xa0, 0xdf, 0x40, 0x0a, 0xed, 0xa1, 0x05, 0xab, 0xd0, 0x43, 0x29, 0x6c, 0x66,
+	0x77, 0x5f, 0xc6, 0x01, 0x9d, 0x19, 0xf6, 0x8d, 0x21, 0xf9, 0x16, 0xf9, 0x58, 0x1e, 0x3d, 0xe6,
+	0x14, 0x82, 0x7e, 0x89, 0x1c, 0x43, 0x76, 0x27, 0xea, 0x9a, 0x5c, 0x72, 0x1b, 0xde, 0xff, 0x3f,
+	0xbf, 0x77, 0x79, 0xa4, 0xcb, 0x73, 0x00, 0x79, 0x2a, 0x60, 0x9e, 0x45, 0xa8, 0x23, 0x0e, 0x12,
+	0x50, 0x60, 0xa8, 0x73, 0x65, 0x14, 0x6d, 0xed, 0xc3, 0x10, 0xb5, 0xdf, 0xe6, 0x8a, 0xab, 0x22,
+	0x89, 0xee, 0x5f, 0x65, 0xc9, 0xf7, 0xab, 0x82, 0x66, 0x39, 0x5b, 0x58, 0xc0, 0xff, 0x50, 0xcd,
+	0xcc, 0x85, 0x06, 0x1b, 0x7d, 0xbe, 0xad, 0x91, 0x57, 0x3f, 0xca, 0x6d, 0x53, 0xc3, 0x0c, 0xd0,
+	0x21, 0x69, 0x94, 0x7f, 0x3d, 0xb7, 0xe7, 0xf6, 0x9b, 0x83, 0x4e, 0x58, 0xd9, 0x1e, 0x8e, 0x8b,
+	0x70, 0x54, 0x5f, 0x5d, 0x7f, 0x72, 0x26, 0xb6, 0x4a, 0xff, 0x90, 0xb7, 0x68, 0x54, 0xce, 0x38,
+	0xc4, 0x3a, 0x57, 0x67, 0x22, 0x83, 0x1c, 0xbd, 0x17, 0xbd, 0x5a, 0xbf, 0x39, 0x08, 0x8e, 0xfe,
+	0x4f, 0xcb, 0xde, 0xd8, 0xd6, 0x2c, 0xf4, 0x06, 0xab, 0x63, 0xa4, 0x7f, 0x49, 0x07, 0x75, 0xbc,
+	0x57, 0x45, 0x0a, 0xf1, 0x5c, 0xa0, 0xf1, 0x6a, 0x05, 0xfb, 0xf1, 0x98, 0xd5, 0x3b, 0x58, 0xa4,
+	0x60, 0x55, 0x8a, 0x95, 0xe9, 0x2f, 0x81, 0x86, 0xfe, 0x24, 0xaf, 0x51, 0xc7, 0x39, 0xe8, 0xa5,
+	0x61, 0x46, 0x28, 0x89, 0x5e, 0xbd, 0x00, 0xbb, 0x8f, 0xc0, 0xc9, 0xae, 0x63, 0xb9, 0x16, 0x1e,
+	0xcc, 0x90, 0x9e, 0x90, 0xf7, 0x98, 0xce, 0x20, 0x5b, 0xce, 0x21, 0x8b, 0x17, 0x4c, 0x48, 0x03,
+	0x92, 0xc9, 0x14, 0xd0, 0x7b, 0x59, 0x88, 0x5f, 0x8e, 0xc5, 0x87, 0xf2, 0xef, 0x7d, 0xd7, 0xca,
+	0x1d, 0x7c, 0x22, 0x43, 0xfa, 0x9f, 0xb4, 0x95, 0xe4, 0x4a, 0x48, 0x5e, 0xf5, 0x1b, 0xcf, 0xf5,
+	0xdf, 0x59, 0xe6, 0x50, 0x1f, 0x7d, 0x5f, 0x6d, 0x02, 0x77, 0xbd, 0x09, 0xdc, 0x9b, 0x4d, 0xe0,
+	0x5e, 0x6e, 0x03, 0x67, 0xbd, 0x0d, 0x9c, 0xab, 0x6d, 0xe0, 0xfc, 0xfb, 0xca, 0x85, 0x99, 0x2d,
+	0x93, 0x30, 0x55, 0x8b, 0x28, 0x91, 0xc9, 0xb7, 0x74, 0xc6, 0x84, 0x8c, 0x0e, 0x8e, 0xe8, 0x7c,
+	0x77, 0x46, 0x49, 0xa3, 0xb8, 0xa3, 0xe1, 0x5d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xe4, 0x1f,
+	0x69, 0xc2, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +165,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OngoingMaintenances) > 0 {
+		for iNdEx := len(m.OngoingMaintenances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OngoingMaintenances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ScheduledMaintenances) > 0 {
+		for iNdEx := len(m.ScheduledMaintenances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMaintenances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpReputations) > 0 {
+		for iNdEx := len(m.SpReputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpReputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.SpStoragePriceList) > 0 {
 		for iNdEx := len(m.SpStoragePriceList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -205,6 +279,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SpReputations) > 0 {
+		for _, e := range m.SpReputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledMaintenances) > 0 {
+		for _, e := range m.ScheduledMaintenances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OngoingMaintenances) > 0 {
+		for _, e := range m.OngoingMaintenances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -344,6 +436,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpReputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpReputations = append(m.SpReputations, SpReputation{})
+			if err := m.SpReputations[len(m.SpReputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMaintenances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMaintenances = append(m.ScheduledMaintenances, ScheduledMaintenance{})
+			if err := m.ScheduledMaintenances[len(m.ScheduledMaintenances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OngoingMaintenances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OngoingMaintenances = append(m.OngoingMaintenances, ScheduledMaintenance{})
+			if err := m.OngoingMaintenances[len(m.OngoingMaintenances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,6 +36,10 @@ var (
 	StorageProviderSequenceKey       = []byte{0x31}
 
 	StorageProviderMaintenanceRecordPrefix = []byte{0x41}
+	StorageProviderReputationPrefix        = []byte{0x42}
+	ScheduledMaintenancePrefix             = []byte{0x43} // prefix of the maintenance windows not started, by start time
+	ScheduledMaintenanceExitPrefix         = []byte{0x44} // prefix of the maintenance windows in progress, by end time
+	ScheduledMaintenanceBySpPrefix         = []byte{0x45} // prefix of the maintenance windows not started, by sp id
 )
 
 // GetStorageProviderKey creates the key for the provider with address
@@ -123,3 +127,33 @@ func ParseGlobalSpStorePriceKey(key []byte) (timestamp int64) {
 func GetStorageProviderMaintenanceRecordsKey(spAddr sdk.AccAddress) []byte {
 	return append(StorageProviderMaintenanceRecordPrefix, spAddr.Bytes()...)
 }
+
+// GetStorageProviderReputationKey creates the key for the reputation of the storage provider with id
+func GetStorageProviderReputationKey(id []byte) []byte {
+	return append(StorageProviderReputationPrefix, id...)
+}
+
+// GetScheduledMaintenanceKey creates the key of a maintenance window with the time and storage provider id, it is
+// used under ScheduledMaintenancePrefix and ScheduledMaintenanceExitPrefix to keep the windows ordered by time.
+func GetScheduledMaintenanceKey(timestamp int64, spId uint32) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(timestamp))
+	binary.BigEndian.PutUint32(bz[8:], spId)
+	return bz
+}
+
+// GetScheduledMaintenanceBySpKey creates the index key of a maintenance window with the storage provider id and the
+// start time, it is used under ScheduledMaintenanceBySpPrefix to find the windows of a storage provider.
+func GetScheduledMaintenanceBySpKey(spId uint32, startTime int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(startTime))
+	return append(GetScheduledMaintenanceBySpPrefix(spId), bz...)
+}
+
+// GetScheduledMaintenanceBySpPrefix creates the prefix of the index keys of the maintenance windows of a storage
+// provider.
+func GetScheduledMaintenanceBySpPrefix(spId uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spId)
+	return bz
+}
@@ -19,6 +19,8 @@ const (
 	TypeMsgUpdateSpStoragePrice        = "update_sp_storage_price"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgUpdateStorageProviderStatus = "update_storage_provider_status"
+	TypeMsgScheduleMaintenance         = "schedule_maintenance"
+	TypeMsgCancelScheduledMaintenance  = "cancel_scheduled_maintenance"
 )
 
 var (
@@ -29,6 +31,8 @@ var (
 	_ sdk.Msg = &MsgUpdateSpStoragePrice{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgUpdateStorageProviderStatus{}
+	_ sdk.Msg = &MsgScheduleMaintenance{}
+	_ sdk.Msg = &MsgCancelScheduledMaintenance{}
 )
 
 // NewMsgCreateStorageProvider creates a new MsgCreateStorageProvider instance.
@@ -374,6 +378,98 @@ func (msg *MsgUpdateStorageProviderStatus) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgScheduleMaintenance creates a new MsgScheduleMaintenance instance
+func NewMsgScheduleMaintenance(spAddress sdk.AccAddress, startTime, duration int64) *MsgScheduleMaintenance {
+	return &MsgScheduleMaintenance{
+		SpAddress: spAddress.String(),
+		StartTime: startTime,
+		Duration:  duration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) Type() string {
+	return TypeMsgScheduleMaintenance
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgScheduleMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgScheduleMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.StartTime <= 0 {
+		return errors.Wrapf(ErrInvalidMaintenanceSchedule, "invalid start time %d", msg.StartTime)
+	}
+	if msg.Duration <= 0 {
+		return errors.Wrapf(ErrInvalidMaintenanceSchedule, "invalid duration %d", msg.Duration)
+	}
+	return nil
+}
+
+// NewMsgCancelScheduledMaintenance creates a new MsgCancelScheduledMaintenance instance
+func NewMsgCancelScheduledMaintenance(spAddress sdk.AccAddress, startTime int64) *MsgCancelScheduledMaintenance {
+	return &MsgCancelScheduledMaintenance{
+		SpAddress: spAddress.String(),
+		StartTime: startTime,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) Type() string {
+	return TypeMsgCancelScheduledMaintenance
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgCancelScheduledMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgCancelScheduledMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.StartTime <= 0 {
+		return errors.Wrapf(ErrInvalidMaintenanceSchedule, "invalid start time %d", msg.StartTime)
+	}
+	return nil
+}
+
 func validateBlsKeyAndProof(blsKey, blsProof string) error {
 	blsPk, err := hex.DecodeString(blsKey)
 	if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
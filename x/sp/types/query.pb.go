@@ -670,6 +670,381 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) GetRecords() []*Mainten
 	return nil
 }
 
+// SpReputationSummary defines the aggregated service events and the score of a storage provider in the rolling windows.
+type SpReputationSummary struct {
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// total defines the sum of the service events in the rolling windows, its start_time is the start of the earliest window.
+	Total SpReputationWindow `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	// score defines the service-level score in basis points, 10000 means no failure at all.
+	Score uint32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// attestation_participation defines the ratio in basis points of the issued challenges attested by the validators.
+	AttestationParticipation uint32 `protobuf:"varint,4,opt,name=attestation_participation,json=attestationParticipation,proto3" json:"attestation_participation,omitempty"`
+}
+
+func (m *SpReputationSummary) Reset()         { *m = SpReputationSummary{} }
+func (m *SpReputationSummary) String() string { return proto.CompactTextString(m) }
+func (*SpReputationSummary) ProtoMessage()    {}
+func (*SpReputationSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{14}
+}
+func (m *SpReputationSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpReputationSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpReputationSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpReputationSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpReputationSummary.Merge(m, src)
+}
+func (m *SpReputationSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpReputationSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpReputationSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpReputationSummary proto.InternalMessageInfo
+
+func (m *SpReputationSummary) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpReputationSummary) GetTotal() SpReputationWindow {
+	if m != nil {
+		return m.Total
+	}
+	return SpReputationWindow{}
+}
+
+func (m *SpReputationSummary) GetScore() uint32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SpReputationSummary) GetAttestationParticipation() uint32 {
+	if m != nil {
+		return m.AttestationParticipation
+	}
+	return 0
+}
+
+type QueryStorageProviderReputationRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryStorageProviderReputationRequest) Reset()         { *m = QueryStorageProviderReputationRequest{} }
+func (m *QueryStorageProviderReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderReputationRequest) ProtoMessage()    {}
+func (*QueryStorageProviderReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{15}
+}
+func (m *QueryStorageProviderReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderReputationRequest.Merge(m, src)
+}
+func (m *QueryStorageProviderReputationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderReputationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProviderReputationRequest proto.InternalMessageInfo
+
+func (m *QueryStorageProviderReputationRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryStorageProviderReputationResponse struct {
+	Summary SpReputationSummary  `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
+	Windows []SpReputationWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows"`
+}
+
+func (m *QueryStorageProviderReputationResponse) Reset() {
+	*m = QueryStorageProviderReputationResponse{}
+}
+func (m *QueryStorageProviderReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderReputationResponse) ProtoMessage()    {}
+func (*QueryStorageProviderReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{16}
+}
+func (m *QueryStorageProviderReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderReputationResponse.Merge(m, src)
+}
+func (m *QueryStorageProviderReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProviderReputationResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProviderReputationResponse) GetSummary() SpReputationSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return SpReputationSummary{}
+}
+
+func (m *QueryStorageProviderReputationResponse) GetWindows() []SpReputationWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+type QueryStorageProviderReputationsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageProviderReputationsRequest) Reset() {
+	*m = QueryStorageProviderReputationsRequest{}
+}
+func (m *QueryStorageProviderReputationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderReputationsRequest) ProtoMessage()    {}
+func (*QueryStorageProviderReputationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{17}
+}
+func (m *QueryStorageProviderReputationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderReputationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderReputationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderReputationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderReputationsRequest.Merge(m, src)
+}
+func (m *QueryStorageProviderReputationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderReputationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderReputationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProviderReputationsRequest proto.InternalMessageInfo
+
+func (m *QueryStorageProviderReputationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStorageProviderReputationsResponse struct {
+	Summaries  []SpReputationSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageProviderReputationsResponse) Reset() {
+	*m = QueryStorageProviderReputationsResponse{}
+}
+func (m *QueryStorageProviderReputationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderReputationsResponse) ProtoMessage()    {}
+func (*QueryStorageProviderReputationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{18}
+}
+func (m *QueryStorageProviderReputationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderReputationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderReputationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderReputationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderReputationsResponse.Merge(m, src)
+}
+func (m *QueryStorageProviderReputationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderReputationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderReputationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProviderReputationsResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProviderReputationsResponse) GetSummaries() []SpReputationSummary {
+	if m != nil {
+		return m.Summaries
+	}
+	return nil
+}
+
+func (m *QueryStorageProviderReputationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledMaintenancesRequest struct {
+	// sp_id is the storage provider to query, zero for all the storage providers.
+	SpId       uint32             `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledMaintenancesRequest) Reset()         { *m = QueryScheduledMaintenancesRequest{} }
+func (m *QueryScheduledMaintenancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMaintenancesRequest) ProtoMessage()    {}
+func (*QueryScheduledMaintenancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{19}
+}
+func (m *QueryScheduledMaintenancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMaintenancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMaintenancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledMaintenancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMaintenancesRequest.Merge(m, src)
+}
+func (m *QueryScheduledMaintenancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMaintenancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMaintenancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledMaintenancesRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledMaintenancesRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *QueryScheduledMaintenancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledMaintenancesResponse struct {
+	Windows    []ScheduledMaintenance `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledMaintenancesResponse) Reset()         { *m = QueryScheduledMaintenancesResponse{} }
+func (m *QueryScheduledMaintenancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMaintenancesResponse) ProtoMessage()    {}
+func (*QueryScheduledMaintenancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{20}
+}
+func (m *QueryScheduledMaintenancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMaintenancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMaintenancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledMaintenancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMaintenancesResponse.Merge(m, src)
+}
+func (m *QueryScheduledMaintenancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMaintenancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMaintenancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledMaintenancesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledMaintenancesResponse) GetWindows() []ScheduledMaintenance {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *QueryScheduledMaintenancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.sp.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.sp.QueryParamsResponse")
@@ -685,67 +1060,94 @@ func init() {
 	proto.RegisterType((*QueryStorageProviderByOperatorAddressResponse)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressResponse")
 	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsRequest)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsRequest")
 	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsResponse)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsResponse")
+	proto.RegisterType((*SpReputationSummary)(nil), "greenfield.sp.SpReputationSummary")
+	proto.RegisterType((*QueryStorageProviderReputationRequest)(nil), "greenfield.sp.QueryStorageProviderReputationRequest")
+	proto.RegisterType((*QueryStorageProviderReputationResponse)(nil), "greenfield.sp.QueryStorageProviderReputationResponse")
+	proto.RegisterType((*QueryStorageProviderReputationsRequest)(nil), "greenfield.sp.QueryStorageProviderReputationsRequest")
+	proto.RegisterType((*QueryStorageProviderReputationsResponse)(nil), "greenfield.sp.QueryStorageProviderReputationsResponse")
+	proto.RegisterType((*QueryScheduledMaintenancesRequest)(nil), "greenfield.sp.QueryScheduledMaintenancesRequest")
+	proto.RegisterType((*QueryScheduledMaintenancesResponse)(nil), "greenfield.sp.QueryScheduledMaintenancesResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/query.proto", fileDescriptor_48dd9c8aad3b7a6d) }
 
 var fileDescriptor_48dd9c8aad3b7a6d = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x4f, 0xf4, 0x44,
-	0x18, 0xde, 0x2e, 0xba, 0x5f, 0x98, 0x2f, 0xdf, 0x07, 0x19, 0x21, 0x48, 0x65, 0x17, 0xa8, 0xa2,
-	0xec, 0x02, 0xad, 0xec, 0x4a, 0x62, 0x40, 0x13, 0x25, 0x44, 0xd4, 0x84, 0x80, 0xd5, 0x8b, 0x5c,
-	0x9a, 0xe9, 0x76, 0x28, 0x4d, 0x76, 0x3b, 0x43, 0xa7, 0x10, 0x37, 0x84, 0x0b, 0xfe, 0x01, 0x13,
-	0xf5, 0xe2, 0xc5, 0xbf, 0xc3, 0xc1, 0x03, 0x89, 0x17, 0xe3, 0xc1, 0x18, 0xf0, 0x4f, 0x78, 0x33,
-	0x9d, 0x99, 0xee, 0xd2, 0xd9, 0x2e, 0x5b, 0xc9, 0x77, 0xdb, 0xce, 0xfb, 0x3e, 0xef, 0xfb, 0x3c,
-	0xef, 0xbc, 0x7d, 0xb6, 0x60, 0xde, 0x8f, 0x30, 0x0e, 0x4f, 0x02, 0xdc, 0xf1, 0x2c, 0x46, 0xad,
-	0xb3, 0x73, 0x1c, 0xf5, 0x4c, 0x1a, 0x91, 0x98, 0xc0, 0x17, 0x83, 0x90, 0xc9, 0xa8, 0xde, 0x68,
-	0x13, 0xd6, 0x25, 0xcc, 0x72, 0x11, 0xc3, 0x22, 0xcf, 0xba, 0xd8, 0x74, 0x71, 0x8c, 0x36, 0x2d,
-	0x8a, 0xfc, 0x20, 0x44, 0x71, 0x40, 0x42, 0x01, 0xd5, 0xe7, 0x45, 0xae, 0xc3, 0x9f, 0x2c, 0xf1,
-	0x20, 0x43, 0x33, 0x3e, 0xf1, 0x89, 0x38, 0x4f, 0x7e, 0xc9, 0xd3, 0x05, 0x9f, 0x10, 0xbf, 0x83,
-	0x2d, 0x44, 0x03, 0x0b, 0x85, 0x21, 0x89, 0x79, 0xb5, 0x14, 0xa3, 0x67, 0x49, 0x52, 0x14, 0xa1,
-	0x6e, 0x1a, 0x53, 0x04, 0xc4, 0x3d, 0x8a, 0x65, 0xc8, 0x98, 0x01, 0xf0, 0xab, 0x84, 0xe7, 0x11,
-	0xcf, 0xb7, 0xf1, 0xd9, 0x39, 0x66, 0xb1, 0xf1, 0x25, 0x78, 0x23, 0x73, 0xca, 0x28, 0x09, 0x19,
-	0x86, 0x2d, 0x50, 0x11, 0x75, 0xdf, 0xd4, 0x96, 0xb4, 0xd5, 0xe7, 0xcd, 0x59, 0x33, 0x23, 0xdf,
-	0x14, 0xe9, 0xbb, 0xaf, 0xdd, 0xfc, 0xb5, 0x58, 0xb2, 0x65, 0xaa, 0x71, 0x02, 0x16, 0x78, 0xad,
-	0xaf, 0x63, 0x12, 0x21, 0x1f, 0x1f, 0x45, 0xe4, 0x22, 0xf0, 0x70, 0x94, 0xf6, 0x82, 0x9f, 0x01,
-	0x30, 0x98, 0x8d, 0x2c, 0xfc, 0xae, 0x29, 0xe7, 0x91, 0x0c, 0xd2, 0x14, 0x03, 0x97, 0x83, 0x34,
-	0x8f, 0x90, 0x8f, 0x25, 0xd6, 0x7e, 0x80, 0x34, 0x7e, 0xd1, 0x40, 0x75, 0x44, 0x23, 0x49, 0xff,
-	0x7d, 0x30, 0xc1, 0x68, 0xc2, 0x7d, 0x62, 0xf5, 0x79, 0xb3, 0xa6, 0x70, 0x57, 0x50, 0x76, 0x92,
-	0x0a, 0xf7, 0x33, 0xdc, 0xca, 0x9c, 0xdb, 0x7b, 0x63, 0xb9, 0x89, 0x76, 0x19, 0x72, 0x5b, 0x40,
-	0x17, 0xdc, 0x68, 0xbf, 0x4f, 0xd0, 0x4e, 0x65, 0xc0, 0x39, 0xf0, 0x8c, 0x51, 0x07, 0x79, 0x5e,
-	0xc4, 0xf5, 0x4f, 0xda, 0x15, 0x46, 0x3f, 0xf5, 0xbc, 0xc8, 0xe8, 0x80, 0xb7, 0x72, 0x61, 0x52,
-	0xd0, 0x01, 0x98, 0x66, 0xd4, 0x61, 0x22, 0xe4, 0xd0, 0x24, 0x26, 0x07, 0x58, 0x55, 0xd5, 0x65,
-	0x0a, 0xc8, 0x1b, 0x7a, 0xc9, 0x32, 0xa7, 0xc6, 0x1e, 0x78, 0x87, 0x77, 0xdb, 0xef, 0x10, 0x17,
-	0x75, 0x04, 0x44, 0x02, 0x7a, 0xdf, 0x04, 0xdd, 0x3e, 0xdd, 0x05, 0x30, 0x19, 0x07, 0x5d, 0xcc,
-	0x62, 0xd4, 0xa5, 0xbc, 0xdf, 0x84, 0x3d, 0x38, 0x30, 0xbe, 0xd7, 0xc0, 0xca, 0x98, 0x32, 0x92,
-	0xfe, 0x31, 0x98, 0xf5, 0x79, 0x8e, 0x23, 0x55, 0x64, 0x35, 0x2c, 0x2b, 0x1a, 0x72, 0xea, 0x09,
-	0x1d, 0xd0, 0x1f, 0x8a, 0x18, 0x1b, 0xe9, 0xe4, 0x94, 0x6b, 0x95, 0x12, 0x5e, 0x82, 0x72, 0xe0,
-	0xf1, 0x3e, 0x2f, 0xec, 0x72, 0xe0, 0x19, 0xa7, 0xf9, 0x4b, 0xda, 0xa7, 0xfa, 0x39, 0x98, 0x62,
-	0xd9, 0x90, 0x24, 0x39, 0x6e, 0x8d, 0x54, 0x98, 0xf1, 0x2d, 0x58, 0xcf, 0xeb, 0xb4, 0xdb, 0x3b,
-	0xa4, 0x38, 0x42, 0x31, 0x89, 0x92, 0x8b, 0xc7, 0xac, 0xff, 0x7a, 0xd4, 0xc1, 0x34, 0x91, 0x11,
-	0xbe, 0x21, 0x98, 0x31, 0xb9, 0x24, 0x53, 0x24, 0x8b, 0x30, 0x7a, 0x60, 0xa3, 0x60, 0xe9, 0x57,
-	0xae, 0xea, 0x38, 0xbf, 0xf5, 0x01, 0x0a, 0xc2, 0x18, 0x87, 0x28, 0x4c, 0x96, 0xb6, 0x4d, 0x22,
-	0xef, 0x29, 0xb2, 0x3a, 0xc0, 0x2c, 0x5a, 0x5b, 0xea, 0xda, 0x06, 0xcf, 0x22, 0x71, 0x24, 0x5f,
-	0xf6, 0x25, 0x45, 0xcf, 0x10, 0xd6, 0x4e, 0x01, 0xcd, 0x7f, 0x27, 0xc1, 0xeb, 0xbc, 0x1d, 0x0c,
-	0x41, 0x45, 0x18, 0x1a, 0x54, 0x37, 0x71, 0xd8, 0x31, 0x75, 0xe3, 0xb1, 0x14, 0x41, 0xcb, 0xa8,
-	0x5e, 0xff, 0xfe, 0xcf, 0x8f, 0xe5, 0x39, 0x38, 0x6b, 0xe5, 0x79, 0x35, 0xfc, 0x49, 0x03, 0xd3,
-	0xaa, 0x77, 0xc1, 0xb5, 0xbc, 0xba, 0x23, 0xac, 0x54, 0x5f, 0x2f, 0x96, 0x2c, 0xe9, 0xac, 0x70,
-	0x3a, 0x8b, 0xb0, 0x9a, 0xa1, 0xd3, 0x37, 0x93, 0x94, 0xc1, 0xaf, 0x9a, 0xfc, 0x33, 0xc8, 0x7a,
-	0x08, 0xac, 0xe7, 0x36, 0xcb, 0xf3, 0x37, 0xbd, 0x51, 0x24, 0x55, 0xb2, 0xda, 0xe4, 0xac, 0xd6,
-	0x60, 0x5d, 0x19, 0x92, 0x6a, 0x74, 0xd6, 0xa5, 0xb4, 0xcc, 0x2b, 0xf8, 0x5b, 0xea, 0xfc, 0xa3,
-	0x1c, 0x07, 0xb6, 0xf2, 0x08, 0x8c, 0xb1, 0x39, 0xfd, 0x83, 0xff, 0x07, 0x92, 0xfc, 0x3f, 0xe1,
-	0xfc, 0xb7, 0xe1, 0x87, 0x0a, 0xff, 0x5c, 0xa7, 0x73, 0xdc, 0x9e, 0x93, 0x38, 0xa7, 0x75, 0xd9,
-	0xf7, 0xcf, 0x2b, 0xf8, 0xb3, 0x06, 0xa6, 0x94, 0x4b, 0x83, 0x8d, 0x02, 0x37, 0x9b, 0xf2, 0x5e,
-	0x2b, 0x94, 0x2b, 0xe9, 0xd6, 0x39, 0xdd, 0xb7, 0xe1, 0xf2, 0x63, 0x4b, 0x60, 0x5d, 0x06, 0xde,
-	0x15, 0xfc, 0x53, 0x03, 0x4b, 0xe3, 0xac, 0x05, 0xee, 0x14, 0x68, 0x3e, 0xca, 0xeb, 0xf4, 0x8f,
-	0x9e, 0x06, 0x96, 0x52, 0x76, 0xb8, 0x94, 0x2d, 0xd8, 0x52, 0x37, 0x47, 0x51, 0x93, 0x0c, 0x5d,
-	0xf5, 0x1e, 0x78, 0x5d, 0x06, 0xcd, 0xb1, 0x06, 0x33, 0x2c, 0xb7, 0x08, 0xe3, 0x91, 0x26, 0xa8,
-	0x7f, 0xfc, 0x44, 0xb4, 0x14, 0x7c, 0xc8, 0x05, 0x7f, 0x01, 0xf7, 0xc7, 0x09, 0xee, 0x0e, 0x6a,
-	0x38, 0xd2, 0xe7, 0xf2, 0x86, 0xb0, 0xbb, 0x77, 0x73, 0x57, 0xd3, 0x6e, 0xef, 0x6a, 0xda, 0xdf,
-	0x77, 0x35, 0xed, 0x87, 0xfb, 0x5a, 0xe9, 0xf6, 0xbe, 0x56, 0xfa, 0xe3, 0xbe, 0x56, 0x3a, 0x6e,
-	0xf8, 0x41, 0x7c, 0x7a, 0xee, 0x9a, 0x6d, 0xd2, 0xb5, 0xdc, 0xd0, 0xdd, 0x68, 0x9f, 0xa2, 0x20,
-	0x7c, 0xd8, 0xf6, 0xbb, 0xfe, 0x87, 0xa5, 0x5b, 0xe1, 0x5f, 0x96, 0xad, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0x64, 0xa6, 0xac, 0x37, 0x0b, 0x00, 0x00,
+	// 1200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x4f, 0xdc, 0x46,
+	0x14, 0xc6, 0x4b, 0x00, 0xe5, 0x45, 0x01, 0x34, 0x80, 0x02, 0x2e, 0x6c, 0xc0, 0x29, 0x21, 0x40,
+	0x58, 0x07, 0x08, 0x4d, 0x15, 0x1a, 0xa9, 0xa1, 0x11, 0x34, 0x95, 0xa2, 0xd0, 0xa5, 0x52, 0x55,
+	0x2e, 0x2b, 0xef, 0x7a, 0x62, 0x2c, 0xed, 0x7a, 0x26, 0x1e, 0x6f, 0xd2, 0x15, 0x42, 0xaa, 0xd2,
+	0x3f, 0x50, 0xa9, 0xed, 0xa5, 0x97, 0xfe, 0x80, 0x4a, 0xad, 0xd4, 0x63, 0xd5, 0x43, 0x7b, 0xcb,
+	0xa1, 0x87, 0x48, 0xbd, 0x54, 0x3d, 0x54, 0x15, 0xf4, 0x87, 0x54, 0x9e, 0x19, 0x7b, 0xd7, 0xb3,
+	0x5e, 0x6c, 0x10, 0x37, 0x76, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x9b, 0x37, 0x9e, 0x4f, 0xc0, 0x94,
+	0xe3, 0x63, 0xec, 0x3d, 0x73, 0x71, 0xdd, 0x36, 0x19, 0x35, 0x9f, 0x37, 0xb1, 0xdf, 0x2a, 0x51,
+	0x9f, 0x04, 0x04, 0x5d, 0x6d, 0x6f, 0x95, 0x18, 0xd5, 0x97, 0x6a, 0x84, 0x35, 0x08, 0x33, 0xab,
+	0x16, 0xc3, 0x22, 0xce, 0x7c, 0xb1, 0x5a, 0xc5, 0x81, 0xb5, 0x6a, 0x52, 0xcb, 0x71, 0x3d, 0x2b,
+	0x70, 0x89, 0x27, 0xa0, 0xfa, 0x94, 0x88, 0xad, 0xf0, 0x5f, 0xa6, 0xf8, 0x21, 0xb7, 0xc6, 0x1d,
+	0xe2, 0x10, 0xb1, 0x1e, 0xfe, 0x25, 0x57, 0xa7, 0x1d, 0x42, 0x9c, 0x3a, 0x36, 0x2d, 0xea, 0x9a,
+	0x96, 0xe7, 0x91, 0x80, 0x67, 0x8b, 0x30, 0x7a, 0x92, 0x24, 0xb5, 0x7c, 0xab, 0x11, 0xed, 0x29,
+	0x02, 0x82, 0x16, 0xc5, 0x72, 0xcb, 0x18, 0x07, 0xf4, 0x71, 0xc8, 0x73, 0x97, 0xc7, 0x97, 0xf1,
+	0xf3, 0x26, 0x66, 0x81, 0xf1, 0x11, 0x8c, 0x25, 0x56, 0x19, 0x25, 0x1e, 0xc3, 0x68, 0x1d, 0x06,
+	0x45, 0xde, 0x49, 0x6d, 0x56, 0xbb, 0x75, 0x65, 0x6d, 0xa2, 0x94, 0x90, 0x5f, 0x12, 0xe1, 0x5b,
+	0x97, 0x5e, 0xff, 0x73, 0xbd, 0xaf, 0x2c, 0x43, 0x8d, 0x67, 0x30, 0xcd, 0x73, 0xed, 0x05, 0xc4,
+	0xb7, 0x1c, 0xbc, 0xeb, 0x93, 0x17, 0xae, 0x8d, 0xfd, 0xa8, 0x16, 0xda, 0x06, 0x68, 0xf7, 0x46,
+	0x26, 0xbe, 0x59, 0x92, 0xfd, 0x08, 0x1b, 0x59, 0x12, 0x0d, 0x97, 0x8d, 0x2c, 0xed, 0x5a, 0x0e,
+	0x96, 0xd8, 0x72, 0x07, 0xd2, 0xf8, 0x4e, 0x83, 0x99, 0x1e, 0x85, 0x24, 0xfd, 0x3b, 0xd0, 0xcf,
+	0x68, 0xc8, 0xbd, 0xff, 0xd6, 0x95, 0xb5, 0xa2, 0xc2, 0x5d, 0x41, 0x95, 0xc3, 0x50, 0xb4, 0x93,
+	0xe0, 0x56, 0xe0, 0xdc, 0x16, 0x32, 0xb9, 0x89, 0x72, 0x09, 0x72, 0x1b, 0xa0, 0x0b, 0x6e, 0x34,
+	0xae, 0xe3, 0xd6, 0x22, 0x19, 0xe8, 0x1a, 0x0c, 0x31, 0x5a, 0xb1, 0x6c, 0xdb, 0xe7, 0xfa, 0x2f,
+	0x97, 0x07, 0x19, 0x7d, 0x68, 0xdb, 0xbe, 0x51, 0x87, 0xb7, 0x52, 0x61, 0x52, 0xd0, 0x13, 0x18,
+	0x65, 0xb4, 0xc2, 0xc4, 0x56, 0x85, 0x86, 0x7b, 0xb2, 0x81, 0x33, 0xaa, 0xba, 0x44, 0x02, 0x79,
+	0x42, 0xc3, 0x2c, 0xb1, 0x6a, 0x3c, 0x82, 0xb7, 0x79, 0xb5, 0x9d, 0x3a, 0xa9, 0x5a, 0x75, 0x01,
+	0x91, 0x80, 0xd6, 0x27, 0x6e, 0x23, 0xa6, 0x3b, 0x0d, 0x97, 0x03, 0xb7, 0x81, 0x59, 0x60, 0x35,
+	0x28, 0xaf, 0xd7, 0x5f, 0x6e, 0x2f, 0x18, 0x5f, 0x6a, 0x30, 0x9f, 0x91, 0x46, 0xd2, 0xdf, 0x87,
+	0x09, 0x87, 0xc7, 0x54, 0xa4, 0x8a, 0xa4, 0x86, 0x39, 0x45, 0x43, 0x4a, 0x3e, 0xa1, 0x03, 0x39,
+	0x5d, 0x3b, 0xc6, 0x4a, 0xd4, 0x39, 0xe5, 0x58, 0xa5, 0x84, 0x61, 0x28, 0xb8, 0x36, 0xaf, 0x73,
+	0xb5, 0x5c, 0x70, 0x6d, 0xe3, 0x20, 0x7d, 0x48, 0x63, 0xaa, 0x1f, 0xc2, 0x08, 0x4b, 0x6e, 0x49,
+	0x92, 0x59, 0x63, 0xa4, 0xc2, 0x8c, 0xcf, 0xe0, 0x76, 0x5a, 0xa5, 0xad, 0xd6, 0x53, 0x8a, 0x7d,
+	0x2b, 0x20, 0x7e, 0x78, 0xf0, 0x98, 0xc5, 0xd7, 0x63, 0x11, 0x46, 0x89, 0xdc, 0xe1, 0x13, 0x82,
+	0x19, 0x93, 0x43, 0x32, 0x42, 0x92, 0x08, 0xa3, 0x05, 0x2b, 0x39, 0x53, 0x5f, 0xb8, 0xaa, 0xfd,
+	0xf4, 0xd2, 0x4f, 0x2c, 0xd7, 0x0b, 0xb0, 0x67, 0x79, 0xe1, 0xd0, 0xd6, 0x88, 0x6f, 0x9f, 0x47,
+	0x56, 0x1d, 0x4a, 0x79, 0x73, 0x4b, 0x5d, 0xf7, 0x61, 0xc8, 0x17, 0x4b, 0xf2, 0xb2, 0xcf, 0x2a,
+	0x7a, 0xba, 0xb0, 0xe5, 0x08, 0x60, 0xfc, 0xaa, 0xc1, 0xd8, 0x1e, 0x2d, 0x63, 0xda, 0x14, 0xdf,
+	0xd7, 0xbd, 0x66, 0xa3, 0x61, 0xf9, 0x2d, 0x34, 0x06, 0x03, 0x8c, 0x56, 0xe2, 0xa1, 0xb9, 0xc4,
+	0xe8, 0x63, 0x1b, 0x3d, 0x80, 0x81, 0x80, 0x04, 0x56, 0x5d, 0x7e, 0x1a, 0xe6, 0xba, 0x6e, 0x5d,
+	0x3b, 0xcf, 0xa7, 0xae, 0x67, 0x93, 0x97, 0x72, 0x62, 0x05, 0x0a, 0x8d, 0xc3, 0x00, 0xab, 0x11,
+	0x1f, 0x4f, 0xf6, 0xf3, 0x9c, 0xe2, 0x07, 0xda, 0x84, 0x29, 0x2b, 0x08, 0xc2, 0xdb, 0x14, 0xe2,
+	0x2a, 0xd4, 0xf2, 0x03, 0xb7, 0xe6, 0x52, 0xf1, 0x0d, 0xba, 0xc4, 0x23, 0x27, 0x3b, 0x02, 0x76,
+	0x3b, 0xf7, 0x8d, 0x7b, 0xf2, 0xf2, 0x75, 0x0d, 0x72, 0xc4, 0xa3, 0xd7, 0x0d, 0xf8, 0x49, 0x83,
+	0x9b, 0x59, 0x48, 0xd9, 0xde, 0x2d, 0x18, 0x62, 0xa2, 0x2b, 0x72, 0x5c, 0x8c, 0x53, 0x74, 0xcb,
+	0xfe, 0x49, 0xe1, 0x11, 0x10, 0x3d, 0x84, 0xa1, 0x97, 0xbc, 0x23, 0x6c, 0xb2, 0xc0, 0x8f, 0x28,
+	0x77, 0xef, 0x22, 0x9c, 0x41, 0xb3, 0x08, 0x5f, 0xf8, 0x13, 0xf3, 0x8b, 0x06, 0x0b, 0x99, 0x25,
+	0x65, 0x93, 0xb6, 0xe1, 0xb2, 0xd0, 0xea, 0xe2, 0x68, 0x0a, 0xf3, 0xb7, 0xa9, 0x0d, 0xbd, 0xb8,
+	0x27, 0xe8, 0x0b, 0x0d, 0xe6, 0x04, 0xf9, 0xda, 0x01, 0xb6, 0x9b, 0x75, 0x6c, 0x77, 0xdc, 0x82,
+	0xb8, 0x55, 0xa9, 0x63, 0xbe, 0x9d, 0xc2, 0xe1, 0x3c, 0xfd, 0xfb, 0x59, 0x03, 0xe3, 0x34, 0x0a,
+	0xb2, 0x75, 0x1f, 0xb4, 0x67, 0x43, 0x34, 0xee, 0x86, 0xda, 0xb8, 0x14, 0xb8, 0x32, 0x1d, 0x17,
+	0xd6, 0xb7, 0xb5, 0x1f, 0x87, 0x61, 0x80, 0x93, 0x46, 0x1e, 0x0c, 0x0a, 0x87, 0x83, 0xd4, 0x61,
+	0xed, 0xb6, 0x50, 0xba, 0x71, 0x5a, 0x88, 0x28, 0x63, 0xcc, 0xbc, 0xfa, 0xf3, 0xbf, 0xaf, 0x0b,
+	0xd7, 0xd0, 0x84, 0x99, 0x66, 0xde, 0xd0, 0x37, 0x1a, 0x8c, 0xaa, 0x66, 0x06, 0x2d, 0xa7, 0xe5,
+	0xed, 0xe1, 0xad, 0xf4, 0xdb, 0xf9, 0x82, 0x25, 0x9d, 0x79, 0x4e, 0xe7, 0x3a, 0x9a, 0x49, 0xd0,
+	0x89, 0xdd, 0x45, 0xc4, 0xe0, 0x7b, 0x4d, 0xba, 0xc3, 0xa4, 0xa9, 0x40, 0x8b, 0xa9, 0xc5, 0xd2,
+	0x0c, 0x8f, 0xbe, 0x94, 0x27, 0x54, 0xb2, 0x5a, 0xe5, 0xac, 0x96, 0xd1, 0xa2, 0xd2, 0x24, 0xd5,
+	0xf9, 0x98, 0x87, 0xd2, 0x43, 0x1d, 0xa1, 0x3f, 0x22, 0x2b, 0xd8, 0xcb, 0x82, 0xa0, 0xf5, 0x34,
+	0x02, 0x19, 0xbe, 0x47, 0xbf, 0x7b, 0x36, 0x90, 0xe4, 0xff, 0x3e, 0xe7, 0x7f, 0x1f, 0xbd, 0xab,
+	0xf0, 0x4f, 0xb5, 0x3e, 0x95, 0x6a, 0xab, 0x12, 0x5a, 0x29, 0xf3, 0x30, 0x36, 0x54, 0x47, 0xe8,
+	0x5b, 0x0d, 0x46, 0x94, 0x43, 0x43, 0x4b, 0x39, 0x4e, 0x36, 0xe2, 0xbd, 0x9c, 0x2b, 0x56, 0xd2,
+	0x5d, 0xe4, 0x74, 0x6f, 0xa0, 0xb9, 0xd3, 0x86, 0xc0, 0x3c, 0x74, 0xed, 0x23, 0xf4, 0xb7, 0x06,
+	0xb3, 0x59, 0x5e, 0x03, 0x6d, 0xe6, 0x28, 0xde, 0xcb, 0xfc, 0xe8, 0xef, 0x9d, 0x0f, 0x2c, 0xa5,
+	0x6c, 0x72, 0x29, 0x1b, 0x68, 0x5d, 0x9d, 0x1c, 0x45, 0x4d, 0xd8, 0x74, 0xd5, 0x8c, 0xa0, 0x57,
+	0x05, 0x58, 0xcb, 0x74, 0x1c, 0xdd, 0x72, 0xf3, 0x30, 0xee, 0xe9, 0x8a, 0xf4, 0x07, 0xe7, 0x44,
+	0x4b, 0xc1, 0x4f, 0xb9, 0xe0, 0xc7, 0x68, 0x27, 0x4b, 0x70, 0xa3, 0x9d, 0xa3, 0x22, 0x8d, 0x4f,
+	0x6a, 0x13, 0x7e, 0xd7, 0x60, 0xaa, 0xe7, 0x5b, 0x87, 0xee, 0xe6, 0x9a, 0x2b, 0xc5, 0x78, 0xe8,
+	0x1b, 0x67, 0x44, 0x49, 0x6d, 0xf7, 0xb8, 0xb6, 0x55, 0x64, 0x66, 0x69, 0xf3, 0x63, 0xac, 0x98,
+	0xd2, 0xdf, 0x34, 0xd0, 0x7b, 0xbf, 0xd7, 0xe8, 0x6c, 0x74, 0xe2, 0x93, 0x7a, 0xe7, 0xac, 0x30,
+	0x29, 0x63, 0x9d, 0xcb, 0x58, 0x41, 0xcb, 0xf9, 0x65, 0x30, 0xf4, 0x83, 0x06, 0x13, 0xa9, 0x4f,
+	0x26, 0xba, 0x93, 0x4a, 0xe3, 0x94, 0x07, 0x5e, 0x5f, 0x3d, 0x03, 0x42, 0x72, 0x5e, 0xe1, 0x9c,
+	0x17, 0xd0, 0xbc, 0xca, 0x39, 0x42, 0x75, 0xce, 0x13, 0xdb, 0x7a, 0xf4, 0xfa, 0xb8, 0xa8, 0xbd,
+	0x39, 0x2e, 0x6a, 0xff, 0x1e, 0x17, 0xb5, 0xaf, 0x4e, 0x8a, 0x7d, 0x6f, 0x4e, 0x8a, 0x7d, 0x7f,
+	0x9d, 0x14, 0xfb, 0xf6, 0x97, 0x1c, 0x37, 0x38, 0x68, 0x56, 0x4b, 0x35, 0xd2, 0x30, 0xab, 0x5e,
+	0x75, 0xa5, 0x76, 0x60, 0xb9, 0x5e, 0x67, 0xd2, 0xcf, 0xe3, 0x7f, 0x4f, 0x54, 0x07, 0xf9, 0xff,
+	0x27, 0xd6, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xcc, 0x69, 0x71, 0x7d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -774,6 +1176,12 @@ type QueryClient interface {
 	StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error)
 	// Queries a StorageProvider by specify operator address.
 	StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+	// Queries the reputation of a storage provider with specify id.
+	StorageProviderReputation(ctx context.Context, in *QueryStorageProviderReputationRequest, opts ...grpc.CallOption) (*QueryStorageProviderReputationResponse, error)
+	// Queries the reputations of all storage providers.
+	StorageProviderReputations(ctx context.Context, in *QueryStorageProviderReputationsRequest, opts ...grpc.CallOption) (*QueryStorageProviderReputationsResponse, error)
+	// Queries the upcoming scheduled maintenance windows ordered by start time.
+	ScheduledMaintenances(ctx context.Context, in *QueryScheduledMaintenancesRequest, opts ...grpc.CallOption) (*QueryScheduledMaintenancesResponse, error)
 }
 
 type queryClient struct {
@@ -847,6 +1255,33 @@ func (c *queryClient) StorageProviderMaintenanceRecordsByOperatorAddress(ctx con
 	return out, nil
 }
 
+func (c *queryClient) StorageProviderReputation(ctx context.Context, in *QueryStorageProviderReputationRequest, opts ...grpc.CallOption) (*QueryStorageProviderReputationResponse, error) {
+	out := new(QueryStorageProviderReputationResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageProviderReputations(ctx context.Context, in *QueryStorageProviderReputationsRequest, opts ...grpc.CallOption) (*QueryStorageProviderReputationsResponse, error) {
+	out := new(QueryStorageProviderReputationsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderReputations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMaintenances(ctx context.Context, in *QueryScheduledMaintenancesRequest, opts ...grpc.CallOption) (*QueryScheduledMaintenancesResponse, error) {
+	out := new(QueryScheduledMaintenancesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/ScheduledMaintenances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -863,6 +1298,12 @@ type QueryServer interface {
 	StorageProviderByOperatorAddress(context.Context, *QueryStorageProviderByOperatorAddressRequest) (*QueryStorageProviderByOperatorAddressResponse, error)
 	// Queries a StorageProvider by specify operator address.
 	StorageProviderMaintenanceRecordsByOperatorAddress(context.Context, *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+	// Queries the reputation of a storage provider with specify id.
+	StorageProviderReputation(context.Context, *QueryStorageProviderReputationRequest) (*QueryStorageProviderReputationResponse, error)
+	// Queries the reputations of all storage providers.
+	StorageProviderReputations(context.Context, *QueryStorageProviderReputationsRequest) (*QueryStorageProviderReputationsResponse, error)
+	// Queries the upcoming scheduled maintenance windows ordered by start time.
+	ScheduledMaintenances(context.Context, *QueryScheduledMaintenancesRequest) (*QueryScheduledMaintenancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -890,6 +1331,15 @@ func (*UnimplementedQueryServer) StorageProviderByOperatorAddress(ctx context.Co
 func (*UnimplementedQueryServer) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, req *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderMaintenanceRecordsByOperatorAddress not implemented")
 }
+func (*UnimplementedQueryServer) StorageProviderReputation(ctx context.Context, req *QueryStorageProviderReputationRequest) (*QueryStorageProviderReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderReputation not implemented")
+}
+func (*UnimplementedQueryServer) StorageProviderReputations(ctx context.Context, req *QueryStorageProviderReputationsRequest) (*QueryStorageProviderReputationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderReputations not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMaintenances(ctx context.Context, req *QueryScheduledMaintenancesRequest) (*QueryScheduledMaintenancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMaintenances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1021,19 +1471,73 @@ func _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.sp.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "StorageProviders",
-			Handler:    _Query_StorageProviders_Handler,
-		},
-		{
+func _Query_StorageProviderReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderReputation(ctx, req.(*QueryStorageProviderReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageProviderReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderReputationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderReputations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderReputations(ctx, req.(*QueryStorageProviderReputationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMaintenances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMaintenancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMaintenances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/ScheduledMaintenances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMaintenances(ctx, req.(*QueryScheduledMaintenancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.sp.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "StorageProviders",
+			Handler:    _Query_StorageProviders_Handler,
+		},
+		{
 			MethodName: "QuerySpStoragePrice",
 			Handler:    _Query_QuerySpStoragePrice_Handler,
 		},
@@ -1053,6 +1557,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StorageProviderMaintenanceRecordsByOperatorAddress",
 			Handler:    _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler,
 		},
+		{
+			MethodName: "StorageProviderReputation",
+			Handler:    _Query_StorageProviderReputation_Handler,
+		},
+		{
+			MethodName: "StorageProviderReputations",
+			Handler:    _Query_StorageProviderReputations_Handler,
+		},
+		{
+			MethodName: "ScheduledMaintenances",
+			Handler:    _Query_ScheduledMaintenances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/sp/query.proto",
@@ -1517,202 +2033,1118 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpReputationSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *SpReputationSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SpReputationSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AttestationParticipation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestationParticipation))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QueryStorageProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Score != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.Sps) > 0 {
-		for _, e := range m.Sps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpStoragePriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageProviderReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySpStoragePriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpStoragePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStorageProviderReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageProviderReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStorageProviderReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GlobalSpStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStorageProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
+func (m *QueryStorageProviderReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageProviderReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProvider != nil {
-		l = m.StorageProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderByOperatorAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageProviderReputationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStorageProviderByOperatorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageProvider != nil {
-		l = m.StorageProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStorageProviderReputationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStorageProviderReputationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderReputationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderReputationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderReputationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Summaries) > 0 {
+		for iNdEx := len(m.Summaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Summaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMaintenancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMaintenancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMaintenancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMaintenancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMaintenancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMaintenancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryStorageProviderMaintenanceRecordsResponse) Size() (n int) {
+func (m *QueryStorageProvidersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
+	if len(m.Sps) > 0 {
+		for _, e := range m.Sps {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpStoragePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpStoragePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpStoragePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGlobalSpStorePriceByTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalSpStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		l = m.StorageProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderByOperatorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		l = m.StorageProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderMaintenanceRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpReputationSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Score != 0 {
+		n += 1 + sovQuery(uint64(m.Score))
+	}
+	if m.AttestationParticipation != 0 {
+		n += 1 + sovQuery(uint64(m.AttestationParticipation))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorageProviderReputationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderReputationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for _, e := range m.Summaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledMaintenancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledMaintenancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sps = append(m.Sps, &StorageProvider{})
+			if err := m.Sps[len(m.Sps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpStoragePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpStoragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpStoragePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpStoragePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpStoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,12 +3167,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1762,7 +3213,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1785,15 +3236,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1820,7 +3271,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1845,7 +3296,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1868,17 +3319,17 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1888,28 +3339,11 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1931,7 +3365,7 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,15 +3388,15 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1989,16 +3423,68 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sps = append(m.Sps, &StorageProvider{})
-			if err := m.Sps[len(m.Sps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StorageProvider == nil {
+				m.StorageProvider = &StorageProvider{}
+			}
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2008,27 +3494,23 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2051,7 +3533,7 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,17 +3556,17 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpStoragePriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpStoragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2094,23 +3576,27 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpAddr = string(dAtA[iNdEx:postIndex])
+			if m.StorageProvider == nil {
+				m.StorageProvider = &StorageProvider{}
+			}
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2133,7 +3619,7 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2156,17 +3642,17 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpStoragePriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpStoragePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2176,24 +3662,23 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpStoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2216,7 +3701,7 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2239,17 +3724,17 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2259,11 +3744,26 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &MaintenanceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2285,7 +3785,7 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *SpReputationSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,15 +3808,34 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpReputationSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpReputationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2343,10 +3862,48 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationParticipation", wireType)
+			}
+			m.AttestationParticipation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationParticipation |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2368,7 +3925,7 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderReputationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2391,10 +3948,10 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderReputationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2437,7 +3994,7 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderReputationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2460,15 +4017,15 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderReputationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2495,10 +4052,41 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageProvider == nil {
-				m.StorageProvider = &StorageProvider{}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, SpReputationWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2523,7 +4111,7 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderReputationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2546,17 +4134,17 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderReputationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderReputationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2566,23 +4154,27 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2605,7 +4197,7 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderReputationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2628,15 +4220,15 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderReputationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderReputationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summaries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2663,10 +4255,44 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageProvider == nil {
-				m.StorageProvider = &StorageProvider{}
+			m.Summaries = append(m.Summaries, SpReputationSummary{})
+			if err := m.Summaries[len(m.Summaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2691,7 +4317,7 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledMaintenancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2714,17 +4340,36 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledMaintenancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledMaintenancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2734,23 +4379,27 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2773,7 +4422,7 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledMaintenancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2796,15 +4445,15 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledMaintenancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledMaintenancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2831,8 +4480,44 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &MaintenanceRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, ScheduledMaintenance{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,81 @@
+package types
+
+const (
+	// ReputationWindowDuration defines the duration in seconds of a window of the storage provider reputation.
+	ReputationWindowDuration int64 = 24 * 60 * 60
+	// ReputationWindowCount defines the number of the rolling windows the storage provider reputation is aggregated in.
+	ReputationWindowCount int64 = 30
+
+	// MaxReputationScore is the score of a storage provider without any failure, in basis points.
+	MaxReputationScore uint32 = 10000
+)
+
+// Add adds the service events of another window to the window.
+func (w *SpReputationWindow) Add(other SpReputationWindow) {
+	w.ChallengesIssued += other.ChallengesIssued
+	w.ChallengesPassed += other.ChallengesPassed
+	w.ChallengesFailed += other.ChallengesFailed
+	w.MaintenanceOverruns += other.MaintenanceOverruns
+	w.Discontinues += other.Discontinues
+	w.ForcedExits += other.ForcedExits
+	w.ChallengesAttested += other.ChallengesAttested
+}
+
+// Score computes the service-level score in basis points from the service events. Up to half of the score is lost for
+// the ratio of the failed challenges in the issued ones, and every maintenance overrun, discontinue and forced exit
+// costs a fixed penalty. The passed challenges are not used since only the heartbeats of them are attested.
+func (w *SpReputationWindow) Score() uint32 {
+	penalty := uint64(0)
+	if w.ChallengesIssued > 0 {
+		failed := w.ChallengesFailed
+		if failed > w.ChallengesIssued {
+			failed = w.ChallengesIssued
+		}
+		penalty += uint64(MaxReputationScore) / 2 * failed / w.ChallengesIssued
+	}
+	penalty += 1000 * w.MaintenanceOverruns
+	penalty += 10 * w.Discontinues
+	penalty += 5000 * w.ForcedExits
+	if penalty >= uint64(MaxReputationScore) {
+		return 0
+	}
+	return MaxReputationScore - uint32(penalty)
+}
+
+// AttestationParticipation computes the ratio in basis points of the issued challenges attested by the validators.
+func (w *SpReputationWindow) AttestationParticipation() uint32 {
+	if w.ChallengesIssued == 0 || w.ChallengesAttested >= w.ChallengesIssued {
+		return MaxReputationScore
+	}
+	return uint32(uint64(MaxReputationScore) * w.ChallengesAttested / w.ChallengesIssued)
+}
+
+// Summary aggregates the windows which are not older than the rolling windows at the time.
+func (r *SpReputation) Summary(now int64) SpReputationSummary {
+	summary := SpReputationSummary{SpId: r.SpId}
+	for i, window := range r.ActiveWindows(now) {
+		if i == 0 {
+			summary.Total.StartTime = window.StartTime
+		}
+		summary.Total.Add(window)
+	}
+	summary.Score = summary.Total.Score()
+	summary.AttestationParticipation = summary.Total.AttestationParticipation()
+	return summary
+}
+
+// ActiveWindows returns the windows which are not older than the rolling windows at the time.
+func (r *SpReputation) ActiveWindows(now int64) []SpReputationWindow {
+	earliest := ReputationWindowStart(now) - (ReputationWindowCount-1)*ReputationWindowDuration
+	for i, window := range r.Windows {
+		if window.StartTime >= earliest {
+			return r.Windows[i:]
+		}
+	}
+	return nil
+}
+
+// ReputationWindowStart returns the start time of the window the time is in.
+func ReputationWindowStart(now int64) int64 {
+	return now - now%ReputationWindowDuration
+}
@@ -771,6 +771,200 @@ func (m *MsgUpdateStorageProviderStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateStorageProviderStatusResponse proto.InternalMessageInfo
 
+// MsgScheduleMaintenance is used for a storage provider to register a future maintenance window, it enters
+// STATUS_IN_MAINTENANCE at the start time and turns back to STATUS_IN_SERVICE after the duration automatically.
+type MsgScheduleMaintenance struct {
+	// sp_address defines the operator address
+	SpAddress string `protobuf:"bytes,1,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// start_time defines the timestamp to enter maintenance mode
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration defines the maintenance duration in seconds
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *MsgScheduleMaintenance) Reset()         { *m = MsgScheduleMaintenance{} }
+func (m *MsgScheduleMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenance) ProtoMessage()    {}
+func (*MsgScheduleMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{12}
+}
+func (m *MsgScheduleMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenance.Merge(m, src)
+}
+func (m *MsgScheduleMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMaintenance proto.InternalMessageInfo
+
+func (m *MsgScheduleMaintenance) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+func (m *MsgScheduleMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgScheduleMaintenance) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// MsgScheduleMaintenanceResponse defines the MsgScheduleMaintenance response type.
+type MsgScheduleMaintenanceResponse struct {
+}
+
+func (m *MsgScheduleMaintenanceResponse) Reset()         { *m = MsgScheduleMaintenanceResponse{} }
+func (m *MsgScheduleMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenanceResponse) ProtoMessage()    {}
+func (*MsgScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{13}
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMaintenanceResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledMaintenance is used for a storage provider to cancel a maintenance window not started yet.
+type MsgCancelScheduledMaintenance struct {
+	// sp_address defines the operator address
+	SpAddress string `protobuf:"bytes,1,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// start_time defines the start timestamp of the maintenance window
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (m *MsgCancelScheduledMaintenance) Reset()         { *m = MsgCancelScheduledMaintenance{} }
+func (m *MsgCancelScheduledMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMaintenance) ProtoMessage()    {}
+func (*MsgCancelScheduledMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{14}
+}
+func (m *MsgCancelScheduledMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMaintenance.Merge(m, src)
+}
+func (m *MsgCancelScheduledMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMaintenance proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledMaintenance) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+// MsgCancelScheduledMaintenanceResponse defines the MsgCancelScheduledMaintenance response type.
+type MsgCancelScheduledMaintenanceResponse struct {
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) Reset()         { *m = MsgCancelScheduledMaintenanceResponse{} }
+func (m *MsgCancelScheduledMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMaintenanceResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{15}
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMaintenanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStorageProvider)(nil), "greenfield.sp.MsgCreateStorageProvider")
 	proto.RegisterType((*MsgCreateStorageProviderResponse)(nil), "greenfield.sp.MsgCreateStorageProviderResponse")
@@ -784,74 +978,85 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.sp.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateStorageProviderStatus)(nil), "greenfield.sp.MsgUpdateStorageProviderStatus")
 	proto.RegisterType((*MsgUpdateStorageProviderStatusResponse)(nil), "greenfield.sp.MsgUpdateStorageProviderStatusResponse")
+	proto.RegisterType((*MsgScheduleMaintenance)(nil), "greenfield.sp.MsgScheduleMaintenance")
+	proto.RegisterType((*MsgScheduleMaintenanceResponse)(nil), "greenfield.sp.MsgScheduleMaintenanceResponse")
+	proto.RegisterType((*MsgCancelScheduledMaintenance)(nil), "greenfield.sp.MsgCancelScheduledMaintenance")
+	proto.RegisterType((*MsgCancelScheduledMaintenanceResponse)(nil), "greenfield.sp.MsgCancelScheduledMaintenanceResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/tx.proto", fileDescriptor_f630c2933caa1bce) }
 
 var fileDescriptor_f630c2933caa1bce = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xc6, 0xae, 0x1d, 0x3f, 0x27, 0x36, 0xda, 0xa6, 0xcd, 0x7a, 0x91, 0x1c, 0xd7, 0x12,
-	0x26, 0xaa, 0x64, 0x5b, 0x49, 0x05, 0x15, 0xa5, 0x97, 0x26, 0x46, 0x08, 0x21, 0x4b, 0xc1, 0x11,
-	0x1c, 0x40, 0xc8, 0x9a, 0xdd, 0x9d, 0x6c, 0x56, 0xb1, 0x77, 0xb6, 0x33, 0x63, 0xab, 0xb9, 0xf2,
-	0x07, 0x20, 0x24, 0xfe, 0x11, 0x0e, 0xe5, 0x1f, 0xe0, 0x42, 0x8f, 0x55, 0x4f, 0x88, 0x43, 0x85,
-	0x92, 0x03, 0x37, 0xce, 0x1c, 0xd1, 0xec, 0xce, 0x8e, 0x77, 0xfd, 0xa3, 0x76, 0x93, 0x72, 0xb2,
-	0x67, 0xde, 0xf7, 0xbe, 0x79, 0x33, 0xf3, 0xbd, 0x6f, 0x77, 0xe1, 0xae, 0x4b, 0x31, 0xf6, 0x4f,
-	0x3d, 0x3c, 0x70, 0xda, 0x2c, 0x68, 0xf3, 0x67, 0xad, 0x80, 0x12, 0x4e, 0xf4, 0xad, 0xc9, 0x7c,
-	0x8b, 0x05, 0x66, 0xd5, 0x26, 0x6c, 0x48, 0x58, 0xdb, 0x42, 0x0c, 0xb7, 0xc7, 0xfb, 0x16, 0xe6,
-	0x68, 0xbf, 0x6d, 0x13, 0xcf, 0x8f, 0xe0, 0xe6, 0x8e, 0x8c, 0x0f, 0x99, 0xdb, 0x1e, 0xef, 0x8b,
-	0x1f, 0x19, 0xa8, 0x44, 0x81, 0x7e, 0x38, 0x6a, 0x47, 0x03, 0x19, 0xda, 0x76, 0x89, 0x4b, 0xa2,
-	0x79, 0xf1, 0x4f, 0xce, 0x9a, 0xe9, 0x82, 0x02, 0x44, 0xd1, 0x30, 0xce, 0xa8, 0x4c, 0x15, 0x7b,
-	0x11, 0x60, 0x19, 0xaa, 0xff, 0x9c, 0x07, 0xa3, 0xcb, 0xdc, 0x23, 0x8a, 0x11, 0xc7, 0x27, 0x9c,
-	0x50, 0xe4, 0xe2, 0x63, 0x4a, 0xc6, 0x9e, 0x83, 0xa9, 0x7e, 0x00, 0x79, 0x5b, 0x04, 0x08, 0x35,
-	0xb4, 0x9a, 0xb6, 0x57, 0x38, 0x34, 0x5e, 0x3d, 0x6f, 0x6e, 0xcb, 0x62, 0x9e, 0x38, 0x0e, 0xc5,
-	0x8c, 0x9d, 0x70, 0xea, 0xf9, 0x6e, 0x2f, 0x06, 0xea, 0x87, 0x50, 0x74, 0x30, 0xb3, 0xa9, 0x17,
-	0x70, 0x8f, 0xf8, 0xc6, 0x7a, 0x4d, 0xdb, 0x2b, 0x1e, 0x98, 0xad, 0xd4, 0xb1, 0xb4, 0x3a, 0x13,
-	0xc4, 0x61, 0xf6, 0xc5, 0xeb, 0xdd, 0xb5, 0x5e, 0x32, 0x49, 0x7f, 0x08, 0xc0, 0x82, 0x3e, 0x8a,
-	0x16, 0x30, 0x32, 0x4b, 0x96, 0x2e, 0xb0, 0x40, 0x4e, 0xe8, 0x4f, 0xa0, 0x7c, 0x3a, 0xf2, 0x1d,
-	0xcf, 0x77, 0x55, 0x76, 0x76, 0x49, 0x76, 0x49, 0x26, 0xc4, 0x14, 0x9f, 0xc2, 0x26, 0xc3, 0x68,
-	0xa0, 0xf2, 0x6f, 0x2d, 0xc9, 0x2f, 0x0a, 0x74, 0x9c, 0x7c, 0x04, 0xef, 0xa1, 0x20, 0xa0, 0x64,
-	0x9c, 0x20, 0xc8, 0x2d, 0x21, 0x28, 0xc7, 0x19, 0x31, 0xc9, 0x43, 0x00, 0xd7, 0x56, 0xe9, 0xf9,
-	0x65, 0xbb, 0x77, 0xed, 0x38, 0xf1, 0x0b, 0xb8, 0x3d, 0x44, 0x9e, 0xcf, 0xb1, 0x8f, 0x7c, 0x1b,
-	0x2b, 0x86, 0x8d, 0x25, 0x0c, 0x7a, 0x22, 0x29, 0xa6, 0x32, 0x61, 0x03, 0xfb, 0x4e, 0x40, 0x3c,
-	0x9f, 0x1b, 0x05, 0x91, 0xdf, 0x53, 0x63, 0xfd, 0x13, 0xc8, 0x3b, 0x38, 0x20, 0xcc, 0xe3, 0x06,
-	0x84, 0xb7, 0x5b, 0x69, 0x49, 0x5e, 0xa1, 0xf2, 0x96, 0x54, 0x79, 0xeb, 0x88, 0x78, 0xf1, 0xe5,
-	0xc6, 0x78, 0xfd, 0x3b, 0x00, 0x8a, 0x91, 0xd3, 0x0f, 0xa8, 0x67, 0x63, 0xa3, 0x18, 0x16, 0xf6,
-	0x58, 0x40, 0xfe, 0x7c, 0xbd, 0xdb, 0x70, 0x3d, 0x7e, 0x36, 0xb2, 0x5a, 0x36, 0x19, 0x4a, 0xbd,
-	0xcb, 0x9f, 0x26, 0x73, 0xce, 0xa5, 0x66, 0x3b, 0xd8, 0x7e, 0xf5, 0xbc, 0x09, 0x72, 0xb9, 0x0e,
-	0xb6, 0x7b, 0x05, 0xc1, 0x77, 0x2c, 0xe8, 0xf4, 0x06, 0x94, 0x4f, 0x29, 0xc6, 0xfd, 0x70, 0x85,
-	0xa7, 0x23, 0xc2, 0x91, 0xb1, 0x59, 0xd3, 0xf6, 0xb2, 0xbd, 0x2d, 0x31, 0xdd, 0xc3, 0xc8, 0xf9,
-	0x4a, 0x4c, 0xea, 0xdf, 0x43, 0x91, 0x71, 0x42, 0xb1, 0xac, 0x62, 0xeb, 0x1d, 0x54, 0x01, 0x21,
-	0x61, 0x54, 0xc6, 0x0e, 0xe4, 0xad, 0x01, 0xeb, 0x9f, 0xe3, 0x0b, 0xa3, 0x14, 0x9e, 0x5c, 0xce,
-	0x1a, 0xb0, 0x2f, 0xf1, 0x85, 0xfe, 0x3e, 0x14, 0x44, 0x20, 0xa0, 0x84, 0x9c, 0x1a, 0xe5, 0xe8,
-	0x50, 0xad, 0x01, 0x3b, 0x16, 0xe3, 0x47, 0x9b, 0x3f, 0xfc, 0xfd, 0xcb, 0xfd, 0xb8, 0x89, 0xea,
-	0x75, 0xa8, 0x2d, 0x6a, 0xca, 0x1e, 0x66, 0x01, 0xf1, 0x19, 0xae, 0xff, 0xa6, 0x01, 0x74, 0x99,
-	0xdb, 0x91, 0x47, 0x7b, 0x9d, 0x5e, 0x4d, 0xf7, 0xd9, 0xfa, 0xea, 0x7d, 0x96, 0x90, 0x40, 0xe6,
-	0xed, 0x24, 0x30, 0xb5, 0xd1, 0x6d, 0xd0, 0x27, 0x7b, 0x50, 0x5b, 0xfb, 0x37, 0x03, 0x77, 0xbb,
-	0xcc, 0xfd, 0xcc, 0xf1, 0xf8, 0xb4, 0x25, 0xa5, 0x4b, 0xd6, 0x56, 0x2f, 0x39, 0xa9, 0xe8, 0xf5,
-	0x29, 0x45, 0x3f, 0x4e, 0x7b, 0x56, 0x66, 0x99, 0x67, 0xa5, 0xdd, 0x6a, 0xda, 0x31, 0xb2, 0x37,
-	0x75, 0x8c, 0x5b, 0x37, 0x73, 0x8c, 0xdc, 0x8d, 0x1d, 0x23, 0x7f, 0x0d, 0xc7, 0x48, 0xc8, 0x7e,
-	0x63, 0xb1, 0xec, 0x0b, 0x53, 0xb2, 0x2f, 0x0b, 0x35, 0x24, 0x6e, 0xb4, 0x5e, 0x83, 0xea, 0xfc,
-	0x9b, 0x57, 0xe2, 0xf8, 0x7d, 0x1d, 0x76, 0xba, 0xcc, 0xfd, 0x3a, 0x70, 0x44, 0x73, 0x04, 0x0a,
-	0x26, 0x7a, 0xef, 0xda, 0xea, 0x48, 0x1b, 0xd3, 0xfa, 0xff, 0x6e, 0x4c, 0x99, 0x15, 0x8c, 0x29,
-	0xfb, 0x6e, 0x8d, 0x69, 0xf6, 0xac, 0xef, 0xc1, 0xee, 0x82, 0x83, 0x54, 0x87, 0xfd, 0xa3, 0x06,
-	0x65, 0x85, 0x39, 0x0e, 0xdf, 0x29, 0xf4, 0x8f, 0xa1, 0x80, 0x46, 0xfc, 0x8c, 0x50, 0x8f, 0x5f,
-	0x2c, 0x3f, 0x63, 0x05, 0xd5, 0x1f, 0x40, 0x2e, 0x7a, 0x2b, 0x91, 0x2f, 0x05, 0x77, 0xa6, 0x1a,
-	0x2c, 0xa2, 0x97, 0x7e, 0x21, 0xa1, 0x8f, 0x4a, 0xa2, 0xe8, 0x09, 0x49, 0xbd, 0x92, 0xb8, 0xfc,
-	0x28, 0x41, 0xd5, 0xfa, 0xab, 0x16, 0x6a, 0x47, 0xee, 0x27, 0xad, 0x9e, 0x13, 0x8e, 0xf8, 0x88,
-	0x5d, 0x5f, 0x1f, 0x4d, 0xc8, 0xb1, 0x90, 0x22, 0xac, 0xbd, 0x34, 0x53, 0x7b, 0xc4, 0xdf, 0x93,
-	0x20, 0x61, 0x36, 0xce, 0x88, 0x22, 0xe5, 0x26, 0x99, 0x9e, 0x1a, 0xcf, 0x5e, 0xc3, 0x1e, 0x34,
-	0xde, 0x5c, 0x76, 0xbc, 0xc3, 0x83, 0x7f, 0xb2, 0x90, 0xe9, 0x32, 0x57, 0x7f, 0x0a, 0x77, 0xe6,
-	0xbf, 0xb0, 0x7d, 0x38, 0x55, 0xd6, 0xa2, 0x87, 0x88, 0xd9, 0x5e, 0x11, 0x18, 0x2f, 0xad, 0x7f,
-	0x0e, 0xf9, 0xf8, 0x49, 0x53, 0x99, 0xcd, 0x95, 0x21, 0xf3, 0xde, 0xc2, 0x90, 0x22, 0x3a, 0x87,
-	0xdb, 0xf3, 0x7c, 0xfd, 0x83, 0xd9, 0xcc, 0x39, 0x30, 0xb3, 0xb9, 0x12, 0x4c, 0x2d, 0xe6, 0xc3,
-	0xf6, 0x5c, 0x9f, 0x68, 0xcc, 0xd2, 0xcc, 0xc3, 0x99, 0xad, 0xd5, 0x70, 0x6a, 0xbd, 0x31, 0x94,
-	0x26, 0xf1, 0x50, 0x09, 0xcd, 0x85, 0x0c, 0xf3, 0x6e, 0xda, 0xfc, 0xe8, 0xad, 0xe0, 0x6a, 0xdd,
-	0x6f, 0x60, 0x33, 0xd5, 0xa2, 0xd5, 0x45, 0x34, 0x51, 0xdc, 0x6c, 0xbc, 0x39, 0x1e, 0xf3, 0x1e,
-	0x76, 0x5e, 0x5c, 0x56, 0xb5, 0x97, 0x97, 0x55, 0xed, 0xaf, 0xcb, 0xaa, 0xf6, 0xd3, 0x55, 0x75,
-	0xed, 0xe5, 0x55, 0x75, 0xed, 0x8f, 0xab, 0xea, 0xda, 0xb7, 0xf7, 0x13, 0x76, 0x64, 0xf9, 0x56,
-	0xd3, 0x3e, 0x43, 0x9e, 0xdf, 0x4e, 0x7c, 0x67, 0x3c, 0x53, 0x5f, 0x1a, 0x56, 0x2e, 0xfc, 0xd4,
-	0x78, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x22, 0xdb, 0xd4, 0x34, 0x0d, 0x00, 0x00,
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xa9, 0x1d, 0xbf, 0x24, 0x36, 0xda, 0xa6, 0xcd, 0x7a, 0x51, 0x1d, 0xd7, 0x52,
+	0xdd, 0xa8, 0xc2, 0xb6, 0x92, 0x02, 0x15, 0xa5, 0x97, 0x26, 0x46, 0x08, 0x21, 0x4b, 0x61, 0x03,
+	0x1c, 0x40, 0xc8, 0x5a, 0xef, 0x4e, 0x36, 0xab, 0xd8, 0x3b, 0xdb, 0x99, 0xb1, 0xd5, 0xdc, 0x10,
+	0x27, 0x4e, 0x08, 0x89, 0x33, 0xdf, 0x81, 0x43, 0xf9, 0x02, 0x5c, 0xe8, 0xb1, 0xea, 0x09, 0x71,
+	0x88, 0x50, 0x72, 0xe0, 0x2b, 0x70, 0x44, 0xb3, 0x3b, 0x3b, 0x5e, 0xdb, 0xeb, 0x3f, 0x71, 0x0a,
+	0x27, 0x7b, 0xe7, 0xfd, 0xde, 0x9b, 0xdf, 0x7b, 0xf3, 0x7b, 0x6f, 0x76, 0xe1, 0xb6, 0x43, 0x10,
+	0xf2, 0x8e, 0x5d, 0xd4, 0xb1, 0xeb, 0xd4, 0xaf, 0xb3, 0xe7, 0x35, 0x9f, 0x60, 0x86, 0xd5, 0x8d,
+	0xc1, 0x7a, 0x8d, 0xfa, 0x7a, 0xd1, 0xc2, 0xb4, 0x8b, 0x69, 0xbd, 0x6d, 0x52, 0x54, 0xef, 0xef,
+	0xb6, 0x11, 0x33, 0x77, 0xeb, 0x16, 0x76, 0xbd, 0x10, 0xae, 0x6f, 0x09, 0x7b, 0x97, 0x3a, 0xf5,
+	0xfe, 0x2e, 0xff, 0x11, 0x86, 0x42, 0x68, 0x68, 0x05, 0x4f, 0xf5, 0xf0, 0x41, 0x98, 0x36, 0x1d,
+	0xec, 0xe0, 0x70, 0x9d, 0xff, 0x13, 0xab, 0xfa, 0x30, 0x21, 0xdf, 0x24, 0x66, 0x37, 0xf2, 0x28,
+	0x8c, 0x90, 0x3d, 0xf3, 0x91, 0x30, 0x95, 0x7f, 0xca, 0x80, 0xd6, 0xa4, 0xce, 0x01, 0x41, 0x26,
+	0x43, 0x47, 0x0c, 0x13, 0xd3, 0x41, 0x87, 0x04, 0xf7, 0x5d, 0x1b, 0x11, 0x75, 0x0f, 0x32, 0x16,
+	0x37, 0x60, 0xa2, 0x29, 0x25, 0x65, 0x27, 0xbb, 0xaf, 0xbd, 0x7e, 0x51, 0xdd, 0x14, 0x64, 0x9e,
+	0xda, 0x36, 0x41, 0x94, 0x1e, 0x31, 0xe2, 0x7a, 0x8e, 0x11, 0x01, 0xd5, 0x7d, 0x58, 0xb3, 0x11,
+	0xb5, 0x88, 0xeb, 0x33, 0x17, 0x7b, 0xda, 0x72, 0x49, 0xd9, 0x59, 0xdb, 0xd3, 0x6b, 0x43, 0x65,
+	0xa9, 0x35, 0x06, 0x88, 0xfd, 0x95, 0x97, 0xe7, 0xdb, 0x4b, 0x46, 0xdc, 0x49, 0x7d, 0x04, 0x40,
+	0xfd, 0x96, 0x19, 0x6e, 0xa0, 0xa5, 0x66, 0x6c, 0x9d, 0xa5, 0xbe, 0x58, 0x50, 0x9f, 0x42, 0xfe,
+	0xb8, 0xe7, 0xd9, 0xae, 0xe7, 0x48, 0xef, 0x95, 0x19, 0xde, 0x39, 0xe1, 0x10, 0x85, 0xf8, 0x10,
+	0xd6, 0x29, 0x32, 0x3b, 0xd2, 0xff, 0xc6, 0x0c, 0xff, 0x35, 0x8e, 0x8e, 0x9c, 0x0f, 0xe0, 0x2d,
+	0xd3, 0xf7, 0x09, 0xee, 0xc7, 0x02, 0xa4, 0x67, 0x04, 0xc8, 0x47, 0x1e, 0x51, 0x90, 0x47, 0x00,
+	0x8e, 0x25, 0xdd, 0x33, 0xb3, 0xb2, 0x77, 0xac, 0xc8, 0xf1, 0x13, 0xb8, 0xd9, 0x35, 0x5d, 0x8f,
+	0x21, 0xcf, 0xf4, 0x2c, 0x24, 0x23, 0xac, 0xce, 0x88, 0xa0, 0xc6, 0x9c, 0xa2, 0x50, 0x3a, 0xac,
+	0x22, 0xcf, 0xf6, 0xb1, 0xeb, 0x31, 0x2d, 0xcb, 0xfd, 0x0d, 0xf9, 0xac, 0x7e, 0x00, 0x19, 0x1b,
+	0xf9, 0x98, 0xba, 0x4c, 0x83, 0xe0, 0x74, 0x0b, 0x35, 0x11, 0x97, 0xab, 0xbc, 0x26, 0x54, 0x5e,
+	0x3b, 0xc0, 0x6e, 0x74, 0xb8, 0x11, 0x5e, 0xfd, 0x1a, 0x80, 0x20, 0xd3, 0x6e, 0xf9, 0xc4, 0xb5,
+	0x90, 0xb6, 0x16, 0x10, 0x7b, 0xc2, 0x21, 0x7f, 0x9e, 0x6f, 0x57, 0x1c, 0x97, 0x9d, 0xf4, 0xda,
+	0x35, 0x0b, 0x77, 0x85, 0xde, 0xc5, 0x4f, 0x95, 0xda, 0xa7, 0x42, 0xb3, 0x0d, 0x64, 0xbd, 0x7e,
+	0x51, 0x05, 0xb1, 0x5d, 0x03, 0x59, 0x46, 0x96, 0xc7, 0x3b, 0xe4, 0xe1, 0xd4, 0x0a, 0xe4, 0x8f,
+	0x09, 0x42, 0xad, 0x60, 0x87, 0x67, 0x3d, 0xcc, 0x4c, 0x6d, 0xbd, 0xa4, 0xec, 0xac, 0x18, 0x1b,
+	0x7c, 0xd9, 0x40, 0xa6, 0xfd, 0x19, 0x5f, 0x54, 0xbf, 0x81, 0x35, 0xca, 0x30, 0x41, 0x82, 0xc5,
+	0xc6, 0x1b, 0x60, 0x01, 0x41, 0xc0, 0x90, 0xc6, 0x16, 0x64, 0xda, 0x1d, 0xda, 0x3a, 0x45, 0x67,
+	0x5a, 0x2e, 0xa8, 0x5c, 0xba, 0xdd, 0xa1, 0x9f, 0xa2, 0x33, 0xf5, 0x6d, 0xc8, 0x72, 0x83, 0x4f,
+	0x30, 0x3e, 0xd6, 0xf2, 0x61, 0x51, 0xdb, 0x1d, 0x7a, 0xc8, 0x9f, 0x1f, 0xaf, 0x7f, 0xf7, 0xf7,
+	0x2f, 0x0f, 0xa2, 0x26, 0x2a, 0x97, 0xa1, 0x34, 0xa9, 0x29, 0x0d, 0x44, 0x7d, 0xec, 0x51, 0x54,
+	0xfe, 0x4d, 0x01, 0x68, 0x52, 0xa7, 0x21, 0x4a, 0xbb, 0x48, 0xaf, 0x0e, 0xf7, 0xd9, 0xf2, 0xfc,
+	0x7d, 0x16, 0x93, 0x40, 0xea, 0x6a, 0x12, 0x18, 0x49, 0x74, 0x13, 0xd4, 0x41, 0x0e, 0x32, 0xb5,
+	0x7f, 0x52, 0x70, 0xbb, 0x49, 0x9d, 0x8f, 0x6c, 0x97, 0x8d, 0x8e, 0xa4, 0x61, 0xca, 0xca, 0xfc,
+	0x94, 0xe3, 0x8a, 0x5e, 0x1e, 0x51, 0xf4, 0x93, 0xe1, 0x99, 0x95, 0x9a, 0x35, 0xb3, 0x86, 0xa7,
+	0xd5, 0xe8, 0xc4, 0x58, 0xb9, 0xee, 0xc4, 0xb8, 0x71, 0xbd, 0x89, 0x91, 0xbe, 0xf6, 0xc4, 0xc8,
+	0x2c, 0x30, 0x31, 0x62, 0xb2, 0x5f, 0x9d, 0x2c, 0xfb, 0xec, 0x88, 0xec, 0xf3, 0x5c, 0x0d, 0xb1,
+	0x13, 0x2d, 0x97, 0xa0, 0x98, 0x7c, 0xf2, 0x52, 0x1c, 0xbf, 0x2f, 0xc3, 0x56, 0x93, 0x3a, 0x5f,
+	0xf8, 0x36, 0x6f, 0x0e, 0x5f, 0xc2, 0x78, 0xef, 0x2d, 0xac, 0x8e, 0xe1, 0xc1, 0xb4, 0xfc, 0x9f,
+	0x0f, 0xa6, 0xd4, 0x1c, 0x83, 0x69, 0xe5, 0xcd, 0x0e, 0xa6, 0xf1, 0x5a, 0xdf, 0x85, 0xed, 0x09,
+	0x85, 0x94, 0xc5, 0xfe, 0x41, 0x81, 0xbc, 0xc4, 0x1c, 0x06, 0xef, 0x14, 0xea, 0xfb, 0x90, 0x35,
+	0x7b, 0xec, 0x04, 0x13, 0x97, 0x9d, 0xcd, 0xae, 0xb1, 0x84, 0xaa, 0x0f, 0x21, 0x1d, 0xbe, 0x95,
+	0x88, 0x97, 0x82, 0x5b, 0x23, 0x0d, 0x16, 0x86, 0x17, 0xf3, 0x42, 0x40, 0x1f, 0xe7, 0x38, 0xe9,
+	0x41, 0x90, 0x72, 0x21, 0x76, 0xf8, 0xa1, 0x83, 0xe4, 0xfa, 0xab, 0x12, 0x68, 0x47, 0xe4, 0x33,
+	0xac, 0x9e, 0x23, 0x66, 0xb2, 0x1e, 0x5d, 0x5c, 0x1f, 0x55, 0x48, 0xd3, 0x20, 0x44, 0xc0, 0x3d,
+	0x37, 0xc6, 0x3d, 0x8c, 0x6f, 0x08, 0x10, 0x1f, 0x36, 0x76, 0x8f, 0x98, 0x72, 0x9a, 0xa4, 0x0c,
+	0xf9, 0x3c, 0x7e, 0x0c, 0x3b, 0x50, 0x99, 0x4e, 0x5b, 0x66, 0xf8, 0xb3, 0x12, 0xcc, 0xc5, 0x23,
+	0xeb, 0x04, 0xd9, 0xbd, 0x0e, 0x6a, 0x0e, 0xba, 0x70, 0xf1, 0xcc, 0xee, 0x00, 0x50, 0x66, 0x12,
+	0xd6, 0x62, 0x6e, 0x37, 0x54, 0x7e, 0xca, 0xc8, 0x06, 0x2b, 0x9f, 0xbb, 0x5d, 0x74, 0xb5, 0x4c,
+	0xc2, 0xe6, 0x4d, 0xa0, 0x27, 0x33, 0xf8, 0x5e, 0x81, 0x3b, 0xfc, 0x66, 0xe3, 0x8b, 0x9d, 0x08,
+	0x68, 0xff, 0x0f, 0x89, 0x8c, 0x93, 0xbd, 0x0f, 0xf7, 0xa6, 0x32, 0x89, 0x38, 0xef, 0x9d, 0xa7,
+	0x21, 0xd5, 0xa4, 0x8e, 0xfa, 0x0c, 0x6e, 0x25, 0xbf, 0x26, 0xdf, 0x1f, 0x11, 0xc3, 0xa4, 0xab,
+	0x5b, 0xaf, 0xcf, 0x09, 0x8c, 0xb6, 0x56, 0x3f, 0x86, 0x4c, 0x74, 0xbf, 0x17, 0xc6, 0x7d, 0x85,
+	0x49, 0xbf, 0x3b, 0xd1, 0x24, 0x03, 0x9d, 0xc2, 0xcd, 0xa4, 0xdb, 0xf4, 0xde, 0xb8, 0x67, 0x02,
+	0x4c, 0xaf, 0xce, 0x05, 0x93, 0x9b, 0x79, 0xb0, 0x99, 0x38, 0x9d, 0x2b, 0xe3, 0x61, 0x92, 0x70,
+	0x7a, 0x6d, 0x3e, 0x9c, 0xdc, 0xaf, 0x0f, 0xb9, 0x81, 0x3d, 0xe8, 0xbf, 0xea, 0xc4, 0x08, 0x49,
+	0xfd, 0xa5, 0xbf, 0x77, 0x25, 0x78, 0xbc, 0xa8, 0x49, 0xad, 0x98, 0x50, 0xd4, 0x04, 0x58, 0x52,
+	0x51, 0xa7, 0x74, 0x8e, 0xfa, 0xad, 0x02, 0xfa, 0x94, 0xb6, 0x79, 0x27, 0x41, 0x5a, 0x13, 0xd1,
+	0xfa, 0xbb, 0x57, 0x41, 0x4b, 0x0a, 0x5f, 0xc2, 0xfa, 0xd0, 0x45, 0x50, 0x9c, 0x54, 0xb6, 0xd0,
+	0xae, 0x57, 0xa6, 0xdb, 0xa3, 0xb8, 0xfb, 0x8d, 0x97, 0x17, 0x45, 0xe5, 0xd5, 0x45, 0x51, 0xf9,
+	0xeb, 0xa2, 0xa8, 0xfc, 0x78, 0x59, 0x5c, 0x7a, 0x75, 0x59, 0x5c, 0xfa, 0xe3, 0xb2, 0xb8, 0xf4,
+	0xd5, 0x83, 0xd8, 0xa5, 0xd7, 0xf6, 0xda, 0x55, 0xeb, 0xc4, 0x74, 0xbd, 0x7a, 0xec, 0x6b, 0xf6,
+	0xb9, 0xfc, 0x9e, 0x6d, 0xa7, 0x83, 0x0f, 0xda, 0x87, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x41,
+	0x88, 0x02, 0xa4, 0x9a, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -871,6 +1076,8 @@ type MsgClient interface {
 	EditStorageProvider(ctx context.Context, in *MsgEditStorageProvider, opts ...grpc.CallOption) (*MsgEditStorageProviderResponse, error)
 	UpdateSpStoragePrice(ctx context.Context, in *MsgUpdateSpStoragePrice, opts ...grpc.CallOption) (*MsgUpdateSpStoragePriceResponse, error)
 	UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProviderStatus, opts ...grpc.CallOption) (*MsgUpdateStorageProviderStatusResponse, error)
+	ScheduleMaintenance(ctx context.Context, in *MsgScheduleMaintenance, opts ...grpc.CallOption) (*MsgScheduleMaintenanceResponse, error)
+	CancelScheduledMaintenance(ctx context.Context, in *MsgCancelScheduledMaintenance, opts ...grpc.CallOption) (*MsgCancelScheduledMaintenanceResponse, error)
 	// UpdateParams defines a governance operation for updating the x/sp module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -931,6 +1138,24 @@ func (c *msgClient) UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProv
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMaintenance(ctx context.Context, in *MsgScheduleMaintenance, opts ...grpc.CallOption) (*MsgScheduleMaintenanceResponse, error) {
+	out := new(MsgScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/ScheduleMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMaintenance(ctx context.Context, in *MsgCancelScheduledMaintenance, opts ...grpc.CallOption) (*MsgCancelScheduledMaintenanceResponse, error) {
+	out := new(MsgCancelScheduledMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/CancelScheduledMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateParams", in, out, opts...)
@@ -947,6 +1172,8 @@ type MsgServer interface {
 	EditStorageProvider(context.Context, *MsgEditStorageProvider) (*MsgEditStorageProviderResponse, error)
 	UpdateSpStoragePrice(context.Context, *MsgUpdateSpStoragePrice) (*MsgUpdateSpStoragePriceResponse, error)
 	UpdateSpStatus(context.Context, *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error)
+	ScheduleMaintenance(context.Context, *MsgScheduleMaintenance) (*MsgScheduleMaintenanceResponse, error)
+	CancelScheduledMaintenance(context.Context, *MsgCancelScheduledMaintenance) (*MsgCancelScheduledMaintenanceResponse, error)
 	// UpdateParams defines a governance operation for updating the x/sp module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -973,6 +1200,12 @@ func (*UnimplementedMsgServer) UpdateSpStoragePrice(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) UpdateSpStatus(ctx context.Context, req *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpStatus not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleMaintenance(ctx context.Context, req *MsgScheduleMaintenance) (*MsgScheduleMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMaintenance(ctx context.Context, req *MsgCancelScheduledMaintenance) (*MsgCancelScheduledMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMaintenance not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1071,6 +1304,42 @@ func _Msg_UpdateSpStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/ScheduleMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMaintenance(ctx, req.(*MsgScheduleMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/CancelScheduledMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMaintenance(ctx, req.(*MsgCancelScheduledMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1113,6 +1382,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSpStatus",
 			Handler:    _Msg_UpdateSpStatus_Handler,
 		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _Msg_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMaintenance",
+			Handler:    _Msg_CancelScheduledMaintenance_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1671,75 +1948,196 @@ func (m *MsgUpdateStorageProviderStatusResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateStorageProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FundingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SealAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ApprovalAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GcAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MaintenanceAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ReadPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.FreeReadQuota != 0 {
-		n += 1 + sovTx(uint64(m.FreeReadQuota))
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.StorePrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.BlsKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.BlsProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SealAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApprovalAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaintenanceAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlsProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
 
 func (m *MsgCreateStorageProviderResponse) Size() (n int) {
@@ -1914,6 +2312,59 @@ func (m *MsgUpdateStorageProviderStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgScheduleMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScheduledMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3636,6 +4087,327 @@ func (m *MsgUpdateStorageProviderStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,8 @@ const (
 	MaxWebsiteLength                      = 140
 	MaxDetailsLength                      = 280
 	MaintenanceRecordsGCFrequencyInBlocks = 100
+	MaxScheduledMaintenances              = 5       // the max number of maintenance windows not started for a SP
+	MaxMaintenanceScheduleAhead           = 2592000 // the max seconds a maintenance window can be scheduled ahead, 30 days
 )
 
 // NewStorageProvider constructs a new StorageProvider
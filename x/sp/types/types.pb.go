@@ -570,6 +570,234 @@ func (m *MaintenanceRecord) GetRequestAt() int64 {
 	return 0
 }
 
+// ScheduledMaintenance defines a maintenance window registered by a storage provider in advance.
+type ScheduledMaintenance struct {
+	// sp_id defines the identifier of the storage provider.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// start_time defines the timestamp the storage provider enters maintenance mode at.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration defines the requested maintenance duration in seconds.
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *ScheduledMaintenance) Reset()         { *m = ScheduledMaintenance{} }
+func (m *ScheduledMaintenance) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMaintenance) ProtoMessage()    {}
+func (*ScheduledMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{7}
+}
+func (m *ScheduledMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMaintenance.Merge(m, src)
+}
+func (m *ScheduledMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMaintenance proto.InternalMessageInfo
+
+func (m *ScheduledMaintenance) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *ScheduledMaintenance) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ScheduledMaintenance) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// SpReputationWindow defines the service events of a storage provider in a time window.
+type SpReputationWindow struct {
+	// start_time defines the timestamp the window starts at.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// challenges_issued defines the number of challenges issued against the storage provider.
+	ChallengesIssued uint64 `protobuf:"varint,2,opt,name=challenges_issued,json=challengesIssued,proto3" json:"challenges_issued,omitempty"`
+	// challenges_passed defines the number of challenges the storage provider passed, i.e. heartbeats and appealed slashes.
+	ChallengesPassed uint64 `protobuf:"varint,3,opt,name=challenges_passed,json=challengesPassed,proto3" json:"challenges_passed,omitempty"`
+	// challenges_failed defines the number of challenges the storage provider is slashed for.
+	ChallengesFailed uint64 `protobuf:"varint,4,opt,name=challenges_failed,json=challengesFailed,proto3" json:"challenges_failed,omitempty"`
+	// maintenance_overruns defines the number of maintenances lasting longer than requested.
+	MaintenanceOverruns uint64 `protobuf:"varint,5,opt,name=maintenance_overruns,json=maintenanceOverruns,proto3" json:"maintenance_overruns,omitempty"`
+	// discontinues defines the number of discontinue bucket and object requests of the storage provider.
+	Discontinues uint64 `protobuf:"varint,6,opt,name=discontinues,proto3" json:"discontinues,omitempty"`
+	// forced_exits defines the number of times the storage provider is forced to exit by governance.
+	ForcedExits uint64 `protobuf:"varint,7,opt,name=forced_exits,json=forcedExits,proto3" json:"forced_exits,omitempty"`
+	// challenges_attested defines the number of challenges against the storage provider attested by the validators,
+	// i.e. heartbeats and slashes.
+	ChallengesAttested uint64 `protobuf:"varint,8,opt,name=challenges_attested,json=challengesAttested,proto3" json:"challenges_attested,omitempty"`
+}
+
+func (m *SpReputationWindow) Reset()         { *m = SpReputationWindow{} }
+func (m *SpReputationWindow) String() string { return proto.CompactTextString(m) }
+func (*SpReputationWindow) ProtoMessage()    {}
+func (*SpReputationWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{8}
+}
+func (m *SpReputationWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpReputationWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpReputationWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpReputationWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpReputationWindow.Merge(m, src)
+}
+func (m *SpReputationWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpReputationWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpReputationWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpReputationWindow proto.InternalMessageInfo
+
+func (m *SpReputationWindow) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetChallengesIssued() uint64 {
+	if m != nil {
+		return m.ChallengesIssued
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetChallengesPassed() uint64 {
+	if m != nil {
+		return m.ChallengesPassed
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetChallengesFailed() uint64 {
+	if m != nil {
+		return m.ChallengesFailed
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetMaintenanceOverruns() uint64 {
+	if m != nil {
+		return m.MaintenanceOverruns
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetDiscontinues() uint64 {
+	if m != nil {
+		return m.Discontinues
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetForcedExits() uint64 {
+	if m != nil {
+		return m.ForcedExits
+	}
+	return 0
+}
+
+func (m *SpReputationWindow) GetChallengesAttested() uint64 {
+	if m != nil {
+		return m.ChallengesAttested
+	}
+	return 0
+}
+
+// SpReputation defines the service events of a storage provider in the rolling windows.
+type SpReputation struct {
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// windows defines the windows sorted by start time, the outdated ones are purged.
+	Windows []SpReputationWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows"`
+}
+
+func (m *SpReputation) Reset()         { *m = SpReputation{} }
+func (m *SpReputation) String() string { return proto.CompactTextString(m) }
+func (*SpReputation) ProtoMessage()    {}
+func (*SpReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{9}
+}
+func (m *SpReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpReputation.Merge(m, src)
+}
+func (m *SpReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpReputation proto.InternalMessageInfo
+
+func (m *SpReputation) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpReputation) GetWindows() []SpReputationWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("greenfield.sp.Status", Status_name, Status_value)
 	proto.RegisterType((*Description)(nil), "greenfield.sp.Description")
@@ -579,76 +807,92 @@ func init() {
 	proto.RegisterType((*GlobalSpStorePrice)(nil), "greenfield.sp.GlobalSpStorePrice")
 	proto.RegisterType((*SpMaintenanceStats)(nil), "greenfield.sp.SpMaintenanceStats")
 	proto.RegisterType((*MaintenanceRecord)(nil), "greenfield.sp.MaintenanceRecord")
+	proto.RegisterType((*ScheduledMaintenance)(nil), "greenfield.sp.ScheduledMaintenance")
+	proto.RegisterType((*SpReputationWindow)(nil), "greenfield.sp.SpReputationWindow")
+	proto.RegisterType((*SpReputation)(nil), "greenfield.sp.SpReputation")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/types.proto", fileDescriptor_7a9af9b5be8c2eeb) }
 
 var fileDescriptor_7a9af9b5be8c2eeb = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0x59, 0x8e, 0x47, 0xb6, 0xa5, 0xac, 0xed, 0x3f, 0xb4, 0x7f, 0x54, 0x31, 0x74,
-	0x70, 0xdd, 0x00, 0x96, 0x10, 0xf7, 0x10, 0xa0, 0xed, 0x45, 0x96, 0x14, 0x83, 0x6d, 0xe2, 0xa6,
-	0x94, 0x53, 0x14, 0x2d, 0x0a, 0x62, 0x45, 0x8e, 0xe5, 0x85, 0xa5, 0x5d, 0x66, 0x77, 0xe5, 0x54,
+	// 1224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0x1b, 0x37,
+	0x13, 0xf6, 0x4a, 0xb2, 0x1c, 0x8f, 0x64, 0x4b, 0xa6, 0xed, 0x3f, 0xb2, 0x7f, 0x44, 0x71, 0x74,
+	0x48, 0xdd, 0x14, 0x96, 0x10, 0xf7, 0x10, 0xa0, 0xed, 0x45, 0x96, 0x94, 0x40, 0x6d, 0xe2, 0xa4,
+	0x2b, 0xa7, 0x2d, 0x5a, 0x14, 0x0b, 0x6a, 0x39, 0x92, 0x89, 0x48, 0xcb, 0xcd, 0x92, 0x6b, 0x47,
 	0x6f, 0xd0, 0x53, 0xd1, 0x27, 0xe8, 0xa1, 0x7d, 0x82, 0x02, 0x39, 0xf5, 0x09, 0x72, 0x0c, 0x72,
-	0x2a, 0x7a, 0x08, 0x0a, 0xfb, 0x29, 0x7a, 0x2b, 0x48, 0x2e, 0x29, 0xd6, 0x3d, 0x08, 0x05, 0x7c,
-	0x92, 0xe6, 0x9b, 0xf9, 0xbe, 0x1d, 0xce, 0xce, 0x0c, 0x09, 0xdb, 0x23, 0x89, 0xc8, 0xcf, 0x18,
-	0x8e, 0x83, 0xb6, 0x0a, 0xdb, 0x7a, 0x16, 0xa2, 0x6a, 0x85, 0x52, 0x68, 0x41, 0xd6, 0xe6, 0xae,
-	0x96, 0x0a, 0x77, 0x1a, 0xbe, 0x50, 0x13, 0xa1, 0xda, 0x43, 0xaa, 0xb0, 0x7d, 0xf9, 0x70, 0x88,
-	0x9a, 0x3e, 0x6c, 0xfb, 0x82, 0xf1, 0x24, 0x7c, 0x67, 0x3b, 0xf1, 0x7b, 0xb1, 0xd5, 0x4e, 0x0c,
-	0xe3, 0xda, 0x1c, 0x89, 0x91, 0x48, 0xf0, 0xe8, 0x5f, 0x82, 0x36, 0x7f, 0xb6, 0xa0, 0xda, 0x43,
-	0xe5, 0x4b, 0x16, 0x6a, 0x26, 0x38, 0xb1, 0x61, 0x79, 0x22, 0x38, 0xbb, 0x40, 0x69, 0x5b, 0xbb,
-	0xd6, 0xfe, 0x8a, 0x9b, 0x9a, 0x64, 0x07, 0xee, 0xb0, 0x00, 0xb9, 0x66, 0x7a, 0x66, 0x17, 0x63,
-	0x57, 0x66, 0x47, 0xac, 0x97, 0x38, 0x54, 0x4c, 0xa3, 0x5d, 0x4a, 0x58, 0xc6, 0x24, 0x1f, 0x40,
-	0x5d, 0xa1, 0x3f, 0x95, 0x4c, 0xcf, 0x3c, 0x5f, 0x70, 0x4d, 0x7d, 0x6d, 0x97, 0xe3, 0x90, 0x5a,
-	0x8a, 0x77, 0x13, 0x38, 0x12, 0x09, 0x50, 0x53, 0x36, 0x56, 0xf6, 0x52, 0x22, 0x62, 0xcc, 0xe6,
-	0x6f, 0x4b, 0x50, 0x1b, 0x68, 0x21, 0xe9, 0x08, 0x9f, 0x49, 0x71, 0xc9, 0x02, 0x94, 0x64, 0x1d,
-	0x8a, 0x2c, 0x88, 0x73, 0x5c, 0x73, 0x8b, 0x2c, 0x20, 0x5d, 0xa8, 0x8b, 0x10, 0x25, 0xd5, 0x42,
-	0x7a, 0x34, 0x08, 0x24, 0x2a, 0x95, 0xa4, 0x79, 0x64, 0xbf, 0x7d, 0x75, 0xb0, 0x69, 0x4a, 0xd1,
-	0x49, 0x3c, 0x03, 0x2d, 0x19, 0x1f, 0xb9, 0xb5, 0x94, 0x61, 0x60, 0xd2, 0x81, 0xda, 0xd9, 0x94,
-	0x07, 0x8c, 0x8f, 0x32, 0x8d, 0xd2, 0x02, 0x8d, 0x75, 0x43, 0x48, 0x25, 0x3e, 0x86, 0x55, 0x85,
-	0x74, 0x9c, 0xf1, 0xcb, 0x0b, 0xf8, 0xd5, 0x28, 0x3a, 0x25, 0x77, 0xa1, 0x4e, 0xc3, 0x50, 0x8a,
-	0xcb, 0x9c, 0xc0, 0xd2, 0xa2, 0x87, 0x48, 0x19, 0xa9, 0xc8, 0x23, 0x80, 0x91, 0x9f, 0xd1, 0x2b,
-	0x0b, 0xe8, 0x2b, 0x23, 0x3f, 0x25, 0x3a, 0xb0, 0x31, 0xa1, 0x8c, 0x6b, 0xe4, 0x94, 0xfb, 0x98,
-	0x29, 0x2c, 0x2f, 0x50, 0x20, 0x39, 0x52, 0x2a, 0x45, 0x61, 0x4d, 0x0b, 0x4d, 0xc7, 0x5e, 0x80,
-	0xa1, 0x50, 0x4c, 0xdb, 0x77, 0x62, 0x91, 0x4f, 0x5e, 0xbf, 0xbb, 0x5f, 0xf8, 0xe3, 0xdd, 0xfd,
-	0xbd, 0x11, 0xd3, 0xe7, 0xd3, 0x61, 0xcb, 0x17, 0x13, 0xd3, 0xa4, 0xe6, 0xe7, 0x40, 0x05, 0x17,
-	0xa6, 0xff, 0x1d, 0xae, 0xdf, 0xbe, 0x3a, 0x00, 0x73, 0xa4, 0xc3, 0xb5, 0xbb, 0x1a, 0x4b, 0xf6,
-	0x12, 0x45, 0x72, 0x00, 0x15, 0xa5, 0xa9, 0x9e, 0x2a, 0x7b, 0x65, 0xd7, 0xda, 0x5f, 0x3f, 0xdc,
-	0x6a, 0xfd, 0x63, 0x54, 0x5a, 0x83, 0xd8, 0xe9, 0x9a, 0xa0, 0xa8, 0x7d, 0x91, 0x07, 0xa1, 0x60,
-	0x5c, 0xdb, 0x90, 0xb4, 0x6f, 0x6a, 0x93, 0x23, 0xa8, 0x06, 0xf3, 0x19, 0xb0, 0xab, 0xbb, 0xd6,
-	0x7e, 0xf5, 0x70, 0xe7, 0x86, 0x5e, 0x6e, 0x4a, 0x8e, 0xca, 0xd1, 0x73, 0xb8, 0x79, 0x12, 0xb9,
-	0x07, 0xcb, 0xc3, 0xb1, 0xf2, 0x2e, 0x70, 0x66, 0xaf, 0xee, 0x5a, 0xfb, 0xab, 0x6e, 0x65, 0x38,
-	0x56, 0x9f, 0xe1, 0xac, 0x39, 0x03, 0x70, 0xf1, 0x25, 0x95, 0x81, 0xc3, 0xcf, 0x04, 0x39, 0x84,
-	0xe5, 0xb4, 0xae, 0xd6, 0x82, 0xba, 0xa6, 0x81, 0xe4, 0x11, 0x54, 0xe8, 0x44, 0x4c, 0xb9, 0x8e,
-	0x1b, 0xba, 0x7a, 0xb8, 0xdd, 0x32, 0xf1, 0xd1, 0x16, 0x68, 0x99, 0x2d, 0xd0, 0xea, 0x0a, 0x96,
-	0x26, 0x66, 0xc2, 0x9b, 0xbf, 0x16, 0x61, 0x7d, 0x10, 0x66, 0x93, 0xc3, 0x7c, 0x24, 0x1b, 0xb0,
-	0xa4, 0x42, 0x2f, 0x9b, 0x9c, 0xb2, 0x0a, 0x9d, 0x80, 0xec, 0x41, 0x6d, 0x1a, 0x06, 0x54, 0xa3,
-	0xa7, 0xd9, 0x04, 0x3d, 0x85, 0x7e, 0x7c, 0x52, 0xc9, 0x5d, 0x4b, 0xe0, 0x53, 0x36, 0xc1, 0x01,
-	0xfa, 0xe4, 0x1b, 0x00, 0x89, 0x34, 0xf0, 0xc2, 0x48, 0xca, 0x4c, 0xc6, 0x7f, 0xb9, 0xd2, 0x1e,
-	0xfa, 0xb9, 0x2b, 0xed, 0xa1, 0xef, 0xae, 0x44, 0x7a, 0x49, 0x66, 0x7b, 0x50, 0x3b, 0x93, 0x88,
-	0x5e, 0x7c, 0xc2, 0x8b, 0xa9, 0xd0, 0x34, 0x9e, 0x9d, 0xb2, 0xbb, 0x16, 0xc1, 0x2e, 0xd2, 0xe0,
-	0x8b, 0x08, 0x24, 0xdf, 0x42, 0x55, 0x69, 0x21, 0xd1, 0x64, 0xb1, 0x74, 0x0b, 0x59, 0x40, 0x2c,
-	0x18, 0xa7, 0xd1, 0xfc, 0xab, 0x08, 0xe4, 0x78, 0x2c, 0x86, 0x74, 0x9c, 0x54, 0x0e, 0xb3, 0xec,
-	0x6e, 0x96, 0xc8, 0x5a, 0x5c, 0xa2, 0xe2, 0xed, 0x96, 0x68, 0x0c, 0x1b, 0xa1, 0x64, 0x13, 0x2a,
-	0x67, 0x5e, 0xbe, 0x04, 0xb7, 0x71, 0x11, 0x77, 0x8d, 0x70, 0xee, 0x91, 0x43, 0xd8, 0x52, 0xe8,
-	0x0b, 0x1e, 0xdc, 0x3c, 0xaf, 0x7c, 0x0b, 0xe7, 0x6d, 0x64, 0xd2, 0xf3, 0x13, 0x9b, 0xcf, 0x80,
-	0x0c, 0xc2, 0xa7, 0xf3, 0x6d, 0x12, 0x8d, 0xb0, 0x22, 0x1f, 0xc1, 0xb2, 0x44, 0x5f, 0xc8, 0x20,
-	0x1a, 0x99, 0xd2, 0x7e, 0xf5, 0x70, 0xf7, 0xc6, 0x64, 0xe6, 0x18, 0x6e, 0x1c, 0xe8, 0xa6, 0x84,
-	0xe6, 0x4f, 0x16, 0xdc, 0xfd, 0x97, 0x9b, 0xfc, 0x0f, 0x2a, 0xe7, 0xc8, 0x46, 0xe7, 0xda, 0xdc,
-	0xa1, 0xb1, 0xa2, 0x97, 0x95, 0xc4, 0x17, 0x53, 0x54, 0xda, 0x0b, 0xa6, 0x92, 0xc6, 0xcb, 0x20,
-	0x19, 0x84, 0x9a, 0xc1, 0x7b, 0x06, 0x26, 0xef, 0x43, 0x8d, 0xfa, 0x7a, 0x1a, 0x6d, 0xb8, 0x34,
-	0xb2, 0x14, 0x47, 0xae, 0x27, 0x70, 0x16, 0xf8, 0x5e, 0xd4, 0x10, 0x89, 0x26, 0x4d, 0x5e, 0x7d,
-	0xa5, 0xe8, 0x4a, 0x63, 0xa4, 0xa3, 0x1f, 0xfc, 0x60, 0x41, 0x25, 0xd9, 0x54, 0x64, 0x0b, 0xee,
-	0x0e, 0x4e, 0x3b, 0xa7, 0xcf, 0x07, 0x9e, 0x73, 0xe2, 0x0d, 0xfa, 0xee, 0x97, 0x4e, 0xb7, 0x5f,
-	0x2f, 0x90, 0x4d, 0xa8, 0xcf, 0xe1, 0x4f, 0x3b, 0xce, 0x93, 0x7e, 0xaf, 0x6e, 0x91, 0xff, 0xc3,
-	0x3d, 0x83, 0x1e, 0xbb, 0x9d, 0x6e, 0xff, 0xf1, 0xf3, 0x27, 0x5e, 0xff, 0x2b, 0xe7, 0xd4, 0x39,
-	0x39, 0xae, 0x17, 0xc9, 0x36, 0x6c, 0xcd, 0x29, 0x4f, 0x3b, 0xce, 0xc9, 0x69, 0xff, 0xa4, 0x73,
-	0xd2, 0xed, 0xd7, 0x4b, 0x39, 0xd7, 0xe3, 0xcf, 0xdd, 0x6e, 0xbf, 0x97, 0xb1, 0xca, 0x3b, 0xe5,
-	0xef, 0x7f, 0x69, 0x14, 0x8e, 0x7a, 0xaf, 0xaf, 0x1a, 0xd6, 0x9b, 0xab, 0x86, 0xf5, 0xe7, 0x55,
-	0xc3, 0xfa, 0xf1, 0xba, 0x51, 0x78, 0x73, 0xdd, 0x28, 0xfc, 0x7e, 0xdd, 0x28, 0x7c, 0xfd, 0x20,
-	0x77, 0xd1, 0x43, 0x3e, 0x3c, 0xf0, 0xcf, 0x29, 0xe3, 0xed, 0xdc, 0xa7, 0xcb, 0x77, 0xd9, 0xc7,
-	0xcb, 0xb0, 0x12, 0x7f, 0x5d, 0x7c, 0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xc1, 0x3c,
-	0xf4, 0xda, 0x08, 0x00, 0x00,
+	0x2a, 0x7a, 0x08, 0x8a, 0x04, 0x7d, 0x88, 0xde, 0x8a, 0xe5, 0x72, 0xa5, 0xb5, 0x12, 0x40, 0x28,
+	0xe0, 0x93, 0xc4, 0x6f, 0xe6, 0xfb, 0x38, 0x3b, 0x9c, 0xe1, 0x10, 0x76, 0x86, 0x01, 0xa2, 0x37,
+	0xe0, 0x38, 0x62, 0x0d, 0xe9, 0x37, 0xd4, 0xc4, 0x47, 0x59, 0xf7, 0x03, 0xa1, 0x04, 0x59, 0x9b,
+	0x99, 0xea, 0xd2, 0xdf, 0xad, 0xba, 0x42, 0x8e, 0x85, 0x6c, 0xf4, 0xa9, 0xc4, 0xc6, 0xd9, 0xed,
+	0x3e, 0x2a, 0x7a, 0xbb, 0xe1, 0x0a, 0xee, 0xc5, 0xee, 0xbb, 0x3b, 0xb1, 0xdd, 0xd1, 0xab, 0x46,
+	0xbc, 0x30, 0xa6, 0xad, 0xa1, 0x18, 0x8a, 0x18, 0x8f, 0xfe, 0xc5, 0x68, 0xed, 0x17, 0x0b, 0x0a,
+	0x6d, 0x94, 0x6e, 0xc0, 0x7d, 0xc5, 0x85, 0x47, 0x2a, 0xb0, 0x32, 0x16, 0x1e, 0x7f, 0x82, 0x41,
+	0xc5, 0xda, 0xb3, 0xf6, 0x57, 0xed, 0x64, 0x49, 0x76, 0xe1, 0x0a, 0x67, 0xe8, 0x29, 0xae, 0x26,
+	0x95, 0x8c, 0x36, 0x4d, 0xd7, 0x11, 0xeb, 0x1c, 0xfb, 0x92, 0x2b, 0xac, 0x64, 0x63, 0x96, 0x59,
+	0x92, 0x0f, 0xa1, 0x2c, 0xd1, 0x0d, 0x03, 0xae, 0x26, 0x8e, 0x2b, 0x3c, 0x45, 0x5d, 0x55, 0xc9,
+	0x69, 0x97, 0x52, 0x82, 0xb7, 0x62, 0x38, 0x12, 0x61, 0xa8, 0x28, 0x1f, 0xc9, 0xca, 0x72, 0x2c,
+	0x62, 0x96, 0xb5, 0xdf, 0x97, 0xa1, 0xd4, 0x53, 0x22, 0xa0, 0x43, 0x7c, 0x14, 0x88, 0x33, 0xce,
+	0x30, 0x20, 0xeb, 0x90, 0xe1, 0x4c, 0xc7, 0xb8, 0x66, 0x67, 0x38, 0x23, 0x2d, 0x28, 0x0b, 0x1f,
+	0x03, 0xaa, 0x44, 0xe0, 0x50, 0xc6, 0x02, 0x94, 0x32, 0x0e, 0xf3, 0xa8, 0xf2, 0xea, 0xf9, 0xc1,
+	0x96, 0x49, 0x45, 0x33, 0xb6, 0xf4, 0x54, 0xc0, 0xbd, 0xa1, 0x5d, 0x4a, 0x18, 0x06, 0x26, 0x4d,
+	0x28, 0x0d, 0x42, 0x8f, 0x71, 0x6f, 0x38, 0xd5, 0xc8, 0x2e, 0xd0, 0x58, 0x37, 0x84, 0x44, 0xe2,
+	0x53, 0x28, 0x4a, 0xa4, 0xa3, 0x29, 0x3f, 0xb7, 0x80, 0x5f, 0x88, 0xbc, 0x13, 0x72, 0x0b, 0xca,
+	0xd4, 0xf7, 0x03, 0x71, 0x96, 0x12, 0x58, 0x5e, 0xf4, 0x11, 0x09, 0x23, 0x11, 0xb9, 0x03, 0x30,
+	0x74, 0xa7, 0xf4, 0xfc, 0x02, 0xfa, 0xea, 0xd0, 0x4d, 0x88, 0x5d, 0xd8, 0x1c, 0x53, 0xee, 0x29,
+	0xf4, 0xa8, 0xe7, 0xe2, 0x54, 0x61, 0x65, 0x81, 0x02, 0x49, 0x91, 0x12, 0x29, 0x0a, 0x6b, 0x4a,
+	0x28, 0x3a, 0x72, 0x18, 0xfa, 0x42, 0x72, 0x55, 0xb9, 0xa2, 0x45, 0x3e, 0x7b, 0xf1, 0xfa, 0xfa,
+	0xd2, 0x9f, 0xaf, 0xaf, 0xdf, 0x1c, 0x72, 0x75, 0x1a, 0xf6, 0xeb, 0xae, 0x18, 0x9b, 0x22, 0x35,
+	0x3f, 0x07, 0x92, 0x3d, 0x31, 0xf5, 0xdf, 0xf5, 0xd4, 0xab, 0xe7, 0x07, 0x60, 0xb6, 0xec, 0x7a,
+	0xca, 0x2e, 0x6a, 0xc9, 0x76, 0xac, 0x48, 0x0e, 0x20, 0x2f, 0x15, 0x55, 0xa1, 0xac, 0xac, 0xee,
+	0x59, 0xfb, 0xeb, 0x87, 0xdb, 0xf5, 0x0b, 0xad, 0x52, 0xef, 0x69, 0xa3, 0x6d, 0x9c, 0xa2, 0xf2,
+	0x45, 0x8f, 0xf9, 0x82, 0x7b, 0xaa, 0x02, 0x71, 0xf9, 0x26, 0x6b, 0x72, 0x04, 0x05, 0x36, 0xeb,
+	0x81, 0x4a, 0x61, 0xcf, 0xda, 0x2f, 0x1c, 0xee, 0xce, 0xe9, 0xa5, 0xba, 0xe4, 0x28, 0x17, 0x7d,
+	0x87, 0x9d, 0x26, 0x91, 0xab, 0xb0, 0xd2, 0x1f, 0x49, 0xe7, 0x09, 0x4e, 0x2a, 0xc5, 0x3d, 0x6b,
+	0xbf, 0x68, 0xe7, 0xfb, 0x23, 0xf9, 0x05, 0x4e, 0x6a, 0x13, 0x00, 0x1b, 0xcf, 0x69, 0xc0, 0xba,
+	0xde, 0x40, 0x90, 0x43, 0x58, 0x49, 0xf2, 0x6a, 0x2d, 0xc8, 0x6b, 0xe2, 0x48, 0xee, 0x40, 0x9e,
+	0x8e, 0x45, 0xe8, 0x29, 0x5d, 0xd0, 0x85, 0xc3, 0x9d, 0xba, 0xf1, 0x8f, 0x6e, 0x81, 0xba, 0xb9,
+	0x05, 0xea, 0x2d, 0xc1, 0x93, 0xc0, 0x8c, 0x7b, 0xed, 0xb7, 0x0c, 0xac, 0xf7, 0xfc, 0x69, 0xe7,
+	0x70, 0x17, 0xc9, 0x26, 0x2c, 0x4b, 0xdf, 0x99, 0x76, 0x4e, 0x4e, 0xfa, 0x5d, 0x46, 0x6e, 0x42,
+	0x29, 0xf4, 0x19, 0x55, 0xe8, 0x28, 0x3e, 0x46, 0x47, 0xa2, 0xab, 0x77, 0xca, 0xda, 0x6b, 0x31,
+	0x7c, 0xc2, 0xc7, 0xd8, 0x43, 0x97, 0x7c, 0x07, 0x10, 0x20, 0x65, 0x8e, 0x1f, 0x49, 0x99, 0xce,
+	0xf8, 0x2f, 0x47, 0xda, 0x46, 0x37, 0x75, 0xa4, 0x6d, 0x74, 0xed, 0xd5, 0x48, 0x2f, 0x8e, 0xec,
+	0x26, 0x94, 0x06, 0x01, 0xa2, 0xa3, 0x77, 0x78, 0x1a, 0x0a, 0x45, 0x75, 0xef, 0xe4, 0xec, 0xb5,
+	0x08, 0xb6, 0x91, 0xb2, 0x2f, 0x23, 0x90, 0x7c, 0x0f, 0x05, 0xa9, 0x44, 0x80, 0x26, 0x8a, 0xe5,
+	0x4b, 0x88, 0x02, 0xb4, 0xa0, 0x0e, 0xa3, 0xf6, 0x4f, 0x06, 0xc8, 0xbd, 0x91, 0xe8, 0xd3, 0x51,
+	0x9c, 0x39, 0x9c, 0x46, 0x37, 0x9f, 0x22, 0x6b, 0x71, 0x8a, 0x32, 0x97, 0x9b, 0xa2, 0x11, 0x6c,
+	0xfa, 0x01, 0x1f, 0xd3, 0x60, 0xe2, 0xa4, 0x53, 0x70, 0x19, 0x07, 0xb1, 0x61, 0x84, 0x53, 0x9f,
+	0xec, 0xc3, 0xb6, 0x44, 0x57, 0x78, 0x6c, 0x7e, 0xbf, 0xdc, 0x25, 0xec, 0xb7, 0x39, 0x95, 0x9e,
+	0xed, 0x58, 0x7b, 0x04, 0xa4, 0xe7, 0x3f, 0x98, 0xdd, 0x26, 0x51, 0x0b, 0x4b, 0xf2, 0x09, 0xac,
+	0x04, 0xe8, 0x8a, 0x80, 0x45, 0x2d, 0x93, 0xdd, 0x2f, 0x1c, 0xee, 0xcd, 0x75, 0x66, 0x8a, 0x61,
+	0x6b, 0x47, 0x3b, 0x21, 0xd4, 0x7e, 0xb6, 0x60, 0xe3, 0x1d, 0x33, 0xf9, 0x1f, 0xe4, 0x4f, 0x91,
+	0x0f, 0x4f, 0x95, 0x39, 0x43, 0xb3, 0x8a, 0x86, 0x55, 0x80, 0x4f, 0x43, 0x94, 0xca, 0x61, 0x61,
+	0x40, 0xf5, 0x65, 0x10, 0x37, 0x42, 0xc9, 0xe0, 0x6d, 0x03, 0x93, 0x0f, 0xa0, 0x44, 0x5d, 0x15,
+	0x46, 0x37, 0x5c, 0xe2, 0x99, 0xd5, 0x9e, 0xeb, 0x31, 0x3c, 0x75, 0xbc, 0x16, 0x15, 0x44, 0xac,
+	0x49, 0xe3, 0xd1, 0x97, 0x8d, 0x8e, 0x54, 0x23, 0x4d, 0x55, 0x1b, 0xc0, 0x56, 0xcf, 0x3d, 0x45,
+	0x16, 0x8e, 0x90, 0xa5, 0x02, 0x7d, 0x7f, 0x9f, 0x5e, 0x03, 0x90, 0x8a, 0x06, 0x4a, 0xd7, 0xa0,
+	0x89, 0x6c, 0x55, 0x23, 0x51, 0xf9, 0x45, 0x57, 0xdc, 0x5c, 0x30, 0xd3, 0x75, 0xed, 0xef, 0x4c,
+	0x94, 0x5b, 0x1b, 0xfd, 0x50, 0x69, 0xe0, 0x6b, 0xee, 0x31, 0x71, 0x3e, 0xa7, 0x68, 0xcd, 0x2b,
+	0x7e, 0x04, 0x1b, 0xee, 0x29, 0x1d, 0x8d, 0xd0, 0x1b, 0xa2, 0x74, 0xb8, 0x94, 0x21, 0x32, 0xbd,
+	0x6f, 0xce, 0x2e, 0xcf, 0x0c, 0x5d, 0x8d, 0xcf, 0x39, 0xfb, 0x54, 0x4a, 0x64, 0x3a, 0x8e, 0x0b,
+	0xce, 0x8f, 0x34, 0x3e, 0xe7, 0x3c, 0xa0, 0x7c, 0x84, 0xcc, 0xf4, 0x7b, 0xca, 0xf9, 0xae, 0xc6,
+	0xc9, 0x6d, 0xd8, 0x4a, 0x0f, 0x26, 0x71, 0x86, 0x41, 0x10, 0x7a, 0xf1, 0x68, 0xcc, 0xd9, 0xe9,
+	0xa1, 0xf5, 0xd0, 0x98, 0x48, 0x0d, 0x8a, 0x8c, 0xcb, 0xe8, 0xc5, 0xc1, 0xbd, 0x10, 0xe3, 0x31,
+	0x98, 0xb3, 0x2f, 0x60, 0xe4, 0x06, 0x14, 0x07, 0x22, 0x70, 0x91, 0x39, 0xf8, 0x8c, 0xab, 0x78,
+	0xd0, 0xe5, 0xec, 0x42, 0x8c, 0x75, 0x22, 0x88, 0x34, 0x60, 0x33, 0x15, 0x26, 0x55, 0x0a, 0xa5,
+	0x42, 0xa6, 0xa7, 0x59, 0xce, 0x26, 0x33, 0x53, 0xd3, 0x58, 0x6a, 0x03, 0x28, 0xa6, 0xd3, 0xfc,
+	0xfe, 0x73, 0x6c, 0xc2, 0xca, 0xb9, 0xce, 0x7f, 0xf4, 0x44, 0x89, 0x2a, 0xfa, 0xc6, 0xfc, 0xec,
+	0x7a, 0xe7, 0xa4, 0xcc, 0xcd, 0x9e, 0xf0, 0x6e, 0xfd, 0x68, 0x41, 0x3e, 0x9e, 0x70, 0x64, 0x1b,
+	0x36, 0x7a, 0x27, 0xcd, 0x93, 0xc7, 0x3d, 0xa7, 0x7b, 0xec, 0xf4, 0x3a, 0xf6, 0x57, 0xdd, 0x56,
+	0xa7, 0xbc, 0x44, 0xb6, 0xa0, 0x3c, 0x83, 0x3f, 0x6f, 0x76, 0xef, 0x77, 0xda, 0x65, 0x8b, 0xfc,
+	0x1f, 0xae, 0x1a, 0xf4, 0x9e, 0xdd, 0x6c, 0x75, 0xee, 0x3e, 0xbe, 0xef, 0x74, 0xbe, 0xe9, 0x9e,
+	0x74, 0x8f, 0xef, 0x95, 0x33, 0x64, 0x07, 0xb6, 0x67, 0x94, 0x07, 0xcd, 0xee, 0xf1, 0x49, 0xe7,
+	0xb8, 0x79, 0xdc, 0xea, 0x94, 0xb3, 0x29, 0xd3, 0xdd, 0x87, 0x76, 0xab, 0xd3, 0x9e, 0xb2, 0x72,
+	0xbb, 0xb9, 0x1f, 0x7e, 0xad, 0x2e, 0x1d, 0xb5, 0x5f, 0xbc, 0xa9, 0x5a, 0x2f, 0xdf, 0x54, 0xad,
+	0xbf, 0xde, 0x54, 0xad, 0x9f, 0xde, 0x56, 0x97, 0x5e, 0xbe, 0xad, 0x2e, 0xfd, 0xf1, 0xb6, 0xba,
+	0xf4, 0xed, 0xad, 0xd4, 0x05, 0xd1, 0xf7, 0xfa, 0x07, 0xee, 0x29, 0xe5, 0x5e, 0x23, 0xf5, 0xe4,
+	0x7d, 0x36, 0x7d, 0xf4, 0xf6, 0xf3, 0xfa, 0x55, 0xfa, 0xf1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x88, 0x6c, 0x5d, 0xa5, 0x12, 0x0b, 0x00, 0x00,
 }
 
 func (m *Description) Marshal() (dAtA []byte, err error) {
@@ -1054,6 +1298,149 @@ func (m *MaintenanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpReputationWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpReputationWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpReputationWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengesAttested != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesAttested))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForcedExits != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ForcedExits))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Discontinues != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Discontinues))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaintenanceOverruns != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaintenanceOverruns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChallengesFailed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesFailed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChallengesPassed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesPassed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChallengesIssued != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesIssued))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1236,17 +1623,86 @@ func (m *MaintenanceRecord) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ScheduledMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTypes(uint64(m.Duration))
+	}
+	return n
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *SpReputationWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	if m.ChallengesIssued != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesIssued))
+	}
+	if m.ChallengesPassed != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesPassed))
+	}
+	if m.ChallengesFailed != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesFailed))
+	}
+	if m.MaintenanceOverruns != 0 {
+		n += 1 + sovTypes(uint64(m.MaintenanceOverruns))
+	}
+	if m.Discontinues != 0 {
+		n += 1 + sovTypes(uint64(m.Discontinues))
+	}
+	if m.ForcedExits != 0 {
+		n += 1 + sovTypes(uint64(m.ForcedExits))
+	}
+	if m.ChallengesAttested != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesAttested))
+	}
+	return n
+}
+
+func (m *SpReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2536,6 +2992,418 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpReputationWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpReputationWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpReputationWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesIssued", wireType)
+			}
+			m.ChallengesIssued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesIssued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesPassed", wireType)
+			}
+			m.ChallengesPassed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesPassed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesFailed", wireType)
+			}
+			m.ChallengesFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceOverruns", wireType)
+			}
+			m.MaintenanceOverruns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceOverruns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discontinues", wireType)
+			}
+			m.Discontinues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Discontinues |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedExits", wireType)
+			}
+			m.ForcedExits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForcedExits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesAttested", wireType)
+			}
+			m.ChallengesAttested = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesAttested |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, SpReputationWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,6 +31,9 @@ const (
 	FlagGroupName            = "group-name"
 	FlagExtra                = "extra"
 	FlagTags                 = "tags"
+	FlagPrefix               = "prefix"
+	FlagDelimiter            = "delimiter"
+	FlagStartAfter           = "start-after"
 )
 
 func GetVisibilityType(str string) (storagetypes.VisibilityType, error) {
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,9 +31,16 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryParams(),
 		CmdHeadBucket(),
 		CmdHeadObject(),
+		CmdHeadObjectVersion(),
+		CmdHeadComposedObject(),
 		CmdListBuckets(),
 		CmdListObjects(),
+		CmdListObjectVersions(),
+		CmdBucketLifecycle(),
+		CmdEstimateStorageCost(),
+		CmdReaderQuota(),
 		CmdVerifyPermission(),
+		CmdExplainPermission(),
 		CmdHeadGroup(),
 		CmdListGroups(),
 		CmdHeadGroupMember(),
@@ -111,6 +119,133 @@ func CmdHeadObject() *cobra.Command {
 	return cmd
 }
 
+func CmdHeadObjectVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "head-object-version [bucket-name] [object-name] [version]",
+		Short: "Query a retained version of the object",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqObjectName := args[1]
+			reqVersion, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version: %s", args[2])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHeadObjectVersionRequest{
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+				Version:    reqVersion,
+			}
+
+			res, err := queryClient.HeadObjectVersion(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHeadComposedObject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "head-composed-object [bucket-name] [object-name]",
+		Short: "Query a composed object with its components",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqObjectName := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHeadComposedObjectRequest{
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+			}
+
+			res, err := queryClient.HeadComposedObject(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateStorageCost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-storage-cost [bucket-name] [payload-size] [duration]",
+		Short: "Estimate the cost of storing an object in the bucket for the duration in seconds",
+		Long: `Estimate the cost of storing an object in the bucket for the duration in seconds, including the flow rates,
+the lock fee and the early deletion fee. The current charged read quota of the bucket is used if --charged-read-quota is not set.`,
+		Example: "gnfd query storage estimate-storage-cost mybucket 1048576 2592000 --charged-read-quota 1073741824",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqPayloadSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			reqDuration, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			reqReadQuota, err := cmd.Flags().GetUint64(FlagChargedReadQuota)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEstimateStorageCostRequest{
+				BucketName:  reqBucketName,
+				PayloadSize: reqPayloadSize,
+				ReadQuota:   reqReadQuota,
+				Duration:    reqDuration,
+			}
+
+			res, err := queryClient.EstimateStorageCost(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagChargedReadQuota, 0, "The charged read quota of the bucket to estimate with")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListBuckets() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-buckets",
@@ -146,19 +281,34 @@ func CmdListObjects() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-objects [bucket-name]",
 		Short: "Query list objects of the bucket",
-		Args:  cobra.ExactArgs(1),
+		Long: `Query list objects of the bucket. The objects whose names contain the --delimiter after the --prefix
+are grouped into common prefixes, which can be listed again with a common prefix as the --prefix.`,
+		Example: "gnfd query storage list-objects mybucket --prefix photos/ --delimiter /",
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			reqBucketName := args[0]
+			reqPrefix, _ := cmd.Flags().GetString(FlagPrefix)
+			reqDelimiter, _ := cmd.Flags().GetString(FlagDelimiter)
+			reqStartAfter, _ := cmd.Flags().GetString(FlagStartAfter)
 
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryListObjectsRequest{
 				BucketName: reqBucketName,
+				Pagination: pageReq,
+				Prefix:     reqPrefix,
+				Delimiter:  reqDelimiter,
+				StartAfter: reqStartAfter,
 			}
 
 			res, err := queryClient.ListObjects(cmd.Context(), params)
@@ -170,6 +320,84 @@ func CmdListObjects() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagPrefix, "", "List only the objects whose names begin with the prefix")
+	cmd.Flags().String(FlagDelimiter, "", "Group the objects whose names contain the delimiter after the prefix into common prefixes")
+	cmd.Flags().String(FlagStartAfter, "", "List only the objects whose names are lexicographically greater than it")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdListObjectVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-object-versions [bucket-name] [object-name]",
+		Short: "Query the retained versions of the object",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqObjectName := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListObjectVersionsRequest{
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ListObjectVersions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdBucketLifecycle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bucket-lifecycle [bucket-name]",
+		Short: "Query the lifecycle rules of the bucket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBucketLifecycleRequest{
+				BucketName: reqBucketName,
+			}
+
+			res, err := queryClient.BucketLifecycle(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -219,6 +447,50 @@ func CmdVerifyPermission() *cobra.Command {
 	return cmd
 }
 
+func CmdExplainPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain-permission [operator] [bucket-name] [object-name] [action-type]",
+		Short: "Query the trace of how the operator's permission for the bucket/object's action is decided",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqOperator := args[0]
+			reqBucketName := args[1]
+			reqObjectName := args[2]
+			reqActionType := args[3]
+
+			actionType, err := GetActionType(reqActionType)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryExplainPermissionRequest{
+				Operator:   reqOperator,
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+				ActionType: actionType,
+			}
+
+			res, err := queryClient.ExplainPermission(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdHeadGroup() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "head-group [group-owner] [group-name]",
@@ -414,3 +686,38 @@ Examples:
 
 	return cmd
 }
+
+func CmdReaderQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reader-quota [bucket-name] [reader]",
+		Short: "Query the read quota the reader bought for a requester-pays bucket",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqReader := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryReaderQuotaRequest{
+				BucketName: reqBucketName,
+				Reader:     reqReader,
+			}
+
+			res, err := queryClient.ReaderQuota(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -42,6 +42,7 @@ func GetTxCmd() *cobra.Command {
 		CmdMigrateBucket(),
 		CmdCancelMigrateBucket(),
 		CmdSetBucketFlowRateLimit(),
+		CmdSetBucketVersioning(),
 	)
 
 	cmd.AddCommand(
@@ -52,6 +53,17 @@ func GetTxCmd() *cobra.Command {
 		CmdMirrorObject(),
 		CmdDiscontinueObject(),
 		CmdUpdateObjectInfo(),
+		CmdDeleteObjectVersion(),
+		CmdSetBucketLifecycle(),
+		CmdSetRetention(),
+		CmdSetLegalHold(),
+		CmdComposeObject(),
+		CmdBatchCreateObjects(),
+		CmdBatchDeleteObjects(),
+		CmdMintReadVoucher(),
+		CmdRedeemReadVoucher(),
+		CmdSetBucketRequesterPays(),
+		CmdPurchaseReaderQuota(),
 	)
 
 	cmd.AddCommand(
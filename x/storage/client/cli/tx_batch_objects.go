@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdBatchCreateObjects() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-create-objects [bucket-name] [objects-file]",
+		Short: "Create objects of the bucket atomically, the objects are read from a json file",
+		Long: `Create objects of the bucket atomically, the objects are read from a json file like:
+{
+  "objects": [
+    {
+      "object_name": "a.txt",
+      "payload_size": "1024",
+      "visibility": "VISIBILITY_TYPE_PRIVATE",
+      "content_type": "text/plain",
+      "expect_checksums": ["<base64 checksum>", ...],
+      "redundancy_type": "REDUNDANCY_EC_TYPE"
+    }
+  ]
+}`,
+		Example: "gnfd tx storage batch-create-objects mybucket ./objects.json",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var objects types.MsgBatchCreateObjects
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &objects); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchCreateObjects(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				objects.Objects,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBatchDeleteObjects() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-delete-objects [bucket-name] [object-names]",
+		Short:   "Delete objects of the bucket atomically, object names split by ','",
+		Example: "gnfd tx storage batch-delete-objects mybucket a.txt,b.txt,c.txt",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argObjectNames := strings.Split(args[1], ",")
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchDeleteObjects(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argObjectNames,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
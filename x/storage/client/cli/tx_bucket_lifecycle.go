@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdSetBucketLifecycle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bucket-lifecycle [bucket-name] [rules]",
+		Short: "Set the lifecycle rules of a bucket, the rules are a JSON array and an empty array removes the rules",
+		Example: `gnfd tx storage set-bucket-lifecycle mybucket \
+'[{"id":"expire-logs","prefix":"logs/","expiration_days":30},{"id":"abort-uploads","abort_incomplete_upload_hours":24}]'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			var argRules []types.LifecycleRule
+			if err = json.Unmarshal([]byte(args[1]), &argRules); err != nil {
+				return fmt.Errorf("invalid rules: %s", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBucketLifecycle(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argRules,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdComposeObject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compose-object [bucket-name] [object-name] [source-object-names] [content-type]",
+		Short: "Concatenate sealed objects of the bucket into a new object, source object names split by ','",
+		Long: `Concatenate sealed objects of the bucket into a new object, source object names split by ','.
+The source objects are consumed by the composed object, they can not be accessed by their names any more.`,
+		Example: "gnfd tx storage compose-object mybucket dataset part1,part2,part3 application/octet-stream",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argObjectName := args[1]
+			argSourceObjectNames := strings.Split(args[2], ",")
+			argContentType := args[3]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			visibility, err := cmd.Flags().GetString(FlagVisibility)
+			if err != nil {
+				return err
+			}
+			visibilityType, err := GetVisibilityType(visibility)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgComposeObject(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argObjectName,
+				argSourceObjectNames,
+				argContentType,
+				visibilityType,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetVisibility())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdSetRetention() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-retention [bucket-name] [mode] [retain-until]",
+		Short: "Set the retention of a bucket, or of an object with the --object-name flag",
+		Long: `Set the retention of a bucket, or of an object with the --object-name flag.
+The mode is one of RETENTION_MODE_NONE, RETENTION_MODE_GOVERNANCE and RETENTION_MODE_COMPLIANCE,
+and the retain-until is a unix timestamp in seconds, it should be 0 when the mode is RETENTION_MODE_NONE.`,
+		Example: "gnfd tx storage set-retention mybucket RETENTION_MODE_COMPLIANCE 1893456000 --object-name myobject",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			mode, ok := types.RetentionMode_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid retention mode: %s", args[1])
+			}
+			argRetainUntil, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid retain until: %s", args[2])
+			}
+			argObjectName, _ := cmd.Flags().GetString(FlagObjectName)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRetention(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argObjectName,
+				types.Retention{Mode: types.RetentionMode(mode), RetainUntil: argRetainUntil},
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagObjectName, "", "Name of the object, the retention is set on the bucket if it is empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetLegalHold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-legal-hold [bucket-name] [legal-hold]",
+		Short: "Place or release the legal hold of a bucket, or of an object with the --object-name flag",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argLegalHold, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid legal hold: %s", args[1])
+			}
+			argObjectName, _ := cmd.Flags().GetString(FlagObjectName)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLegalHold(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argObjectName,
+				argLegalHold,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagObjectName, "", "Name of the object, the legal hold is set on the bucket if it is empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
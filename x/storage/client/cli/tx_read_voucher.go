@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdMintReadVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-read-voucher [bucket-name] [holder] [read-quota] [duration]",
+		Short:   "Mint a prepaid read quota voucher of the bucket for the holder, the duration is in seconds",
+		Example: "gnfd tx storage mint-read-voucher mybucket 0x... 1073741824 2592000",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argHolder, err := sdk.AccAddressFromHexUnsafe(args[1])
+			if err != nil {
+				return err
+			}
+			argReadQuota, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argDuration, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintReadVoucher(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argHolder,
+				argReadQuota,
+				argDuration,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRedeemReadVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-read-voucher [voucher-id] [read-bytes] [nonce] [holder-signature]",
+		Short: "Redeem the served read bytes against a read quota voucher, signed by the primary SP operator and approved by the hex encoded signature of the voucher holder",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argVoucherId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argReadBytes, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argNonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argHolderSignature, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemReadVoucher(
+				clientCtx.GetFromAddress(),
+				argVoucherId,
+				argReadBytes,
+				argNonce,
+				argHolderSignature,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdSetBucketRequesterPays() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bucket-requester-pays [bucket-name] [enabled]",
+		Short: "Enable or disable the readers paying for their own read quota of a bucket",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argEnabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid enabled: %s", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBucketRequesterPays(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argEnabled,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPurchaseReaderQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purchase-reader-quota [bucket-name] [read-quota]",
+		Short: "Buy the read quota per month of a requester-pays bucket for the sender, zero cancels the read quota",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argReadQuota, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var paymentAcc sdk.AccAddress
+			payment, _ := cmd.Flags().GetString(FlagPaymentAccount)
+			if payment != "" {
+				paymentAcc, err = sdk.AccAddressFromHexUnsafe(payment)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPurchaseReaderQuota(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				paymentAcc,
+				argReadQuota,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPaymentAccount, "", "The address of the payment account of the sender used to pay for the read quota. The default is the sender account.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,18 @@ func BeginBlocker(ctx sdk.Context, keeper Keeper) {
 }
 
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
+	ctx = ctx.WithValue(paymenttypes.ForceUpdateStreamRecordKey, true)
+
+	// index the objects existing before the object name index by name
+	keeper.BackfillObjectNameIndex(ctx, objectNameIndexBackfillMax)
+
+	// delete objects expired by the bucket lifecycle rules
+	lifecycleDeletionMax := keeper.LifecycleDeletionMax(ctx)
+	if lifecycleDeletionMax > 0 {
+		keeper.ApplyLifecycleRules(ctx, lifecycleDeletionMax)
+	}
+
 	deletionMax := keeper.DiscontinueDeletionMax(ctx)
 	if deletionMax == 0 {
 		return
@@ -24,9 +36,6 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 	blockTime := ctx.BlockTime().Unix()
 
-	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
-	ctx = ctx.WithValue(paymenttypes.ForceUpdateStreamRecordKey, true)
-
 	// delete objects
 	deleted, err := keeper.DeleteDiscontinueObjectsUntil(ctx, blockTime, deletionMax)
 	if err != nil {
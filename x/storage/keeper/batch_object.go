@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// BatchCreateObjects creates the objects of a bucket atomically. The bucket and the primary sp are checked once, the
+// store fee of all the objects is locked with a single stream record update, and one batch event is emitted.
+func (k Keeper) BatchCreateObjects(ctx sdk.Context, operator sdk.AccAddress, bucketName string,
+	items []types.BatchCreateObjectItem,
+) ([]sdkmath.Uint, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	if err := k.checkBatchSize(ctx, len(items)); err != nil {
+		return nil, err
+	}
+	// the batch message carries no sp approval, so it is only available once the approval is not needed.
+	if !ctx.IsUpgraded(upgradetypes.Serengeti) {
+		return nil, types.ErrInvalidApproval.Wrap("batch create objects is not supported before the approval is removed")
+	}
+
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	err := bucketInfo.CheckBucketStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	sp := k.MustGetPrimarySPForBucket(ctx, bucketInfo)
+	err = k.VerifySP(ctx, sp, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner := operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner))
+	objectInfoCreator := operator
+	if isOwner {
+		objectInfoCreator = sdk.AccAddress{}
+	}
+
+	maxPayloadSize := k.MaxPayloadSize(ctx)
+	objectInfos := make([]*types.ObjectInfo, 0, len(items))
+	var createdObjects []*types.ObjectInfo
+	for _, item := range items {
+		if item.PayloadSize > maxPayloadSize {
+			return nil, types.ErrTooLargeObject.Wrapf("object: %s", item.ObjectName)
+		}
+		// the owner is always allowed to create objects in its own bucket
+		if !isOwner {
+			payloadSize := item.PayloadSize
+			verifyOpts := &permtypes.VerifyOptions{
+				WantedSize:  &payloadSize,
+				ObjectName:  item.ObjectName,
+				ContentType: item.ContentType,
+				PayloadSize: &payloadSize,
+			}
+			effect := k.VerifyBucketPermission(ctx, bucketInfo, operator, permtypes.ACTION_CREATE_OBJECT, verifyOpts)
+			if effect != permtypes.EFFECT_ALLOW {
+				return nil, types.ErrAccessDenied.Wrapf("The creator(%s) has no CreateObject permission of the bucket(%s)",
+					operator.String(), bucketName)
+			}
+		}
+		if store.Has(types.GetObjectKey(bucketName, item.ObjectName)) {
+			return nil, types.ErrObjectAlreadyExists.Wrapf("object: %s", item.ObjectName)
+		}
+
+		// the empty object doesn't need sealed
+		objectStatus := types.OBJECT_STATUS_CREATED
+		if item.PayloadSize == 0 {
+			objectStatus = types.OBJECT_STATUS_SEALED
+		}
+		objectInfo := &types.ObjectInfo{
+			Owner:          bucketInfo.Owner,
+			Creator:        objectInfoCreator.String(),
+			BucketName:     bucketName,
+			ObjectName:     item.ObjectName,
+			PayloadSize:    item.PayloadSize,
+			Visibility:     item.Visibility,
+			ContentType:    item.ContentType,
+			Id:             k.GenNextObjectID(ctx),
+			CreateAt:       ctx.BlockTime().Unix(),
+			ObjectStatus:   objectStatus,
+			RedundancyType: item.RedundancyType,
+			SourceType:     types.SOURCE_TYPE_ORIGIN,
+			Checksums:      item.ExpectChecksums,
+		}
+		if objectInfo.PayloadSize == 0 {
+			_, err = k.SealEmptyObjectOnVirtualGroup(ctx, bucketInfo, objectInfo)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			createdObjects = append(createdObjects, objectInfo)
+		}
+		objectInfos = append(objectInfos, objectInfo)
+	}
+
+	if len(createdObjects) != 0 {
+		err = k.lockObjectsStoreFee(ctx, bucketInfo, ctx.BlockTime().Unix(), createdObjects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bbz := k.cdc.MustMarshal(bucketInfo)
+	store.Set(types.GetBucketByIDKey(bucketInfo.Id), bbz)
+
+	objectNames := make([]string, 0, len(objectInfos))
+	objectIds := make([]sdkmath.Uint, 0, len(objectInfos))
+	for _, objectInfo := range objectInfos {
+		if ctx.IsUpgraded(upgradetypes.Pawnee) && objectInfo.ObjectStatus == types.OBJECT_STATUS_CREATED {
+			k.IncreaseLockedObjectCount(ctx, bucketInfo.Id)
+		}
+		store.Set(types.GetObjectKey(bucketName, objectInfo.ObjectName), k.objectSeq.EncodeSequence(objectInfo.Id))
+		store.Set(types.GetObjectByIDKey(objectInfo.Id), k.cdc.MustMarshal(objectInfo))
+		k.setObjectNameIndex(ctx, bucketInfo.Id, objectInfo.ObjectName, objectInfo.Id)
+
+		objectNames = append(objectNames, objectInfo.ObjectName)
+		objectIds = append(objectIds, objectInfo.Id)
+	}
+	k.scheduleLifecycleExpiration(ctx, bucketInfo.Id, objectInfos...)
+
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventBatchCreateObjects{
+		Creator:     operator.String(),
+		BucketName:  bucketName,
+		BucketId:    bucketInfo.Id,
+		PrimarySpId: sp.Id,
+		ObjectNames: objectNames,
+		ObjectIds:   objectIds,
+	}); err != nil {
+		return nil, err
+	}
+	return objectIds, nil
+}
+
+// BatchDeleteObjects deletes the objects of a bucket atomically, either all the objects are deleted or none of them.
+// The objects not sealed yet are cancelled. The store fee of all the sealed objects, including the components of the
+// composed ones, is uncharged with a single ApplyUserFlowsList call, their retained versions are uncharged when the
+// objects are removed, and one batch event is emitted.
+func (k Keeper) BatchDeleteObjects(ctx sdk.Context, operator sdk.AccAddress, bucketName string, objectNames []string) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if err := k.checkBatchSize(ctx, len(objectNames)); err != nil {
+		return err
+	}
+
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	isOwner := operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner))
+
+	var createdObjects, sealedObjects []*types.ObjectInfo
+	objectIds := make([]sdkmath.Uint, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		objectInfo, found := k.GetObjectInfo(ctx, bucketName, objectName)
+		if !found {
+			return types.ErrNoSuchObject.Wrapf("object: %s", objectName)
+		}
+		if objectInfo.ObjectStatus == types.OBJECT_STATUS_DISCONTINUED {
+			return types.ErrInvalidObjectStatus.Wrapf("The object %s is discontined, will be deleted automatically",
+				objectInfo.ObjectName)
+		}
+		if objectInfo.SourceType != types.SOURCE_TYPE_ORIGIN {
+			return types.ErrSourceTypeMismatch.Wrapf("object: %s", objectName)
+		}
+		if objectInfo.ObjectStatus == types.OBJECT_STATUS_CREATED && !ctx.IsUpgraded(upgradetypes.Pawnee) {
+			return types.ErrObjectNotSealed.Wrapf("object: %s", objectName)
+		}
+		// the owner is always allowed to delete objects in its own bucket
+		if !isOwner {
+			effect := k.VerifyObjectPermission(ctx, bucketInfo, objectInfo, operator, permtypes.ACTION_DELETE_OBJECT)
+			if effect != permtypes.EFFECT_ALLOW {
+				return types.ErrAccessDenied.Wrapf(
+					"The operator(%s) has no DeleteObject permission of the bucket(%s), object(%s)",
+					operator.String(), bucketName, objectName)
+			}
+		}
+
+		if objectInfo.ObjectStatus == types.OBJECT_STATUS_CREATED {
+			createdObjects = append(createdObjects, objectInfo)
+		} else {
+			if err := k.checkObjectLock(ctx, bucketInfo, objectInfo); err != nil {
+				return err
+			}
+			if objectInfo.IsUpdating {
+				shadowObjectInfo := k.MustGetShadowObjectInfo(ctx, bucketInfo.BucketName, objectInfo.ObjectName)
+				err := k.UnlockShadowObjectFeeAndDeleteShadowObjectInfo(ctx, bucketInfo, shadowObjectInfo, objectInfo.ObjectName)
+				if err != nil {
+					return err
+				}
+				k.DecreaseLockedObjectCount(ctx, bucketInfo.Id)
+			}
+			sealedObjects = append(sealedObjects, objectInfo)
+		}
+		objectIds = append(objectIds, objectInfo.Id)
+	}
+
+	if len(createdObjects) != 0 {
+		err := k.unlockObjectsStoreFee(ctx, bucketInfo, createdObjects)
+		if err != nil {
+			return err
+		}
+		for _, objectInfo := range createdObjects {
+			k.DecreaseLockedObjectCount(ctx, bucketInfo.Id)
+			if err = k.removeObject(ctx, operator, bucketInfo, objectInfo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(sealedObjects) != 0 {
+		internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+		err := k.UnChargeObjectsStoreFee(ctx, bucketInfo, internalBucketInfo, sealedObjects)
+		if err != nil {
+			return err
+		}
+		k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+
+		err = k.deleteObjectsFromVirtualGroups(ctx, bucketInfo, sealedObjects)
+		if err != nil {
+			return err
+		}
+		for _, objectInfo := range sealedObjects {
+			if err = k.removeObject(ctx, operator, bucketInfo, objectInfo); err != nil {
+				return err
+			}
+		}
+	}
+
+	bbz := k.cdc.MustMarshal(bucketInfo)
+	store.Set(types.GetBucketByIDKey(bucketInfo.Id), bbz)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventBatchDeleteObjects{
+		Operator:    operator.String(),
+		BucketName:  bucketName,
+		BucketId:    bucketInfo.Id,
+		ObjectNames: objectNames,
+		ObjectIds:   objectIds,
+	})
+}
+
+// checkBatchSize checks the number of items of a batch message against the storage params.
+func (k Keeper) checkBatchSize(ctx sdk.Context, size int) error {
+	maxBatchSize := k.MaxBatchSize(ctx)
+	if maxBatchSize == 0 {
+		return types.ErrTooManyBatchItems.Wrap("the batch messages are disabled")
+	}
+	if uint64(size) > maxBatchSize {
+		return types.ErrTooManyBatchItems.Wrapf("the batch has %d items, the max is %d", size, maxBatchSize)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestBatchDeleteObjects() {
+	const mb = 1024 * 1024
+	owner := sample.RandAccAddress()
+	updatedAt := s.ctx.BlockTime().Unix() + 1
+	// move the block time out of the reserve time, so that no early deletion fee is charged
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().AddDate(1, 0, 0))
+	// the created objects can be deleted since Pawnee
+	s.ctx = sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Pawnee || name == gnfdtypes.Gobi
+	}, s.ctx.Logger())
+
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 1, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	gvg1 := &vgtypes.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3},
+		StoredSize: 3 * mb, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	gvg2 := &vgtypes.GlobalVirtualGroup{Id: 2, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3},
+		StoredSize: 3 * mb, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gvgFamily.Id).Return(gvgFamily, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg1.Id).Return(gvg1, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg2.Id).Return(gvg2, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SetGVGAndEmitUpdateEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(1),
+		PrimaryStorePrice:   sdk.NewDec(2),
+		SecondaryStorePrice: sdk.NewDec(1),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	appliedFlows := 0
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ []paymenttypes.UserFlows) error {
+			appliedFlows++
+			return nil
+		}).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().UpdateStreamRecordByAddr(gomock.Any(), gomock.Any()).
+		Return(&paymenttypes.StreamRecord{}, nil).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+	// the created object has a policy to be garbage collected
+	createdObjectId := sdkmath.NewUint(200)
+	s.permissionKeeper.EXPECT().ExistAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ resource.ResourceType, id sdkmath.Uint) bool {
+			return id.Equal(createdObjectId)
+		}).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{
+		PriceTime:       updatedAt,
+		TotalChargeSize: 6 * mb,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, GlobalVirtualGroupId: gvg1.Id, StoredSize: 3 * mb, TotalChargeSize: 3 * mb},
+			{Id: 2, GlobalVirtualGroupId: gvg2.Id, StoredSize: 3 * mb, TotalChargeSize: 3 * mb},
+		},
+	})
+
+	objectNames := []string{"a", "b", "c", "d", "e", "f"}
+	for i, name := range objectNames {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Owner:               owner.String(),
+			BucketName:          bucketInfo.BucketName,
+			ObjectName:          name,
+			Id:                  sdkmath.NewUint(uint64(100 + i)),
+			LocalVirtualGroupId: uint32(i%2 + 1),
+			PayloadSize:         mb,
+			ObjectStatus:        types.OBJECT_STATUS_SEALED,
+			CreateAt:            updatedAt,
+		})
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+		Owner:        owner.String(),
+		BucketName:   bucketInfo.BucketName,
+		ObjectName:   "created",
+		Id:           createdObjectId,
+		PayloadSize:  mb,
+		ObjectStatus: types.OBJECT_STATUS_CREATED,
+		CreateAt:     updatedAt,
+	})
+	objectNames = append(objectNames, "created")
+
+	// the number of items is limited by the params
+	params := s.storageKeeper.GetParams(s.ctx)
+	params.MaxBatchSize = 2
+	s.Require().NoError(s.storageKeeper.SetParams(s.ctx, params))
+	_, err := s.msgServer.BatchDeleteObjects(s.ctx, types.NewMsgBatchDeleteObjects(owner, bucketInfo.BucketName, objectNames))
+	s.Require().ErrorIs(err, types.ErrTooManyBatchItems)
+	params.MaxBatchSize = types.DefaultMaxBatchSize
+	s.Require().NoError(s.storageKeeper.SetParams(s.ctx, params))
+
+	// the batch is atomic, none of the objects is deleted when one of them fails
+	_, err = s.msgServer.BatchDeleteObjects(s.ctx, types.NewMsgBatchDeleteObjects(sample.RandAccAddress(),
+		bucketInfo.BucketName, objectNames))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	_, err = s.msgServer.BatchDeleteObjects(s.ctx, types.NewMsgBatchDeleteObjects(owner, bucketInfo.BucketName,
+		append([]string{"nonexistent"}, objectNames...)))
+	s.Require().ErrorIs(err, types.ErrNoSuchObject)
+
+	// the objects can not be deleted while the bucket is migrating
+	bucketInfo.BucketStatus = types.BUCKET_STATUS_MIGRATING
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	_, err = s.msgServer.BatchDeleteObjects(s.ctx, types.NewMsgBatchDeleteObjects(owner, bucketInfo.BucketName, objectNames))
+	s.Require().ErrorIs(err, types.ErrBucketMigrating)
+	bucketInfo.BucketStatus = types.BUCKET_STATUS_CREATED
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	appliedFlows = 0
+	_, err = s.msgServer.BatchDeleteObjects(s.ctx, types.NewMsgBatchDeleteObjects(owner, bucketInfo.BucketName, objectNames))
+	s.Require().NoError(err)
+	// the flows of all the sealed objects are applied together
+	s.Require().Equal(1, appliedFlows)
+	s.storageKeeper.PersistDeleteInfo(s.ctx)
+	var deleteInfo *types.DeleteInfo
+	for _, event := range s.ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			if cleanup, ok := msg.(*types.EventStalePolicyCleanup); ok {
+				deleteInfo = cleanup.DeleteInfo
+			}
+		}
+	}
+	s.Require().NotNil(deleteInfo)
+	s.Require().Equal([]sdkmath.Uint{createdObjectId}, deleteInfo.ObjectIds.Id)
+	for _, name := range objectNames {
+		_, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketInfo.BucketName, name)
+		s.Require().False(found)
+	}
+	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(0), internalBucketInfo.TotalChargeSize)
+	s.Require().Empty(internalBucketInfo.LocalVirtualGroups)
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
@@ -16,6 +18,11 @@ func (k Keeper) SetBucketFlowRateLimit(ctx sdk.Context, operator sdk.AccAddress,
 		return paymenttypes.ErrNotPaymentAccountOwner
 	}
 
+	err := k.checkFlowRateLimitAllocation(ctx, paymentAccount, bucketOwner, bucketName, rateLimit)
+	if err != nil {
+		return err
+	}
+
 	// get the bucket
 	bucket, found := k.GetBucketInfo(ctx, bucketName)
 
@@ -29,7 +36,7 @@ func (k Keeper) SetBucketFlowRateLimit(ctx sdk.Context, operator sdk.AccAddress,
 	}
 
 	// set the flow rate limit for the bucket for the current bucket owner
-	err := k.setFlowRateLimit(ctx, bucket, paymentAccount, bucketName, rateLimit)
+	err = k.setFlowRateLimit(ctx, bucket, paymentAccount, bucketName, rateLimit)
 	if err != nil {
 		return err
 	}
@@ -42,6 +49,37 @@ func (k Keeper) SetBucketFlowRateLimit(ctx sdk.Context, operator sdk.AccAddress,
 	return nil
 }
 
+// checkFlowRateLimitAllocation checks the flow rate limits of the buckets paid by the payment account against the max
+// outflow rate of its spending budget, the flow rate limits are the allocations of the max outflow rate.
+func (k Keeper) checkFlowRateLimitAllocation(ctx sdk.Context, paymentAccount, bucketOwner sdk.AccAddress, bucketName string, rateLimit sdkmath.Int) error {
+	spendingBudget, found := k.paymentKeeper.GetSpendingBudget(ctx, paymentAccount)
+	if !found || !spendingBudget.MaxOutflowRate.IsPositive() {
+		return nil
+	}
+
+	keyPrefix := append(append([]byte{}, types.BucketRateLimitPrefix...), paymentAccount...)
+	bucketKey := types.GetBucketFlowRateLimitKey(paymentAccount, bucketOwner, bucketName)[len(keyPrefix):]
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allocated := rateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		// skip the flow rate limit statuses sharing the prefix, and the bucket to set
+		if len(iterator.Key()) != len(bucketKey) || bytes.Equal(iterator.Key(), bucketKey) {
+			continue
+		}
+		var bucketRateLimit types.BucketFlowRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &bucketRateLimit)
+		allocated = allocated.Add(bucketRateLimit.FlowRateLimit)
+	}
+	if allocated.GT(spendingBudget.MaxOutflowRate) {
+		return paymenttypes.ErrSpendingBudgetExceeded.Wrapf("the flow rate limits %s of the buckets are greater than the max outflow rate %s",
+			allocated, spendingBudget.MaxOutflowRate)
+	}
+	return nil
+}
+
 func (k Keeper) unChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.BucketInfo,
 	internalBucketInfo *types.InternalBucketInfo) error {
 	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
@@ -53,7 +91,7 @@ func (k Keeper) unChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.Bu
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, false)
 }
 
 func (k Keeper) chargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.BucketInfo,
@@ -68,7 +106,7 @@ func (k Keeper) chargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.Buck
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
 	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, true)
 }
 
 func getTotalOutFlowRate(flows []paymenttypes.OutFlow) sdkmath.Int {
@@ -26,6 +26,7 @@ func (s *TestSuite) TestSetBucketFlowRateLimit() {
 
 	// case 2: bucket is not found
 	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().GetSpendingBudget(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
 	err = s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, bucketName, sdkmath.NewInt(1))
 	s.Require().NoError(err)
 
@@ -48,6 +49,33 @@ func (s *TestSuite) TestSetBucketFlowRateLimit() {
 	s.Require().NoError(err)
 }
 
+func (s *TestSuite) TestSetBucketFlowRateLimitAllocation() {
+	operatorAddress := sample.RandAccAddress()
+	bucketOwner := sample.RandAccAddress()
+	paymentAccount := sample.RandAccAddress()
+
+	// the flow rate limits of the buckets are capped by the max outflow rate of the payment account
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().GetSpendingBudget(gomock.Any(), paymentAccount).Return(&paymenttypes.SpendingBudget{
+		Addr:              paymentAccount.String(),
+		MaxOutflowRate:    sdkmath.NewInt(10),
+		MaxSpendPerPeriod: sdkmath.ZeroInt(),
+	}, true).AnyTimes()
+
+	err := s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, "bucket-a", sdkmath.NewInt(6))
+	s.Require().NoError(err)
+	err = s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, "bucket-b", sdkmath.NewInt(5))
+	s.Require().ErrorIs(err, paymenttypes.ErrSpendingBudgetExceeded)
+	err = s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, "bucket-b", sdkmath.NewInt(4))
+	s.Require().NoError(err)
+
+	// the flow rate limit of the bucket itself is replaced
+	err = s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, "bucket-a", sdkmath.NewInt(7))
+	s.Require().ErrorIs(err, paymenttypes.ErrSpendingBudgetExceeded)
+	err = s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, "bucket-a", sdkmath.NewInt(2))
+	s.Require().NoError(err)
+}
+
 func (s *TestSuite) TestSetZeroBucketFlowRateLimit() {
 	operatorAddress := sample.RandAccAddress()
 	bucketOwner := sample.RandAccAddress()
@@ -64,6 +92,7 @@ func (s *TestSuite) TestSetZeroBucketFlowRateLimit() {
 	prepareReadStoreBill(s, bucketInfo)
 
 	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().GetSpendingBudget(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
 	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	err := s.storageKeeper.SetBucketFlowRateLimit(s.ctx, operatorAddress, bucketOwner, paymentAccount, bucketName, sdkmath.NewInt(0))
 	s.Require().NoError(err)
@@ -85,6 +114,7 @@ func (s *TestSuite) TestSetFlowRateLimit_NotLimited() {
 	prepareReadStoreBill(s, bucketInfo)
 
 	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().GetSpendingBudget(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
 	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
@@ -130,6 +160,7 @@ func (s *TestSuite) TestSetBucketFlowRateLimit_Limited() {
 	prepareReadStoreBill(s, bucketInfo)
 
 	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().GetSpendingBudget(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
 	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
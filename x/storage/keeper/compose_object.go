@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// ComposeObject concatenates the sealed source objects of a bucket into a new object. The source objects are consumed
+// by the composed object: their content stays charged and stored on their local virtual groups as the components of
+// the composed object, and is released when the composed object is deleted. Composed source objects are flattened,
+// so that the components of a composed object are always plain objects.
+func (k Keeper) ComposeObject(ctx sdk.Context, operator sdk.AccAddress, bucketName, objectName string,
+	sourceObjectNames []string, contentType string, visibility types.VisibilityType,
+) (sdkmath.Uint, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return sdkmath.ZeroUint(), types.ErrNoSuchBucket
+	}
+	err := bucketInfo.CheckBucketStatus()
+	if err != nil {
+		return sdkmath.ZeroUint(), err
+	}
+
+	if store.Has(types.GetObjectKey(bucketName, objectName)) {
+		return sdkmath.ZeroUint(), types.ErrObjectAlreadyExists
+	}
+
+	var (
+		components  []*types.ObjectComponent
+		payloadSize uint64
+	)
+	sourceObjects := make([]*types.ObjectInfo, 0, len(sourceObjectNames))
+	for _, sourceObjectName := range sourceObjectNames {
+		sourceObject, found := k.GetObjectInfo(ctx, bucketName, sourceObjectName)
+		if !found {
+			return sdkmath.ZeroUint(), types.ErrNoSuchObject.Wrapf("source object: %s", sourceObjectName)
+		}
+		if err = k.checkComposeSource(ctx, operator, bucketInfo, sourceObject); err != nil {
+			return sdkmath.ZeroUint(), err
+		}
+
+		if sourceObject.IsComposed {
+			composedObject := k.MustGetComposedObject(ctx, sourceObject.Id)
+			for _, component := range composedObject.Components {
+				component.Offset = payloadSize
+				payloadSize += component.PayloadSize
+				components = append(components, component)
+			}
+		} else {
+			components = append(components, types.NewObjectComponent(sourceObject, payloadSize))
+			payloadSize += sourceObject.PayloadSize
+		}
+		sourceObjects = append(sourceObjects, sourceObject)
+	}
+
+	// the content of the source objects was uploaded and counted against the size limit of the create object
+	// permission when they were created, composing them uploads nothing, so no wanted size is consumed.
+	verifyOpts := &permtypes.VerifyOptions{
+		ObjectName:  objectName,
+		ContentType: contentType,
+		PayloadSize: &payloadSize,
+	}
+	effect := k.VerifyBucketPermission(ctx, bucketInfo, operator, permtypes.ACTION_CREATE_OBJECT, verifyOpts)
+	if effect != permtypes.EFFECT_ALLOW {
+		return sdkmath.ZeroUint(), types.ErrAccessDenied.Wrapf("The operator(%s) has no CreateObject permission of the bucket(%s)",
+			operator.String(), bucketName)
+	}
+
+	objectInfoCreator := operator
+	if objectInfoCreator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner)) {
+		objectInfoCreator = sdk.AccAddress{}
+	}
+	objectInfo := &types.ObjectInfo{
+		Owner:          bucketInfo.Owner,
+		Creator:        objectInfoCreator.String(),
+		BucketName:     bucketName,
+		ObjectName:     objectName,
+		PayloadSize:    payloadSize,
+		Visibility:     visibility,
+		ContentType:    contentType,
+		Id:             k.GenNextObjectID(ctx),
+		CreateAt:       ctx.BlockTime().Unix(),
+		ObjectStatus:   types.OBJECT_STATUS_SEALED,
+		RedundancyType: components[0].RedundancyType,
+		SourceType:     types.SOURCE_TYPE_ORIGIN,
+		IsComposed:     true,
+	}
+
+	// the source objects are consumed, their content is taken over by the composed object.
+	for _, sourceObject := range sourceObjects {
+		store.Delete(types.GetObjectKey(bucketName, sourceObject.ObjectName))
+		store.Delete(types.GetObjectByIDKey(sourceObject.Id))
+		k.afterObjectDeleted(ctx, sourceObject.Id)
+		k.deleteObjectNameIndex(ctx, bucketInfo.Id, sourceObject.ObjectName)
+		store.Delete(types.GetComposedObjectKey(sourceObject.Id))
+		if err = k.appendResourceIdForGarbageCollection(ctx, resource.RESOURCE_TYPE_OBJECT, sourceObject.Id); err != nil {
+			return sdkmath.ZeroUint(), err
+		}
+		if err = ctx.EventManager().EmitTypedEvents(&types.EventDeleteObject{
+			Operator:            operator.String(),
+			BucketName:          bucketName,
+			ObjectName:          sourceObject.ObjectName,
+			ObjectId:            sourceObject.Id,
+			LocalVirtualGroupId: sourceObject.LocalVirtualGroupId,
+		}); err != nil {
+			return sdkmath.ZeroUint(), err
+		}
+	}
+
+	k.SetComposedObject(ctx, &types.ComposedObject{
+		ObjectId:   objectInfo.Id,
+		Components: components,
+	})
+	store.Set(types.GetObjectKey(bucketName, objectName), k.objectSeq.EncodeSequence(objectInfo.Id))
+	store.Set(types.GetObjectByIDKey(objectInfo.Id), k.cdc.MustMarshal(objectInfo))
+	k.setObjectNameIndex(ctx, bucketInfo.Id, objectName, objectInfo.Id)
+	k.scheduleLifecycleExpiration(ctx, bucketInfo.Id, objectInfo)
+
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventComposeObject{
+		Operator:          operator.String(),
+		BucketName:        bucketName,
+		ObjectName:        objectName,
+		ObjectId:          objectInfo.Id,
+		PayloadSize:       payloadSize,
+		SourceObjectNames: sourceObjectNames,
+	}); err != nil {
+		return sdkmath.ZeroUint(), err
+	}
+	return objectInfo.Id, nil
+}
+
+// checkComposeSource checks whether the object can be consumed by a composed object.
+func (k Keeper) checkComposeSource(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo,
+	objectInfo *types.ObjectInfo,
+) error {
+	if objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+		return types.ErrObjectNotSealed.Wrapf("source object: %s", objectInfo.ObjectName)
+	}
+	if objectInfo.IsUpdating {
+		return types.ErrInvalidComposeSource.Wrapf("the source object %s is being updated", objectInfo.ObjectName)
+	}
+	if objectInfo.SourceType != types.SOURCE_TYPE_ORIGIN {
+		return types.ErrInvalidComposeSource.Wrapf("the source object %s is not created on greenfield", objectInfo.ObjectName)
+	}
+	if len(k.GetObjectVersions(ctx, objectInfo.Id)) != 0 {
+		return types.ErrInvalidComposeSource.Wrapf("the source object %s has retained versions", objectInfo.ObjectName)
+	}
+
+	effect := k.VerifyObjectPermission(ctx, bucketInfo, objectInfo, operator, permtypes.ACTION_DELETE_OBJECT)
+	if effect != permtypes.EFFECT_ALLOW {
+		return types.ErrAccessDenied.Wrapf(
+			"The operator(%s) has no DeleteObject permission of the bucket(%s), object(%s)",
+			operator.String(), bucketInfo.BucketName, objectInfo.ObjectName)
+	}
+	return k.checkObjectLock(ctx, bucketInfo, objectInfo)
+}
+
+func (k Keeper) SetComposedObject(ctx sdk.Context, composedObject *types.ComposedObject) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetComposedObjectKey(composedObject.ObjectId), k.cdc.MustMarshal(composedObject))
+}
+
+func (k Keeper) GetComposedObject(ctx sdk.Context, objectId sdkmath.Uint) (*types.ComposedObject, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetComposedObjectKey(objectId))
+	if bz == nil {
+		return nil, false
+	}
+
+	var composedObject types.ComposedObject
+	k.cdc.MustUnmarshal(bz, &composedObject)
+	return &composedObject, true
+}
+
+func (k Keeper) MustGetComposedObject(ctx sdk.Context, objectId sdkmath.Uint) *types.ComposedObject {
+	composedObject, found := k.GetComposedObject(ctx, objectId)
+	if !found {
+		panic("composed object not found")
+	}
+	return composedObject
+}
+
+// deleteObjectsFromVirtualGroups releases the contents of the objects from their local virtual groups. The components
+// of composed objects are released as well. The objects are uncharged all at once before, so the contents on the same
+// lvg are released together, otherwise the lvg would be seen with stored size but without charge size.
+func (k Keeper) deleteObjectsFromVirtualGroups(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfos []*types.ObjectInfo) error {
+	var lvgObjects []*types.ObjectInfo
+	merge := func(content *types.ObjectInfo) {
+		if content.LocalVirtualGroupId == 0 { // when object was not sealed, the lvg id is 0 by default.
+			return
+		}
+		for _, lvgObject := range lvgObjects {
+			if lvgObject.LocalVirtualGroupId == content.LocalVirtualGroupId {
+				lvgObject.PayloadSize += content.PayloadSize
+				return
+			}
+		}
+		lvgObject := *content
+		lvgObjects = append(lvgObjects, &lvgObject)
+	}
+	for _, objectInfo := range objectInfos {
+		if objectInfo.IsComposed {
+			composedObject := k.MustGetComposedObject(ctx, objectInfo.Id)
+			for _, component := range composedObject.Components {
+				merge(component.ToObjectInfo(objectInfo))
+			}
+		} else {
+			merge(objectInfo)
+		}
+	}
+	for _, lvgObject := range lvgObjects {
+		if err := k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, lvgObject); err != nil {
+			return err
+		}
+	}
+	return nil
+}
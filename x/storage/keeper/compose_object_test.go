@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestComposeObject() {
+	const mb = 1024 * 1024
+	owner := sample.RandAccAddress()
+	updatedAt := s.ctx.BlockTime().Unix() + 1
+	// move the block time out of the reserve time, so that no early deletion fee is charged
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().AddDate(1, 0, 0))
+
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 1, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	gvg := &vgtypes.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3},
+		StoredSize: 6 * mb, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gvgFamily.Id).Return(gvgFamily, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).Return(gvg, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SetGVGAndEmitUpdateEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(1),
+		PrimaryStorePrice:   sdk.NewDec(2),
+		SecondaryStorePrice: sdk.NewDec(1),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return(nil).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{
+		PriceTime:       updatedAt,
+		TotalChargeSize: 6 * mb,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, GlobalVirtualGroupId: gvg.Id, StoredSize: 6 * mb, TotalChargeSize: 6 * mb},
+		},
+	})
+
+	sourceNames := []string{"part1", "part2", "part3"}
+	for i, name := range sourceNames {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Owner:               owner.String(),
+			BucketName:          bucketInfo.BucketName,
+			ObjectName:          name,
+			Id:                  sdkmath.NewUint(uint64(100 + i)),
+			LocalVirtualGroupId: 1,
+			PayloadSize:         uint64(i+1) * mb,
+			ObjectStatus:        types.OBJECT_STATUS_SEALED,
+			CreateAt:            updatedAt,
+		})
+	}
+
+	// only the operator with the permissions of the bucket and the source objects can compose
+	_, err := s.msgServer.ComposeObject(s.ctx, types.NewMsgComposeObject(sample.RandAccAddress(), bucketInfo.BucketName,
+		"composed", sourceNames[:2], "", types.VISIBILITY_TYPE_PRIVATE))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	_, err = s.msgServer.ComposeObject(s.ctx, types.NewMsgComposeObject(owner, bucketInfo.BucketName,
+		"composed", []string{"part1", "nonexistent"}, "", types.VISIBILITY_TYPE_PRIVATE))
+	s.Require().ErrorIs(err, types.ErrNoSuchObject)
+
+	// compose part1 and part2, then compose the result with part3, the components are flattened
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.ComposeObject(s.ctx, types.NewMsgComposeObject(owner, bucketInfo.BucketName,
+		"composed", sourceNames[:2], "", types.VISIBILITY_TYPE_PRIVATE))
+	s.Require().NoError(err)
+	deleteEvents := 0
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventDeleteObject{}) {
+			deleteEvents++
+		}
+	}
+	s.Require().Equal(2, deleteEvents)
+	_, err = s.msgServer.ComposeObject(s.ctx, types.NewMsgComposeObject(owner, bucketInfo.BucketName,
+		"dataset", []string{"composed", "part3"}, "", types.VISIBILITY_TYPE_PRIVATE))
+	s.Require().NoError(err)
+	for _, name := range append(sourceNames, "composed") {
+		_, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketInfo.BucketName, name)
+		s.Require().False(found)
+	}
+
+	headRes, err := s.queryClient.HeadComposedObject(s.ctx, &types.QueryHeadComposedObjectRequest{
+		BucketName: bucketInfo.BucketName,
+		ObjectName: "dataset",
+	})
+	s.Require().NoError(err)
+	s.Require().True(headRes.ObjectInfo.IsComposed)
+	s.Require().Equal(uint64(6*mb), headRes.ObjectInfo.PayloadSize)
+	s.Require().Len(headRes.ComposedObject.Components, 3)
+	s.Require().Len(headRes.GlobalVirtualGroups, 3)
+	idx, offset, ok := headRes.ComposedObject.LocateOffset(4 * mb)
+	s.Require().True(ok)
+	s.Require().Equal("part3", headRes.ComposedObject.Components[idx].ObjectName)
+	s.Require().Equal(uint64(mb), offset)
+
+	// the composed object can not be updated or composed twice under the same name
+	err = s.storageKeeper.UpdateObjectContent(s.ctx, owner, bucketInfo.BucketName, "dataset", mb, types.UpdateObjectOptions{})
+	s.Require().ErrorIs(err, types.ErrUpdateObjectNotAllowed)
+
+	// deleting the composed object releases all the components
+	err = s.storageKeeper.DeleteObject(s.ctx, owner, bucketInfo.BucketName, "dataset", types.DeleteObjectOptions{})
+	s.Require().NoError(err)
+	_, found := s.storageKeeper.GetComposedObject(s.ctx, headRes.ObjectInfo.Id)
+	s.Require().False(found)
+	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(0), internalBucketInfo.TotalChargeSize)
+	s.Require().Empty(internalBucketInfo.LocalVirtualGroups)
+}
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
 	gnfdresource "github.com/bnb-chain/greenfield/types/resource"
 	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
@@ -158,6 +159,13 @@ func (app *PermissionApp) handleCreatePolicySynPackage(ctx sdk.Context, createPo
 		}
 	}
 
+	if !ctx.IsUpgraded(gnfdtypes.Gobi) {
+		// the condition field was unknown before Gobi and got dropped by the decoder
+		for _, s := range policy.Statements {
+			s.Condition = nil
+		}
+	}
+
 	resOwner, err := app.getResourceOwner(ctx, &policy)
 	if err != nil {
 		return sdk.ExecuteResult{
@@ -196,6 +204,20 @@ func (app *PermissionApp) handleCreatePolicySynPackage(ctx sdk.Context, createPo
 		}
 	}
 
+	if ctx.IsUpgraded(gnfdtypes.Gobi) {
+		err = app.storageKeeper.NormalizeStatements(policy.Statements)
+		if err != nil {
+			return sdk.ExecuteResult{
+				Payload: types.CreatePolicyAckPackage{
+					Status:    types.StatusFail,
+					Creator:   createPolicyPackage.Operator,
+					ExtraData: createPolicyPackage.ExtraData,
+				}.MustSerialize(),
+				Err: err,
+			}
+		}
+	}
+
 	PolicyId, err := app.permissionKeeper.PutPolicy(ctx, &policy)
 	if err != nil {
 		return sdk.ExecuteResult{
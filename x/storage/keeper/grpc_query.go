@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -15,6 +16,7 @@ import (
 	"github.com/bnb-chain/greenfield/internal/sequence"
 	gnfd "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/types/errors"
+	gnfdresource "github.com/bnb-chain/greenfield/types/resource"
 	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	"github.com/bnb-chain/greenfield/x/storage/types"
@@ -110,7 +112,8 @@ func (k Keeper) HeadObject(goCtx context.Context, req *types.QueryHeadObjectRequ
 		return nil, types.ErrNoSuchBucket
 	}
 	var gvg *vgtypes.GlobalVirtualGroup
-	if objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED {
+	// the content of a composed object is stored on the gvgs of its components, see HeadComposedObject.
+	if objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED && !objectInfo.IsComposed {
 		gvgFound := false
 		gvg, gvgFound = k.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
 		if !gvgFound {
@@ -144,7 +147,8 @@ func (k Keeper) HeadObjectById(goCtx context.Context, req *types.QueryHeadObject
 		return nil, types.ErrNoSuchBucket
 	}
 	var gvg *vgtypes.GlobalVirtualGroup
-	if objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED {
+	// the content of a composed object is stored on the gvgs of its components, see HeadComposedObject.
+	if objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED && !objectInfo.IsComposed {
 		gvgFound := false
 		gvg, gvgFound = k.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
 		if !gvgFound {
@@ -224,17 +228,85 @@ func (k Keeper) ListObjects(goCtx context.Context, req *types.QueryListObjectsRe
 	store := ctx.KVStore(k.storeKey)
 	objectPrefixStore := prefix.NewStore(store, types.GetObjectKeyOnlyBucketPrefix(req.BucketName))
 
-	pageRes, err := query.Paginate(objectPrefixStore, req.Pagination, func(key, value []byte) error {
-		objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(value))
-		if found {
-			objectInfos = append(objectInfos, objectInfo)
+	if req.Prefix == "" && req.Delimiter == "" && req.StartAfter == "" {
+		pageRes, err := query.Paginate(objectPrefixStore, req.Pagination, func(key, value []byte) error {
+			objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(value))
+			if found {
+				objectInfos = append(objectInfos, objectInfo)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-		return nil
-	})
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return &types.QueryListObjectsResponse{ObjectInfos: objectInfos, Pagination: pageRes}, nil
+	}
+
+	// The object keys are made of the hash of the object names, so the objects are ranged by name in the object name index.
+	// Until the existing objects are all indexed, the objects are scanned and filtered out of order instead.
+	if k.isObjectNameIndexBackfilling(ctx) {
+		var commonPrefixes []string
+		seenPrefixes := make(map[string]struct{})
+		pageRes, err := query.FilteredPaginate(objectPrefixStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+			objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(value))
+			if !found || !strings.HasPrefix(objectInfo.ObjectName, req.Prefix) || objectInfo.ObjectName <= req.StartAfter {
+				return false, nil
+			}
+			if commonPrefix, ok := objectCommonPrefix(objectInfo.ObjectName, req.Prefix, req.Delimiter); ok {
+				// a common prefix is listed once in a page
+				if _, seen := seenPrefixes[commonPrefix]; seen {
+					return false, nil
+				}
+				if accumulate {
+					seenPrefixes[commonPrefix] = struct{}{}
+					commonPrefixes = append(commonPrefixes, commonPrefix)
+				}
+				return true, nil
+			}
+			if accumulate {
+				objectInfos = append(objectInfos, objectInfo)
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryListObjectsResponse{ObjectInfos: objectInfos, Pagination: pageRes, CommonPrefixes: commonPrefixes}, nil
 	}
-	return &types.QueryListObjectsResponse{ObjectInfos: objectInfos, Pagination: pageRes}, nil
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	var (
+		pageKey []byte
+		limit   = uint64(query.DefaultLimit)
+	)
+	if req.Pagination != nil {
+		pageKey = req.Pagination.Key
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+	objectInfos, commonPrefixes, nextKey := k.listObjectsByName(ctx, bucketInfo.Id, req.Prefix, req.Delimiter, req.StartAfter,
+		pageKey, limit)
+	return &types.QueryListObjectsResponse{
+		ObjectInfos:    objectInfos,
+		Pagination:     &query.PageResponse{NextKey: nextKey},
+		CommonPrefixes: commonPrefixes,
+	}, nil
+}
+
+// objectCommonPrefix returns the object name up to and including the first delimiter after the prefix.
+func objectCommonPrefix(objectName, prefix, delimiter string) (string, bool) {
+	if delimiter == "" {
+		return "", false
+	}
+	idx := strings.Index(objectName[len(prefix):], delimiter)
+	if idx < 0 {
+		return "", false
+	}
+	return objectName[:len(prefix)+idx+len(delimiter)], true
 }
 
 func (k Keeper) ListObjectsByBucketId(goCtx context.Context, req *types.QueryListObjectsByBucketIdRequest) (*types.QueryListObjectsResponse, error) {
@@ -350,6 +422,34 @@ func (k Keeper) QueryLockFee(c context.Context, req *types.QueryLockFeeRequest)
 	return &types.QueryLockFeeResponse{Amount: amount}, nil
 }
 
+func (k Keeper) EstimateStorageCost(c context.Context, req *types.QueryEstimateStorageCostRequest) (*types.QueryEstimateStorageCostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Duration < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid duration")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	if req.PayloadSize > k.MaxPayloadSize(ctx) {
+		return nil, types.ErrTooLargeObject
+	}
+	readQuota := req.ReadQuota
+	if readQuota == 0 {
+		readQuota = bucketInfo.ChargedReadQuota
+	}
+
+	res, err := k.GetStorageCostEstimate(ctx, req.PayloadSize, readQuota, req.Duration)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
 func (k Keeper) HeadBucketExtra(c context.Context, req *types.QueryHeadBucketExtraRequest) (*types.QueryHeadBucketExtraResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -518,6 +618,47 @@ func (k Keeper) VerifyPermission(goCtx context.Context, req *types.QueryVerifyPe
 	}, nil
 }
 
+func (k Keeper) ExplainPermission(goCtx context.Context, req *types.QueryExplainPermissionRequest) (*types.QueryExplainPermissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromHexUnsafe(req.Operator)
+	if err != nil && err != sdk.ErrEmptyHexAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if req.BucketName == "" {
+		return nil, errorsmod.Wrapf(errors.ErrInvalidParameter, "No bucket specified")
+	}
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	tracer := &permissionTracer{}
+	var effect permtypes.Effect
+	if req.ObjectName == "" {
+		effect = k.verifyBucketPermission(ctx, bucketInfo, operator, req.ActionType, nil, tracer)
+		tracer.record(types.TRACE_STEP_FINAL, gnfdresource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, effect)
+	} else {
+		objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+		if !found {
+			return nil, types.ErrNoSuchObject
+		}
+		effect = k.verifyObjectPermission(ctx, bucketInfo, objectInfo, operator, req.ActionType, tracer)
+		tracer.record(types.TRACE_STEP_FINAL, gnfdresource.RESOURCE_TYPE_OBJECT, objectInfo.Id, effect)
+	}
+
+	return &types.QueryExplainPermissionResponse{
+		Effect: effect,
+		Trace:  tracer.steps,
+	}, nil
+}
+
 func (k Keeper) HeadGroup(goCtx context.Context, req *types.QueryHeadGroupRequest) (*types.QueryHeadGroupResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -709,3 +850,162 @@ func (k Keeper) QueryPaymentAccountBucketFlowRateLimit(goCtx context.Context, re
 		FlowRateLimit: flowRateLimit.FlowRateLimit,
 	}, nil
 }
+
+func (k Keeper) ListObjectVersions(goCtx context.Context, req *types.QueryListObjectVersionsRequest) (*types.QueryListObjectVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+
+	var objectVersions []*types.ObjectVersion
+	store := ctx.KVStore(k.storeKey)
+	versionStore := prefix.NewStore(store, types.GetObjectVersionKeyOnlyObjectPrefix(objectInfo.Id))
+
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(key, value []byte) error {
+		var objectVersion types.ObjectVersion
+		k.cdc.MustUnmarshal(value, &objectVersion)
+		objectVersions = append(objectVersions, &objectVersion)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListObjectVersionsResponse{ObjectVersions: objectVersions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) HeadObjectVersion(goCtx context.Context, req *types.QueryHeadObjectVersionRequest) (*types.QueryHeadObjectVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+
+	objectVersion, found := k.GetObjectVersion(ctx, objectInfo.Id, req.Version)
+	if !found {
+		return nil, types.ErrNoSuchObjectVersion
+	}
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	gvg, found := k.GetObjectGVG(ctx, bucketInfo.Id, objectVersion.LocalVirtualGroupId)
+	if !found {
+		return nil, types.ErrInvalidGlobalVirtualGroup.Wrapf("gvg not found. objectVersion: %s", objectVersion.String())
+	}
+	return &types.QueryHeadObjectVersionResponse{
+		ObjectVersion:      objectVersion,
+		GlobalVirtualGroup: gvg,
+	}, nil
+}
+
+func (k Keeper) HeadComposedObject(goCtx context.Context, req *types.QueryHeadComposedObjectRequest) (*types.QueryHeadComposedObjectResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+	if !objectInfo.IsComposed {
+		return nil, types.ErrNoSuchObject.Wrapf("the object %s is not a composed object", req.ObjectName)
+	}
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	composedObject := k.MustGetComposedObject(ctx, objectInfo.Id)
+	gvgs := make([]*vgtypes.GlobalVirtualGroup, 0, len(composedObject.Components))
+	for _, component := range composedObject.Components {
+		gvg, found := k.GetObjectGVG(ctx, bucketInfo.Id, component.LocalVirtualGroupId)
+		if !found {
+			return nil, types.ErrInvalidGlobalVirtualGroup.Wrapf("gvg not found. component: %s", component.String())
+		}
+		gvgs = append(gvgs, gvg)
+	}
+	return &types.QueryHeadComposedObjectResponse{
+		ObjectInfo:          objectInfo,
+		ComposedObject:      composedObject,
+		GlobalVirtualGroups: gvgs,
+	}, nil
+}
+
+func (k Keeper) BucketLifecycle(goCtx context.Context, req *types.QueryBucketLifecycleRequest) (*types.QueryBucketLifecycleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	lifecycle, found := k.GetBucketLifecycle(ctx, bucketInfo.Id)
+	if !found {
+		return &types.QueryBucketLifecycleResponse{}, nil
+	}
+	return &types.QueryBucketLifecycleResponse{Rules: lifecycle.Rules}, nil
+}
+
+func (k Keeper) ReaderQuota(goCtx context.Context, req *types.QueryReaderQuotaRequest) (*types.QueryReaderQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reader, err := sdk.AccAddressFromHexUnsafe(req.Reader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reader address")
+	}
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	readerQuota, found := k.GetReaderQuota(ctx, bucketInfo.Id, reader)
+	if !found {
+		return &types.QueryReaderQuotaResponse{}, nil
+	}
+	return &types.QueryReaderQuotaResponse{ReaderQuota: readerQuota}, nil
+}
+
+func (k Keeper) PaymentHealth(goCtx context.Context, req *types.QueryPaymentHealthRequest) (*types.QueryPaymentHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	// checking all the payment data is unbounded, it is only served by the nodes of the operators enabling it
+	if !k.IsPaymentHealthQueryEnabled() {
+		return nil, status.Error(codes.Unavailable, "payment health query is disabled, enable payment-check.query-enabled in app.toml")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPaymentHealthResponse{
+		Report: k.CheckPaymentHealth(ctx),
+		Height: ctx.BlockHeight(),
+	}, nil
+}
@@ -312,6 +312,107 @@ func TestListObjects(t *testing.T) {
 	require.ErrorContains(t, err, "exceed pagination limit")
 }
 
+func (s *TestSuite) TestListObjectsWithDelimiter() {
+	bucketName := "bucketname"
+	s.storageKeeper.StoreBucketInfo(s.ctx, &types.BucketInfo{BucketName: bucketName, Id: sdk.NewUint(1)})
+	objectNames := []string{"a.txt", "photos/2023/a.jpg", "photos/2023/b.jpg", "photos/2024/c.jpg", "photos/d.jpg", "videos/e.mp4"}
+	for i, objectName := range objectNames {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			BucketName: bucketName,
+			ObjectName: objectName,
+			Id:         sdk.NewUint(uint64(i + 1)),
+		})
+	}
+	listNames := func(req *types.QueryListObjectsRequest) ([]string, []string) {
+		req.BucketName = bucketName
+		res, err := s.queryClient.ListObjects(s.ctx, req)
+		s.Require().NoError(err)
+		var names []string
+		for _, objectInfo := range res.ObjectInfos {
+			names = append(names, objectInfo.ObjectName)
+		}
+		return names, res.CommonPrefixes
+	}
+
+	names, commonPrefixes := listNames(&types.QueryListObjectsRequest{Delimiter: "/"})
+	s.Require().Equal([]string{"a.txt"}, names)
+	s.Require().Equal([]string{"photos/", "videos/"}, commonPrefixes)
+
+	names, commonPrefixes = listNames(&types.QueryListObjectsRequest{Prefix: "photos/", Delimiter: "/"})
+	s.Require().Equal([]string{"photos/d.jpg"}, names)
+	s.Require().Equal([]string{"photos/2023/", "photos/2024/"}, commonPrefixes)
+
+	names, commonPrefixes = listNames(&types.QueryListObjectsRequest{Prefix: "photos/", StartAfter: "photos/2023/b.jpg"})
+	s.Require().Equal([]string{"photos/2024/c.jpg", "photos/d.jpg"}, names)
+	s.Require().Empty(commonPrefixes)
+
+	// the limit counts the objects and the common prefixes, a common prefix is not repeated on the next page
+	res, err := s.queryClient.ListObjects(s.ctx, &types.QueryListObjectsRequest{
+		BucketName: bucketName,
+		Prefix:     "photos/",
+		Delimiter:  "/",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.ObjectInfos)
+	s.Require().Equal([]string{"photos/2023/"}, res.CommonPrefixes)
+	s.Require().Equal([]byte("photos/2024/c.jpg"), res.Pagination.NextKey)
+	names, commonPrefixes = listNames(&types.QueryListObjectsRequest{
+		Prefix:     "photos/",
+		Delimiter:  "/",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().Equal([]string{"photos/d.jpg"}, names)
+	s.Require().Equal([]string{"photos/2024/"}, commonPrefixes)
+
+	// the deleted objects are removed from the index
+	s.storageKeeper.DeleteObjectInfo(s.ctx, &types.ObjectInfo{BucketName: bucketName, ObjectName: "videos/e.mp4", Id: sdk.NewUint(6)})
+	_, commonPrefixes = listNames(&types.QueryListObjectsRequest{Delimiter: "/"})
+	s.Require().Equal([]string{"photos/"}, commonPrefixes)
+}
+
+func (s *TestSuite) TestListObjectsDuringNameIndexBackfill() {
+	bucketName := "bucketname"
+	s.storageKeeper.StoreBucketInfo(s.ctx, &types.BucketInfo{BucketName: bucketName, Id: sdk.NewUint(1)})
+	objectNames := []string{"a.txt", "photos/2023/a.jpg", "photos/2023/b.jpg", "photos/2024/c.jpg", "photos/d.jpg", "videos/e.mp4"}
+	for i, objectName := range objectNames {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			BucketName: bucketName,
+			ObjectName: objectName,
+			Id:         sdk.NewUint(uint64(i + 1)),
+		})
+	}
+	listNames := func(req *types.QueryListObjectsRequest) ([]string, []string) {
+		req.BucketName = bucketName
+		res, err := s.queryClient.ListObjects(s.ctx, req)
+		s.Require().NoError(err)
+		var names []string
+		for _, objectInfo := range res.ObjectInfos {
+			names = append(names, objectInfo.ObjectName)
+		}
+		return names, res.CommonPrefixes
+	}
+
+	// the objects are scanned and filtered until the backfill is done
+	s.storageKeeper.StartObjectNameIndexBackfill(s.ctx)
+	names, commonPrefixes := listNames(&types.QueryListObjectsRequest{Prefix: "photos/", Delimiter: "/"})
+	s.Require().Equal([]string{"photos/d.jpg"}, names)
+	s.Require().ElementsMatch([]string{"photos/2023/", "photos/2024/"}, commonPrefixes)
+	names, _ = listNames(&types.QueryListObjectsRequest{Prefix: "photos/", StartAfter: "photos/2023/b.jpg"})
+	s.Require().ElementsMatch([]string{"photos/2024/c.jpg", "photos/d.jpg"}, names)
+
+	// the backfill is bounded in each end block
+	s.storageKeeper.BackfillObjectNameIndex(s.ctx, 4)
+	names, _ = listNames(&types.QueryListObjectsRequest{Prefix: "photos/"})
+	s.Require().ElementsMatch([]string{"photos/2023/a.jpg", "photos/2023/b.jpg", "photos/2024/c.jpg", "photos/d.jpg"}, names)
+	s.storageKeeper.BackfillObjectNameIndex(s.ctx, 4)
+
+	// the objects are listed in the order of their names once all are indexed
+	names, commonPrefixes = listNames(&types.QueryListObjectsRequest{Delimiter: "/"})
+	s.Require().Equal([]string{"a.txt"}, names)
+	s.Require().Equal([]string{"photos/", "videos/"}, commonPrefixes)
+}
+
 func TestListObjectsByBucketId(t *testing.T) {
 	// invalid argument
 	k, ctx := makeKeeper(t)
@@ -50,12 +50,18 @@ type (
 
 		// payment check config
 		cfg *paymentCheckConfig
+
+		// the compiled resource patterns of the policy statements
+		regexCache *permtypes.RegexCache
+
+		hooks types.StorageHooks
 	}
 )
 
 type paymentCheckConfig struct {
-	Enabled  bool
-	Interval uint32
+	Enabled      bool
+	Interval     uint32
+	QueryEnabled bool
 }
 
 func NewKeeper(
@@ -82,6 +88,7 @@ func NewKeeper(
 		virtualGroupKeeper: virtualGroupKeeper,
 		authority:          authority,
 		cfg:                &paymentCheckConfig{Enabled: false, Interval: 0},
+		regexCache:         permtypes.NewRegexCache(permtypes.DefaultRegexCacheSize),
 	}
 
 	k.bucketSeq = sequence.NewSequence[sdkmath.Uint](types.BucketSequencePrefix)
@@ -94,6 +101,21 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetHooks sets the hooks of the storage module, it should be called before the keeper is copied to the modules.
+func (k *Keeper) SetHooks(hooks types.StorageHooks) {
+	if k.hooks != nil {
+		panic("cannot set storage hooks twice")
+	}
+	k.hooks = hooks
+}
+
+// afterObjectDeleted calls the hooks after an object info is removed from the store since Gobi.
+func (k Keeper) afterObjectDeleted(ctx sdk.Context, objectId sdkmath.Uint) {
+	if k.hooks != nil && ctx.IsUpgraded(types2.Gobi) {
+		k.hooks.AfterObjectDeleted(ctx, objectId)
+	}
+}
+
 func (k Keeper) IsPaymentCheckEnabled() bool {
 	return k.cfg.Enabled
 }
@@ -102,6 +124,10 @@ func (k Keeper) GetPaymentCheckInterval() uint32 {
 	return k.cfg.Interval
 }
 
+func (k Keeper) IsPaymentHealthQueryEnabled() bool {
+	return k.cfg.QueryEnabled
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -232,6 +258,10 @@ func (k Keeper) DeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketNam
 			operator.String(), bucketName)
 	}
 
+	if err := k.checkBucketLock(ctx, bucketInfo); err != nil {
+		return err
+	}
+
 	// check if the bucket empty
 	if k.isNonEmptyBucket(ctx, bucketName) {
 		return types.ErrBucketNotEmpty
@@ -255,12 +285,21 @@ func (k Keeper) DeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketNam
 }
 
 func (k Keeper) doDeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo) error {
+	if err := k.unChargeReaderQuotas(ctx, bucketInfo.Id); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetBucketKey(bucketInfo.BucketName))
 	store.Delete(types.GetBucketByIDKey(bucketInfo.Id))
 	store.Delete(types.GetQuotaKey(bucketInfo.Id))
 	store.Delete(types.GetInternalBucketInfoKey(bucketInfo.Id))
 	store.Delete(types.GetMigrationBucketKey(bucketInfo.Id))
+	if ctx.IsUpgraded(types2.Gobi) {
+		store.Delete(types.GetBucketLifecycleKey(bucketInfo.Id))
+		store.Delete(types.GetLifecycleBackfillKey(bucketInfo.Id))
+		store.Delete(types.GetObjectLocksKey(bucketInfo.Id))
+	}
 	if ctx.IsUpgraded(upgradetypes.Pawnee) {
 		store.Delete(types.GetLockedObjectCountKey(bucketInfo.Id))
 	}
@@ -455,6 +494,9 @@ func (k Keeper) UpdateBucketInfo(ctx sdk.Context, operator sdk.AccAddress, bucke
 		// save quota update time
 		k.setQuotaUpdateTime(ctx, bucketInfo.Id, blockTime)
 	}
+	if bucketInfo.RequesterPays && *opts.ChargedReadQuota != 0 {
+		return types.ErrRequesterPaysChargedQuota.Wrapf("the readers of the bucket(%s) buy their own read quota", bucketName)
+	}
 
 	if opts.Visibility != types.VISIBILITY_TYPE_UNSPECIFIED {
 		bucketInfo.Visibility = opts.Visibility
@@ -527,6 +569,13 @@ func (k Keeper) DiscontinueBucket(ctx sdk.Context, operator sdk.AccAddress, buck
 			"only primary sp is allowed to do discontinue bucket, expect sp id : %d", spInState.Id)
 	}
 
+	if err := k.checkBucketLock(ctx, bucketInfo); err != nil {
+		return err
+	}
+	if ctx.IsUpgraded(types2.Gobi) && k.hasLockedObjects(ctx, bucketInfo.Id) {
+		return types.ErrBucketLocked.Wrapf("bucket %s has locked objects", bucketInfo.BucketName)
+	}
+
 	count := k.GetDiscontinueBucketCount(ctx, operator)
 	max := k.DiscontinueBucketMax(ctx)
 	if count+1 > max {
@@ -543,6 +592,7 @@ func (k Keeper) DiscontinueBucket(ctx sdk.Context, operator sdk.AccAddress, buck
 
 	k.appendDiscontinueBucketIds(ctx, deleteAt, []sdkmath.Uint{bucketInfo.Id})
 	k.SetDiscontinueBucketCount(ctx, operator, count+1)
+	k.spKeeper.RecordDiscontinue(ctx, sp.Id)
 
 	if previousStatus == types.BUCKET_STATUS_MIGRATING {
 		if err := ctx.EventManager().EmitTypedEvents(&types.EventCancelMigrationBucket{
@@ -634,7 +684,10 @@ func (k Keeper) CreateObject(
 
 	// verify permission
 	verifyOpts := &permtypes.VerifyOptions{
-		WantedSize: &payloadSize,
+		WantedSize:  &payloadSize,
+		ObjectName:  objectName,
+		ContentType: opts.ContentType,
+		PayloadSize: &payloadSize,
 	}
 	effect := k.VerifyBucketPermission(ctx, bucketInfo, creator, permtypes.ACTION_CREATE_OBJECT, verifyOpts)
 	if effect != permtypes.EFFECT_ALLOW {
@@ -717,6 +770,10 @@ func (k Keeper) CreateObject(
 	obz := k.cdc.MustMarshal(&objectInfo)
 	store.Set(objectKey, k.objectSeq.EncodeSequence(objectInfo.Id))
 	store.Set(types.GetObjectByIDKey(objectInfo.Id), obz)
+	if ctx.IsUpgraded(types2.Gobi) {
+		k.setObjectNameIndex(ctx, bucketInfo.Id, objectInfo.ObjectName, objectInfo.Id)
+		k.scheduleLifecycleExpiration(ctx, bucketInfo.Id, &objectInfo)
+	}
 
 	if err = ctx.EventManager().EmitTypedEvents(&types.EventCreateObject{
 		Creator:             creator.String(),
@@ -751,6 +808,9 @@ func (k Keeper) StoreObjectInfo(ctx sdk.Context, objectInfo *types.ObjectInfo) {
 	obz := k.cdc.MustMarshal(objectInfo)
 	store.Set(objectKey, k.objectSeq.EncodeSequence(objectInfo.Id))
 	store.Set(types.GetObjectByIDKey(objectInfo.Id), obz)
+	if bucketInfo, found := k.GetBucketInfo(ctx, objectInfo.BucketName); found {
+		k.setObjectNameIndex(ctx, bucketInfo.Id, objectInfo.ObjectName, objectInfo.Id)
+	}
 }
 
 // DeleteObjectInfo deletes object related keys from KVStore,
@@ -762,6 +822,10 @@ func (k Keeper) DeleteObjectInfo(ctx sdk.Context, objectInfo *types.ObjectInfo)
 
 	store.Delete(objectKey)
 	store.Delete(types.GetObjectByIDKey(objectInfo.Id))
+	k.afterObjectDeleted(ctx, objectInfo.Id)
+	if bucketInfo, found := k.GetBucketInfo(ctx, objectInfo.BucketName); found {
+		k.deleteObjectNameIndex(ctx, bucketInfo.Id, objectInfo.ObjectName)
+	}
 }
 
 func (k Keeper) SetObjectInfo(ctx sdk.Context, objectInfo *types.ObjectInfo) {
@@ -869,15 +933,22 @@ func (k Keeper) SealObject(
 
 	// an object might be set to OBJECT_STATUS_DISCONTINUED
 	if isUpdate && objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED {
-		internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
-		err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, objectInfo)
-		if err != nil {
-			return err
-		}
-		k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
-		err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
-		if err != nil {
-			return err
+		if bucketInfo.VersioningEnabled {
+			// the previous content is retained as a version, which is still charged and stored on the lvg.
+			if err := k.retainObjectVersion(ctx, objectInfo); err != nil {
+				return err
+			}
+		} else {
+			internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+			err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, objectInfo)
+			if err != nil {
+				return err
+			}
+			k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+			err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
+			if err != nil {
+				return err
+			}
 		}
 
 		shadowObjectInfo := k.MustGetShadowObjectInfo(ctx, bucketName, objectName)
@@ -935,6 +1006,9 @@ func (k Keeper) SealObject(
 
 	obz := k.cdc.MustMarshal(objectInfo)
 	store.Set(types.GetObjectByIDKey(objectInfo.Id), obz)
+	if ctx.IsUpgraded(types2.Gobi) {
+		k.scheduleLifecycleExpiration(ctx, bucketInfo.Id, objectInfo)
+	}
 
 	if isUpdate {
 		if err := ctx.EventManager().EmitTypedEvents(&types.EventUpdateObjectContentSuccess{
@@ -1025,6 +1099,10 @@ func (k Keeper) CancelCreateObject(
 
 	store.Delete(types.GetObjectKey(bucketName, objectName))
 	store.Delete(types.GetObjectByIDKey(objectInfo.Id))
+	k.afterObjectDeleted(ctx, objectInfo.Id)
+	if ctx.IsUpgraded(types2.Gobi) {
+		k.deleteObjectNameIndex(ctx, bucketInfo.Id, objectName)
+	}
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCancelCreateObject{
 		Operator:    operator.String(),
@@ -1073,6 +1151,9 @@ func (k Keeper) DeleteObject(
 			"The operator(%s) has no DeleteObject permission of the bucket(%s), object(%s)",
 			operator.String(), bucketName, objectName)
 	}
+	if err := k.checkObjectLock(ctx, bucketInfo, objectInfo); err != nil {
+		return err
+	}
 	if objectInfo.IsUpdating {
 		shadowObjectInfo := k.MustGetShadowObjectInfo(ctx, bucketInfo.BucketName, objectInfo.ObjectName)
 		err := k.UnlockShadowObjectFeeAndDeleteShadowObjectInfo(ctx, bucketInfo, shadowObjectInfo, objectInfo.ObjectName)
@@ -1102,18 +1183,12 @@ func (k Keeper) doDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketI
 	bbz := k.cdc.MustMarshal(bucketInfo)
 	store.Set(types.GetBucketByIDKey(bucketInfo.Id), bbz)
 
-	store.Delete(types.GetObjectKey(bucketInfo.BucketName, objectInfo.ObjectName))
-	store.Delete(types.GetObjectByIDKey(objectInfo.Id))
-
-	// when object was not sealed, the lvg id is 0 by default.
-	if objectInfo.LocalVirtualGroupId != 0 {
-		err := k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
-		if err != nil {
-			return err
-		}
+	err := k.deleteObjectsFromVirtualGroups(ctx, bucketInfo, []*types.ObjectInfo{objectInfo})
+	if err != nil {
+		return err
 	}
 
-	err := k.appendResourceIdForGarbageCollection(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id)
+	err = k.removeObject(ctx, operator, bucketInfo, objectInfo)
 	if err != nil {
 		return err
 	}
@@ -1128,6 +1203,29 @@ func (k Keeper) doDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketI
 	return err
 }
 
+// removeObject removes the object and its retained versions from the store, the content of the object should have been
+// released from the virtual groups.
+func (k Keeper) removeObject(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) error {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetObjectKey(bucketInfo.BucketName, objectInfo.ObjectName))
+	store.Delete(types.GetObjectByIDKey(objectInfo.Id))
+	k.afterObjectDeleted(ctx, objectInfo.Id)
+	if objectInfo.IsComposed {
+		store.Delete(types.GetComposedObjectKey(objectInfo.Id))
+	}
+
+	if ctx.IsUpgraded(types2.Gobi) {
+		k.deleteObjectNameIndex(ctx, bucketInfo.Id, objectInfo.ObjectName)
+		err := k.deleteObjectVersions(ctx, operator, bucketInfo, objectInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.appendResourceIdForGarbageCollection(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id)
+}
+
 // ForceDeleteObject will delete object without permission check, it is used for discontinue request from sps.
 func (k Keeper) ForceDeleteObject(ctx sdk.Context, objectId sdkmath.Uint) error {
 	objectInfo, found := k.GetObjectInfoById(ctx, objectId)
@@ -1145,7 +1243,25 @@ func (k Keeper) ForceDeleteObject(ctx sdk.Context, objectId sdkmath.Uint) error
 		return err
 	}
 
+	// the bucket may be locked after the object was discontinued, the sealed content is restored and kept then
+	if ctx.IsUpgraded(types2.Gobi) && objectStatus == types.OBJECT_STATUS_SEALED {
+		objectInfo.ObjectStatus = objectStatus
+		if err = k.checkObjectLock(ctx, bucketInfo, objectInfo); err != nil {
+			k.SetObjectInfo(ctx, objectInfo)
+			ctx.Logger().Info("keep the locked discontinued object", "err", err, "id", objectId)
+			return nil
+		}
+		objectInfo.ObjectStatus = types.OBJECT_STATUS_DISCONTINUED
+	}
+
 	spInState := k.MustGetPrimarySPForBucket(ctx, bucketInfo)
+	return k.forceDeleteObject(ctx, sdk.MustAccAddressFromHex(spInState.OperatorAddress), bucketInfo, objectInfo, objectStatus)
+}
+
+// forceDeleteObject releases the locked or charged fee of the object according to its status and deletes it.
+func (k Keeper) forceDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo,
+	objectInfo *types.ObjectInfo, objectStatus types.ObjectStatus,
+) error {
 	if objectStatus == types.OBJECT_STATUS_CREATED {
 		err := k.UnlockObjectStoreFee(ctx, bucketInfo, objectInfo)
 		if err != nil {
@@ -1175,7 +1291,7 @@ func (k Keeper) ForceDeleteObject(ctx sdk.Context, objectId sdkmath.Uint) error
 		}
 	}
 
-	err = k.doDeleteObject(ctx, sdk.MustAccAddressFromHex(spInState.OperatorAddress), bucketInfo, objectInfo)
+	err := k.doDeleteObject(ctx, operator, bucketInfo, objectInfo)
 	if err != nil {
 		ctx.Logger().Error("do delete object err", "err", err)
 		return err
@@ -1218,6 +1334,9 @@ func (k Keeper) CopyObject(
 	if srcObjectInfo.IsUpdating {
 		return sdkmath.ZeroUint(), types.ErrAccessDenied.Wrapf("the object is being updated, can not be copied")
 	}
+	if srcObjectInfo.IsComposed {
+		return sdkmath.ZeroUint(), types.ErrAccessDenied.Wrapf("the object is composed of other objects, can not be copied")
+	}
 
 	// check permission
 	effect := k.VerifyObjectPermission(ctx, srcBucketInfo, srcObjectInfo, operator, permtypes.ACTION_COPY_OBJECT)
@@ -1289,6 +1408,10 @@ func (k Keeper) CopyObject(
 	obz := k.cdc.MustMarshal(&objectInfo)
 	store.Set(types.GetObjectKey(dstBucketName, dstObjectName), k.objectSeq.EncodeSequence(objectInfo.Id))
 	store.Set(types.GetObjectByIDKey(objectInfo.Id), obz)
+	if ctx.IsUpgraded(types2.Gobi) {
+		k.setObjectNameIndex(ctx, dstBucketInfo.Id, dstObjectName, objectInfo.Id)
+		k.scheduleLifecycleExpiration(ctx, dstBucketInfo.Id, &objectInfo)
+	}
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCopyObject{
 		Operator:            operator.String(),
@@ -1350,6 +1473,10 @@ func (k Keeper) RejectSealObject(ctx sdk.Context, operator sdk.AccAddress, bucke
 		store.Set(types.GetBucketByIDKey(bucketInfo.Id), bbz)
 		store.Delete(types.GetObjectKey(bucketName, objectName))
 		store.Delete(types.GetObjectByIDKey(objectInfo.Id))
+		k.afterObjectDeleted(ctx, objectInfo.Id)
+		if ctx.IsUpgraded(types2.Gobi) {
+			k.deleteObjectNameIndex(ctx, bucketInfo.Id, objectName)
+		}
 	}
 
 	if ctx.IsUpgraded(upgradetypes.Pawnee) {
@@ -1411,6 +1538,9 @@ func (k Keeper) DiscontinueObject(ctx sdk.Context, operator sdk.AccAddress, buck
 		if object.ObjectStatus != types.OBJECT_STATUS_SEALED && object.ObjectStatus != types.OBJECT_STATUS_CREATED {
 			return types.ErrInvalidObjectIds.Wrapf("object %s should in created or sealed status", objectId)
 		}
+		if err := k.checkObjectLock(ctx, bucketInfo, object); err != nil {
+			return err
+		}
 
 		// remember object status
 		k.saveDiscontinueObjectStatus(ctx, object)
@@ -1423,6 +1553,7 @@ func (k Keeper) DiscontinueObject(ctx sdk.Context, operator sdk.AccAddress, buck
 	deleteAt := ctx.BlockTime().Unix() + k.DiscontinueConfirmPeriod(ctx)
 	k.AppendDiscontinueObjectIds(ctx, deleteAt, objectIds)
 	k.SetDiscontinueObjectCount(ctx, operator, count+uint64(len(objectIds)))
+	k.spKeeper.RecordDiscontinue(ctx, sp.Id)
 
 	events := make([]proto.Message, 0)
 	for _, objectId := range objectIds {
@@ -2147,6 +2278,11 @@ func (k Keeper) MigrateBucket(ctx sdk.Context, operator sdk.AccAddress, bucketNa
 		return types.ErrMigrationBucketFailed.Wrapf("The dest sp must not be the origin sp.")
 	}
 
+	if ctx.IsUpgraded(types2.Gobi) && (bucketInfo.IsLocked(ctx.BlockTime().Unix()) || k.hasLockedObjects(ctx, bucketInfo.Id)) &&
+		!k.IsRetentionCompliantSp(ctx, dstSP.Id) {
+		return types.ErrBucketLocked.Wrapf("The locked bucket or a bucket with locked objects can only be migrated to a retention compliant sp, dst sp: %d", dstSP.Id)
+	}
+
 	if !srcSP.IsInService() || !dstSP.IsInService() {
 		return sptypes.ErrStorageProviderNotInService.Wrapf(
 			"origin SP status: %s, dst SP status: %s", srcSP.Status.String(), dstSP.Status.String())
@@ -2255,6 +2391,13 @@ func (k Keeper) CompleteMigrateBucket(ctx sdk.Context, operator sdk.AccAddress,
 		return types.ErrMigrationBucketFailed.Wrapf("cancel charge bucket failed, err: %s", err)
 	}
 
+	if ctx.IsUpgraded(types2.Gobi) {
+		err = k.MoveReaderQuotas(ctx, bucketInfo.Id, bucketInfo.GlobalVirtualGroupFamilyId, gvgFamilyID)
+		if err != nil {
+			return types.ErrMigrationBucketFailed.Wrapf("move reader quotas failed, err: %s", err)
+		}
+	}
+
 	bucketInfo.GlobalVirtualGroupFamilyId = gvgFamilyID
 
 	// check secondary sp signature
@@ -2590,6 +2733,9 @@ func (k Keeper) UpdateObjectContent(
 	if objectInfo.IsUpdating {
 		return types.ErrObjectIsUpdating.Wrapf("The object is already being updated")
 	}
+	if objectInfo.IsComposed {
+		return types.ErrUpdateObjectNotAllowed.Wrapf("The object is composed of other objects")
+	}
 	// check permission
 	var updater sdk.AccAddress
 	if opts.Delegated {
@@ -2603,6 +2749,9 @@ func (k Keeper) UpdateObjectContent(
 			"The updater(%s) has no updateObjectContent permission of the bucket(%s), object(%s)",
 			updater.String(), bucketName, objectName)
 	}
+	if err := k.checkObjectLock(ctx, bucketInfo, objectInfo); err != nil {
+		return err
+	}
 
 	// check payload size
 	if payloadSize > k.MaxPayloadSize(ctx) {
@@ -2626,15 +2775,21 @@ func (k Keeper) UpdateObjectContent(
 	nextVersion := objectInfo.Version + 1
 
 	if payloadSize == 0 {
-		internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
-		err := k.UnChargeObjectStoreFee(ctx, bucketInfo, k.MustGetInternalBucketInfo(ctx, bucketInfo.Id), objectInfo)
-		if err != nil {
-			return err
-		}
-		k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
-		err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
-		if err != nil {
-			return err
+		if bucketInfo.VersioningEnabled {
+			if err := k.retainObjectVersion(ctx, objectInfo); err != nil {
+				return err
+			}
+		} else {
+			internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+			err := k.UnChargeObjectStoreFee(ctx, bucketInfo, k.MustGetInternalBucketInfo(ctx, bucketInfo.Id), objectInfo)
+			if err != nil {
+				return err
+			}
+			k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+			err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
+			if err != nil {
+				return err
+			}
 		}
 		objectInfo.UpdatedAt = ctx.BlockTime().Unix()
 		objectInfo.Version = nextVersion
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	// lifecycleBackfillFactor bounds the existing objects enqueued in each end block to a multiple of the deletion max.
+	lifecycleBackfillFactor = 10
+	// lifecycleRecheckInterval is how long an expired object is postponed when it can not be deleted yet, e.g. it is
+	// locked, its bucket is not in service or the deletion failed.
+	lifecycleRecheckInterval = 86400
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of the bucket, empty rules remove the lifecycle configuration.
+func (k Keeper) SetBucketLifecycle(ctx sdk.Context, operator sdk.AccAddress, bucketName string, rules []types.LifecycleRule) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	if operator.String() != bucketInfo.Owner {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can set lifecycle", bucketInfo.Owner)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(rules) == 0 {
+		store.Delete(types.GetBucketLifecycleKey(bucketInfo.Id))
+		store.Delete(types.GetLifecycleBackfillKey(bucketInfo.Id))
+	} else {
+		store.Set(types.GetBucketLifecycleKey(bucketInfo.Id), k.cdc.MustMarshal(&types.BucketLifecycle{Rules: rules}))
+		// the existing objects are enqueued by the end blocker, the stale queue entries are rescheduled when popped
+		store.Set(types.GetLifecycleBackfillKey(bucketInfo.Id), k.cdc.MustMarshal(&types.LifecycleCursor{BucketId: bucketInfo.Id}))
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketLifecycle{
+		Operator:   operator.String(),
+		BucketName: bucketInfo.BucketName,
+		BucketId:   bucketInfo.Id,
+		Rules:      rules,
+	})
+}
+
+func (k Keeper) GetBucketLifecycle(ctx sdk.Context, bucketId sdkmath.Uint) (*types.BucketLifecycle, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBucketLifecycleKey(bucketId))
+	if bz == nil {
+		return nil, false
+	}
+
+	var lifecycle types.BucketLifecycle
+	k.cdc.MustUnmarshal(bz, &lifecycle)
+	return &lifecycle, true
+}
+
+// scheduleLifecycleExpiration enqueues the objects at the time the lifecycle rules of their bucket expire them.
+// The objects are enqueued when they are created or sealed, an entry made stale by a later update or a rule change
+// is rescheduled when it is popped.
+func (k Keeper) scheduleLifecycleExpiration(ctx sdk.Context, bucketId sdkmath.Uint, objectInfos ...*types.ObjectInfo) {
+	lifecycle, found := k.GetBucketLifecycle(ctx, bucketId)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, objectInfo := range objectInfos {
+		if expireAt, ok := lifecycle.ExpireAt(objectInfo); ok {
+			store.Set(types.GetLifecycleQueueKey(expireAt, objectInfo.Id), []byte{})
+		}
+	}
+}
+
+// ApplyLifecycleRules pops at most maxObjectsToDelete objects due in the lifecycle queue and deletes the expired ones,
+// then enqueues the existing objects of the buckets whose lifecycle rules were set.
+func (k Keeper) ApplyLifecycleRules(ctx sdk.Context, maxObjectsToDelete uint64) (deleted uint64) {
+	deleted = k.processLifecycleQueue(ctx, maxObjectsToDelete)
+	k.backfillLifecycleQueue(ctx, maxObjectsToDelete*lifecycleBackfillFactor)
+	return deleted
+}
+
+func (k Keeper) processLifecycleQueue(ctx sdk.Context, maxToProcess uint64) (deleted uint64) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime().Unix()
+
+	iterator := store.Iterator(types.LifecycleQueuePrefix, types.GetLifecycleQueueKeyPrefix(blockTime+1))
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < maxToProcess; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	prefixLen := len(types.GetLifecycleQueueKeyPrefix(0))
+	for _, key := range keys {
+		store.Delete(key)
+		objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(key[prefixLen:]))
+		if !found {
+			continue
+		}
+		// the bucket info is changed by every deletion, so it is loaded for each object
+		bucketInfo, found := k.GetBucketInfo(ctx, objectInfo.BucketName)
+		if !found {
+			continue
+		}
+		lifecycle, found := k.GetBucketLifecycle(ctx, bucketInfo.Id)
+		if !found {
+			continue
+		}
+		expireAt, ok := lifecycle.ExpireAt(objectInfo)
+		if !ok {
+			continue
+		}
+		if expireAt > blockTime {
+			store.Set(types.GetLifecycleQueueKey(expireAt, objectInfo.Id), []byte{})
+			continue
+		}
+		if bucketInfo.BucketStatus != types.BUCKET_STATUS_CREATED || k.checkObjectLock(ctx, bucketInfo, objectInfo) != nil {
+			store.Set(types.GetLifecycleQueueKey(blockTime+lifecycleRecheckInterval, objectInfo.Id), []byte{})
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		err := k.forceDeleteObject(cacheCtx, sdk.MustAccAddressFromHex(bucketInfo.Owner), bucketInfo, objectInfo, objectInfo.ObjectStatus)
+		if err != nil {
+			ctx.Logger().Error("apply lifecycle rules error", "err", err, "id", objectInfo.Id, "height", ctx.BlockHeight())
+			store.Set(types.GetLifecycleQueueKey(blockTime+lifecycleRecheckInterval, objectInfo.Id), []byte{})
+			continue
+		}
+		write()
+		deleted++
+	}
+	return deleted
+}
+
+// backfillLifecycleQueue enqueues at most maxScanned existing objects of the buckets whose lifecycle rules were set,
+// a bucket is removed from the backfill once all its objects are enqueued.
+func (k Keeper) backfillLifecycleQueue(ctx sdk.Context, maxScanned uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	var cursors []*types.LifecycleCursor
+	iterator := store.Iterator(types.LifecycleBackfillPrefix, storetypes.PrefixEndBytes(types.LifecycleBackfillPrefix))
+	for ; iterator.Valid(); iterator.Next() {
+		var cursor types.LifecycleCursor
+		k.cdc.MustUnmarshal(iterator.Value(), &cursor)
+		cursors = append(cursors, &cursor)
+	}
+	iterator.Close()
+
+	scanned := uint64(0)
+	for _, cursor := range cursors {
+		if scanned >= maxScanned {
+			return
+		}
+		bucketInfo, found := k.GetBucketInfoById(ctx, cursor.BucketId)
+		if !found {
+			store.Delete(types.GetLifecycleBackfillKey(cursor.BucketId))
+			continue
+		}
+
+		objectPrefix := types.GetObjectKeyOnlyBucketPrefix(bucketInfo.BucketName)
+		objectStart := objectPrefix
+		if len(cursor.ObjectKey) > 0 {
+			// start right after the last enqueued object
+			objectStart = append(append([]byte{}, cursor.ObjectKey...), 0x00)
+		}
+		var objectInfos []*types.ObjectInfo
+		var lastKey []byte
+		done := true
+		objectIterator := store.Iterator(objectStart, storetypes.PrefixEndBytes(objectPrefix))
+		for ; objectIterator.Valid(); objectIterator.Next() {
+			if scanned >= maxScanned {
+				done = false
+				break
+			}
+			scanned++
+			lastKey = objectIterator.Key()
+			if objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(objectIterator.Value())); found {
+				objectInfos = append(objectInfos, objectInfo)
+			}
+		}
+		objectIterator.Close()
+
+		k.scheduleLifecycleExpiration(ctx, bucketInfo.Id, objectInfos...)
+		if done {
+			store.Delete(types.GetLifecycleBackfillKey(bucketInfo.Id))
+		} else {
+			cursor.ObjectKey = lastKey
+			store.Set(types.GetLifecycleBackfillKey(bucketInfo.Id), k.cdc.MustMarshal(cursor))
+		}
+	}
+}
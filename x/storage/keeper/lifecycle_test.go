@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestSetBucketLifecycle() {
+	owner := sample.RandAccAddress()
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "bucketname",
+		Id:         sdk.NewUint(1),
+	}
+	rules := []types.LifecycleRule{{Id: "expire-logs", Prefix: "logs/", ExpirationDays: 30}}
+
+	_, err := s.msgServer.SetBucketLifecycle(s.ctx, types.NewMsgSetBucketLifecycle(owner, bucketInfo.BucketName, rules))
+	s.Require().ErrorIs(err, types.ErrNoSuchBucket)
+
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	_, err = s.msgServer.SetBucketLifecycle(s.ctx, types.NewMsgSetBucketLifecycle(sample.RandAccAddress(), bucketInfo.BucketName, rules))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+
+	_, err = s.msgServer.SetBucketLifecycle(s.ctx, types.NewMsgSetBucketLifecycle(owner, bucketInfo.BucketName, rules))
+	s.Require().NoError(err)
+	res, err := s.queryClient.BucketLifecycle(s.ctx, &types.QueryBucketLifecycleRequest{BucketName: bucketInfo.BucketName})
+	s.Require().NoError(err)
+	s.Require().Equal(rules, res.Rules)
+
+	// empty rules remove the lifecycle configuration
+	_, err = s.msgServer.SetBucketLifecycle(s.ctx, types.NewMsgSetBucketLifecycle(owner, bucketInfo.BucketName, nil))
+	s.Require().NoError(err)
+	_, found := s.storageKeeper.GetBucketLifecycle(s.ctx, bucketInfo.Id)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestApplyLifecycleRules() {
+	const mb = 1024 * 1024
+	const day = 86400
+	owner := sample.RandAccAddress()
+	createdAt := s.ctx.BlockTime().Unix() + 1
+	// move the block time out of the reserve time, so that no early deletion fee is charged
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().AddDate(1, 0, 0))
+	now := s.ctx.BlockTime().Unix()
+
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 1, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	gvg := &vgtypes.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3},
+		StoredSize: 3 * mb, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gvgFamily.Id).Return(gvgFamily, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).Return(gvg, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SetGVGAndEmitUpdateEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(1),
+		PrimaryStorePrice:   sdk.NewDec(2),
+		SecondaryStorePrice: sdk.NewDec(1),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().UpdateStreamRecordByAddr(gomock.Any(), gomock.Any()).
+		Return(&paymenttypes.StreamRecord{}, nil).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{
+		PriceTime:       createdAt,
+		TotalChargeSize: 3 * mb,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, GlobalVirtualGroupId: gvg.Id, StoredSize: 3 * mb, TotalChargeSize: 3 * mb},
+		},
+	})
+
+	objects := []*types.ObjectInfo{
+		{ObjectName: "logs/old", ObjectStatus: types.OBJECT_STATUS_SEALED, LocalVirtualGroupId: 1, CreateAt: createdAt},
+		{ObjectName: "logs/new", ObjectStatus: types.OBJECT_STATUS_SEALED, LocalVirtualGroupId: 1, CreateAt: now - day/2},
+		{ObjectName: "data/old", ObjectStatus: types.OBJECT_STATUS_SEALED, LocalVirtualGroupId: 1, CreateAt: createdAt},
+		{ObjectName: "upload/old", ObjectStatus: types.OBJECT_STATUS_CREATED, CreateAt: now - 2*3600},
+		{ObjectName: "upload/new", ObjectStatus: types.OBJECT_STATUS_CREATED, CreateAt: now - 1800},
+	}
+	for i, objectInfo := range objects {
+		objectInfo.Owner = owner.String()
+		objectInfo.BucketName = bucketInfo.BucketName
+		objectInfo.Id = sdkmath.NewUint(uint64(i + 1))
+		objectInfo.PayloadSize = mb
+		s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+	}
+
+	s.Require().NoError(s.storageKeeper.SetBucketLifecycle(s.ctx, owner, bucketInfo.BucketName, []types.LifecycleRule{
+		{Id: "expire-logs", Prefix: "logs/", ExpirationDays: 1},
+		{Id: "abort-uploads", AbortIncompleteUploadHours: 1},
+	}))
+
+	// the existing objects are enqueued by the first round, at most one object is deleted in each round
+	deleted := uint64(0)
+	for i := 0; i < 3; i++ {
+		n := s.storageKeeper.ApplyLifecycleRules(s.ctx, 1)
+		s.Require().LessOrEqual(n, uint64(1))
+		deleted += n
+	}
+	s.Require().Equal(uint64(2), deleted)
+
+	for _, objectInfo := range objects {
+		_, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketInfo.BucketName, objectInfo.ObjectName)
+		expired := objectInfo.ObjectName == "logs/old" || objectInfo.ObjectName == "upload/old"
+		s.Require().Equal(!expired, found, objectInfo.ObjectName)
+	}
+	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(2*mb), internalBucketInfo.TotalChargeSize)
+
+	// nothing left to delete
+	s.Require().Equal(uint64(0), s.storageKeeper.ApplyLifecycleRules(s.ctx, 10))
+
+	// the enqueued objects are deleted once they expire
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(day * time.Second))
+	s.Require().Equal(uint64(2), s.storageKeeper.ApplyLifecycleRules(s.ctx, 10))
+	_, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketInfo.BucketName, "data/old")
+	s.Require().True(found)
+	internalBucketInfo = s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(mb), internalBucketInfo.TotalChargeSize)
+}
@@ -389,6 +389,9 @@ func (k msgServer) PutPolicy(goCtx context.Context, msg *types.MsgPutPolicy) (*t
 		if s.ExpirationTime != nil && s.ExpirationTime.Before(ctx.BlockTime()) {
 			return nil, permtypes.ErrPermissionExpired.Wrapf("The specified statement expiration time is less than the current block time, block time: %s", ctx.BlockTime().String())
 		}
+		if s.Condition != nil && s.Condition.NotAfter != nil && s.Condition.NotAfter.Before(ctx.BlockTime()) {
+			return nil, permtypes.ErrPermissionExpired.Wrapf("The specified statement condition not_after is less than the current block time, block time: %s", ctx.BlockTime().String())
+		}
 	}
 
 	policy := &permtypes.Policy{
@@ -854,3 +857,162 @@ func (k msgServer) SetBucketFlowRateLimit(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBucketFlowRateLimitResponse{}, nil
 }
+
+func (k msgServer) SetBucketVersioning(goCtx context.Context, msg *types.MsgSetBucketVersioning) (*types.MsgSetBucketVersioningResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketVersioning(ctx, operatorAcc, msg.BucketName, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetBucketVersioningResponse{}, nil
+}
+
+func (k msgServer) DeleteObjectVersion(goCtx context.Context, msg *types.MsgDeleteObjectVersion) (*types.MsgDeleteObjectVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.DeleteObjectVersion(ctx, operatorAcc, msg.BucketName, msg.ObjectName, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDeleteObjectVersionResponse{}, nil
+}
+
+func (k msgServer) SetBucketLifecycle(goCtx context.Context, msg *types.MsgSetBucketLifecycle) (*types.MsgSetBucketLifecycleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketLifecycle(ctx, operatorAcc, msg.BucketName, msg.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetBucketLifecycleResponse{}, nil
+}
+
+func (k msgServer) SetRetention(goCtx context.Context, msg *types.MsgSetRetention) (*types.MsgSetRetentionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetRetention(ctx, operatorAcc, msg.BucketName, msg.ObjectName, msg.Retention)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRetentionResponse{}, nil
+}
+
+func (k msgServer) SetLegalHold(goCtx context.Context, msg *types.MsgSetLegalHold) (*types.MsgSetLegalHoldResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetLegalHold(ctx, operatorAcc, msg.BucketName, msg.ObjectName, msg.LegalHold)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetLegalHoldResponse{}, nil
+}
+
+func (k msgServer) ComposeObject(goCtx context.Context, msg *types.MsgComposeObject) (*types.MsgComposeObjectResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	id, err := k.Keeper.ComposeObject(ctx, operatorAcc, msg.BucketName, msg.ObjectName, msg.SourceObjectNames,
+		msg.ContentType, msg.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgComposeObjectResponse{
+		ObjectId: id,
+	}, nil
+}
+
+func (k msgServer) BatchCreateObjects(goCtx context.Context, msg *types.MsgBatchCreateObjects) (*types.MsgBatchCreateObjectsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAcc := sdk.MustAccAddressFromHex(msg.Creator)
+
+	expectChecksumNum := int(1 + k.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix()))
+	for _, object := range msg.Objects {
+		if len(object.ExpectChecksums) != expectChecksumNum {
+			return nil, gnfderrors.ErrInvalidChecksum.Wrapf("ExpectChecksums missing, object: %s, expect: %d, actual: %d",
+				object.ObjectName, expectChecksumNum, len(object.ExpectChecksums))
+		}
+	}
+
+	ids, err := k.Keeper.BatchCreateObjects(ctx, creatorAcc, msg.BucketName, msg.Objects)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgBatchCreateObjectsResponse{
+		ObjectIds: ids,
+	}, nil
+}
+
+func (k msgServer) BatchDeleteObjects(goCtx context.Context, msg *types.MsgBatchDeleteObjects) (*types.MsgBatchDeleteObjectsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.BatchDeleteObjects(ctx, operatorAcc, msg.BucketName, msg.ObjectNames)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgBatchDeleteObjectsResponse{}, nil
+}
+
+func (k msgServer) MintReadVoucher(goCtx context.Context, msg *types.MsgMintReadVoucher) (*types.MsgMintReadVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+	holderAcc := sdk.MustAccAddressFromHex(msg.Holder)
+
+	id, err := k.Keeper.MintReadVoucher(ctx, operatorAcc, msg.BucketName, holderAcc, msg.ReadQuota, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgMintReadVoucherResponse{Id: id}, nil
+}
+
+func (k msgServer) RedeemReadVoucher(goCtx context.Context, msg *types.MsgRedeemReadVoucher) (*types.MsgRedeemReadVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.RedeemReadVoucher(ctx, operatorAcc, msg.VoucherId, msg.ReadBytes, msg.Nonce,
+		msg.GetHolderSignBytes(ctx.ChainID()), msg.HolderSignature)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRedeemReadVoucherResponse{}, nil
+}
+
+func (k msgServer) SetBucketRequesterPays(goCtx context.Context, msg *types.MsgSetBucketRequesterPays) (*types.MsgSetBucketRequesterPaysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketRequesterPays(ctx, operatorAcc, msg.BucketName, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetBucketRequesterPaysResponse{}, nil
+}
+
+func (k msgServer) PurchaseReaderQuota(goCtx context.Context, msg *types.MsgPurchaseReaderQuota) (*types.MsgPurchaseReaderQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	readerAcc := sdk.MustAccAddressFromHex(msg.Reader)
+
+	err := k.Keeper.PurchaseReaderQuota(ctx, readerAcc, msg.BucketName, msg.PaymentAddress, msg.ReadQuota)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgPurchaseReaderQuotaResponse{}, nil
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// SetRetention sets the retention of the bucket, or of the object if the object name is not empty.
+// An active compliance retention can only be extended.
+func (k Keeper) SetRetention(ctx sdk.Context, operator sdk.AccAddress, bucketName, objectName string, retention types.Retention) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	if operator.String() != bucketInfo.Owner {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can set retention", bucketInfo.Owner)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if retention.Mode != types.RETENTION_MODE_NONE && retention.RetainUntil <= blockTime {
+		return gnfderrors.ErrInvalidParameter.Wrapf("retain until(%d) should be later than the block time(%d)",
+			retention.RetainUntil, blockTime)
+	}
+	var newRetention *types.Retention
+	if retention.Mode != types.RETENTION_MODE_NONE {
+		newRetention = &retention
+	}
+
+	if objectName == "" {
+		if !bucketInfo.Retention.CanBeReplacedBy(retention, blockTime) {
+			return types.ErrBucketLocked.Wrapf("the compliance retention of bucket(%s) can only be extended", bucketName)
+		}
+		bucketInfo.Retention = newRetention
+		k.SetBucketInfo(ctx, bucketInfo)
+	} else {
+		objectInfo, found := k.GetObjectInfo(ctx, bucketName, objectName)
+		if !found {
+			return types.ErrNoSuchObject
+		}
+		if objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+			return types.ErrObjectNotSealed
+		}
+		if !objectInfo.Retention.CanBeReplacedBy(retention, blockTime) {
+			return types.ErrObjectLocked.Wrapf("the compliance retention of object(%s) can only be extended", objectName)
+		}
+		objectInfo.Retention = newRetention
+		k.SetObjectInfo(ctx, objectInfo)
+
+		if newRetention != nil {
+			objectLocks := k.getObjectLocks(ctx, bucketInfo.Id)
+			if newRetention.RetainUntil > objectLocks.RetainUntil {
+				objectLocks.RetainUntil = newRetention.RetainUntil
+				k.setObjectLocks(ctx, bucketInfo.Id, objectLocks)
+			}
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetRetention{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		Retention:  retention,
+	})
+}
+
+// SetLegalHold places or releases the legal hold of the bucket, or of the object if the object name is not empty.
+func (k Keeper) SetLegalHold(ctx sdk.Context, operator sdk.AccAddress, bucketName, objectName string, legalHold bool) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	if operator.String() != bucketInfo.Owner {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can set legal hold", bucketInfo.Owner)
+	}
+
+	if objectName == "" {
+		bucketInfo.LegalHold = legalHold
+		k.SetBucketInfo(ctx, bucketInfo)
+	} else {
+		objectInfo, found := k.GetObjectInfo(ctx, bucketName, objectName)
+		if !found {
+			return types.ErrNoSuchObject
+		}
+		if objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+			return types.ErrObjectNotSealed
+		}
+		if objectInfo.LegalHold != legalHold {
+			objectLocks := k.getObjectLocks(ctx, bucketInfo.Id)
+			if legalHold {
+				objectLocks.LegalHoldCount++
+			} else {
+				objectLocks.LegalHoldCount--
+			}
+			k.setObjectLocks(ctx, bucketInfo.Id, objectLocks)
+		}
+		objectInfo.LegalHold = legalHold
+		k.SetObjectInfo(ctx, objectInfo)
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetLegalHold{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		LegalHold:  legalHold,
+	})
+}
+
+// checkBucketLock returns an error if the bucket is protected by an active retention or a legal hold.
+func (k Keeper) checkBucketLock(ctx sdk.Context, bucketInfo *types.BucketInfo) error {
+	if bucketInfo.IsLocked(ctx.BlockTime().Unix()) {
+		return types.ErrBucketLocked.Wrapf("bucket: %s", bucketInfo.BucketName)
+	}
+	return nil
+}
+
+// checkObjectLock returns an error if the sealed content of the object is protected by an active retention
+// or a legal hold, either of the object itself or of its bucket.
+func (k Keeper) checkObjectLock(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) error {
+	if objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+		return nil
+	}
+	blockTime := ctx.BlockTime().Unix()
+	if bucketInfo.IsLocked(blockTime) {
+		return types.ErrObjectLocked.Wrapf("object(%s) is protected by its bucket(%s)", objectInfo.ObjectName, bucketInfo.BucketName)
+	}
+	if objectInfo.IsLocked(blockTime) {
+		return types.ErrObjectLocked.Wrapf("object: %s", objectInfo.ObjectName)
+	}
+	return nil
+}
+
+// hasLockedObjects returns whether any object in the bucket may be protected by a retention or a legal hold.
+// It is conservative, a retention shortened or removed before it expires still counts until its original time.
+func (k Keeper) hasLockedObjects(ctx sdk.Context, bucketId sdkmath.Uint) bool {
+	return k.getObjectLocks(ctx, bucketId).IsActive(ctx.BlockTime().Unix())
+}
+
+func (k Keeper) getObjectLocks(ctx sdk.Context, bucketId sdkmath.Uint) *types.ObjectLocks {
+	var objectLocks types.ObjectLocks
+	bz := ctx.KVStore(k.storeKey).Get(types.GetObjectLocksKey(bucketId))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &objectLocks)
+	}
+	return &objectLocks
+}
+
+func (k Keeper) setObjectLocks(ctx sdk.Context, bucketId sdkmath.Uint, objectLocks *types.ObjectLocks) {
+	ctx.KVStore(k.storeKey).Set(types.GetObjectLocksKey(bucketId), k.cdc.MustMarshal(objectLocks))
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestObjectLock() {
+	owner := sample.RandAccAddress()
+	now := s.ctx.BlockTime().Unix()
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "bucketname",
+		Id:         sdk.NewUint(1),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	objectInfo := &types.ObjectInfo{
+		Owner:        owner.String(),
+		BucketName:   bucketInfo.BucketName,
+		ObjectName:   "object",
+		Id:           sdkmath.NewUint(1),
+		ObjectStatus: types.OBJECT_STATUS_SEALED,
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+
+	compliance := types.Retention{Mode: types.RETENTION_MODE_COMPLIANCE, RetainUntil: now + 100}
+	_, err := s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(sample.RandAccAddress(), bucketInfo.BucketName, objectInfo.ObjectName, compliance))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, objectInfo.ObjectName,
+		types.Retention{Mode: types.RETENTION_MODE_COMPLIANCE, RetainUntil: now}))
+	s.Require().Error(err)
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, objectInfo.ObjectName, compliance))
+	s.Require().NoError(err)
+
+	// the compliance retention can only be extended
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, objectInfo.ObjectName,
+		types.Retention{Mode: types.RETENTION_MODE_GOVERNANCE, RetainUntil: now + 200}))
+	s.Require().ErrorIs(err, types.ErrObjectLocked)
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, objectInfo.ObjectName,
+		types.Retention{Mode: types.RETENTION_MODE_COMPLIANCE, RetainUntil: now + 50}))
+	s.Require().ErrorIs(err, types.ErrObjectLocked)
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, objectInfo.ObjectName, types.Retention{}))
+	s.Require().ErrorIs(err, types.ErrObjectLocked)
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, objectInfo.ObjectName,
+		types.Retention{Mode: types.RETENTION_MODE_COMPLIANCE, RetainUntil: now + 200}))
+	s.Require().NoError(err)
+
+	objectInfo, _ = s.storageKeeper.GetObjectInfo(s.ctx, bucketInfo.BucketName, objectInfo.ObjectName)
+	s.Require().Equal(now+200, objectInfo.Retention.RetainUntil)
+
+	err = s.storageKeeper.DeleteObject(s.ctx, owner, bucketInfo.BucketName, objectInfo.ObjectName, types.DeleteObjectOptions{})
+	s.Require().ErrorIs(err, types.ErrObjectLocked)
+
+	// the retention expires, but the legal hold still protects the object
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(300 * 1e9))
+	_, err = s.msgServer.SetLegalHold(s.ctx, types.NewMsgSetLegalHold(owner, bucketInfo.BucketName, objectInfo.ObjectName, true))
+	s.Require().NoError(err)
+	err = s.storageKeeper.DeleteObject(s.ctx, owner, bucketInfo.BucketName, objectInfo.ObjectName, types.DeleteObjectOptions{})
+	s.Require().ErrorIs(err, types.ErrObjectLocked)
+	_, err = s.msgServer.SetLegalHold(s.ctx, types.NewMsgSetLegalHold(owner, bucketInfo.BucketName, objectInfo.ObjectName, false))
+	s.Require().NoError(err)
+
+	// the bucket retention protects the bucket and its objects
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, "",
+		types.Retention{Mode: types.RETENTION_MODE_GOVERNANCE, RetainUntil: s.ctx.BlockTime().Unix() + 100}))
+	s.Require().NoError(err)
+	err = s.storageKeeper.DeleteObject(s.ctx, owner, bucketInfo.BucketName, objectInfo.ObjectName, types.DeleteObjectOptions{})
+	s.Require().ErrorIs(err, types.ErrObjectLocked)
+	err = s.storageKeeper.DeleteBucket(s.ctx, owner, bucketInfo.BucketName, types.DeleteBucketOptions{})
+	s.Require().ErrorIs(err, types.ErrBucketLocked)
+
+	// the governance retention can be removed by the owner
+	_, err = s.msgServer.SetRetention(s.ctx, types.NewMsgSetRetention(owner, bucketInfo.BucketName, "", types.Retention{}))
+	s.Require().NoError(err)
+	bucketInfo, _ = s.storageKeeper.GetBucketInfo(s.ctx, bucketInfo.BucketName)
+	s.Require().Nil(bucketInfo.Retention)
+	s.Require().False(bucketInfo.IsLocked(s.ctx.BlockTime().Unix()))
+}
+
+func (s *TestSuite) TestDiscontinueBucketWithLockedObjects() {
+	owner := sample.RandAccAddress()
+	spGcAddr := sample.RandAccAddress()
+	sp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE, GcAddress: spGcAddr.String()}
+	s.spKeeper.EXPECT().GetStorageProviderByGcAddr(gomock.Any(), spGcAddr).Return(sp, true).AnyTimes()
+	s.spKeeper.EXPECT().MustGetStorageProvider(gomock.Any(), sp.Id).Return(sp).AnyTimes()
+	s.spKeeper.EXPECT().RecordDiscontinue(gomock.Any(), sp.Id).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), uint32(1)).
+		Return(&vgtypes.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: sp.Id}, true).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		GlobalVirtualGroupFamilyId: 1,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	objectInfo := &types.ObjectInfo{
+		Owner:        owner.String(),
+		BucketName:   bucketInfo.BucketName,
+		ObjectName:   "object",
+		Id:           sdkmath.NewUint(1),
+		ObjectStatus: types.OBJECT_STATUS_SEALED,
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+
+	_, err := s.msgServer.SetLegalHold(s.ctx, types.NewMsgSetLegalHold(owner, bucketInfo.BucketName, objectInfo.ObjectName, true))
+	s.Require().NoError(err)
+	err = s.storageKeeper.DiscontinueBucket(s.ctx, spGcAddr, bucketInfo.BucketName, "test")
+	s.Require().ErrorIs(err, types.ErrBucketLocked)
+
+	_, err = s.msgServer.SetLegalHold(s.ctx, types.NewMsgSetLegalHold(owner, bucketInfo.BucketName, objectInfo.ObjectName, false))
+	s.Require().NoError(err)
+	err = s.storageKeeper.DiscontinueBucket(s.ctx, spGcAddr, bucketInfo.BucketName, "test")
+	s.Require().NoError(err)
+}
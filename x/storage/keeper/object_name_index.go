@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// The objects are stored under the hash of their names, so they cannot be ranged by name. The object name index keeps
+// a second key for every object, made of the bucket id and the plain object name, whose value is only the object id.
+// Rekeying the object infos themselves would change the keys every existing reader of the store relies on, while the
+// index costs one small entry per object and lets a prefix or start after listing read only the objects it returns
+// instead of scanning the whole bucket.
+
+const (
+	// objectNameIndexBackfillMax bounds the existing objects added to the object name index in each end block.
+	objectNameIndexBackfillMax = 1000
+)
+
+func (k Keeper) setObjectNameIndex(ctx sdk.Context, bucketId sdkmath.Uint, objectName string, objectId sdkmath.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetObjectNameIndexKey(bucketId, objectName), k.objectSeq.EncodeSequence(objectId))
+}
+
+func (k Keeper) deleteObjectNameIndex(ctx sdk.Context, bucketId sdkmath.Uint, objectName string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetObjectNameIndexKey(bucketId, objectName))
+}
+
+// listObjectsByName lists the objects of the bucket in the order of their names from the object name index. Only the
+// range of the prefix after start after or the pagination key is iterated, and the objects under a common prefix are
+// skipped over, so a common prefix is listed once across the pages.
+func (k Keeper) listObjectsByName(ctx sdk.Context, bucketId sdkmath.Uint, objectPrefix, delimiter, startAfter string,
+	pageKey []byte, limit uint64,
+) (objectInfos []*types.ObjectInfo, commonPrefixes []string, nextKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectNameIndexBucketPrefix(bucketId))
+
+	start := []byte(objectPrefix)
+	if startAfter >= objectPrefix {
+		start = append([]byte(startAfter), 0x00)
+	}
+	if string(pageKey) > string(start) {
+		start = pageKey
+	}
+	end := storetypes.PrefixEndBytes([]byte(objectPrefix))
+
+	count := uint64(0)
+	for start != nil {
+		iterator := store.Iterator(start, end)
+		start = nil
+		for ; iterator.Valid(); iterator.Next() {
+			if count == limit {
+				nextKey = iterator.Key()
+				break
+			}
+			objectName := string(iterator.Key())
+			if commonPrefix, ok := objectCommonPrefix(objectName, objectPrefix, delimiter); ok {
+				if commonPrefix > startAfter {
+					commonPrefixes = append(commonPrefixes, commonPrefix)
+					count++
+				}
+				// continue after the objects under the common prefix
+				start = storetypes.PrefixEndBytes([]byte(commonPrefix))
+				break
+			}
+			if objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(iterator.Value())); found {
+				objectInfos = append(objectInfos, objectInfo)
+				count++
+			}
+		}
+		iterator.Close()
+	}
+	return objectInfos, commonPrefixes, nextKey
+}
+
+// StartObjectNameIndexBackfill starts to index the existing objects by name in the end blocks, the objects created
+// later are indexed when they are stored.
+func (k Keeper) StartObjectNameIndexBackfill(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ObjectNameIndexBackfillKey, []byte{})
+}
+
+// isObjectNameIndexBackfilling returns whether some existing objects may not be in the object name index yet.
+func (k Keeper) isObjectNameIndexBackfilling(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ObjectNameIndexBackfillKey)
+}
+
+// BackfillObjectNameIndex indexes at most maxScanned existing objects by name in the order of their ids, from the
+// object after the cursor. The cursor is removed once all the objects are indexed.
+func (k Keeper) BackfillObjectNameIndex(ctx sdk.Context, maxScanned uint64) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.ObjectNameIndexBackfillKey)
+	if cursor == nil {
+		return
+	}
+
+	start := types.ObjectByIDPrefix
+	if len(cursor) > 0 {
+		// start right after the last indexed object
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+	var objectInfos []*types.ObjectInfo
+	scanned := uint64(0)
+	done := true
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(types.ObjectByIDPrefix))
+	for ; iterator.Valid(); iterator.Next() {
+		if scanned >= maxScanned {
+			done = false
+			break
+		}
+		scanned++
+		cursor = iterator.Key()
+
+		var objectInfo types.ObjectInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &objectInfo)
+		objectInfos = append(objectInfos, &objectInfo)
+	}
+	iterator.Close()
+
+	bucketIds := make(map[string]sdkmath.Uint)
+	for _, objectInfo := range objectInfos {
+		bucketId, ok := bucketIds[objectInfo.BucketName]
+		if !ok {
+			bucketInfo, found := k.GetBucketInfo(ctx, objectInfo.BucketName)
+			if !found {
+				continue
+			}
+			bucketId = bucketInfo.Id
+			bucketIds[objectInfo.BucketName] = bucketId
+		}
+		k.setObjectNameIndex(ctx, bucketId, objectInfo.ObjectName, objectInfo.Id)
+	}
+
+	if done {
+		store.Delete(types.ObjectNameIndexBackfillKey)
+	} else {
+		store.Set(types.ObjectNameIndexBackfillKey, cursor)
+	}
+}
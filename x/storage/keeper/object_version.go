@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// SetBucketVersioning enables or disables retaining the previous contents of the updated objects in the bucket.
+// Disabling it does not delete the versions retained already.
+func (k Keeper) SetBucketVersioning(ctx sdk.Context, operator sdk.AccAddress, bucketName string, enabled bool) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if operator.String() != bucketInfo.Owner {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can set versioning", bucketInfo.Owner)
+	}
+	bucketInfo.VersioningEnabled = enabled
+	k.SetBucketInfo(ctx, bucketInfo)
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketVersioning{
+		BucketName:        bucketInfo.BucketName,
+		BucketId:          bucketInfo.Id,
+		VersioningEnabled: bucketInfo.VersioningEnabled,
+	})
+}
+
+func (k Keeper) SetObjectVersion(ctx sdk.Context, objectVersion *types.ObjectVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetObjectVersionKey(objectVersion.ObjectId, objectVersion.Version), k.cdc.MustMarshal(objectVersion))
+}
+
+func (k Keeper) GetObjectVersion(ctx sdk.Context, objectId sdkmath.Uint, version int64) (*types.ObjectVersion, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetObjectVersionKey(objectId, version))
+	if bz == nil {
+		return nil, false
+	}
+
+	var objectVersion types.ObjectVersion
+	k.cdc.MustUnmarshal(bz, &objectVersion)
+	return &objectVersion, true
+}
+
+// GetObjectVersions returns all the retained versions of an object in ascending order of version.
+func (k Keeper) GetObjectVersions(ctx sdk.Context, objectId sdkmath.Uint) []*types.ObjectVersion {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectVersionKeyOnlyObjectPrefix(objectId))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var objectVersions []*types.ObjectVersion
+	for ; iter.Valid(); iter.Next() {
+		var objectVersion types.ObjectVersion
+		k.cdc.MustUnmarshal(iter.Value(), &objectVersion)
+		objectVersions = append(objectVersions, &objectVersion)
+	}
+	return objectVersions
+}
+
+// retainObjectVersion keeps the current sealed content of the object as a version before it is replaced by the
+// new content. The version stays charged and stored on its local virtual group until it is deleted.
+func (k Keeper) retainObjectVersion(ctx sdk.Context, objectInfo *types.ObjectInfo) error {
+	objectVersion := types.NewObjectVersion(objectInfo)
+	k.SetObjectVersion(ctx, objectVersion)
+	return ctx.EventManager().EmitTypedEvents(&types.EventCreateObjectVersion{
+		BucketName:          objectVersion.BucketName,
+		ObjectName:          objectVersion.ObjectName,
+		ObjectId:            objectVersion.ObjectId,
+		Version:             objectVersion.Version,
+		LocalVirtualGroupId: objectVersion.LocalVirtualGroupId,
+		PayloadSize:         objectVersion.PayloadSize,
+	})
+}
+
+// DeleteObjectVersion deletes a retained version of an object, the operator should have the DeleteObject permission of the object.
+func (k Keeper) DeleteObjectVersion(ctx sdk.Context, operator sdk.AccAddress, bucketName, objectName string, version int64) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+
+	objectInfo, found := k.GetObjectInfo(ctx, bucketName, objectName)
+	if !found {
+		return types.ErrNoSuchObject
+	}
+
+	if objectInfo.ObjectStatus == types.OBJECT_STATUS_DISCONTINUED {
+		return types.ErrInvalidObjectStatus.Wrapf("The object %s is discontined, will be deleted automatically",
+			objectInfo.ObjectName)
+	}
+
+	effect := k.VerifyObjectPermission(ctx, bucketInfo, objectInfo, operator, permtypes.ACTION_DELETE_OBJECT)
+	if effect != permtypes.EFFECT_ALLOW {
+		return types.ErrAccessDenied.Wrapf(
+			"The operator(%s) has no DeleteObject permission of the bucket(%s), object(%s)",
+			operator.String(), bucketName, objectName)
+	}
+	if err := k.checkObjectLock(ctx, bucketInfo, objectInfo); err != nil {
+		return err
+	}
+
+	objectVersion, found := k.GetObjectVersion(ctx, objectInfo.Id, version)
+	if !found {
+		return types.ErrNoSuchObjectVersion.Wrapf("object: %s, version: %d", objectName, version)
+	}
+
+	return k.doDeleteObjectVersion(ctx, operator, bucketInfo, objectInfo, objectVersion)
+}
+
+// deleteObjectVersions deletes all the retained versions of an object, it is called when the object is deleted.
+func (k Keeper) deleteObjectVersions(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) error {
+	for _, objectVersion := range k.GetObjectVersions(ctx, objectInfo.Id) {
+		if err := k.doDeleteObjectVersion(ctx, operator, bucketInfo, objectInfo, objectVersion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) doDeleteObjectVersion(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo,
+	objectInfo *types.ObjectInfo, objectVersion *types.ObjectVersion) error {
+	versionInfo := objectVersion.ToObjectInfo(objectInfo)
+
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, versionInfo)
+	if err != nil {
+		return err
+	}
+	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+
+	err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, versionInfo)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetObjectVersionKey(objectVersion.ObjectId, objectVersion.Version))
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventDeleteObjectVersion{
+		Operator:            operator.String(),
+		BucketName:          bucketInfo.BucketName,
+		ObjectName:          objectInfo.ObjectName,
+		ObjectId:            objectInfo.Id,
+		Version:             objectVersion.Version,
+		LocalVirtualGroupId: objectVersion.LocalVirtualGroupId,
+	})
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestSetBucketVersioning() {
+	owner := sample.RandAccAddress()
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "bucketname",
+		Id:         sdk.NewUint(1),
+	}
+
+	_, err := s.msgServer.SetBucketVersioning(s.ctx, types.NewMsgSetBucketVersioning(owner, bucketInfo.BucketName, true))
+	s.Require().ErrorIs(err, types.ErrNoSuchBucket)
+
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	_, err = s.msgServer.SetBucketVersioning(s.ctx, types.NewMsgSetBucketVersioning(sample.RandAccAddress(), bucketInfo.BucketName, true))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+
+	_, err = s.msgServer.SetBucketVersioning(s.ctx, types.NewMsgSetBucketVersioning(owner, bucketInfo.BucketName, true))
+	s.Require().NoError(err)
+	bucketInfo, _ = s.storageKeeper.GetBucketInfo(s.ctx, bucketInfo.BucketName)
+	s.Require().True(bucketInfo.VersioningEnabled)
+
+	_, err = s.msgServer.SetBucketVersioning(s.ctx, types.NewMsgSetBucketVersioning(owner, bucketInfo.BucketName, false))
+	s.Require().NoError(err)
+	bucketInfo, _ = s.storageKeeper.GetBucketInfo(s.ctx, bucketInfo.BucketName)
+	s.Require().False(bucketInfo.VersioningEnabled)
+}
+
+func (s *TestSuite) TestObjectVersions() {
+	const mb = 1024 * 1024
+	owner := sample.RandAccAddress()
+	updatedAt := s.ctx.BlockTime().Unix() + 1
+	// move the block time out of the reserve time, so that no early deletion fee is charged
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().AddDate(1, 0, 0))
+
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 1, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	gvg := &vgtypes.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3},
+		StoredSize: 6 * mb, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gvgFamily.Id).Return(gvgFamily, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).Return(gvg, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SetGVGAndEmitUpdateEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(1),
+		PrimaryStorePrice:   sdk.NewDec(2),
+		SecondaryStorePrice: sdk.NewDec(1),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return(nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+		VersioningEnabled:          true,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{
+		PriceTime:       updatedAt,
+		TotalChargeSize: 6 * mb,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, GlobalVirtualGroupId: gvg.Id, StoredSize: 6 * mb, TotalChargeSize: 6 * mb},
+		},
+	})
+
+	objectInfo := &types.ObjectInfo{
+		Owner:               owner.String(),
+		BucketName:          bucketInfo.BucketName,
+		ObjectName:          "object",
+		Id:                  sdkmath.NewUint(1),
+		LocalVirtualGroupId: 1,
+		PayloadSize:         3 * mb,
+		ObjectStatus:        types.OBJECT_STATUS_SEALED,
+		CreateAt:            updatedAt,
+		UpdatedAt:           updatedAt,
+		Version:             2,
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+	for i, size := range []uint64{1 * mb, 2 * mb} {
+		version := types.NewObjectVersion(objectInfo)
+		version.Version = int64(i)
+		version.PayloadSize = size
+		s.storageKeeper.SetObjectVersion(s.ctx, version)
+	}
+
+	// query versions
+	listRes, err := s.queryClient.ListObjectVersions(s.ctx, &types.QueryListObjectVersionsRequest{
+		BucketName: bucketInfo.BucketName,
+		ObjectName: objectInfo.ObjectName,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(listRes.ObjectVersions, 2)
+	s.Require().Equal(int64(0), listRes.ObjectVersions[0].Version)
+	s.Require().Equal(int64(1), listRes.ObjectVersions[1].Version)
+
+	headRes, err := s.queryClient.HeadObjectVersion(s.ctx, &types.QueryHeadObjectVersionRequest{
+		BucketName: bucketInfo.BucketName,
+		ObjectName: objectInfo.ObjectName,
+		Version:    1,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2*mb), headRes.ObjectVersion.PayloadSize)
+	s.Require().Equal(gvg.Id, headRes.GlobalVirtualGroup.Id)
+
+	_, err = s.queryClient.HeadObjectVersion(s.ctx, &types.QueryHeadObjectVersionRequest{
+		BucketName: bucketInfo.BucketName,
+		ObjectName: objectInfo.ObjectName,
+		Version:    objectInfo.Version,
+	})
+	s.Require().ErrorContains(err, types.ErrNoSuchObjectVersion.Error())
+
+	// delete a version
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+	_, err = s.msgServer.DeleteObjectVersion(s.ctx, types.NewMsgDeleteObjectVersion(sample.RandAccAddress(), bucketInfo.BucketName, objectInfo.ObjectName, 0))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	_, err = s.msgServer.DeleteObjectVersion(s.ctx, types.NewMsgDeleteObjectVersion(owner, bucketInfo.BucketName, objectInfo.ObjectName, objectInfo.Version))
+	s.Require().ErrorIs(err, types.ErrNoSuchObjectVersion)
+	_, err = s.msgServer.DeleteObjectVersion(s.ctx, types.NewMsgDeleteObjectVersion(owner, bucketInfo.BucketName, objectInfo.ObjectName, 0))
+	s.Require().NoError(err)
+
+	_, found := s.storageKeeper.GetObjectVersion(s.ctx, objectInfo.Id, 0)
+	s.Require().False(found)
+	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(5*mb), internalBucketInfo.TotalChargeSize)
+	s.Require().Equal(uint64(5*mb), internalBucketInfo.MustGetLVG(1).TotalChargeSize)
+	s.Require().Equal(uint64(5*mb), internalBucketInfo.MustGetLVG(1).StoredSize)
+
+	// deleting the object deletes the remaining versions
+	s.permissionKeeper.EXPECT().ExistAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	err = s.storageKeeper.DeleteObject(s.ctx, owner, bucketInfo.BucketName, objectInfo.ObjectName, types.DeleteObjectOptions{})
+	s.Require().NoError(err)
+	s.Require().Empty(s.storageKeeper.GetObjectVersions(s.ctx, objectInfo.Id))
+	internalBucketInfo = s.storageKeeper.MustGetInternalBucketInfo(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(0), internalBucketInfo.TotalChargeSize)
+	s.Require().Empty(internalBucketInfo.LocalVirtualGroups)
+}
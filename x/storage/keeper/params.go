@@ -172,6 +172,26 @@ func (k Keeper) DiscontinueDeletionMax(ctx sdk.Context) (res uint64) {
 	return params.DiscontinueDeletionMax
 }
 
+func (k Keeper) LifecycleDeletionMax(ctx sdk.Context) (res uint64) {
+	params := k.GetParams(ctx)
+	return params.LifecycleDeletionMax
+}
+
+func (k Keeper) MaxBatchSize(ctx sdk.Context) (res uint64) {
+	params := k.GetParams(ctx)
+	return params.MaxBatchSize
+}
+
+func (k Keeper) IsRetentionCompliantSp(ctx sdk.Context, spId uint32) bool {
+	params := k.GetParams(ctx)
+	for _, id := range params.RetentionCompliantSpIds {
+		if id == spId {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) MaxSegmentSize(ctx sdk.Context, timestamp int64) (res uint64, err error) {
 	params, err := k.GetVersionedParamsWithTs(ctx, timestamp)
 	if err != nil {
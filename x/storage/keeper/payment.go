@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
@@ -16,7 +17,8 @@ import (
 
 func (k Keeper) ChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
-	if bucketInfo.ChargedReadQuota == 0 {
+	// the readers of a requester-pays bucket pay for their own read quota
+	if bucketInfo.ChargedReadQuota == 0 || bucketInfo.RequesterPays {
 		return nil
 	}
 	internalBucketInfo.PriceTime = ctx.BlockTime().Unix()
@@ -37,7 +39,7 @@ func (k Keeper) ChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.Bu
 		ctx.Logger().Error("charge initial read fee failed", "bucket", bucketInfo.BucketName, "err", err.Error())
 		return err
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, true)
 }
 
 func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -49,7 +51,7 @@ func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.
 	if ctx.IsUpgraded(upgradetypes.Erdos) {
 		// if the bucket's flow rate limit is set to zero, no need to uncharge, since the bucket is already uncharged
 		if k.IsBucketRateLimited(ctx, bucketInfo.BucketName) {
-			return nil
+			return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, false)
 		}
 	}
 
@@ -66,48 +68,56 @@ func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.
 		ctx.Logger().Error("uncharge bucket read fee failed", "bucket", bucketInfo.BucketName, "err", err.Error())
 		return err
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, false)
 }
 
 func (k Keeper) GetBucketReadBill(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) (userFlows types.UserFlows, err error) {
 	userFlows.From = sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
-	if bucketInfo.ChargedReadQuota == 0 {
+	if bucketInfo.ChargedReadQuota == 0 || bucketInfo.RequesterPays {
 		return userFlows, nil
 	}
-	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	userFlows.Flows, err = k.getReadQuotaFlows(ctx, bucketInfo.GlobalVirtualGroupFamilyId, bucketInfo.ChargedReadQuota,
+		internalBucketInfo.PriceTime)
+	return userFlows, err
+}
+
+// getReadQuotaFlows returns the out flows to the primary sp and the validator tax pool for the read quota at the price time.
+func (k Keeper) getReadQuotaFlows(ctx sdk.Context, gvgFamilyId uint32, readQuota uint64, priceTime int64) ([]types.OutFlow, error) {
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, gvgFamilyId)
 	if !found {
-		return userFlows, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+		return nil, fmt.Errorf("get GVG family failed: %d", gvgFamilyId)
 	}
 
-	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, priceTime)
 	if err != nil {
-		return userFlows, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
+		return nil, fmt.Errorf("get storage price failed: %d %w", priceTime, err)
 	}
 
+	var flows []types.OutFlow
 	// primary sp total rate
-	primaryTotalFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
+	primaryTotalFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(readQuota)).TruncateInt()
 
 	if primaryTotalFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
+		flows = append(flows, types.OutFlow{
 			ToAddress: gvgFamily.VirtualPaymentAddress,
 			Rate:      primaryTotalFlowRate,
 		})
 	}
 
-	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, priceTime)
 	if err != nil {
-		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+		return nil, fmt.Errorf("failed to get validator tax rate: %d %w", priceTime, err)
 	}
 	validatorTaxRate := versionedParams.ValidatorTaxRate.MulInt(primaryTotalFlowRate).TruncateInt()
 	if validatorTaxRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
+		flows = append(flows, types.OutFlow{
 			ToAddress: types.ValidatorTaxPoolAddress.String(),
 			Rate:      validatorTaxRate,
 		})
 	}
 
-	return userFlows, nil
+	return flows, nil
 }
 
 func (k Keeper) UpdateBucketInfoAndCharge(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -132,11 +142,29 @@ func (k Keeper) LockShadowObjectStoreFee(ctx sdk.Context, bucketInfo *storagetyp
 }
 
 func (k Keeper) lockObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, timestamp int64, payloadSize uint64, objectName string) error {
-	paymentAddr := sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
 	amount, rate, err := k.GetObjectLockFee(ctx, timestamp, payloadSize)
 	if err != nil {
 		return fmt.Errorf("get object store fee rate failed: %s %s %w", bucketInfo.BucketName, objectName, err)
 	}
+	return k.lockStoreFee(ctx, bucketInfo, timestamp, amount, rate, objectName)
+}
+
+// lockObjectsStoreFee locks the store fee of the objects created at the same time with a single stream record update.
+func (k Keeper) lockObjectsStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, timestamp int64, objectInfos []*storagetypes.ObjectInfo) error {
+	totalAmount, totalRate := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, objectInfo := range objectInfos {
+		amount, rate, err := k.GetObjectLockFee(ctx, timestamp, objectInfo.PayloadSize)
+		if err != nil {
+			return fmt.Errorf("get object store fee rate failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
+		}
+		totalAmount = totalAmount.Add(amount)
+		totalRate = totalRate.Add(rate)
+	}
+	return k.lockStoreFee(ctx, bucketInfo, timestamp, totalAmount, totalRate, fmt.Sprintf("%d objects", len(objectInfos)))
+}
+
+func (k Keeper) lockStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, timestamp int64, amount, rate sdkmath.Int, objectName string) error {
+	paymentAddr := sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
 	if ctx.IsCheckTx() {
 		_ = ctx.EventManager().EmitTypedEvents(&types.EventFeePreview{
 			Account:        paymentAddr.String(),
@@ -190,6 +218,25 @@ func (k Keeper) UnlockObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.B
 	return nil
 }
 
+// unlockObjectsStoreFee unlocks the store fee of the objects deleted in INIT state with a single stream record update.
+func (k Keeper) unlockObjectsStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, objectInfos []*storagetypes.ObjectInfo) error {
+	totalLockedBalance := sdkmath.ZeroInt()
+	for _, objectInfo := range objectInfos {
+		lockedBalance, _, err := k.GetObjectLockFee(ctx, objectInfo.GetLatestUpdatedTime(), objectInfo.PayloadSize)
+		if err != nil {
+			return fmt.Errorf("get object store fee rate failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
+		}
+		totalLockedBalance = totalLockedBalance.Add(lockedBalance)
+	}
+	paymentAddr := sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
+	change := types.NewDefaultStreamRecordChangeWithAddr(paymentAddr).WithLockBalanceChange(totalLockedBalance.Neg())
+	_, err := k.paymentKeeper.UpdateStreamRecordByAddr(ctx, change)
+	if err != nil {
+		return fmt.Errorf("update stream record failed: %s %w", bucketInfo.BucketName, err)
+	}
+	return nil
+}
+
 // UnlockShadowObjectStoreFee unlock store fee if the object is deleted in INIT state
 func (k Keeper) UnlockShadowObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ShadowObjectInfo) error {
 	lockedBalance, _, err := k.GetObjectLockFee(ctx, objectInfo.GetUpdatedAt(), objectInfo.PayloadSize)
@@ -244,6 +291,9 @@ func (k Keeper) IsPriceChanged(ctx sdk.Context, primarySpId uint32, priceTime in
 		&prePrice, preParams.ValidatorTaxRate, &currentPrice, currentParams.ValidatorTaxRate, nil
 }
 
+// ChargeObjectStoreFee charges the store fee of the sealed content of the object. In a versioning enabled bucket, the
+// previous contents retained as object versions are not uncharged on update, so they are still counted in the charge
+// size of the bucket and its lvgs until the versions are deleted.
 func (k Keeper) ChargeObjectStoreFee(ctx sdk.Context, primarySpId uint32, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfo *storagetypes.ObjectInfo) error {
 	chargeSize, err := k.GetObjectChargeSize(ctx, objectInfo.PayloadSize, objectInfo.GetLatestUpdatedTime())
@@ -278,6 +328,18 @@ func (k Keeper) ChargeObjectStoreFee(ctx sdk.Context, primarySpId uint32, bucket
 
 func (k Keeper) UnChargeObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfo *storagetypes.ObjectInfo) error {
+	if objectInfo.IsComposed {
+		// each component is charged the same way as the source object it was.
+		composedObject := k.MustGetComposedObject(ctx, objectInfo.Id)
+		for _, component := range composedObject.Components {
+			err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, component.ToObjectInfo(objectInfo))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	chargeSize, err := k.GetObjectChargeSize(ctx, objectInfo.PayloadSize, objectInfo.GetLatestUpdatedTime())
 	if err != nil {
 		return fmt.Errorf("get charge size failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
@@ -304,6 +366,122 @@ func (k Keeper) UnChargeObjectStoreFee(ctx sdk.Context, bucketInfo *storagetypes
 	return nil
 }
 
+// UnChargeObjectsStoreFee uncharges the store fee of the sealed objects of a bucket together. The flow changes of all
+// the objects are applied with a single ApplyUserFlowsList call, and the early deletion fees are paid once to each
+// flow receiver.
+func (k Keeper) UnChargeObjectsStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfos []*storagetypes.ObjectInfo) error {
+	var contents []*storagetypes.ObjectInfo
+	for _, objectInfo := range objectInfos {
+		if objectInfo.IsComposed {
+			composedObject := k.MustGetComposedObject(ctx, objectInfo.Id)
+			for _, component := range composedObject.Components {
+				contents = append(contents, component.ToObjectInfo(objectInfo))
+			}
+		} else {
+			contents = append(contents, objectInfo)
+		}
+	}
+	if len(contents) == 0 {
+		return nil
+	}
+
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if !found {
+		return fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+	}
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return fmt.Errorf("failed to get versioned params: %w", err)
+	}
+
+	chargeSizes := make([]uint64, len(contents))
+	var lvgIds []uint32
+	lvgChargeSizes := make(map[uint32]uint64)
+	for i, content := range contents {
+		chargeSize, err := k.GetObjectChargeSize(ctx, content.PayloadSize, content.GetLatestUpdatedTime())
+		if err != nil {
+			return fmt.Errorf("get charge size failed: %s %s %w", bucketInfo.BucketName, content.ObjectName, err)
+		}
+		chargeSizes[i] = chargeSize
+		if _, ok := lvgChargeSizes[content.LocalVirtualGroupId]; !ok {
+			lvgIds = append(lvgIds, content.LocalVirtualGroupId)
+		}
+		lvgChargeSizes[content.LocalVirtualGroupId] += chargeSize
+	}
+
+	userFlows := types.UserFlows{
+		From:  sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress),
+		Flows: make([]types.OutFlow, 0),
+	}
+	gvgs := make(map[uint32]*vgtypes.GlobalVirtualGroup, len(lvgIds))
+	for _, lvgId := range lvgIds {
+		lvg := internalBucketInfo.MustGetLVG(lvgId)
+		gvg, found := k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
+		if !found {
+			return fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
+		}
+		gvgs[lvgId] = gvg
+
+		preOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+		internalBucketInfo.TotalChargeSize = internalBucketInfo.TotalChargeSize - lvgChargeSizes[lvgId]
+		lvg.TotalChargeSize = lvg.TotalChargeSize - lvgChargeSizes[lvgId]
+		newOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+
+		userFlows.Flows = append(userFlows.Flows, getNegFlows(preOutFlows)...)
+		userFlows.Flows = append(userFlows.Flows, newOutFlows...)
+	}
+	err = k.applyObjectUserFlows(ctx, bucketInfo, internalBucketInfo, userFlows, fmt.Sprintf("%d objects", len(objectInfos)))
+	if err != nil {
+		return err
+	}
+
+	// the objects stored less than reserve time pay their own flows for the rest of the reserve time
+	blockTime := ctx.BlockTime().Unix()
+	var toAddresses []string
+	staticBalanceChanges := make(map[string]sdkmath.Int)
+	for i, content := range contents {
+		timeToPay := content.GetLatestUpdatedTime() + int64(versionedParams.ReserveTime) - blockTime
+		if timeToPay <= 0 {
+			continue
+		}
+		lvg := internalBucketInfo.MustGetLVG(content.LocalVirtualGroupId)
+		objectFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvgs[lvg.Id],
+			&storagetypes.LocalVirtualGroup{Id: lvg.Id, GlobalVirtualGroupId: lvg.GlobalVirtualGroupId, TotalChargeSize: chargeSizes[i]})
+		for _, flow := range objectFlows {
+			change, ok := staticBalanceChanges[flow.ToAddress]
+			if !ok {
+				toAddresses = append(toAddresses, flow.ToAddress)
+				change = sdkmath.ZeroInt()
+			}
+			staticBalanceChanges[flow.ToAddress] = change.Add(flow.Rate.Abs().MulRaw(timeToPay))
+		}
+	}
+	if len(toAddresses) == 0 {
+		return nil
+	}
+	totalStaticBalanceChange := sdkmath.ZeroInt()
+	for _, toAddress := range toAddresses {
+		_, err = k.paymentKeeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(
+			sdk.MustAccAddressFromHex(toAddress)).WithStaticBalanceChange(staticBalanceChanges[toAddress]))
+		if err != nil {
+			return fmt.Errorf("pay address %s failed: %s %w", toAddress, bucketInfo.BucketName, err)
+		}
+		totalStaticBalanceChange = totalStaticBalanceChange.Add(staticBalanceChanges[toAddress])
+	}
+	_, err = k.paymentKeeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(
+		sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)).WithStaticBalanceChange(totalStaticBalanceChange.Neg()))
+	forced, _ := ctx.Value(types.ForceUpdateStreamRecordKey).(bool) // force update in end block
+	if !forced && err != nil {
+		return fmt.Errorf("pay for early deletion failed: %s %w", bucketInfo.BucketName, err)
+	}
+	return nil
+}
+
 func (k Keeper) ChargeObjectStoreFeeForEarlyDeletion(ctx sdk.Context, userFlows []types.OutFlow, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo, timeToPay int64) error {
 	totalStaticBalanceChange := sdkmath.NewInt(0)
 	for _, flow := range userFlows {
@@ -382,7 +560,10 @@ func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.
 		}
 	}
 
-	return nil
+	if prevPaymentAccount != bucketInfo.PaymentAddress && ctx.IsUpgraded(gnfdtypes.Gobi) {
+		k.paymentKeeper.SetBucketBill(ctx, &types.BucketBill{PaymentAddress: prevPaymentAccount, BucketId: bucketInfo.Id})
+	}
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, true)
 }
 
 func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -434,6 +615,19 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 	userFlows.Flows = append(userFlows.Flows, getNegFlows(preOutFlows)...)
 	userFlows.Flows = append(userFlows.Flows, newOutFlows...)
 
+	err = k.applyObjectUserFlows(ctx, bucketInfo, internalBucketInfo, userFlows, objectInfo.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	// merge outflows for early deletion usage
+	return k.paymentKeeper.MergeOutFlows(userFlows.Flows), nil
+}
+
+// applyObjectUserFlows applies the flow changes of the bucket payment account caused by the object changes,
+// the flow rate limit of the bucket is checked before applying unless the stream records are force updated.
+func (k Keeper) applyObjectUserFlows(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, userFlows types.UserFlows, objectName string) error {
 	if ctx.IsUpgraded(upgradetypes.Erdos) {
 		var shouldApplyFlowRate = true
 		forced, _ := ctx.Value(types.ForceUpdateStreamRecordKey).(bool)
@@ -447,39 +641,38 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 		} else {
 			isRateLimited := k.IsBucketRateLimited(ctx, bucketInfo.BucketName)
 			if isRateLimited {
-				return nil, fmt.Errorf("bucket is rate limited: %s", bucketInfo.BucketName)
+				return fmt.Errorf("bucket is rate limited: %s", bucketInfo.BucketName)
 			}
 			// we should only check the flow rate limit when is not forced
 			currentBill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
 			if err != nil {
-				return nil, fmt.Errorf("get bucket bill failed: %s %w", bucketInfo.BucketName, err)
+				return fmt.Errorf("get bucket bill failed: %s %w", bucketInfo.BucketName, err)
 			}
 
 			err = k.isBucketFlowRateUnderLimit(ctx, sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress), sdk.MustAccAddressFromHex(bucketInfo.Owner), bucketInfo.BucketName, currentBill)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if shouldApplyFlowRate {
-			err = k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{userFlows})
+			err := k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{userFlows})
 			if err != nil {
 				ctx.Logger().Error("charge object store fee failed", "bucket", bucketInfo.BucketName,
-					"object", objectInfo.ObjectName, "err", err.Error())
-				return nil, err
+					"object", objectName, "err", err.Error())
+				return err
 			}
 		}
 	} else {
-		err = k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{userFlows})
+		err := k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{userFlows})
 		if err != nil {
 			ctx.Logger().Error("charge object store fee failed", "bucket", bucketInfo.BucketName,
-				"object", objectInfo.ObjectName, "err", err.Error())
-			return nil, err
+				"object", objectName, "err", err.Error())
+			return err
 		}
 	}
-
-	// merge outflows for early deletion usage
-	return k.paymentKeeper.MergeOutFlows(userFlows.Flows), nil
+	charged := !ctx.IsUpgraded(upgradetypes.Erdos) || !k.IsBucketRateLimited(ctx, bucketInfo.BucketName)
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, charged)
 }
 
 func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpStorePrice, params types.VersionedParams,
@@ -519,26 +712,36 @@ func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpSto
 func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) (userFlows types.UserFlows, err error) {
 	userFlows.From = sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
+	readFlows, storeFlows, err := k.getBucketReadStoreFlows(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return userFlows, err
+	}
+	userFlows.Flows = append(readFlows, storeFlows...)
+	return userFlows, nil
+}
 
+// getBucketReadStoreFlows returns the read flows and the store flows of the bill of the bucket separately.
+func (k Keeper) getBucketReadStoreFlows(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo) (readFlows, storeFlows []types.OutFlow, err error) {
 	if internalBucketInfo.TotalChargeSize == 0 && bucketInfo.ChargedReadQuota == 0 {
-		return userFlows, nil
+		return nil, nil, nil
 	}
 
 	// calculate read fee & store fee separately, for precision
 	// calculate read fee
 	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
 	if !found {
-		return userFlows, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+		return nil, nil, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
 	}
 
 	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
 	if err != nil {
-		return userFlows, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
+		return nil, nil, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
 	}
 
 	primaryReadFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
 	if primaryReadFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
+		readFlows = append(readFlows, types.OutFlow{
 			ToAddress: gvgFamily.VirtualPaymentAddress,
 			Rate:      primaryReadFlowRate,
 		})
@@ -546,11 +749,11 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 
 	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
 	if err != nil {
-		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+		return nil, nil, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
 	}
 	validatorTaxReadFlowRate := versionedParams.ValidatorTaxRate.MulInt(primaryReadFlowRate).TruncateInt()
 	if validatorTaxReadFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
+		readFlows = append(readFlows, types.OutFlow{
 			ToAddress: types.ValidatorTaxPoolAddress.String(),
 			Rate:      validatorTaxReadFlowRate,
 		})
@@ -562,13 +765,73 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 		//secondary sp
 		gvg, found := k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
 		if !found {
-			return userFlows, fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
+			return nil, nil, fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
 		}
 		outFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
-		userFlows.Flows = append(userFlows.Flows, outFlows...)
+		storeFlows = append(storeFlows, outFlows...)
 	}
 
-	return userFlows, nil
+	return readFlows, storeFlows, nil
+}
+
+// GetBucketBillingFlows returns the flows of the bill of the bucket labeled by category and receiver.
+func (k Keeper) GetBucketBillingFlows(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo) ([]types.BillingFlow, error) {
+	readFlows, storeFlows, err := k.getBucketReadStoreFlows(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(readFlows) == 0 && len(storeFlows) == 0 {
+		return nil, nil
+	}
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if !found {
+		return nil, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+	}
+
+	flows := make([]types.BillingFlow, 0, len(readFlows)+len(storeFlows))
+	tag := func(outFlows []types.OutFlow, category types.BillingCategory) {
+		for _, flow := range outFlows {
+			recipient := types.BILLING_RECIPIENT_GVG
+			switch flow.ToAddress {
+			case gvgFamily.VirtualPaymentAddress:
+				recipient = types.BILLING_RECIPIENT_GVG_FAMILY
+			case types.ValidatorTaxPoolAddress.String():
+				recipient = types.BILLING_RECIPIENT_VALIDATOR_TAX_POOL
+			}
+			flows = append(flows, types.BillingFlow{
+				ToAddress: flow.ToAddress,
+				Rate:      flow.Rate,
+				Category:  category,
+				Recipient: recipient,
+			})
+		}
+	}
+	tag(readFlows, types.BILLING_CATEGORY_READ)
+	tag(storeFlows, types.BILLING_CATEGORY_STORE)
+	return flows, nil
+}
+
+// recordBucketBill records the current bill of the bucket for the billing statements of its payment account,
+// the bill is empty if the bucket is not charged.
+func (k Keeper) recordBucketBill(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, charged bool) error {
+	if !ctx.IsUpgraded(gnfdtypes.Gobi) {
+		return nil
+	}
+	bucketBill := &types.BucketBill{
+		PaymentAddress: bucketInfo.PaymentAddress,
+		BucketId:       bucketInfo.Id,
+	}
+	if charged {
+		flows, err := k.GetBucketBillingFlows(ctx, bucketInfo, internalBucketInfo)
+		if err != nil {
+			return fmt.Errorf("get bucket billing flows failed: %s %w", bucketInfo.BucketName, err)
+		}
+		bucketBill.Flows = flows
+	}
+	k.paymentKeeper.SetBucketBill(ctx, bucketBill)
+	return nil
 }
 
 func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -577,7 +840,7 @@ func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storaget
 	if ctx.IsUpgraded(upgradetypes.Erdos) {
 		// if the bucket's flow rate limit is set to zero, no need to uncharge, since the bucket is already uncharged
 		if k.IsBucketRateLimited(ctx, bucketInfo.BucketName) {
-			return nil
+			return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, false)
 		}
 	}
 
@@ -590,7 +853,7 @@ func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storaget
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, false)
 }
 
 func (k Keeper) ChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -617,7 +880,7 @@ func (k Keeper) ChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetyp
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo, true)
 }
 
 func (k Keeper) ApplyBillChanges(ctx sdk.Context, prevFlows, currentFlows *types.UserFlows) error {
@@ -658,23 +921,86 @@ func (k Keeper) GetObjectLockFee(ctx sdk.Context, priceTime int64, payloadSize u
 		return amount, rate, fmt.Errorf("get charge size failed: %d %w", priceTime, err)
 	}
 
-	primaryRate := price.PrimaryStorePrice.MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
-
-	secondarySPNum := int64(k.GetExpectSecondarySPNumForECObject(ctx, priceTime))
-	secondaryRate := price.SecondaryStorePrice.MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
-	secondaryRate = secondaryRate.MulRaw(int64(secondarySPNum))
-
 	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, priceTime)
 	if err != nil {
 		return amount, rate, fmt.Errorf("get versioned reserve time error: %w", err)
 	}
-	validatorTaxRate := versionedParams.ValidatorTaxRate.MulInt(primaryRate.Add(secondaryRate)).TruncateInt()
+	secondarySPNum := k.GetExpectSecondarySPNumForECObject(ctx, priceTime)
+	primaryRate, secondaryRate, validatorTaxRate := getObjectStoreRates(price, versionedParams, chargeSize, secondarySPNum)
 
 	rate = primaryRate.Add(secondaryRate).Add(validatorTaxRate) // should also lock for validator tax pool
 	amount = rate.Mul(sdkmath.NewIntFromUint64(versionedParams.ReserveTime))
 	return amount, rate, nil
 }
 
+// getObjectStoreRates returns the flow rates of storing an object of the charge size on a primary sp and the secondary
+// sps, the same as the bill of a lvg calculated by calculateLVGStoreBill.
+func getObjectStoreRates(price sptypes.GlobalSpStorePrice, params types.VersionedParams, chargeSize uint64,
+	secondarySPNum uint32) (primaryRate, secondaryRate, validatorTaxRate sdkmath.Int) {
+	primaryRate = price.PrimaryStorePrice.MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
+	secondaryRate = price.SecondaryStorePrice.MulInt(sdkmath.NewIntFromUint64(chargeSize)).TruncateInt()
+	secondaryRate = secondaryRate.MulRaw(int64(secondarySPNum))
+	validatorTaxRate = params.ValidatorTaxRate.MulInt(primaryRate.Add(secondaryRate)).TruncateInt()
+	return primaryRate, secondaryRate, validatorTaxRate
+}
+
+// GetStorageCostEstimate estimates the cost of storing an object of the payload size in the bucket for the duration, with
+// the price and the versioned params in effect at the current block time. The read quota is charged for the bucket
+// over the duration as well.
+func (k Keeper) GetStorageCostEstimate(ctx sdk.Context, payloadSize, readQuota uint64, duration int64,
+) (*storagetypes.QueryEstimateStorageCostResponse, error) {
+	priceTime := ctx.BlockTime().Unix()
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get store price failed: %d %w", priceTime, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versioned params: %d %w", priceTime, err)
+	}
+	storageParams, err := k.GetVersionedParamsWithTs(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage versioned params: %d %w", priceTime, err)
+	}
+	chargeSize, err := k.GetObjectChargeSize(ctx, payloadSize, priceTime)
+	if err != nil {
+		return nil, err
+	}
+	lockFee, _, err := k.GetObjectLockFee(ctx, priceTime, payloadSize)
+	if err != nil {
+		return nil, err
+	}
+
+	secondarySPNum := k.GetExpectSecondarySPNumForECObject(ctx, priceTime)
+	primaryRate, secondaryRate, validatorTaxStoreRate := getObjectStoreRates(price, versionedParams, chargeSize, secondarySPNum)
+	storeRate := primaryRate.Add(secondaryRate).Add(validatorTaxStoreRate)
+
+	readRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(readQuota)).TruncateInt()
+	validatorTaxReadRate := versionedParams.ValidatorTaxRate.MulInt(readRate).TruncateInt()
+	totalRate := storeRate.Add(readRate).Add(validatorTaxReadRate)
+
+	// the same as ChargeObjectStoreFeeForEarlyDeletion, the store flows are paid for the rest of the reserve time
+	earlyDeletionFee := sdkmath.ZeroInt()
+	if timeToPay := int64(versionedParams.ReserveTime) - duration; timeToPay > 0 {
+		earlyDeletionFee = storeRate.MulRaw(timeToPay)
+	}
+
+	return &storagetypes.QueryEstimateStorageCostResponse{
+		ChargeSize:            chargeSize,
+		MinChargeSize:         storageParams.MinChargeSize,
+		ReserveTime:           versionedParams.ReserveTime,
+		PrimaryStoreRate:      primaryRate,
+		SecondaryStoreRate:    secondaryRate,
+		ValidatorTaxStoreRate: validatorTaxStoreRate,
+		ReadRate:              readRate,
+		ValidatorTaxReadRate:  validatorTaxReadRate,
+		TotalRate:             totalRate,
+		LockFee:               lockFee,
+		EarlyDeletionFee:      earlyDeletionFee,
+		TotalCost:             totalRate.MulRaw(duration).Add(earlyDeletionFee),
+	}, nil
+}
+
 func (k Keeper) GetObjectChargeSize(ctx sdk.Context, payloadSize uint64, ts int64) (size uint64, err error) {
 	params, err := k.GetVersionedParamsWithTs(ctx, ts)
 	if err != nil {
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+
 	sdkmath "cosmossdk.io/math"
 	"github.com/bnb-chain/greenfield/internal/sequence"
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
@@ -12,27 +15,50 @@ import (
 )
 
 // InitPaymentCheck initializes the payment check configuration.
-func InitPaymentCheck(k Keeper, enabled bool, interval uint32) {
+func InitPaymentCheck(k Keeper, enabled bool, interval uint32, queryEnabled bool) {
 	k.cfg.Enabled = enabled
 	k.cfg.Interval = interval
+	k.cfg.QueryEnabled = queryEnabled
 }
 
-// RunPaymentCheck checks the payment data of all buckets and objects.
+// RunPaymentCheck checks the payment data of all buckets and objects, the issues are logged.
 // It will compare the lock balance, net flow rate of users and gvg families/gvgs/validator tax pool.
 func (k Keeper) RunPaymentCheck(ctx sdk.Context) error {
 	ctx.Logger().Info("start checking payment data")
 
-	type Detail struct {
-		address string
-		amount  sdkmath.Int
+	report := k.CheckPaymentHealth(ctx)
+	for _, e := range report.Errors {
+		ctx.Logger().Error(e)
+	}
+	if len(report.Errors) > 0 {
+		ctx.Logger().Info("stop checking payment data due to error")
+		return errors.New(report.Errors[0])
 	}
 
+	var result error
+	for _, mismatch := range report.Mismatches {
+		ctx.Logger().Error(mismatch.Description, "type", mismatch.Type, "address", mismatch.Address,
+			"expected", mismatch.Expected, "actual", mismatch.Actual, "known issue", mismatch.KnownIssue, "buckets", mismatch.Buckets)
+		if !mismatch.KnownIssue {
+			result = errors.New(mismatch.Description)
+		}
+	}
+
+	ctx.Logger().Info("finish checking payment data")
+	return result
+}
+
+// CheckPaymentHealth checks the payment data of all buckets and objects against the stream records and reports
+// the mismatched accounts. The known lock balance issues are reported but they do not make the payment data unhealthy.
+func (k Keeper) CheckPaymentHealth(ctx sdk.Context) *types.PaymentHealthReport {
+	report := &types.PaymentHealthReport{TotalNetflowRate: sdkmath.ZeroInt()}
+
 	lockBalanceMap := make(map[string]sdkmath.Int)         // payment address -> lock balance
-	lockBalanceDetailMap := make(map[string][]Detail)      // payment address -> {bucket name, lock balance}
+	lockBalanceBucketMap := make(map[string][]string)      // payment address -> bucket names
 	userFlowRateMap := make(map[string]sdkmath.Int)        // payment address -> net flow rate
-	userFlowRateDetailMap := make(map[string][]Detail)     // payment address -> {bucket name, net flow rate}
+	userFlowRateBucketMap := make(map[string][]string)     // payment address -> bucket names
 	receiverFlowRateMap := make(map[string]sdkmath.Int)    // gvg family/gvg/validator tax pool address -> net flow rate
-	receiverFlowRateDetailMap := make(map[string][]Detail) // gvg family/gvg/validator tax pool address -> {bucket name, net flow rate}
+	receiverFlowRateBucketMap := make(map[string][]string) // gvg family/gvg/validator tax pool address -> bucket names
 
 	store := ctx.KVStore(k.storeKey)
 	bucketStore := prefix.NewStore(store, types.BucketByIDPrefix)
@@ -44,9 +70,18 @@ func (k Keeper) RunPaymentCheck(ctx sdk.Context) error {
 	allStreamRecords := k.paymentKeeper.GetAllStreamRecord(ctx)
 	for _, record := range allStreamRecords {
 		streamRecordMap[record.Account] = record
+		report.TotalNetflowRate = report.TotalNetflowRate.Add(record.NetflowRate)
 	}
 
-	var result error
+	addMismatch := func(mismatch types.PaymentMismatch) {
+		if mismatch.Expected.IsNil() {
+			mismatch.Expected = sdkmath.ZeroInt()
+		}
+		if mismatch.Actual.IsNil() {
+			mismatch.Actual = sdkmath.ZeroInt()
+		}
+		report.Mismatches = append(report.Mismatches, mismatch)
+	}
 
 Exit:
 	for ; bucketIt.Valid(); bucketIt.Next() {
@@ -59,14 +94,12 @@ Exit:
 		// get net flow rate
 		internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucket.Id)
 		if !found {
-			result = errors.New("internal bucket info not found")
-			ctx.Logger().Error("internal bucket info not found", "bucket", bucket.BucketName)
+			report.Errors = append(report.Errors, fmt.Sprintf("internal bucket info not found, bucket: %s", bucket.BucketName))
 			continue Exit
 		}
 		userFlows, err := k.GetBucketReadStoreBill(ctx, &bucket, internalBucketInfo)
 		if err != nil {
-			result = errors.New("fail to get bucket read and store bill")
-			ctx.Logger().Error("fail to get bucket read and store bill", "bucket", bucket.BucketName, "error", err)
+			report.Errors = append(report.Errors, fmt.Sprintf("fail to get bucket read and store bill, bucket: %s, error: %s", bucket.BucketName, err))
 			continue Exit
 		}
 
@@ -86,11 +119,9 @@ Exit:
 				_, ok := receiverFlowRateMap[flow.ToAddress]
 				if !ok {
 					receiverFlowRateMap[flow.ToAddress] = sdkmath.ZeroInt()
-					receiverFlowRateDetailMap[flow.ToAddress] = []Detail{}
 				}
 				receiverFlowRateMap[flow.ToAddress] = receiverFlowRateMap[flow.ToAddress].Add(flow.Rate)
-				receiverFlowRateDetailMap[flow.ToAddress] = append(receiverFlowRateDetailMap[flow.ToAddress],
-					Detail{bucket.BucketName, flow.Rate})
+				receiverFlowRateBucketMap[flow.ToAddress] = append(receiverFlowRateBucketMap[flow.ToAddress], bucket.BucketName)
 			}
 
 			// user payment account
@@ -98,12 +129,35 @@ Exit:
 			_, ok := userFlowRateMap[paymentAddress]
 			if !ok {
 				userFlowRateMap[paymentAddress] = sdkmath.ZeroInt()
-				userFlowRateDetailMap[paymentAddress] = []Detail{}
 			}
 			userFlowRateMap[paymentAddress] = userFlowRateMap[paymentAddress].Add(expectedNetFlowRate)
-			userFlowRateDetailMap[paymentAddress] = append(userFlowRateDetailMap[paymentAddress],
-				Detail{bucket.BucketName, expectedNetFlowRate})
+			userFlowRateBucketMap[paymentAddress] = append(userFlowRateBucketMap[paymentAddress], bucket.BucketName)
+		}
+
+		// the read quota bought by the readers of a requester-pays bucket is charged from their own payment accounts
+		readerQuotaStore := prefix.NewStore(store, types.GetReaderQuotaKeyOnlyBucketPrefix(bucket.Id))
+		readerQuotaIt := readerQuotaStore.Iterator(nil, nil)
+		for ; readerQuotaIt.Valid(); readerQuotaIt.Next() {
+			var readerQuota types.ReaderQuota
+			k.cdc.MustUnmarshal(readerQuotaIt.Value(), &readerQuota)
+			expectedNetFlowRate := sdkmath.ZeroInt()
+			for _, flow := range readerQuota.Flows {
+				expectedNetFlowRate = expectedNetFlowRate.Add(flow.Rate)
+				_, ok := receiverFlowRateMap[flow.ToAddress]
+				if !ok {
+					receiverFlowRateMap[flow.ToAddress] = sdkmath.ZeroInt()
+				}
+				receiverFlowRateMap[flow.ToAddress] = receiverFlowRateMap[flow.ToAddress].Add(flow.Rate)
+				receiverFlowRateBucketMap[flow.ToAddress] = append(receiverFlowRateBucketMap[flow.ToAddress], bucket.BucketName)
+			}
+			_, ok := userFlowRateMap[readerQuota.PaymentAddress]
+			if !ok {
+				userFlowRateMap[readerQuota.PaymentAddress] = sdkmath.ZeroInt()
+			}
+			userFlowRateMap[readerQuota.PaymentAddress] = userFlowRateMap[readerQuota.PaymentAddress].Add(expectedNetFlowRate.Neg())
+			userFlowRateBucketMap[readerQuota.PaymentAddress] = append(userFlowRateBucketMap[readerQuota.PaymentAddress], bucket.BucketName)
 		}
+		readerQuotaIt.Close()
 
 		// get lock balance
 		objectPrefixStore := prefix.NewStore(store, types.GetObjectKeyOnlyBucketPrefix(bucket.BucketName))
@@ -120,8 +174,8 @@ Exit:
 				if objectInfo.IsUpdating {
 					shadowObject, found := k.GetShadowObjectInfo(ctx, bucket.BucketName, objectInfo.ObjectName)
 					if !found {
-						result = errors.New("shadow object not found")
-						ctx.Logger().Error("shadow object not found", "bucket", bucket.BucketName, "object", objectInfo.ObjectName)
+						report.Errors = append(report.Errors, fmt.Sprintf("shadow object not found, bucket: %s, object: %s",
+							bucket.BucketName, objectInfo.ObjectName))
 						continue Exit
 					}
 					priceTime = shadowObject.UpdatedAt
@@ -130,8 +184,8 @@ Exit:
 
 				lockAmount, _, err := k.GetObjectLockFee(ctx, priceTime, payloadSize)
 				if err != nil {
-					result = errors.New("get object lock fee failed")
-					ctx.Logger().Error("get object lock fee failed", "bucket", bucket.BucketName, "object", objectInfo.ObjectName, "error", err)
+					report.Errors = append(report.Errors, fmt.Sprintf("get object lock fee failed, bucket: %s, object: %s, error: %s",
+						bucket.BucketName, objectInfo.ObjectName, err))
 					continue Exit
 				}
 				expectedLockBalance = expectedLockBalance.Add(lockAmount)
@@ -142,38 +196,41 @@ Exit:
 			_, ok := lockBalanceMap[bucket.PaymentAddress]
 			if !ok {
 				lockBalanceMap[bucket.PaymentAddress] = sdkmath.ZeroInt()
-				lockBalanceDetailMap[bucket.PaymentAddress] = []Detail{}
 			}
 			lockBalanceMap[bucket.PaymentAddress] = lockBalanceMap[bucket.PaymentAddress].Add(expectedLockBalance)
-			lockBalanceDetailMap[bucket.PaymentAddress] = append(lockBalanceDetailMap[bucket.PaymentAddress],
-				Detail{bucket.BucketName, expectedLockBalance})
+			lockBalanceBucketMap[bucket.PaymentAddress] = append(lockBalanceBucketMap[bucket.PaymentAddress], bucket.BucketName)
 		}
 	}
 
-	if result != nil { // if already has error, do not check the following
-		ctx.Logger().Info("stop checking payment data due to error")
-		return result
+	if len(report.Errors) > 0 { // if already has error, do not check the following
+		return report
 	}
 
 	// compare lock balance: expected -> actual side
 	for address, expectedLockBalance := range lockBalanceMap {
 		streamRecord, found := streamRecordMap[address]
 		if !found {
-			result = errors.New("comparing lock balance - stream record not found")
-			ctx.Logger().Error("comparing lock balance - stream record not found", "address", address)
-			continue // to print all errors if there are any
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_STREAM_RECORD_NOT_FOUND,
+				Address:     address,
+				Expected:    expectedLockBalance,
+				Buckets:     lockBalanceBucketMap[address],
+				Description: "comparing lock balance - stream record not found",
+			})
+			continue
 		}
 
 		actualLockBalance := streamRecord.LockBalance
 		if !expectedLockBalance.Equal(actualLockBalance) {
-			if !k.isKnownLockBalanceIssue(ctx, address) {
-				result = errors.New("lock balance not equal")
-			}
-			ctx.Logger().Error("lock balance not equal", "address", address, "expected", expectedLockBalance, "actual", actualLockBalance)
-			details := lockBalanceDetailMap[address]
-			for _, detail := range details {
-				ctx.Logger().Error("lock balance detail", "bucket", detail.address, "amount", detail.amount)
-			}
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_LOCK_BALANCE,
+				Address:     address,
+				Expected:    expectedLockBalance,
+				Actual:      actualLockBalance,
+				KnownIssue:  k.isKnownLockBalanceIssue(ctx, address),
+				Buckets:     lockBalanceBucketMap[address],
+				Description: "lock balance not equal",
+			})
 		}
 	}
 
@@ -182,9 +239,14 @@ Exit:
 	for address, expectedNetFlowRate := range userFlowRateMap {
 		streamRecord, found := streamRecordMap[address]
 		if !found {
-			result = errors.New("comparing user net flow rate - stream record not found")
-			ctx.Logger().Error("comparing user net flow rate - stream record not found", "address", address)
-			continue // to print all errors if there are any
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_STREAM_RECORD_NOT_FOUND,
+				Address:     address,
+				Expected:    expectedNetFlowRate,
+				Buckets:     userFlowRateBucketMap[address],
+				Description: "comparing user net flow rate - stream record not found",
+			})
+			continue
 		}
 
 		actualNetFlowRate := streamRecord.NetflowRate
@@ -206,18 +268,23 @@ Exit:
 		}
 
 		if actualNetFlowRate.IsNegative() && streamRecord.OutFlowCount <= 0 {
-			result = errors.New("user net flow rate invalid status or out flow count")
-			ctx.Logger().Error("user net flow rate invalid flow rate or out flow count",
-				"address", address, "status", actualNetFlowRate, "outflow count", streamRecord.OutFlowCount)
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_STREAM_RECORD_STATUS,
+				Address:     address,
+				Actual:      actualNetFlowRate,
+				Description: fmt.Sprintf("user net flow rate invalid status or out flow count, out flow count: %d", streamRecord.OutFlowCount),
+			})
 		}
 
 		if !expectedNetFlowRate.Equal(actualNetFlowRate) {
-			result = errors.New("user net flow rate not equal")
-			ctx.Logger().Error("user net flow rate not equal", "address", address, "expected", expectedNetFlowRate, "actual", actualNetFlowRate)
-			details := userFlowRateDetailMap[address]
-			for _, detail := range details {
-				ctx.Logger().Error("user net flow rate detail", "bucket", detail.address, "amount", detail.amount)
-			}
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_USER_NET_FLOW_RATE,
+				Address:     address,
+				Expected:    expectedNetFlowRate,
+				Actual:      actualNetFlowRate,
+				Buckets:     userFlowRateBucketMap[address],
+				Description: "user net flow rate not equal",
+			})
 		}
 	}
 
@@ -225,15 +292,24 @@ Exit:
 	for address, expectedNetFlowRate := range receiverFlowRateMap {
 		streamRecord, found := streamRecordMap[address]
 		if !found {
-			result = errors.New("comparing receiver net flow rate - stream record not found")
-			ctx.Logger().Error("comparing receiver net flow rate - stream record not found", "address", address)
-			continue // to print all errors if there are any
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_STREAM_RECORD_NOT_FOUND,
+				Address:     address,
+				Expected:    expectedNetFlowRate,
+				Buckets:     receiverFlowRateBucketMap[address],
+				Description: "comparing receiver net flow rate - stream record not found",
+			})
+			continue
 		}
 
 		if streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN || streamRecord.OutFlowCount > 0 {
-			result = errors.New("receiver net flow rate invalid status or out flow count")
-			ctx.Logger().Error("receiver net flow rate invalid status or out flow count",
-				"address", address, "status", streamRecord.Status, "outflow count", streamRecord.OutFlowCount)
+			addMismatch(types.PaymentMismatch{
+				Type:    types.PAYMENT_MISMATCH_STREAM_RECORD_STATUS,
+				Address: address,
+				Actual:  streamRecord.NetflowRate,
+				Description: fmt.Sprintf("receiver net flow rate invalid status or out flow count, status: %s, out flow count: %d",
+					streamRecord.Status, streamRecord.OutFlowCount),
+			})
 		}
 
 		actualNetFlowRate := streamRecord.NetflowRate
@@ -243,48 +319,75 @@ Exit:
 		}
 
 		if !expectedNetFlowRate.Equal(actualNetFlowRate) {
-			result = errors.New("receiver net flow rate not equal")
-			ctx.Logger().Error("receiver net flow rate not equal", "address", address, "expected", expectedNetFlowRate, "actual", actualNetFlowRate)
-			details := receiverFlowRateDetailMap[address]
-			for _, detail := range details {
-				ctx.Logger().Error("receiver net flow rate detail", "bucket", detail.address, "amount", detail.amount)
-			}
+			addMismatch(types.PaymentMismatch{
+				Type:        types.PAYMENT_MISMATCH_RECEIVER_NET_FLOW_RATE,
+				Address:     address,
+				Expected:    expectedNetFlowRate,
+				Actual:      actualNetFlowRate,
+				Buckets:     receiverFlowRateBucketMap[address],
+				Description: "receiver net flow rate not equal",
+			})
 		}
 	}
 
 	// compare lock balance: actual -> expected side
 	// compare user net flow rate: actual -> expected side
 	// compare receiver net flow rate: actual -> expected side
-	for _, streamRecord := range streamRecordMap {
+	for _, streamRecord := range allStreamRecords {
 		if streamRecord.LockBalance.IsPositive() {
 			_, found := lockBalanceMap[streamRecord.Account]
 			if !found {
-				if !k.isKnownLockBalanceIssue(ctx, streamRecord.Account) {
-					result = errors.New("the stream record has lock balance which is not expected")
-				}
-				ctx.Logger().Error("the stream record has lock balance which is not expected", "address", streamRecord.Account)
+				addMismatch(types.PaymentMismatch{
+					Type:        types.PAYMENT_MISMATCH_LOCK_BALANCE,
+					Address:     streamRecord.Account,
+					Actual:      streamRecord.LockBalance,
+					KnownIssue:  k.isKnownLockBalanceIssue(ctx, streamRecord.Account),
+					Description: "the stream record has lock balance which is not expected",
+				})
 			}
 		}
 
 		if streamRecord.NetflowRate.IsNegative() || streamRecord.FrozenNetflowRate.IsNegative() {
 			_, found := userFlowRateMap[streamRecord.Account]
 			if !found {
-				result = errors.New("the stream record has negative flow rate which is not expected")
-				ctx.Logger().Error("the stream record has negative flow rate which is not expected", "address", streamRecord.Account)
+				addMismatch(types.PaymentMismatch{
+					Type:        types.PAYMENT_MISMATCH_USER_NET_FLOW_RATE,
+					Address:     streamRecord.Account,
+					Actual:      streamRecord.NetflowRate.Add(streamRecord.FrozenNetflowRate),
+					Description: "the stream record has negative flow rate which is not expected",
+				})
 			}
 		}
 
 		if streamRecord.NetflowRate.IsPositive() {
 			_, found := receiverFlowRateMap[streamRecord.Account]
 			if !found {
-				result = errors.New("the stream record has positive flow rate which is not expected")
-				ctx.Logger().Error("the stream record has positive flow rate which is not expected", "address", streamRecord.Account)
+				addMismatch(types.PaymentMismatch{
+					Type:        types.PAYMENT_MISMATCH_RECEIVER_NET_FLOW_RATE,
+					Address:     streamRecord.Account,
+					Actual:      streamRecord.NetflowRate,
+					Description: "the stream record has positive flow rate which is not expected",
+				})
 			}
 		}
 	}
 
-	ctx.Logger().Info("finish checking payment data")
-	return result
+	// the maps are iterated in random order, the mismatches are sorted to make the report deterministic
+	sort.SliceStable(report.Mismatches, func(i, j int) bool {
+		if report.Mismatches[i].Address != report.Mismatches[j].Address {
+			return report.Mismatches[i].Address < report.Mismatches[j].Address
+		}
+		return report.Mismatches[i].Type < report.Mismatches[j].Type
+	})
+
+	report.Healthy = true
+	for _, mismatch := range report.Mismatches {
+		if !mismatch.KnownIssue {
+			report.Healthy = false
+			break
+		}
+	}
+	return report
 }
 
 // isKnownLockBalanceIssue checks if the address is the known addresses of the lock balance issue on testnet.
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/keeper"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestPaymentHealth() {
+	knownIssueAddr := "0x8E15D16d6432166372Fb1e6f4A41840D71edd41F"
+	knownIssueRecord := paymenttypes.StreamRecord{
+		Account:           knownIssueAddr,
+		LockBalance:       sdkmath.NewInt(100),
+		NetflowRate:       sdkmath.ZeroInt(),
+		FrozenNetflowRate: sdkmath.ZeroInt(),
+	}
+	receiverRecord := paymenttypes.StreamRecord{
+		Account:           sample.RandAccAddress().String(),
+		LockBalance:       sdkmath.ZeroInt(),
+		NetflowRate:       sdkmath.NewInt(10),
+		FrozenNetflowRate: sdkmath.ZeroInt(),
+	}
+	ctx := s.ctx.WithChainID(upgradetypes.TestnetChainID)
+
+	// the known lock balance issue is reported but it is healthy
+	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).Return([]paymenttypes.StreamRecord{knownIssueRecord}).Times(2)
+	report := s.storageKeeper.CheckPaymentHealth(ctx)
+	s.Require().True(report.Healthy)
+	s.Require().Len(report.Mismatches, 1)
+	s.Require().Equal(types.PAYMENT_MISMATCH_LOCK_BALANCE, report.Mismatches[0].Type)
+	s.Require().True(report.Mismatches[0].KnownIssue)
+	s.Require().NoError(s.storageKeeper.RunPaymentCheck(ctx))
+
+	// a receiver without any bucket bill is a mismatch, the mismatches are sorted by address
+	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).Return([]paymenttypes.StreamRecord{knownIssueRecord, receiverRecord}).Times(2)
+	report = s.storageKeeper.CheckPaymentHealth(ctx)
+	s.Require().False(report.Healthy)
+	s.Require().Len(report.Mismatches, 2)
+	s.Require().True(report.Mismatches[0].Address < report.Mismatches[1].Address)
+	for _, mismatch := range report.Mismatches {
+		if mismatch.Address == receiverRecord.Account {
+			s.Require().Equal(types.PAYMENT_MISMATCH_RECEIVER_NET_FLOW_RATE, mismatch.Type)
+		}
+	}
+	s.Require().Equal(sdkmath.NewInt(10), report.TotalNetflowRate)
+	s.Require().Error(s.storageKeeper.RunPaymentCheck(ctx))
+
+	// the query is only served when it is enabled in the app config
+	_, err := s.storageKeeper.PaymentHealth(ctx, &types.QueryPaymentHealthRequest{})
+	s.Require().Equal(codes.Unavailable, status.Code(err))
+	keeper.InitPaymentCheck(*s.storageKeeper, false, 0, true)
+	defer keeper.InitPaymentCheck(*s.storageKeeper, false, 0, false)
+	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).Return([]paymenttypes.StreamRecord{knownIssueRecord, receiverRecord}).Times(1)
+	res, err := s.storageKeeper.PaymentHealth(ctx, &types.QueryPaymentHealthRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(report, res.Report)
+	s.Require().Equal(ctx.BlockHeight(), res.Height)
+}
+
+func (s *TestSuite) TestPaymentHealthReaderQuota() {
+	owner := sample.RandAccAddress()
+	reader := sample.RandAccAddress()
+
+	primarySp := &sptypes.StorageProvider{Id: 1, OperatorAddress: sample.RandAccAddress().String()}
+	s.spKeeper.EXPECT().MustGetStorageProvider(gomock.Any(), primarySp.Id).Return(primarySp).AnyTimes()
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: primarySp.Id, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gvgFamily.Id).Return(gvgFamily, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice: sdk.NewDec(1),
+	}, nil).AnyTimes()
+	versionedParams := paymenttypes.DefaultParams().VersionedParams
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).Return(versionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             owner.String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+		RequesterPays:              true,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{})
+	s.Require().NoError(s.storageKeeper.PurchaseReaderQuota(s.ctx, reader, bucketInfo.BucketName, "", 1000))
+
+	// the reader pays for its read quota to the gvg family and the validator tax pool
+	taxRate := versionedParams.ValidatorTaxRate.MulInt64(1000).TruncateInt()
+	streamRecords := []paymenttypes.StreamRecord{
+		{
+			Account:           reader.String(),
+			LockBalance:       sdkmath.ZeroInt(),
+			NetflowRate:       sdkmath.NewInt(-1000).Sub(taxRate),
+			FrozenNetflowRate: sdkmath.ZeroInt(),
+			OutFlowCount:      2,
+		},
+		{
+			Account:           gvgFamily.VirtualPaymentAddress,
+			LockBalance:       sdkmath.ZeroInt(),
+			NetflowRate:       sdkmath.NewInt(1000),
+			FrozenNetflowRate: sdkmath.ZeroInt(),
+		},
+		{
+			Account:           paymenttypes.ValidatorTaxPoolAddress.String(),
+			LockBalance:       sdkmath.ZeroInt(),
+			NetflowRate:       taxRate,
+			FrozenNetflowRate: sdkmath.ZeroInt(),
+		},
+	}
+	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).Return(streamRecords).Times(1)
+	report := s.storageKeeper.CheckPaymentHealth(s.ctx)
+	s.Require().Empty(report.Errors)
+	s.Require().Empty(report.Mismatches)
+	s.Require().True(report.Healthy)
+}
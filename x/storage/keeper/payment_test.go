@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge"
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
@@ -50,7 +51,7 @@ func (s *TestSuite) SetupTest() {
 	header := testCtx.Ctx.BlockHeader()
 	header.Time = time.Now()
 	upgradeChecker := func(ctx sdk.Context, name string) bool {
-		return name == upgradetypes.Serengeti
+		return name == upgradetypes.Serengeti || name == gnfdtypes.Gobi
 	}
 	testCtx = testutil.TestContext{
 		Ctx: sdk.NewContext(testCtx.CMS, header, false, upgradeChecker, testCtx.Ctx.Logger()),
@@ -66,6 +67,8 @@ func (s *TestSuite) SetupTest() {
 	permissionKeeper := types.NewMockPermissionKeeper(ctrl)
 	crossChainKeeper := types.NewMockCrossChainKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
+	// the bucket bills are recorded for the billing statements on every charge change
+	paymentKeeper.EXPECT().SetBucketBill(gomock.Any(), gomock.Any()).AnyTimes()
 	virtualGroupKeeper := types.NewMockVirtualGroupKeeper(ctrl)
 
 	s.storageKeeper = keeper.NewKeeper(
@@ -131,6 +134,70 @@ func (s *TestSuite) TestGetObjectLockFee() {
 	s.Require().True(amount.Equal(expectedAmount))
 }
 
+func (s *TestSuite) TestEstimateStorageCost() {
+	// the versioned params take effect after the block time they are set
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Second))
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:            sample.RandAccAddress().String(),
+		BucketName:       "bucketname",
+		Id:               sdk.NewUint(1),
+		PaymentAddress:   sample.RandAccAddress().String(),
+		ChargedReadQuota: 100,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	_, err := s.storageKeeper.EstimateStorageCost(s.ctx, &types.QueryEstimateStorageCostRequest{BucketName: "nonexistent"})
+	s.Require().ErrorIs(err, types.ErrNoSuchBucket)
+
+	// the payload smaller than the min charge size is charged as the min charge size
+	reserveTime := int64(params.VersionedParams.ReserveTime)
+	res, err := s.storageKeeper.EstimateStorageCost(s.ctx, &types.QueryEstimateStorageCostRequest{
+		BucketName:  bucketInfo.BucketName,
+		PayloadSize: 1,
+		Duration:    reserveTime / 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.MinChargeSize, res.ChargeSize)
+	s.Require().Equal(uint64(reserveTime), res.ReserveTime)
+
+	lockFee, storeRate, err := s.storageKeeper.GetObjectLockFee(s.ctx, s.ctx.BlockTime().Unix(), 1)
+	s.Require().NoError(err)
+	s.Require().True(res.LockFee.Equal(lockFee))
+	s.Require().True(res.PrimaryStoreRate.Add(res.SecondaryStoreRate).Add(res.ValidatorTaxStoreRate).Equal(storeRate))
+
+	// the read quota of the bucket is charged by default
+	readRate := price.ReadPrice.MulInt64(100).TruncateInt()
+	s.Require().True(res.ReadRate.Equal(readRate))
+	s.Require().True(res.TotalRate.Equal(storeRate.Add(readRate).Add(res.ValidatorTaxReadRate)))
+
+	// the object deleted within the reserve time pays the store fee for the rest of the reserve time
+	earlyDeletionFee := storeRate.MulRaw(reserveTime - reserveTime/2)
+	s.Require().True(res.EarlyDeletionFee.Equal(earlyDeletionFee))
+	s.Require().True(res.TotalCost.Equal(res.TotalRate.MulRaw(reserveTime / 2).Add(earlyDeletionFee)))
+
+	res, err = s.storageKeeper.EstimateStorageCost(s.ctx, &types.QueryEstimateStorageCostRequest{
+		BucketName:  bucketInfo.BucketName,
+		PayloadSize: 1,
+		ReadQuota:   200,
+		Duration:    reserveTime,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.ReadRate.Equal(price.ReadPrice.MulInt64(200).TruncateInt()))
+	s.Require().True(res.EarlyDeletionFee.IsZero())
+	s.Require().True(res.TotalCost.Equal(res.TotalRate.MulRaw(reserveTime)))
+}
+
 func (s *TestSuite) TestGetBucketReadBill() {
 	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
 		Id:                    1,
@@ -311,4 +378,25 @@ func (s *TestSuite) TestGetBucketReadStoreBill() {
 	s.Require().Equal(flows.Flows[7].ToAddress, paymenttypes.ValidatorTaxPoolAddress.String())
 	taxPoolRate = params.VersionedParams.ValidatorTaxRate.MulInt(primaryStoreRate.Add(gvg2StoreRate)).TruncateInt()
 	s.Require().Equal(flows.Flows[7].Rate, taxPoolRate)
+
+	// the billing flows are labeled by category and receiver
+	billingFlows, err := s.storageKeeper.GetBucketBillingFlows(s.ctx, bucketInfo, internalBucketInfo)
+	s.Require().NoError(err)
+	s.Require().Len(billingFlows, len(flows.Flows))
+	categories := []paymenttypes.BillingCategory{
+		paymenttypes.BILLING_CATEGORY_READ, paymenttypes.BILLING_CATEGORY_READ,
+		paymenttypes.BILLING_CATEGORY_STORE, paymenttypes.BILLING_CATEGORY_STORE, paymenttypes.BILLING_CATEGORY_STORE,
+		paymenttypes.BILLING_CATEGORY_STORE, paymenttypes.BILLING_CATEGORY_STORE, paymenttypes.BILLING_CATEGORY_STORE,
+	}
+	recipients := []paymenttypes.BillingRecipient{
+		paymenttypes.BILLING_RECIPIENT_GVG_FAMILY, paymenttypes.BILLING_RECIPIENT_VALIDATOR_TAX_POOL,
+		paymenttypes.BILLING_RECIPIENT_GVG_FAMILY, paymenttypes.BILLING_RECIPIENT_GVG, paymenttypes.BILLING_RECIPIENT_VALIDATOR_TAX_POOL,
+		paymenttypes.BILLING_RECIPIENT_GVG_FAMILY, paymenttypes.BILLING_RECIPIENT_GVG, paymenttypes.BILLING_RECIPIENT_VALIDATOR_TAX_POOL,
+	}
+	for i, flow := range billingFlows {
+		s.Require().Equal(flows.Flows[i].ToAddress, flow.ToAddress)
+		s.Require().Equal(flows.Flows[i].Rate, flow.Rate)
+		s.Require().Equal(categories[i], flow.Category)
+		s.Require().Equal(recipients[i], flow.Recipient)
+	}
 }
@@ -27,6 +27,13 @@ var (
 		permtypes.ACTION_COPY_OBJECT:    true,
 		permtypes.ACTION_EXECUTE_OBJECT: true,
 	}
+	// RequesterPaysReadActions are the actions reading the object data, the operator pays for them with its read quota
+	// in a requester-pays bucket.
+	RequesterPaysReadActions = map[permtypes.ActionType]bool{
+		permtypes.ACTION_GET_OBJECT:     true,
+		permtypes.ACTION_COPY_OBJECT:    true,
+		permtypes.ACTION_EXECUTE_OBJECT: true,
+	}
 )
 
 // VerifyBucketPermission Bucket permissions checks are divided into three steps:
@@ -35,23 +42,44 @@ var (
 // Third, verify the policy corresponding to the bucket and the operator.
 //  1. If the policy is evaluated as "allow", return "allow" to the user.
 //  2. If it is evaluated as "deny" or "unspecified", return "deny".
+//
+// The anonymous operator is always denied for a requester-pays bucket, as the reader pays for the read quota, and so
+// is the operator reading the object data without the read quota it bought by PurchaseReaderQuota, the owner included.
 func (k Keeper) VerifyBucketPermission(ctx sdk.Context, bucketInfo *types.BucketInfo, operator sdk.AccAddress,
 	action permtypes.ActionType, options *permtypes.VerifyOptions,
 ) permtypes.Effect {
+	return k.verifyBucketPermission(ctx, bucketInfo, operator, action, options, nil)
+}
+
+func (k Keeper) verifyBucketPermission(ctx sdk.Context, bucketInfo *types.BucketInfo, operator sdk.AccAddress,
+	action permtypes.ActionType, options *permtypes.VerifyOptions, tracer *permissionTracer,
+) permtypes.Effect {
+	// the readers of a requester-pays bucket must be known to be charged for their read quota
+	if bucketInfo.RequesterPays && operator.Empty() {
+		tracer.record(types.TRACE_STEP_ANONYMOUS, gnfdresource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, permtypes.EFFECT_DENY)
+		return permtypes.EFFECT_DENY
+	}
+	if bucketInfo.RequesterPays && RequesterPaysReadActions[action] && !k.hasReaderQuota(ctx, bucketInfo, operator) {
+		tracer.record(types.TRACE_STEP_READER_QUOTA_MISSING, gnfdresource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, permtypes.EFFECT_DENY)
+		return permtypes.EFFECT_DENY
+	}
 	// if bucket is public, anyone can read but can not write it.
 	if bucketInfo.Visibility == storagetypes.VISIBILITY_TYPE_PUBLIC_READ && PublicReadBucketAllowedActions[action] {
+		tracer.record(types.TRACE_STEP_PUBLIC_READ, gnfdresource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, permtypes.EFFECT_ALLOW)
 		return permtypes.EFFECT_ALLOW
 	}
 	// if the operator is empty(may anonymous user), don't need check policy
 	if operator.Empty() {
+		tracer.record(types.TRACE_STEP_ANONYMOUS, gnfdresource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, permtypes.EFFECT_DENY)
 		return permtypes.EFFECT_DENY
 	}
 	// The owner has full permissions
 	if operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner)) {
+		tracer.record(types.TRACE_STEP_OWNER, gnfdresource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, permtypes.EFFECT_ALLOW)
 		return permtypes.EFFECT_ALLOW
 	}
 	// verify policy
-	effect := k.VerifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, options)
+	effect := k.verifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, options, tracer)
 	if effect == permtypes.EFFECT_ALLOW {
 		return permtypes.EFFECT_ALLOW
 	}
@@ -70,9 +98,26 @@ func (k Keeper) VerifyBucketPermission(ctx sdk.Context, bucketInfo *types.Bucket
 //  2. If it is evaluated as "allow", return "allow".
 //  3. If it is evaluated as "unspecified", then if the EffectBucket is "allow", return allow
 //  4. If it is evaluated as "unspecified", then if the EffectBucket is "unspecified", return deny
+//
+// The object data of a requester-pays bucket is only read by the operator holding its own read quota of the bucket.
 func (k Keeper) VerifyObjectPermission(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo,
 	operator sdk.AccAddress, action permtypes.ActionType,
 ) permtypes.Effect {
+	return k.verifyObjectPermission(ctx, bucketInfo, objectInfo, operator, action, nil)
+}
+
+func (k Keeper) verifyObjectPermission(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo,
+	operator sdk.AccAddress, action permtypes.ActionType, tracer *permissionTracer,
+) permtypes.Effect {
+	// the readers of a requester-pays bucket must be known to be charged for their read quota
+	if bucketInfo.RequesterPays && operator.Empty() {
+		tracer.record(types.TRACE_STEP_ANONYMOUS, gnfdresource.RESOURCE_TYPE_OBJECT, objectInfo.Id, permtypes.EFFECT_DENY)
+		return permtypes.EFFECT_DENY
+	}
+	if bucketInfo.RequesterPays && RequesterPaysReadActions[action] && !k.hasReaderQuota(ctx, bucketInfo, operator) {
+		tracer.record(types.TRACE_STEP_READER_QUOTA_MISSING, gnfdresource.RESOURCE_TYPE_OBJECT, objectInfo.Id, permtypes.EFFECT_DENY)
+		return permtypes.EFFECT_DENY
+	}
 	// anyone can read but can not write it when the following case: 1) object is public 2) object is inherit, only when bucket is public
 	visibility := false
 	if objectInfo.Visibility == storagetypes.VISIBILITY_TYPE_PUBLIC_READ ||
@@ -80,30 +125,44 @@ func (k Keeper) VerifyObjectPermission(ctx sdk.Context, bucketInfo *types.Bucket
 		visibility = true
 	}
 	if visibility && PublicReadObjectAllowedActions[action] {
+		tracer.record(types.TRACE_STEP_PUBLIC_READ, gnfdresource.RESOURCE_TYPE_OBJECT, objectInfo.Id, permtypes.EFFECT_ALLOW)
 		return permtypes.EFFECT_ALLOW
 	}
 
 	// if the operator is empty(may anonymous user), don't need check policy
 	if operator.Empty() {
+		tracer.record(types.TRACE_STEP_ANONYMOUS, gnfdresource.RESOURCE_TYPE_OBJECT, objectInfo.Id, permtypes.EFFECT_DENY)
 		return permtypes.EFFECT_DENY
 	}
 	// The owner has full permissions
 	ownerAcc := sdk.MustAccAddressFromHex(objectInfo.Owner)
 	if ownerAcc.Equals(operator) {
+		tracer.record(types.TRACE_STEP_OWNER, gnfdresource.RESOURCE_TYPE_OBJECT, objectInfo.Id, permtypes.EFFECT_ALLOW)
 		return permtypes.EFFECT_ALLOW
 	}
 
 	// verify policy
+	payloadSize := objectInfo.PayloadSize
 	opts := &permtypes.VerifyOptions{
-		Resource: types2.NewObjectGRN(objectInfo.BucketName, objectInfo.ObjectName).String(),
+		Resource:    types2.NewObjectGRN(objectInfo.BucketName, objectInfo.ObjectName).String(),
+		ObjectName:  objectInfo.ObjectName,
+		ContentType: objectInfo.ContentType,
+		PayloadSize: &payloadSize,
+		RegexCache:  k.regexCache,
 	}
-	bucketEffect := k.VerifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, opts)
+	bucketEffect := k.verifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, opts, tracer)
 	if bucketEffect == permtypes.EFFECT_DENY {
 		return permtypes.EFFECT_DENY
 	}
 
-	objectEffect := k.VerifyPolicy(ctx, objectInfo.Id, gnfdresource.RESOURCE_TYPE_OBJECT, operator, action,
-		nil)
+	// the object policy has no sub-resource, only the condition related options are needed
+	objectOpts := &permtypes.VerifyOptions{
+		ObjectName:  objectInfo.ObjectName,
+		ContentType: objectInfo.ContentType,
+		PayloadSize: &payloadSize,
+	}
+	objectEffect := k.verifyPolicy(ctx, objectInfo.Id, gnfdresource.RESOURCE_TYPE_OBJECT, operator, action,
+		objectOpts, tracer)
 	if objectEffect == permtypes.EFFECT_DENY {
 		return permtypes.EFFECT_DENY
 	}
@@ -133,11 +192,19 @@ func (k Keeper) VerifyGroupPermission(ctx sdk.Context, groupInfo *types.GroupInf
 
 func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType gnfdresource.ResourceType,
 	operator sdk.AccAddress, action permtypes.ActionType, opts *permtypes.VerifyOptions,
+) permtypes.Effect {
+	return k.verifyPolicy(ctx, resourceID, resourceType, operator, action, opts, nil)
+}
+
+func (k Keeper) verifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType gnfdresource.ResourceType,
+	operator sdk.AccAddress, action permtypes.ActionType, opts *permtypes.VerifyOptions, tracer *permissionTracer,
 ) permtypes.Effect {
 	// verify policy which grant permission to account
 	policy, found := k.permKeeper.GetPolicyForAccount(ctx, resourceID, resourceType, operator)
 	if found {
-		effect, newPolicy := policy.Eval(action, ctx.BlockTime(), opts)
+		evalTrace := tracer.newEvalTrace()
+		effect, newPolicy := policy.EvalWithTrace(action, ctx.BlockTime(), opts, evalTrace)
+		tracer.recordPolicy(types.TRACE_STEP_ACCOUNT_POLICY, resourceType, resourceID, policy.Id, math.ZeroUint(), evalTrace, effect)
 		if effect != permtypes.EFFECT_UNSPECIFIED {
 			if effect == permtypes.EFFECT_ALLOW && action == permtypes.ACTION_CREATE_OBJECT && newPolicy != nil && ctx.TxBytes() != nil {
 				_, err := k.permKeeper.PutPolicy(ctx, newPolicy)
@@ -160,7 +227,9 @@ func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType
 			}
 			// check the group has the right permission of this resource
 			p := k.permKeeper.MustGetPolicyByID(ctx, item.PolicyId)
-			effect, newPolicy := p.Eval(action, ctx.BlockTime(), opts)
+			evalTrace := tracer.newEvalTrace()
+			effect, newPolicy := p.EvalWithTrace(action, ctx.BlockTime(), opts, evalTrace)
+			tracer.recordPolicy(types.TRACE_STEP_GROUP_POLICY, resourceType, resourceID, p.Id, item.GroupId, evalTrace, effect)
 			if effect != permtypes.EFFECT_UNSPECIFIED {
 				// check the operator is the member of this group
 				groupMember, memberFound := k.permKeeper.GetGroupMember(ctx, item.GroupId, operator)
@@ -171,6 +240,8 @@ func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType
 					} else if effect == permtypes.EFFECT_DENY {
 						return permtypes.EFFECT_DENY
 					}
+				} else {
+					tracer.recordGroupMemberMissing(resourceType, resourceID, p.Id, item.GroupId)
 				}
 			}
 		}
@@ -228,6 +299,12 @@ func (k Keeper) PutPolicy(ctx sdk.Context, operator sdk.AccAddress, grn types2.G
 	if err != nil {
 		return math.ZeroUint(), err
 	}
+	if ctx.IsUpgraded(types2.Gobi) {
+		err = k.NormalizeStatements(policy.Statements)
+		if err != nil {
+			return math.ZeroUint(), err
+		}
+	}
 	policy.ResourceId = resID
 	return k.permKeeper.PutPolicy(ctx, policy)
 }
@@ -268,6 +345,20 @@ func (k Keeper) NormalizePrincipal(ctx sdk.Context, principal *permtypes.Princip
 	}
 }
 
+// NormalizeStatements removes the duplicated resources of the statements, and compiles the resource patterns
+// in advance, so that the malformed patterns are rejected and the compiled ones are cached for the evaluation.
+func (k Keeper) NormalizeStatements(statements []*permtypes.Statement) error {
+	for _, s := range statements {
+		s.Resources = permtypes.NormalizeResources(s.Resources)
+		for _, res := range s.Resources {
+			if _, err := k.regexCache.Compile(res); err != nil {
+				return permtypes.ErrInvalidStatement.Wrapf("The Resources regexp compile failed, err: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
 func (k Keeper) ValidatePrincipal(ctx sdk.Context, resOwner sdk.AccAddress, principal *permtypes.Principal) error {
 	if principal.Type == permtypes.PRINCIPAL_TYPE_GNFD_ACCOUNT {
 		principalAccAddress, err := principal.GetAccountAddress()
@@ -333,3 +424,90 @@ func (k Keeper) getResourceOwnerAndIdFromGRN(ctx sdk.Context, grn types2.GRN) (r
 
 	return resOwner, resID, nil
 }
+
+// permissionTracer collects the steps of a permission verification to explain the final effect.
+// All the methods are safe to be called on a nil tracer, which records nothing.
+type permissionTracer struct {
+	steps []*types.PermissionTraceStep
+}
+
+func (t *permissionTracer) record(stepType types.PermissionTraceStepType, resourceType gnfdresource.ResourceType,
+	resourceID math.Uint, effect permtypes.Effect,
+) {
+	if t == nil {
+		return
+	}
+	t.steps = append(t.steps, &types.PermissionTraceStep{
+		Type:           stepType,
+		ResourceType:   resourceType,
+		ResourceId:     resourceID,
+		PolicyId:       math.ZeroUint(),
+		GroupId:        math.ZeroUint(),
+		StatementIndex: -1,
+		Effect:         effect,
+	})
+}
+
+func (t *permissionTracer) newEvalTrace() *permtypes.EvalTrace {
+	if t == nil {
+		return nil
+	}
+	return &permtypes.EvalTrace{}
+}
+
+func (t *permissionTracer) recordPolicy(stepType types.PermissionTraceStepType, resourceType gnfdresource.ResourceType,
+	resourceID, policyID, groupID math.Uint, evalTrace *permtypes.EvalTrace, effect permtypes.Effect,
+) {
+	if t == nil {
+		return
+	}
+	if evalTrace.Expired {
+		t.steps = append(t.steps, &types.PermissionTraceStep{
+			Type:           types.TRACE_STEP_POLICY_EXPIRED,
+			ResourceType:   resourceType,
+			ResourceId:     resourceID,
+			PolicyId:       policyID,
+			GroupId:        groupID,
+			StatementIndex: -1,
+			Effect:         permtypes.EFFECT_UNSPECIFIED,
+		})
+		return
+	}
+	for _, i := range evalTrace.SkippedStatements {
+		t.steps = append(t.steps, &types.PermissionTraceStep{
+			Type:           types.TRACE_STEP_STATEMENT_EXPIRED,
+			ResourceType:   resourceType,
+			ResourceId:     resourceID,
+			PolicyId:       policyID,
+			GroupId:        groupID,
+			StatementIndex: int32(i),
+			Effect:         permtypes.EFFECT_UNSPECIFIED,
+		})
+	}
+	t.steps = append(t.steps, &types.PermissionTraceStep{
+		Type:           stepType,
+		ResourceType:   resourceType,
+		ResourceId:     resourceID,
+		PolicyId:       policyID,
+		GroupId:        groupID,
+		StatementIndex: int32(evalTrace.MatchedStatement),
+		Effect:         effect,
+	})
+}
+
+func (t *permissionTracer) recordGroupMemberMissing(resourceType gnfdresource.ResourceType, resourceID, policyID,
+	groupID math.Uint,
+) {
+	if t == nil {
+		return
+	}
+	t.steps = append(t.steps, &types.PermissionTraceStep{
+		Type:           types.TRACE_STEP_GROUP_MEMBER_MISSING,
+		ResourceType:   resourceType,
+		ResourceId:     resourceID,
+		PolicyId:       policyID,
+		GroupId:        groupID,
+		StatementIndex: -1,
+		Effect:         permtypes.EFFECT_UNSPECIFIED,
+	})
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	types2 "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/bnb-chain/greenfield/x/storage/keeper"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func (s *TestSuite) TestExplainPermission() {
+	bucketOwner := sample.RandAccAddress()
+	operator := sample.RandAccAddress()
+	bucketName := string(sample.RandStr(10))
+
+	bucketInfo := &types.BucketInfo{
+		Owner:        bucketOwner.String(),
+		BucketName:   bucketName,
+		Id:           sdk.NewUint(1),
+		BucketStatus: types.BUCKET_STATUS_CREATED,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	// case 1: the owner is allowed directly
+	res, err := s.queryClient.ExplainPermission(s.ctx, &types.QueryExplainPermissionRequest{
+		Operator:   bucketOwner.String(),
+		BucketName: bucketName,
+		ActionType: permtypes.ACTION_DELETE_BUCKET,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, res.Effect)
+	s.Require().Len(res.Trace, 2)
+	s.Require().Equal(types.TRACE_STEP_OWNER, res.Trace[0].Type)
+	s.Require().Equal(types.TRACE_STEP_FINAL, res.Trace[1].Type)
+
+	// case 2: the account policy with an expired statement and a matched statement
+	expired := s.ctx.BlockTime().Add(-time.Hour)
+	policy := &permtypes.Policy{
+		Id:           sdk.NewUint(10),
+		Principal:    permtypes.NewPrincipalWithAccount(operator),
+		ResourceType: resource.RESOURCE_TYPE_BUCKET,
+		ResourceId:   bucketInfo.Id,
+		Statements: []*permtypes.Statement{
+			{
+				Effect:         permtypes.EFFECT_ALLOW,
+				Actions:        []permtypes.ActionType{permtypes.ACTION_DELETE_BUCKET},
+				ExpirationTime: &expired,
+			},
+			{
+				Effect:  permtypes.EFFECT_ALLOW,
+				Actions: []permtypes.ActionType{permtypes.ACTION_DELETE_BUCKET},
+			},
+		},
+	}
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(policy, true).AnyTimes()
+
+	res, err = s.queryClient.ExplainPermission(s.ctx, &types.QueryExplainPermissionRequest{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ActionType: permtypes.ACTION_DELETE_BUCKET,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, res.Effect)
+	s.Require().Len(res.Trace, 3)
+	s.Require().Equal(types.TRACE_STEP_STATEMENT_EXPIRED, res.Trace[0].Type)
+	s.Require().Equal(int32(0), res.Trace[0].StatementIndex)
+	s.Require().Equal(types.TRACE_STEP_ACCOUNT_POLICY, res.Trace[1].Type)
+	s.Require().Equal(policy.Id, res.Trace[1].PolicyId)
+	s.Require().Equal(int32(1), res.Trace[1].StatementIndex)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, res.Trace[1].Effect)
+	s.Require().Equal(types.TRACE_STEP_FINAL, res.Trace[2].Type)
+
+	// the explanation is consistent with the verification
+	verifyRes, err := s.queryClient.VerifyPermission(s.ctx, &types.QueryVerifyPermissionRequest{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ActionType: permtypes.ACTION_DELETE_BUCKET,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(verifyRes.Effect, res.Effect)
+}
+
+func (s *TestSuite) TestNormalizeStatements() {
+	bucketName := "bucket"
+	statements := []*permtypes.Statement{
+		{
+			Effect:  permtypes.EFFECT_ALLOW,
+			Actions: []permtypes.ActionType{permtypes.ACTION_GET_OBJECT},
+			Resources: []string{
+				types2.NewObjectGRN(bucketName, "a*").String(),
+				types2.NewObjectGRN(bucketName, "a*").String(),
+			},
+		},
+	}
+	err := s.storageKeeper.NormalizeStatements(statements)
+	s.Require().NoError(err)
+	s.Require().Len(statements[0].Resources, 1)
+
+	statements[0].Resources = []string{types2.NewObjectGRN(bucketName, "(").String()}
+	err = s.storageKeeper.NormalizeStatements(statements)
+	s.Require().ErrorIs(err, permtypes.ErrInvalidStatement)
+}
+
+// BenchmarkVerifyObjectPermission verifies the object permission against a bucket policy with hundreds of
+// wildcard statements, the resource patterns are compiled once and then served from the keeper's cache.
+func BenchmarkVerifyObjectPermission(b *testing.B) {
+	for _, num := range []int{100, 500} {
+		b.Run(fmt.Sprintf("statements_%d", num), func(b *testing.B) {
+			encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockTime(time.Now())
+
+			ctrl := gomock.NewController(b)
+			permissionKeeper := types.NewMockPermissionKeeper(ctrl)
+			k := keeper.NewKeeper(
+				encCfg.Codec,
+				key,
+				key,
+				types.NewMockAccountKeeper(ctrl),
+				types.NewMockSpKeeper(ctrl),
+				types.NewMockPaymentKeeper(ctrl),
+				permissionKeeper,
+				types.NewMockCrossChainKeeper(ctrl),
+				types.NewMockVirtualGroupKeeper(ctrl),
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+
+			bucketName := "bucket"
+			operator := sample.RandAccAddress()
+			bucketInfo := &types.BucketInfo{
+				Owner:      sample.RandAccAddress().String(),
+				BucketName: bucketName,
+				Id:         sdk.NewUint(1),
+			}
+			objectInfo := &types.ObjectInfo{
+				Owner:      bucketInfo.Owner,
+				BucketName: bucketName,
+				ObjectName: fmt.Sprintf("prefix_%d/object", num-1),
+				Id:         sdk.NewUint(1),
+			}
+			statements := make([]*permtypes.Statement, 0, num)
+			for i := 0; i < num; i++ {
+				statements = append(statements, &permtypes.Statement{
+					Effect:    permtypes.EFFECT_ALLOW,
+					Actions:   []permtypes.ActionType{permtypes.ACTION_GET_OBJECT},
+					Resources: []string{types2.NewObjectGRN(bucketName, fmt.Sprintf("prefix_%d/*", i)).String()},
+				})
+			}
+			policy := &permtypes.Policy{
+				Principal:    permtypes.NewPrincipalWithAccount(operator),
+				ResourceType: resource.RESOURCE_TYPE_BUCKET,
+				ResourceId:   bucketInfo.Id,
+				Statements:   statements,
+			}
+			if err := k.NormalizeStatements(policy.Statements); err != nil {
+				b.Fatal(err)
+			}
+			permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(policy, true).AnyTimes()
+			permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, false).AnyTimes()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				effect := k.VerifyObjectPermission(ctx, bucketInfo, objectInfo, operator, permtypes.ACTION_GET_OBJECT)
+				if effect != permtypes.EFFECT_ALLOW {
+					b.Fatalf("unexpected effect %s", effect)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// MintReadVoucher mints a read voucher of the bucket to the holder. The read quota of the voucher is prepaid for the
+// whole duration at the current read price by the payment account of the bucket, and is paid out to the gvg family
+// of the bucket and the validator tax pool as the voucher is redeemed.
+func (k Keeper) MintReadVoucher(ctx sdk.Context, operator sdk.AccAddress, bucketName string, holder sdk.AccAddress,
+	readQuota, duration uint64,
+) (uint64, error) {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return 0, types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return 0, err
+	}
+	if !operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner)) {
+		return 0, types.ErrAccessDenied.Wrapf("only the bucket owner can mint the read voucher of the bucket(%s)", bucketName)
+	}
+
+	fee, taxFee, err := k.GetReadVoucherFee(ctx, readQuota, duration)
+	if err != nil {
+		return 0, err
+	}
+	paymentAddr := sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
+	streamRecord, found := k.paymentKeeper.GetStreamRecord(ctx, paymentAddr)
+	if !found {
+		return 0, paymenttypes.ErrStreamRecordNotFound
+	}
+	if streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN {
+		return 0, paymenttypes.ErrInvalidStreamAccountStatus.Wrapf("the payment account %s is frozen", paymentAddr)
+	}
+	_, err = k.paymentKeeper.UpdateStreamRecordByAddr(ctx, paymenttypes.NewDefaultStreamRecordChangeWithAddr(paymentAddr).
+		WithStaticBalanceChange(fee.Add(taxFee).Neg()))
+	if err != nil {
+		return 0, fmt.Errorf("pay for read voucher failed: %s %w", bucketName, err)
+	}
+
+	return k.paymentKeeper.CreateReadVoucher(ctx, &paymenttypes.ReadVoucher{
+		Issuer:         operator.String(),
+		BucketId:       bucketInfo.Id,
+		Holder:         holder.String(),
+		ReadQuota:      readQuota,
+		ExpireTime:     ctx.BlockTime().Unix() + int64(duration),
+		PaymentAddress: paymentAddr.String(),
+		Fee:            fee,
+		TaxFee:         taxFee,
+	}), nil
+}
+
+// GetReadVoucherFee returns the fee to the primary sp and the validator tax of a read voucher at the current price.
+func (k Keeper) GetReadVoucherFee(ctx sdk.Context, readQuota, duration uint64) (fee, taxFee sdkmath.Int, err error) {
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, ctx.BlockTime().Unix())
+	if err != nil {
+		return fee, taxFee, fmt.Errorf("get storage price failed: %d %w", ctx.BlockTime().Unix(), err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, ctx.BlockTime().Unix())
+	if err != nil {
+		return fee, taxFee, fmt.Errorf("failed to get validator tax rate: %d %w", ctx.BlockTime().Unix(), err)
+	}
+	fee = price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(readQuota)).MulInt(sdkmath.NewIntFromUint64(duration)).TruncateInt()
+	taxFee = versionedParams.ValidatorTaxRate.MulInt(fee).TruncateInt()
+	return fee, taxFee, nil
+}
+
+// RedeemReadVoucher debits the bytes downloaded by the holder from the read voucher, only the primary sp of the
+// bucket can redeem the voucher, with the signature of the holder over the chain id, the voucher id, the read bytes
+// and the nonce.
+func (k Keeper) RedeemReadVoucher(ctx sdk.Context, operator sdk.AccAddress, voucherId, readBytes, nonce uint64,
+	holderSignBytes, holderSignature []byte,
+) error {
+	readVoucher, found := k.paymentKeeper.GetReadVoucher(ctx, voucherId)
+	if !found {
+		return paymenttypes.ErrReadVoucherNotFound.Wrapf("read voucher %d", voucherId)
+	}
+	bucketInfo, found := k.GetBucketInfoById(ctx, readVoucher.BucketId)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	sp := k.MustGetPrimarySPForBucket(ctx, bucketInfo)
+	if sp.OperatorAddress != operator.String() {
+		return types.ErrAccessDenied.Wrapf("only the primary sp of the bucket(%s) can redeem the read voucher", bucketInfo.BucketName)
+	}
+
+	err := gnfdtypes.VerifySignature(sdk.MustAccAddressFromHex(readVoucher.Holder), sdk.Keccak256(holderSignBytes), holderSignature)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidApproval, "verify holder signature error: %s", err)
+	}
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if !found {
+		return fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+	}
+
+	_, err = k.paymentKeeper.RedeemReadVoucher(ctx, voucherId, readVoucher.BucketId, readBytes, nonce,
+		sdk.MustAccAddressFromHex(gvgFamily.VirtualPaymentAddress))
+	return err
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// SetBucketRequesterPays enables or disables the readers paying for their own read quota of the bucket. The read quota
+// bought by the readers already is kept when it is disabled, the readers can cancel it by themselves.
+func (k Keeper) SetBucketRequesterPays(ctx sdk.Context, operator sdk.AccAddress, bucketName string, enabled bool) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if operator.String() != bucketInfo.Owner {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can set requester pays", bucketInfo.Owner)
+	}
+	if enabled && bucketInfo.ChargedReadQuota != 0 {
+		return types.ErrRequesterPaysChargedQuota.Wrapf("update the charged read quota of the bucket(%s) to zero first", bucketName)
+	}
+	bucketInfo.RequesterPays = enabled
+	k.SetBucketInfo(ctx, bucketInfo)
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketRequesterPays{
+		BucketName:    bucketInfo.BucketName,
+		BucketId:      bucketInfo.Id,
+		RequesterPays: bucketInfo.RequesterPays,
+	})
+}
+
+// PurchaseReaderQuota sets the read quota the reader buys for a requester-pays bucket, the read quota is charged from
+// the payment account of the reader at the current read price. Zero read quota cancels the read quota of the reader.
+func (k Keeper) PurchaseReaderQuota(ctx sdk.Context, reader sdk.AccAddress, bucketName, paymentAddress string, readQuota uint64) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if readQuota != 0 {
+		if err := bucketInfo.CheckBucketStatus(); err != nil {
+			return err
+		}
+		if !bucketInfo.RequesterPays {
+			return types.ErrRequesterPaysNotEnabled.Wrapf("bucket(%s)", bucketName)
+		}
+	}
+
+	paymentAcc := reader
+	if paymentAddress != "" {
+		paymentAcc = sdk.MustAccAddressFromHex(paymentAddress)
+		if !paymentAcc.Equals(reader) && !k.paymentKeeper.IsPaymentAccountOwner(ctx, paymentAcc, reader) {
+			return paymenttypes.ErrNotPaymentAccountOwner
+		}
+	}
+
+	// the previous read quota is uncharged at the rates it was charged
+	if prev, found := k.GetReaderQuota(ctx, bucketInfo.Id, reader); found {
+		if err := k.unChargeReaderQuota(ctx, prev); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if readQuota == 0 {
+		store.Delete(types.GetReaderQuotaKey(bucketInfo.Id, reader))
+	} else {
+		flows, err := k.getReadQuotaFlows(ctx, bucketInfo.GlobalVirtualGroupFamilyId, readQuota, ctx.BlockTime().Unix())
+		if err != nil {
+			return err
+		}
+		err = k.paymentKeeper.ApplyUserFlowsList(ctx, []paymenttypes.UserFlows{{From: paymentAcc, Flows: flows}})
+		if err != nil {
+			return types.ErrChargeFailed.Wrapf("charge reader quota error: %s", err)
+		}
+		readerQuota := &types.ReaderQuota{
+			BucketId:       bucketInfo.Id,
+			Reader:         reader.String(),
+			PaymentAddress: paymentAcc.String(),
+			ReadQuota:      readQuota,
+			Flows:          flows,
+		}
+		store.Set(types.GetReaderQuotaKey(bucketInfo.Id, reader), k.cdc.MustMarshal(readerQuota))
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventPurchaseReaderQuota{
+		Reader:         reader.String(),
+		BucketName:     bucketInfo.BucketName,
+		BucketId:       bucketInfo.Id,
+		PaymentAddress: paymentAcc.String(),
+		ReadQuota:      readQuota,
+	})
+}
+
+func (k Keeper) GetReaderQuota(ctx sdk.Context, bucketId sdkmath.Uint, reader sdk.AccAddress) (*types.ReaderQuota, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetReaderQuotaKey(bucketId, reader))
+	if bz == nil {
+		return nil, false
+	}
+
+	var readerQuota types.ReaderQuota
+	k.cdc.MustUnmarshal(bz, &readerQuota)
+	return &readerQuota, true
+}
+
+// hasReaderQuota returns whether the operator has bought the read quota of the requester-pays bucket, the storage
+// providers only serve the object data of the bucket to the operators charged for it.
+func (k Keeper) hasReaderQuota(ctx sdk.Context, bucketInfo *types.BucketInfo, operator sdk.AccAddress) bool {
+	readerQuota, found := k.GetReaderQuota(ctx, bucketInfo.Id, operator)
+	return found && readerQuota.ReadQuota > 0
+}
+
+func (k Keeper) unChargeReaderQuota(ctx sdk.Context, readerQuota *types.ReaderQuota) error {
+	err := k.paymentKeeper.ApplyUserFlowsList(ctx, []paymenttypes.UserFlows{{
+		From:  sdk.MustAccAddressFromHex(readerQuota.PaymentAddress),
+		Flows: getNegFlows(readerQuota.Flows),
+	}})
+	if err != nil {
+		return types.ErrChargeFailed.Wrapf("uncharge reader quota error: %s", err)
+	}
+	return nil
+}
+
+// unChargeReaderQuotas cancels the read quota bought by all the readers of the bucket, it is used when the bucket is deleted.
+func (k Keeper) unChargeReaderQuotas(ctx sdk.Context, bucketId sdkmath.Uint) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetReaderQuotaKeyOnlyBucketPrefix(bucketId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var readerQuota types.ReaderQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &readerQuota)
+		if err := k.unChargeReaderQuota(ctx, &readerQuota); err != nil {
+			return err
+		}
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// MoveReaderQuotas moves the read quota bought by the readers of the bucket from the src gvg family to the dst gvg
+// family at the rates it was charged, it is used when the migration of the bucket is completed.
+func (k Keeper) MoveReaderQuotas(ctx sdk.Context, bucketId sdkmath.Uint, srcGvgFamilyId, dstGvgFamilyId uint32) error {
+	srcGvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, srcGvgFamilyId)
+	if !found {
+		return fmt.Errorf("get GVG family failed: %d", srcGvgFamilyId)
+	}
+	dstGvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, dstGvgFamilyId)
+	if !found {
+		return fmt.Errorf("get GVG family failed: %d", dstGvgFamilyId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetReaderQuotaKeyOnlyBucketPrefix(bucketId))
+	defer iterator.Close()
+
+	var readerQuotas []*types.ReaderQuota
+	for ; iterator.Valid(); iterator.Next() {
+		var readerQuota types.ReaderQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &readerQuota)
+		readerQuotas = append(readerQuotas, &readerQuota)
+	}
+
+	for _, readerQuota := range readerQuotas {
+		if err := k.unChargeReaderQuota(ctx, readerQuota); err != nil {
+			return err
+		}
+		flows := make([]paymenttypes.OutFlow, 0, len(readerQuota.Flows))
+		for _, flow := range readerQuota.Flows {
+			if flow.ToAddress == srcGvgFamily.VirtualPaymentAddress {
+				flow.ToAddress = dstGvgFamily.VirtualPaymentAddress
+			}
+			flows = append(flows, flow)
+		}
+		err := k.paymentKeeper.ApplyUserFlowsList(ctx, []paymenttypes.UserFlows{{
+			From:  sdk.MustAccAddressFromHex(readerQuota.PaymentAddress),
+			Flows: flows,
+		}})
+		if err != nil {
+			return types.ErrChargeFailed.Wrapf("charge reader quota error: %s", err)
+		}
+		readerQuota.Flows = flows
+		store.Set(types.GetReaderQuotaKey(bucketId, sdk.MustAccAddressFromHex(readerQuota.Reader)), k.cdc.MustMarshal(readerQuota))
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestSetBucketRequesterPays() {
+	owner := sample.RandAccAddress()
+	bucketInfo := &types.BucketInfo{
+		Owner:            owner.String(),
+		BucketName:       "bucketname",
+		Id:               sdk.NewUint(1),
+		Visibility:       types.VISIBILITY_TYPE_PUBLIC_READ,
+		ChargedReadQuota: 100,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	_, err := s.msgServer.SetBucketRequesterPays(s.ctx, types.NewMsgSetBucketRequesterPays(sample.RandAccAddress(), bucketInfo.BucketName, true))
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	// the bucket can not pay for the read quota and let the readers pay at the same time
+	_, err = s.msgServer.SetBucketRequesterPays(s.ctx, types.NewMsgSetBucketRequesterPays(owner, bucketInfo.BucketName, true))
+	s.Require().ErrorIs(err, types.ErrRequesterPaysChargedQuota)
+
+	bucketInfo.ChargedReadQuota = 0
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	_, err = s.msgServer.SetBucketRequesterPays(s.ctx, types.NewMsgSetBucketRequesterPays(owner, bucketInfo.BucketName, true))
+	s.Require().NoError(err)
+	bucketInfo, _ = s.storageKeeper.GetBucketInfo(s.ctx, bucketInfo.BucketName)
+	s.Require().True(bucketInfo.RequesterPays)
+
+	// the public bucket can only be read by known readers, and its object data only by the readers with read quota
+	s.Require().Equal(permtypes.EFFECT_DENY,
+		s.storageKeeper.VerifyBucketPermission(s.ctx, bucketInfo, sdk.AccAddress{}, permtypes.ACTION_LIST_OBJECT, nil))
+	s.Require().Equal(permtypes.EFFECT_ALLOW,
+		s.storageKeeper.VerifyBucketPermission(s.ctx, bucketInfo, sample.RandAccAddress(), permtypes.ACTION_LIST_OBJECT, nil))
+	s.Require().Equal(permtypes.EFFECT_DENY,
+		s.storageKeeper.VerifyBucketPermission(s.ctx, bucketInfo, sample.RandAccAddress(), permtypes.ACTION_GET_OBJECT, nil))
+	s.Require().Equal(permtypes.EFFECT_DENY,
+		s.storageKeeper.VerifyBucketPermission(s.ctx, bucketInfo, owner, permtypes.ACTION_GET_OBJECT, nil))
+}
+
+func (s *TestSuite) TestPurchaseReaderQuota() {
+	owner := sample.RandAccAddress()
+	reader := sample.RandAccAddress()
+	readerPaymentAcc := sample.RandAccAddress()
+
+	primarySp := &sptypes.StorageProvider{Id: 1, OperatorAddress: sample.RandAccAddress().String()}
+	s.spKeeper.EXPECT().MustGetStorageProvider(gomock.Any(), primarySp.Id).Return(primarySp).AnyTimes()
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: primarySp.Id, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gvgFamily.Id).Return(gvgFamily, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice: sdk.NewDec(1),
+	}, nil).AnyTimes()
+	versionedParams := paymenttypes.DefaultParams().VersionedParams
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).Return(versionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), readerPaymentAcc, reader).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	var appliedFlows []paymenttypes.UserFlows
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, userFlows []paymenttypes.UserFlows) error {
+			appliedFlows = append(appliedFlows, userFlows...)
+			return nil
+		}).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             owner.String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{})
+
+	_, err := s.msgServer.PurchaseReaderQuota(s.ctx, types.NewMsgPurchaseReaderQuota(reader, bucketInfo.BucketName, nil, 1000))
+	s.Require().ErrorIs(err, types.ErrRequesterPaysNotEnabled)
+
+	bucketInfo.RequesterPays = true
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	_, err = s.msgServer.PurchaseReaderQuota(s.ctx, types.NewMsgPurchaseReaderQuota(reader, bucketInfo.BucketName, owner, 1000))
+	s.Require().ErrorIs(err, paymenttypes.ErrNotPaymentAccountOwner)
+
+	// the reader pays for the read quota to the primary sp and the validator tax pool
+	_, err = s.msgServer.PurchaseReaderQuota(s.ctx, types.NewMsgPurchaseReaderQuota(reader, bucketInfo.BucketName, nil, 1000))
+	s.Require().NoError(err)
+	s.Require().Len(appliedFlows, 1)
+	s.Require().Equal(reader, appliedFlows[0].From)
+	s.Require().Equal(gvgFamily.VirtualPaymentAddress, appliedFlows[0].Flows[0].ToAddress)
+	s.Require().Equal(sdk.NewInt(1000), appliedFlows[0].Flows[0].Rate)
+	s.Require().Equal(versionedParams.ValidatorTaxRate.MulInt64(1000).TruncateInt(), appliedFlows[0].Flows[1].Rate)
+
+	res, err := s.queryClient.ReaderQuota(s.ctx, &types.QueryReaderQuotaRequest{BucketName: bucketInfo.BucketName, Reader: reader.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1000), res.ReaderQuota.ReadQuota)
+	s.Require().Equal(reader.String(), res.ReaderQuota.PaymentAddress)
+
+	// only the reader with read quota reads the object data of the bucket
+	objectInfo := &types.ObjectInfo{
+		Owner:      owner.String(),
+		BucketName: bucketInfo.BucketName,
+		ObjectName: "object",
+		Id:         sdk.NewUint(1),
+		Visibility: types.VISIBILITY_TYPE_PUBLIC_READ,
+	}
+	s.Require().Equal(permtypes.EFFECT_ALLOW,
+		s.storageKeeper.VerifyObjectPermission(s.ctx, bucketInfo, objectInfo, reader, permtypes.ACTION_GET_OBJECT))
+	s.Require().Equal(permtypes.EFFECT_DENY,
+		s.storageKeeper.VerifyObjectPermission(s.ctx, bucketInfo, objectInfo, owner, permtypes.ACTION_GET_OBJECT))
+
+	// buying again moves the read quota to the new payment account
+	_, err = s.msgServer.PurchaseReaderQuota(s.ctx, types.NewMsgPurchaseReaderQuota(reader, bucketInfo.BucketName, readerPaymentAcc, 2000))
+	s.Require().NoError(err)
+	s.Require().Len(appliedFlows, 3)
+	s.Require().Equal(reader, appliedFlows[1].From)
+	s.Require().Equal(sdk.NewInt(-1000), appliedFlows[1].Flows[0].Rate)
+	s.Require().Equal(readerPaymentAcc, appliedFlows[2].From)
+	s.Require().Equal(sdk.NewInt(2000), appliedFlows[2].Flows[0].Rate)
+
+	// the read quota of the readers is moved to the dst gvg family of the bucket migration
+	dstGvgFamily := &vgtypes.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 2, VirtualPaymentAddress: sample.RandAccAddress().String()}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), dstGvgFamily.Id).Return(dstGvgFamily, true).AnyTimes()
+	appliedFlows = nil
+	err = s.storageKeeper.MoveReaderQuotas(s.ctx, bucketInfo.Id, gvgFamily.Id, dstGvgFamily.Id)
+	s.Require().NoError(err)
+	s.Require().Len(appliedFlows, 2)
+	s.Require().Equal(gvgFamily.VirtualPaymentAddress, appliedFlows[0].Flows[0].ToAddress)
+	s.Require().Equal(sdk.NewInt(-2000), appliedFlows[0].Flows[0].Rate)
+	s.Require().Equal(dstGvgFamily.VirtualPaymentAddress, appliedFlows[1].Flows[0].ToAddress)
+	s.Require().Equal(sdk.NewInt(2000), appliedFlows[1].Flows[0].Rate)
+	s.Require().Equal(appliedFlows[0].Flows[1].Rate.Neg(), appliedFlows[1].Flows[1].Rate)
+	readerQuota, found := s.storageKeeper.GetReaderQuota(s.ctx, bucketInfo.Id, reader)
+	s.Require().True(found)
+	s.Require().Equal(dstGvgFamily.VirtualPaymentAddress, readerQuota.Flows[0].ToAddress)
+
+	// the read quota of the readers is cancelled with the bucket
+	reader2 := sample.RandAccAddress()
+	_, err = s.msgServer.PurchaseReaderQuota(s.ctx, types.NewMsgPurchaseReaderQuota(reader2, bucketInfo.BucketName, nil, 1000))
+	s.Require().NoError(err)
+	appliedFlows = nil
+	_, _, err = s.storageKeeper.ForceDeleteBucket(s.ctx, bucketInfo.Id, 10)
+	s.Require().NoError(err)
+	var uncharged []sdk.AccAddress
+	for _, userFlows := range appliedFlows {
+		if len(userFlows.Flows) > 0 && userFlows.Flows[0].Rate.IsNegative() {
+			uncharged = append(uncharged, userFlows.From)
+		}
+	}
+	s.Require().ElementsMatch([]sdk.AccAddress{readerPaymentAcc, reader2}, uncharged)
+	_, found = s.storageKeeper.GetReaderQuota(s.ctx, bucketInfo.Id, reader2)
+	s.Require().False(found)
+}
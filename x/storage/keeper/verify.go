@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -22,5 +23,13 @@ func (k Keeper) VerifyPaymentAccount(ctx sdk.Context, paymentAddress string, own
 		}
 	}
 
+	// only the binders allowed by the owners of a multisig payment account can bind buckets to it
+	if ctx.IsUpgraded(gnfdtypes.Gobi) && !paymentAcc.Equals(ownerAcc) {
+		paymentAccount, found := k.paymentKeeper.GetPaymentAccount(ctx, paymentAcc)
+		if found && paymentAccount.IsMultisig() && !k.paymentKeeper.IsBucketBinder(ctx, paymentAcc, ownerAcc) {
+			return nil, paymenttypes.ErrNotPaymentAccountOwner.Wrapf("%s is not a bucket binder of the multisig payment account %s", ownerAcc, paymentAcc)
+		}
+	}
+
 	return paymentAcc, nil
 }
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (s *TestSuite) TestVerifyMultisigPaymentAccount() {
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Erdos || name == gnfdtypes.Gobi
+	}, s.ctx.Logger())
+
+	paymentAcc := sample.RandAccAddress()
+	binder := sample.RandAccAddress()
+	other := sample.RandAccAddress()
+	s.paymentKeeper.EXPECT().GetPaymentAccount(gomock.Any(), paymentAcc).Return(&paymenttypes.PaymentAccount{
+		Addr:      paymentAcc.String(),
+		Owner:     paymentAcc.String(),
+		Owners:    []string{sample.RandAccAddressHex(), sample.RandAccAddressHex()},
+		Threshold: 2,
+	}, true).AnyTimes()
+	s.paymentKeeper.EXPECT().IsBucketBinder(gomock.Any(), paymentAcc, binder).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().IsBucketBinder(gomock.Any(), paymentAcc, other).Return(false).AnyTimes()
+
+	// only the bucket binders can bind buckets to the multisig payment account
+	acc, err := s.storageKeeper.VerifyPaymentAccount(ctx, paymentAcc.String(), binder)
+	s.Require().NoError(err)
+	s.Require().Equal(paymentAcc, acc)
+	_, err = s.storageKeeper.VerifyPaymentAccount(ctx, paymentAcc.String(), other)
+	s.Require().ErrorIs(err, paymenttypes.ErrNotPaymentAccountOwner)
+
+	// the payment account of other accounts can be used since Erdos
+	regularAcc := sample.RandAccAddress()
+	s.paymentKeeper.EXPECT().GetPaymentAccount(gomock.Any(), regularAcc).Return(&paymenttypes.PaymentAccount{
+		Addr:  regularAcc.String(),
+		Owner: sample.RandAccAddressHex(),
+	}, true).AnyTimes()
+	acc, err = s.storageKeeper.VerifyPaymentAccount(ctx, regularAcc.String(), other)
+	s.Require().NoError(err)
+	s.Require().Equal(regularAcc, acc)
+}
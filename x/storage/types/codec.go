@@ -28,6 +28,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelMigrateBucket{}, "storage/CancelMigrateBucket", nil)
 	cdc.RegisterConcrete(&MsgRejectMigrateBucket{}, "storage/RejectMigrateBucket", nil)
 	cdc.RegisterConcrete(&MsgSetBucketFlowRateLimit{}, "storage/SetBucketFlowRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetBucketVersioning{}, "storage/SetBucketVersioning", nil)
+	cdc.RegisterConcrete(&MsgDeleteObjectVersion{}, "storage/DeleteObjectVersion", nil)
+	cdc.RegisterConcrete(&MsgSetBucketLifecycle{}, "storage/SetBucketLifecycle", nil)
+	cdc.RegisterConcrete(&MsgSetRetention{}, "storage/SetRetention", nil)
+	cdc.RegisterConcrete(&MsgSetLegalHold{}, "storage/SetLegalHold", nil)
+	cdc.RegisterConcrete(&MsgComposeObject{}, "storage/ComposeObject", nil)
+	cdc.RegisterConcrete(&MsgBatchCreateObjects{}, "storage/BatchCreateObjects", nil)
+	cdc.RegisterConcrete(&MsgBatchDeleteObjects{}, "storage/BatchDeleteObjects", nil)
+	cdc.RegisterConcrete(&MsgMintReadVoucher{}, "storage/MintReadVoucher", nil)
+	cdc.RegisterConcrete(&MsgRedeemReadVoucher{}, "storage/RedeemReadVoucher", nil)
+	cdc.RegisterConcrete(&MsgSetBucketRequesterPays{}, "storage/SetBucketRequesterPays", nil)
+	cdc.RegisterConcrete(&MsgPurchaseReaderQuota{}, "storage/PurchaseReaderQuota", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -137,6 +149,42 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetBucketFlowRateLimit{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBucketVersioning{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeleteObjectVersion{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBucketLifecycle{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRetention{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetLegalHold{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgComposeObject{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchCreateObjects{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchDeleteObjects{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMintReadVoucher{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRedeemReadVoucher{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBucketRequesterPays{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPurchaseReaderQuota{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
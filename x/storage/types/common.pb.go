@@ -182,6 +182,77 @@ func (VisibilityType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4eff6c0fa4aaf4c9, []int{4}
 }
 
+// RetentionMode defines how strictly a retention protects a bucket or an object.
+// A governance retention can be shortened or removed by the bucket owner, while a compliance
+// retention can only be extended until it expires.
+type RetentionMode int32
+
+const (
+	RETENTION_MODE_NONE       RetentionMode = 0
+	RETENTION_MODE_GOVERNANCE RetentionMode = 1
+	RETENTION_MODE_COMPLIANCE RetentionMode = 2
+)
+
+var RetentionMode_name = map[int32]string{
+	0: "RETENTION_MODE_NONE",
+	1: "RETENTION_MODE_GOVERNANCE",
+	2: "RETENTION_MODE_COMPLIANCE",
+}
+
+var RetentionMode_value = map[string]int32{
+	"RETENTION_MODE_NONE":       0,
+	"RETENTION_MODE_GOVERNANCE": 1,
+	"RETENTION_MODE_COMPLIANCE": 2,
+}
+
+func (x RetentionMode) String() string {
+	return proto.EnumName(RetentionMode_name, int32(x))
+}
+
+func (RetentionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4eff6c0fa4aaf4c9, []int{5}
+}
+
+// PaymentMismatchType represents which invariant of the payment data a PaymentMismatch breaks.
+type PaymentMismatchType int32
+
+const (
+	// the lock balance of a payment account differs from the lock fee of its objects
+	PAYMENT_MISMATCH_LOCK_BALANCE PaymentMismatchType = 0
+	// the net flow rate of a payment account differs from the bills of its buckets
+	PAYMENT_MISMATCH_USER_NET_FLOW_RATE PaymentMismatchType = 1
+	// the net flow rate of a gvg family/gvg/validator tax pool differs from the bills of the buckets
+	PAYMENT_MISMATCH_RECEIVER_NET_FLOW_RATE PaymentMismatchType = 2
+	// the stream record of an account expected by the buckets is not found
+	PAYMENT_MISMATCH_STREAM_RECORD_NOT_FOUND PaymentMismatchType = 3
+	// the status or the out flow count of a stream record does not match its net flow rate
+	PAYMENT_MISMATCH_STREAM_RECORD_STATUS PaymentMismatchType = 4
+)
+
+var PaymentMismatchType_name = map[int32]string{
+	0: "PAYMENT_MISMATCH_LOCK_BALANCE",
+	1: "PAYMENT_MISMATCH_USER_NET_FLOW_RATE",
+	2: "PAYMENT_MISMATCH_RECEIVER_NET_FLOW_RATE",
+	3: "PAYMENT_MISMATCH_STREAM_RECORD_NOT_FOUND",
+	4: "PAYMENT_MISMATCH_STREAM_RECORD_STATUS",
+}
+
+var PaymentMismatchType_value = map[string]int32{
+	"PAYMENT_MISMATCH_LOCK_BALANCE":            0,
+	"PAYMENT_MISMATCH_USER_NET_FLOW_RATE":      1,
+	"PAYMENT_MISMATCH_RECEIVER_NET_FLOW_RATE":  2,
+	"PAYMENT_MISMATCH_STREAM_RECORD_NOT_FOUND": 3,
+	"PAYMENT_MISMATCH_STREAM_RECORD_STATUS":    4,
+}
+
+func (x PaymentMismatchType) String() string {
+	return proto.EnumName(PaymentMismatchType_name, int32(x))
+}
+
+func (PaymentMismatchType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4eff6c0fa4aaf4c9, []int{6}
+}
+
 // SecondarySpSealObjectSignDoc used to generate seal signature of secondary SP
 // If the secondary SP only signs the checksum to declare the object pieces are saved,
 // it might be reused by the primary SP to fake it's declaration.
@@ -553,6 +624,8 @@ func init() {
 	proto.RegisterEnum("greenfield.storage.RedundancyType", RedundancyType_name, RedundancyType_value)
 	proto.RegisterEnum("greenfield.storage.ObjectStatus", ObjectStatus_name, ObjectStatus_value)
 	proto.RegisterEnum("greenfield.storage.VisibilityType", VisibilityType_name, VisibilityType_value)
+	proto.RegisterEnum("greenfield.storage.RetentionMode", RetentionMode_name, RetentionMode_value)
+	proto.RegisterEnum("greenfield.storage.PaymentMismatchType", PaymentMismatchType_name, PaymentMismatchType_value)
 	proto.RegisterType((*SecondarySpSealObjectSignDoc)(nil), "greenfield.storage.SecondarySpSealObjectSignDoc")
 	proto.RegisterType((*GVGMapping)(nil), "greenfield.storage.GVGMapping")
 	proto.RegisterType((*SecondarySpMigrationBucketSignDoc)(nil), "greenfield.storage.SecondarySpMigrationBucketSignDoc")
@@ -564,67 +637,77 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/common.proto", fileDescriptor_4eff6c0fa4aaf4c9) }
 
 var fileDescriptor_4eff6c0fa4aaf4c9 = []byte{
-	// 946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xe3, 0x44,
-	0x18, 0x8e, 0xd3, 0x00, 0xed, 0x6c, 0xb7, 0x4d, 0x4d, 0xa1, 0x6d, 0x82, 0x92, 0x92, 0x03, 0x2a,
-	0x95, 0xda, 0x1c, 0x10, 0xd2, 0x4a, 0xf4, 0x62, 0x3b, 0xde, 0xec, 0xb0, 0xa9, 0x13, 0xcd, 0x38,
-	0x95, 0xca, 0x65, 0xe4, 0xd8, 0x53, 0x77, 0xa8, 0xe3, 0xb1, 0x3c, 0x13, 0x96, 0x2e, 0x2f, 0x80,
-	0xe0, 0x82, 0x78, 0x01, 0x0e, 0x1c, 0x78, 0x81, 0x7d, 0x05, 0xd0, 0x1e, 0x57, 0x7b, 0x42, 0x1c,
-	0x56, 0xa8, 0x7d, 0x11, 0x64, 0x8f, 0x93, 0x4d, 0x20, 0x54, 0x2b, 0x38, 0x25, 0xf3, 0x7d, 0x9f,
-	0xe7, 0xff, 0xfe, 0xef, 0xf7, 0x78, 0x40, 0x33, 0x4c, 0x29, 0x8d, 0x2f, 0x18, 0x8d, 0x82, 0xb6,
-	0x90, 0x3c, 0xf5, 0x42, 0xda, 0xf6, 0xf9, 0x78, 0xcc, 0xe3, 0xe3, 0x24, 0xe5, 0x92, 0xeb, 0xfa,
-	0x6b, 0xc1, 0x71, 0x21, 0xa8, 0xed, 0xf9, 0x5c, 0x8c, 0xb9, 0x20, 0xb9, 0xa2, 0xad, 0x16, 0x4a,
-	0x5e, 0xdb, 0x0e, 0x79, 0xc8, 0x15, 0x9e, 0xfd, 0x53, 0x68, 0xeb, 0x37, 0x0d, 0x7c, 0x80, 0xa9,
-	0xcf, 0xe3, 0xc0, 0x4b, 0xaf, 0x71, 0x82, 0xa9, 0x17, 0xf5, 0x47, 0x5f, 0x52, 0x5f, 0x62, 0x16,
-	0xc6, 0x1d, 0xee, 0xeb, 0x7b, 0x60, 0xd5, 0xbf, 0xf4, 0x58, 0x4c, 0x58, 0xb0, 0xab, 0xed, 0x6b,
-	0x07, 0x6b, 0xe8, 0x9d, 0x7c, 0x0d, 0x03, 0xfd, 0x53, 0xb0, 0x13, 0x46, 0x7c, 0xe4, 0x45, 0xe4,
-	0x2b, 0x96, 0xca, 0x89, 0x17, 0x91, 0x30, 0xe5, 0x93, 0x24, 0x53, 0x96, 0xf7, 0xb5, 0x83, 0xfb,
-	0x68, 0x5b, 0xd1, 0x67, 0x8a, 0xed, 0x66, 0x24, 0x0c, 0xf4, 0x07, 0x60, 0x8d, 0xe7, 0x25, 0x32,
-	0xe1, 0x4a, 0xb6, 0xa5, 0x59, 0x7f, 0xfe, 0xaa, 0x59, 0xfa, 0xe3, 0x55, 0xb3, 0x32, 0x64, 0xb1,
-	0x7c, 0xf9, 0xec, 0xe8, 0x5e, 0xe1, 0x3c, 0x5b, 0xa2, 0x55, 0xa5, 0x86, 0x81, 0x5e, 0xcb, 0xbc,
-	0x50, 0xff, 0x4a, 0x4c, 0xc6, 0xbb, 0x95, 0x7d, 0xed, 0x60, 0x1d, 0xcd, 0xd6, 0xad, 0x5f, 0x35,
-	0x00, 0xba, 0x67, 0xdd, 0x53, 0x2f, 0x49, 0x58, 0x1c, 0xea, 0x27, 0xa0, 0x2e, 0x52, 0x9f, 0xfc,
-	0x9b, 0x3f, 0x2d, 0xf7, 0xb7, 0x23, 0x52, 0xbf, 0xbb, 0xcc, 0xe2, 0x09, 0xa8, 0x07, 0x42, 0x92,
-	0xbb, 0xbb, 0xdb, 0x09, 0x84, 0x5c, 0xfa, 0xf4, 0x67, 0xa0, 0x26, 0xa6, 0x91, 0x12, 0x91, 0x90,
-	0x51, 0x24, 0x88, 0x60, 0x61, 0xec, 0xc9, 0x49, 0x4a, 0xf3, 0x8e, 0xd7, 0xd1, 0x8e, 0x78, 0x1d,
-	0xba, 0x19, 0x09, 0x3c, 0xa5, 0x5b, 0x3f, 0x95, 0xc1, 0x87, 0x73, 0x03, 0x39, 0x65, 0x61, 0xea,
-	0x49, 0xc6, 0x63, 0x73, 0xe2, 0x5f, 0xd1, 0x37, 0x99, 0xca, 0xc7, 0x60, 0x2b, 0xf3, 0x9e, 0xa4,
-	0x6c, 0x5c, 0xd4, 0x9f, 0x39, 0xde, 0x08, 0x84, 0x1c, 0x28, 0x1c, 0x17, 0x6d, 0xde, 0x15, 0xd2,
-	0xca, 0xff, 0x0a, 0xa9, 0x72, 0x77, 0x48, 0x0f, 0xc0, 0xda, 0x28, 0x6f, 0x29, 0xd3, 0xbe, 0xf5,
-	0x06, 0x6f, 0x81, 0x52, 0xc3, 0xa0, 0xf5, 0x8b, 0x06, 0xb6, 0x7a, 0xdc, 0x5f, 0xdc, 0x51, 0xdf,
-	0x00, 0xe5, 0xd9, 0x5c, 0xcb, 0xec, 0x3f, 0xbf, 0x9c, 0x4d, 0x70, 0x2f, 0x3b, 0x4b, 0x34, 0x20,
-	0x82, 0x3d, 0x55, 0xc3, 0xaa, 0x20, 0xa0, 0x20, 0xcc, 0x9e, 0x52, 0xfd, 0x10, 0x6c, 0x49, 0x2e,
-	0xbd, 0x88, 0xf8, 0x97, 0x5e, 0x1a, 0x52, 0x25, 0xab, 0xe4, 0xb2, 0xcd, 0x9c, 0xb0, 0x72, 0x3c,
-	0xd3, 0xb6, 0xbe, 0x01, 0xef, 0xaa, 0xb1, 0x3d, 0x8c, 0xf8, 0x13, 0xe4, 0x49, 0xda, 0x63, 0x63,
-	0x26, 0xf5, 0x00, 0x6c, 0x5e, 0x44, 0xfc, 0x09, 0x49, 0x3d, 0x49, 0x49, 0x94, 0x41, 0x6a, 0x86,
-	0xe6, 0x49, 0x11, 0xc0, 0x47, 0x21, 0x93, 0x97, 0x93, 0xd1, 0xb1, 0xcf, 0xc7, 0xc5, 0x19, 0x2e,
-	0x7e, 0x8e, 0x44, 0x70, 0xd5, 0x96, 0xd7, 0x09, 0x15, 0xc7, 0x30, 0x8f, 0x08, 0x14, 0x11, 0xc1,
-	0x58, 0xa2, 0xfb, 0x17, 0xf3, 0x55, 0x5a, 0xdf, 0x69, 0x60, 0x6f, 0x49, 0x75, 0x2c, 0x3d, 0x39,
-	0x11, 0x59, 0x1b, 0x4c, 0x90, 0x62, 0x02, 0xb9, 0x07, 0xaa, 0xd2, 0x5b, 0x45, 0x9b, 0x4c, 0xa8,
-	0xe7, 0x7a, 0x0a, 0xd6, 0x0d, 0xb0, 0x99, 0x78, 0xd7, 0x63, 0x1a, 0x4b, 0xe2, 0x05, 0x41, 0x4a,
-	0x85, 0xc8, 0x23, 0x5c, 0x33, 0x77, 0x5f, 0x3e, 0x3b, 0xda, 0x2e, 0x1c, 0x18, 0x8a, 0xc1, 0x32,
-	0x65, 0x71, 0x88, 0x36, 0x8a, 0x07, 0x0a, 0xf4, 0xf0, 0x7b, 0x0d, 0x00, 0xcc, 0x27, 0xa9, 0x4f,
-	0xdd, 0xeb, 0x84, 0xea, 0xef, 0x03, 0x1d, 0xf7, 0x87, 0xc8, 0xb2, 0x89, 0x7b, 0x3e, 0xb0, 0x49,
-	0x1f, 0xc1, 0x2e, 0x74, 0xaa, 0x25, 0xbd, 0x01, 0x6a, 0xf3, 0xf8, 0x29, 0x44, 0xa8, 0x8f, 0xc8,
-	0xc0, 0x76, 0x3a, 0xd0, 0xe9, 0x56, 0x35, 0xbd, 0x09, 0xea, 0xf3, 0xbc, 0x89, 0x2d, 0x62, 0xa1,
-	0x3e, 0xc6, 0xc4, 0x7a, 0x64, 0x40, 0xa7, 0x5a, 0xfe, 0xfb, 0x06, 0xfd, 0xc1, 0x02, 0xbf, 0x52,
-	0xab, 0x7c, 0xfb, 0x73, 0xa3, 0x74, 0x18, 0x81, 0xf5, 0xe2, 0x38, 0xa9, 0x30, 0xf6, 0xc0, 0x7b,
-	0xe6, 0xd0, 0x7a, 0x6c, 0xbb, 0x04, 0xbb, 0x86, 0x3b, 0xc4, 0xc4, 0x42, 0xb6, 0xe1, 0xda, 0x1d,
-	0xe5, 0x68, 0x91, 0xea, 0x40, 0x6c, 0xf5, 0x1d, 0x17, 0x3a, 0x43, 0xbb, 0x53, 0xd5, 0xf4, 0x3a,
-	0xd8, 0x59, 0xe4, 0x4f, 0x61, 0x17, 0x19, 0x6e, 0x66, 0xb7, 0x5c, 0x54, 0x7b, 0x0c, 0x36, 0x10,
-	0x0d, 0x26, 0x71, 0xe0, 0xc5, 0xfe, 0xf5, 0xb4, 0x7d, 0x64, 0x77, 0x86, 0x4e, 0xc7, 0x70, 0xac,
-	0x73, 0x62, 0x5b, 0xb9, 0xd9, 0x6a, 0x29, 0xdb, 0x6c, 0x0e, 0x47, 0xf6, 0xa0, 0x07, 0x2d, 0x43,
-	0x91, 0x5a, 0xb1, 0x19, 0x03, 0xeb, 0xc5, 0xf7, 0x79, 0x66, 0xbd, 0x6f, 0x7e, 0x6e, 0x5b, 0x4b,
-	0xac, 0xef, 0x82, 0xed, 0x45, 0x0a, 0xdb, 0x46, 0x2f, 0x37, 0xdd, 0x00, 0xb5, 0x45, 0x66, 0xa1,
-	0xa9, 0xa9, 0xef, 0x1f, 0x35, 0xb0, 0x71, 0xc6, 0x04, 0x1b, 0xb1, 0x88, 0x49, 0x65, 0xbc, 0x09,
-	0xea, 0x67, 0x10, 0x43, 0x13, 0xf6, 0xa0, 0x7b, 0xae, 0x22, 0x1e, 0x3a, 0x78, 0x60, 0x5b, 0xf0,
-	0x21, 0xcc, 0x6b, 0x2e, 0x11, 0x0c, 0x86, 0x66, 0x0f, 0x5a, 0x04, 0xd9, 0x46, 0x91, 0xd7, 0x3f,
-	0x04, 0x08, 0x9e, 0x19, 0xae, 0x5d, 0x2d, 0x2f, 0x23, 0xa1, 0xf3, 0xc8, 0x46, 0xd0, 0x9d, 0x8e,
-	0xce, 0x84, 0xcf, 0x6f, 0x1a, 0xda, 0x8b, 0x9b, 0x86, 0xf6, 0xe7, 0x4d, 0x43, 0xfb, 0xe1, 0xb6,
-	0x51, 0x7a, 0x71, 0xdb, 0x28, 0xfd, 0x7e, 0xdb, 0x28, 0x7d, 0xd1, 0x9e, 0x3b, 0x34, 0xa3, 0x78,
-	0x74, 0x94, 0x7f, 0x0f, 0xdb, 0x73, 0x97, 0xe8, 0xd7, 0xb3, 0x6b, 0x34, 0x3f, 0x41, 0xa3, 0xb7,
-	0xf3, 0x1b, 0xf0, 0x93, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x70, 0xc6, 0x1b, 0xe5, 0x69, 0x07,
-	0x00, 0x00,
+	// 1114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xc1, 0x72, 0xdb, 0x44,
+	0x18, 0xb6, 0x1c, 0x03, 0xcd, 0x36, 0x4d, 0x5c, 0x35, 0xe0, 0xc4, 0xa6, 0x76, 0x6b, 0x06, 0x9a,
+	0x16, 0x12, 0x1f, 0x18, 0x66, 0x3a, 0x43, 0x2f, 0x92, 0xbc, 0x71, 0x97, 0x5a, 0x92, 0x67, 0x25,
+	0x9b, 0x09, 0x97, 0x1d, 0x59, 0xda, 0x2a, 0x4b, 0x65, 0xc9, 0xa3, 0x5d, 0x53, 0x52, 0x5e, 0x80,
+	0x81, 0x0b, 0xc3, 0x0b, 0x70, 0xe0, 0xc0, 0x0b, 0xf4, 0x15, 0x60, 0x7a, 0xec, 0xf4, 0xc4, 0x70,
+	0xe8, 0x30, 0xcd, 0x99, 0x77, 0x60, 0xa4, 0x55, 0x52, 0xbb, 0x31, 0xa1, 0x03, 0xa7, 0x64, 0xff,
+	0xef, 0xdb, 0xff, 0xff, 0xfe, 0xef, 0xd7, 0xae, 0x17, 0xb4, 0xc2, 0x94, 0xd2, 0xf8, 0x3e, 0xa3,
+	0x51, 0xd0, 0xe1, 0x22, 0x49, 0xbd, 0x90, 0x76, 0xfc, 0x64, 0x32, 0x49, 0xe2, 0xbd, 0x69, 0x9a,
+	0x88, 0x44, 0x55, 0x5f, 0x12, 0xf6, 0x0a, 0x42, 0x7d, 0xdb, 0x4f, 0xf8, 0x24, 0xe1, 0x24, 0x67,
+	0x74, 0xe4, 0x42, 0xd2, 0xeb, 0x9b, 0x61, 0x12, 0x26, 0x32, 0x9e, 0xfd, 0x27, 0xa3, 0xed, 0xdf,
+	0x14, 0xf0, 0xae, 0x43, 0xfd, 0x24, 0x0e, 0xbc, 0xf4, 0xc8, 0x99, 0x3a, 0xd4, 0x8b, 0xec, 0xf1,
+	0x97, 0xd4, 0x17, 0x0e, 0x0b, 0xe3, 0x6e, 0xe2, 0xab, 0xdb, 0xe0, 0x82, 0x7f, 0xe8, 0xb1, 0x98,
+	0xb0, 0x60, 0x4b, 0xb9, 0xa6, 0xec, 0xac, 0xe2, 0xb7, 0xf2, 0x35, 0x0a, 0xd4, 0x4f, 0x40, 0x2d,
+	0x8c, 0x92, 0xb1, 0x17, 0x91, 0xaf, 0x58, 0x2a, 0x66, 0x5e, 0x44, 0xc2, 0x34, 0x99, 0x4d, 0x33,
+	0x66, 0xf9, 0x9a, 0xb2, 0x73, 0x09, 0x6f, 0x4a, 0x78, 0x24, 0xd1, 0x5e, 0x06, 0xa2, 0x40, 0xbd,
+	0x0d, 0x56, 0x93, 0xbc, 0x44, 0x46, 0x5c, 0xc9, 0x52, 0xea, 0x8d, 0x27, 0xcf, 0x5b, 0xa5, 0x3f,
+	0x9e, 0xb7, 0x2a, 0x43, 0x16, 0x8b, 0x67, 0x8f, 0x77, 0x2f, 0x16, 0xca, 0xb3, 0x25, 0xbe, 0x20,
+	0xd9, 0x28, 0x50, 0xeb, 0x99, 0x16, 0xea, 0x3f, 0xe0, 0xb3, 0xc9, 0x56, 0xe5, 0x9a, 0xb2, 0xb3,
+	0x86, 0x4f, 0xd7, 0xed, 0x5f, 0x15, 0x00, 0x7a, 0xa3, 0x9e, 0xe9, 0x4d, 0xa7, 0x2c, 0x0e, 0xd5,
+	0x3b, 0xa0, 0xc1, 0x53, 0x9f, 0xfc, 0x93, 0x3e, 0x25, 0xd7, 0x57, 0xe3, 0xa9, 0xdf, 0x5b, 0x26,
+	0xf1, 0x0e, 0x68, 0x04, 0x5c, 0x90, 0xf3, 0xbb, 0xab, 0x05, 0x5c, 0x2c, 0xdd, 0xfd, 0x29, 0xa8,
+	0xf3, 0x13, 0x4b, 0x09, 0x9f, 0x92, 0x71, 0xc4, 0x09, 0x67, 0x61, 0xec, 0x89, 0x59, 0x4a, 0xf3,
+	0x8e, 0xd7, 0x70, 0x8d, 0xbf, 0x34, 0x5d, 0x8f, 0xb8, 0x73, 0x02, 0xb7, 0x7f, 0x2a, 0x83, 0xeb,
+	0x73, 0x03, 0x31, 0x59, 0x98, 0x7a, 0x82, 0x25, 0xb1, 0x3e, 0xf3, 0x1f, 0xd0, 0xd7, 0x99, 0xca,
+	0x4d, 0x70, 0x39, 0xd3, 0x3e, 0x4d, 0xd9, 0xa4, 0xa8, 0x7f, 0xaa, 0x78, 0x3d, 0xe0, 0x62, 0x20,
+	0xe3, 0x4e, 0xd1, 0xe6, 0x79, 0x26, 0xad, 0xfc, 0x2f, 0x93, 0x2a, 0xe7, 0x9b, 0x74, 0x1b, 0xac,
+	0x8e, 0xf3, 0x96, 0x32, 0xee, 0x1b, 0xaf, 0xf1, 0x15, 0x48, 0x36, 0x0a, 0xda, 0xbf, 0x28, 0xe0,
+	0x72, 0x3f, 0xf1, 0x17, 0x33, 0xaa, 0xeb, 0xa0, 0x7c, 0x3a, 0xd7, 0x32, 0xfb, 0xcf, 0x1f, 0x67,
+	0x0b, 0x5c, 0xcc, 0xce, 0x12, 0x0d, 0x08, 0x67, 0x8f, 0xe4, 0xb0, 0x2a, 0x18, 0xc8, 0x90, 0xc3,
+	0x1e, 0x51, 0xf5, 0x16, 0xb8, 0x2c, 0x12, 0xe1, 0x45, 0xc4, 0x3f, 0xf4, 0xd2, 0x90, 0x4a, 0x5a,
+	0x25, 0xa7, 0x6d, 0xe4, 0x80, 0x91, 0xc7, 0x33, 0x6e, 0xfb, 0x1b, 0x70, 0x45, 0x8e, 0x6d, 0x3f,
+	0x4a, 0x1e, 0x62, 0x4f, 0xd0, 0x3e, 0x9b, 0x30, 0xa1, 0x06, 0x60, 0xe3, 0x7e, 0x94, 0x3c, 0x24,
+	0xa9, 0x27, 0x28, 0x89, 0xb2, 0x90, 0x9c, 0xa1, 0x7e, 0xa7, 0x30, 0xe0, 0x83, 0x90, 0x89, 0xc3,
+	0xd9, 0x78, 0xcf, 0x4f, 0x26, 0xc5, 0x19, 0x2e, 0xfe, 0xec, 0xf2, 0xe0, 0x41, 0x47, 0x1c, 0x4d,
+	0x29, 0xdf, 0x43, 0xb9, 0x45, 0xa0, 0xb0, 0x08, 0xc5, 0x02, 0x5f, 0xba, 0x3f, 0x5f, 0xa5, 0xfd,
+	0x9d, 0x02, 0xb6, 0x97, 0x54, 0x77, 0x84, 0x27, 0x66, 0x3c, 0x6b, 0x83, 0x71, 0x52, 0x4c, 0x20,
+	0xd7, 0x40, 0xa5, 0x7b, 0x17, 0xf0, 0x06, 0xe3, 0x72, 0x5f, 0x5f, 0x86, 0x55, 0x0d, 0x6c, 0x4c,
+	0xbd, 0xa3, 0x09, 0x8d, 0x05, 0xf1, 0x82, 0x20, 0xa5, 0x9c, 0xe7, 0x16, 0xae, 0xea, 0x5b, 0xcf,
+	0x1e, 0xef, 0x6e, 0x16, 0x0a, 0x34, 0x89, 0x38, 0x22, 0x65, 0x71, 0x88, 0xd7, 0x8b, 0x0d, 0x45,
+	0xf4, 0xd6, 0xf7, 0x0a, 0x00, 0x4e, 0x32, 0x4b, 0x7d, 0xea, 0x1e, 0x4d, 0xa9, 0xfa, 0x0e, 0x50,
+	0x1d, 0x7b, 0x88, 0x0d, 0x48, 0xdc, 0x83, 0x01, 0x24, 0x36, 0x46, 0x3d, 0x64, 0x55, 0x4b, 0x6a,
+	0x13, 0xd4, 0xe7, 0xe3, 0x26, 0xc2, 0xd8, 0xc6, 0x64, 0x00, 0xad, 0x2e, 0xb2, 0x7a, 0x55, 0x45,
+	0x6d, 0x81, 0xc6, 0x3c, 0xae, 0x3b, 0x06, 0x31, 0xb0, 0xed, 0x38, 0xc4, 0xb8, 0xab, 0x21, 0xab,
+	0x5a, 0x7e, 0x35, 0x81, 0x3d, 0x58, 0xc0, 0x57, 0xea, 0x95, 0x6f, 0x7f, 0x6e, 0x96, 0x6e, 0x45,
+	0x60, 0xad, 0x38, 0x4e, 0xd2, 0x8c, 0x6d, 0xf0, 0xb6, 0x3e, 0x34, 0xee, 0x41, 0x97, 0x38, 0xae,
+	0xe6, 0x0e, 0x1d, 0x62, 0x60, 0xa8, 0xb9, 0xb0, 0x2b, 0x15, 0x2d, 0x42, 0x5d, 0xe4, 0x18, 0xb6,
+	0xe5, 0x22, 0x6b, 0x08, 0xbb, 0x55, 0x45, 0x6d, 0x80, 0xda, 0x22, 0x6e, 0xa2, 0x1e, 0xd6, 0xdc,
+	0x4c, 0x6e, 0xb9, 0xa8, 0x76, 0x0f, 0xac, 0x63, 0x1a, 0xcc, 0xe2, 0xc0, 0x8b, 0xfd, 0xa3, 0x93,
+	0xf6, 0x31, 0xec, 0x0e, 0xad, 0xae, 0x66, 0x19, 0x07, 0x04, 0x1a, 0xb9, 0xd8, 0x6a, 0x29, 0x4b,
+	0x36, 0x17, 0xc7, 0x70, 0xd0, 0x47, 0x86, 0x26, 0x41, 0xa5, 0x48, 0xc6, 0xc0, 0x5a, 0x71, 0x3f,
+	0x9f, 0x4a, 0xb7, 0xf5, 0xcf, 0xa0, 0xb1, 0x44, 0xfa, 0x16, 0xd8, 0x5c, 0x84, 0x1c, 0xa8, 0xf5,
+	0x73, 0xd1, 0x4d, 0x50, 0x5f, 0x44, 0x16, 0x9a, 0x3a, 0xd1, 0xfd, 0xa3, 0x02, 0xd6, 0x47, 0x8c,
+	0xb3, 0x31, 0x8b, 0x98, 0x90, 0xc2, 0x5b, 0xa0, 0x31, 0x42, 0x0e, 0xd2, 0x51, 0x1f, 0xb9, 0x07,
+	0xd2, 0xe2, 0xa1, 0xe5, 0x0c, 0xa0, 0x81, 0xf6, 0x51, 0x5e, 0x73, 0x09, 0x61, 0x30, 0xd4, 0xfb,
+	0xc8, 0x20, 0x18, 0x6a, 0x85, 0x5f, 0x67, 0x08, 0x18, 0x8d, 0x34, 0x17, 0x56, 0xcb, 0xcb, 0x40,
+	0x64, 0xdd, 0x85, 0x18, 0xb9, 0x73, 0xa3, 0xbb, 0x84, 0xa9, 0xa0, 0x71, 0x76, 0x25, 0x9a, 0x49,
+	0x40, 0xd5, 0x1a, 0xb8, 0x82, 0xa1, 0x0b, 0x2d, 0x17, 0xd9, 0x16, 0x31, 0xed, 0x2e, 0x24, 0x96,
+	0x6d, 0x65, 0x66, 0x5e, 0x05, 0xdb, 0xaf, 0x00, 0x3d, 0x7b, 0x04, 0xb1, 0xa5, 0x59, 0x06, 0xac,
+	0x2a, 0x4b, 0x60, 0xc3, 0x36, 0x07, 0x7d, 0x94, 0xc3, 0x27, 0x16, 0xfc, 0xa5, 0x80, 0x2b, 0x03,
+	0xf9, 0x25, 0x9b, 0x8c, 0x4f, 0x3c, 0xe1, 0x1f, 0xe6, 0x3e, 0x5c, 0x07, 0x57, 0x07, 0xda, 0x81,
+	0x09, 0x2d, 0x97, 0x98, 0xc8, 0x31, 0x35, 0xd7, 0xb8, 0x4b, 0xfa, 0xb6, 0x71, 0x8f, 0xe8, 0x5a,
+	0x3f, 0x4f, 0x50, 0x52, 0x6f, 0x80, 0xf7, 0xce, 0x50, 0x86, 0x0e, 0xc4, 0xc4, 0x82, 0x2e, 0xd9,
+	0xef, 0xdb, 0x9f, 0x13, 0x9c, 0x35, 0xad, 0xa8, 0x1f, 0x82, 0x1b, 0x67, 0x88, 0x18, 0x1a, 0x10,
+	0x8d, 0xce, 0x90, 0xcb, 0xea, 0x47, 0x60, 0xe7, 0x0c, 0xd9, 0x71, 0x31, 0xd4, 0xcc, 0x6c, 0x8f,
+	0x8d, 0xbb, 0xc4, 0xb2, 0x5d, 0xb2, 0x6f, 0x0f, 0xad, 0x6e, 0x75, 0x45, 0xbd, 0x09, 0xde, 0xff,
+	0x17, 0xb6, 0x9c, 0x7f, 0xb5, 0x22, 0xfb, 0xd5, 0xd1, 0x93, 0x17, 0x4d, 0xe5, 0xe9, 0x8b, 0xa6,
+	0xf2, 0xe7, 0x8b, 0xa6, 0xf2, 0xc3, 0x71, 0xb3, 0xf4, 0xf4, 0xb8, 0x59, 0xfa, 0xfd, 0xb8, 0x59,
+	0xfa, 0xa2, 0x33, 0x77, 0x25, 0x8d, 0xe3, 0xf1, 0x6e, 0xfe, 0x6b, 0xd3, 0x99, 0x7b, 0xa2, 0x7c,
+	0x7d, 0xfa, 0x48, 0xc9, 0xef, 0xa7, 0xf1, 0x9b, 0xf9, 0xfb, 0xe2, 0xe3, 0xbf, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x59, 0xbb, 0xdc, 0xe2, 0xc7, 0x08, 0x00, 0x00,
 }
 
 func (m *SecondarySpSealObjectSignDoc) Marshal() (dAtA []byte, err error) {
@@ -36,6 +36,11 @@ var (
 	ErrObjectIsNotUpdating          = errors.Register(ModuleName, 1128, "Object is not being updated")
 	ErrUpdatePaymentAccountFailed   = errors.Register(ModuleName, 1129, "Update payment account failed")
 	ErrObjectChecksumsMissing       = errors.Register(ModuleName, 1130, "Object checksums is missing")
+	ErrNoSuchObjectVersion          = errors.Register(ModuleName, 1131, "No such object version")
+	ErrBucketLocked                 = errors.Register(ModuleName, 1132, "Bucket is locked by retention or legal hold")
+	ErrObjectLocked                 = errors.Register(ModuleName, 1133, "Object is locked by retention or legal hold")
+	ErrInvalidComposeSource         = errors.Register(ModuleName, 1134, "Invalid source object for composition")
+	ErrTooManyBatchItems            = errors.Register(ModuleName, 1135, "Too many items in a batch message")
 
 	ErrInvalidCrossChainPackage = errors.Register(ModuleName, 3000, "invalid cross chain package")
 	ErrAlreadyMirrored          = errors.Register(ModuleName, 3001, "resource is already mirrored")
@@ -55,5 +60,7 @@ var (
 	ErrVirtualGroupOperateFailed = errors.Register(ModuleName, 3203, "operate virtual group failed.")
 	ErrInvalidBlsPubKey          = errors.Register(ModuleName, 3204, "invalid bls public key")
 
-	ErrInvalidBucketOwner = errors.Register(ModuleName, 3300, "invalid bucket owner")
+	ErrInvalidBucketOwner        = errors.Register(ModuleName, 3300, "invalid bucket owner")
+	ErrRequesterPaysNotEnabled   = errors.Register(ModuleName, 3301, "requester pays is not enabled for the bucket")
+	ErrRequesterPaysChargedQuota = errors.Register(ModuleName, 3302, "the charged read quota of a requester-pays bucket must be zero")
 )
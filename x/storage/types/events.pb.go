@@ -2900,703 +2900,1087 @@ func (m *EventToggleSPAsDelegatedAgent) GetSpAsDelegatedAgentDisabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*EventCreateBucket)(nil), "greenfield.storage.EventCreateBucket")
-	proto.RegisterType((*EventDeleteBucket)(nil), "greenfield.storage.EventDeleteBucket")
-	proto.RegisterType((*EventUpdateBucketInfo)(nil), "greenfield.storage.EventUpdateBucketInfo")
-	proto.RegisterType((*EventDiscontinueBucket)(nil), "greenfield.storage.EventDiscontinueBucket")
-	proto.RegisterType((*EventCreateObject)(nil), "greenfield.storage.EventCreateObject")
-	proto.RegisterType((*EventCancelCreateObject)(nil), "greenfield.storage.EventCancelCreateObject")
-	proto.RegisterType((*EventSealObject)(nil), "greenfield.storage.EventSealObject")
-	proto.RegisterType((*EventCopyObject)(nil), "greenfield.storage.EventCopyObject")
-	proto.RegisterType((*EventDeleteObject)(nil), "greenfield.storage.EventDeleteObject")
-	proto.RegisterType((*EventRejectSealObject)(nil), "greenfield.storage.EventRejectSealObject")
-	proto.RegisterType((*EventDiscontinueObject)(nil), "greenfield.storage.EventDiscontinueObject")
-	proto.RegisterType((*EventUpdateObjectInfo)(nil), "greenfield.storage.EventUpdateObjectInfo")
-	proto.RegisterType((*EventCreateGroup)(nil), "greenfield.storage.EventCreateGroup")
-	proto.RegisterType((*EventDeleteGroup)(nil), "greenfield.storage.EventDeleteGroup")
-	proto.RegisterType((*EventLeaveGroup)(nil), "greenfield.storage.EventLeaveGroup")
-	proto.RegisterType((*EventUpdateGroupMember)(nil), "greenfield.storage.EventUpdateGroupMember")
-	proto.RegisterType((*EventRenewGroupMember)(nil), "greenfield.storage.EventRenewGroupMember")
-	proto.RegisterType((*EventGroupMemberDetail)(nil), "greenfield.storage.EventGroupMemberDetail")
-	proto.RegisterType((*EventUpdateGroupExtra)(nil), "greenfield.storage.EventUpdateGroupExtra")
-	proto.RegisterType((*EventMirrorBucket)(nil), "greenfield.storage.EventMirrorBucket")
-	proto.RegisterType((*EventMirrorBucketResult)(nil), "greenfield.storage.EventMirrorBucketResult")
-	proto.RegisterType((*EventMirrorObject)(nil), "greenfield.storage.EventMirrorObject")
-	proto.RegisterType((*EventMirrorObjectResult)(nil), "greenfield.storage.EventMirrorObjectResult")
-	proto.RegisterType((*EventMirrorGroup)(nil), "greenfield.storage.EventMirrorGroup")
-	proto.RegisterType((*EventMirrorGroupResult)(nil), "greenfield.storage.EventMirrorGroupResult")
-	proto.RegisterType((*EventStalePolicyCleanup)(nil), "greenfield.storage.EventStalePolicyCleanup")
-	proto.RegisterType((*EventMigrationBucket)(nil), "greenfield.storage.EventMigrationBucket")
-	proto.RegisterType((*EventCancelMigrationBucket)(nil), "greenfield.storage.EventCancelMigrationBucket")
-	proto.RegisterType((*EventRejectMigrateBucket)(nil), "greenfield.storage.EventRejectMigrateBucket")
-	proto.RegisterType((*EventCompleteMigrationBucket)(nil), "greenfield.storage.EventCompleteMigrationBucket")
-	proto.RegisterType((*EventSetTag)(nil), "greenfield.storage.EventSetTag")
-	proto.RegisterType((*EventUpdateObjectContent)(nil), "greenfield.storage.EventUpdateObjectContent")
-	proto.RegisterType((*EventUpdateObjectContentSuccess)(nil), "greenfield.storage.EventUpdateObjectContentSuccess")
-	proto.RegisterType((*EventCancelUpdateObjectContent)(nil), "greenfield.storage.EventCancelUpdateObjectContent")
-	proto.RegisterType((*EventSetBucketFlowRateLimit)(nil), "greenfield.storage.EventSetBucketFlowRateLimit")
-	proto.RegisterType((*EventBucketFlowRateLimitStatus)(nil), "greenfield.storage.EventBucketFlowRateLimitStatus")
-	proto.RegisterType((*EventToggleSPAsDelegatedAgent)(nil), "greenfield.storage.EventToggleSPAsDelegatedAgent")
+type EventSetBucketVersioning struct {
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// versioning_enabled indicates whether the previous contents of the updated objects are retained.
+	VersioningEnabled bool `protobuf:"varint,3,opt,name=versioning_enabled,json=versioningEnabled,proto3" json:"versioning_enabled,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/storage/events.proto", fileDescriptor_946dcba4f763ddc4) }
+func (m *EventSetBucketVersioning) Reset()         { *m = EventSetBucketVersioning{} }
+func (m *EventSetBucketVersioning) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketVersioning) ProtoMessage()    {}
+func (*EventSetBucketVersioning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{37}
+}
+func (m *EventSetBucketVersioning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketVersioning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketVersioning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetBucketVersioning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketVersioning.Merge(m, src)
+}
+func (m *EventSetBucketVersioning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketVersioning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketVersioning.DiscardUnknown(m)
+}
 
-var fileDescriptor_946dcba4f763ddc4 = []byte{
-	// 2097 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x8f, 0xe4, 0x46,
-	0x15, 0x5f, 0xf7, 0xd7, 0x74, 0xbf, 0x9e, 0xee, 0xde, 0x31, 0xc3, 0xa6, 0x99, 0xcd, 0xf6, 0x74,
-	0x8c, 0x58, 0x26, 0x11, 0x3b, 0x83, 0x36, 0x01, 0xad, 0x04, 0xd2, 0x6a, 0x3e, 0x12, 0xd4, 0x62,
-	0x93, 0x1d, 0xdc, 0x93, 0x1c, 0xb8, 0x58, 0xd5, 0x76, 0x8d, 0xd7, 0xac, 0xdb, 0x65, 0x5c, 0xd5,
-	0x33, 0xdb, 0xf9, 0x07, 0xb8, 0x80, 0x14, 0x09, 0x21, 0x01, 0x07, 0xae, 0x20, 0x71, 0xe1, 0x90,
-	0x2b, 0x9c, 0xf7, 0x98, 0x2c, 0x97, 0x10, 0xa4, 0x80, 0x76, 0x85, 0xf8, 0x90, 0x10, 0x9c, 0x39,
-	0x21, 0x57, 0x95, 0xdd, 0x76, 0xbb, 0x67, 0x7b, 0xdc, 0x9b, 0xc9, 0xcc, 0xe6, 0x34, 0xe3, 0xd7,
-	0xaf, 0xca, 0xef, 0xe3, 0xf7, 0x3e, 0xea, 0x95, 0x61, 0xdd, 0x0e, 0x30, 0xf6, 0x0e, 0x1d, 0xec,
-	0x5a, 0x5b, 0x94, 0x91, 0x00, 0xd9, 0x78, 0x0b, 0x1f, 0x61, 0x8f, 0xd1, 0x4d, 0x3f, 0x20, 0x8c,
-	0xa8, 0xea, 0x84, 0x61, 0x53, 0x32, 0xac, 0x7d, 0xc9, 0x24, 0x74, 0x48, 0xa8, 0xc1, 0x39, 0xb6,
-	0xc4, 0x83, 0x60, 0x5f, 0x5b, 0xb5, 0x89, 0x4d, 0x04, 0x3d, 0xfc, 0x4f, 0x52, 0xd7, 0x6d, 0x42,
-	0x6c, 0x17, 0x6f, 0xf1, 0xa7, 0xc1, 0xe8, 0x70, 0x8b, 0x39, 0x43, 0x4c, 0x19, 0x1a, 0xfa, 0x31,
-	0xc3, 0x44, 0x8c, 0x00, 0x53, 0x32, 0x0a, 0x4c, 0xbc, 0xc5, 0xc6, 0x3e, 0xa6, 0x33, 0x18, 0x22,
-	0x39, 0x4d, 0x32, 0x1c, 0x12, 0x4f, 0x32, 0x74, 0x66, 0x30, 0x24, 0x36, 0xd0, 0xfe, 0x58, 0x82,
-	0x95, 0xd7, 0x43, 0xc5, 0x76, 0x03, 0x8c, 0x18, 0xde, 0x19, 0x99, 0xf7, 0x31, 0x53, 0x37, 0xa1,
-	0x4c, 0x8e, 0x3d, 0x1c, 0xb4, 0x95, 0xae, 0xb2, 0x51, 0xdb, 0x69, 0x3f, 0x7a, 0xff, 0xc6, 0xaa,
-	0xd4, 0x67, 0xdb, 0xb2, 0x02, 0x4c, 0x69, 0x9f, 0x05, 0x8e, 0x67, 0xeb, 0x82, 0x4d, 0x5d, 0x87,
-	0xfa, 0x80, 0xaf, 0x34, 0x3c, 0x34, 0xc4, 0xed, 0x42, 0xb8, 0x4a, 0x07, 0x41, 0x7a, 0x0b, 0x0d,
-	0xb1, 0xba, 0x03, 0x70, 0xe4, 0x50, 0x67, 0xe0, 0xb8, 0x0e, 0x1b, 0xb7, 0x8b, 0x5d, 0x65, 0xa3,
-	0x79, 0x53, 0xdb, 0xcc, 0xda, 0x70, 0xf3, 0x9d, 0x98, 0xeb, 0x60, 0xec, 0x63, 0x3d, 0xb1, 0x4a,
-	0xbd, 0x0a, 0x35, 0x93, 0x0b, 0x69, 0x20, 0xd6, 0x2e, 0x75, 0x95, 0x8d, 0xa2, 0x5e, 0x15, 0x84,
-	0x6d, 0xa6, 0xde, 0x82, 0x9a, 0x94, 0xc0, 0xb1, 0xda, 0x65, 0x2e, 0xf5, 0xd5, 0x87, 0x9f, 0xac,
-	0x5f, 0xfa, 0xf8, 0x93, 0xf5, 0xd2, 0xdb, 0x8e, 0xc7, 0x1e, 0xbd, 0x7f, 0xa3, 0x2e, 0x35, 0x08,
-	0x1f, 0xf5, 0xaa, 0xe0, 0xee, 0x59, 0xea, 0x6d, 0xa8, 0x0b, 0xc3, 0x1a, 0xa1, 0x5d, 0xda, 0x15,
-	0x2e, 0x5b, 0x67, 0x96, 0x6c, 0x7d, 0xce, 0x26, 0xe4, 0xa2, 0xf1, 0xff, 0xea, 0xd7, 0x40, 0x35,
-	0xef, 0xa1, 0xc0, 0xc6, 0x96, 0x11, 0x60, 0x64, 0x19, 0x3f, 0x1c, 0x11, 0x86, 0xda, 0x4b, 0x5d,
-	0x65, 0xa3, 0xa4, 0x5f, 0x96, 0xbf, 0xe8, 0x18, 0x59, 0xdf, 0x0b, 0xe9, 0xea, 0x36, 0xb4, 0x7c,
-	0x34, 0x1e, 0x62, 0x8f, 0x19, 0x48, 0x98, 0xb2, 0x5d, 0x9d, 0x63, 0xe4, 0xa6, 0x5c, 0x20, 0xa9,
-	0xaa, 0x06, 0x0d, 0x3f, 0x70, 0x86, 0x28, 0x18, 0x1b, 0xd4, 0x0f, 0xf5, 0xad, 0x75, 0x95, 0x8d,
-	0x86, 0x5e, 0x97, 0xc4, 0xbe, 0xdf, 0xb3, 0xd4, 0x1d, 0xe8, 0xd8, 0x2e, 0x19, 0x20, 0xd7, 0x38,
-	0x72, 0x02, 0x36, 0x42, 0xae, 0x61, 0x07, 0x64, 0xe4, 0x1b, 0x87, 0x68, 0xe8, 0xb8, 0xe3, 0x70,
-	0x11, 0xf0, 0x45, 0x6b, 0x82, 0xeb, 0x1d, 0xc1, 0xf4, 0x9d, 0x90, 0xe7, 0x0d, 0xce, 0xd2, 0xb3,
-	0xd4, 0x5b, 0x50, 0xa1, 0x0c, 0xb1, 0x11, 0x6d, 0xd7, 0xb9, 0x51, 0xba, 0xb3, 0x8c, 0x22, 0x10,
-	0xd3, 0xe7, 0x7c, 0xba, 0xe4, 0xd7, 0x7e, 0x5e, 0x90, 0xa8, 0xda, 0xc3, 0x2e, 0x8e, 0x51, 0xf5,
-	0x1a, 0x54, 0x89, 0x8f, 0x03, 0xc4, 0xc8, 0x7c, 0x60, 0xc5, 0x9c, 0x13, 0x2c, 0x16, 0x16, 0xc2,
-	0x62, 0x31, 0x83, 0xc5, 0x14, 0x54, 0x4a, 0x79, 0xa0, 0x32, 0xdf, 0xa8, 0xe5, 0x79, 0x46, 0xd5,
-	0x7e, 0x54, 0x84, 0x2f, 0x72, 0xd3, 0xbc, 0xed, 0x5b, 0x71, 0xc0, 0xf5, 0xbc, 0x43, 0xb2, 0xa0,
-	0x79, 0xe6, 0x86, 0x5e, 0x4a, 0xdd, 0x62, 0x1e, 0x75, 0x67, 0x03, 0xbb, 0x74, 0x02, 0xb0, 0xbf,
-	0x9a, 0x05, 0x36, 0x8f, 0xc3, 0x0c, 0x7c, 0xd3, 0xb9, 0xa0, 0xb2, 0x50, 0x2e, 0x98, 0xef, 0x89,
-	0xa5, 0xb9, 0x9e, 0xf8, 0x8d, 0x02, 0x57, 0x04, 0x48, 0x1d, 0x6a, 0x12, 0x8f, 0x39, 0xde, 0x28,
-	0x42, 0x6a, 0xca, 0x66, 0x4a, 0x1e, 0x9b, 0xcd, 0x75, 0xc7, 0x15, 0xa8, 0x04, 0x18, 0x51, 0xe2,
-	0x49, 0x64, 0xca, 0xa7, 0x30, 0xbb, 0x59, 0x3c, 0x58, 0x12, 0xd9, 0x4d, 0x10, 0xb6, 0x99, 0xf6,
-	0xd3, 0x4a, 0x2a, 0x4b, 0xdf, 0x1d, 0xfc, 0x00, 0x9b, 0x4c, 0xbd, 0x09, 0x4b, 0x3c, 0xff, 0x9d,
-	0x02, 0x2f, 0x11, 0xe3, 0xa7, 0x1f, 0x4d, 0xeb, 0x50, 0x27, 0x5c, 0x1c, 0xc1, 0x50, 0x12, 0x0c,
-	0x82, 0x94, 0xc5, 0x5f, 0x25, 0x8f, 0x2d, 0x6f, 0x41, 0x4d, 0x6e, 0x2d, 0xfd, 0x39, 0x6f, 0xa5,
-	0xe0, 0xee, 0x59, 0xd9, 0x0c, 0x59, 0xcd, 0x66, 0xc8, 0x97, 0x60, 0xd9, 0x47, 0x63, 0x97, 0x20,
-	0xcb, 0xa0, 0xce, 0xbb, 0x98, 0x27, 0xd1, 0x92, 0x5e, 0x97, 0xb4, 0xbe, 0xf3, 0xee, 0x74, 0xd5,
-	0x82, 0x85, 0x90, 0xfa, 0x12, 0x2c, 0x87, 0xe0, 0x0a, 0xc3, 0x82, 0xd7, 0x97, 0x3a, 0x37, 0x50,
-	0x5d, 0xd2, 0x78, 0x01, 0x49, 0x15, 0xb6, 0xe5, 0x4c, 0x61, 0x8b, 0x92, 0x70, 0xe3, 0xe4, 0x24,
-	0x2c, 0x00, 0x91, 0x4e, 0xc2, 0xea, 0x77, 0xa1, 0x15, 0x60, 0x6b, 0xe4, 0x59, 0xc8, 0x33, 0xc7,
-	0xe2, 0xe5, 0xcd, 0x93, 0x55, 0xd0, 0x63, 0x56, 0xae, 0x42, 0x33, 0x48, 0x3d, 0x4f, 0x57, 0xc9,
-	0x56, 0xee, 0x2a, 0xf9, 0x22, 0xd4, 0xcc, 0x7b, 0xd8, 0xbc, 0x4f, 0x47, 0x43, 0xda, 0xbe, 0xdc,
-	0x2d, 0x6e, 0x2c, 0xeb, 0x13, 0x82, 0xfa, 0x2a, 0x5c, 0x71, 0x89, 0x99, 0x09, 0x67, 0xc7, 0x6a,
-	0xaf, 0x70, 0xcf, 0x7d, 0x81, 0xff, 0x9a, 0x0c, 0xe3, 0x9e, 0xa5, 0xfd, 0x47, 0x81, 0x17, 0x44,
-	0x54, 0x20, 0xcf, 0xc4, 0x6e, 0x2a, 0x36, 0xce, 0x28, 0x99, 0x4e, 0xa1, 0xbd, 0x98, 0x41, 0x7b,
-	0x06, 0x79, 0xa5, 0x2c, 0xf2, 0x52, 0xb8, 0xae, 0xe4, 0xc0, 0x75, 0x58, 0x3c, 0x5a, 0x5c, 0xe3,
-	0x3e, 0x46, 0xee, 0x39, 0x6b, 0x9a, 0xd2, 0xa2, 0x9c, 0x27, 0x3a, 0x27, 0x90, 0xae, 0xe4, 0x84,
-	0xf4, 0x37, 0xe0, 0x85, 0x99, 0x69, 0x3f, 0xce, 0xf7, 0xab, 0xd9, 0x7c, 0xdf, 0xb3, 0x9e, 0x82,
-	0xae, 0xea, 0x89, 0xe8, 0x4a, 0x03, 0xb6, 0x36, 0x05, 0x58, 0xed, 0x57, 0x91, 0x27, 0x76, 0x89,
-	0x3f, 0x7e, 0x26, 0x4f, 0x5c, 0x87, 0x16, 0x0d, 0x4c, 0x23, 0xeb, 0x8d, 0x06, 0x0d, 0xcc, 0x9d,
-	0x89, 0x43, 0x24, 0x5f, 0xd6, 0x29, 0x21, 0xdf, 0xdd, 0x89, 0x5f, 0xae, 0x43, 0xcb, 0xa2, 0x2c,
-	0xb5, 0x9f, 0x48, 0xca, 0x0d, 0x8b, 0xb2, 0xf4, 0x7e, 0x21, 0x5f, 0x72, 0xbf, 0x72, 0xcc, 0x97,
-	0xd8, 0xef, 0x36, 0x34, 0x12, 0xef, 0x3d, 0x1d, 0x62, 0xeb, 0xb1, 0x48, 0xbc, 0xc1, 0x6e, 0x24,
-	0x5e, 0x74, 0xba, 0x54, 0x5e, 0x8f, 0x65, 0x58, 0xd0, 0x7d, 0xda, 0xff, 0x94, 0x54, 0x0b, 0x7a,
-	0x91, 0x82, 0xa5, 0x94, 0x27, 0x58, 0x4e, 0x56, 0xbe, 0x7c, 0xb2, 0xf2, 0xff, 0x50, 0x64, 0x93,
-	0xa9, 0x63, 0x1e, 0x45, 0x17, 0x2c, 0x5b, 0xe4, 0x32, 0xc0, 0x35, 0x80, 0x43, 0x12, 0x18, 0x23,
-	0xde, 0x2e, 0x73, 0xa5, 0xab, 0x7a, 0xed, 0x90, 0x04, 0xa2, 0x7f, 0x9e, 0xd9, 0xc5, 0x49, 0x5d,
-	0xa7, 0xa4, 0x56, 0x66, 0xb5, 0xc6, 0x13, 0xa1, 0x0a, 0x79, 0x84, 0x5a, 0xa8, 0x8b, 0xfb, 0x49,
-	0x21, 0xd5, 0xfa, 0x4b, 0x7c, 0x9f, 0x61, 0xeb, 0x7f, 0x86, 0x5e, 0x49, 0xb7, 0x46, 0xe5, 0x45,
-	0x5a, 0x23, 0xed, 0xbf, 0x0a, 0x5c, 0x4e, 0x74, 0xb5, 0x1c, 0xbc, 0xb9, 0x47, 0x0f, 0xd7, 0x00,
-	0x44, 0x44, 0x24, 0x6c, 0x50, 0xe3, 0x14, 0xae, 0xe1, 0x37, 0xa1, 0x1a, 0x07, 0xcc, 0x29, 0x0e,
-	0x3f, 0x4b, 0xb6, 0xcc, 0xfe, 0x53, 0xfd, 0x4e, 0x29, 0x77, 0xbf, 0xb3, 0x0a, 0x65, 0xfc, 0x80,
-	0x05, 0x48, 0x26, 0x55, 0xf1, 0xa0, 0xfd, 0x22, 0x52, 0x59, 0x64, 0xa5, 0x29, 0x95, 0x0b, 0x8b,
-	0xa8, 0x5c, 0x7c, 0x9a, 0xca, 0xa5, 0xd3, 0xab, 0xac, 0xfd, 0x49, 0x91, 0x25, 0xed, 0x0e, 0x46,
-	0x47, 0x52, 0xb4, 0xdb, 0xd0, 0x1c, 0xe2, 0xe1, 0x00, 0x07, 0xf1, 0x99, 0x6e, 0x9e, 0x5b, 0x1a,
-	0x82, 0x3f, 0x3a, 0xec, 0x5d, 0x10, 0xdd, 0xfe, 0x5d, 0x90, 0x59, 0x42, 0x84, 0x1e, 0x57, 0xee,
-	0x4d, 0x2e, 0xe8, 0x67, 0x34, 0x95, 0x38, 0x1b, 0xbd, 0xd4, 0xfd, 0xc8, 0x3f, 0xd4, 0x60, 0x24,
-	0xf4, 0x51, 0xbb, 0xdc, 0x2d, 0x6e, 0xd4, 0x6f, 0xbe, 0x32, 0x0b, 0xa9, 0xdc, 0x00, 0x09, 0xd5,
-	0xf7, 0x30, 0x43, 0x8e, 0xab, 0x2f, 0xcb, 0x1d, 0x0e, 0xc8, 0xb6, 0x65, 0xa9, 0x7b, 0xb0, 0x92,
-	0xd8, 0x51, 0xe4, 0xae, 0x76, 0xa5, 0x5b, 0x7c, 0xaa, 0x92, 0xad, 0x78, 0x0b, 0x81, 0x6b, 0xed,
-	0xcf, 0x85, 0xb8, 0x00, 0x79, 0xf8, 0xf8, 0x73, 0x63, 0xee, 0xa9, 0xac, 0x50, 0xce, 0x9d, 0x15,
-	0xf6, 0x60, 0x49, 0x9a, 0x8a, 0xdb, 0x34, 0x9f, 0xa3, 0xa2, 0xa5, 0xda, 0xcf, 0xa2, 0x9a, 0x97,
-	0xe1, 0x51, 0xbf, 0x0e, 0x15, 0xc1, 0x35, 0xd7, 0xb8, 0x92, 0x4f, 0xed, 0x41, 0x0b, 0x3f, 0xf0,
-	0x9d, 0x00, 0x31, 0x87, 0x78, 0x06, 0x73, 0x64, 0x16, 0xad, 0xdf, 0x5c, 0xdb, 0x14, 0xe3, 0xe9,
-	0xcd, 0x68, 0x3c, 0xbd, 0x79, 0x10, 0x8d, 0xa7, 0x77, 0x4a, 0xef, 0xfd, 0x65, 0x5d, 0xd1, 0x9b,
-	0x93, 0x85, 0xe1, 0x4f, 0xda, 0xbf, 0x94, 0x54, 0x81, 0xe3, 0xd2, 0xbd, 0x1e, 0xe6, 0xbd, 0xe7,
-	0xdb, 0xeb, 0xb3, 0x53, 0xf9, 0xc3, 0xa8, 0xc1, 0x7c, 0xd3, 0x09, 0x02, 0x12, 0x3c, 0xd3, 0x8c,
-	0x33, 0xdf, 0x10, 0x2f, 0xd7, 0xcc, 0x52, 0x83, 0x86, 0x85, 0x29, 0x33, 0xcc, 0x7b, 0xc8, 0xf1,
-	0x26, 0x6d, 0x63, 0x3d, 0x24, 0xee, 0x86, 0xb4, 0x9e, 0xa5, 0xfd, 0x2e, 0x3a, 0x48, 0x27, 0x55,
-	0xd1, 0x31, 0x1d, 0xb9, 0x2c, 0xec, 0x74, 0xe4, 0x61, 0x4d, 0xe1, 0x0b, 0xa3, 0xa3, 0xd8, 0x39,
-	0x8b, 0xfc, 0xcf, 0xb4, 0xf5, 0x9f, 0xdb, 0xee, 0xf6, 0x34, 0xba, 0x7e, 0x98, 0x76, 0x8f, 0xd0,
-	0xf5, 0x59, 0xdd, 0x73, 0xce, 0x3a, 0xfd, 0x3e, 0x6a, 0x84, 0x84, 0x4e, 0x17, 0xaa, 0xf7, 0xcb,
-	0xc8, 0x5f, 0xca, 0xca, 0xff, 0xdb, 0x28, 0x05, 0x27, 0xe4, 0x9f, 0xe3, 0x92, 0x73, 0x94, 0xf6,
-	0x48, 0x02, 0xa8, 0xcf, 0x90, 0x8b, 0xf7, 0x89, 0xeb, 0x98, 0xe3, 0x5d, 0x17, 0x23, 0x6f, 0xe4,
-	0xab, 0x6b, 0x50, 0x1d, 0xb8, 0xc4, 0xbc, 0xff, 0xd6, 0x68, 0xc8, 0xe5, 0x2d, 0xea, 0xf1, 0x73,
-	0x58, 0xee, 0xe4, 0x69, 0xc6, 0xf1, 0x0e, 0x89, 0x2c, 0x0b, 0x33, 0xcb, 0x9d, 0x28, 0xfb, 0xe1,
-	0x59, 0x46, 0x07, 0x2b, 0xfe, 0x5f, 0xfb, 0x71, 0x01, 0x56, 0xa5, 0x95, 0x6c, 0x51, 0x27, 0x3e,
-	0xc3, 0x34, 0x99, 0xeb, 0xae, 0xe3, 0x65, 0x58, 0xb1, 0x28, 0x33, 0x66, 0xcd, 0xee, 0x9a, 0x16,
-	0x65, 0xfb, 0xa9, 0xf1, 0x5d, 0xe4, 0xdf, 0x72, 0xce, 0x6b, 0xb1, 0xbf, 0x2b, 0xb0, 0x96, 0x18,
-	0x58, 0x5e, 0x78, 0xa3, 0x4c, 0x34, 0x2d, 0xe5, 0xd4, 0xf4, 0x6f, 0x0a, 0xb4, 0x13, 0x03, 0x08,
-	0xa1, 0x29, 0xfe, 0xfc, 0xe9, 0xf9, 0x51, 0x01, 0x5e, 0x94, 0x63, 0xc0, 0xa1, 0x1f, 0xc2, 0xfe,
-	0xc2, 0xfb, 0x74, 0xfe, 0xcd, 0x59, 0x69, 0xee, 0xc5, 0xf0, 0xcb, 0xb0, 0x42, 0x03, 0x73, 0x2a,
-	0x58, 0x44, 0x92, 0x6f, 0xd2, 0xc0, 0x9c, 0x1d, 0x2c, 0x95, 0x9c, 0xa6, 0x35, 0xa0, 0x2e, 0x47,
-	0xdd, 0xec, 0x00, 0xd9, 0x61, 0x9e, 0x8a, 0xbe, 0x80, 0x90, 0x93, 0x9c, 0xf8, 0x59, 0x7d, 0x0d,
-	0x4a, 0x0c, 0xd9, 0x54, 0x26, 0xa8, 0xee, 0xec, 0xeb, 0x0d, 0xd9, 0x85, 0x23, 0x9b, 0xea, 0x9c,
-	0x5b, 0xfb, 0x75, 0x41, 0x62, 0x34, 0x39, 0x8e, 0xd9, 0x15, 0xf7, 0x32, 0x0b, 0xfa, 0x6d, 0xf1,
-	0x81, 0xd2, 0xb3, 0xdf, 0xb3, 0x4d, 0xdf, 0x67, 0x95, 0xb3, 0xf7, 0x59, 0xa9, 0x91, 0x76, 0x65,
-	0xfa, 0x0e, 0xa6, 0x0d, 0x4b, 0x47, 0x38, 0xa0, 0x0e, 0xf1, 0xf8, 0x84, 0xb6, 0xa8, 0x47, 0x8f,
-	0xda, 0x87, 0x45, 0x58, 0x3f, 0xc9, 0x52, 0xfd, 0x91, 0x69, 0x86, 0x07, 0xfd, 0xe7, 0xd2, 0x60,
-	0xa9, 0x9b, 0xb9, 0x72, 0xf6, 0x66, 0xee, 0x15, 0x58, 0xf1, 0x03, 0x7c, 0x64, 0xa4, 0x0c, 0x5b,
-	0xe1, 0x86, 0x6d, 0x85, 0x3f, 0xec, 0x27, 0x8c, 0xbb, 0x01, 0x97, 0x3d, 0x7c, 0x9c, 0x66, 0x15,
-	0x1f, 0x81, 0x34, 0x3d, 0x7c, 0x9c, 0xe4, 0xfc, 0x0a, 0x34, 0xf9, 0xae, 0x13, 0x5f, 0x54, 0xb9,
-	0x2f, 0x1a, 0x21, 0x75, 0x37, 0xf6, 0xc7, 0x97, 0xa1, 0x11, 0x6e, 0x38, 0x7d, 0x09, 0xb1, 0xec,
-	0xe1, 0xe3, 0xdd, 0x59, 0x4e, 0x83, 0x94, 0xd3, 0xc2, 0x76, 0x43, 0xcc, 0x4c, 0x2d, 0x03, 0x31,
-	0x7e, 0xed, 0x58, 0xd4, 0x6b, 0x92, 0xb2, 0xcd, 0xb4, 0x47, 0x0a, 0x74, 0x12, 0xb5, 0xe8, 0xd3,
-	0x8b, 0x81, 0x73, 0xec, 0x3c, 0xb5, 0x8f, 0x0b, 0x70, 0x35, 0x4a, 0x1a, 0x22, 0xa9, 0xbc, 0xe1,
-	0x92, 0x63, 0x1d, 0x31, 0x7c, 0xc7, 0x19, 0x3a, 0x67, 0xa6, 0xd1, 0x8c, 0x6f, 0x7a, 0x8a, 0x39,
-	0xbf, 0xe9, 0xf9, 0x16, 0x2c, 0xcb, 0x77, 0x88, 0x0e, 0xb8, 0x34, 0x67, 0xbd, 0x94, 0xe8, 0x2e,
-	0xef, 0x83, 0x2d, 0x68, 0x1d, 0xba, 0xe4, 0xd8, 0x08, 0x6b, 0xac, 0xe1, 0x86, 0x9a, 0xca, 0x0b,
-	0xb9, 0x6f, 0x4b, 0xb3, 0x5d, 0xb7, 0x1d, 0x76, 0x6f, 0x34, 0xd8, 0x34, 0xc9, 0x50, 0x7e, 0x97,
-	0x26, 0xff, 0xdc, 0xa0, 0xd6, 0x7d, 0xf9, 0x3d, 0x58, 0x8f, 0x1b, 0x16, 0xe4, 0xdb, 0x7a, 0x1e,
-	0xd3, 0x1b, 0x87, 0x49, 0xe3, 0x69, 0xbf, 0x8c, 0x10, 0x33, 0xc3, 0xb2, 0xfd, 0x99, 0xa7, 0x8e,
-	0xec, 0xc4, 0xfd, 0x1a, 0x80, 0x43, 0x85, 0x88, 0x58, 0x04, 0x7c, 0x55, 0xaf, 0x39, 0xf4, 0x8e,
-	0x20, 0x2c, 0x5e, 0xd6, 0xb4, 0x3f, 0x28, 0x70, 0x8d, 0x0b, 0x77, 0x40, 0x6c, 0xdb, 0xc5, 0xfd,
-	0xfd, 0x6d, 0x1a, 0xf6, 0xa4, 0x36, 0x47, 0xbb, 0x1d, 0xa2, 0xf9, 0x34, 0xb7, 0x01, 0x93, 0x97,
-	0x17, 0x72, 0xd6, 0x54, 0xea, 0x1b, 0x88, 0xf2, 0x71, 0x99, 0x2d, 0x42, 0x2e, 0x7c, 0xa7, 0x61,
-	0x39, 0x14, 0x0d, 0x5c, 0x2c, 0x74, 0xa9, 0xea, 0x6b, 0xd4, 0x9f, 0x16, 0x6b, 0x4f, 0x72, 0xec,
-	0xf4, 0x1e, 0x3e, 0xee, 0x28, 0x1f, 0x3c, 0xee, 0x28, 0x7f, 0x7d, 0xdc, 0x51, 0xde, 0x7b, 0xd2,
-	0xb9, 0xf4, 0xc1, 0x93, 0xce, 0xa5, 0x8f, 0x9e, 0x74, 0x2e, 0x7d, 0x7f, 0x2b, 0xe1, 0xbc, 0x81,
-	0x37, 0xb8, 0xc1, 0x1b, 0xfd, 0xad, 0xc4, 0x77, 0x7d, 0x0f, 0xd2, 0x5f, 0xf6, 0x0d, 0x2a, 0x7c,
-	0x60, 0xf3, 0xea, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x13, 0xed, 0x46, 0x18, 0xc5, 0x28, 0x00,
-	0x00,
+var xxx_messageInfo_EventSetBucketVersioning proto.InternalMessageInfo
+
+func (m *EventSetBucketVersioning) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
 
-func (m *EventCreateBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetBucketVersioning) GetVersioningEnabled() bool {
+	if m != nil {
+		return m.VersioningEnabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventCreateBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCreateObjectVersion is emitted when the previous content of an object is retained as a version
+type EventCreateObjectVersion struct {
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// object_id define an u256 id for object
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// version define the version of the retained content
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// local_virtual_group_id defines the unique id of lvg which the version stored
+	LocalVirtualGroupId uint32 `protobuf:"varint,5,opt,name=local_virtual_group_id,json=localVirtualGroupId,proto3" json:"local_virtual_group_id,omitempty"`
+	// payload_size define the size of the retained content
+	PayloadSize uint64 `protobuf:"varint,6,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
 }
 
-func (m *EventCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
+func (m *EventCreateObjectVersion) Reset()         { *m = EventCreateObjectVersion{} }
+func (m *EventCreateObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*EventCreateObjectVersion) ProtoMessage()    {}
+func (*EventCreateObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{38}
+}
+func (m *EventCreateObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x50
+}
+func (m *EventCreateObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateObjectVersion.Merge(m, src)
+}
+func (m *EventCreateObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateObjectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateObjectVersion proto.InternalMessageInfo
+
+func (m *EventCreateObjectVersion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *EventCreateObjectVersion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *EventCreateObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *EventCreateObjectVersion) GetLocalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.LocalVirtualGroupId
 	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *EventCreateObjectVersion) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
 	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// EventDeleteObjectVersion is emitted when an object version is deleted
+type EventDeleteObjectVersion struct {
+	// operator define the account address of operator who delete the object version
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// object_id define an u256 id for object
+	ObjectId Uint `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// version define the version of the deleted content
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// local_virtual_group_id defines the unique id of lvg which the version stored
+	LocalVirtualGroupId uint32 `protobuf:"varint,6,opt,name=local_virtual_group_id,json=localVirtualGroupId,proto3" json:"local_virtual_group_id,omitempty"`
+}
+
+func (m *EventDeleteObjectVersion) Reset()         { *m = EventDeleteObjectVersion{} }
+func (m *EventDeleteObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*EventDeleteObjectVersion) ProtoMessage()    {}
+func (*EventDeleteObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{39}
+}
+func (m *EventDeleteObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeleteObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeleteObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.CreateAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *EventDeleteObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeleteObjectVersion.Merge(m, src)
+}
+func (m *EventDeleteObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeleteObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeleteObjectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeleteObjectVersion proto.InternalMessageInfo
+
+func (m *EventDeleteObjectVersion) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventDeleteObjectVersion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventDeleteObjectVersion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDeleteObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDeleteBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDeleteObjectVersion) GetLocalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.LocalVirtualGroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetBucketLifecycle is emitted when the lifecycle rules of a bucket are set
+type EventSetBucketLifecycle struct {
+	// operator define the account address of operator who set the lifecycle rules
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// rules define the lifecycle rules of the bucket, empty means the lifecycle configuration is removed
+	Rules []LifecycleRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules"`
 }
 
-func (m *EventDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventSetBucketLifecycle) Reset()         { *m = EventSetBucketLifecycle{} }
+func (m *EventSetBucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketLifecycle) ProtoMessage()    {}
+func (*EventSetBucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{40}
+}
+func (m *EventSetBucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventSetBucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketLifecycle.Merge(m, src)
+}
+func (m *EventSetBucketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetBucketLifecycle proto.InternalMessageInfo
+
+func (m *EventSetBucketLifecycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSetBucketLifecycle) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetBucketLifecycle) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetRetention is emitted when the retention of a bucket or an object is set
+type EventSetRetention struct {
+	// operator define the account address of operator who set the retention
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object, empty means the retention is set on the bucket
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// retention define the new retention
+	Retention Retention `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention"`
 }
 
-func (m *EventUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventSetRetention) Reset()         { *m = EventSetRetention{} }
+func (m *EventSetRetention) String() string { return proto.CompactTextString(m) }
+func (*EventSetRetention) ProtoMessage()    {}
+func (*EventSetRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{41}
+}
+func (m *EventSetRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventSetRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetRetention.Merge(m, src)
+}
+func (m *EventSetRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetRetention proto.InternalMessageInfo
+
+func (m *EventSetRetention) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSetRetention) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDiscontinueBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetRetention) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSetRetention) GetRetention() Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return Retention{}
 }
 
-func (m *EventDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DeleteAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DeleteAt))
-		i--
-		dAtA[i] = 0x20
+// EventSetLegalHold is emitted when the legal hold of a bucket or an object is placed or released
+type EventSetLegalHold struct {
+	// operator define the account address of operator who set the legal hold
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object, empty means the legal hold is set on the bucket
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// legal_hold define whether the legal hold is placed
+	LegalHold bool `protobuf:"varint,4,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+}
+
+func (m *EventSetLegalHold) Reset()         { *m = EventSetLegalHold{} }
+func (m *EventSetLegalHold) String() string { return proto.CompactTextString(m) }
+func (*EventSetLegalHold) ProtoMessage()    {}
+func (*EventSetLegalHold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{42}
+}
+func (m *EventSetLegalHold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetLegalHold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetLegalHold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventSetLegalHold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetLegalHold.Merge(m, src)
+}
+func (m *EventSetLegalHold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetLegalHold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetLegalHold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetLegalHold proto.InternalMessageInfo
+
+func (m *EventSetLegalHold) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventSetLegalHold) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventSetLegalHold) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetLegalHold) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventComposeObject is emitted when sealed objects are composed into a new object
+type EventComposeObject struct {
+	// operator define the account address of operator who compose the object
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the composed object
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// object_id define an u256 id for the composed object
+	ObjectId Uint `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// payload_size define the total size of the composed object
+	PayloadSize uint64 `protobuf:"varint,5,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// source_object_names define the names of the source objects consumed by the composed object
+	SourceObjectNames []string `protobuf:"bytes,6,rep,name=source_object_names,json=sourceObjectNames,proto3" json:"source_object_names,omitempty"`
 }
 
-func (m *EventCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.RedundancyType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventComposeObject) Reset()         { *m = EventComposeObject{} }
+func (m *EventComposeObject) String() string { return proto.CompactTextString(m) }
+func (*EventComposeObject) ProtoMessage()    {}
+func (*EventComposeObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{43}
+}
+func (m *EventComposeObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventComposeObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventComposeObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventComposeObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventComposeObject.Merge(m, src)
+}
+func (m *EventComposeObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventComposeObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventComposeObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventComposeObject proto.InternalMessageInfo
+
+func (m *EventComposeObject) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventComposeObject) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventComposeObject) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventComposeObject) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCancelCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventComposeObject) GetSourceObjectNames() []string {
+	if m != nil {
+		return m.SourceObjectNames
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventBatchCreateObjects is emitted when objects are created by a batch message
+type EventBatchCreateObjects struct {
+	// creator define the account address of the objects creator
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id define an u256 id for bucket
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// primary_sp_id define the primary sp of the bucket
+	PrimarySpId uint32 `protobuf:"varint,4,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// object_names define the names of the created objects
+	ObjectNames []string `protobuf:"bytes,5,rep,name=object_names,json=objectNames,proto3" json:"object_names,omitempty"`
+	// object_ids define the ids of the created objects in the same order of the object names
+	ObjectIds []Uint `protobuf:"bytes,6,rep,name=object_ids,json=objectIds,proto3,customtype=Uint" json:"object_ids"`
 }
 
-func (m *EventCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventBatchCreateObjects) Reset()         { *m = EventBatchCreateObjects{} }
+func (m *EventBatchCreateObjects) String() string { return proto.CompactTextString(m) }
+func (*EventBatchCreateObjects) ProtoMessage()    {}
+func (*EventBatchCreateObjects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{44}
+}
+func (m *EventBatchCreateObjects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBatchCreateObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBatchCreateObjects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventBatchCreateObjects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchCreateObjects.Merge(m, src)
+}
+func (m *EventBatchCreateObjects) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBatchCreateObjects) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchCreateObjects.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBatchCreateObjects proto.InternalMessageInfo
+
+func (m *EventBatchCreateObjects) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventBatchCreateObjects) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventBatchCreateObjects) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventSealObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBatchCreateObjects) GetObjectNames() []string {
+	if m != nil {
+		return m.ObjectNames
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventSealObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventBatchDeleteObjects is emitted when objects are deleted by a batch message
+type EventBatchDeleteObjects struct {
+	// operator define the account address of operator who delete the objects
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id define an u256 id for bucket
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// object_names define the names of the deleted objects
+	ObjectNames []string `protobuf:"bytes,4,rep,name=object_names,json=objectNames,proto3" json:"object_names,omitempty"`
+	// object_ids define the ids of the deleted objects in the same order of the object names
+	ObjectIds []Uint `protobuf:"bytes,5,rep,name=object_ids,json=objectIds,proto3,customtype=Uint" json:"object_ids"`
 }
 
-func (m *EventSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
+func (m *EventBatchDeleteObjects) Reset()         { *m = EventBatchDeleteObjects{} }
+func (m *EventBatchDeleteObjects) String() string { return proto.CompactTextString(m) }
+func (*EventBatchDeleteObjects) ProtoMessage()    {}
+func (*EventBatchDeleteObjects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{45}
+}
+func (m *EventBatchDeleteObjects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBatchDeleteObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBatchDeleteObjects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+}
+func (m *EventBatchDeleteObjects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchDeleteObjects.Merge(m, src)
+}
+func (m *EventBatchDeleteObjects) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBatchDeleteObjects) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchDeleteObjects.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBatchDeleteObjects proto.InternalMessageInfo
+
+func (m *EventBatchDeleteObjects) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventBatchDeleteObjects) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventBatchDeleteObjects) GetObjectNames() []string {
+	if m != nil {
+		return m.ObjectNames
+	}
+	return nil
+}
+
+// EventSetBucketRequesterPays is emitted on MsgSetBucketRequesterPays
+type EventSetBucketRequesterPays struct {
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// requester_pays indicates whether the readers pay for their own read quota.
+	RequesterPays bool `protobuf:"varint,3,opt,name=requester_pays,json=requesterPays,proto3" json:"requester_pays,omitempty"`
+}
+
+func (m *EventSetBucketRequesterPays) Reset()         { *m = EventSetBucketRequesterPays{} }
+func (m *EventSetBucketRequesterPays) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketRequesterPays) ProtoMessage()    {}
+func (*EventSetBucketRequesterPays) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{46}
+}
+func (m *EventSetBucketRequesterPays) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketRequesterPays) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketRequesterPays.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetBucketRequesterPays) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketRequesterPays.Merge(m, src)
+}
+func (m *EventSetBucketRequesterPays) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketRequesterPays) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketRequesterPays.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetBucketRequesterPays proto.InternalMessageInfo
+
+func (m *EventSetBucketRequesterPays) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventSetBucketRequesterPays) GetRequesterPays() bool {
+	if m != nil {
+		return m.RequesterPays
+	}
+	return false
+}
+
+// EventPurchaseReaderQuota is emitted on MsgPurchaseReaderQuota
+type EventPurchaseReaderQuota struct {
+	// reader define the account address of the reader
+	Reader string `protobuf:"bytes,1,opt,name=reader,proto3" json:"reader,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id define an u256 id for bucket
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// payment_address define the payment account of the reader
+	PaymentAddress string `protobuf:"bytes,4,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// read_quota define the read quota of the reader after updated, zero means the read quota is cancelled
+	ReadQuota uint64 `protobuf:"varint,5,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+}
+
+func (m *EventPurchaseReaderQuota) Reset()         { *m = EventPurchaseReaderQuota{} }
+func (m *EventPurchaseReaderQuota) String() string { return proto.CompactTextString(m) }
+func (*EventPurchaseReaderQuota) ProtoMessage()    {}
+func (*EventPurchaseReaderQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{47}
+}
+func (m *EventPurchaseReaderQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPurchaseReaderQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPurchaseReaderQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPurchaseReaderQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPurchaseReaderQuota.Merge(m, src)
+}
+func (m *EventPurchaseReaderQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPurchaseReaderQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPurchaseReaderQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPurchaseReaderQuota proto.InternalMessageInfo
+
+func (m *EventPurchaseReaderQuota) GetReader() string {
+	if m != nil {
+		return m.Reader
+	}
+	return ""
+}
+
+func (m *EventPurchaseReaderQuota) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventPurchaseReaderQuota) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *EventPurchaseReaderQuota) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventCreateBucket)(nil), "greenfield.storage.EventCreateBucket")
+	proto.RegisterType((*EventDeleteBucket)(nil), "greenfield.storage.EventDeleteBucket")
+	proto.RegisterType((*EventUpdateBucketInfo)(nil), "greenfield.storage.EventUpdateBucketInfo")
+	proto.RegisterType((*EventDiscontinueBucket)(nil), "greenfield.storage.EventDiscontinueBucket")
+	proto.RegisterType((*EventCreateObject)(nil), "greenfield.storage.EventCreateObject")
+	proto.RegisterType((*EventCancelCreateObject)(nil), "greenfield.storage.EventCancelCreateObject")
+	proto.RegisterType((*EventSealObject)(nil), "greenfield.storage.EventSealObject")
+	proto.RegisterType((*EventCopyObject)(nil), "greenfield.storage.EventCopyObject")
+	proto.RegisterType((*EventDeleteObject)(nil), "greenfield.storage.EventDeleteObject")
+	proto.RegisterType((*EventRejectSealObject)(nil), "greenfield.storage.EventRejectSealObject")
+	proto.RegisterType((*EventDiscontinueObject)(nil), "greenfield.storage.EventDiscontinueObject")
+	proto.RegisterType((*EventUpdateObjectInfo)(nil), "greenfield.storage.EventUpdateObjectInfo")
+	proto.RegisterType((*EventCreateGroup)(nil), "greenfield.storage.EventCreateGroup")
+	proto.RegisterType((*EventDeleteGroup)(nil), "greenfield.storage.EventDeleteGroup")
+	proto.RegisterType((*EventLeaveGroup)(nil), "greenfield.storage.EventLeaveGroup")
+	proto.RegisterType((*EventUpdateGroupMember)(nil), "greenfield.storage.EventUpdateGroupMember")
+	proto.RegisterType((*EventRenewGroupMember)(nil), "greenfield.storage.EventRenewGroupMember")
+	proto.RegisterType((*EventGroupMemberDetail)(nil), "greenfield.storage.EventGroupMemberDetail")
+	proto.RegisterType((*EventUpdateGroupExtra)(nil), "greenfield.storage.EventUpdateGroupExtra")
+	proto.RegisterType((*EventMirrorBucket)(nil), "greenfield.storage.EventMirrorBucket")
+	proto.RegisterType((*EventMirrorBucketResult)(nil), "greenfield.storage.EventMirrorBucketResult")
+	proto.RegisterType((*EventMirrorObject)(nil), "greenfield.storage.EventMirrorObject")
+	proto.RegisterType((*EventMirrorObjectResult)(nil), "greenfield.storage.EventMirrorObjectResult")
+	proto.RegisterType((*EventMirrorGroup)(nil), "greenfield.storage.EventMirrorGroup")
+	proto.RegisterType((*EventMirrorGroupResult)(nil), "greenfield.storage.EventMirrorGroupResult")
+	proto.RegisterType((*EventStalePolicyCleanup)(nil), "greenfield.storage.EventStalePolicyCleanup")
+	proto.RegisterType((*EventMigrationBucket)(nil), "greenfield.storage.EventMigrationBucket")
+	proto.RegisterType((*EventCancelMigrationBucket)(nil), "greenfield.storage.EventCancelMigrationBucket")
+	proto.RegisterType((*EventRejectMigrateBucket)(nil), "greenfield.storage.EventRejectMigrateBucket")
+	proto.RegisterType((*EventCompleteMigrationBucket)(nil), "greenfield.storage.EventCompleteMigrationBucket")
+	proto.RegisterType((*EventSetTag)(nil), "greenfield.storage.EventSetTag")
+	proto.RegisterType((*EventUpdateObjectContent)(nil), "greenfield.storage.EventUpdateObjectContent")
+	proto.RegisterType((*EventUpdateObjectContentSuccess)(nil), "greenfield.storage.EventUpdateObjectContentSuccess")
+	proto.RegisterType((*EventCancelUpdateObjectContent)(nil), "greenfield.storage.EventCancelUpdateObjectContent")
+	proto.RegisterType((*EventSetBucketFlowRateLimit)(nil), "greenfield.storage.EventSetBucketFlowRateLimit")
+	proto.RegisterType((*EventBucketFlowRateLimitStatus)(nil), "greenfield.storage.EventBucketFlowRateLimitStatus")
+	proto.RegisterType((*EventToggleSPAsDelegatedAgent)(nil), "greenfield.storage.EventToggleSPAsDelegatedAgent")
+	proto.RegisterType((*EventSetBucketVersioning)(nil), "greenfield.storage.EventSetBucketVersioning")
+	proto.RegisterType((*EventCreateObjectVersion)(nil), "greenfield.storage.EventCreateObjectVersion")
+	proto.RegisterType((*EventDeleteObjectVersion)(nil), "greenfield.storage.EventDeleteObjectVersion")
+	proto.RegisterType((*EventSetBucketLifecycle)(nil), "greenfield.storage.EventSetBucketLifecycle")
+	proto.RegisterType((*EventSetRetention)(nil), "greenfield.storage.EventSetRetention")
+	proto.RegisterType((*EventSetLegalHold)(nil), "greenfield.storage.EventSetLegalHold")
+	proto.RegisterType((*EventComposeObject)(nil), "greenfield.storage.EventComposeObject")
+	proto.RegisterType((*EventBatchCreateObjects)(nil), "greenfield.storage.EventBatchCreateObjects")
+	proto.RegisterType((*EventBatchDeleteObjects)(nil), "greenfield.storage.EventBatchDeleteObjects")
+	proto.RegisterType((*EventSetBucketRequesterPays)(nil), "greenfield.storage.EventSetBucketRequesterPays")
+	proto.RegisterType((*EventPurchaseReaderQuota)(nil), "greenfield.storage.EventPurchaseReaderQuota")
+}
+
+func init() { proto.RegisterFile("greenfield/storage/events.proto", fileDescriptor_946dcba4f763ddc4) }
+
+var fileDescriptor_946dcba4f763ddc4 = []byte{
+	// 2482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x4b, 0x8a, 0x7c, 0x14, 0x25, 0x6b, 0xe3, 0x3a, 0xac, 0x1c, 0x7d, 0x78, 0x8b,
+	0xb8, 0x4a, 0x50, 0x4b, 0x81, 0x92, 0x16, 0x46, 0x3f, 0x60, 0x48, 0xb2, 0xd3, 0x12, 0x75, 0x62,
+	0x75, 0xe9, 0xf8, 0xd0, 0xcb, 0x62, 0xb8, 0x3b, 0x5a, 0x6d, 0xbd, 0xdc, 0x61, 0x76, 0x86, 0x92,
+	0x99, 0x7f, 0xa0, 0x2d, 0xd0, 0x02, 0x01, 0x8a, 0x02, 0x4d, 0x0b, 0xb4, 0x97, 0x02, 0x2d, 0x9a,
+	0x4b, 0x0f, 0xb9, 0xb6, 0x67, 0x9f, 0x8a, 0xc4, 0xbd, 0xa4, 0x29, 0x90, 0x16, 0x36, 0x8a, 0x36,
+	0x05, 0x8a, 0xf6, 0xdc, 0x53, 0xb0, 0x33, 0xb3, 0xcb, 0x5d, 0x2e, 0x65, 0x72, 0xe9, 0x28, 0x92,
+	0x73, 0x12, 0x77, 0xf6, 0xcd, 0xec, 0xfb, 0xf8, 0xbd, 0x8f, 0x79, 0x33, 0x82, 0x15, 0x27, 0xc0,
+	0xd8, 0xdf, 0x73, 0xb1, 0x67, 0x6f, 0x50, 0x46, 0x02, 0xe4, 0xe0, 0x0d, 0x7c, 0x80, 0x7d, 0x46,
+	0xd7, 0xbb, 0x01, 0x61, 0x44, 0xd3, 0x06, 0x04, 0xeb, 0x92, 0x60, 0xf1, 0xf3, 0x16, 0xa1, 0x1d,
+	0x42, 0x4d, 0x4e, 0xb1, 0x21, 0x1e, 0x04, 0xf9, 0xe2, 0x39, 0x87, 0x38, 0x44, 0x8c, 0x87, 0xbf,
+	0xe4, 0xe8, 0x8a, 0x43, 0x88, 0xe3, 0xe1, 0x0d, 0xfe, 0xd4, 0xee, 0xed, 0x6d, 0x30, 0xb7, 0x83,
+	0x29, 0x43, 0x9d, 0x6e, 0x4c, 0x30, 0x60, 0x23, 0xc0, 0x94, 0xf4, 0x02, 0x0b, 0x6f, 0xb0, 0x7e,
+	0x17, 0xd3, 0x11, 0x04, 0x11, 0x9f, 0x16, 0xe9, 0x74, 0x88, 0x2f, 0x09, 0x96, 0x47, 0x10, 0x24,
+	0x16, 0xd0, 0xff, 0xac, 0xc2, 0xc2, 0xf5, 0x50, 0xb0, 0x9d, 0x00, 0x23, 0x86, 0xb7, 0x7b, 0xd6,
+	0x1d, 0xcc, 0xb4, 0x75, 0x28, 0x91, 0x43, 0x1f, 0x07, 0x0d, 0x65, 0x55, 0x59, 0xab, 0x6e, 0x37,
+	0xee, 0xbf, 0x73, 0xf9, 0x9c, 0x94, 0x67, 0xcb, 0xb6, 0x03, 0x4c, 0x69, 0x8b, 0x05, 0xae, 0xef,
+	0x18, 0x82, 0x4c, 0x5b, 0x81, 0x5a, 0x9b, 0xcf, 0x34, 0x7d, 0xd4, 0xc1, 0x8d, 0x42, 0x38, 0xcb,
+	0x00, 0x31, 0xf4, 0x2a, 0xea, 0x60, 0x6d, 0x1b, 0xe0, 0xc0, 0xa5, 0x6e, 0xdb, 0xf5, 0x5c, 0xd6,
+	0x6f, 0x14, 0x57, 0x95, 0xb5, 0xb9, 0x4d, 0x7d, 0x3d, 0xab, 0xc3, 0xf5, 0xdb, 0x31, 0xd5, 0xad,
+	0x7e, 0x17, 0x1b, 0x89, 0x59, 0xda, 0x05, 0xa8, 0x5a, 0x9c, 0x49, 0x13, 0xb1, 0x86, 0xba, 0xaa,
+	0xac, 0x15, 0x8d, 0x8a, 0x18, 0xd8, 0x62, 0xda, 0x15, 0xa8, 0x4a, 0x0e, 0x5c, 0xbb, 0x51, 0xe2,
+	0x5c, 0x5f, 0xb8, 0xf7, 0xe1, 0xca, 0x99, 0x0f, 0x3e, 0x5c, 0x51, 0x5f, 0x73, 0x7d, 0x76, 0xff,
+	0x9d, 0xcb, 0x35, 0x29, 0x41, 0xf8, 0x68, 0x54, 0x04, 0x75, 0xd3, 0xd6, 0xae, 0x42, 0x4d, 0x28,
+	0xd6, 0x0c, 0xf5, 0xd2, 0x28, 0x73, 0xde, 0x96, 0x47, 0xf1, 0xd6, 0xe2, 0x64, 0x82, 0x2f, 0x1a,
+	0xff, 0xd6, 0xbe, 0x04, 0x9a, 0xb5, 0x8f, 0x02, 0x07, 0xdb, 0x66, 0x80, 0x91, 0x6d, 0xbe, 0xde,
+	0x23, 0x0c, 0x35, 0x66, 0x56, 0x95, 0x35, 0xd5, 0x38, 0x2b, 0xdf, 0x18, 0x18, 0xd9, 0xdf, 0x09,
+	0xc7, 0xb5, 0x2d, 0x98, 0xef, 0xa2, 0x7e, 0x07, 0xfb, 0xcc, 0x44, 0x42, 0x95, 0x8d, 0xca, 0x18,
+	0x25, 0xcf, 0xc9, 0x09, 0x72, 0x54, 0xd3, 0xa1, 0xde, 0x0d, 0xdc, 0x0e, 0x0a, 0xfa, 0x26, 0xed,
+	0x86, 0xf2, 0x56, 0x57, 0x95, 0xb5, 0xba, 0x51, 0x93, 0x83, 0xad, 0x6e, 0xd3, 0xd6, 0xb6, 0x61,
+	0xd9, 0xf1, 0x48, 0x1b, 0x79, 0xe6, 0x81, 0x1b, 0xb0, 0x1e, 0xf2, 0x4c, 0x27, 0x20, 0xbd, 0xae,
+	0xb9, 0x87, 0x3a, 0xae, 0xd7, 0x0f, 0x27, 0x01, 0x9f, 0xb4, 0x28, 0xa8, 0x6e, 0x0b, 0xa2, 0x6f,
+	0x86, 0x34, 0x2f, 0x73, 0x92, 0xa6, 0xad, 0x5d, 0x81, 0x32, 0x65, 0x88, 0xf5, 0x68, 0xa3, 0xc6,
+	0x95, 0xb2, 0x3a, 0x4a, 0x29, 0x02, 0x31, 0x2d, 0x4e, 0x67, 0x48, 0x7a, 0xfd, 0x67, 0x05, 0x89,
+	0xaa, 0x6b, 0xd8, 0xc3, 0x31, 0xaa, 0x5e, 0x82, 0x0a, 0xe9, 0xe2, 0x00, 0x31, 0x32, 0x1e, 0x58,
+	0x31, 0xe5, 0x00, 0x8b, 0x85, 0xa9, 0xb0, 0x58, 0xcc, 0x60, 0x31, 0x05, 0x15, 0x35, 0x0f, 0x54,
+	0xc6, 0x2b, 0xb5, 0x34, 0x4e, 0xa9, 0xfa, 0xf7, 0x8b, 0xf0, 0x39, 0xae, 0x9a, 0xd7, 0xba, 0x76,
+	0xec, 0x70, 0x4d, 0x7f, 0x8f, 0x4c, 0xa9, 0x9e, 0xb1, 0xae, 0x97, 0x12, 0xb7, 0x98, 0x47, 0xdc,
+	0xd1, 0xc0, 0x56, 0x8f, 0x00, 0xf6, 0x17, 0xb3, 0xc0, 0xe6, 0x7e, 0x98, 0x81, 0x6f, 0x3a, 0x16,
+	0x94, 0xa7, 0x8a, 0x05, 0xe3, 0x2d, 0x31, 0x33, 0xd6, 0x12, 0xbf, 0x55, 0xe0, 0xbc, 0x00, 0xa9,
+	0x4b, 0x2d, 0xe2, 0x33, 0xd7, 0xef, 0x45, 0x48, 0x4d, 0xe9, 0x4c, 0xc9, 0xa3, 0xb3, 0xb1, 0xe6,
+	0x38, 0x0f, 0xe5, 0x00, 0x23, 0x4a, 0x7c, 0x89, 0x4c, 0xf9, 0x14, 0x46, 0x37, 0x9b, 0x3b, 0x4b,
+	0x22, 0xba, 0x89, 0x81, 0x2d, 0xa6, 0xff, 0xa4, 0x9c, 0x8a, 0xd2, 0x37, 0xdb, 0xdf, 0xc3, 0x16,
+	0xd3, 0x36, 0x61, 0x86, 0xc7, 0xbf, 0x09, 0xf0, 0x12, 0x11, 0x7e, 0xf2, 0xde, 0xb4, 0x02, 0x35,
+	0xc2, 0xd9, 0x11, 0x04, 0xaa, 0x20, 0x10, 0x43, 0x59, 0xfc, 0x95, 0xf3, 0xe8, 0xf2, 0x0a, 0x54,
+	0xe5, 0xd2, 0xd2, 0x9e, 0xe3, 0x66, 0x0a, 0xea, 0xa6, 0x9d, 0x8d, 0x90, 0x95, 0x6c, 0x84, 0xbc,
+	0x08, 0xb3, 0x5d, 0xd4, 0xf7, 0x08, 0xb2, 0x4d, 0xea, 0xbe, 0x81, 0x79, 0x10, 0x55, 0x8d, 0x9a,
+	0x1c, 0x6b, 0xb9, 0x6f, 0x0c, 0x67, 0x2d, 0x98, 0x0a, 0xa9, 0x17, 0x61, 0x36, 0x04, 0x57, 0xe8,
+	0x16, 0x3c, 0xbf, 0xd4, 0xb8, 0x82, 0x6a, 0x72, 0x8c, 0x27, 0x90, 0x54, 0x62, 0x9b, 0xcd, 0x24,
+	0xb6, 0x28, 0x08, 0xd7, 0x8f, 0x0e, 0xc2, 0x02, 0x10, 0xe9, 0x20, 0xac, 0x7d, 0x1b, 0xe6, 0x03,
+	0x6c, 0xf7, 0x7c, 0x1b, 0xf9, 0x56, 0x5f, 0x7c, 0x7c, 0xee, 0x68, 0x11, 0x8c, 0x98, 0x94, 0x8b,
+	0x30, 0x17, 0xa4, 0x9e, 0x87, 0xb3, 0xe4, 0x7c, 0xee, 0x2c, 0xf9, 0x0c, 0x54, 0xad, 0x7d, 0x6c,
+	0xdd, 0xa1, 0xbd, 0x0e, 0x6d, 0x9c, 0x5d, 0x2d, 0xae, 0xcd, 0x1a, 0x83, 0x01, 0xed, 0x45, 0x38,
+	0xef, 0x11, 0x2b, 0xe3, 0xce, 0xae, 0xdd, 0x58, 0xe0, 0x96, 0x7b, 0x8a, 0xbf, 0x4d, 0xba, 0x71,
+	0xd3, 0xd6, 0xff, 0xab, 0xc0, 0xd3, 0xc2, 0x2b, 0x90, 0x6f, 0x61, 0x2f, 0xe5, 0x1b, 0xc7, 0x14,
+	0x4c, 0x87, 0xd0, 0x5e, 0xcc, 0xa0, 0x3d, 0x83, 0x3c, 0x35, 0x8b, 0xbc, 0x14, 0xae, 0xcb, 0x39,
+	0x70, 0x1d, 0x26, 0x8f, 0x79, 0x2e, 0x71, 0x0b, 0x23, 0xef, 0x84, 0x25, 0x4d, 0x49, 0x51, 0xca,
+	0xe3, 0x9d, 0x03, 0x48, 0x97, 0x73, 0x42, 0xfa, 0xcb, 0xf0, 0xf4, 0xc8, 0xb0, 0x1f, 0xc7, 0xfb,
+	0x73, 0xd9, 0x78, 0xdf, 0xb4, 0x1f, 0x81, 0xae, 0xca, 0x91, 0xe8, 0x4a, 0x03, 0xb6, 0x3a, 0x04,
+	0x58, 0xfd, 0x97, 0x91, 0x25, 0x76, 0x48, 0xb7, 0xff, 0x58, 0x96, 0xb8, 0x04, 0xf3, 0x34, 0xb0,
+	0xcc, 0xac, 0x35, 0xea, 0x34, 0xb0, 0xb6, 0x07, 0x06, 0x91, 0x74, 0x59, 0xa3, 0x84, 0x74, 0x37,
+	0x07, 0x76, 0xb9, 0x04, 0xf3, 0x36, 0x65, 0xa9, 0xf5, 0x44, 0x50, 0xae, 0xdb, 0x94, 0xa5, 0xd7,
+	0x0b, 0xe9, 0x92, 0xeb, 0x95, 0x62, 0xba, 0xc4, 0x7a, 0x57, 0xa1, 0x9e, 0xf8, 0xee, 0x64, 0x88,
+	0xad, 0xc5, 0x2c, 0xf1, 0x02, 0xbb, 0x9e, 0xf8, 0xd0, 0x64, 0xa1, 0xbc, 0x16, 0xf3, 0x30, 0xa5,
+	0xf9, 0xf4, 0xff, 0x2b, 0xa9, 0x12, 0xf4, 0x34, 0x39, 0x8b, 0x9a, 0xc7, 0x59, 0x8e, 0x16, 0xbe,
+	0x74, 0xb4, 0xf0, 0xff, 0x52, 0x64, 0x91, 0x69, 0x60, 0xee, 0x45, 0xa7, 0x2c, 0x5a, 0xe4, 0x52,
+	0xc0, 0x12, 0xc0, 0x1e, 0x09, 0xcc, 0x1e, 0x2f, 0x97, 0xb9, 0xd0, 0x15, 0xa3, 0xba, 0x47, 0x02,
+	0x51, 0x3f, 0x8f, 0xac, 0xe2, 0xa4, 0xac, 0x43, 0x5c, 0x2b, 0xa3, 0x4a, 0xe3, 0x01, 0x53, 0x85,
+	0x3c, 0x4c, 0x4d, 0x55, 0xc5, 0xfd, 0xb8, 0x90, 0x2a, 0xfd, 0x25, 0xbe, 0x8f, 0xb1, 0xf4, 0x3f,
+	0x46, 0xab, 0xa4, 0x4b, 0xa3, 0xd2, 0x34, 0xa5, 0x91, 0xfe, 0x3f, 0x05, 0xce, 0x26, 0xaa, 0x5a,
+	0x0e, 0xde, 0xdc, 0xad, 0x87, 0x25, 0x00, 0xe1, 0x11, 0x09, 0x1d, 0x54, 0xf9, 0x08, 0x97, 0xf0,
+	0x2b, 0x50, 0x89, 0x1d, 0x66, 0x82, 0xcd, 0xcf, 0x8c, 0x23, 0xa3, 0xff, 0x50, 0xbd, 0xa3, 0xe6,
+	0xae, 0x77, 0xce, 0x41, 0x09, 0xdf, 0x65, 0x01, 0x92, 0x41, 0x55, 0x3c, 0xe8, 0x6f, 0x45, 0x22,
+	0x8b, 0xa8, 0x34, 0x24, 0x72, 0x61, 0x1a, 0x91, 0x8b, 0x8f, 0x12, 0x59, 0x9d, 0x5c, 0x64, 0xfd,
+	0x2f, 0x8a, 0x4c, 0x69, 0x37, 0x30, 0x3a, 0x90, 0xac, 0x5d, 0x85, 0xb9, 0x0e, 0xee, 0xb4, 0x71,
+	0x10, 0xef, 0xe9, 0xc6, 0x99, 0xa5, 0x2e, 0xe8, 0xa3, 0xcd, 0xde, 0x29, 0x91, 0xed, 0x3f, 0x05,
+	0x19, 0x25, 0x84, 0xeb, 0x71, 0xe1, 0x5e, 0xe1, 0x8c, 0x7e, 0x4a, 0x5d, 0x89, 0xe3, 0x91, 0x4b,
+	0xdb, 0x8d, 0xec, 0x43, 0x4d, 0x46, 0x42, 0x1b, 0x35, 0x4a, 0xab, 0xc5, 0xb5, 0xda, 0xe6, 0xf3,
+	0xa3, 0x90, 0xca, 0x15, 0x90, 0x10, 0xfd, 0x1a, 0x66, 0xc8, 0xf5, 0x8c, 0x59, 0xb9, 0xc2, 0x2d,
+	0xb2, 0x65, 0xdb, 0xda, 0x35, 0x58, 0x48, 0xac, 0x28, 0x62, 0x57, 0xa3, 0xbc, 0x5a, 0x7c, 0xa4,
+	0x90, 0xf3, 0xf1, 0x12, 0x02, 0xd7, 0xfa, 0x5f, 0x0b, 0x71, 0x02, 0xf2, 0xf1, 0xe1, 0x67, 0x46,
+	0xdd, 0x43, 0x51, 0xa1, 0x94, 0x3b, 0x2a, 0x5c, 0x83, 0x19, 0xa9, 0x2a, 0xae, 0xd3, 0x7c, 0x86,
+	0x8a, 0xa6, 0xea, 0x3f, 0x8d, 0x72, 0x5e, 0x86, 0x46, 0x7b, 0x01, 0xca, 0x82, 0x6a, 0xac, 0x72,
+	0x25, 0x9d, 0xd6, 0x84, 0x79, 0x7c, 0xb7, 0xeb, 0x06, 0x88, 0xb9, 0xc4, 0x37, 0x99, 0x2b, 0xa3,
+	0x68, 0x6d, 0x73, 0x71, 0x5d, 0xb4, 0xa7, 0xd7, 0xa3, 0xf6, 0xf4, 0xfa, 0xad, 0xa8, 0x3d, 0xbd,
+	0xad, 0xbe, 0xf9, 0xb7, 0x15, 0xc5, 0x98, 0x1b, 0x4c, 0x0c, 0x5f, 0xe9, 0xff, 0x56, 0x52, 0x09,
+	0x8e, 0x73, 0x77, 0x3d, 0x8c, 0x7b, 0x4f, 0xb6, 0xd5, 0x47, 0x87, 0xf2, 0x7b, 0x51, 0x81, 0xf9,
+	0x8a, 0x1b, 0x04, 0x24, 0x78, 0xac, 0x1e, 0x67, 0xbe, 0x26, 0x5e, 0xae, 0x9e, 0xa5, 0x0e, 0x75,
+	0x1b, 0x53, 0x66, 0x5a, 0xfb, 0xc8, 0xf5, 0x07, 0x65, 0x63, 0x2d, 0x1c, 0xdc, 0x09, 0xc7, 0x9a,
+	0xb6, 0xfe, 0xfb, 0x68, 0x23, 0x9d, 0x14, 0xc5, 0xc0, 0xb4, 0xe7, 0xb1, 0xb0, 0xd2, 0x91, 0x9b,
+	0x35, 0x85, 0x4f, 0x8c, 0xb6, 0x62, 0x27, 0xcc, 0xf2, 0x47, 0x69, 0xed, 0x3f, 0xb1, 0xd5, 0xed,
+	0x24, 0xb2, 0xbe, 0x97, 0x36, 0x8f, 0x90, 0xf5, 0x71, 0xcd, 0x73, 0xc2, 0x32, 0xfd, 0x21, 0x2a,
+	0x84, 0x84, 0x4c, 0xa7, 0xaa, 0xf6, 0xcb, 0xf0, 0xaf, 0x66, 0xf9, 0x7f, 0x3b, 0x0a, 0xc1, 0x09,
+	0xfe, 0xc7, 0x98, 0xe4, 0x04, 0xb9, 0x3d, 0x90, 0x00, 0x6a, 0x31, 0xe4, 0xe1, 0x5d, 0xe2, 0xb9,
+	0x56, 0x7f, 0xc7, 0xc3, 0xc8, 0xef, 0x75, 0xb5, 0x45, 0xa8, 0xb4, 0x3d, 0x62, 0xdd, 0x79, 0xb5,
+	0xd7, 0xe1, 0xfc, 0x16, 0x8d, 0xf8, 0x39, 0x4c, 0x77, 0x72, 0x37, 0xe3, 0xfa, 0x7b, 0x44, 0xa6,
+	0x85, 0x91, 0xe9, 0x4e, 0xa4, 0xfd, 0x70, 0x2f, 0x63, 0x80, 0x1d, 0xff, 0xd6, 0x7f, 0x54, 0x80,
+	0x73, 0x52, 0x4b, 0x8e, 0xc8, 0x13, 0x9f, 0x62, 0x98, 0xcc, 0x75, 0xd6, 0xf1, 0x1c, 0x2c, 0xd8,
+	0x94, 0x99, 0xa3, 0x7a, 0x77, 0x73, 0x36, 0x65, 0xbb, 0xa9, 0xf6, 0x5d, 0x64, 0xdf, 0x52, 0xce,
+	0x63, 0xb1, 0x7f, 0x2a, 0xb0, 0x98, 0x68, 0x58, 0x9e, 0x7a, 0xa5, 0x0c, 0x24, 0x55, 0x73, 0x4a,
+	0xfa, 0x0f, 0x05, 0x1a, 0x89, 0x06, 0x84, 0x90, 0x14, 0x7f, 0xf6, 0xe4, 0x7c, 0xbf, 0x00, 0xcf,
+	0xc8, 0x36, 0x60, 0xa7, 0x1b, 0xc2, 0xfe, 0xd4, 0xdb, 0x74, 0xfc, 0xc9, 0x99, 0x3a, 0xf6, 0x60,
+	0xf8, 0x39, 0x58, 0xa0, 0x81, 0x35, 0xe4, 0x2c, 0x22, 0xc8, 0xcf, 0xd1, 0xc0, 0x1a, 0xed, 0x2c,
+	0xe5, 0x9c, 0xaa, 0x35, 0xa1, 0x26, 0x5b, 0xdd, 0xec, 0x16, 0x72, 0xc2, 0x38, 0x15, 0xdd, 0x80,
+	0x90, 0x9d, 0x9c, 0xf8, 0x59, 0x7b, 0x09, 0x54, 0x86, 0x1c, 0x2a, 0x03, 0xd4, 0xea, 0xe8, 0xe3,
+	0x0d, 0x59, 0x85, 0x23, 0x87, 0x1a, 0x9c, 0x5a, 0xff, 0x4d, 0x41, 0x62, 0x34, 0xd9, 0x8e, 0xd9,
+	0x11, 0xe7, 0x32, 0x53, 0xda, 0x6d, 0xfa, 0x86, 0xd2, 0xe3, 0x9f, 0xb3, 0x0d, 0x9f, 0x67, 0x95,
+	0xb2, 0xe7, 0x59, 0xa9, 0x96, 0x76, 0x79, 0xf8, 0x0c, 0xa6, 0x01, 0x33, 0x07, 0x38, 0xa0, 0x2e,
+	0xf1, 0x79, 0x87, 0xb6, 0x68, 0x44, 0x8f, 0xfa, 0x7b, 0x45, 0x58, 0x39, 0x4a, 0x53, 0xad, 0x9e,
+	0x65, 0x85, 0x1b, 0xfd, 0x27, 0x52, 0x61, 0xa9, 0x93, 0xb9, 0x52, 0xf6, 0x64, 0xee, 0x79, 0x58,
+	0xe8, 0x06, 0xf8, 0xc0, 0x4c, 0x29, 0xb6, 0xcc, 0x15, 0x3b, 0x1f, 0xbe, 0xd8, 0x4d, 0x28, 0x77,
+	0x0d, 0xce, 0xfa, 0xf8, 0x30, 0x4d, 0x2a, 0x2e, 0x81, 0xcc, 0xf9, 0xf8, 0x30, 0x49, 0xf9, 0x2c,
+	0xcc, 0xf1, 0x55, 0x07, 0xb6, 0xa8, 0x70, 0x5b, 0xd4, 0xc3, 0xd1, 0x9d, 0xd8, 0x1e, 0x5f, 0x80,
+	0x7a, 0xb8, 0xe0, 0xf0, 0x21, 0xc4, 0xac, 0x8f, 0x0f, 0x77, 0x46, 0x19, 0x0d, 0x52, 0x46, 0x0b,
+	0xcb, 0x0d, 0xd1, 0x33, 0xb5, 0x4d, 0xc4, 0xf8, 0xb1, 0x63, 0xd1, 0xa8, 0xca, 0x91, 0x2d, 0xa6,
+	0xdf, 0x57, 0x60, 0x39, 0x91, 0x8b, 0x3e, 0x39, 0x1f, 0x38, 0xc1, 0xca, 0x53, 0xff, 0xa0, 0x00,
+	0x17, 0xa2, 0xa0, 0x21, 0x82, 0xca, 0xcb, 0x1e, 0x39, 0x34, 0x10, 0xc3, 0x37, 0xdc, 0x8e, 0x7b,
+	0x6c, 0x12, 0x8d, 0xb8, 0xd3, 0x53, 0xcc, 0x79, 0xa7, 0xe7, 0x6b, 0x30, 0x2b, 0xbf, 0x21, 0x2a,
+	0x60, 0x75, 0xcc, 0x7c, 0xc9, 0xd1, 0x4d, 0x5e, 0x07, 0xdb, 0x30, 0xbf, 0xe7, 0x91, 0x43, 0x33,
+	0xcc, 0xb1, 0xa6, 0x17, 0x4a, 0x2a, 0x0f, 0xe4, 0xbe, 0x2e, 0xd5, 0x76, 0xc9, 0x71, 0xd9, 0x7e,
+	0xaf, 0xbd, 0x6e, 0x91, 0x8e, 0xbc, 0x97, 0x26, 0xff, 0x5c, 0xa6, 0xf6, 0x1d, 0x79, 0x1f, 0xac,
+	0xc9, 0x15, 0x0b, 0xf2, 0x6b, 0x4d, 0x9f, 0x19, 0xf5, 0xbd, 0xa4, 0xf2, 0xf4, 0x9f, 0x47, 0x88,
+	0x19, 0xa1, 0xd9, 0xd6, 0xc8, 0x5d, 0x47, 0xb6, 0xe3, 0xbe, 0x04, 0xe0, 0x52, 0xc1, 0x22, 0x16,
+	0x0e, 0x5f, 0x31, 0xaa, 0x2e, 0xbd, 0x21, 0x06, 0xa6, 0x4f, 0x6b, 0xfa, 0x1f, 0x15, 0x58, 0xe2,
+	0xcc, 0xdd, 0x22, 0x8e, 0xe3, 0xe1, 0xd6, 0xee, 0x16, 0x0d, 0x6b, 0x52, 0x87, 0xa3, 0xdd, 0x09,
+	0xd1, 0x3c, 0xc9, 0x69, 0xc0, 0xe0, 0xe3, 0x85, 0x9c, 0x39, 0x95, 0x76, 0x4d, 0x44, 0x79, 0xbb,
+	0xcc, 0x11, 0x2e, 0x17, 0x7e, 0xd3, 0xb4, 0x5d, 0x8a, 0xda, 0x1e, 0x16, 0xb2, 0x54, 0x8c, 0x45,
+	0xda, 0x1d, 0x66, 0xeb, 0x9a, 0xa4, 0xd0, 0x7f, 0x1d, 0x55, 0x4c, 0x31, 0x74, 0x6f, 0x0b, 0x47,
+	0x76, 0x7d, 0xe7, 0x38, 0x79, 0xbf, 0x0c, 0xda, 0x41, 0xfc, 0x21, 0x13, 0xfb, 0x49, 0x7e, 0x17,
+	0x06, 0x6f, 0xae, 0x8b, 0x17, 0xfa, 0x0f, 0xa2, 0xa4, 0x99, 0x3c, 0x6d, 0x97, 0x9c, 0x8e, 0x67,
+	0x73, 0xc8, 0xf5, 0x0b, 0x8f, 0x76, 0xfd, 0x62, 0x9e, 0x7c, 0x90, 0x08, 0x84, 0x6a, 0x3a, 0x10,
+	0x4e, 0x73, 0x82, 0x96, 0xc9, 0xa6, 0xe5, 0x4c, 0x36, 0xd5, 0x7f, 0x11, 0xa9, 0x22, 0x79, 0xc2,
+	0x18, 0xa9, 0xe2, 0xc9, 0xeb, 0x44, 0x24, 0x14, 0x58, 0x9a, 0x54, 0x81, 0xe5, 0xa3, 0x8f, 0x20,
+	0x3f, 0x8a, 0x9a, 0x16, 0x31, 0x9e, 0x6f, 0xb8, 0x7b, 0xd8, 0xea, 0x5b, 0x1e, 0x3e, 0x7d, 0x45,
+	0xf1, 0x37, 0xa0, 0x14, 0xf4, 0x3c, 0x1c, 0xd6, 0xff, 0xc5, 0xb5, 0xda, 0xe6, 0xc5, 0x51, 0x15,
+	0x64, 0xcc, 0xbe, 0xd1, 0xf3, 0xf0, 0xb6, 0x1a, 0x2e, 0x6c, 0x88, 0x59, 0xfa, 0x9f, 0xa2, 0x66,
+	0x54, 0x0b, 0x33, 0x03, 0x87, 0xb9, 0xf3, 0x24, 0x21, 0xb0, 0x05, 0xd5, 0x20, 0x62, 0x82, 0x43,
+	0xa0, 0xb6, 0xb9, 0x34, 0xba, 0x24, 0x96, 0x44, 0x52, 0x98, 0xc1, 0x2c, 0xfd, 0x77, 0x09, 0x81,
+	0x6e, 0x60, 0x07, 0x79, 0xdf, 0x22, 0x9e, 0x7d, 0x62, 0x02, 0x2d, 0x01, 0x84, 0x21, 0xd3, 0x33,
+	0xf7, 0x89, 0x27, 0x40, 0x5d, 0x31, 0xaa, 0x5e, 0xc4, 0x96, 0xfe, 0x56, 0x01, 0xb4, 0x78, 0x0f,
+	0x46, 0xe8, 0x93, 0x7b, 0xd4, 0x3f, 0x41, 0x05, 0xbf, 0x0e, 0x4f, 0xc9, 0x03, 0x88, 0x04, 0x13,
+	0xa2, 0x96, 0xaf, 0x1a, 0x0b, 0xe2, 0xd5, 0xe0, 0xee, 0x06, 0xd5, 0xdf, 0x2e, 0x48, 0x2f, 0xdc,
+	0x46, 0xcc, 0xda, 0x4f, 0xc6, 0x6c, 0x3a, 0xd5, 0xf5, 0xc1, 0x63, 0xf4, 0xc1, 0x49, 0x6e, 0x4e,
+	0x5d, 0x84, 0xd9, 0x94, 0xdc, 0x25, 0x2e, 0x77, 0x6d, 0xa0, 0x7d, 0xaa, 0x7d, 0x15, 0x20, 0x56,
+	0xbf, 0x54, 0xcc, 0xa3, 0x39, 0xa8, 0x46, 0xfa, 0xa7, 0xfa, 0x0f, 0x53, 0xda, 0x4a, 0x86, 0x75,
+	0x7a, 0xfa, 0x62, 0xd6, 0xb0, 0x2e, 0xd4, 0x71, 0xba, 0x28, 0xe5, 0xd2, 0xc5, 0xaf, 0x94, 0xe1,
+	0x52, 0xda, 0xc0, 0xaf, 0xf7, 0x30, 0x65, 0x38, 0xd8, 0x45, 0x7d, 0x7a, 0x9c, 0x25, 0xc9, 0xb3,
+	0x30, 0x17, 0x44, 0xdf, 0x0a, 0xf7, 0x53, 0x54, 0x96, 0x23, 0xf5, 0x20, 0xc9, 0x41, 0x68, 0x2d,
+	0x91, 0x7f, 0x77, 0x7b, 0x81, 0xb5, 0x8f, 0x28, 0x36, 0x30, 0xb2, 0x71, 0x20, 0x6e, 0x23, 0xbf,
+	0xc0, 0x2f, 0x68, 0xd8, 0x93, 0x9c, 0x83, 0x09, 0xba, 0xe3, 0x34, 0xd5, 0x88, 0xfd, 0x81, 0x9a,
+	0x73, 0x7f, 0xb0, 0x04, 0x90, 0xb8, 0x83, 0x2d, 0x22, 0x43, 0x35, 0x88, 0x2e, 0x5f, 0x6f, 0x37,
+	0xef, 0x3d, 0x58, 0x56, 0xde, 0x7d, 0xb0, 0xac, 0xfc, 0xfd, 0xc1, 0xb2, 0xf2, 0xe6, 0xc3, 0xe5,
+	0x33, 0xef, 0x3e, 0x5c, 0x3e, 0xf3, 0xfe, 0xc3, 0xe5, 0x33, 0xdf, 0xdd, 0x48, 0x94, 0xfe, 0x6d,
+	0xbf, 0x7d, 0x99, 0xb7, 0x89, 0x37, 0x12, 0xff, 0x15, 0x72, 0x37, 0xfd, 0x7f, 0x21, 0xed, 0x32,
+	0x3f, 0xee, 0x7b, 0xf1, 0xe3, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x52, 0x87, 0x47, 0x03, 0x33,
+	0x00, 0x00,
+}
+
+func (m *EventCreateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x58
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
 		i--
 		dAtA[i] = 0x38
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
 		i--
 		dAtA[i] = 0x30
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x2a
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	if m.CreateAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -3605,17 +3989,17 @@ func (m *EventSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCopyObject) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3625,66 +4009,42 @@ func (m *EventCopyObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCopyObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.DstObjectId.Size()
-		i -= size
-		if _, err := m.DstObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		dAtA[i] = 0x28
 	}
-	i--
-	dAtA[i] = 0x3a
 	{
-		size := m.SrcObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.SrcObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.DstObjectName) > 0 {
-		i -= len(m.DstObjectName)
-		copy(dAtA[i:], m.DstObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstObjectName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DstBucketName) > 0 {
-		i -= len(m.DstBucketName)
-		copy(dAtA[i:], m.DstBucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstBucketName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SrcObjectName) > 0 {
-		i -= len(m.SrcObjectName)
-		copy(dAtA[i:], m.SrcObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcObjectName)))
+	dAtA[i] = 0x22
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.SrcBucketName) > 0 {
-		i -= len(m.SrcBucketName)
-		copy(dAtA[i:], m.SrcBucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcBucketName)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3698,7 +4058,7 @@ func (m *EventCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteObject) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3708,38 +4068,48 @@ func (m *EventDeleteObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDeleteObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x38
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -3757,7 +4127,7 @@ func (m *EventDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRejectSealObject) Marshal() (dAtA []byte, err error) {
+func (m *EventDiscontinueBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3767,40 +4137,25 @@ func (m *EventRejectSealObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ForUpdate {
-		i--
-		if m.ForUpdate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.DeleteAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DeleteAt))
 		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3811,17 +4166,20 @@ func (m *EventRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDiscontinueObject) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3831,27 +4189,75 @@ func (m *EventDiscontinueObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DeleteAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DeleteAt))
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x78
+	}
+	if m.RedundancyType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x40
 	}
 	{
 		size := m.ObjectId.Size()
@@ -3862,18 +4268,49 @@ func (m *EventDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x3a
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelCreateObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3883,21 +4320,16 @@ func (m *EventUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x28
-	}
 	{
 		size := m.ObjectId.Size()
 		i -= size
@@ -3907,7 +4339,12 @@ func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x32
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ObjectName) > 0 {
 		i -= len(m.ObjectName)
 		copy(dAtA[i:], m.ObjectName)
@@ -3932,7 +4369,7 @@ func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3942,56 +4379,75 @@ func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCreateGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x40
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	dAtA[i] = 0x2a
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventCopyObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4001,98 +4457,80 @@ func (m *EventDeleteGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCopyObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
-		size := m.GroupId.Size()
+		size := m.DstObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DstObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *EventLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	dAtA[i] = 0x3a
 	{
-		size := m.GroupId.Size()
+		size := m.SrcObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SrcObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	dAtA[i] = 0x32
+	if len(m.DstObjectName) > 0 {
+		i -= len(m.DstObjectName)
+		copy(dAtA[i:], m.DstObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstObjectName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DstBucketName) > 0 {
+		i -= len(m.DstBucketName)
+		copy(dAtA[i:], m.DstBucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstBucketName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcObjectName) > 0 {
+		i -= len(m.SrcObjectName)
+		copy(dAtA[i:], m.SrcObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.SrcBucketName) > 0 {
+		i -= len(m.SrcBucketName)
+		copy(dAtA[i:], m.SrcBucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcBucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MemberAddress) > 0 {
-		i -= len(m.MemberAddress)
-		copy(dAtA[i:], m.MemberAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MemberAddress)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateGroupMember) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4102,60 +4540,42 @@ func (m *EventUpdateGroupMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MembersToDelete) > 0 {
-		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MembersToDelete[iNdEx])
-			copy(dAtA[i:], m.MembersToDelete[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.MembersToAdd) > 0 {
-		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4169,7 +4589,7 @@ func (m *EventUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRenewGroupMember) Marshal() (dAtA []byte, err error) {
+func (m *EventRejectSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4179,56 +4599,47 @@ func (m *EventRenewGroupMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	if m.ForUpdate {
+		i--
+		if m.ForUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
 		i--
 		dAtA[i] = 0x28
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4242,7 +4653,7 @@ func (m *EventRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventGroupMemberDetail) Marshal() (dAtA []byte, err error) {
+func (m *EventDiscontinueObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4252,37 +4663,49 @@ func (m *EventGroupMemberDetail) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventGroupMemberDetail) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventGroupMemberDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpirationTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintEvents(dAtA, i, uint64(n1))
+	if m.DeleteAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DeleteAt))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Member)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4292,44 +4715,42 @@ func (m *EventUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4343,7 +4764,7 @@ func (m *EventUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4353,49 +4774,56 @@ func (m *EventMirrorBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.BucketId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	dAtA[i] = 0x1a
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorBucketResult) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4405,47 +4833,44 @@ func (m *EventMirrorBucketResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorBucketResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorBucketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
-	}
 	{
-		size := m.BucketId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorObject) Marshal() (dAtA []byte, err error) {
+func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4455,56 +4880,51 @@ func (m *EventMirrorObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
-	}
 	{
-		size := m.ObjectId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MemberAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorObjectResult) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4514,54 +4934,74 @@ func (m *EventMirrorObjectResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorObjectResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorObjectResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
+	if len(m.MembersToDelete) > 0 {
+		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MembersToDelete[iNdEx])
+			copy(dAtA[i:], m.MembersToDelete[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MembersToAdd) > 0 {
+		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventRenewGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4571,22 +5011,36 @@ func (m *EventMirrorGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
 		size := m.GroupId.Size()
 		i -= size
 		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
@@ -4595,25 +5049,32 @@ func (m *EventMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	if len(m.GroupName) > 0 {
 		i -= len(m.GroupName)
 		copy(dAtA[i:], m.GroupName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroupResult) Marshal() (dAtA []byte, err error) {
+func (m *EventGroupMemberDetail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4623,47 +5084,37 @@ func (m *EventMirrorGroupResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorGroupResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventGroupMemberDetail) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorGroupResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventGroupMemberDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.GroupId.Size()
-		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+		i -= n1
+		i = encodeVarintEvents(dAtA, i, uint64(n1))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Member)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventStalePolicyCleanup) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4673,37 +5124,58 @@ func (m *EventStalePolicyCleanup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventStalePolicyCleanup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStalePolicyCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DeleteInfo != nil {
-		{
-			size, err := m.DeleteInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BlockNum != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockNum))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMigrationBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4713,26 +5185,21 @@ func (m *EventMigrationBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.DstPrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DstPrimarySpId))
-		i--
-		dAtA[i] = 0x20
-	}
 	{
 		size := m.BucketId.Size()
 		i -= size
@@ -4742,7 +5209,7 @@ func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4760,7 +5227,7 @@ func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelMigrationBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorBucketResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4770,20 +5237,20 @@ func (m *EventCancelMigrationBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorBucketResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorBucketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
 	{
 		size := m.BucketId.Size()
@@ -4794,7 +5261,7 @@ func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4802,17 +5269,15 @@ func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4822,31 +5287,38 @@ func (m *EventRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.BucketId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4864,7 +5336,7 @@ func (m *EventRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteMigrationBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorObjectResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4874,41 +5346,38 @@ func (m *EventCompleteMigrationBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCompleteMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorObjectResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCompleteMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorObjectResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.SrcPrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SrcPrimarySpId))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x20
-	}
 	{
-		size := m.BucketId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4916,17 +5385,15 @@ func (m *EventCompleteMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetTag) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4936,39 +5403,49 @@ func (m *EventSetTag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetTag) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Resource)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorGroupResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4978,70 +5455,47 @@ func (m *EventUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorGroupResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorGroupResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	dAtA[i] = 0x1a
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectContentSuccess) Marshal() (dAtA []byte, err error) {
+func (m *EventStalePolicyCleanup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5051,96 +5505,37 @@ func (m *EventUpdateObjectContentSuccess) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateObjectContentSuccess) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventStalePolicyCleanup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateObjectContentSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventStalePolicyCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpdatedAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Version != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.NewChecksums) > 0 {
-		for iNdEx := len(m.NewChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NewChecksums[iNdEx])
-			copy(dAtA[i:], m.NewChecksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.PrevChecksums) > 0 {
-		for iNdEx := len(m.PrevChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PrevChecksums[iNdEx])
-			copy(dAtA[i:], m.PrevChecksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+	if m.DeleteInfo != nil {
+		{
+			size, err := m.DeleteInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-	}
-	if m.NewPayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewPayloadSize))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.PrevPayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrevPayloadSize))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if m.BlockNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockNum))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *EventMigrationBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5150,33 +5545,36 @@ func (m *EventCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DstPrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DstPrimarySpId))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -5194,7 +5592,7 @@ func (m *EventCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelMigrationBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5204,40 +5602,31 @@ func (m *EventSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size := m.FlowRateLimit.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketOwner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -5255,7 +5644,7 @@ func (m *EventSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBucketFlowRateLimitStatus) Marshal() (dAtA []byte, err error) {
+func (m *EventRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5265,16 +5654,21 @@ func (m *EventBucketFlowRateLimitStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBucketFlowRateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBucketFlowRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.BucketId.Size()
 		i -= size
@@ -5285,27 +5679,24 @@ func (m *EventBucketFlowRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.IsLimited {
-		i--
-		if m.IsLimited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+func (m *EventCompleteMigrationBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5315,25 +5706,30 @@ func (m *EventToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCompleteMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCompleteMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SpAsDelegatedAgentDisabled {
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		if m.SpAsDelegatedAgentDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x30
+	}
+	if m.SrcPrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SrcPrimarySpId))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
 		size := m.BucketId.Size()
@@ -5344,754 +5740,1138 @@ func (m *EventToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int,
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventSetTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovEvents(uint64(m.CreateAt))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
-	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
-	}
-	return n
+
+func (m *EventSetTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeleteBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
+	if m.PayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDiscontinueBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovEvents(uint64(m.DeleteAt))
+func (m *EventUpdateObjectContentSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventUpdateObjectContentSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateObjectContentSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.UpdatedAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovEvents(uint64(m.PayloadSize))
+	if len(m.NewChecksums) > 0 {
+		for iNdEx := len(m.NewChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewChecksums[iNdEx])
+			copy(dAtA[i:], m.NewChecksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
+	if len(m.PrevChecksums) > 0 {
+		for iNdEx := len(m.PrevChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrevChecksums[iNdEx])
+			copy(dAtA[i:], m.PrevChecksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.NewPayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewPayloadSize))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.CreateAt != 0 {
-		n += 1 + sovEvents(uint64(m.CreateAt))
+	if m.PrevPayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrevPayloadSize))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovEvents(uint64(m.RedundancyType))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 2 + l + sovEvents(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.LocalVirtualGroupId != 0 {
-		n += 2 + sovEvents(uint64(m.LocalVirtualGroupId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSealObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCopyObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventBucketFlowRateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SrcBucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SrcObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DstBucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DstObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.SrcObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.DstObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventDeleteObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventBucketFlowRateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBucketFlowRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.IsLimited {
+		i--
+		if m.IsLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRejectSealObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.SpAsDelegatedAgentDisabled {
+		i--
+		if m.SpAsDelegatedAgentDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ForUpdate {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDiscontinueObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketVersioning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketVersioning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketVersioning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.VersioningEnabled {
+		i--
+		if m.VersioningEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovEvents(uint64(m.DeleteAt))
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCreateObjectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateObjectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
-	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventDeleteObjectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventDeleteGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventDeleteObjectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeleteObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func (m *EventLeaveGroup) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.MemberAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.MembersToAdd) > 0 {
-		for _, e := range m.MembersToAdd {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.MembersToDelete) > 0 {
-		for _, s := range m.MembersToDelete {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRenewGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventGroupMemberDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSetLegalHold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupExtra) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSetLegalHold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetLegalHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.LegalHold {
+		i--
+		if m.LegalHold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+func (m *EventComposeObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMirrorBucketResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
-	}
-	return n
+func (m *EventComposeObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventComposeObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SourceObjectNames) > 0 {
+		for iNdEx := len(m.SourceObjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceObjectNames[iNdEx])
+			copy(dAtA[i:], m.SourceObjectNames[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceObjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorObjectResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBatchCreateObjects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBatchCreateObjects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBatchCreateObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ObjectIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectNames) > 0 {
+		for iNdEx := len(m.ObjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectNames[iNdEx])
+			copy(dAtA[i:], m.ObjectNames[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBatchDeleteObjects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBatchDeleteObjects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBatchDeleteObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ObjectIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectNames) > 0 {
+		for iNdEx := len(m.ObjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectNames[iNdEx])
+			copy(dAtA[i:], m.ObjectNames[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroupResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketRequesterPays) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketRequesterPays) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketRequesterPays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.RequesterPays {
+		i--
+		if m.RequesterPays {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventStalePolicyCleanup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPurchaseReaderQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPurchaseReaderQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPurchaseReaderQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockNum != 0 {
-		n += 1 + sovEvents(uint64(m.BlockNum))
+	if m.ReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.DeleteInfo != nil {
-		l = m.DeleteInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reader) > 0 {
+		i -= len(m.Reader)
+		copy(dAtA[i:], m.Reader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMigrationBucket) Size() (n int) {
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -6099,40 +6879,37 @@ func (m *EventMigrationBucket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovEvents(uint64(m.CreateAt))
+	}
 	l = m.BucketId.Size()
 	n += 1 + l + sovEvents(uint64(l))
-	if m.DstPrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.DstPrimarySpId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
 	}
-	return n
-}
-
-func (m *EventCancelMigrationBucket) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
 	}
-	var l int
-	_ = l
-	l = len(m.Operator)
+	l = len(m.PaymentAddress)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	if m.Status != 0 {
 		n += 1 + sovEvents(uint64(m.Status))
 	}
 	return n
 }
 
-func (m *EventRejectMigrateBucket) Size() (n int) {
+func (m *EventDeleteBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6142,19 +6919,23 @@ func (m *EventRejectMigrateBucket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	l = m.BucketId.Size()
 	n += 1 + l + sovEvents(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
 	}
 	return n
 }
 
-func (m *EventCompleteMigrationBucket) Size() (n int) {
+func (m *EventUpdateBucketInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6170,47 +6951,58 @@ func (m *EventCompleteMigrationBucket) Size() (n int) {
 	}
 	l = m.BucketId.Size()
 	n += 1 + l + sovEvents(uint64(l))
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
 	}
-	if m.SrcPrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.SrcPrimarySpId))
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
 	}
 	return n
 }
 
-func (m *EventSetTag) Size() (n int) {
+func (m *EventDiscontinueBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Resource)
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
+	l = len(m.Reason)
+	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovEvents(uint64(m.DeleteAt))
+	}
 	return n
 }
 
-func (m *EventUpdateObjectContent) Size() (n int) {
+func (m *EventCreateObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -6219,22 +7011,48 @@ func (m *EventUpdateObjectContent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
 	if m.PayloadSize != 0 {
 		n += 1 + sovEvents(uint64(m.PayloadSize))
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
 	}
-	if m.Version != 0 {
-		n += 1 + sovEvents(uint64(m.Version))
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
-
-func (m *EventUpdateObjectContentSuccess) Size() (n int) {
+	if m.CreateAt != 0 {
+		n += 1 + sovEvents(uint64(m.CreateAt))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovEvents(uint64(m.RedundancyType))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 2 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *EventCancelCreateObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6244,8 +7062,6 @@ func (m *EventUpdateObjectContentSuccess) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -6254,38 +7070,89 @@ func (m *EventUpdateObjectContentSuccess) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.ContentType)
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.PrevPayloadSize != 0 {
-		n += 1 + sovEvents(uint64(m.PrevPayloadSize))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.NewPayloadSize != 0 {
-		n += 1 + sovEvents(uint64(m.NewPayloadSize))
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	if len(m.PrevChecksums) > 0 {
-		for _, b := range m.PrevChecksums {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
 	}
-	if len(m.NewChecksums) > 0 {
-		for _, b := range m.NewChecksums {
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
 			l = len(b)
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	if m.Version != 0 {
-		n += 1 + sovEvents(uint64(m.Version))
+	return n
+}
+
+func (m *EventCopyObject) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovEvents(uint64(m.UpdatedAt))
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SrcBucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SrcObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstBucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SrcObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.DstObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
 	}
 	return n
 }
 
-func (m *EventCancelUpdateObjectContent) Size() (n int) {
+func (m *EventDeleteObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6305,10 +7172,13 @@ func (m *EventCancelUpdateObjectContent) Size() (n int) {
 	}
 	l = m.ObjectId.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
 	return n
 }
 
-func (m *EventSetBucketFlowRateLimit) Size() (n int) {
+func (m *EventRejectSealObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6322,62 +7192,2825 @@ func (m *EventSetBucketFlowRateLimit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.PaymentAddress)
+	l = len(m.ObjectName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.BucketOwner)
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ForUpdate {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDiscontinueObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.FlowRateLimit.Size()
+	l = m.ObjectId.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovEvents(uint64(m.DeleteAt))
+	}
 	return n
 }
 
-func (m *EventBucketFlowRateLimitStatus) Size() (n int) {
+func (m *EventUpdateObjectInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.IsLimited {
-		n += 2
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *EventCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeleteGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.MembersToAdd) > 0 {
+		for _, e := range m.MembersToAdd {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.MembersToDelete) > 0 {
+		for _, s := range m.MembersToDelete {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRenewGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventGroupMemberDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateGroupExtra) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMirrorBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorBucketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorObjectResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorGroupResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventStalePolicyCleanup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNum != 0 {
+		n += 1 + sovEvents(uint64(m.BlockNum))
+	}
+	if m.DeleteInfo != nil {
+		l = m.DeleteInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMigrationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DstPrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.DstPrimarySpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventCancelMigrationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventRejectMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventCompleteMigrationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.SrcPrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.SrcPrimarySpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventSetTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.PayloadSize))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *EventUpdateObjectContentSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PrevPayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.PrevPayloadSize))
+	}
+	if m.NewPayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.NewPayloadSize))
+	}
+	if len(m.PrevChecksums) > 0 {
+		for _, b := range m.PrevChecksums {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewChecksums) > 0 {
+		for _, b := range m.NewChecksums {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovEvents(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *EventCancelUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetBucketFlowRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBucketFlowRateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimited {
+		n += 2
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventToggleSPAsDelegatedAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SpAsDelegatedAgentDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSetBucketVersioning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.VersioningEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCreateObjectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.PayloadSize))
+	}
+	return n
+}
+
+func (m *EventDeleteObjectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *EventSetBucketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Retention.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetLegalHold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.LegalHold {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventComposeObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.PayloadSize))
+	}
+	if len(m.SourceObjectNames) > 0 {
+		for _, s := range m.SourceObjectNames {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBatchCreateObjects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if len(m.ObjectNames) > 0 {
+		for _, s := range m.ObjectNames {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, e := range m.ObjectIds {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBatchDeleteObjects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectNames) > 0 {
+		for _, s := range m.ObjectNames {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, e := range m.ObjectIds {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetBucketRequesterPays) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.RequesterPays {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventPurchaseReaderQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ReadQuota))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBucketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDiscontinueBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ObjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelCreateObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSealObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSealObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ObjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *EventToggleSPAsDelegatedAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SpAsDelegatedAgentDisabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
+func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6400,15 +10033,15 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCopyObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6436,11 +10069,11 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6468,13 +10101,13 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
 			}
-			m.Visibility = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6484,33 +10117,27 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6538,15 +10165,13 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DstBucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
 			}
-			m.SourceType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6556,33 +10181,27 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.ChargedReadQuota = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6610,13 +10229,15 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SrcObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectId", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6626,35 +10247,31 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 11:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DstObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
 			}
-			m.Status = 0
+			m.LocalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6664,7 +10281,7 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6690,7 +10307,7 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
+func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6713,10 +10330,10 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeleteObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6753,7 +10370,7 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6781,11 +10398,11 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6813,11 +10430,11 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6845,15 +10462,15 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			m.LocalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6863,7 +10480,7 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6889,7 +10506,7 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6912,10 +10529,10 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRejectSealObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6984,7 +10601,7 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7012,32 +10629,11 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
-			}
-			m.ChargedReadQuota = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7065,32 +10661,15 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForUpdate", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7100,11 +10679,12 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ForUpdate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -7126,7 +10706,7 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
+func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7149,15 +10729,15 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDiscontinueBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDiscontinueObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7185,13 +10765,11 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7219,7 +10797,9 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7293,7 +10873,7 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7316,15 +10896,15 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7352,11 +10932,11 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7384,11 +10964,11 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7416,11 +10996,11 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7448,13 +11028,15 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
 			}
-			var stringLen uint64
+			m.Visibility = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7464,29 +11046,64 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Visibility |= VisibilityType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7514,15 +11131,13 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7532,52 +11147,27 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 11:
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7605,32 +11195,15 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
-			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 13:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			m.Status = 0
+			m.SourceType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7640,16 +11213,16 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ObjectStatus(b&0x7F) << shift
+				m.SourceType |= SourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
-			m.RedundancyType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7659,16 +11232,79 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.SourceType = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extra = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7678,16 +11314,29 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7697,29 +11346,29 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7729,11 +11378,26 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -7755,7 +11419,7 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
+func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7778,15 +11442,15 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7814,11 +11478,11 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7846,11 +11510,11 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7878,30 +11542,11 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
-			}
-			m.PrimarySpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7929,7 +11574,7 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7954,7 +11599,7 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSealObject) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7977,10 +11622,10 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8017,7 +11662,7 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8045,11 +11690,11 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8077,11 +11722,11 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8109,53 +11754,15 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ObjectStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
-			}
-			m.GlobalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8165,16 +11772,31 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MembersToAdd = append(m.MembersToAdd, &EventGroupMemberDetail{})
+			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8184,23 +11806,23 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8223,7 +11845,7 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
+func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8246,10 +11868,10 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCopyObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRenewGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8286,7 +11908,7 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8314,11 +11936,11 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8346,11 +11968,11 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8378,13 +12000,15 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstBucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			var stringLen uint64
+			m.SourceType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8394,29 +12018,16 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SourceType |= SourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DstObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8426,29 +12037,79 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SrcObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &EventGroupMemberDetail{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGroupMemberDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGroupMemberDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8476,15 +12137,13 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DstObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8494,11 +12153,28 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8520,7 +12196,7 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8543,10 +12219,10 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateGroupExtra: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8583,7 +12259,7 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8611,11 +12287,11 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8643,11 +12319,11 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8675,15 +12351,15 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8693,11 +12369,24 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extra = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8719,7 +12408,7 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8742,10 +12431,10 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRejectSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8812,41 +12501,9 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8874,15 +12531,15 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			var v int
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8892,12 +12549,11 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForUpdate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8919,7 +12575,7 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8942,17 +12598,17 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDiscontinueObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorBucketResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorBucketResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8962,27 +12618,14 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9010,13 +12653,11 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9044,13 +12685,15 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.DeleteAt = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9060,7 +12703,7 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeleteAt |= int64(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9086,7 +12729,7 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9109,10 +12752,10 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9247,9 +12890,9 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.Visibility = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9259,7 +12902,7 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9285,7 +12928,7 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9308,17 +12951,17 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorObjectResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorObjectResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9328,27 +12971,14 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9376,11 +13006,11 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9408,32 +13038,11 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
-			}
-			m.SourceType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9461,8 +13070,29 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9484,7 +13114,7 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9507,13 +13137,13 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -9545,7 +13175,7 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
@@ -9577,7 +13207,7 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
@@ -9611,6 +13241,25 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9632,7 +13281,7 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9655,15 +13304,34 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorGroupResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorGroupResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9691,11 +13359,11 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9720,16 +13388,87 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStalePolicyCleanup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStalePolicyCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			}
+			m.BlockNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9739,29 +13478,16 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockNum |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9771,23 +13497,25 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DeleteInfo == nil {
+				m.DeleteInfo = &DeleteInfo{}
+			}
+			if err := m.DeleteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9812,7 +13540,7 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
+func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9835,10 +13563,10 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMigrationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9875,7 +13603,7 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9903,43 +13631,11 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9967,15 +13663,15 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
 			}
-			var msglen int
+			m.DstPrimarySpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9985,31 +13681,16 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DstPrimarySpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MembersToAdd = append(m.MembersToAdd, &EventGroupMemberDetail{})
-			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10019,24 +13700,11 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= BucketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10058,7 +13726,7 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
+func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10081,10 +13749,10 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRenewGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelMigrationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10121,7 +13789,7 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10149,43 +13817,11 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10213,34 +13849,15 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
-			}
-			m.SourceType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10250,26 +13867,11 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= BucketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &EventGroupMemberDetail{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10291,7 +13893,7 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
+func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10314,15 +13916,15 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGroupMemberDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRejectMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGroupMemberDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10350,13 +13952,13 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10366,28 +13968,77 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10409,7 +14060,7 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
+func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10432,10 +14083,10 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateGroupExtra: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompleteMigrationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompleteMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10472,7 +14123,7 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10500,11 +14151,11 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10532,13 +14183,15 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10548,31 +14201,16 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPrimarySpId", wireType)
 			}
-			var stringLen uint64
+			m.SrcPrimarySpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10582,24 +14220,30 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcPrimarySpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10621,7 +14265,7 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
+func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10644,15 +14288,15 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10680,13 +14324,13 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10696,77 +14340,28 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-			}
-			m.DestChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10788,7 +14383,7 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10811,17 +14406,49 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorBucketResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorBucketResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10831,12 +14458,27 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -10870,7 +14512,7 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10898,15 +14540,13 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			m.DestChainId = 0
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10916,7 +14556,58 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10942,7 +14633,7 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10965,10 +14656,10 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11005,7 +14696,7 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11033,11 +14724,13 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11065,11 +14758,11 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11097,15 +14790,13 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.DestChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11115,66 +14806,29 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorObjectResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorObjectResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPayloadSize", wireType)
 			}
-			m.Status = 0
+			m.PrevPayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11184,16 +14838,16 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
+				m.PrevPayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPayloadSize", wireType)
 			}
-			var stringLen uint64
+			m.NewPayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11203,29 +14857,16 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NewPayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevChecksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11235,29 +14876,29 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PrevChecksums = append(m.PrevChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.PrevChecksums[len(m.PrevChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChecksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11267,31 +14908,48 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewChecksums = append(m.NewChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.NewChecksums[len(m.NewChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			m.DestChainId = 0
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11301,7 +14959,7 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11327,7 +14985,7 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
+func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11350,15 +15008,15 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11386,11 +15044,11 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11418,11 +15076,11 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11450,15 +15108,13 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
-			m.DestChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11468,11 +15124,26 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11494,7 +15165,7 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11517,17 +15188,17 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorGroupResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorGroupResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11537,14 +15208,27 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11572,11 +15256,11 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11604,15 +15288,13 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
 			}
-			m.DestChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11622,11 +15304,58 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11648,7 +15377,7 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
+func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11671,17 +15400,17 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStalePolicyCleanup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStalePolicyCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			m.BlockNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11691,16 +15420,49 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNum |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLimited = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11710,25 +15472,23 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteInfo == nil {
-				m.DeleteInfo = &DeleteInfo{}
-			}
-			if err := m.DeleteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11753,7 +15513,7 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
+func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11766,55 +15526,23 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMigrationBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -11846,7 +15574,7 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
@@ -11880,30 +15608,11 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
-			}
-			m.DstPrimarySpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPrimarySpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11913,11 +15622,12 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SpAsDelegatedAgentDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11939,7 +15649,7 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketVersioning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11962,45 +15672,13 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelMigrationBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketVersioning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketVersioning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -12032,7 +15710,7 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
@@ -12066,11 +15744,11 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningEnabled", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12080,11 +15758,12 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.VersioningEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -12106,7 +15785,7 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *EventCreateObjectVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12129,15 +15808,15 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRejectMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreateObjectVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreateObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12165,11 +15844,11 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12197,11 +15876,11 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12229,15 +15908,15 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Status = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12247,7 +15926,45 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12273,7 +15990,7 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
+func (m *EventDeleteObjectVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12296,10 +16013,10 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCompleteMigrationBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeleteObjectVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCompleteMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeleteObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12368,7 +16085,7 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12396,15 +16113,13 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12414,16 +16129,31 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.SrcPrimarySpId = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12433,16 +16163,16 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcPrimarySpId |= uint32(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
 			}
-			m.Status = 0
+			m.LocalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12452,7 +16182,7 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12478,7 +16208,7 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetTag) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12488,28 +16218,92 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEvents
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetBucketLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetBucketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetTag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12537,11 +16331,13 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12568,10 +16364,8 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
-			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12596,7 +16390,7 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *EventSetRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12619,10 +16413,10 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12658,40 +16452,6 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -12723,7 +16483,7 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
@@ -12755,30 +16515,11 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			}
 			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12788,43 +16529,25 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -12846,7 +16569,7 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
+func (m *EventSetLegalHold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12869,10 +16592,10 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetLegalHold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetLegalHold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12909,7 +16632,7 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12937,13 +16660,11 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12971,13 +16692,13 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHold", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12987,27 +16708,65 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+			m.LegalHold = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventComposeObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventComposeObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventComposeObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13035,13 +16794,13 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevPayloadSize", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			m.PrevPayloadSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13051,35 +16810,29 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevPayloadSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPayloadSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.NewPayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewPayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13089,29 +16842,29 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevChecksums = append(m.PrevChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.PrevChecksums[len(m.PrevChecksums)-1], dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13121,29 +16874,31 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewChecksums = append(m.NewChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.NewChecksums[len(m.NewChecksums)-1], dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			m.Version = 0
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13153,16 +16908,16 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.PayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceObjectNames", wireType)
 			}
-			m.UpdatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13172,11 +16927,24 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceObjectNames = append(m.SourceObjectNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -13198,7 +16966,7 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *EventBatchCreateObjects) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13221,15 +16989,15 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBatchCreateObjects: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBatchCreateObjects: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13257,7 +17025,7 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -13293,7 +17061,7 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13321,11 +17089,32 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13353,7 +17142,41 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObjectNames = append(m.ObjectNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Uint
+			m.ObjectIds = append(m.ObjectIds, v)
+			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13378,7 +17201,7 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
+func (m *EventBatchDeleteObjects) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13401,10 +17224,10 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBatchDeleteObjects: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBatchDeleteObjects: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13473,7 +17296,7 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13501,11 +17324,13 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13533,11 +17358,11 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
+			m.ObjectNames = append(m.ObjectNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13565,7 +17390,9 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v Uint
+			m.ObjectIds = append(m.ObjectIds, v)
+			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13590,7 +17417,7 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketRequesterPays) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13613,10 +17440,10 @@ func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketRequesterPays: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketRequesterPays: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13652,26 +17479,6 @@ func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLimited", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsLimited = bool(v != 0)
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
@@ -13705,6 +17512,26 @@ func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterPays", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequesterPays = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -13726,7 +17553,7 @@ func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
+func (m *EventPurchaseReaderQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13749,13 +17576,45 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPurchaseReaderQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPurchaseReaderQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -13787,7 +17646,7 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
@@ -13821,11 +17680,43 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
 			}
-			var v int
+			m.ReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13835,12 +17726,11 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SpAsDelegatedAgentDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -41,17 +41,25 @@ type SpKeeper interface {
 	GetStorageProviderBySealAddr(ctx sdk.Context, sealAddr sdk.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetStorageProviderByGcAddr(ctx sdk.Context, gcAddr sdk.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetGlobalSpStorePriceByTime(ctx sdk.Context, time int64) (val sptypes.GlobalSpStorePrice, err error)
+	RecordDiscontinue(ctx sdk.Context, spId uint32)
 }
 
 type PaymentKeeper interface {
 	GetVersionedParamsWithTs(ctx sdk.Context, time int64) (paymenttypes.VersionedParams, error)
 	IsPaymentAccountOwner(ctx sdk.Context, addr, owner sdk.AccAddress) bool
+	GetPaymentAccount(ctx sdk.Context, addr sdk.AccAddress) (val *paymenttypes.PaymentAccount, found bool)
+	IsBucketBinder(ctx sdk.Context, paymentAccount, binder sdk.AccAddress) bool
 	ApplyUserFlowsList(ctx sdk.Context, userFlows []paymenttypes.UserFlows) (err error)
 	UpdateStreamRecordByAddr(ctx sdk.Context, change *paymenttypes.StreamRecordChange) (ret *paymenttypes.StreamRecord, err error)
 	GetStreamRecord(ctx sdk.Context, account sdk.AccAddress) (ret *paymenttypes.StreamRecord, found bool)
 	MergeOutFlows(flows []paymenttypes.OutFlow) []paymenttypes.OutFlow
 	GetAllStreamRecord(ctx sdk.Context) (list []paymenttypes.StreamRecord)
 	GetOutFlows(ctx sdk.Context, addr sdk.AccAddress) []paymenttypes.OutFlow
+	GetSpendingBudget(ctx sdk.Context, addr sdk.AccAddress) (*paymenttypes.SpendingBudget, bool)
+	SetBucketBill(ctx sdk.Context, bucketBill *paymenttypes.BucketBill)
+	CreateReadVoucher(ctx sdk.Context, readVoucher *paymenttypes.ReadVoucher) uint64
+	GetReadVoucher(ctx sdk.Context, id uint64) (*paymenttypes.ReadVoucher, bool)
+	RedeemReadVoucher(ctx sdk.Context, id uint64, bucketId sdk.Uint, readBytes, nonce uint64, receiver sdk.AccAddress) (*paymenttypes.ReadVoucher, error)
 }
 
 type PermissionKeeper interface {
@@ -127,6 +135,7 @@ type StorageKeeper interface {
 
 	NormalizePrincipal(ctx sdk.Context, principal *permtypes.Principal)
 	ValidatePrincipal(ctx sdk.Context, resOwner sdk.AccAddress, principal *permtypes.Principal) error
+	NormalizeStatements(statements []*permtypes.Statement) error
 }
 
 type PaymentMsgServer interface {
@@ -264,6 +264,18 @@ func (mr *MockSpKeeperMockRecorder) MustGetStorageProvider(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGetStorageProvider", reflect.TypeOf((*MockSpKeeper)(nil).MustGetStorageProvider), ctx, id)
 }
 
+// RecordDiscontinue mocks base method.
+func (m *MockSpKeeper) RecordDiscontinue(ctx types3.Context, spId uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordDiscontinue", ctx, spId)
+}
+
+// RecordDiscontinue indicates an expected call of RecordDiscontinue.
+func (mr *MockSpKeeperMockRecorder) RecordDiscontinue(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDiscontinue", reflect.TypeOf((*MockSpKeeper)(nil).RecordDiscontinue), ctx, spId)
+}
+
 // MockPaymentKeeper is a mock of PaymentKeeper interface.
 type MockPaymentKeeper struct {
 	ctrl     *gomock.Controller
@@ -301,6 +313,20 @@ func (mr *MockPaymentKeeperMockRecorder) ApplyUserFlowsList(ctx, userFlows inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUserFlowsList", reflect.TypeOf((*MockPaymentKeeper)(nil).ApplyUserFlowsList), ctx, userFlows)
 }
 
+// CreateReadVoucher mocks base method.
+func (m *MockPaymentKeeper) CreateReadVoucher(ctx types3.Context, readVoucher *types.ReadVoucher) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReadVoucher", ctx, readVoucher)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// CreateReadVoucher indicates an expected call of CreateReadVoucher.
+func (mr *MockPaymentKeeperMockRecorder) CreateReadVoucher(ctx, readVoucher interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReadVoucher", reflect.TypeOf((*MockPaymentKeeper)(nil).CreateReadVoucher), ctx, readVoucher)
+}
+
 // GetAllStreamRecord mocks base method.
 func (m *MockPaymentKeeper) GetAllStreamRecord(ctx types3.Context) []types.StreamRecord {
 	m.ctrl.T.Helper()
@@ -329,6 +355,51 @@ func (mr *MockPaymentKeeperMockRecorder) GetOutFlows(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutFlows", reflect.TypeOf((*MockPaymentKeeper)(nil).GetOutFlows), ctx, addr)
 }
 
+// GetPaymentAccount mocks base method.
+func (m *MockPaymentKeeper) GetPaymentAccount(ctx types3.Context, addr types3.AccAddress) (*types.PaymentAccount, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentAccount", ctx, addr)
+	ret0, _ := ret[0].(*types.PaymentAccount)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPaymentAccount indicates an expected call of GetPaymentAccount.
+func (mr *MockPaymentKeeperMockRecorder) GetPaymentAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentAccount", reflect.TypeOf((*MockPaymentKeeper)(nil).GetPaymentAccount), ctx, addr)
+}
+
+// GetReadVoucher mocks base method.
+func (m *MockPaymentKeeper) GetReadVoucher(ctx types3.Context, id uint64) (*types.ReadVoucher, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadVoucher", ctx, id)
+	ret0, _ := ret[0].(*types.ReadVoucher)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetReadVoucher indicates an expected call of GetReadVoucher.
+func (mr *MockPaymentKeeperMockRecorder) GetReadVoucher(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadVoucher", reflect.TypeOf((*MockPaymentKeeper)(nil).GetReadVoucher), ctx, id)
+}
+
+// GetSpendingBudget mocks base method.
+func (m *MockPaymentKeeper) GetSpendingBudget(ctx types3.Context, addr types3.AccAddress) (*types.SpendingBudget, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingBudget", ctx, addr)
+	ret0, _ := ret[0].(*types.SpendingBudget)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpendingBudget indicates an expected call of GetSpendingBudget.
+func (mr *MockPaymentKeeperMockRecorder) GetSpendingBudget(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingBudget", reflect.TypeOf((*MockPaymentKeeper)(nil).GetSpendingBudget), ctx, addr)
+}
+
 // GetStreamRecord mocks base method.
 func (m *MockPaymentKeeper) GetStreamRecord(ctx types3.Context, account types3.AccAddress) (*types.StreamRecord, bool) {
 	m.ctrl.T.Helper()
@@ -359,6 +430,20 @@ func (mr *MockPaymentKeeperMockRecorder) GetVersionedParamsWithTs(ctx, time inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionedParamsWithTs", reflect.TypeOf((*MockPaymentKeeper)(nil).GetVersionedParamsWithTs), ctx, time)
 }
 
+// IsBucketBinder mocks base method.
+func (m *MockPaymentKeeper) IsBucketBinder(ctx types3.Context, paymentAccount, binder types3.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBucketBinder", ctx, paymentAccount, binder)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBucketBinder indicates an expected call of IsBucketBinder.
+func (mr *MockPaymentKeeperMockRecorder) IsBucketBinder(ctx, paymentAccount, binder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBucketBinder", reflect.TypeOf((*MockPaymentKeeper)(nil).IsBucketBinder), ctx, paymentAccount, binder)
+}
+
 // IsPaymentAccountOwner mocks base method.
 func (m *MockPaymentKeeper) IsPaymentAccountOwner(ctx types3.Context, addr, owner types3.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -387,6 +472,33 @@ func (mr *MockPaymentKeeperMockRecorder) MergeOutFlows(flows interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOutFlows", reflect.TypeOf((*MockPaymentKeeper)(nil).MergeOutFlows), flows)
 }
 
+// RedeemReadVoucher mocks base method.
+func (m *MockPaymentKeeper) RedeemReadVoucher(ctx types3.Context, id uint64, bucketId types3.Uint, readBytes, nonce uint64, receiver types3.AccAddress) (*types.ReadVoucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemReadVoucher", ctx, id, bucketId, readBytes, nonce, receiver)
+	ret0, _ := ret[0].(*types.ReadVoucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemReadVoucher indicates an expected call of RedeemReadVoucher.
+func (mr *MockPaymentKeeperMockRecorder) RedeemReadVoucher(ctx, id, bucketId, readBytes, nonce, receiver interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemReadVoucher", reflect.TypeOf((*MockPaymentKeeper)(nil).RedeemReadVoucher), ctx, id, bucketId, readBytes, nonce, receiver)
+}
+
+// SetBucketBill mocks base method.
+func (m *MockPaymentKeeper) SetBucketBill(ctx types3.Context, bucketBill *types.BucketBill) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBucketBill", ctx, bucketBill)
+}
+
+// SetBucketBill indicates an expected call of SetBucketBill.
+func (mr *MockPaymentKeeperMockRecorder) SetBucketBill(ctx, bucketBill interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketBill", reflect.TypeOf((*MockPaymentKeeper)(nil).SetBucketBill), ctx, bucketBill)
+}
+
 // UpdateStreamRecordByAddr mocks base method.
 func (m *MockPaymentKeeper) UpdateStreamRecordByAddr(ctx types3.Context, change *types.StreamRecordChange) (*types.StreamRecord, error) {
 	m.ctrl.T.Helper()
@@ -1116,6 +1228,20 @@ func (mr *MockStorageKeeperMockRecorder) NormalizePrincipal(ctx, principal inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NormalizePrincipal", reflect.TypeOf((*MockStorageKeeper)(nil).NormalizePrincipal), ctx, principal)
 }
 
+// NormalizeStatements mocks base method.
+func (m *MockStorageKeeper) NormalizeStatements(statements []*types0.Statement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NormalizeStatements", statements)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NormalizeStatements indicates an expected call of NormalizeStatements.
+func (mr *MockStorageKeeperMockRecorder) NormalizeStatements(statements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NormalizeStatements", reflect.TypeOf((*MockStorageKeeper)(nil).NormalizeStatements), statements)
+}
+
 // RenewGroupMember mocks base method.
 func (m *MockStorageKeeper) RenewGroupMember(ctx types3.Context, operator types3.AccAddress, groupInfo *GroupInfo, opts RenewGroupMemberOptions) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StorageHooks defines the hooks of the storage module, which are called by the storage keeper.
+type StorageHooks interface {
+	// AfterObjectDeleted is called after an object info is removed from the store.
+	AfterObjectDeleted(ctx sdk.Context, objectId sdkmath.Uint)
+}
@@ -42,6 +42,16 @@ var (
 
 	LockedObjectCountPrefix = []byte{0x17} // key to track count of created/updating objects, which will involve lock fee
 
+	ObjectVersionPrefix = []byte{0x18} // key to store the retained versions of objects in versioning enabled buckets
+
+	ComposedObjectPrefix = []byte{0x19} // key to store the components of composed objects
+
+	ObjectLocksPrefix = []byte{0x1A} // key to track the retention and legal hold of the objects in a bucket
+
+	ObjectNameIndexPrefix = []byte{0x1B} // key to index the objects of a bucket in the order of their names
+
+	ObjectNameIndexBackfillKey = []byte{0x1C} // cursor of the existing objects still being added to the object name index
+
 	BucketByIDPrefix = []byte{0x21}
 	ObjectByIDPrefix = []byte{0x22}
 	GroupByIDPrefix  = []byte{0x23}
@@ -66,6 +76,12 @@ var (
 
 	BucketRateLimitPrefix       = []byte{0x71}
 	BucketRateLimitStatusPrefix = []byte{0x72}
+
+	BucketLifecyclePrefix   = []byte{0x81}
+	LifecycleBackfillPrefix = []byte{0x82} // buckets whose existing objects are still being enqueued for expiration
+	LifecycleQueuePrefix    = []byte{0x83} // objects to be checked against the lifecycle rules, ordered by time
+
+	ReaderQuotaPrefix = []byte{0x91} // key to store the read quota bought by the readers of requester-pays buckets
 )
 
 // GetBucketKey return the bucket name store key
@@ -85,6 +101,18 @@ func GetObjectKeyOnlyBucketPrefix(bucketName string) []byte {
 	return append(ObjectInfoPrefix, sdk.Keccak256([]byte(bucketName))...)
 }
 
+// GetObjectNameIndexBucketPrefix return the prefix of the object name index of the bucket
+func GetObjectNameIndexBucketPrefix(bucketId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(ObjectNameIndexPrefix, seq.EncodeSequence(bucketId)...)
+}
+
+// GetObjectNameIndexKey return the object name index store key, the object name is not hashed so that the objects
+// of a bucket can be ranged by name
+func GetObjectNameIndexKey(bucketId math.Uint, objectName string) []byte {
+	return append(GetObjectNameIndexBucketPrefix(bucketId), []byte(objectName)...)
+}
+
 // GetShadowObjectKey return the shadow object name store key
 func GetShadowObjectKey(bucketName string, objectName string) []byte {
 	bucketNameHash := sdk.Keccak256([]byte(bucketName))
@@ -176,6 +204,56 @@ func GetLockedObjectCountKey(bucketId math.Uint) []byte {
 	return append(LockedObjectCountPrefix, seq.EncodeSequence(bucketId)...)
 }
 
+// GetObjectVersionKeyOnlyObjectPrefix return the prefix of all the version store keys of an object
+func GetObjectVersionKeyOnlyObjectPrefix(objectId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(ObjectVersionPrefix, seq.EncodeSequence(objectId)...)
+}
+
+// GetObjectVersionKey return the object version store key
+func GetObjectVersionKey(objectId math.Uint, version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return append(GetObjectVersionKeyOnlyObjectPrefix(objectId), bz...)
+}
+
+// GetComposedObjectKey return the composed object store key
+func GetComposedObjectKey(objectId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(ComposedObjectPrefix, seq.EncodeSequence(objectId)...)
+}
+
+// GetObjectLocksKey return the object locks store key of the bucket
+func GetObjectLocksKey(bucketId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(ObjectLocksPrefix, seq.EncodeSequence(bucketId)...)
+}
+
+// GetBucketLifecycleKey return the bucket lifecycle store key
+func GetBucketLifecycleKey(bucketId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketLifecyclePrefix, seq.EncodeSequence(bucketId)...)
+}
+
+// GetLifecycleBackfillKey return the key of the lifecycle backfill cursor of the bucket
+func GetLifecycleBackfillKey(bucketId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(LifecycleBackfillPrefix, seq.EncodeSequence(bucketId)...)
+}
+
+// GetLifecycleQueueKeyPrefix return the prefix of the lifecycle queue entries due at the timestamp
+func GetLifecycleQueueKeyPrefix(timestamp int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(timestamp))
+	return append(LifecycleQueuePrefix, bz...)
+}
+
+// GetLifecycleQueueKey return the lifecycle queue key of the object due at the timestamp
+func GetLifecycleQueueKey(timestamp int64, objectId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(GetLifecycleQueueKeyPrefix(timestamp), seq.EncodeSequence(objectId)...)
+}
+
 // GetBucketFlowRateLimitKey return the bucket rate limit store key
 func GetBucketFlowRateLimitKey(paymentAccount, bucketOwner sdk.AccAddress, bucketName string) []byte {
 	bucketNameHash := sdk.Keccak256([]byte(bucketName))
@@ -187,3 +265,14 @@ func GetBucketFlowRateLimitStatusKey(bucketName string) []byte {
 	bucketNameHash := sdk.Keccak256([]byte(bucketName))
 	return append(BucketRateLimitPrefix, bucketNameHash...)
 }
+
+// GetReaderQuotaKeyOnlyBucketPrefix return the prefix of all the reader quota store keys of a bucket
+func GetReaderQuotaKeyOnlyBucketPrefix(bucketId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(ReaderQuotaPrefix, seq.EncodeSequence(bucketId)...)
+}
+
+// GetReaderQuotaKey return the reader quota store key
+func GetReaderQuotaKey(bucketId math.Uint, reader sdk.AccAddress) []byte {
+	return append(GetReaderQuotaKeyOnlyBucketPrefix(bucketId), reader.Bytes()...)
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgBatchCreateObjects = "batch_create_objects"
+	TypeMsgBatchDeleteObjects = "batch_delete_objects"
+)
+
+var (
+	_ sdk.Msg = &MsgBatchCreateObjects{}
+	_ sdk.Msg = &MsgBatchDeleteObjects{}
+)
+
+func NewMsgBatchCreateObjects(creator sdk.AccAddress, bucketName string, objects []BatchCreateObjectItem) *MsgBatchCreateObjects {
+	return &MsgBatchCreateObjects{
+		Creator:    creator.String(),
+		BucketName: bucketName,
+		Objects:    objects,
+	}
+}
+
+func (msg *MsgBatchCreateObjects) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchCreateObjects) Type() string {
+	return TypeMsgBatchCreateObjects
+}
+
+func (msg *MsgBatchCreateObjects) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchCreateObjects) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBatchCreateObjects) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if len(msg.Objects) == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("no objects to create")
+	}
+	objectNames := make(map[string]bool, len(msg.Objects))
+	for _, object := range msg.Objects {
+		err = s3util.CheckValidObjectName(object.ObjectName)
+		if err != nil {
+			return err
+		}
+		if objectNames[object.ObjectName] {
+			return gnfderrors.ErrInvalidParameter.Wrapf("duplicated object: %s", object.ObjectName)
+		}
+		objectNames[object.ObjectName] = true
+
+		err = s3util.CheckValidExpectChecksums(object.ExpectChecksums)
+		if err != nil {
+			return err
+		}
+
+		err = s3util.CheckValidContentType(object.ContentType)
+		if err != nil {
+			return err
+		}
+
+		if object.Visibility == VISIBILITY_TYPE_UNSPECIFIED {
+			return errors.Wrapf(ErrInvalidVisibility, "Unspecified visibility is not allowed.")
+		}
+	}
+	return nil
+}
+
+func NewMsgBatchDeleteObjects(operator sdk.AccAddress, bucketName string, objectNames []string) *MsgBatchDeleteObjects {
+	return &MsgBatchDeleteObjects{
+		Operator:    operator.String(),
+		BucketName:  bucketName,
+		ObjectNames: objectNames,
+	}
+}
+
+func (msg *MsgBatchDeleteObjects) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchDeleteObjects) Type() string {
+	return TypeMsgBatchDeleteObjects
+}
+
+func (msg *MsgBatchDeleteObjects) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgBatchDeleteObjects) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBatchDeleteObjects) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if len(msg.ObjectNames) == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("no objects to delete")
+	}
+	objectNames := make(map[string]bool, len(msg.ObjectNames))
+	for _, objectName := range msg.ObjectNames {
+		err = s3util.CheckValidObjectName(objectName)
+		if err != nil {
+			return err
+		}
+		if objectNames[objectName] {
+			return gnfderrors.ErrInvalidParameter.Wrapf("duplicated object: %s", objectName)
+		}
+		objectNames[objectName] = true
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgBatchCreateObjects_ValidateBasic(t *testing.T) {
+	validItem := func(objectName string) BatchCreateObjectItem {
+		return BatchCreateObjectItem{
+			ObjectName:      objectName,
+			PayloadSize:     1024,
+			Visibility:      VISIBILITY_TYPE_PRIVATE,
+			ContentType:     "content-type",
+			ExpectChecksums: [][]byte{sample.Checksum(), sample.Checksum(), sample.Checksum(), sample.Checksum(), sample.Checksum(), sample.Checksum(), sample.Checksum()},
+		}
+	}
+	invalidChecksumItem := validItem("b.txt")
+	invalidChecksumItem.ExpectChecksums = [][]byte{[]byte("invalid")}
+	unspecifiedVisibilityItem := validItem("b.txt")
+	unspecifiedVisibilityItem.Visibility = VISIBILITY_TYPE_UNSPECIFIED
+
+	tests := []struct {
+		name string
+		msg  MsgBatchCreateObjects
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBatchCreateObjects{
+				Creator:    "invalid_address",
+				BucketName: testBucketName,
+				Objects:    []BatchCreateObjectItem{validItem("a.txt")},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+				Objects:    []BatchCreateObjectItem{validItem("a.txt")},
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "no objects",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "duplicated objects",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Objects:    []BatchCreateObjectItem{validItem("a.txt"), validItem("a.txt")},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "invalid object name",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Objects:    []BatchCreateObjectItem{validItem("a.txt"), validItem("")},
+			},
+			err: gnfderrors.ErrInvalidObjectName,
+		}, {
+			name: "invalid checksum",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Objects:    []BatchCreateObjectItem{validItem("a.txt"), invalidChecksumItem},
+			},
+			err: gnfderrors.ErrInvalidChecksum,
+		}, {
+			name: "unspecified visibility",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Objects:    []BatchCreateObjectItem{validItem("a.txt"), unspecifiedVisibilityItem},
+			},
+			err: ErrInvalidVisibility,
+		}, {
+			name: "valid case",
+			msg: MsgBatchCreateObjects{
+				Creator:    sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Objects:    []BatchCreateObjectItem{validItem("a.txt"), validItem("b.txt")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBatchDeleteObjects_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBatchDeleteObjects
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBatchDeleteObjects{
+				Operator:    "invalid_address",
+				BucketName:  testBucketName,
+				ObjectNames: []string{"a.txt"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no objects",
+			msg: MsgBatchDeleteObjects{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "duplicated objects",
+			msg: MsgBatchDeleteObjects{
+				Operator:    sample.RandAccAddressHex(),
+				BucketName:  testBucketName,
+				ObjectNames: []string{"a.txt", "a.txt"},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "invalid object name",
+			msg: MsgBatchDeleteObjects{
+				Operator:    sample.RandAccAddressHex(),
+				BucketName:  testBucketName,
+				ObjectNames: []string{"a.txt", ""},
+			},
+			err: gnfderrors.ErrInvalidObjectName,
+		}, {
+			name: "valid case",
+			msg: MsgBatchDeleteObjects{
+				Operator:    sample.RandAccAddressHex(),
+				BucketName:  testBucketName,
+				ObjectNames: []string{"a.txt", "b.txt"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
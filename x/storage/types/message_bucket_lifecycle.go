@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgSetBucketLifecycle = "set_bucket_lifecycle"
+
+	MaxLifecycleRuleCount    = 16
+	MaxLifecycleRuleIdLength = 64
+	MaxLifecyclePrefixLength = 1024
+)
+
+var _ sdk.Msg = &MsgSetBucketLifecycle{}
+
+func NewMsgSetBucketLifecycle(operator sdk.AccAddress, bucketName string, rules []LifecycleRule) *MsgSetBucketLifecycle {
+	return &MsgSetBucketLifecycle{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		Rules:      rules,
+	}
+}
+
+func (msg *MsgSetBucketLifecycle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketLifecycle) Type() string {
+	return TypeMsgSetBucketLifecycle
+}
+
+func (msg *MsgSetBucketLifecycle) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSetBucketLifecycle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketLifecycle) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if len(msg.Rules) > MaxLifecycleRuleCount {
+		return gnfderrors.ErrInvalidParameter.Wrapf("Lifecycle rules count cannot exceed %d", MaxLifecycleRuleCount)
+	}
+	for _, rule := range msg.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the rule has at least one action and its fields are within the limits.
+func (r LifecycleRule) Validate() error {
+	if len(r.Id) > MaxLifecycleRuleIdLength {
+		return gnfderrors.ErrInvalidParameter.Wrapf("Lifecycle rule id length cannot exceed %d", MaxLifecycleRuleIdLength)
+	}
+	if len(r.Prefix) > MaxLifecyclePrefixLength {
+		return gnfderrors.ErrInvalidParameter.Wrapf("Lifecycle rule prefix length cannot exceed %d", MaxLifecyclePrefixLength)
+	}
+	if r.ExpirationDays == 0 && r.AbortIncompleteUploadHours == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("Lifecycle rule(%s) should set expiration days or abort incomplete upload hours", r.Id)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgSetBucketLifecycle_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBucketLifecycle
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBucketLifecycle{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "rule without action",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules:      []LifecycleRule{{Id: "rule", Prefix: "logs/"}},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "too long prefix",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules:      []LifecycleRule{{Prefix: strings.Repeat("a", MaxLifecyclePrefixLength+1), ExpirationDays: 1}},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "too many rules",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules:      make([]LifecycleRule, MaxLifecycleRuleCount+1),
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "remove rules",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		}, {
+			name: "valid case",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules: []LifecycleRule{
+					{Id: "expire-logs", Prefix: "logs/", ExpirationDays: 30},
+					{Id: "abort-uploads", AbortIncompleteUploadHours: 24},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
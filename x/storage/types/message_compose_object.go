@@ -0,0 +1,98 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgComposeObject = "compose_object"
+
+	// MinComposeSourceCount is the minimum number of source objects of a composed object.
+	MinComposeSourceCount = 2
+	// MaxComposeSourceCount is the maximum number of source objects which can be composed in one message.
+	MaxComposeSourceCount = 64
+)
+
+var _ sdk.Msg = &MsgComposeObject{}
+
+func NewMsgComposeObject(operator sdk.AccAddress, bucketName, objectName string, sourceObjectNames []string,
+	contentType string, visibility VisibilityType,
+) *MsgComposeObject {
+	return &MsgComposeObject{
+		Operator:          operator.String(),
+		BucketName:        bucketName,
+		ObjectName:        objectName,
+		SourceObjectNames: sourceObjectNames,
+		ContentType:       contentType,
+		Visibility:        visibility,
+	}
+}
+
+func (msg *MsgComposeObject) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgComposeObject) Type() string {
+	return TypeMsgComposeObject
+}
+
+func (msg *MsgComposeObject) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgComposeObject) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgComposeObject) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	err = s3util.CheckValidObjectName(msg.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	if len(msg.SourceObjectNames) < MinComposeSourceCount || len(msg.SourceObjectNames) > MaxComposeSourceCount {
+		return gnfderrors.ErrInvalidParameter.Wrapf("the number of source objects should be between %d and %d",
+			MinComposeSourceCount, MaxComposeSourceCount)
+	}
+	sourceObjectNames := make(map[string]bool, len(msg.SourceObjectNames))
+	for _, sourceObjectName := range msg.SourceObjectNames {
+		err = s3util.CheckValidObjectName(sourceObjectName)
+		if err != nil {
+			return err
+		}
+		if sourceObjectNames[sourceObjectName] {
+			return gnfderrors.ErrInvalidParameter.Wrapf("duplicated source object: %s", sourceObjectName)
+		}
+		sourceObjectNames[sourceObjectName] = true
+	}
+
+	err = s3util.CheckValidContentType(msg.ContentType)
+	if err != nil {
+		return err
+	}
+
+	if msg.Visibility == VISIBILITY_TYPE_UNSPECIFIED {
+		return errors.Wrapf(ErrInvalidVisibility, "Unspecified visibility is not allowed.")
+	}
+	return nil
+}
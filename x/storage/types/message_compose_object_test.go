@@ -0,0 +1,121 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgComposeObject_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgComposeObject
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgComposeObject{
+				Operator:          "invalid_address",
+				BucketName:        testBucketName,
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1", "part2"},
+				Visibility:        VISIBILITY_TYPE_PRIVATE,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgComposeObject{
+				Operator:          sample.RandAccAddressHex(),
+				BucketName:        string(testInvalidBucketNameWithLongLength[:]),
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1", "part2"},
+				Visibility:        VISIBILITY_TYPE_PRIVATE,
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "too few source objects",
+			msg: MsgComposeObject{
+				Operator:          sample.RandAccAddressHex(),
+				BucketName:        testBucketName,
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1"},
+				Visibility:        VISIBILITY_TYPE_PRIVATE,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "duplicated source objects",
+			msg: MsgComposeObject{
+				Operator:          sample.RandAccAddressHex(),
+				BucketName:        testBucketName,
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1", "part1"},
+				Visibility:        VISIBILITY_TYPE_PRIVATE,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "invalid source object name",
+			msg: MsgComposeObject{
+				Operator:          sample.RandAccAddressHex(),
+				BucketName:        testBucketName,
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1", ""},
+				Visibility:        VISIBILITY_TYPE_PRIVATE,
+			},
+			err: gnfderrors.ErrInvalidObjectName,
+		}, {
+			name: "unspecified visibility",
+			msg: MsgComposeObject{
+				Operator:          sample.RandAccAddressHex(),
+				BucketName:        testBucketName,
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1", "part2"},
+			},
+			err: ErrInvalidVisibility,
+		}, {
+			name: "valid case",
+			msg: MsgComposeObject{
+				Operator:          sample.RandAccAddressHex(),
+				BucketName:        testBucketName,
+				ObjectName:        testObjectName,
+				SourceObjectNames: []string{"part1", "part2"},
+				Visibility:        VISIBILITY_TYPE_PRIVATE,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestComposedObject_LocateOffset(t *testing.T) {
+	composedObject := &ComposedObject{
+		Components: []*ObjectComponent{
+			{Offset: 0, PayloadSize: 10},
+			{Offset: 10, PayloadSize: 0},
+			{Offset: 10, PayloadSize: 5},
+		},
+	}
+	idx, offset, ok := composedObject.LocateOffset(3)
+	require.True(t, ok)
+	require.Equal(t, 0, idx)
+	require.Equal(t, uint64(3), offset)
+
+	idx, offset, ok = composedObject.LocateOffset(12)
+	require.True(t, ok)
+	require.Equal(t, 2, idx)
+	require.Equal(t, uint64(2), offset)
+
+	_, _, ok = composedObject.LocateOffset(15)
+	require.False(t, ok)
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgSetRetention = "set_retention"
+	TypeMsgSetLegalHold = "set_legal_hold"
+)
+
+var (
+	_ sdk.Msg = &MsgSetRetention{}
+	_ sdk.Msg = &MsgSetLegalHold{}
+)
+
+func NewMsgSetRetention(operator sdk.AccAddress, bucketName, objectName string, retention Retention) *MsgSetRetention {
+	return &MsgSetRetention{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		Retention:  retention,
+	}
+}
+
+func (msg *MsgSetRetention) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRetention) Type() string {
+	return TypeMsgSetRetention
+}
+
+func (msg *MsgSetRetention) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSetRetention) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRetention) ValidateBasic() error {
+	err := validateObjectLockTarget(msg.Operator, msg.BucketName, msg.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := RetentionMode_name[int32(msg.Retention.Mode)]; !ok {
+		return gnfderrors.ErrInvalidParameter.Wrapf("invalid retention mode: %d", msg.Retention.Mode)
+	}
+	if msg.Retention.Mode == RETENTION_MODE_NONE && msg.Retention.RetainUntil != 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("retain until should be 0 when the retention is removed")
+	}
+	if msg.Retention.Mode != RETENTION_MODE_NONE && msg.Retention.RetainUntil <= 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("retain until should be positive")
+	}
+	return nil
+}
+
+func NewMsgSetLegalHold(operator sdk.AccAddress, bucketName, objectName string, legalHold bool) *MsgSetLegalHold {
+	return &MsgSetLegalHold{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		LegalHold:  legalHold,
+	}
+}
+
+func (msg *MsgSetLegalHold) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetLegalHold) Type() string {
+	return TypeMsgSetLegalHold
+}
+
+func (msg *MsgSetLegalHold) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSetLegalHold) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetLegalHold) ValidateBasic() error {
+	return validateObjectLockTarget(msg.Operator, msg.BucketName, msg.ObjectName)
+}
+
+// validateObjectLockTarget validates the operator and the bucket, the object name is optional.
+func validateObjectLockTarget(operator, bucketName, objectName string) error {
+	_, err := sdk.AccAddressFromHexUnsafe(operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(bucketName)
+	if err != nil {
+		return err
+	}
+
+	if objectName != "" {
+		return s3util.CheckValidObjectName(objectName)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgSetRetention_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetRetention
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetRetention{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "invalid object name",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: "//",
+			},
+			err: gnfderrors.ErrInvalidObjectName,
+		}, {
+			name: "invalid mode",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Retention:  Retention{Mode: 3, RetainUntil: 1},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "remove retention with retain until",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Retention:  Retention{Mode: RETENTION_MODE_NONE, RetainUntil: 1},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "retention without retain until",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Retention:  Retention{Mode: RETENTION_MODE_COMPLIANCE},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid bucket retention",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Retention:  Retention{Mode: RETENTION_MODE_GOVERNANCE, RetainUntil: 1},
+			},
+		}, {
+			name: "valid object retention",
+			msg: MsgSetRetention{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+				Retention:  Retention{Mode: RETENTION_MODE_COMPLIANCE, RetainUntil: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetLegalHold_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetLegalHold
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetLegalHold{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetLegalHold{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "valid case",
+			msg: MsgSetLegalHold{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+				LegalHold:  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgSetBucketVersioning = "set_bucket_versioning"
+	TypeMsgDeleteObjectVersion = "delete_object_version"
+)
+
+var (
+	_ sdk.Msg = &MsgSetBucketVersioning{}
+	_ sdk.Msg = &MsgDeleteObjectVersion{}
+)
+
+func NewMsgSetBucketVersioning(operator sdk.AccAddress, bucketName string, enabled bool) *MsgSetBucketVersioning {
+	return &MsgSetBucketVersioning{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		Enabled:    enabled,
+	}
+}
+
+func (msg *MsgSetBucketVersioning) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketVersioning) Type() string {
+	return TypeMsgSetBucketVersioning
+}
+
+func (msg *MsgSetBucketVersioning) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSetBucketVersioning) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketVersioning) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	return s3util.CheckValidBucketName(msg.BucketName)
+}
+
+func NewMsgDeleteObjectVersion(operator sdk.AccAddress, bucketName, objectName string, version int64) *MsgDeleteObjectVersion {
+	return &MsgDeleteObjectVersion{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		Version:    version,
+	}
+}
+
+func (msg *MsgDeleteObjectVersion) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteObjectVersion) Type() string {
+	return TypeMsgDeleteObjectVersion
+}
+
+func (msg *MsgDeleteObjectVersion) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgDeleteObjectVersion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteObjectVersion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	err = s3util.CheckValidObjectName(msg.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	if msg.Version < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("version cannot be negative")
+	}
+	return nil
+}
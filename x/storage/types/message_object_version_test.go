@@ -0,0 +1,105 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgSetBucketVersioning_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBucketVersioning
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBucketVersioning{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetBucketVersioning{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "valid case",
+			msg: MsgSetBucketVersioning{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Enabled:    true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeleteObjectVersion_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeleteObjectVersion
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeleteObjectVersion{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid object name",
+			msg: MsgDeleteObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: "",
+			},
+			err: gnfderrors.ErrInvalidObjectName,
+		}, {
+			name: "invalid version",
+			msg: MsgDeleteObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+				Version:    -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid case",
+			msg: MsgDeleteObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+				Version:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgMintReadVoucher   = "mint_read_voucher"
+	TypeMsgRedeemReadVoucher = "redeem_read_voucher"
+)
+
+var (
+	_ sdk.Msg = &MsgMintReadVoucher{}
+	_ sdk.Msg = &MsgRedeemReadVoucher{}
+)
+
+func NewMsgMintReadVoucher(operator sdk.AccAddress, bucketName string, holder sdk.AccAddress, readQuota, duration uint64) *MsgMintReadVoucher {
+	return &MsgMintReadVoucher{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		Holder:     holder.String(),
+		ReadQuota:  readQuota,
+		Duration:   duration,
+	}
+}
+
+func (msg *MsgMintReadVoucher) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMintReadVoucher) Type() string {
+	return TypeMsgMintReadVoucher
+}
+
+func (msg *MsgMintReadVoucher) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgMintReadVoucher) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMintReadVoucher) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Holder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if msg.ReadQuota == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("read quota of the voucher can not be zero")
+	}
+	if msg.Duration == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("duration of the voucher can not be zero")
+	}
+	return nil
+}
+
+func NewMsgRedeemReadVoucher(operator sdk.AccAddress, voucherId, readBytes, nonce uint64, holderSignature []byte) *MsgRedeemReadVoucher {
+	return &MsgRedeemReadVoucher{
+		Operator:        operator.String(),
+		VoucherId:       voucherId,
+		ReadBytes:       readBytes,
+		Nonce:           nonce,
+		HolderSignature: holderSignature,
+	}
+}
+
+func (msg *MsgRedeemReadVoucher) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRedeemReadVoucher) Type() string {
+	return TypeMsgRedeemReadVoucher
+}
+
+func (msg *MsgRedeemReadVoucher) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgRedeemReadVoucher) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetHolderSignBytes returns the bytes signed by the holder of the read voucher to approve the redemption, which
+// covers the chain id, the voucher id, the read bytes and the nonce of the redemption, so that an approval can not be
+// replayed on another chain.
+func (msg *MsgRedeemReadVoucher) GetHolderSignBytes(chainId string) []byte {
+	fakeMsg := &MsgRedeemReadVoucher{
+		VoucherId: msg.VoucherId,
+		ReadBytes: msg.ReadBytes,
+		Nonce:     msg.Nonce,
+	}
+	bs := make([]byte, 0)
+	bs = append(bs, []byte(chainId)...)
+	bs = append(bs, fakeMsg.GetSignBytes()...)
+	return bs
+}
+
+func (msg *MsgRedeemReadVoucher) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	if msg.ReadBytes == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("read bytes to redeem can not be zero")
+	}
+	if msg.Nonce == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("nonce of the redemption can not be zero")
+	}
+	if len(msg.HolderSignature) == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrap("holder signature of the redemption can not be empty")
+	}
+	return nil
+}
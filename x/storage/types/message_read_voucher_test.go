@@ -0,0 +1,158 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgMintReadVoucher_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgMintReadVoucher
+		err  error
+	}{
+		{
+			name: "invalid holder",
+			msg: MsgMintReadVoucher{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Holder:     "invalid_address",
+				ReadQuota:  1024,
+				Duration:   3600,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgMintReadVoucher{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+				Holder:     sample.RandAccAddressHex(),
+				ReadQuota:  1024,
+				Duration:   3600,
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "zero read quota",
+			msg: MsgMintReadVoucher{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Holder:     sample.RandAccAddressHex(),
+				Duration:   3600,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "zero duration",
+			msg: MsgMintReadVoucher{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Holder:     sample.RandAccAddressHex(),
+				ReadQuota:  1024,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgMintReadVoucher{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Holder:     sample.RandAccAddressHex(),
+				ReadQuota:  1024,
+				Duration:   3600,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRedeemReadVoucher_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRedeemReadVoucher
+		err  error
+	}{
+		{
+			name: "invalid operator",
+			msg: MsgRedeemReadVoucher{
+				Operator:  "invalid_address",
+				VoucherId: 1,
+				ReadBytes: 1024,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero read bytes",
+			msg: MsgRedeemReadVoucher{
+				Operator:  sample.RandAccAddressHex(),
+				VoucherId: 1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "zero nonce",
+			msg: MsgRedeemReadVoucher{
+				Operator:        sample.RandAccAddressHex(),
+				VoucherId:       1,
+				ReadBytes:       1024,
+				HolderSignature: []byte("signature"),
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "empty holder signature",
+			msg: MsgRedeemReadVoucher{
+				Operator:  sample.RandAccAddressHex(),
+				VoucherId: 1,
+				ReadBytes: 1024,
+				Nonce:     1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgRedeemReadVoucher{
+				Operator:        sample.RandAccAddressHex(),
+				VoucherId:       1,
+				ReadBytes:       1024,
+				Nonce:           1,
+				HolderSignature: []byte("signature"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRedeemReadVoucher_GetHolderSignBytes(t *testing.T) {
+	msg := MsgRedeemReadVoucher{
+		Operator:        sample.RandAccAddressHex(),
+		VoucherId:       1,
+		ReadBytes:       1024,
+		Nonce:           1,
+		HolderSignature: []byte("signature"),
+	}
+	// the operator and the signature are not signed by the holder
+	other := msg
+	other.Operator = sample.RandAccAddressHex()
+	other.HolderSignature = []byte("other")
+	require.Equal(t, msg.GetHolderSignBytes("greenfield_1017-1"), other.GetHolderSignBytes("greenfield_1017-1"))
+
+	// the approval is bound to the chain
+	require.NotEqual(t, msg.GetHolderSignBytes("greenfield_1017-1"), msg.GetHolderSignBytes("greenfield_5600-1"))
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	TypeMsgSetBucketRequesterPays = "set_bucket_requester_pays"
+	TypeMsgPurchaseReaderQuota    = "purchase_reader_quota"
+)
+
+var (
+	_ sdk.Msg = &MsgSetBucketRequesterPays{}
+	_ sdk.Msg = &MsgPurchaseReaderQuota{}
+)
+
+func NewMsgSetBucketRequesterPays(operator sdk.AccAddress, bucketName string, enabled bool) *MsgSetBucketRequesterPays {
+	return &MsgSetBucketRequesterPays{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		Enabled:    enabled,
+	}
+}
+
+func (msg *MsgSetBucketRequesterPays) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketRequesterPays) Type() string {
+	return TypeMsgSetBucketRequesterPays
+}
+
+func (msg *MsgSetBucketRequesterPays) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSetBucketRequesterPays) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketRequesterPays) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	return s3util.CheckValidBucketName(msg.BucketName)
+}
+
+func NewMsgPurchaseReaderQuota(reader sdk.AccAddress, bucketName string, paymentAddress sdk.AccAddress, readQuota uint64) *MsgPurchaseReaderQuota {
+	var paymentAddr string
+	if paymentAddress != nil {
+		paymentAddr = paymentAddress.String()
+	}
+	return &MsgPurchaseReaderQuota{
+		Reader:         reader.String(),
+		BucketName:     bucketName,
+		PaymentAddress: paymentAddr,
+		ReadQuota:      readQuota,
+	}
+}
+
+func (msg *MsgPurchaseReaderQuota) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPurchaseReaderQuota) Type() string {
+	return TypeMsgPurchaseReaderQuota
+}
+
+func (msg *MsgPurchaseReaderQuota) GetSigners() []sdk.AccAddress {
+	reader, err := sdk.AccAddressFromHexUnsafe(msg.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reader}
+}
+
+func (msg *MsgPurchaseReaderQuota) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPurchaseReaderQuota) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Reader)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid reader address (%s)", err)
+	}
+
+	if msg.PaymentAddress != "" {
+		if _, err = sdk.AccAddressFromHexUnsafe(msg.PaymentAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid payment address (%s)", err)
+		}
+	}
+
+	return s3util.CheckValidBucketName(msg.BucketName)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgPurchaseReaderQuota_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPurchaseReaderQuota
+		err  error
+	}{
+		{
+			name: "invalid reader",
+			msg: MsgPurchaseReaderQuota{
+				Reader:     "invalid_address",
+				BucketName: testBucketName,
+				ReadQuota:  1024,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid payment address",
+			msg: MsgPurchaseReaderQuota{
+				Reader:         sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				PaymentAddress: "invalid_address",
+				ReadQuota:      1024,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgPurchaseReaderQuota{
+				Reader:     sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+				ReadQuota:  1024,
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "cancel read quota",
+			msg: MsgPurchaseReaderQuota{
+				Reader:     sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		}, {
+			name: "valid case",
+			msg: MsgPurchaseReaderQuota{
+				Reader:         sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				PaymentAddress: sample.RandAccAddressHex(),
+				ReadQuota:      1024,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -24,6 +24,8 @@ const (
 	DefaultDiscontinueDeletionMax    uint64 = 100
 	DefaultStalePolicyCleanupMax     uint64 = 200
 	DefaultMinUpdateQuotaInterval    uint64 = 2592000 // 30 days (in second)
+	DefaultLifecycleDeletionMax      uint64 = 100
+	DefaultMaxBatchSize              uint64 = 100
 
 	DefaultMaxLocalVirtualGroupNumPerBucket uint32 = 10
 	DefaultBscMirrorBucketRelayerFee               = "1300000000000000" // 0.0013
@@ -67,6 +69,9 @@ var (
 	KeyOpMirrorGroupRelayerFee          = []byte("OpMirrorGroupRelayerFee")
 	KeyOpMirrorGroupAckRelayerFee       = []byte("OpMirrorGroupAckRelayerFee")
 	KeyMaxLocalVirtualGroupNumPerBucket = []byte("MaxLocalVirtualGroupNumPerBucket")
+	KeyLifecycleDeletionMax             = []byte("LifecycleDeletionMax")
+	KeyRetentionCompliantSpIds          = []byte("RetentionCompliantSpIds")
+	KeyMaxBatchSize                     = []byte("MaxBatchSize")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -92,6 +97,9 @@ func NewParams(
 	stalePoliesCleanupMax uint64,
 	minUpdateQuotaInterval uint64,
 	maxLocalVirtualGroupNumPerBucket uint32,
+	lifecycleDeletionMax uint64,
+	retentionCompliantSpIds []uint32,
+	maxBatchSize uint64,
 ) Params {
 	return Params{
 		VersionedParams: VersionedParams{
@@ -122,6 +130,9 @@ func NewParams(
 		StalePolicyCleanupMax:            stalePoliesCleanupMax,
 		MinQuotaUpdateInterval:           minUpdateQuotaInterval,
 		MaxLocalVirtualGroupNumPerBucket: maxLocalVirtualGroupNumPerBucket,
+		LifecycleDeletionMax:             lifecycleDeletionMax,
+		RetentionCompliantSpIds:          retentionCompliantSpIds,
+		MaxBatchSize:                     maxBatchSize,
 	}
 }
 
@@ -138,7 +149,8 @@ func DefaultParams() Params {
 		DefaultOpMirrorGroupRelayerFee, DefaultOpMirrorGroupAckRelayerFee,
 		DefaultDiscontinueCountingWindow, DefaultDiscontinueObjectMax, DefaultDiscontinueBucketMax,
 		DefaultDiscontinueConfirmPeriod, DefaultDiscontinueDeletionMax, DefaultStalePolicyCleanupMax,
-		DefaultMinUpdateQuotaInterval, DefaultMaxLocalVirtualGroupNumPerBucket,
+		DefaultMinUpdateQuotaInterval, DefaultMaxLocalVirtualGroupNumPerBucket, DefaultLifecycleDeletionMax,
+		nil, DefaultMaxBatchSize,
 	)
 }
 
@@ -172,6 +184,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyStalePolicyCleanupMax, &p.StalePolicyCleanupMax, validateStalePolicyCleanupMax),
 		paramtypes.NewParamSetPair(KeyMinUpdateQuotaInterval, &p.MinQuotaUpdateInterval, validateMinUpdateQuotaInterval),
 		paramtypes.NewParamSetPair(KeyMaxLocalVirtualGroupNumPerBucket, &p.MaxLocalVirtualGroupNumPerBucket, validateMaxLocalVirtualGroupNumPerBucket),
+		paramtypes.NewParamSetPair(KeyLifecycleDeletionMax, &p.LifecycleDeletionMax, validateLifecycleDeletionMax),
+		paramtypes.NewParamSetPair(KeyRetentionCompliantSpIds, &p.RetentionCompliantSpIds, validateRetentionCompliantSpIds),
+		paramtypes.NewParamSetPair(KeyMaxBatchSize, &p.MaxBatchSize, validateMaxBatchSize),
 	}
 }
 
@@ -255,6 +270,15 @@ func (p Params) Validate() error {
 	if err := validateMaxLocalVirtualGroupNumPerBucket(p.MaxLocalVirtualGroupNumPerBucket); err != nil {
 		return err
 	}
+	if err := validateLifecycleDeletionMax(p.LifecycleDeletionMax); err != nil {
+		return err
+	}
+	if err := validateRetentionCompliantSpIds(p.RetentionCompliantSpIds); err != nil {
+		return err
+	}
+	if err := validateMaxBatchSize(p.MaxBatchSize); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -451,6 +475,45 @@ func validateDiscontinueDeletionMax(i interface{}) error {
 	return nil
 }
 
+// validateLifecycleDeletionMax allows 0, which disables applying the bucket lifecycle rules
+func validateLifecycleDeletionMax(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRetentionCompliantSpIds(i interface{}) error {
+	v, ok := i.([]uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint32]bool, len(v))
+	for _, spId := range v {
+		if spId == 0 {
+			return fmt.Errorf("retention compliant sp id must be positive")
+		}
+		if seen[spId] {
+			return fmt.Errorf("duplicated retention compliant sp id: %d", spId)
+		}
+		seen[spId] = true
+	}
+	return nil
+}
+
+// validateMaxBatchSize allows 0, which disables the batch create and delete objects messages
+func validateMaxBatchSize(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateStalePolicyCleanupMax(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -70,6 +70,12 @@ type Params struct {
 	OpMirrorGroupRelayerFee string `protobuf:"bytes,22,opt,name=op_mirror_group_relayer_fee,json=opMirrorGroupRelayerFee,proto3" json:"op_mirror_group_relayer_fee,omitempty"`
 	// Relayer fee for the ACK or FAIL_ACK package of the mirror object tx to op chain
 	OpMirrorGroupAckRelayerFee string `protobuf:"bytes,23,opt,name=op_mirror_group_ack_relayer_fee,json=opMirrorGroupAckRelayerFee,proto3" json:"op_mirror_group_ack_relayer_fee,omitempty"`
+	// The max objects deleted by the bucket lifecycle rules in each end block, 0 means the lifecycle rules are not applied
+	LifecycleDeletionMax uint64 `protobuf:"varint,24,opt,name=lifecycle_deletion_max,json=lifecycleDeletionMax,proto3" json:"lifecycle_deletion_max,omitempty"`
+	// The storage providers which are allowed to be the destination of migrating a retention locked bucket
+	RetentionCompliantSpIds []uint32 `protobuf:"varint,25,rep,packed,name=retention_compliant_sp_ids,json=retentionCompliantSpIds,proto3" json:"retention_compliant_sp_ids,omitempty"`
+	// The max items of a batch create or delete objects message, 0 means the batch messages are disabled
+	MaxBatchSize uint64 `protobuf:"varint,26,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -265,6 +271,27 @@ func (m *Params) GetOpMirrorGroupAckRelayerFee() string {
 	return ""
 }
 
+func (m *Params) GetLifecycleDeletionMax() uint64 {
+	if m != nil {
+		return m.LifecycleDeletionMax
+	}
+	return 0
+}
+
+func (m *Params) GetRetentionCompliantSpIds() []uint32 {
+	if m != nil {
+		return m.RetentionCompliantSpIds
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 // VersionedParams defines the parameters for the storage module with multi version, each version store with different timestamp.
 type VersionedParams struct {
 	// max_segment_size is the maximum size of a segment. default: 16M
@@ -345,59 +372,64 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/params.proto", fileDescriptor_127b8b1511d84eca) }
 
 var fileDescriptor_127b8b1511d84eca = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x6f, 0xdb, 0x36,
-	0x18, 0xb6, 0x16, 0x2f, 0x5b, 0x99, 0xa5, 0xc9, 0xb4, 0xa4, 0x51, 0x92, 0xce, 0xd1, 0x3a, 0xa0,
-	0xf0, 0x65, 0x36, 0xb0, 0x0f, 0x74, 0x1f, 0x45, 0xb1, 0xc6, 0xed, 0x8a, 0x02, 0x6b, 0xe7, 0xb9,
-	0x5b, 0x06, 0xec, 0x42, 0x50, 0x14, 0xa3, 0x70, 0x96, 0x48, 0x8d, 0xa2, 0x5c, 0xbb, 0xbf, 0x62,
-	0xc7, 0x1d, 0xf7, 0x73, 0x7a, 0xec, 0x71, 0x97, 0x7d, 0x20, 0xf9, 0x23, 0x03, 0x5f, 0xaa, 0xb6,
-	0x28, 0x25, 0xbb, 0x09, 0x7c, 0x3e, 0xf8, 0xf0, 0xd5, 0xfb, 0x92, 0xe8, 0x28, 0x51, 0x8c, 0x89,
-	0x53, 0xce, 0xd2, 0x78, 0x58, 0x68, 0xa9, 0x48, 0xc2, 0x86, 0x39, 0x51, 0x24, 0x2b, 0x06, 0xb9,
-	0x92, 0x5a, 0xfa, 0xfe, 0x8a, 0x30, 0xa8, 0x08, 0x07, 0x3b, 0x89, 0x4c, 0x24, 0xc0, 0x43, 0xf3,
-	0x65, 0x99, 0xb7, 0xfe, 0xda, 0x40, 0xeb, 0x63, 0x90, 0xfa, 0x3f, 0xa0, 0xed, 0x19, 0x53, 0x05,
-	0x97, 0x82, 0xc5, 0xd8, 0xda, 0x05, 0x5e, 0xe8, 0xf5, 0x37, 0x3e, 0xfe, 0x70, 0xd0, 0xf6, 0x1b,
-	0x9c, 0xbc, 0xe6, 0x5a, 0xf9, 0x71, 0xf7, 0xe5, 0xdf, 0x47, 0x9d, 0xc9, 0xd6, 0xcc, 0x5d, 0xf6,
-	0xfb, 0x68, 0x3b, 0x23, 0x73, 0x9c, 0x93, 0x45, 0x2a, 0x49, 0x8c, 0x0b, 0xfe, 0x82, 0x05, 0x6f,
-	0x84, 0x5e, 0xbf, 0x3b, 0xb9, 0x9e, 0x91, 0xf9, 0xd8, 0x2e, 0x3f, 0xe3, 0x2f, 0x98, 0xff, 0x35,
-	0x7a, 0x3f, 0x2a, 0x28, 0xce, 0xb8, 0x52, 0x52, 0xe1, 0xa8, 0xa4, 0x53, 0xa6, 0xb1, 0x62, 0x29,
-	0x59, 0x30, 0x85, 0x4f, 0x19, 0x0b, 0xd6, 0x42, 0xaf, 0x7f, 0x6d, 0xb2, 0x1f, 0x15, 0xf4, 0x09,
-	0x70, 0x8e, 0x81, 0x32, 0xb1, 0x8c, 0x6f, 0x18, 0xf3, 0x1f, 0xa1, 0x0f, 0xda, 0x0e, 0x84, 0x4e,
-	0x1d, 0x97, 0x2e, 0xb8, 0xdc, 0x6c, 0xb8, 0xdc, 0xa7, 0xd3, 0x9a, 0x91, 0x1b, 0x45, 0x46, 0xbf,
-	0x30, 0xea, 0x46, 0x79, 0xb3, 0x11, 0xe5, 0x3b, 0xa0, 0x5c, 0x19, 0xa5, 0x72, 0x68, 0x46, 0x59,
-	0x6f, 0x44, 0xb1, 0x2e, 0x6e, 0x94, 0x7b, 0xe8, 0x66, 0xcd, 0x28, 0x51, 0xb2, 0xcc, 0x1d, 0x8f,
-	0xb7, 0xc0, 0x23, 0x58, 0x7a, 0x3c, 0x32, 0x8c, 0x9a, 0xfe, 0x21, 0x0a, 0x5b, 0xfa, 0x66, 0x8e,
-	0xb7, 0xc1, 0xe3, 0xd0, 0xf5, 0x70, 0x63, 0x7c, 0x86, 0xf6, 0xcc, 0x6f, 0xb4, 0x35, 0x2d, 0x70,
-	0xce, 0x14, 0x26, 0x94, 0xca, 0x52, 0xe8, 0xe0, 0x5a, 0xe8, 0xf5, 0x37, 0x27, 0x3b, 0x19, 0x99,
-	0xdb, 0x52, 0x16, 0x63, 0xa6, 0xee, 0x5b, 0xcc, 0xbf, 0x87, 0x0e, 0x63, 0x5e, 0x50, 0x29, 0x34,
-	0x17, 0x25, 0xc3, 0xb0, 0xc8, 0x45, 0x82, 0x9f, 0x73, 0x11, 0xcb, 0xe7, 0x01, 0x82, 0x46, 0xd8,
-	0xaf, 0x51, 0x46, 0x15, 0xe3, 0x27, 0x20, 0xf8, 0x9f, 0xa2, 0x1b, 0x75, 0x7d, 0x55, 0xc7, 0x8c,
-	0xcc, 0x83, 0x0d, 0x90, 0xee, 0xd4, 0x50, 0x5b, 0xbd, 0x27, 0x64, 0xde, 0x54, 0x55, 0x8d, 0x60,
-	0x54, 0xef, 0xb4, 0x54, 0x36, 0xb3, 0x51, 0xdd, 0x45, 0x07, 0x6e, 0x56, 0x71, 0xca, 0x55, 0x66,
-	0x8e, 0xca, 0x65, 0x1c, 0x6c, 0x86, 0x5e, 0x7f, 0x6d, 0x12, 0x38, 0x51, 0x81, 0x30, 0x06, 0xdc,
-	0xff, 0x1c, 0xd5, 0x31, 0x1c, 0xb3, 0x94, 0x69, 0x2e, 0x05, 0xec, 0x7a, 0x1d, 0x76, 0xad, 0x67,
-	0x7a, 0x50, 0xc1, 0x66, 0xdf, 0x3b, 0x28, 0x28, 0x34, 0x49, 0x19, 0xce, 0x65, 0xca, 0xe9, 0x02,
-	0xd3, 0x94, 0x11, 0x51, 0xe6, 0xa0, 0xdc, 0x02, 0xe5, 0x2e, 0xe0, 0x63, 0x80, 0x47, 0x16, 0x35,
-	0xc2, 0x2f, 0xd0, 0x7e, 0xc6, 0x05, 0xfe, 0xb5, 0x94, 0x9a, 0xe0, 0x32, 0x8f, 0x89, 0x66, 0x98,
-	0x0b, 0xcd, 0xd4, 0x8c, 0xa4, 0xc1, 0xb6, 0xdd, 0x33, 0xe3, 0xe2, 0x7b, 0x83, 0xff, 0x08, 0xf0,
-	0xe3, 0x0a, 0xf5, 0xc7, 0xe8, 0xb6, 0xf9, 0x9d, 0xa9, 0xa4, 0x24, 0xc5, 0x33, 0xae, 0x74, 0x49,
-	0xd2, 0xaa, 0x39, 0x44, 0x09, 0x67, 0xae, 0xaa, 0x16, 0xbc, 0x0b, 0x7f, 0x37, 0xcc, 0xc8, 0xfc,
-	0x5b, 0x43, 0x3e, 0xb1, 0x5c, 0xe8, 0x90, 0xa7, 0xa5, 0x39, 0xbc, 0x2d, 0xa0, 0xe9, 0x53, 0x99,
-	0xff, 0xcf, 0xf0, 0xfa, 0xb6, 0x4f, 0x65, 0x7e, 0xc5, 0xec, 0x3e, 0x44, 0x61, 0x4b, 0xdf, 0xec,
-	0xd3, 0xf7, 0x6c, 0x9f, 0xba, 0x1e, 0xad, 0x71, 0x59, 0xd9, 0x5c, 0x32, 0xb8, 0x3b, 0x6e, 0x8c,
-	0xd6, 0xdc, 0x3a, 0x31, 0xae, 0x18, 0xdb, 0x5d, 0x37, 0xc6, 0x65, 0x53, 0x7b, 0x17, 0x1d, 0xae,
-	0x6c, 0xda, 0x43, 0x7b, 0x03, 0x1c, 0xf6, 0x5e, 0x3b, 0x34, 0x67, 0x76, 0x84, 0x8e, 0x9a, 0xea,
-	0x66, 0x86, 0x3d, 0x70, 0x38, 0x70, 0x1c, 0x9c, 0x08, 0x5f, 0x76, 0x7f, 0xff, 0xe3, 0xa8, 0x73,
-	0xeb, 0x1f, 0x0f, 0x6d, 0x9d, 0x5c, 0x7e, 0x25, 0x17, 0x2c, 0xc9, 0x98, 0xd0, 0xf6, 0x4a, 0xf6,
-	0x96, 0x57, 0xf2, 0x33, 0xbb, 0x0c, 0x57, 0xf2, 0x1d, 0x14, 0x28, 0x16, 0x97, 0x22, 0x26, 0x42,
-	0xe3, 0x98, 0x68, 0x82, 0xe9, 0x59, 0x29, 0xa6, 0xa6, 0x47, 0xe0, 0x12, 0xdf, 0x9c, 0xec, 0x2e,
-	0xf1, 0x07, 0x44, 0x93, 0x91, 0x41, 0x9f, 0x96, 0x99, 0xff, 0x15, 0x3a, 0x58, 0x09, 0x73, 0xa2,
-	0xb8, 0x5e, 0xd4, 0xa4, 0x6b, 0x20, 0xdd, 0x5b, 0x32, 0xc6, 0x40, 0x58, 0x8a, 0x6f, 0xa3, 0x2d,
-	0xd3, 0xd7, 0xf4, 0x8c, 0xa8, 0x84, 0xd9, 0x78, 0x5d, 0x88, 0xb7, 0x99, 0x71, 0x31, 0x82, 0x55,
-	0x93, 0xce, 0x9e, 0xf0, 0xf8, 0xf1, 0xcb, 0xf3, 0x9e, 0xf7, 0xea, 0xbc, 0xe7, 0xfd, 0x7b, 0xde,
-	0xf3, 0x7e, 0xbb, 0xe8, 0x75, 0x5e, 0x5d, 0xf4, 0x3a, 0x7f, 0x5e, 0xf4, 0x3a, 0x3f, 0x0f, 0x13,
-	0xae, 0xcf, 0xca, 0x68, 0x40, 0x65, 0x36, 0x8c, 0x44, 0xf4, 0x11, 0x3d, 0x23, 0x5c, 0x0c, 0x6b,
-	0x6f, 0xe7, 0x7c, 0xf9, 0x7a, 0xea, 0x45, 0xce, 0x8a, 0x68, 0x1d, 0xde, 0xc4, 0x4f, 0xfe, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0xb3, 0x74, 0xe3, 0x82, 0x60, 0x07, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x73, 0x1b, 0x35,
+	0x18, 0xf6, 0x12, 0x13, 0xa8, 0x1a, 0x27, 0x61, 0x49, 0xe2, 0x8d, 0x53, 0x9c, 0xa5, 0x30, 0x1d,
+	0x5f, 0xb0, 0x67, 0xa0, 0x4c, 0xf9, 0xe8, 0x74, 0x68, 0xdc, 0xd2, 0xc9, 0x0c, 0x2d, 0xc6, 0x81,
+	0x30, 0xc3, 0x45, 0x23, 0x6b, 0x95, 0xb5, 0xc8, 0xae, 0xb4, 0x68, 0xb5, 0xa9, 0xdd, 0x5f, 0xc1,
+	0x91, 0x23, 0x3f, 0xa7, 0xc7, 0x1c, 0x39, 0x01, 0x93, 0xfc, 0x07, 0xce, 0x8c, 0x5e, 0x6d, 0xec,
+	0xfd, 0x48, 0x7a, 0xdb, 0xd1, 0xf3, 0xa1, 0x47, 0xda, 0xf7, 0xd5, 0x8b, 0xf6, 0x43, 0xc5, 0x98,
+	0x38, 0xe1, 0x2c, 0x0a, 0x06, 0xa9, 0x96, 0x8a, 0x84, 0x6c, 0x90, 0x10, 0x45, 0xe2, 0xb4, 0x9f,
+	0x28, 0xa9, 0xa5, 0xeb, 0x2e, 0x09, 0xfd, 0x9c, 0xd0, 0xd9, 0x0a, 0x65, 0x28, 0x01, 0x1e, 0x98,
+	0x2f, 0xcb, 0xbc, 0xfb, 0xdf, 0x1a, 0x5a, 0x1d, 0x81, 0xd4, 0xfd, 0x11, 0x6d, 0x9e, 0x31, 0x95,
+	0x72, 0x29, 0x58, 0x80, 0xad, 0x9d, 0xe7, 0xf8, 0x4e, 0xef, 0xf6, 0xa7, 0x1f, 0xf5, 0xeb, 0x7e,
+	0xfd, 0xe3, 0x2b, 0xae, 0x95, 0x1f, 0x34, 0x5f, 0xff, 0xbd, 0xdf, 0x18, 0x6f, 0x9c, 0x95, 0x97,
+	0xdd, 0x1e, 0xda, 0x8c, 0xc9, 0x0c, 0x27, 0x64, 0x1e, 0x49, 0x12, 0xe0, 0x94, 0xbf, 0x62, 0xde,
+	0x5b, 0xbe, 0xd3, 0x6b, 0x8e, 0xd7, 0x63, 0x32, 0x1b, 0xd9, 0xe5, 0x23, 0xfe, 0x8a, 0xb9, 0xdf,
+	0xa0, 0x0f, 0x26, 0x29, 0xc5, 0x31, 0x57, 0x4a, 0x2a, 0x3c, 0xc9, 0xe8, 0x29, 0xd3, 0x58, 0xb1,
+	0x88, 0xcc, 0x99, 0xc2, 0x27, 0x8c, 0x79, 0x2b, 0xbe, 0xd3, 0xbb, 0x35, 0xde, 0x9d, 0xa4, 0xf4,
+	0x39, 0x70, 0x0e, 0x80, 0x32, 0xb6, 0x8c, 0x6f, 0x19, 0x73, 0x9f, 0xa1, 0x0f, 0xeb, 0x0e, 0x84,
+	0x9e, 0x96, 0x5c, 0x9a, 0xe0, 0x72, 0xa7, 0xe2, 0xf2, 0x98, 0x9e, 0x16, 0x8c, 0xca, 0x51, 0xe4,
+	0xe4, 0x57, 0x46, 0xcb, 0x51, 0xde, 0xae, 0x44, 0xf9, 0x1e, 0x28, 0x37, 0x46, 0xc9, 0x1d, 0xaa,
+	0x51, 0x56, 0x2b, 0x51, 0xac, 0x4b, 0x39, 0xca, 0x23, 0x74, 0xa7, 0x60, 0x14, 0x2a, 0x99, 0x25,
+	0x25, 0x8f, 0x77, 0xc0, 0xc3, 0x5b, 0x78, 0x3c, 0x33, 0x8c, 0x82, 0xfe, 0x29, 0xf2, 0x6b, 0xfa,
+	0x6a, 0x8e, 0x77, 0xc1, 0x63, 0xaf, 0xec, 0x51, 0x8e, 0xf1, 0x39, 0x6a, 0x9b, 0xdf, 0x68, 0xef,
+	0x34, 0xc5, 0x09, 0x53, 0x98, 0x50, 0x2a, 0x33, 0xa1, 0xbd, 0x5b, 0xbe, 0xd3, 0x6b, 0x8d, 0xb7,
+	0x62, 0x32, 0xb3, 0x57, 0x99, 0x8e, 0x98, 0x7a, 0x6c, 0x31, 0xf7, 0x11, 0xda, 0x0b, 0x78, 0x4a,
+	0xa5, 0xd0, 0x5c, 0x64, 0x0c, 0xc3, 0x22, 0x17, 0x21, 0x7e, 0xc9, 0x45, 0x20, 0x5f, 0x7a, 0x08,
+	0x0a, 0x61, 0xb7, 0x40, 0x19, 0xe6, 0x8c, 0x9f, 0x81, 0xe0, 0xde, 0x47, 0x3b, 0x45, 0x7d, 0x7e,
+	0x8f, 0x31, 0x99, 0x79, 0xb7, 0x41, 0xba, 0x55, 0x40, 0xed, 0xed, 0x3d, 0x27, 0xb3, 0xaa, 0x2a,
+	0x2f, 0x04, 0xa3, 0x5a, 0xab, 0xa9, 0x6c, 0x66, 0xa3, 0x7a, 0x88, 0x3a, 0xe5, 0xac, 0xe2, 0x84,
+	0xab, 0xd8, 0x1c, 0x95, 0xcb, 0xc0, 0x6b, 0xf9, 0x4e, 0x6f, 0x65, 0xec, 0x95, 0xa2, 0x02, 0x61,
+	0x04, 0xb8, 0xfb, 0x05, 0x2a, 0x62, 0x38, 0x60, 0x11, 0xd3, 0x5c, 0x0a, 0xd8, 0x75, 0x1d, 0x76,
+	0x2d, 0x66, 0x7a, 0x92, 0xc3, 0x66, 0xdf, 0x07, 0xc8, 0x4b, 0x35, 0x89, 0x18, 0x4e, 0x64, 0xc4,
+	0xe9, 0x1c, 0xd3, 0x88, 0x11, 0x91, 0x25, 0xa0, 0xdc, 0x00, 0xe5, 0x36, 0xe0, 0x23, 0x80, 0x87,
+	0x16, 0x35, 0xc2, 0x2f, 0xd1, 0x6e, 0xcc, 0x05, 0xfe, 0x2d, 0x93, 0x9a, 0xe0, 0x2c, 0x09, 0x88,
+	0x66, 0x98, 0x0b, 0xcd, 0xd4, 0x19, 0x89, 0xbc, 0x4d, 0xbb, 0x67, 0xcc, 0xc5, 0x0f, 0x06, 0xff,
+	0x09, 0xe0, 0xc3, 0x1c, 0x75, 0x47, 0xe8, 0x9e, 0xf9, 0x9d, 0x91, 0xa4, 0x24, 0xc2, 0x67, 0x5c,
+	0xe9, 0x8c, 0x44, 0x79, 0x71, 0x88, 0x0c, 0xce, 0x9c, 0xdf, 0x9a, 0xf7, 0x1e, 0xfc, 0x5d, 0x3f,
+	0x26, 0xb3, 0xef, 0x0c, 0xf9, 0xd8, 0x72, 0xa1, 0x42, 0x5e, 0x64, 0xe6, 0xf0, 0xf6, 0x02, 0x4d,
+	0x9d, 0xca, 0xe4, 0x0d, 0xcd, 0xeb, 0xda, 0x3a, 0x95, 0xc9, 0x0d, 0xbd, 0xfb, 0x14, 0xf9, 0x35,
+	0x7d, 0xb5, 0x4e, 0xdf, 0xb7, 0x75, 0x5a, 0xf6, 0xa8, 0xb5, 0xcb, 0xd2, 0xe6, 0x9a, 0xc6, 0xdd,
+	0x2a, 0xc7, 0xa8, 0xf5, 0x6d, 0x29, 0xc6, 0x0d, 0x6d, 0xbb, 0x5d, 0x8e, 0x71, 0x5d, 0xd7, 0x3e,
+	0x44, 0x7b, 0x4b, 0x9b, 0x7a, 0xd3, 0xee, 0x80, 0x43, 0xfb, 0xca, 0xa1, 0xda, 0xb3, 0x43, 0xb4,
+	0x5f, 0x55, 0x57, 0x33, 0xb4, 0xc1, 0xa1, 0x53, 0x72, 0x28, 0x47, 0xb8, 0x8f, 0x76, 0x22, 0x7e,
+	0xc2, 0xe8, 0x9c, 0x46, 0x95, 0x72, 0xf4, 0x6c, 0x13, 0x2c, 0xd0, 0x62, 0x31, 0x7e, 0x8d, 0x3a,
+	0x8a, 0x69, 0x26, 0x80, 0x4c, 0x65, 0x9c, 0x44, 0x9c, 0x08, 0x8d, 0xd3, 0x04, 0xf3, 0x20, 0xf5,
+	0x76, 0xfd, 0x95, 0x5e, 0x6b, 0xdc, 0x5e, 0x30, 0x86, 0x57, 0x84, 0xa3, 0xe4, 0x30, 0x48, 0xdd,
+	0x8f, 0xd1, 0x3a, 0x3c, 0x12, 0x44, 0xd3, 0xa9, 0x7d, 0xe9, 0x3b, 0xb0, 0xd5, 0x9a, 0x79, 0x1b,
+	0xcc, 0xa2, 0x79, 0xe7, 0xbf, 0x6a, 0xfe, 0xf1, 0xe7, 0x7e, 0xe3, 0xee, 0x3f, 0x0e, 0xda, 0x38,
+	0xbe, 0x7e, 0x56, 0xa4, 0x2c, 0x8c, 0x99, 0xd9, 0xd4, 0x38, 0x38, 0x8b, 0x59, 0x71, 0x64, 0x97,
+	0x61, 0x56, 0x3c, 0x40, 0x9e, 0x62, 0x41, 0x26, 0x02, 0x13, 0x2e, 0x20, 0x9a, 0x60, 0x3a, 0xcd,
+	0xc4, 0xa9, 0x29, 0x5e, 0x98, 0x2e, 0xad, 0xf1, 0xf6, 0x02, 0x7f, 0x42, 0x34, 0x19, 0x1a, 0xf4,
+	0x45, 0x16, 0xdb, 0xf3, 0x5d, 0x09, 0x13, 0xa2, 0xb8, 0x9e, 0x17, 0xa4, 0x2b, 0x20, 0x6d, 0x2f,
+	0x18, 0x23, 0x20, 0x2c, 0xc4, 0xf7, 0xd0, 0x86, 0x69, 0x38, 0x3a, 0x25, 0x2a, 0x64, 0x36, 0x5e,
+	0x13, 0xe2, 0xb5, 0x62, 0x2e, 0x86, 0xb0, 0xba, 0x3c, 0xe1, 0xc1, 0xe1, 0xeb, 0x8b, 0xae, 0x73,
+	0x7e, 0xd1, 0x75, 0xfe, 0xbd, 0xe8, 0x3a, 0xbf, 0x5f, 0x76, 0x1b, 0xe7, 0x97, 0xdd, 0xc6, 0x5f,
+	0x97, 0xdd, 0xc6, 0x2f, 0x83, 0x90, 0xeb, 0x69, 0x36, 0xe9, 0x53, 0x19, 0x0f, 0x26, 0x62, 0xf2,
+	0x09, 0x9d, 0x12, 0x2e, 0x06, 0x85, 0xa1, 0x3e, 0x5b, 0x8c, 0x75, 0x3d, 0x4f, 0x58, 0x3a, 0x59,
+	0x85, 0x61, 0xfd, 0xd9, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x12, 0x14, 0x5e, 0x4b, 0xf9, 0x07,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -420,6 +452,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.RetentionCompliantSpIds) > 0 {
+		dAtA2 := make([]byte, len(m.RetentionCompliantSpIds)*10)
+		var j1 int
+		for _, num := range m.RetentionCompliantSpIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.LifecycleDeletionMax != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LifecycleDeletionMax))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	if len(m.OpMirrorGroupAckRelayerFee) > 0 {
 		i -= len(m.OpMirrorGroupAckRelayerFee)
 		copy(dAtA[i:], m.OpMirrorGroupAckRelayerFee)
@@ -723,6 +789,19 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.LifecycleDeletionMax != 0 {
+		n += 2 + sovParams(uint64(m.LifecycleDeletionMax))
+	}
+	if len(m.RetentionCompliantSpIds) > 0 {
+		l = 0
+		for _, e := range m.RetentionCompliantSpIds {
+			l += sovParams(uint64(e))
+		}
+		n += 2 + sovParams(uint64(l)) + l
+	}
+	if m.MaxBatchSize != 0 {
+		n += 2 + sovParams(uint64(m.MaxBatchSize))
+	}
 	return n
 }
 
@@ -1389,6 +1468,120 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.OpMirrorGroupAckRelayerFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleDeletionMax", wireType)
+			}
+			m.LifecycleDeletionMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleDeletionMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetentionCompliantSpIds = append(m.RetentionCompliantSpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetentionCompliantSpIds) == 0 {
+					m.RetentionCompliantSpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetentionCompliantSpIds = append(m.RetentionCompliantSpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionCompliantSpIds", wireType)
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
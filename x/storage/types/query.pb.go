@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	resource "github.com/bnb-chain/greenfield/types/resource"
 	types1 "github.com/bnb-chain/greenfield/x/permission/types"
 	types "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -34,6 +35,69 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PermissionTraceStepType defines the kind of a step in the permission verification trace.
+type PermissionTraceStepType int32
+
+const (
+	TRACE_STEP_UNSPECIFIED PermissionTraceStepType = 0
+	// the resource is public and the action is read-only
+	TRACE_STEP_PUBLIC_READ PermissionTraceStepType = 1
+	// the operator is empty, no policy is checked
+	TRACE_STEP_ANONYMOUS PermissionTraceStepType = 2
+	// the operator is the owner of the resource
+	TRACE_STEP_OWNER PermissionTraceStepType = 3
+	// the policy granted to the operator account is evaluated
+	TRACE_STEP_ACCOUNT_POLICY PermissionTraceStepType = 4
+	// the policy granted to a group is evaluated
+	TRACE_STEP_GROUP_POLICY PermissionTraceStepType = 5
+	// the policy is skipped because it has expired
+	TRACE_STEP_POLICY_EXPIRED PermissionTraceStepType = 6
+	// the statement is skipped because it has expired or is out of its time window
+	TRACE_STEP_STATEMENT_EXPIRED PermissionTraceStepType = 7
+	// the group policy is skipped because the operator is not a valid member of the group
+	TRACE_STEP_GROUP_MEMBER_MISSING PermissionTraceStepType = 8
+	// the final effect of the verification
+	TRACE_STEP_FINAL PermissionTraceStepType = 9
+	// the operator reads the data of a requester-pays bucket without its own read quota
+	TRACE_STEP_READER_QUOTA_MISSING PermissionTraceStepType = 10
+)
+
+var PermissionTraceStepType_name = map[int32]string{
+	0:  "TRACE_STEP_UNSPECIFIED",
+	1:  "TRACE_STEP_PUBLIC_READ",
+	2:  "TRACE_STEP_ANONYMOUS",
+	3:  "TRACE_STEP_OWNER",
+	4:  "TRACE_STEP_ACCOUNT_POLICY",
+	5:  "TRACE_STEP_GROUP_POLICY",
+	6:  "TRACE_STEP_POLICY_EXPIRED",
+	7:  "TRACE_STEP_STATEMENT_EXPIRED",
+	8:  "TRACE_STEP_GROUP_MEMBER_MISSING",
+	9:  "TRACE_STEP_FINAL",
+	10: "TRACE_STEP_READER_QUOTA_MISSING",
+}
+
+var PermissionTraceStepType_value = map[string]int32{
+	"TRACE_STEP_UNSPECIFIED":          0,
+	"TRACE_STEP_PUBLIC_READ":          1,
+	"TRACE_STEP_ANONYMOUS":            2,
+	"TRACE_STEP_OWNER":                3,
+	"TRACE_STEP_ACCOUNT_POLICY":       4,
+	"TRACE_STEP_GROUP_POLICY":         5,
+	"TRACE_STEP_POLICY_EXPIRED":       6,
+	"TRACE_STEP_STATEMENT_EXPIRED":    7,
+	"TRACE_STEP_GROUP_MEMBER_MISSING": 8,
+	"TRACE_STEP_FINAL":                9,
+	"TRACE_STEP_READER_QUOTA_MISSING": 10,
+}
+
+func (x PermissionTraceStepType) String() string {
+	return proto.EnumName(PermissionTraceStepType_name, int32(x))
+}
+
+func (PermissionTraceStepType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -692,6 +756,16 @@ func (m *QueryListBucketsResponse) GetPagination() *query.PageResponse {
 type QueryListObjectsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	BucketName string             `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// prefix limits the response to the objects whose names begin with it.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// delimiter groups the objects whose names contain it after the prefix into common_prefixes,
+	// the object names are not split if it is empty.
+	Delimiter string `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// start_after limits the response to the objects whose names are lexicographically greater than it.
+	// The objects are listed in the order of their names if any of prefix, delimiter and start_after is set. While the
+	// objects existing before the upgrade are still being indexed by name, they are listed in the order of the object
+	// keys instead, and a common prefix may be repeated across the pages.
+	StartAfter string `protobuf:"bytes,5,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 }
 
 func (m *QueryListObjectsRequest) Reset()         { *m = QueryListObjectsRequest{} }
@@ -741,6 +815,27 @@ func (m *QueryListObjectsRequest) GetBucketName() string {
 	return ""
 }
 
+func (m *QueryListObjectsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QueryListObjectsRequest) GetDelimiter() string {
+	if m != nil {
+		return m.Delimiter
+	}
+	return ""
+}
+
+func (m *QueryListObjectsRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
 type QueryListObjectsByBucketIdRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	BucketId   string             `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
@@ -796,6 +891,9 @@ func (m *QueryListObjectsByBucketIdRequest) GetBucketId() string {
 type QueryListObjectsResponse struct {
 	ObjectInfos []*ObjectInfo       `protobuf:"bytes,1,rep,name=object_infos,json=objectInfos,proto3" json:"object_infos,omitempty"`
 	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// common_prefixes defines the distinct object name prefixes up to the first delimiter after the prefix
+	// of the request, the objects under them are not listed in object_infos.
+	CommonPrefixes []string `protobuf:"bytes,3,rep,name=common_prefixes,json=commonPrefixes,proto3" json:"common_prefixes,omitempty"`
 }
 
 func (m *QueryListObjectsResponse) Reset()         { *m = QueryListObjectsResponse{} }
@@ -845,6 +943,13 @@ func (m *QueryListObjectsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryListObjectsResponse) GetCommonPrefixes() []string {
+	if m != nil {
+		return m.CommonPrefixes
+	}
+	return nil
+}
+
 type QueryNFTRequest struct {
 	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
@@ -1229,6 +1334,205 @@ func (m *QueryVerifyPermissionResponse) GetEffect() types1.Effect {
 	return types1.EFFECT_UNSPECIFIED
 }
 
+type QueryExplainPermissionRequest struct {
+	Operator   string            `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	BucketName string            `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string            `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	ActionType types1.ActionType `protobuf:"varint,4,opt,name=action_type,json=actionType,proto3,enum=greenfield.permission.ActionType" json:"action_type,omitempty"`
+}
+
+func (m *QueryExplainPermissionRequest) Reset()         { *m = QueryExplainPermissionRequest{} }
+func (m *QueryExplainPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainPermissionRequest) ProtoMessage()    {}
+func (*QueryExplainPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{25}
+}
+func (m *QueryExplainPermissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExplainPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExplainPermissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExplainPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainPermissionRequest.Merge(m, src)
+}
+func (m *QueryExplainPermissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExplainPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainPermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainPermissionRequest proto.InternalMessageInfo
+
+func (m *QueryExplainPermissionRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *QueryExplainPermissionRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *QueryExplainPermissionRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *QueryExplainPermissionRequest) GetActionType() types1.ActionType {
+	if m != nil {
+		return m.ActionType
+	}
+	return types1.ACTION_UNSPECIFIED
+}
+
+type QueryExplainPermissionResponse struct {
+	// effect is the final effect, the same as the one returned by VerifyPermission
+	Effect types1.Effect `protobuf:"varint,1,opt,name=effect,proto3,enum=greenfield.permission.Effect" json:"effect,omitempty"`
+	// trace lists the steps consulted during the verification in order
+	Trace []*PermissionTraceStep `protobuf:"bytes,2,rep,name=trace,proto3" json:"trace,omitempty"`
+}
+
+func (m *QueryExplainPermissionResponse) Reset()         { *m = QueryExplainPermissionResponse{} }
+func (m *QueryExplainPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainPermissionResponse) ProtoMessage()    {}
+func (*QueryExplainPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{26}
+}
+func (m *QueryExplainPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExplainPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExplainPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExplainPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainPermissionResponse.Merge(m, src)
+}
+func (m *QueryExplainPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExplainPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainPermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainPermissionResponse proto.InternalMessageInfo
+
+func (m *QueryExplainPermissionResponse) GetEffect() types1.Effect {
+	if m != nil {
+		return m.Effect
+	}
+	return types1.EFFECT_UNSPECIFIED
+}
+
+func (m *QueryExplainPermissionResponse) GetTrace() []*PermissionTraceStep {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
+// PermissionTraceStep is a step of the permission verification trace.
+type PermissionTraceStep struct {
+	Type PermissionTraceStepType `protobuf:"varint,1,opt,name=type,proto3,enum=greenfield.storage.PermissionTraceStepType" json:"type,omitempty"`
+	// resource_type and resource_id define the resource whose policies are consulted
+	ResourceType resource.ResourceType `protobuf:"varint,2,opt,name=resource_type,json=resourceType,proto3,enum=greenfield.resource.ResourceType" json:"resource_type,omitempty"`
+	ResourceId   Uint                  `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3,customtype=Uint" json:"resource_id"`
+	// policy_id is the id of the consulted policy, zero if no policy is involved
+	PolicyId Uint `protobuf:"bytes,4,opt,name=policy_id,json=policyId,proto3,customtype=Uint" json:"policy_id"`
+	// group_id is the id of the group the policy granted to, zero if it is not a group policy
+	GroupId Uint `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3,customtype=Uint" json:"group_id"`
+	// statement_index is the index of the statement in the policy, -1 if no statement is involved
+	StatementIndex int32 `protobuf:"varint,6,opt,name=statement_index,json=statementIndex,proto3" json:"statement_index,omitempty"`
+	// effect is the effect produced by this step
+	Effect types1.Effect `protobuf:"varint,7,opt,name=effect,proto3,enum=greenfield.permission.Effect" json:"effect,omitempty"`
+}
+
+func (m *PermissionTraceStep) Reset()         { *m = PermissionTraceStep{} }
+func (m *PermissionTraceStep) String() string { return proto.CompactTextString(m) }
+func (*PermissionTraceStep) ProtoMessage()    {}
+func (*PermissionTraceStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{27}
+}
+func (m *PermissionTraceStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionTraceStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionTraceStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionTraceStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionTraceStep.Merge(m, src)
+}
+func (m *PermissionTraceStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionTraceStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionTraceStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionTraceStep proto.InternalMessageInfo
+
+func (m *PermissionTraceStep) GetType() PermissionTraceStepType {
+	if m != nil {
+		return m.Type
+	}
+	return TRACE_STEP_UNSPECIFIED
+}
+
+func (m *PermissionTraceStep) GetResourceType() resource.ResourceType {
+	if m != nil {
+		return m.ResourceType
+	}
+	return resource.RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (m *PermissionTraceStep) GetStatementIndex() int32 {
+	if m != nil {
+		return m.StatementIndex
+	}
+	return 0
+}
+
+func (m *PermissionTraceStep) GetEffect() types1.Effect {
+	if m != nil {
+		return m.Effect
+	}
+	return types1.EFFECT_UNSPECIFIED
+}
+
 type QueryHeadGroupRequest struct {
 	GroupOwner string `protobuf:"bytes,1,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
 	GroupName  string `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
@@ -1238,7 +1542,7 @@ func (m *QueryHeadGroupRequest) Reset()         { *m = QueryHeadGroupRequest{} }
 func (m *QueryHeadGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupRequest) ProtoMessage()    {}
 func (*QueryHeadGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{25}
+	return fileDescriptor_b1b80b580af04cb0, []int{28}
 }
 func (m *QueryHeadGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1289,7 +1593,7 @@ func (m *QueryHeadGroupResponse) Reset()         { *m = QueryHeadGroupResponse{}
 func (m *QueryHeadGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupResponse) ProtoMessage()    {}
 func (*QueryHeadGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{26}
+	return fileDescriptor_b1b80b580af04cb0, []int{29}
 }
 func (m *QueryHeadGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,7 +1638,7 @@ func (m *QueryListGroupsRequest) Reset()         { *m = QueryListGroupsRequest{}
 func (m *QueryListGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListGroupsRequest) ProtoMessage()    {}
 func (*QueryListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{27}
+	return fileDescriptor_b1b80b580af04cb0, []int{30}
 }
 func (m *QueryListGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1386,7 +1690,7 @@ func (m *QueryListGroupsResponse) Reset()         { *m = QueryListGroupsResponse
 func (m *QueryListGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListGroupsResponse) ProtoMessage()    {}
 func (*QueryListGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{28}
+	return fileDescriptor_b1b80b580af04cb0, []int{31}
 }
 func (m *QueryListGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1743,7 @@ func (m *QueryHeadGroupMemberRequest) Reset()         { *m = QueryHeadGroupMembe
 func (m *QueryHeadGroupMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupMemberRequest) ProtoMessage()    {}
 func (*QueryHeadGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{29}
+	return fileDescriptor_b1b80b580af04cb0, []int{32}
 }
 func (m *QueryHeadGroupMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1497,7 +1801,7 @@ func (m *QueryHeadGroupMemberResponse) Reset()         { *m = QueryHeadGroupMemb
 func (m *QueryHeadGroupMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupMemberResponse) ProtoMessage()    {}
 func (*QueryHeadGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{30}
+	return fileDescriptor_b1b80b580af04cb0, []int{33}
 }
 func (m *QueryHeadGroupMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,7 +1846,7 @@ func (m *QueryPolicyForGroupRequest) Reset()         { *m = QueryPolicyForGroupR
 func (m *QueryPolicyForGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForGroupRequest) ProtoMessage()    {}
 func (*QueryPolicyForGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{31}
+	return fileDescriptor_b1b80b580af04cb0, []int{34}
 }
 func (m *QueryPolicyForGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1897,7 @@ func (m *QueryPolicyForGroupResponse) Reset()         { *m = QueryPolicyForGroup
 func (m *QueryPolicyForGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForGroupResponse) ProtoMessage()    {}
 func (*QueryPolicyForGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{32}
+	return fileDescriptor_b1b80b580af04cb0, []int{35}
 }
 func (m *QueryPolicyForGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1637,7 +1941,7 @@ func (m *QueryPolicyByIdRequest) Reset()         { *m = QueryPolicyByIdRequest{}
 func (m *QueryPolicyByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyByIdRequest) ProtoMessage()    {}
 func (*QueryPolicyByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{33}
+	return fileDescriptor_b1b80b580af04cb0, []int{36}
 }
 func (m *QueryPolicyByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +1985,7 @@ func (m *QueryPolicyByIdResponse) Reset()         { *m = QueryPolicyByIdResponse
 func (m *QueryPolicyByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyByIdResponse) ProtoMessage()    {}
 func (*QueryPolicyByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{34}
+	return fileDescriptor_b1b80b580af04cb0, []int{37}
 }
 func (m *QueryPolicyByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +2034,7 @@ func (m *QueryLockFeeRequest) Reset()         { *m = QueryLockFeeRequest{} }
 func (m *QueryLockFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockFeeRequest) ProtoMessage()    {}
 func (*QueryLockFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{35}
+	return fileDescriptor_b1b80b580af04cb0, []int{38}
 }
 func (m *QueryLockFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1788,7 +2092,7 @@ func (m *QueryLockFeeResponse) Reset()         { *m = QueryLockFeeResponse{} }
 func (m *QueryLockFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLockFeeResponse) ProtoMessage()    {}
 func (*QueryLockFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{36}
+	return fileDescriptor_b1b80b580af04cb0, []int{39}
 }
 func (m *QueryLockFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +2129,7 @@ func (m *QueryHeadBucketExtraRequest) Reset()         { *m = QueryHeadBucketExtr
 func (m *QueryHeadBucketExtraRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraRequest) ProtoMessage()    {}
 func (*QueryHeadBucketExtraRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{37}
+	return fileDescriptor_b1b80b580af04cb0, []int{40}
 }
 func (m *QueryHeadBucketExtraRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +2173,7 @@ func (m *QueryHeadBucketExtraResponse) Reset()         { *m = QueryHeadBucketExt
 func (m *QueryHeadBucketExtraResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraResponse) ProtoMessage()    {}
 func (*QueryHeadBucketExtraResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{38}
+	return fileDescriptor_b1b80b580af04cb0, []int{41}
 }
 func (m *QueryHeadBucketExtraResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1913,7 +2217,7 @@ func (m *QueryIsPriceChangedRequest) Reset()         { *m = QueryIsPriceChangedR
 func (m *QueryIsPriceChangedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedRequest) ProtoMessage()    {}
 func (*QueryIsPriceChangedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{39}
+	return fileDescriptor_b1b80b580af04cb0, []int{42}
 }
 func (m *QueryIsPriceChangedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1965,7 +2269,7 @@ func (m *QueryIsPriceChangedResponse) Reset()         { *m = QueryIsPriceChanged
 func (m *QueryIsPriceChangedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedResponse) ProtoMessage()    {}
 func (*QueryIsPriceChangedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{40}
+	return fileDescriptor_b1b80b580af04cb0, []int{43}
 }
 func (m *QueryIsPriceChangedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2009,7 +2313,7 @@ func (m *QueryQuoteUpdateTimeRequest) Reset()         { *m = QueryQuoteUpdateTim
 func (m *QueryQuoteUpdateTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeRequest) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{41}
+	return fileDescriptor_b1b80b580af04cb0, []int{44}
 }
 func (m *QueryQuoteUpdateTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2053,7 +2357,7 @@ func (m *QueryQuoteUpdateTimeResponse) Reset()         { *m = QueryQuoteUpdateTi
 func (m *QueryQuoteUpdateTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeResponse) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{42}
+	return fileDescriptor_b1b80b580af04cb0, []int{45}
 }
 func (m *QueryQuoteUpdateTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2402,7 @@ func (m *QueryGroupMembersExistRequest) Reset()         { *m = QueryGroupMembers
 func (m *QueryGroupMembersExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistRequest) ProtoMessage()    {}
 func (*QueryGroupMembersExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{43}
+	return fileDescriptor_b1b80b580af04cb0, []int{46}
 }
 func (m *QueryGroupMembersExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2149,7 +2453,7 @@ func (m *QueryGroupMembersExistResponse) Reset()         { *m = QueryGroupMember
 func (m *QueryGroupMembersExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistResponse) ProtoMessage()    {}
 func (*QueryGroupMembersExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{44}
+	return fileDescriptor_b1b80b580af04cb0, []int{47}
 }
 func (m *QueryGroupMembersExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2194,7 +2498,7 @@ func (m *QueryGroupsExistRequest) Reset()         { *m = QueryGroupsExistRequest
 func (m *QueryGroupsExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistRequest) ProtoMessage()    {}
 func (*QueryGroupsExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{45}
+	return fileDescriptor_b1b80b580af04cb0, []int{48}
 }
 func (m *QueryGroupsExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2245,7 +2549,7 @@ func (m *QueryGroupsExistByIdRequest) Reset()         { *m = QueryGroupsExistByI
 func (m *QueryGroupsExistByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistByIdRequest) ProtoMessage()    {}
 func (*QueryGroupsExistByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{46}
+	return fileDescriptor_b1b80b580af04cb0, []int{49}
 }
 func (m *QueryGroupsExistByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2593,7 @@ func (m *QueryGroupsExistResponse) Reset()         { *m = QueryGroupsExistRespon
 func (m *QueryGroupsExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistResponse) ProtoMessage()    {}
 func (*QueryGroupsExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{47}
+	return fileDescriptor_b1b80b580af04cb0, []int{50}
 }
 func (m *QueryGroupsExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2339,7 +2643,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) String() string {
 }
 func (*QueryPaymentAccountBucketFlowRateLimitRequest) ProtoMessage() {}
 func (*QueryPaymentAccountBucketFlowRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{48}
+	return fileDescriptor_b1b80b580af04cb0, []int{51}
 }
 func (m *QueryPaymentAccountBucketFlowRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2402,7 +2706,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) String() string {
 }
 func (*QueryPaymentAccountBucketFlowRateLimitResponse) ProtoMessage() {}
 func (*QueryPaymentAccountBucketFlowRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{49}
+	return fileDescriptor_b1b80b580af04cb0, []int{52}
 }
 func (m *QueryPaymentAccountBucketFlowRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2438,2266 +2742,2525 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) GetIsSet() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.storage.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.storage.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.storage.QueryParamsByTimestampRequest")
-	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.storage.QueryParamsByTimestampResponse")
-	proto.RegisterType((*QueryHeadBucketRequest)(nil), "greenfield.storage.QueryHeadBucketRequest")
-	proto.RegisterType((*QueryHeadBucketByIdRequest)(nil), "greenfield.storage.QueryHeadBucketByIdRequest")
-	proto.RegisterType((*QueryHeadBucketResponse)(nil), "greenfield.storage.QueryHeadBucketResponse")
-	proto.RegisterType((*QueryHeadObjectRequest)(nil), "greenfield.storage.QueryHeadObjectRequest")
-	proto.RegisterType((*QueryHeadObjectByIdRequest)(nil), "greenfield.storage.QueryHeadObjectByIdRequest")
-	proto.RegisterType((*QueryHeadShadowObjectRequest)(nil), "greenfield.storage.QueryHeadShadowObjectRequest")
-	proto.RegisterType((*QueryHeadObjectResponse)(nil), "greenfield.storage.QueryHeadObjectResponse")
-	proto.RegisterType((*QueryHeadShadowObjectResponse)(nil), "greenfield.storage.QueryHeadShadowObjectResponse")
-	proto.RegisterType((*QueryListBucketsRequest)(nil), "greenfield.storage.QueryListBucketsRequest")
-	proto.RegisterType((*QueryListBucketsResponse)(nil), "greenfield.storage.QueryListBucketsResponse")
-	proto.RegisterType((*QueryListObjectsRequest)(nil), "greenfield.storage.QueryListObjectsRequest")
-	proto.RegisterType((*QueryListObjectsByBucketIdRequest)(nil), "greenfield.storage.QueryListObjectsByBucketIdRequest")
-	proto.RegisterType((*QueryListObjectsResponse)(nil), "greenfield.storage.QueryListObjectsResponse")
-	proto.RegisterType((*QueryNFTRequest)(nil), "greenfield.storage.QueryNFTRequest")
-	proto.RegisterType((*QueryBucketNFTResponse)(nil), "greenfield.storage.QueryBucketNFTResponse")
-	proto.RegisterType((*QueryObjectNFTResponse)(nil), "greenfield.storage.QueryObjectNFTResponse")
-	proto.RegisterType((*QueryGroupNFTResponse)(nil), "greenfield.storage.QueryGroupNFTResponse")
-	proto.RegisterType((*QueryPolicyForAccountRequest)(nil), "greenfield.storage.QueryPolicyForAccountRequest")
-	proto.RegisterType((*QueryPolicyForAccountResponse)(nil), "greenfield.storage.QueryPolicyForAccountResponse")
-	proto.RegisterType((*QueryVerifyPermissionRequest)(nil), "greenfield.storage.QueryVerifyPermissionRequest")
-	proto.RegisterType((*QueryVerifyPermissionResponse)(nil), "greenfield.storage.QueryVerifyPermissionResponse")
-	proto.RegisterType((*QueryHeadGroupRequest)(nil), "greenfield.storage.QueryHeadGroupRequest")
-	proto.RegisterType((*QueryHeadGroupResponse)(nil), "greenfield.storage.QueryHeadGroupResponse")
-	proto.RegisterType((*QueryListGroupsRequest)(nil), "greenfield.storage.QueryListGroupsRequest")
-	proto.RegisterType((*QueryListGroupsResponse)(nil), "greenfield.storage.QueryListGroupsResponse")
-	proto.RegisterType((*QueryHeadGroupMemberRequest)(nil), "greenfield.storage.QueryHeadGroupMemberRequest")
-	proto.RegisterType((*QueryHeadGroupMemberResponse)(nil), "greenfield.storage.QueryHeadGroupMemberResponse")
-	proto.RegisterType((*QueryPolicyForGroupRequest)(nil), "greenfield.storage.QueryPolicyForGroupRequest")
-	proto.RegisterType((*QueryPolicyForGroupResponse)(nil), "greenfield.storage.QueryPolicyForGroupResponse")
-	proto.RegisterType((*QueryPolicyByIdRequest)(nil), "greenfield.storage.QueryPolicyByIdRequest")
-	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "greenfield.storage.QueryPolicyByIdResponse")
-	proto.RegisterType((*QueryLockFeeRequest)(nil), "greenfield.storage.QueryLockFeeRequest")
-	proto.RegisterType((*QueryLockFeeResponse)(nil), "greenfield.storage.QueryLockFeeResponse")
-	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "greenfield.storage.QueryHeadBucketExtraRequest")
-	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "greenfield.storage.QueryHeadBucketExtraResponse")
-	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "greenfield.storage.QueryIsPriceChangedRequest")
-	proto.RegisterType((*QueryIsPriceChangedResponse)(nil), "greenfield.storage.QueryIsPriceChangedResponse")
-	proto.RegisterType((*QueryQuoteUpdateTimeRequest)(nil), "greenfield.storage.QueryQuoteUpdateTimeRequest")
-	proto.RegisterType((*QueryQuoteUpdateTimeResponse)(nil), "greenfield.storage.QueryQuoteUpdateTimeResponse")
-	proto.RegisterType((*QueryGroupMembersExistRequest)(nil), "greenfield.storage.QueryGroupMembersExistRequest")
-	proto.RegisterType((*QueryGroupMembersExistResponse)(nil), "greenfield.storage.QueryGroupMembersExistResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupMembersExistResponse.ExistsEntry")
-	proto.RegisterType((*QueryGroupsExistRequest)(nil), "greenfield.storage.QueryGroupsExistRequest")
-	proto.RegisterType((*QueryGroupsExistByIdRequest)(nil), "greenfield.storage.QueryGroupsExistByIdRequest")
-	proto.RegisterType((*QueryGroupsExistResponse)(nil), "greenfield.storage.QueryGroupsExistResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupsExistResponse.ExistsEntry")
-	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitRequest)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitRequest")
-	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitResponse)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitResponse")
+type QueryListObjectVersionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	BucketName string             `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string             `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/storage/query.proto", fileDescriptor_b1b80b580af04cb0) }
-
-var fileDescriptor_b1b80b580af04cb0 = []byte{
-	// 2854 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdb, 0x6f, 0x1c, 0x49,
-	0xd5, 0x4f, 0xdb, 0x89, 0x63, 0x97, 0xbd, 0x89, 0xbf, 0x5a, 0xef, 0xae, 0x77, 0x92, 0x38, 0x9b,
-	0xde, 0xfd, 0xbc, 0xd9, 0x24, 0x9e, 0xce, 0x15, 0xc5, 0xb9, 0x21, 0x7b, 0x6d, 0x07, 0xa3, 0x5c,
-	0x9c, 0xb1, 0x31, 0x22, 0x02, 0x35, 0x35, 0xd3, 0x35, 0x93, 0x5e, 0xcf, 0x74, 0x4f, 0xba, 0x7b,
-	0xe2, 0xcc, 0x5a, 0x23, 0xc4, 0xbe, 0xc0, 0x23, 0x62, 0x85, 0x84, 0xc4, 0x45, 0x08, 0xc4, 0x55,
-	0x42, 0x08, 0x76, 0x85, 0xc4, 0x13, 0x0f, 0x80, 0xb4, 0x12, 0x42, 0x0a, 0xcb, 0x0b, 0xda, 0x87,
-	0x15, 0x24, 0xfc, 0x21, 0xa8, 0xab, 0x4e, 0x75, 0x57, 0x5f, 0xa6, 0xbb, 0x1d, 0x0f, 0x4f, 0x9e,
-	0xa9, 0xa9, 0x73, 0xce, 0xef, 0x5c, 0xea, 0xd4, 0xa9, 0x73, 0x8c, 0x66, 0x1a, 0x0e, 0xa5, 0x56,
-	0xdd, 0xa4, 0x4d, 0x43, 0x73, 0x3d, 0xdb, 0x21, 0x0d, 0xaa, 0x3d, 0xec, 0x50, 0xa7, 0x5b, 0x6e,
-	0x3b, 0xb6, 0x67, 0x63, 0x1c, 0xfe, 0x5e, 0x86, 0xdf, 0x4b, 0xa7, 0x6a, 0xb6, 0xdb, 0xb2, 0x5d,
-	0xad, 0x4a, 0x5c, 0xd8, 0xac, 0x3d, 0x3a, 0x57, 0xa5, 0x1e, 0x39, 0xa7, 0xb5, 0x49, 0xc3, 0xb4,
-	0x88, 0x67, 0xda, 0x16, 0xa7, 0x2f, 0xbd, 0xca, 0xf7, 0xea, 0xec, 0x9b, 0xc6, 0xbf, 0xc0, 0x4f,
-	0x53, 0x0d, 0xbb, 0x61, 0xf3, 0x75, 0xff, 0x13, 0xac, 0x1e, 0x6d, 0xd8, 0x76, 0xa3, 0x49, 0x35,
-	0xd2, 0x36, 0x35, 0x62, 0x59, 0xb6, 0xc7, 0xb8, 0x09, 0x1a, 0x55, 0x82, 0xdb, 0xa6, 0x4e, 0xcb,
-	0x74, 0x5d, 0xd3, 0xb6, 0xb4, 0x9a, 0xdd, 0x6a, 0x05, 0x22, 0x4f, 0xa4, 0xef, 0xf1, 0xba, 0x6d,
-	0x2a, 0xd8, 0x1c, 0x4f, 0xd1, 0xba, 0x4d, 0x1c, 0xd2, 0x12, 0x1b, 0xd2, 0xcc, 0x22, 0x33, 0x78,
-	0x5d, 0xfa, 0xfd, 0x91, 0xe9, 0x78, 0x1d, 0xd2, 0x6c, 0x38, 0x76, 0xa7, 0x2d, 0x6f, 0x52, 0xa7,
-	0x10, 0xbe, 0xe7, 0x5b, 0x67, 0x8d, 0x71, 0xae, 0xd0, 0x87, 0x1d, 0xea, 0x7a, 0xea, 0x5d, 0xf4,
-	0x62, 0x64, 0xd5, 0x6d, 0xdb, 0x96, 0x4b, 0xf1, 0x65, 0x34, 0xc2, 0x11, 0x4c, 0x2b, 0xaf, 0x29,
-	0x27, 0xc7, 0xcf, 0x97, 0xca, 0x49, 0xcb, 0x97, 0x39, 0xcd, 0xe2, 0xfe, 0x8f, 0x3e, 0x3d, 0xbe,
-	0xaf, 0x02, 0xfb, 0xd5, 0xeb, 0xe8, 0x98, 0xc4, 0x70, 0xb1, 0xbb, 0x61, 0xb6, 0xa8, 0xeb, 0x91,
-	0x56, 0x1b, 0x24, 0xe2, 0xa3, 0x68, 0xcc, 0x13, 0x6b, 0x8c, 0xfb, 0x70, 0x25, 0x5c, 0x50, 0xef,
-	0xa3, 0x99, 0x7e, 0xe4, 0x7b, 0x86, 0x36, 0x8f, 0x5e, 0x66, 0xbc, 0x3f, 0x47, 0x89, 0xb1, 0xd8,
-	0xa9, 0x6d, 0x51, 0x4f, 0x60, 0x3a, 0x8e, 0xc6, 0xab, 0x6c, 0x41, 0xb7, 0x48, 0x8b, 0x32, 0xc6,
-	0x63, 0x15, 0xc4, 0x97, 0xee, 0x90, 0x16, 0x55, 0xe7, 0x51, 0x29, 0x46, 0xba, 0xd8, 0x5d, 0x35,
-	0x04, 0xf9, 0x11, 0x34, 0x06, 0xe4, 0xa6, 0x01, 0xc4, 0xa3, 0x7c, 0x61, 0xd5, 0x50, 0x7f, 0xa8,
-	0xa0, 0x57, 0x12, 0x62, 0x41, 0x97, 0xcf, 0x06, 0x72, 0x4d, 0xab, 0x6e, 0x83, 0x42, 0x33, 0x69,
-	0x0a, 0x71, 0xc2, 0x55, 0xab, 0x6e, 0x0b, 0x5c, 0xfe, 0x67, 0xbc, 0x88, 0x10, 0x7d, 0xec, 0x39,
-	0x84, 0xd3, 0x0f, 0x31, 0xfa, 0xd7, 0xfb, 0xd3, 0x2f, 0xfb, 0x7b, 0x19, 0x93, 0x31, 0x2a, 0x3e,
-	0xaa, 0xf7, 0x25, 0xb3, 0xdc, 0xad, 0xbe, 0x43, 0x6b, 0x85, 0xcd, 0xe2, 0x6f, 0xb0, 0x19, 0x05,
-	0xdf, 0x30, 0xc4, 0x37, 0xf0, 0xa5, 0x84, 0xdd, 0x38, 0xef, 0x98, 0xdd, 0x80, 0x3c, 0xb4, 0x1b,
-	0x5f, 0x58, 0x35, 0xd4, 0xaf, 0xa2, 0xa3, 0x01, 0xe9, 0xfa, 0x03, 0x62, 0xd8, 0xdb, 0x83, 0x06,
-	0xf7, 0x07, 0xd9, 0x33, 0x82, 0x79, 0xe8, 0x19, 0x01, 0x2d, 0xc7, 0x33, 0x9c, 0x90, 0x7b, 0xc6,
-	0x0e, 0x3e, 0xe3, 0xaf, 0xa0, 0xa9, 0x46, 0xd3, 0xae, 0x92, 0xa6, 0x0e, 0x27, 0x52, 0x67, 0x47,
-	0x12, 0x7c, 0x74, 0x5a, 0xe6, 0x24, 0x1f, 0xd9, 0xf2, 0x4d, 0x46, 0xb4, 0xc9, 0x97, 0x6e, 0xfa,
-	0x4b, 0x15, 0xdc, 0x48, 0xac, 0xa9, 0x75, 0x38, 0x66, 0x49, 0xeb, 0x80, 0x02, 0xcb, 0x69, 0x0a,
-	0xbc, 0x91, 0xa6, 0x80, 0x4c, 0x1e, 0x57, 0x43, 0x25, 0x60, 0xa2, 0x5b, 0xa6, 0xeb, 0xf1, 0x18,
-	0x12, 0xa9, 0x03, 0xaf, 0x20, 0x14, 0x26, 0x58, 0x10, 0x30, 0x5b, 0x86, 0xa4, 0xea, 0x67, 0xe3,
-	0x32, 0x4f, 0xdd, 0x90, 0x8d, 0xcb, 0x6b, 0xa4, 0x41, 0x81, 0xb6, 0x22, 0x51, 0xaa, 0x3f, 0x53,
-	0xd0, 0x74, 0x52, 0x06, 0xa8, 0xb1, 0x80, 0x26, 0xa4, 0x13, 0xe2, 0x9f, 0xf9, 0xe1, 0x02, 0x47,
-	0x64, 0x3c, 0x3c, 0x22, 0x2e, 0xbe, 0x19, 0xc1, 0xc9, 0xed, 0xff, 0x66, 0x2e, 0x4e, 0x2e, 0x3f,
-	0x02, 0xf4, 0x3d, 0x45, 0x32, 0x06, 0xb7, 0xd7, 0xa0, 0x8d, 0x11, 0x8f, 0xea, 0xa1, 0x44, 0x26,
-	0xfa, 0xa6, 0x82, 0x4e, 0xc4, 0x41, 0x2c, 0x76, 0x41, 0x77, 0x63, 0xd0, 0x70, 0x22, 0x99, 0x6d,
-	0x28, 0x96, 0xd9, 0x22, 0x8e, 0x0b, 0xec, 0x11, 0x3a, 0x4e, 0x8a, 0xbf, 0x4c, 0xc7, 0x49, 0xa1,
-	0x37, 0x1e, 0x86, 0xde, 0x00, 0x1d, 0x77, 0x06, 0x1d, 0x66, 0x38, 0xef, 0xac, 0x6c, 0x08, 0x03,
-	0xbd, 0x8a, 0x46, 0x3d, 0x7b, 0x8b, 0x5a, 0x61, 0xe6, 0x39, 0xc8, 0xbe, 0xaf, 0x1a, 0xea, 0x97,
-	0x20, 0x1f, 0x72, 0x9b, 0x32, 0x9a, 0x20, 0x29, 0x8c, 0xb5, 0xa8, 0x47, 0x74, 0x83, 0x78, 0x04,
-	0x8c, 0xaa, 0xf6, 0x8f, 0xc4, 0xdb, 0xd4, 0x23, 0x4b, 0xc4, 0x23, 0x95, 0xd1, 0x16, 0x7c, 0x0a,
-	0x58, 0x73, 0x8d, 0x9f, 0x87, 0x35, 0xa7, 0x4c, 0x61, 0xfd, 0x45, 0xf4, 0x12, 0x63, 0xcd, 0xd2,
-	0x83, 0xcc, 0xf9, 0x46, 0x92, 0xf3, 0x89, 0x34, 0xce, 0x8c, 0x30, 0x85, 0xf1, 0xd7, 0x15, 0x48,
-	0xc4, 0x6b, 0x76, 0xd3, 0xac, 0x75, 0x57, 0x6c, 0x67, 0xa1, 0x56, 0xb3, 0x3b, 0x56, 0x90, 0x88,
-	0x4b, 0x68, 0xd4, 0xa1, 0xae, 0xdd, 0x71, 0x6a, 0x22, 0x0b, 0x07, 0xdf, 0xf1, 0x32, 0xfa, 0xbf,
-	0xb6, 0x63, 0x5a, 0x35, 0xb3, 0x4d, 0x9a, 0x3a, 0x31, 0x0c, 0x87, 0xba, 0x2e, 0x8f, 0xa3, 0xc5,
-	0xe9, 0x8f, 0x3f, 0x9c, 0x9b, 0x02, 0x67, 0x2e, 0xf0, 0x5f, 0xd6, 0x3d, 0xc7, 0xb4, 0x1a, 0x95,
-	0xc9, 0x80, 0x04, 0xd6, 0xd5, 0x4d, 0x51, 0x54, 0x24, 0x20, 0x80, 0x92, 0x97, 0xd0, 0x48, 0x9b,
-	0xfd, 0x06, 0x1a, 0x1e, 0x93, 0x35, 0x0c, 0xcb, 0xae, 0x32, 0x67, 0x50, 0x81, 0xcd, 0xea, 0x27,
-	0x42, 0xb7, 0x4d, 0xea, 0x98, 0xf5, 0xee, 0x5a, 0xb0, 0x51, 0xe8, 0x76, 0x11, 0x8d, 0xda, 0x6d,
-	0xea, 0x10, 0xcf, 0x76, 0xb8, 0x6e, 0x19, 0xb0, 0x83, 0x9d, 0xb9, 0x87, 0x38, 0x7e, 0x35, 0x0d,
-	0xc7, 0xaf, 0x26, 0xbc, 0x88, 0xc6, 0x49, 0xcd, 0x8f, 0x5d, 0xdd, 0x2f, 0xe1, 0xa6, 0xf7, 0xbf,
-	0xa6, 0x9c, 0x3c, 0x14, 0x75, 0x9b, 0xa4, 0xd4, 0x02, 0xdb, 0xb9, 0xd1, 0x6d, 0xd3, 0x0a, 0x22,
-	0xc1, 0xe7, 0xc0, 0x68, 0x49, 0xdd, 0x42, 0xa3, 0xd1, 0x7a, 0x9d, 0xd6, 0x3c, 0xa6, 0xda, 0xa1,
-	0xbe, 0x46, 0x5b, 0x66, 0x9b, 0x2a, 0xb0, 0x59, 0x7d, 0x08, 0x91, 0xe6, 0x5f, 0x3d, 0xfc, 0x82,
-	0x02, 0x63, 0xcd, 0xa3, 0x71, 0x76, 0x87, 0xe9, 0xf6, 0xb6, 0x45, 0xf3, 0xed, 0x85, 0xd8, 0xe6,
-	0xbb, 0xfe, 0x5e, 0x7c, 0x0c, 0xf1, 0x6f, 0xb2, 0xc1, 0xc6, 0xd8, 0x0a, 0x4b, 0x7a, 0x9b, 0x52,
-	0x89, 0x02, 0x22, 0x41, 0x87, 0x6b, 0x82, 0x50, 0xba, 0xe5, 0x8e, 0xf5, 0x0d, 0x6f, 0x5e, 0xfa,
-	0x34, 0xc4, 0x47, 0xf5, 0x7b, 0x0a, 0x30, 0xf6, 0x33, 0x18, 0xdb, 0x31, 0xf0, 0x84, 0x1e, 0x33,
-	0xca, 0x50, 0x71, 0xa3, 0xa8, 0x3f, 0x96, 0xef, 0x1b, 0x81, 0x0e, 0xf4, 0xbe, 0x99, 0x02, 0xef,
-	0x79, 0x72, 0x23, 0xbe, 0x21, 0xf0, 0xf1, 0x34, 0x3d, 0xc4, 0xd2, 0x74, 0x8e, 0x05, 0x51, 0x60,
-	0x41, 0x57, 0xfd, 0xa5, 0x82, 0x8e, 0x44, 0x7d, 0x73, 0x9b, 0xb6, 0xaa, 0xd4, 0x11, 0x76, 0x3c,
-	0x8b, 0x46, 0x5a, 0x6c, 0x21, 0x37, 0x1e, 0x60, 0xdf, 0x1e, 0x2c, 0x16, 0x0b, 0xa3, 0xe1, 0x78,
-	0x18, 0x51, 0xa9, 0xa4, 0x8c, 0x40, 0x0d, 0x6a, 0xa6, 0x09, 0x4e, 0x2e, 0x21, 0x8e, 0xe5, 0x61,
-	0xe9, 0x58, 0xc8, 0x1c, 0x38, 0x62, 0xfe, 0x45, 0xad, 0x43, 0xd1, 0x1b, 0x64, 0xab, 0xc8, 0x29,
-	0xc9, 0x4a, 0x97, 0x67, 0x10, 0x0e, 0xd3, 0x25, 0xb8, 0x45, 0xdc, 0xbb, 0x61, 0x56, 0xe4, 0x8e,
-	0x30, 0xd4, 0x0d, 0xb0, 0x7c, 0x5c, 0xce, 0xde, 0x72, 0xe2, 0x25, 0x38, 0x12, 0x7c, 0x39, 0x56,
-	0xae, 0xf3, 0x3d, 0x52, 0xb9, 0xce, 0x17, 0x56, 0x0d, 0x75, 0x0d, 0x62, 0x55, 0x26, 0xdb, 0x1b,
-	0x90, 0x1f, 0x28, 0xf0, 0x36, 0xbd, 0x65, 0xd7, 0xb6, 0x56, 0x28, 0x0d, 0x4f, 0xa6, 0x6f, 0xa4,
-	0x16, 0x71, 0xba, 0xba, 0xdb, 0x0e, 0x2e, 0x15, 0xa5, 0xc0, 0xa5, 0xe2, 0xd3, 0xac, 0xb7, 0x61,
-	0xdd, 0x57, 0xa7, 0xe6, 0x50, 0xe2, 0x51, 0x9d, 0x78, 0xcc, 0xc6, 0xc3, 0x95, 0x51, 0xbe, 0xb0,
-	0xe0, 0xe1, 0x13, 0x68, 0xa2, 0x4d, 0xba, 0x4d, 0x9b, 0x18, 0xba, 0x6b, 0xbe, 0xcb, 0x63, 0x69,
-	0x7f, 0x65, 0x1c, 0xd6, 0xd6, 0xcd, 0x77, 0xa9, 0xda, 0x44, 0x53, 0x51, 0x78, 0xa0, 0xee, 0x06,
-	0x1a, 0x21, 0x2d, 0xff, 0x76, 0x02, 0x4c, 0xd7, 0xfc, 0x47, 0xe8, 0x27, 0x9f, 0x1e, 0x9f, 0x6d,
-	0x98, 0xde, 0x83, 0x4e, 0xb5, 0x5c, 0xb3, 0x5b, 0xd0, 0x7a, 0x80, 0x3f, 0x73, 0xae, 0xb1, 0x05,
-	0x4f, 0xf5, 0x55, 0xcb, 0xfb, 0xf8, 0xc3, 0x39, 0x04, 0x1a, 0xac, 0x5a, 0x5e, 0x05, 0x78, 0xa9,
-	0x37, 0xa4, 0x63, 0x26, 0x3d, 0xe6, 0x0a, 0xbf, 0x60, 0xe5, 0xd8, 0x8f, 0xd0, 0x07, 0xb1, 0x2f,
-	0xbf, 0x24, 0x45, 0xbe, 0x4b, 0x49, 0x03, 0xab, 0x96, 0x47, 0x1d, 0x8b, 0x34, 0xa5, 0x72, 0x5b,
-	0x7a, 0x4c, 0x5e, 0x87, 0xd8, 0x5f, 0x75, 0xd7, 0x1c, 0xb3, 0x46, 0xdf, 0x7e, 0x40, 0xac, 0x06,
-	0x35, 0x0a, 0xa3, 0xfc, 0xf7, 0x41, 0x50, 0x33, 0x4e, 0x0f, 0x28, 0xa7, 0xd1, 0xc1, 0x1a, 0x5f,
-	0x62, 0xc4, 0xa3, 0x15, 0xf1, 0x15, 0xbf, 0x83, 0x70, 0xad, 0xe3, 0x38, 0xd4, 0xf2, 0x74, 0x87,
-	0x12, 0x43, 0x6f, 0xfb, 0xe4, 0x90, 0x3c, 0x76, 0xe3, 0x81, 0x25, 0x5a, 0x93, 0x3c, 0xb0, 0x44,
-	0x6b, 0x95, 0x49, 0xe0, 0x5b, 0xa1, 0xc4, 0x60, 0xa0, 0xf0, 0x0e, 0x3a, 0x22, 0x64, 0x05, 0x91,
-	0xe8, 0xd9, 0x0e, 0x05, 0xa1, 0xc3, 0x03, 0x10, 0x3a, 0x0d, 0x02, 0xd6, 0x20, 0x6a, 0x7d, 0xf6,
-	0x5c, 0xf8, 0xd7, 0xd0, 0x31, 0x21, 0xdc, 0xa5, 0x35, 0xdb, 0x32, 0xe2, 0xe2, 0xf7, 0x0f, 0x40,
-	0x7c, 0x09, 0x44, 0xac, 0x0b, 0x09, 0x12, 0x80, 0x2e, 0x12, 0xbf, 0xea, 0x8f, 0x48, 0xd3, 0x34,
-	0xfc, 0x92, 0x47, 0xf7, 0xc8, 0x63, 0xdd, 0x21, 0x1e, 0x9d, 0x3e, 0x30, 0x00, 0xe9, 0xaf, 0x00,
-	0xff, 0x4d, 0xc1, 0x7e, 0x83, 0x3c, 0xae, 0x10, 0x8f, 0xe2, 0x2a, 0x3a, 0x64, 0xd1, 0x6d, 0xd9,
-	0xc1, 0x23, 0x03, 0x10, 0x37, 0x61, 0xd1, 0xed, 0xd0, 0xb9, 0x2e, 0x7a, 0xc5, 0x97, 0x91, 0xe6,
-	0xd8, 0x83, 0x03, 0x10, 0x36, 0x65, 0xd1, 0xed, 0xa4, 0x53, 0xb7, 0xd1, 0xab, 0xbe, 0xd0, 0x74,
-	0x87, 0x8e, 0x0e, 0x40, 0xec, 0xcb, 0x16, 0xdd, 0x4e, 0x73, 0xe6, 0x43, 0xe4, 0xff, 0x92, 0xe6,
-	0xc8, 0xb1, 0x01, 0x48, 0x7d, 0xd1, 0xa2, 0xdb, 0x71, 0x27, 0x06, 0x99, 0xec, 0x5e, 0xc7, 0xf6,
-	0xe8, 0x17, 0xda, 0x06, 0xf1, 0xe8, 0x86, 0xd9, 0xa2, 0x85, 0x73, 0xc4, 0x55, 0xc8, 0x64, 0x09,
-	0x7a, 0xc8, 0x11, 0x47, 0xd0, 0x58, 0x87, 0xad, 0xfa, 0x79, 0x7d, 0x84, 0xe7, 0x75, 0xbe, 0xb0,
-	0xe0, 0xa9, 0x16, 0x14, 0xc5, 0xd2, 0xe5, 0xed, 0x2e, 0x3f, 0x36, 0x5d, 0x4f, 0x7a, 0x18, 0x06,
-	0x17, 0x2f, 0x3c, 0x0c, 0x79, 0xb5, 0x63, 0xe0, 0xf3, 0xe8, 0x20, 0x2f, 0x0c, 0x78, 0x99, 0x94,
-	0x75, 0xdb, 0x88, 0x8d, 0xea, 0x07, 0x0a, 0x34, 0x34, 0x53, 0x04, 0x02, 0xde, 0x4d, 0x34, 0x42,
-	0xfd, 0x05, 0xf1, 0x46, 0xbe, 0x91, 0x96, 0x75, 0xb3, 0x79, 0x94, 0xd9, 0x37, 0x77, 0xd9, 0xf2,
-	0x9c, 0x6e, 0x05, 0xb8, 0x95, 0xe6, 0xd1, 0xb8, 0xb4, 0x8c, 0x27, 0xd1, 0xf0, 0x16, 0xed, 0x82,
-	0x4e, 0xfe, 0x47, 0x3c, 0x85, 0x0e, 0x3c, 0x22, 0xcd, 0x0e, 0xcf, 0x92, 0xa3, 0x15, 0xfe, 0xe5,
-	0xca, 0xd0, 0x65, 0x45, 0xed, 0xc0, 0x65, 0xce, 0x8b, 0xce, 0x88, 0x7d, 0xf6, 0x50, 0xe4, 0x1f,
-	0x17, 0xa4, 0xbe, 0x63, 0xc1, 0x86, 0xb0, 0xc1, 0x77, 0xac, 0xab, 0x5e, 0x81, 0xc8, 0x90, 0xc4,
-	0xc6, 0xea, 0x0f, 0xe1, 0x1a, 0x6e, 0xab, 0xb1, 0xca, 0x28, 0xf8, 0xc6, 0x55, 0x7f, 0x2e, 0x9a,
-	0x11, 0x11, 0xcc, 0x60, 0xe2, 0xb5, 0x98, 0x89, 0x2f, 0x67, 0x9b, 0xf8, 0x7f, 0x6b, 0xdc, 0x27,
-	0x0a, 0x9a, 0x83, 0x1e, 0x77, 0xb7, 0x45, 0x2d, 0x0f, 0xde, 0xb2, 0xfc, 0x3e, 0x5d, 0x69, 0xda,
-	0xdb, 0xfe, 0x29, 0xb9, 0x65, 0xb6, 0xcc, 0xc0, 0xe6, 0x0b, 0xe8, 0x70, 0x9b, 0xef, 0xd5, 0x09,
-	0xdf, 0x9c, 0x6b, 0xf7, 0x43, 0xed, 0x08, 0x73, 0x7c, 0x35, 0xe8, 0xa3, 0x15, 0xab, 0xaa, 0xe1,
-	0x0c, 0x06, 0x8e, 0x93, 0x8f, 0xe4, 0x70, 0xe2, 0x48, 0xfe, 0x5a, 0x41, 0xe5, 0xa2, 0x2a, 0x81,
-	0x4b, 0x5e, 0x42, 0x23, 0xa6, 0xab, 0xbb, 0xd4, 0x83, 0x8b, 0xfc, 0x80, 0xe9, 0xae, 0x53, 0x0f,
-	0x1b, 0xe8, 0x70, 0xbd, 0x69, 0x6f, 0xb3, 0x14, 0xa4, 0x37, 0x7d, 0x8a, 0xe7, 0xb8, 0xc3, 0x93,
-	0x55, 0xd4, 0x0b, 0x75, 0x19, 0xc4, 0xf9, 0xbf, 0xcf, 0xa2, 0x03, 0x0c, 0x2f, 0xee, 0xa1, 0x11,
-	0x3e, 0x2b, 0xc0, 0xb3, 0x7d, 0x63, 0x22, 0x32, 0x31, 0x29, 0xbd, 0x99, 0xbb, 0x8f, 0x6b, 0xa8,
-	0xaa, 0xef, 0xfd, 0xe3, 0x3f, 0xef, 0x0f, 0x1d, 0xc5, 0x25, 0xad, 0xef, 0x7c, 0x07, 0xff, 0x46,
-	0x3c, 0x40, 0x13, 0xf3, 0x0e, 0x7c, 0x2e, 0x47, 0x4e, 0x72, 0xb4, 0x52, 0x3a, 0xbf, 0x1b, 0x12,
-	0x40, 0x59, 0x66, 0x28, 0x4f, 0xe2, 0xd9, 0xfe, 0x28, 0xb5, 0x9d, 0x60, 0x3e, 0xd3, 0xc3, 0xdf,
-	0x57, 0x10, 0x0a, 0x6b, 0x48, 0x7c, 0xaa, 0xaf, 0xc8, 0xc4, 0x94, 0xa5, 0x74, 0xba, 0xd0, 0x5e,
-	0xc0, 0x75, 0x89, 0xe1, 0xd2, 0xf0, 0x5c, 0x1a, 0xae, 0x07, 0x7e, 0x01, 0xc0, 0xe3, 0x4f, 0xdb,
-	0x91, 0x42, 0xb3, 0x87, 0x7f, 0xa1, 0xa0, 0x43, 0xd1, 0x21, 0x0d, 0x2e, 0x17, 0x10, 0x2b, 0xa5,
-	0x99, 0xdd, 0xc1, 0x9c, 0x67, 0x30, 0x2f, 0xe0, 0x73, 0x39, 0x30, 0xf5, 0xaa, 0xff, 0x6a, 0x0a,
-	0xc0, 0x9a, 0x46, 0x0f, 0x7f, 0x57, 0x41, 0x2f, 0x84, 0x1c, 0xef, 0xac, 0x6c, 0xe0, 0xd7, 0xfb,
-	0x4a, 0x0e, 0x3b, 0x97, 0xa5, 0xfe, 0x16, 0x4f, 0x34, 0x2c, 0xd5, 0xcf, 0x30, 0x74, 0x67, 0x71,
-	0x39, 0x0f, 0x9d, 0x55, 0xf7, 0xb4, 0x1d, 0xd1, 0x10, 0xed, 0xe1, 0x5f, 0x81, 0x93, 0x79, 0xb7,
-	0x31, 0xc7, 0xc9, 0x91, 0xb1, 0x4c, 0x8e, 0xf5, 0xa2, 0x43, 0x0a, 0xf5, 0x6d, 0x86, 0xef, 0x3a,
-	0xbe, 0xda, 0x17, 0x1f, 0xef, 0x89, 0x45, 0x9d, 0xac, 0xed, 0x48, 0xcd, 0xb3, 0xd0, 0xe5, 0xe1,
-	0x7c, 0x29, 0xc7, 0xe5, 0x89, 0x41, 0xd4, 0xee, 0x40, 0xe7, 0xbb, 0x1c, 0xe0, 0x81, 0xcb, 0x83,
-	0x11, 0x57, 0x0f, 0xff, 0x49, 0x41, 0x93, 0xf1, 0x89, 0x0d, 0x3e, 0x9b, 0x29, 0x3c, 0x65, 0xf4,
-	0x55, 0x3a, 0xb7, 0x0b, 0x0a, 0x00, 0xfd, 0x79, 0x06, 0x7a, 0x09, 0x2f, 0xf6, 0x05, 0xed, 0x32,
-	0xb2, 0x22, 0x06, 0x17, 0x81, 0x1b, 0x74, 0xb1, 0xf7, 0x1a, 0xb8, 0x89, 0x76, 0x78, 0x81, 0xc0,
-	0x15, 0x88, 0xa2, 0x81, 0xfb, 0x6d, 0x05, 0x8d, 0x4b, 0x63, 0x24, 0xdc, 0xdf, 0xb1, 0xc9, 0x81,
-	0x56, 0xe9, 0x4c, 0xb1, 0xcd, 0x00, 0xf1, 0x24, 0x83, 0xa8, 0xe2, 0xd7, 0xd2, 0x20, 0x36, 0x4d,
-	0xd7, 0x83, 0xb3, 0xe5, 0xe2, 0x1f, 0x01, 0x28, 0x18, 0x91, 0xe4, 0x80, 0x8a, 0x0e, 0x96, 0x72,
-	0x40, 0xc5, 0xa6, 0x2e, 0xd9, 0x76, 0x63, 0xa0, 0xb8, 0xdd, 0xdc, 0x58, 0xda, 0xfc, 0xa3, 0x82,
-	0x5e, 0x4a, 0x1d, 0x28, 0xe1, 0x4b, 0x45, 0xe4, 0x27, 0x06, 0x50, 0xbb, 0x84, 0xbd, 0xc0, 0x60,
-	0x5f, 0xc5, 0xf3, 0x79, 0xb0, 0xfd, 0x33, 0x15, 0xa4, 0xd0, 0x48, 0x36, 0xfd, 0x8e, 0x82, 0x26,
-	0x82, 0xbe, 0x5e, 0xe1, 0x98, 0x7c, 0x2b, 0xbb, 0x10, 0x94, 0x43, 0x32, 0xff, 0x42, 0x82, 0xe2,
-	0x36, 0x1a, 0x91, 0x7f, 0x55, 0xa0, 0x5d, 0x1e, 0x9f, 0x5d, 0x64, 0x9c, 0xfb, 0x3e, 0x93, 0x96,
-	0x8c, 0x73, 0xdf, 0x6f, 0x30, 0xa2, 0xde, 0x66, 0xa8, 0x6f, 0xe2, 0xe5, 0xd4, 0xeb, 0x9d, 0x77,
-	0xf3, 0xea, 0xb6, 0x23, 0xea, 0x4a, 0x6d, 0x47, 0xf4, 0x22, 0x7b, 0xda, 0x4e, 0x62, 0x72, 0xd3,
-	0xc3, 0x7f, 0x53, 0xd0, 0x64, 0x7c, 0x9e, 0x90, 0xa1, 0x48, 0x9f, 0xb1, 0x4a, 0x86, 0x22, 0xfd,
-	0x86, 0x15, 0xea, 0x06, 0x53, 0xe4, 0x0e, 0xbe, 0x95, 0xa6, 0xc8, 0x23, 0x46, 0xa5, 0x4b, 0xff,
-	0x5f, 0xb3, 0x23, 0x86, 0x31, 0xbd, 0x78, 0x2a, 0x93, 0xe6, 0x2a, 0x3d, 0xfc, 0x53, 0x05, 0x8d,
-	0x05, 0x51, 0x83, 0xdf, 0xca, 0xcc, 0xab, 0x72, 0x17, 0xb7, 0x74, 0xaa, 0xc8, 0xd6, 0x22, 0xd1,
-	0x1d, 0x46, 0x8e, 0xb6, 0x23, 0x3d, 0xac, 0x7a, 0xe2, 0x1b, 0x3f, 0x9f, 0x7e, 0xd5, 0x15, 0x4e,
-	0x01, 0x32, 0x2e, 0xe4, 0xc4, 0x20, 0xa3, 0x74, 0xba, 0xd0, 0xde, 0x22, 0x41, 0xce, 0x0e, 0x22,
-	0x43, 0xe5, 0x46, 0xb1, 0xe2, 0x9f, 0x28, 0xe8, 0x70, 0xac, 0xa9, 0x8e, 0xb5, 0x7c, 0x0b, 0x45,
-	0x26, 0x05, 0xa5, 0xb3, 0xc5, 0x09, 0x00, 0xed, 0x1c, 0x43, 0xfb, 0x26, 0xfe, 0xff, 0x9c, 0x23,
-	0x09, 0x83, 0x85, 0x3f, 0x8b, 0x86, 0x72, 0xb4, 0x61, 0x9e, 0x51, 0x2d, 0xa4, 0x76, 0xf0, 0x4b,
-	0x5a, 0xe1, 0xfd, 0x80, 0xf3, 0x16, 0xc3, 0xb9, 0x82, 0x97, 0x72, 0x0e, 0x21, 0x84, 0x41, 0xea,
-	0x11, 0x14, 0x2f, 0xdf, 0x9e, 0x7f, 0x9d, 0x1c, 0x8e, 0xb5, 0xda, 0x33, 0x02, 0x22, 0xd1, 0xc6,
-	0xcf, 0x08, 0x88, 0x64, 0xef, 0x5e, 0xbd, 0xc8, 0xa0, 0x97, 0xf1, 0x99, 0x0c, 0xe8, 0x50, 0xe7,
-	0x04, 0xb3, 0x81, 0x1e, 0xfe, 0x86, 0x82, 0x26, 0xe4, 0xde, 0x38, 0xee, 0xff, 0x68, 0x8a, 0x36,
-	0xf7, 0x4b, 0x27, 0xf3, 0x37, 0x02, 0xb2, 0x37, 0x18, 0xb2, 0x19, 0x7c, 0x34, 0x35, 0x54, 0xed,
-	0xda, 0x96, 0x5e, 0xa7, 0x14, 0xff, 0x16, 0x22, 0x53, 0x6a, 0x79, 0xe7, 0x44, 0x66, 0xb2, 0xb9,
-	0x9e, 0x13, 0x99, 0x29, 0xdd, 0x74, 0xf5, 0x2a, 0x03, 0x77, 0x09, 0x5f, 0xc8, 0x2b, 0xbc, 0x59,
-	0xe7, 0x3c, 0x76, 0x19, 0xff, 0x4e, 0xc4, 0x69, 0xb4, 0x09, 0x9e, 0x11, 0xa7, 0xa9, 0xdd, 0xf6,
-	0x8c, 0x38, 0x4d, 0xef, 0xae, 0xab, 0x57, 0x18, 0xea, 0x8b, 0xf8, 0x7c, 0x1a, 0x6a, 0xd3, 0xe5,
-	0xed, 0x48, 0x1d, 0x3a, 0xee, 0x31, 0xd0, 0xbf, 0x57, 0x60, 0x1c, 0x72, 0xaf, 0x63, 0x7b, 0x24,
-	0x6c, 0xcb, 0x65, 0x58, 0x3b, 0xbd, 0x01, 0x98, 0x61, 0xed, 0x3e, 0x1d, 0xbf, 0x6c, 0x6b, 0x3f,
-	0xf4, 0xf1, 0xe8, 0xd0, 0x11, 0xf4, 0x1f, 0xb2, 0x31, 0xe0, 0x7f, 0x11, 0x4f, 0xf0, 0x44, 0x77,
-	0x2d, 0xe3, 0x09, 0xde, 0xaf, 0x7d, 0x98, 0xf1, 0x04, 0xef, 0xdb, 0xbc, 0x53, 0x97, 0x18, 0xfc,
-	0x1b, 0xf8, 0x5a, 0x1a, 0x7c, 0x39, 0x83, 0xb9, 0x3a, 0xeb, 0x3e, 0x89, 0xe4, 0x6b, 0x1a, 0x3d,
-	0x6d, 0x07, 0x7e, 0xe9, 0xe1, 0x0f, 0x14, 0x34, 0x19, 0x6f, 0x61, 0x65, 0x94, 0x9a, 0xc9, 0xd6,
-	0x5e, 0x46, 0xcd, 0x96, 0xd2, 0x15, 0x2b, 0x80, 0x3a, 0x06, 0x37, 0x79, 0xaf, 0xb9, 0x3d, 0xff,
-	0x7c, 0x4e, 0xa5, 0xf5, 0xfc, 0x32, 0xc2, 0x26, 0xbd, 0x3b, 0xb8, 0x4b, 0xf4, 0x99, 0xa1, 0x2e,
-	0xa3, 0x17, 0xd9, 0x2d, 0xe8, 0x3c, 0xf6, 0xf0, 0xfb, 0x43, 0x68, 0xb6, 0x58, 0xb7, 0x0b, 0x2f,
-	0x64, 0x74, 0x64, 0x8a, 0x35, 0xff, 0x4a, 0x8b, 0x7b, 0x61, 0x01, 0xda, 0x56, 0x99, 0xb6, 0x5f,
-	0xc6, 0xf7, 0xd3, 0x9b, 0x3c, 0x91, 0xd6, 0xa2, 0xc8, 0x4c, 0xb1, 0x36, 0x9c, 0xb6, 0x13, 0xdb,
-	0x17, 0x2b, 0xac, 0x16, 0x57, 0x3f, 0x7a, 0x3a, 0xa3, 0x3c, 0x79, 0x3a, 0xa3, 0xfc, 0xeb, 0xe9,
-	0x8c, 0xf2, 0xad, 0x67, 0x33, 0xfb, 0x9e, 0x3c, 0x9b, 0xd9, 0xf7, 0xcf, 0x67, 0x33, 0xfb, 0xee,
-	0x6b, 0x52, 0xcb, 0xae, 0x6a, 0x55, 0xe7, 0x6a, 0x0f, 0x88, 0x69, 0xc9, 0x48, 0x1e, 0x47, 0xff,
-	0xab, 0xb9, 0x3a, 0xc2, 0xfe, 0x63, 0xf9, 0xc2, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x93,
-	0xb9, 0xe9, 0x0f, 0x2e, 0x00, 0x00,
+func (m *QueryListObjectVersionsRequest) Reset()         { *m = QueryListObjectVersionsRequest{} }
+func (m *QueryListObjectVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListObjectVersionsRequest) ProtoMessage()    {}
+func (*QueryListObjectVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{53}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Parameters queries the parameters of the module.
-	QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
-	// Queries a bucket with specify name.
-	HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
-	// Queries a bucket by id
-	HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
-	// Queries a bucket with EIP712 standard metadata info
-	HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error)
-	// Queries a object with specify name.
-	HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
-	// Queries an object by id
-	HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
-	// Queries a shadow object with specify name.
-	HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error)
-	// Queries a object with EIP712 standard metadata info
-	HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error)
-	// Queries a list of bucket items.
-	ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
-	// Queries a group with EIP712 standard metadata info
-	HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error)
-	// Queries a policy which grants permission to account
-	QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error)
-	// Queries a list of VerifyPermission items.
-	VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error)
-	// Queries a group with specify owner and name .
-	HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error)
-	// Queries a list of ListGroup items.
-	ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error)
-	// Queries a list of HeadGroupMember items.
-	HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error)
-	// Queries a policy that grants permission to a group
-	QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error)
-	// Queries a policy by policy id
-	QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error)
-	// Queries lock fee for storing an object
-	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
-	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
-	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error)
-	// Queries whether some members are in the group.
-	QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error)
-	// Queries whether some groups are exist.
-	QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
-	// Queries whether some groups are exist by id.
-	QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
-	// Queries the flow rate limit of a bucket for a payment account
-	QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+func (m *QueryListObjectVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryListObjectVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListObjectVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryListObjectVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListObjectVersionsRequest.Merge(m, src)
+}
+func (m *QueryListObjectVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListObjectVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListObjectVersionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListObjectVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryListObjectVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
-	out := new(QueryParamsByTimestampResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryParamsByTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListObjectVersionsRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
-	out := new(QueryHeadBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListObjectVersionsRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
-	out := new(QueryHeadBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListObjectVersionsResponse struct {
+	ObjectVersions []*ObjectVersion    `protobuf:"bytes,1,rep,name=object_versions,json=objectVersions,proto3" json:"object_versions,omitempty"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error) {
-	out := new(QueryBucketNFTResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListObjectVersionsResponse) Reset()         { *m = QueryListObjectVersionsResponse{} }
+func (m *QueryListObjectVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListObjectVersionsResponse) ProtoMessage()    {}
+func (*QueryListObjectVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{54}
+}
+func (m *QueryListObjectVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListObjectVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListObjectVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListObjectVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListObjectVersionsResponse.Merge(m, src)
+}
+func (m *QueryListObjectVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListObjectVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListObjectVersionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
-	out := new(QueryHeadObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListObjectVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryListObjectVersionsResponse) GetObjectVersions() []*ObjectVersion {
+	if m != nil {
+		return m.ObjectVersions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
-	out := new(QueryHeadObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListObjectVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error) {
-	out := new(QueryHeadShadowObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadShadowObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeadObjectVersionRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Version    int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (c *queryClient) HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error) {
-	out := new(QueryObjectNFTResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionRequest) Reset()         { *m = QueryHeadObjectVersionRequest{} }
+func (m *QueryHeadObjectVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadObjectVersionRequest) ProtoMessage()    {}
+func (*QueryHeadObjectVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{55}
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadObjectVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadObjectVersionRequest.Merge(m, src)
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadObjectVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadObjectVersionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
-	out := new(QueryListBucketsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListBuckets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadObjectVersionRequest proto.InternalMessageInfo
+
+func (m *QueryHeadObjectVersionRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
-	out := new(QueryListObjectsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjects", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
-	out := new(QueryListObjectsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjectsByBucketId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error) {
-	out := new(QueryGroupNFTResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeadObjectVersionResponse struct {
+	ObjectVersion      *ObjectVersion            `protobuf:"bytes,1,opt,name=object_version,json=objectVersion,proto3" json:"object_version,omitempty"`
+	GlobalVirtualGroup *types.GlobalVirtualGroup `protobuf:"bytes,2,opt,name=global_virtual_group,json=globalVirtualGroup,proto3" json:"global_virtual_group,omitempty"`
 }
 
-func (c *queryClient) QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error) {
-	out := new(QueryPolicyForAccountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionResponse) Reset()         { *m = QueryHeadObjectVersionResponse{} }
+func (m *QueryHeadObjectVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadObjectVersionResponse) ProtoMessage()    {}
+func (*QueryHeadObjectVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{56}
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadObjectVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadObjectVersionResponse.Merge(m, src)
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadObjectVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadObjectVersionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error) {
-	out := new(QueryVerifyPermissionResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/VerifyPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadObjectVersionResponse proto.InternalMessageInfo
+
+func (m *QueryHeadObjectVersionResponse) GetObjectVersion() *ObjectVersion {
+	if m != nil {
+		return m.ObjectVersion
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error) {
-	out := new(QueryHeadGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionResponse) GetGlobalVirtualGroup() *types.GlobalVirtualGroup {
+	if m != nil {
+		return m.GlobalVirtualGroup
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error) {
-	out := new(QueryListGroupsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeadComposedObjectRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
 }
 
-func (c *queryClient) HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error) {
-	out := new(QueryHeadGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryHeadComposedObjectRequest) Reset()         { *m = QueryHeadComposedObjectRequest{} }
+func (m *QueryHeadComposedObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadComposedObjectRequest) ProtoMessage()    {}
+func (*QueryHeadComposedObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{57}
 }
-
-func (c *queryClient) QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error) {
-	out := new(QueryPolicyForGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryHeadComposedObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error) {
-	out := new(QueryPolicyByIdResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadComposedObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadComposedObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error) {
-	out := new(QueryLockFeeResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryLockFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryHeadComposedObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadComposedObjectRequest.Merge(m, src)
+}
+func (m *QueryHeadComposedObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadComposedObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadComposedObjectRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error) {
-	out := new(QueryHeadBucketExtraResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketExtra", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadComposedObjectRequest proto.InternalMessageInfo
+
+func (m *QueryHeadComposedObjectRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
-	out := new(QueryIsPriceChangedResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryIsPriceChanged", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadComposedObjectRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error) {
-	out := new(QueryQuoteUpdateTimeResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryQuotaUpdateTime", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeadComposedObjectResponse struct {
+	ObjectInfo     *ObjectInfo     `protobuf:"bytes,1,opt,name=object_info,json=objectInfo,proto3" json:"object_info,omitempty"`
+	ComposedObject *ComposedObject `protobuf:"bytes,2,opt,name=composed_object,json=composedObject,proto3" json:"composed_object,omitempty"`
+	// global_virtual_groups defines the gvg of each component in the same order of the components
+	GlobalVirtualGroups []*types.GlobalVirtualGroup `protobuf:"bytes,3,rep,name=global_virtual_groups,json=globalVirtualGroups,proto3" json:"global_virtual_groups,omitempty"`
 }
 
-func (c *queryClient) QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error) {
-	out := new(QueryGroupMembersExistResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupMembersExist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadComposedObjectResponse) Reset()         { *m = QueryHeadComposedObjectResponse{} }
+func (m *QueryHeadComposedObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadComposedObjectResponse) ProtoMessage()    {}
+func (*QueryHeadComposedObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{58}
+}
+func (m *QueryHeadComposedObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadComposedObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadComposedObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeadComposedObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadComposedObjectResponse.Merge(m, src)
+}
+func (m *QueryHeadComposedObjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadComposedObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadComposedObjectResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
-	out := new(QueryGroupsExistResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExist", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadComposedObjectResponse proto.InternalMessageInfo
+
+func (m *QueryHeadComposedObjectResponse) GetObjectInfo() *ObjectInfo {
+	if m != nil {
+		return m.ObjectInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
-	out := new(QueryGroupsExistResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExistById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadComposedObjectResponse) GetComposedObject() *ComposedObject {
+	if m != nil {
+		return m.ComposedObject
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
-	out := new(QueryPaymentAccountBucketFlowRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadComposedObjectResponse) GetGlobalVirtualGroups() []*types.GlobalVirtualGroup {
+	if m != nil {
+		return m.GlobalVirtualGroups
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Parameters queries the parameters of the module.
-	QueryParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
-	// Queries a bucket with specify name.
-	HeadBucket(context.Context, *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error)
-	// Queries a bucket by id
-	HeadBucketById(context.Context, *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error)
-	// Queries a bucket with EIP712 standard metadata info
-	HeadBucketNFT(context.Context, *QueryNFTRequest) (*QueryBucketNFTResponse, error)
-	// Queries a object with specify name.
-	HeadObject(context.Context, *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error)
-	// Queries an object by id
-	HeadObjectById(context.Context, *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error)
-	// Queries a shadow object with specify name.
-	HeadShadowObject(context.Context, *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error)
-	// Queries a object with EIP712 standard metadata info
-	HeadObjectNFT(context.Context, *QueryNFTRequest) (*QueryObjectNFTResponse, error)
-	// Queries a list of bucket items.
-	ListBuckets(context.Context, *QueryListBucketsRequest) (*QueryListBucketsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjects(context.Context, *QueryListObjectsRequest) (*QueryListObjectsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjectsByBucketId(context.Context, *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error)
-	// Queries a group with EIP712 standard metadata info
-	HeadGroupNFT(context.Context, *QueryNFTRequest) (*QueryGroupNFTResponse, error)
-	// Queries a policy which grants permission to account
-	QueryPolicyForAccount(context.Context, *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error)
-	// Queries a list of VerifyPermission items.
-	VerifyPermission(context.Context, *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error)
-	// Queries a group with specify owner and name .
-	HeadGroup(context.Context, *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error)
-	// Queries a list of ListGroup items.
-	ListGroups(context.Context, *QueryListGroupsRequest) (*QueryListGroupsResponse, error)
-	// Queries a list of HeadGroupMember items.
-	HeadGroupMember(context.Context, *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error)
-	// Queries a policy that grants permission to a group
-	QueryPolicyForGroup(context.Context, *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error)
-	// Queries a policy by policy id
-	QueryPolicyById(context.Context, *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error)
-	// Queries lock fee for storing an object
-	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
-	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
-	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryQuotaUpdateTime(context.Context, *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error)
-	// Queries whether some members are in the group.
-	QueryGroupMembersExist(context.Context, *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error)
-	// Queries whether some groups are exist.
-	QueryGroupsExist(context.Context, *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error)
-	// Queries whether some groups are exist by id.
-	QueryGroupsExistById(context.Context, *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error)
-	// Queries the flow rate limit of a bucket for a payment account
-	QueryPaymentAccountBucketFlowRateLimit(context.Context, *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+type QueryBucketLifecycleRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBucketLifecycleRequest) Reset()         { *m = QueryBucketLifecycleRequest{} }
+func (m *QueryBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketLifecycleRequest) ProtoMessage()    {}
+func (*QueryBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{59}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParamsByTimestamp not implemented")
+func (m *QueryBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketLifecycleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HeadBucket(ctx context.Context, req *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucket not implemented")
+func (m *QueryBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketLifecycleRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) HeadBucketById(ctx context.Context, req *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketById not implemented")
+func (m *QueryBucketLifecycleRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) HeadBucketNFT(ctx context.Context, req *QueryNFTRequest) (*QueryBucketNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketNFT not implemented")
+func (m *QueryBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketLifecycleRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) HeadObject(ctx context.Context, req *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
+
+var xxx_messageInfo_QueryBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *QueryBucketLifecycleRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) HeadObjectById(ctx context.Context, req *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectById not implemented")
+
+type QueryBucketLifecycleResponse struct {
+	Rules []LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
 }
-func (*UnimplementedQueryServer) HeadShadowObject(ctx context.Context, req *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadShadowObject not implemented")
+
+func (m *QueryBucketLifecycleResponse) Reset()         { *m = QueryBucketLifecycleResponse{} }
+func (m *QueryBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketLifecycleResponse) ProtoMessage()    {}
+func (*QueryBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{60}
 }
-func (*UnimplementedQueryServer) HeadObjectNFT(ctx context.Context, req *QueryNFTRequest) (*QueryObjectNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectNFT not implemented")
+func (m *QueryBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListBuckets(ctx context.Context, req *QueryListBucketsRequest) (*QueryListBucketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
+func (m *QueryBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListObjects(ctx context.Context, req *QueryListObjectsRequest) (*QueryListObjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+func (m *QueryBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketLifecycleResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListObjectsByBucketId(ctx context.Context, req *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByBucketId not implemented")
+func (m *QueryBucketLifecycleResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) HeadGroupNFT(ctx context.Context, req *QueryNFTRequest) (*QueryGroupNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupNFT not implemented")
+func (m *QueryBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketLifecycleResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryPolicyForAccount(ctx context.Context, req *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForAccount not implemented")
+
+var xxx_messageInfo_QueryBucketLifecycleResponse proto.InternalMessageInfo
+
+func (m *QueryBucketLifecycleResponse) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) VerifyPermission(ctx context.Context, req *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyPermission not implemented")
+
+type QueryEstimateStorageCostRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// payload_size is the total size of the object payload
+	PayloadSize uint64 `protobuf:"varint,2,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// read_quota is the charged read quota of the bucket to estimate with, the current charged read quota of the bucket
+	// is used if it is not set.
+	ReadQuota uint64 `protobuf:"varint,3,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+	// duration is the seconds the object is expected to be stored
+	Duration int64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *QueryEstimateStorageCostRequest) Reset()         { *m = QueryEstimateStorageCostRequest{} }
+func (m *QueryEstimateStorageCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateStorageCostRequest) ProtoMessage()    {}
+func (*QueryEstimateStorageCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{61}
+}
+func (m *QueryEstimateStorageCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HeadGroup(ctx context.Context, req *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroup not implemented")
+func (m *QueryEstimateStorageCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateStorageCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListGroups(ctx context.Context, req *QueryListGroupsRequest) (*QueryListGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+func (m *QueryEstimateStorageCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateStorageCostRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) HeadGroupMember(ctx context.Context, req *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupMember not implemented")
+func (m *QueryEstimateStorageCostRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryPolicyForGroup(ctx context.Context, req *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForGroup not implemented")
+func (m *QueryEstimateStorageCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateStorageCostRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryPolicyById(ctx context.Context, req *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyById not implemented")
+
+var xxx_messageInfo_QueryEstimateStorageCostRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateStorageCostRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLockFeeRequest) (*QueryLockFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryLockFee not implemented")
+
+func (m *QueryEstimateStorageCostRequest) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
+
+func (m *QueryEstimateStorageCostRequest) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
+
+func (m *QueryEstimateStorageCostRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) QueryQuotaUpdateTime(ctx context.Context, req *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryQuotaUpdateTime not implemented")
+
+type QueryEstimateStorageCostResponse struct {
+	// charge_size is the payload size rounded up to the min charge size
+	ChargeSize uint64 `protobuf:"varint,1,opt,name=charge_size,json=chargeSize,proto3" json:"charge_size,omitempty"`
+	// min_charge_size is the min charge size of the versioned params in effect
+	MinChargeSize uint64 `protobuf:"varint,2,opt,name=min_charge_size,json=minChargeSize,proto3" json:"min_charge_size,omitempty"`
+	// reserve_time is the reserve time of the versioned params in effect
+	ReserveTime uint64 `protobuf:"varint,3,opt,name=reserve_time,json=reserveTime,proto3" json:"reserve_time,omitempty"`
+	// primary_store_rate is the flow rate to the primary sp for storing the object
+	PrimaryStoreRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=primary_store_rate,json=primaryStoreRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"primary_store_rate"`
+	// secondary_store_rate is the flow rate to the secondary sps for storing the object
+	SecondaryStoreRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=secondary_store_rate,json=secondaryStoreRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"secondary_store_rate"`
+	// validator_tax_store_rate is the flow rate to the validator tax pool for storing the object
+	ValidatorTaxStoreRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=validator_tax_store_rate,json=validatorTaxStoreRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_tax_store_rate"`
+	// read_rate is the flow rate to the primary sp for the read quota
+	ReadRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=read_rate,json=readRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"read_rate"`
+	// validator_tax_read_rate is the flow rate to the validator tax pool for the read quota
+	ValidatorTaxReadRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=validator_tax_read_rate,json=validatorTaxReadRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_tax_read_rate"`
+	// total_rate is the sum of all the flow rates
+	TotalRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=total_rate,json=totalRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_rate"`
+	// lock_fee is the amount locked when the object is created and before it is sealed
+	LockFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=lock_fee,json=lockFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lock_fee"`
+	// early_deletion_fee is the fee charged when the object is deleted after the duration, which is within the reserve time
+	EarlyDeletionFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=early_deletion_fee,json=earlyDeletionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"early_deletion_fee"`
+	// total_cost is the total rate over the duration plus the early deletion fee
+	TotalCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=total_cost,json=totalCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_cost"`
+}
+
+func (m *QueryEstimateStorageCostResponse) Reset()         { *m = QueryEstimateStorageCostResponse{} }
+func (m *QueryEstimateStorageCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateStorageCostResponse) ProtoMessage()    {}
+func (*QueryEstimateStorageCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{62}
+}
+func (m *QueryEstimateStorageCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryGroupMembersExist(ctx context.Context, req *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupMembersExist not implemented")
+func (m *QueryEstimateStorageCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateStorageCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryGroupsExist(ctx context.Context, req *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExist not implemented")
+func (m *QueryEstimateStorageCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateStorageCostResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryGroupsExistById(ctx context.Context, req *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExistById not implemented")
+func (m *QueryEstimateStorageCostResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, req *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAccountBucketFlowRateLimit not implemented")
+func (m *QueryEstimateStorageCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateStorageCostResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryEstimateStorageCostResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryEstimateStorageCostResponse) GetChargeSize() uint64 {
+	if m != nil {
+		return m.ChargeSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParamsByTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryParamsByTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+func (m *QueryEstimateStorageCostResponse) GetMinChargeSize() uint64 {
+	if m != nil {
+		return m.MinChargeSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucket(ctx, req.(*QueryHeadBucketRequest))
+func (m *QueryEstimateStorageCostResponse) GetReserveTime() uint64 {
+	if m != nil {
+		return m.ReserveTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_HeadBucketById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucketById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketById(ctx, req.(*QueryHeadBucketByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryReaderQuotaRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	Reader     string `protobuf:"bytes,2,opt,name=reader,proto3" json:"reader,omitempty"`
 }
 
-func _Query_HeadBucketNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucketNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketNFT(ctx, req.(*QueryNFTRequest))
+func (m *QueryReaderQuotaRequest) Reset()         { *m = QueryReaderQuotaRequest{} }
+func (m *QueryReaderQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReaderQuotaRequest) ProtoMessage()    {}
+func (*QueryReaderQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{63}
+}
+func (m *QueryReaderQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReaderQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReaderQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReaderQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReaderQuotaRequest.Merge(m, src)
+}
+func (m *QueryReaderQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReaderQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReaderQuotaRequest.DiscardUnknown(m)
 }
 
-func _Query_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadObjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObject(ctx, req.(*QueryHeadObjectRequest))
+var xxx_messageInfo_QueryReaderQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryReaderQuotaRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HeadObjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadObjectByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObjectById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadObjectById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObjectById(ctx, req.(*QueryHeadObjectByIdRequest))
+func (m *QueryReaderQuotaRequest) GetReader() string {
+	if m != nil {
+		return m.Reader
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HeadShadowObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadShadowObjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadShadowObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadShadowObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadShadowObject(ctx, req.(*QueryHeadShadowObjectRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryReaderQuotaResponse struct {
+	ReaderQuota *ReaderQuota `protobuf:"bytes,1,opt,name=reader_quota,json=readerQuota,proto3" json:"reader_quota,omitempty"`
 }
 
-func _Query_HeadObjectNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObjectNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadObjectNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObjectNFT(ctx, req.(*QueryNFTRequest))
+func (m *QueryReaderQuotaResponse) Reset()         { *m = QueryReaderQuotaResponse{} }
+func (m *QueryReaderQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReaderQuotaResponse) ProtoMessage()    {}
+func (*QueryReaderQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{64}
+}
+func (m *QueryReaderQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReaderQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReaderQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReaderQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReaderQuotaResponse.Merge(m, src)
+}
+func (m *QueryReaderQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReaderQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReaderQuotaResponse.DiscardUnknown(m)
 }
 
-func _Query_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListBucketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListBuckets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListBuckets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListBuckets(ctx, req.(*QueryListBucketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryReaderQuotaResponse proto.InternalMessageInfo
 
-func _Query_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListObjectsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListObjects(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListObjects",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListObjects(ctx, req.(*QueryListObjectsRequest))
+func (m *QueryReaderQuotaResponse) GetReaderQuota() *ReaderQuota {
+	if m != nil {
+		return m.ReaderQuota
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListObjectsByBucketId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListObjectsByBucketIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListObjectsByBucketId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListObjectsByBucketId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListObjectsByBucketId(ctx, req.(*QueryListObjectsByBucketIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPaymentHealthRequest struct {
 }
 
-func _Query_HeadGroupNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroupNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadGroupNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroupNFT(ctx, req.(*QueryNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentHealthRequest) Reset()         { *m = QueryPaymentHealthRequest{} }
+func (m *QueryPaymentHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentHealthRequest) ProtoMessage()    {}
+func (*QueryPaymentHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{65}
 }
-
-func _Query_QueryPolicyForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyForAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyForAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPolicyForAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyForAccount(ctx, req.(*QueryPolicyForAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_VerifyPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerifyPermissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerifyPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/VerifyPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerifyPermission(ctx, req.(*QueryVerifyPermissionRequest))
+func (m *QueryPaymentHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_HeadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadGroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroup(ctx, req.(*QueryHeadGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentHealthRequest.Merge(m, src)
 }
-
-func _Query_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListGroupsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/ListGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListGroups(ctx, req.(*QueryListGroupsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentHealthRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_HeadGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadGroupMemberRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroupMember(ctx, req.(*QueryHeadGroupMemberRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentHealthRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryPolicyForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyForGroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyForGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPolicyForGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyForGroup(ctx, req.(*QueryPolicyForGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPaymentHealthRequest proto.InternalMessageInfo
+
+type QueryPaymentHealthResponse struct {
+	Report *PaymentHealthReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// height defines the block height the payment data is checked at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func _Query_QueryPolicyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPolicyById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyById(ctx, req.(*QueryPolicyByIdRequest))
+func (m *QueryPaymentHealthResponse) Reset()         { *m = QueryPaymentHealthResponse{} }
+func (m *QueryPaymentHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentHealthResponse) ProtoMessage()    {}
+func (*QueryPaymentHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{66}
+}
+func (m *QueryPaymentHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPaymentHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentHealthResponse.Merge(m, src)
+}
+func (m *QueryPaymentHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentHealthResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryLockFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryLockFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryLockFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryLockFee(ctx, req.(*QueryLockFeeRequest))
+var xxx_messageInfo_QueryPaymentHealthResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentHealthResponse) GetReport() *PaymentHealthReport {
+	if m != nil {
+		return m.Report
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketExtraRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketExtra(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/HeadBucketExtra",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketExtra(ctx, req.(*QueryHeadBucketExtraRequest))
+func (m *QueryPaymentHealthResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsPriceChangedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryIsPriceChanged(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryIsPriceChanged",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryIsPriceChanged(ctx, req.(*QueryIsPriceChangedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterEnum("greenfield.storage.PermissionTraceStepType", PermissionTraceStepType_name, PermissionTraceStepType_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.storage.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.storage.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.storage.QueryParamsByTimestampRequest")
+	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.storage.QueryParamsByTimestampResponse")
+	proto.RegisterType((*QueryHeadBucketRequest)(nil), "greenfield.storage.QueryHeadBucketRequest")
+	proto.RegisterType((*QueryHeadBucketByIdRequest)(nil), "greenfield.storage.QueryHeadBucketByIdRequest")
+	proto.RegisterType((*QueryHeadBucketResponse)(nil), "greenfield.storage.QueryHeadBucketResponse")
+	proto.RegisterType((*QueryHeadObjectRequest)(nil), "greenfield.storage.QueryHeadObjectRequest")
+	proto.RegisterType((*QueryHeadObjectByIdRequest)(nil), "greenfield.storage.QueryHeadObjectByIdRequest")
+	proto.RegisterType((*QueryHeadShadowObjectRequest)(nil), "greenfield.storage.QueryHeadShadowObjectRequest")
+	proto.RegisterType((*QueryHeadObjectResponse)(nil), "greenfield.storage.QueryHeadObjectResponse")
+	proto.RegisterType((*QueryHeadShadowObjectResponse)(nil), "greenfield.storage.QueryHeadShadowObjectResponse")
+	proto.RegisterType((*QueryListBucketsRequest)(nil), "greenfield.storage.QueryListBucketsRequest")
+	proto.RegisterType((*QueryListBucketsResponse)(nil), "greenfield.storage.QueryListBucketsResponse")
+	proto.RegisterType((*QueryListObjectsRequest)(nil), "greenfield.storage.QueryListObjectsRequest")
+	proto.RegisterType((*QueryListObjectsByBucketIdRequest)(nil), "greenfield.storage.QueryListObjectsByBucketIdRequest")
+	proto.RegisterType((*QueryListObjectsResponse)(nil), "greenfield.storage.QueryListObjectsResponse")
+	proto.RegisterType((*QueryNFTRequest)(nil), "greenfield.storage.QueryNFTRequest")
+	proto.RegisterType((*QueryBucketNFTResponse)(nil), "greenfield.storage.QueryBucketNFTResponse")
+	proto.RegisterType((*QueryObjectNFTResponse)(nil), "greenfield.storage.QueryObjectNFTResponse")
+	proto.RegisterType((*QueryGroupNFTResponse)(nil), "greenfield.storage.QueryGroupNFTResponse")
+	proto.RegisterType((*QueryPolicyForAccountRequest)(nil), "greenfield.storage.QueryPolicyForAccountRequest")
+	proto.RegisterType((*QueryPolicyForAccountResponse)(nil), "greenfield.storage.QueryPolicyForAccountResponse")
+	proto.RegisterType((*QueryVerifyPermissionRequest)(nil), "greenfield.storage.QueryVerifyPermissionRequest")
+	proto.RegisterType((*QueryVerifyPermissionResponse)(nil), "greenfield.storage.QueryVerifyPermissionResponse")
+	proto.RegisterType((*QueryExplainPermissionRequest)(nil), "greenfield.storage.QueryExplainPermissionRequest")
+	proto.RegisterType((*QueryExplainPermissionResponse)(nil), "greenfield.storage.QueryExplainPermissionResponse")
+	proto.RegisterType((*PermissionTraceStep)(nil), "greenfield.storage.PermissionTraceStep")
+	proto.RegisterType((*QueryHeadGroupRequest)(nil), "greenfield.storage.QueryHeadGroupRequest")
+	proto.RegisterType((*QueryHeadGroupResponse)(nil), "greenfield.storage.QueryHeadGroupResponse")
+	proto.RegisterType((*QueryListGroupsRequest)(nil), "greenfield.storage.QueryListGroupsRequest")
+	proto.RegisterType((*QueryListGroupsResponse)(nil), "greenfield.storage.QueryListGroupsResponse")
+	proto.RegisterType((*QueryHeadGroupMemberRequest)(nil), "greenfield.storage.QueryHeadGroupMemberRequest")
+	proto.RegisterType((*QueryHeadGroupMemberResponse)(nil), "greenfield.storage.QueryHeadGroupMemberResponse")
+	proto.RegisterType((*QueryPolicyForGroupRequest)(nil), "greenfield.storage.QueryPolicyForGroupRequest")
+	proto.RegisterType((*QueryPolicyForGroupResponse)(nil), "greenfield.storage.QueryPolicyForGroupResponse")
+	proto.RegisterType((*QueryPolicyByIdRequest)(nil), "greenfield.storage.QueryPolicyByIdRequest")
+	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "greenfield.storage.QueryPolicyByIdResponse")
+	proto.RegisterType((*QueryLockFeeRequest)(nil), "greenfield.storage.QueryLockFeeRequest")
+	proto.RegisterType((*QueryLockFeeResponse)(nil), "greenfield.storage.QueryLockFeeResponse")
+	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "greenfield.storage.QueryHeadBucketExtraRequest")
+	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "greenfield.storage.QueryHeadBucketExtraResponse")
+	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "greenfield.storage.QueryIsPriceChangedRequest")
+	proto.RegisterType((*QueryIsPriceChangedResponse)(nil), "greenfield.storage.QueryIsPriceChangedResponse")
+	proto.RegisterType((*QueryQuoteUpdateTimeRequest)(nil), "greenfield.storage.QueryQuoteUpdateTimeRequest")
+	proto.RegisterType((*QueryQuoteUpdateTimeResponse)(nil), "greenfield.storage.QueryQuoteUpdateTimeResponse")
+	proto.RegisterType((*QueryGroupMembersExistRequest)(nil), "greenfield.storage.QueryGroupMembersExistRequest")
+	proto.RegisterType((*QueryGroupMembersExistResponse)(nil), "greenfield.storage.QueryGroupMembersExistResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupMembersExistResponse.ExistsEntry")
+	proto.RegisterType((*QueryGroupsExistRequest)(nil), "greenfield.storage.QueryGroupsExistRequest")
+	proto.RegisterType((*QueryGroupsExistByIdRequest)(nil), "greenfield.storage.QueryGroupsExistByIdRequest")
+	proto.RegisterType((*QueryGroupsExistResponse)(nil), "greenfield.storage.QueryGroupsExistResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "greenfield.storage.QueryGroupsExistResponse.ExistsEntry")
+	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitRequest)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitRequest")
+	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitResponse)(nil), "greenfield.storage.QueryPaymentAccountBucketFlowRateLimitResponse")
+	proto.RegisterType((*QueryListObjectVersionsRequest)(nil), "greenfield.storage.QueryListObjectVersionsRequest")
+	proto.RegisterType((*QueryListObjectVersionsResponse)(nil), "greenfield.storage.QueryListObjectVersionsResponse")
+	proto.RegisterType((*QueryHeadObjectVersionRequest)(nil), "greenfield.storage.QueryHeadObjectVersionRequest")
+	proto.RegisterType((*QueryHeadObjectVersionResponse)(nil), "greenfield.storage.QueryHeadObjectVersionResponse")
+	proto.RegisterType((*QueryHeadComposedObjectRequest)(nil), "greenfield.storage.QueryHeadComposedObjectRequest")
+	proto.RegisterType((*QueryHeadComposedObjectResponse)(nil), "greenfield.storage.QueryHeadComposedObjectResponse")
+	proto.RegisterType((*QueryBucketLifecycleRequest)(nil), "greenfield.storage.QueryBucketLifecycleRequest")
+	proto.RegisterType((*QueryBucketLifecycleResponse)(nil), "greenfield.storage.QueryBucketLifecycleResponse")
+	proto.RegisterType((*QueryEstimateStorageCostRequest)(nil), "greenfield.storage.QueryEstimateStorageCostRequest")
+	proto.RegisterType((*QueryEstimateStorageCostResponse)(nil), "greenfield.storage.QueryEstimateStorageCostResponse")
+	proto.RegisterType((*QueryReaderQuotaRequest)(nil), "greenfield.storage.QueryReaderQuotaRequest")
+	proto.RegisterType((*QueryReaderQuotaResponse)(nil), "greenfield.storage.QueryReaderQuotaResponse")
+	proto.RegisterType((*QueryPaymentHealthRequest)(nil), "greenfield.storage.QueryPaymentHealthRequest")
+	proto.RegisterType((*QueryPaymentHealthResponse)(nil), "greenfield.storage.QueryPaymentHealthResponse")
 }
 
-func _Query_QueryQuotaUpdateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQuoteUpdateTimeRequest)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("greenfield/storage/query.proto", fileDescriptor_b1b80b580af04cb0) }
+
+var fileDescriptor_b1b80b580af04cb0 = []byte{
+	// 4120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0xdd, 0x6f, 0x1c, 0xc9,
+	0x71, 0xd7, 0x90, 0xd4, 0x72, 0xd9, 0xa4, 0x48, 0xba, 0x45, 0x49, 0xd4, 0x4a, 0x22, 0xa5, 0xb9,
+	0x8b, 0x4e, 0xd6, 0x9d, 0x76, 0x25, 0xdd, 0xc9, 0x39, 0x9d, 0x4e, 0x3a, 0xf0, 0x63, 0xa9, 0x5b,
+	0x87, 0x5f, 0x37, 0x5c, 0xea, 0x72, 0x97, 0x38, 0x93, 0xe1, 0x6e, 0x73, 0x39, 0xd6, 0xee, 0xcc,
+	0x6a, 0x66, 0x56, 0xd4, 0x9a, 0x58, 0x04, 0xc9, 0x4b, 0xfc, 0x68, 0xc4, 0x48, 0x10, 0xe4, 0x0b,
+	0x46, 0x8c, 0x7c, 0xd8, 0x40, 0x12, 0x27, 0x36, 0x0c, 0xe4, 0x29, 0x08, 0x92, 0x00, 0x0e, 0x82,
+	0x20, 0x17, 0x07, 0x01, 0x12, 0x07, 0x30, 0x92, 0x3b, 0xff, 0x21, 0x41, 0x77, 0x57, 0xcf, 0xf6,
+	0x7c, 0xee, 0x50, 0x5c, 0xe7, 0x21, 0x4f, 0xda, 0xe9, 0xee, 0xaa, 0xfa, 0x55, 0x75, 0x75, 0x75,
+	0x75, 0x77, 0x89, 0x68, 0xa1, 0xe1, 0x10, 0x62, 0xed, 0x9b, 0xa4, 0x59, 0x2f, 0xb9, 0x9e, 0xed,
+	0x18, 0x0d, 0x52, 0x7a, 0xd6, 0x21, 0x4e, 0xb7, 0xd8, 0x76, 0x6c, 0xcf, 0xc6, 0xb8, 0xdf, 0x5f,
+	0x84, 0xfe, 0xc2, 0xcd, 0x9a, 0xed, 0xb6, 0x6c, 0xb7, 0xb4, 0x67, 0xb8, 0x30, 0xb8, 0xf4, 0xfc,
+	0xce, 0x1e, 0xf1, 0x8c, 0x3b, 0xa5, 0xb6, 0xd1, 0x30, 0x2d, 0xc3, 0x33, 0x6d, 0x8b, 0xd3, 0x17,
+	0x2e, 0xf2, 0xb1, 0x3a, 0xfb, 0x2a, 0xf1, 0x0f, 0xe8, 0x9a, 0x6b, 0xd8, 0x0d, 0x9b, 0xb7, 0xd3,
+	0x5f, 0xd0, 0x7a, 0xb9, 0x61, 0xdb, 0x8d, 0x26, 0x29, 0x19, 0x6d, 0xb3, 0x64, 0x58, 0x96, 0xed,
+	0x31, 0x6e, 0x82, 0x46, 0x95, 0xe0, 0xb6, 0x89, 0xd3, 0x32, 0x5d, 0xd7, 0xb4, 0xad, 0x52, 0xcd,
+	0x6e, 0xb5, 0x7c, 0x91, 0xd7, 0xe2, 0xc7, 0x78, 0xdd, 0x36, 0x11, 0x6c, 0x16, 0x63, 0xb4, 0x6e,
+	0x1b, 0x8e, 0xd1, 0x12, 0x03, 0xe2, 0xcc, 0x92, 0xc4, 0xc0, 0x21, 0xae, 0xdd, 0x71, 0x6a, 0xc1,
+	0x01, 0xaf, 0x48, 0x03, 0x9e, 0x9b, 0x8e, 0xd7, 0x31, 0x9a, 0x0d, 0xc7, 0xee, 0xb4, 0xe5, 0x41,
+	0xea, 0x1c, 0xc2, 0x1f, 0x50, 0xf3, 0x6d, 0x33, 0xd1, 0x1a, 0x79, 0xd6, 0x21, 0xae, 0xa7, 0x6e,
+	0xa1, 0xb3, 0x81, 0x56, 0xb7, 0x6d, 0x5b, 0x2e, 0xc1, 0x6f, 0xa3, 0x1c, 0x87, 0x38, 0xaf, 0x5c,
+	0x55, 0x6e, 0x4c, 0xde, 0x2d, 0x14, 0xa3, 0x53, 0x53, 0xe4, 0x34, 0xcb, 0x63, 0x3f, 0xf8, 0xf1,
+	0xe2, 0x29, 0x0d, 0xc6, 0xab, 0x0f, 0xd1, 0x15, 0x89, 0xe1, 0x72, 0xb7, 0x6a, 0xb6, 0x88, 0xeb,
+	0x19, 0xad, 0x36, 0x48, 0xc4, 0x97, 0xd1, 0x84, 0x27, 0xda, 0x18, 0xf7, 0x51, 0xad, 0xdf, 0xa0,
+	0x7e, 0x8c, 0x16, 0x92, 0xc8, 0x4f, 0x0c, 0xed, 0x3e, 0x3a, 0xcf, 0x78, 0xbf, 0x4f, 0x8c, 0xfa,
+	0x72, 0xa7, 0xf6, 0x94, 0x78, 0x02, 0xd3, 0x22, 0x9a, 0xdc, 0x63, 0x0d, 0xba, 0x65, 0xb4, 0x08,
+	0x63, 0x3c, 0xa1, 0x21, 0xde, 0xb4, 0x69, 0xb4, 0x88, 0x7a, 0x1f, 0x15, 0x42, 0xa4, 0xcb, 0xdd,
+	0x4a, 0x5d, 0x90, 0x5f, 0x42, 0x13, 0x40, 0x6e, 0xd6, 0x81, 0x38, 0xcf, 0x1b, 0x2a, 0x75, 0xf5,
+	0x0f, 0x14, 0x74, 0x21, 0x22, 0x16, 0x74, 0x79, 0xcf, 0x97, 0x6b, 0x5a, 0xfb, 0x36, 0x28, 0xb4,
+	0x10, 0xa7, 0x10, 0x27, 0xac, 0x58, 0xfb, 0xb6, 0xc0, 0x45, 0x7f, 0xe3, 0x65, 0x84, 0xc8, 0x0b,
+	0xcf, 0x31, 0x38, 0xfd, 0x08, 0xa3, 0x7f, 0x25, 0x99, 0xbe, 0x4c, 0xc7, 0x32, 0x26, 0x13, 0x44,
+	0xfc, 0x54, 0x3f, 0x96, 0xcc, 0xb2, 0xb5, 0xf7, 0x65, 0x52, 0xcb, 0x6c, 0x16, 0x3a, 0xc0, 0x66,
+	0x14, 0x7c, 0xc0, 0x08, 0x1f, 0xc0, 0x9b, 0x22, 0x76, 0xe3, 0xbc, 0x43, 0x76, 0x03, 0xf2, 0xbe,
+	0xdd, 0x78, 0x43, 0xa5, 0xae, 0xfe, 0x32, 0xba, 0xec, 0x93, 0xee, 0x1c, 0x18, 0x75, 0xfb, 0x70,
+	0xd8, 0xe0, 0xfe, 0x5a, 0x9e, 0x19, 0xc1, 0xbc, 0x3f, 0x33, 0x02, 0xda, 0x80, 0x99, 0xe1, 0x84,
+	0x7c, 0x66, 0x6c, 0xff, 0x37, 0xfe, 0x12, 0x9a, 0x6b, 0x34, 0xed, 0x3d, 0xa3, 0xa9, 0xc3, 0x8a,
+	0xd4, 0xd9, 0x92, 0x84, 0x39, 0x7a, 0x5d, 0xe6, 0x24, 0x2f, 0xd9, 0xe2, 0x63, 0x46, 0xf4, 0x84,
+	0x37, 0x3d, 0xa6, 0x4d, 0x1a, 0x6e, 0x44, 0xda, 0xd4, 0x7d, 0x58, 0x66, 0x51, 0xeb, 0x80, 0x02,
+	0xe5, 0x38, 0x05, 0x5e, 0x8d, 0x53, 0x40, 0x26, 0x0f, 0xab, 0xa1, 0x1a, 0x60, 0xa2, 0x75, 0xd3,
+	0xf5, 0xb8, 0x0f, 0x89, 0xd0, 0x81, 0xd7, 0x10, 0xea, 0x47, 0x60, 0x10, 0x70, 0xbd, 0x08, 0x51,
+	0x97, 0x86, 0xeb, 0x22, 0x8f, 0xed, 0x10, 0xae, 0x8b, 0xdb, 0x46, 0x83, 0x00, 0xad, 0x26, 0x51,
+	0xaa, 0x7f, 0xac, 0xa0, 0xf9, 0xa8, 0x0c, 0x50, 0x63, 0x09, 0x4d, 0x49, 0x2b, 0x84, 0xae, 0xf9,
+	0xd1, 0x0c, 0x4b, 0x64, 0xb2, 0xbf, 0x44, 0x5c, 0xfc, 0x38, 0x80, 0x93, 0xdb, 0xff, 0xb5, 0x81,
+	0x38, 0xb9, 0xfc, 0x00, 0xd0, 0xff, 0x54, 0x24, 0x63, 0x70, 0x7b, 0x0d, 0xdb, 0x18, 0x61, 0xaf,
+	0x1e, 0x89, 0x78, 0xf5, 0x79, 0x94, 0x6b, 0x3b, 0x64, 0xdf, 0x7c, 0x31, 0x3f, 0xca, 0xfa, 0xe0,
+	0x8b, 0x86, 0xd5, 0x3a, 0x69, 0x9a, 0x2d, 0xd3, 0x23, 0xce, 0xfc, 0x18, 0xeb, 0xea, 0x37, 0x50,
+	0xb6, 0xae, 0x67, 0x38, 0x9e, 0x6e, 0xec, 0xd3, 0xfe, 0xd3, 0x9c, 0x2d, 0x6b, 0x5a, 0xa2, 0x2d,
+	0xea, 0x57, 0x15, 0x74, 0x2d, 0xac, 0xdb, 0x72, 0x17, 0x4c, 0x5a, 0x1f, 0xb6, 0x96, 0x81, 0x80,
+	0x39, 0x12, 0x0a, 0x98, 0xff, 0x22, 0xfb, 0x83, 0x6f, 0xe6, 0xbe, 0x3f, 0x48, 0x6e, 0x9d, 0xea,
+	0x0f, 0x92, 0x47, 0x4f, 0xf6, 0x3d, 0x7a, 0x78, 0xfe, 0x80, 0x5f, 0x43, 0x33, 0x3c, 0x17, 0xd0,
+	0xf9, 0x1c, 0x10, 0x77, 0x7e, 0xf4, 0xea, 0xe8, 0x8d, 0x09, 0x6d, 0x9a, 0x37, 0x6f, 0x43, 0xab,
+	0xfa, 0x06, 0x9a, 0x61, 0x0a, 0x6d, 0xae, 0x55, 0x85, 0x25, 0x2f, 0xa2, 0xbc, 0x67, 0x3f, 0x25,
+	0x56, 0x3f, 0xf2, 0x8d, 0xb3, 0xef, 0x4a, 0x5d, 0xfd, 0x08, 0xe2, 0x31, 0x37, 0x3e, 0xa3, 0xf1,
+	0x83, 0xd2, 0x44, 0x8b, 0x78, 0x86, 0x5e, 0x37, 0x3c, 0x03, 0xac, 0xaf, 0x26, 0xaf, 0x84, 0x0d,
+	0xe2, 0x19, 0xab, 0x86, 0x67, 0x68, 0xf9, 0x16, 0xfc, 0xf2, 0x59, 0x73, 0xd3, 0xbc, 0x0c, 0x6b,
+	0x4e, 0x19, 0xc3, 0xfa, 0x43, 0x74, 0x8e, 0xb1, 0x66, 0xe1, 0x49, 0xe6, 0xfc, 0x28, 0xca, 0xf9,
+	0x5a, 0x1c, 0x67, 0x46, 0x18, 0xc3, 0xf8, 0x57, 0x15, 0xd8, 0x08, 0xb6, 0xed, 0xa6, 0x59, 0xeb,
+	0xae, 0xd9, 0xce, 0x52, 0xad, 0x66, 0x77, 0x2c, 0x7f, 0x23, 0x28, 0xa0, 0xbc, 0xc8, 0x8a, 0xc4,
+	0x26, 0x22, 0xbe, 0x71, 0x19, 0x7d, 0xae, 0xed, 0x98, 0x56, 0xcd, 0x6c, 0x1b, 0x4d, 0xdd, 0xa8,
+	0xd7, 0x1d, 0xe2, 0xba, 0xdc, 0xe1, 0x96, 0xe7, 0x7f, 0xf8, 0xbd, 0x5b, 0x73, 0x30, 0xeb, 0x4b,
+	0xbc, 0x67, 0xc7, 0x73, 0x4c, 0xab, 0xa1, 0xcd, 0xfa, 0x24, 0xd0, 0xae, 0x3e, 0x11, 0x49, 0x4d,
+	0x04, 0x02, 0x28, 0x79, 0x0f, 0xe5, 0xda, 0xac, 0x0f, 0x34, 0xbc, 0x22, 0x6b, 0xd8, 0xcf, 0x0b,
+	0x8b, 0x9c, 0x81, 0x06, 0x83, 0xd5, 0x1f, 0x09, 0xdd, 0x9e, 0x10, 0xc7, 0xdc, 0xef, 0x6e, 0xfb,
+	0x03, 0x85, 0x6e, 0x6f, 0xa1, 0xbc, 0xdd, 0x26, 0x8e, 0xe1, 0xd9, 0x0e, 0xd7, 0x2d, 0x05, 0xb6,
+	0x3f, 0x72, 0x70, 0x10, 0x09, 0x6d, 0x8d, 0xa3, 0xe1, 0xad, 0x11, 0x2f, 0xa3, 0x49, 0xa3, 0x46,
+	0x9d, 0x5c, 0xa7, 0x29, 0x24, 0x8b, 0x27, 0xd3, 0xc1, 0x69, 0x93, 0x94, 0x5a, 0x62, 0x23, 0xab,
+	0xdd, 0x36, 0xd1, 0x90, 0xe1, 0xff, 0xf6, 0x8d, 0x16, 0xd5, 0xad, 0x6f, 0x34, 0xb2, 0xbf, 0x4f,
+	0x6a, 0x1e, 0x53, 0x6d, 0x3a, 0xd1, 0x68, 0x65, 0x36, 0x48, 0x83, 0xc1, 0xea, 0x7f, 0x29, 0xc0,
+	0xb8, 0xfc, 0xa2, 0xdd, 0x34, 0x4c, 0xeb, 0xff, 0x97, 0xd5, 0x7e, 0x4b, 0x81, 0x0c, 0x38, 0x46,
+	0xbb, 0x13, 0xd9, 0x0d, 0x3f, 0x44, 0xa7, 0x3d, 0xc7, 0xa8, 0x51, 0xcd, 0x46, 0x59, 0xc8, 0x8b,
+	0xcb, 0x9b, 0x7d, 0xea, 0x2a, 0x1d, 0xba, 0xe3, 0x91, 0xb6, 0xc6, 0xa9, 0xd4, 0x3f, 0x1f, 0x45,
+	0x67, 0x63, 0xba, 0xf1, 0x7b, 0x68, 0x8c, 0x69, 0xcb, 0xb1, 0xbc, 0x9e, 0x91, 0x2b, 0xd3, 0x9b,
+	0x11, 0xe2, 0x35, 0x74, 0x46, 0xac, 0x57, 0x6e, 0xb7, 0x91, 0xa8, 0xdd, 0xc4, 0x80, 0xa2, 0x06,
+	0x3f, 0x18, 0xfd, 0x94, 0x23, 0x7d, 0xe1, 0x77, 0xd1, 0xa4, 0xcf, 0xc7, 0xac, 0xf3, 0xe9, 0x59,
+	0xbe, 0x44, 0x4f, 0x00, 0x3f, 0xfa, 0xf1, 0xe2, 0xd8, 0xae, 0x69, 0x79, 0x3f, 0xfc, 0xde, 0xad,
+	0x49, 0x70, 0x02, 0xfa, 0xa9, 0x21, 0x31, 0xbe, 0x52, 0xc7, 0x6f, 0xa3, 0x09, 0xbe, 0x28, 0x29,
+	0xed, 0xd8, 0x60, 0xda, 0x3c, 0x1f, 0x5d, 0xa9, 0xe3, 0x2f, 0xa0, 0x3c, 0x4b, 0xdd, 0x28, 0xe1,
+	0xe9, 0xc1, 0x84, 0xe3, 0x6c, 0x70, 0xa5, 0x4e, 0xb7, 0x0f, 0xd7, 0x33, 0x3c, 0xd2, 0x22, 0x16,
+	0xdd, 0xcd, 0xea, 0xe4, 0xc5, 0x7c, 0xee, 0xaa, 0x72, 0xe3, 0xb4, 0x36, 0xed, 0x37, 0x57, 0x68,
+	0xab, 0x34, 0xdf, 0xe3, 0xc7, 0x59, 0x27, 0xcf, 0x20, 0x22, 0xd3, 0x14, 0x91, 0x27, 0x92, 0xb0,
+	0x3c, 0xee, 0xa3, 0x49, 0x0e, 0xd8, 0x3e, 0xb4, 0xc8, 0xe0, 0x15, 0x82, 0xd8, 0xe0, 0x2d, 0x3a,
+	0x16, 0x5f, 0x41, 0xfc, 0x4b, 0x5e, 0x22, 0x13, 0xac, 0x85, 0x65, 0xd4, 0x4f, 0xa4, 0xa3, 0x04,
+	0x88, 0x04, 0x9f, 0x7d, 0x57, 0x10, 0x4a, 0xd9, 0xe8, 0x95, 0xc4, 0x6d, 0x80, 0x1f, 0x51, 0x1a,
+	0xe2, 0xa7, 0xfa, 0xbb, 0x0a, 0x30, 0xa6, 0x29, 0x01, 0x1b, 0x31, 0xf4, 0xc4, 0x2b, 0x64, 0x94,
+	0x91, 0xec, 0x46, 0x51, 0xff, 0x50, 0xce, 0x0b, 0x05, 0x3a, 0xd0, 0xfb, 0x71, 0x0c, 0xbc, 0x97,
+	0x4a, 0x36, 0x1e, 0x09, 0x7c, 0x3c, 0xef, 0xe1, 0x6b, 0x78, 0x80, 0x05, 0x91, 0x6f, 0x41, 0x57,
+	0xfd, 0x96, 0x82, 0x2e, 0x05, 0xe7, 0x66, 0x83, 0xb4, 0xf6, 0x88, 0x23, 0xec, 0x78, 0x1b, 0xe5,
+	0x5a, 0xac, 0x61, 0xa0, 0x3f, 0xc0, 0xb8, 0x13, 0x58, 0x2c, 0xe4, 0x46, 0xa3, 0x61, 0x37, 0x22,
+	0xd2, 0xd1, 0x2f, 0x00, 0xd5, 0x3f, 0xdb, 0x4c, 0x71, 0x72, 0x09, 0x71, 0x28, 0x5f, 0x91, 0x96,
+	0x85, 0xcc, 0x81, 0x23, 0xe6, 0x1f, 0xea, 0x3e, 0x1c, 0x4e, 0xfd, 0x5d, 0x3d, 0xb0, 0x4a, 0xd2,
+	0xd2, 0x8a, 0x37, 0x10, 0xee, 0xa7, 0x15, 0xfe, 0xe2, 0xe7, 0xcb, 0xa1, 0x9f, 0x3d, 0xf0, 0x89,
+	0xa8, 0xab, 0x55, 0xb0, 0x7c, 0x58, 0xce, 0xc9, 0x72, 0x87, 0x7b, 0xb0, 0x24, 0x78, 0x73, 0xe8,
+	0x58, 0xdd, 0x0f, 0x65, 0x00, 0x5d, 0x44, 0x2b, 0x75, 0x1b, 0x7c, 0x55, 0x26, 0x3b, 0x19, 0x90,
+	0xdf, 0x57, 0xe0, 0x0e, 0x69, 0xdd, 0xae, 0x3d, 0x5d, 0x23, 0xa4, 0xbf, 0x32, 0xa9, 0x91, 0x5a,
+	0x86, 0xd3, 0xd5, 0xdd, 0xb6, 0x9f, 0x7c, 0x29, 0x19, 0x92, 0x2f, 0x4a, 0xb3, 0xd3, 0x86, 0x76,
+	0xaa, 0x4e, 0xcd, 0x21, 0x86, 0x47, 0x74, 0xc3, 0x63, 0x36, 0x1e, 0xd5, 0xf2, 0xbc, 0x61, 0xc9,
+	0xc3, 0xd7, 0xd0, 0x54, 0xdb, 0xe8, 0x36, 0x6d, 0xa3, 0xae, 0xbb, 0xe6, 0x57, 0xb8, 0x2f, 0x8d,
+	0x69, 0x93, 0xd0, 0xb6, 0x63, 0x7e, 0x85, 0xa8, 0x4d, 0x34, 0x17, 0x84, 0x07, 0xea, 0x56, 0x51,
+	0xce, 0x68, 0xd1, 0x2c, 0x0e, 0x30, 0xbd, 0x0b, 0x51, 0xfb, 0x7a, 0xc3, 0xf4, 0x0e, 0x3a, 0x7b,
+	0xc5, 0x9a, 0xdd, 0x82, 0x3b, 0x44, 0xf8, 0xe7, 0x96, 0x5b, 0x7f, 0x0a, 0x57, 0x6a, 0x15, 0x16,
+	0xd7, 0x11, 0x68, 0x50, 0xb1, 0x3c, 0x0d, 0x78, 0xa9, 0x8f, 0xa4, 0x65, 0x26, 0x5d, 0xba, 0x64,
+	0xbe, 0x69, 0x92, 0x7d, 0x3f, 0x40, 0xef, 0xfb, 0xbe, 0x7c, 0xe3, 0x23, 0xe2, 0x5d, 0x4c, 0x18,
+	0xa8, 0x58, 0x1e, 0x71, 0x2c, 0xa3, 0x29, 0x1d, 0x8b, 0xa5, 0x4b, 0x9f, 0x87, 0xe0, 0xfb, 0x15,
+	0x77, 0xdb, 0x31, 0x6b, 0x64, 0xe5, 0xc0, 0xb0, 0x1a, 0xa4, 0x9e, 0x19, 0xe5, 0xff, 0x8c, 0x83,
+	0x9a, 0x61, 0x7a, 0x40, 0x39, 0x8f, 0xc6, 0x6b, 0xbc, 0x89, 0x11, 0xe7, 0x35, 0xf1, 0x89, 0xbf,
+	0x8c, 0x70, 0xad, 0xe3, 0x38, 0x74, 0xcf, 0x73, 0x88, 0x51, 0xd7, 0xdb, 0x94, 0x1c, 0x82, 0xc7,
+	0x71, 0x66, 0x60, 0x95, 0xd4, 0xa4, 0x19, 0x58, 0x25, 0x35, 0x6d, 0x16, 0xf8, 0x6a, 0xc4, 0xa8,
+	0x33, 0x50, 0xf8, 0x08, 0x5d, 0x12, 0xb2, 0x7c, 0x4f, 0xf4, 0x6c, 0x87, 0x80, 0xd0, 0xd1, 0x21,
+	0x08, 0x9d, 0x07, 0x01, 0xdb, 0xe0, 0xb5, 0x94, 0x3d, 0x17, 0xfe, 0x2b, 0xe8, 0x8a, 0x10, 0xee,
+	0x92, 0x9a, 0x6d, 0xd5, 0xc3, 0xe2, 0xc7, 0x86, 0x20, 0xbe, 0x00, 0x22, 0x76, 0x84, 0x04, 0x09,
+	0x40, 0x17, 0x89, 0x5e, 0xfd, 0xb9, 0xd1, 0x34, 0xeb, 0x34, 0xc9, 0xd5, 0x3d, 0xe3, 0x85, 0xee,
+	0x18, 0x1e, 0x81, 0x4c, 0xe5, 0x64, 0xd2, 0x2f, 0x00, 0xff, 0x27, 0x82, 0x7d, 0xd5, 0x78, 0xa1,
+	0x19, 0x1e, 0xc1, 0x7b, 0x68, 0xda, 0x22, 0x87, 0xf2, 0x04, 0xe7, 0x86, 0x20, 0x6e, 0xca, 0x22,
+	0x87, 0xfd, 0xc9, 0x75, 0xd1, 0x05, 0x2a, 0x23, 0x6e, 0x62, 0xc7, 0x87, 0x20, 0x6c, 0xce, 0x22,
+	0x87, 0xd1, 0x49, 0x3d, 0x44, 0x17, 0xa9, 0xd0, 0xf8, 0x09, 0xcd, 0x0f, 0x41, 0xec, 0x79, 0x8b,
+	0x1c, 0xc6, 0x4d, 0xe6, 0x33, 0x44, 0x7b, 0xe2, 0x26, 0x72, 0x62, 0x08, 0x52, 0xcf, 0x5a, 0xe4,
+	0x30, 0x3c, 0x89, 0x7e, 0x24, 0xfb, 0xa0, 0x63, 0x7b, 0x64, 0xb7, 0x5d, 0x37, 0x3c, 0x52, 0x35,
+	0x5b, 0x24, 0x73, 0x8c, 0x78, 0x00, 0x91, 0x2c, 0x42, 0x0f, 0x31, 0xe2, 0x12, 0x9a, 0xe8, 0xb0,
+	0x56, 0x1a, 0xd7, 0x73, 0x3c, 0xae, 0xf3, 0x86, 0x25, 0x4f, 0xb5, 0xe0, 0x8c, 0x27, 0x6d, 0xde,
+	0x6e, 0xf9, 0x85, 0xe9, 0x7a, 0xd2, 0x05, 0x8a, 0xbf, 0xf1, 0xc2, 0x05, 0x8a, 0x48, 0xac, 0xef,
+	0xa2, 0x71, 0x9e, 0x18, 0xf0, 0x34, 0x29, 0x6d, 0xb7, 0x11, 0x03, 0xd5, 0xef, 0x8a, 0x63, 0x57,
+	0x8c, 0x40, 0xc0, 0xfb, 0x04, 0xe5, 0x08, 0x6d, 0x10, 0x97, 0x4e, 0x8f, 0xe2, 0xa2, 0x6e, 0x3a,
+	0x8f, 0x22, 0xfb, 0x72, 0xcb, 0x96, 0xe7, 0x74, 0x35, 0xe0, 0x56, 0xb8, 0x8f, 0x26, 0xa5, 0x66,
+	0x3c, 0x8b, 0x46, 0x9f, 0x92, 0x2e, 0xe8, 0x44, 0x7f, 0xe2, 0x39, 0x74, 0xfa, 0xb9, 0xd1, 0xec,
+	0xf0, 0x28, 0x99, 0xd7, 0xf8, 0xc7, 0x3b, 0x23, 0x6f, 0x2b, 0x6a, 0x07, 0x36, 0x73, 0x9e, 0x74,
+	0x06, 0xec, 0x73, 0x82, 0x24, 0x7f, 0x51, 0x90, 0xd2, 0x89, 0x05, 0x1b, 0xc2, 0x00, 0x3a, 0xb1,
+	0xae, 0xfa, 0x0e, 0x78, 0x86, 0x24, 0x36, 0x94, 0x7f, 0x88, 0xa9, 0xe1, 0xb6, 0x9a, 0xd0, 0xf2,
+	0x30, 0x37, 0xae, 0xfa, 0x27, 0xe2, 0x76, 0x2f, 0x80, 0x19, 0x4c, 0xbc, 0x1d, 0x32, 0xf1, 0xdb,
+	0xe9, 0x26, 0xfe, 0xe9, 0x1a, 0xf7, 0x13, 0x05, 0xdd, 0x82, 0xb7, 0xa8, 0x2e, 0x3d, 0x8c, 0xc1,
+	0x9d, 0x0f, 0xdf, 0x4f, 0xd7, 0x9a, 0xf6, 0x21, 0x5d, 0x25, 0xeb, 0x66, 0xcb, 0xf4, 0x6d, 0xbe,
+	0x84, 0x66, 0xda, 0x7c, 0xac, 0x6e, 0xf0, 0xc1, 0x03, 0xed, 0x3e, 0xdd, 0x0e, 0x30, 0xc7, 0x0f,
+	0xfc, 0xfb, 0xee, 0x6c, 0x59, 0x35, 0xac, 0x41, 0x7f, 0xe2, 0xe4, 0x25, 0x39, 0x1a, 0x59, 0x92,
+	0x7f, 0xa6, 0xa0, 0x62, 0x56, 0x95, 0x60, 0x4a, 0xce, 0xa1, 0x9c, 0xe9, 0xea, 0x2e, 0xf1, 0x60,
+	0x23, 0x3f, 0x6d, 0xba, 0x3b, 0xc4, 0xc3, 0x75, 0x34, 0xb3, 0xdf, 0xb4, 0x0f, 0x59, 0x08, 0xd2,
+	0xd9, 0x2d, 0xf3, 0x4b, 0xec, 0xe1, 0xd1, 0x2c, 0xea, 0xcc, 0xbe, 0x0c, 0x42, 0xfd, 0xb6, 0x58,
+	0x95, 0xfd, 0xab, 0xe0, 0x27, 0xc4, 0xa1, 0x49, 0xe8, 0xff, 0xfd, 0xc5, 0xfb, 0xa0, 0xdb, 0x1f,
+	0xf5, 0xfb, 0x0a, 0x5a, 0x4c, 0x04, 0x0b, 0xd6, 0xfc, 0x22, 0x9a, 0x01, 0x26, 0xcf, 0xa1, 0x0b,
+	0x3c, 0xfd, 0x5a, 0xf2, 0x65, 0x2b, 0x30, 0xd1, 0xa6, 0xed, 0x00, 0xcf, 0xe1, 0xbd, 0x6b, 0x1c,
+	0x49, 0x6f, 0x49, 0x41, 0x91, 0xc3, 0x7a, 0x6a, 0xa3, 0xf9, 0x20, 0x28, 0xcc, 0x0c, 0x37, 0xaa,
+	0x89, 0x4f, 0xf5, 0x1f, 0xc5, 0x14, 0xc7, 0x48, 0x07, 0xa3, 0xbd, 0x8f, 0xa6, 0x83, 0x46, 0x4b,
+	0xbb, 0x46, 0x0e, 0xb2, 0x38, 0x13, 0xb0, 0xd9, 0x4f, 0xfb, 0x51, 0x6e, 0x4f, 0x52, 0x65, 0xc5,
+	0x6e, 0xb5, 0x6d, 0x97, 0x0c, 0xfd, 0x45, 0xf5, 0x6b, 0x23, 0xe0, 0x65, 0x71, 0x42, 0x86, 0xf5,
+	0x78, 0xf9, 0x73, 0xec, 0x65, 0x83, 0xb1, 0xd6, 0x79, 0x33, 0x98, 0x28, 0xf6, 0x4d, 0x20, 0x84,
+	0x62, 0xba, 0x16, 0xf8, 0xc6, 0x3a, 0x3a, 0x17, 0x67, 0x74, 0xfe, 0x58, 0x72, 0x4c, 0xab, 0x9f,
+	0x8d, 0x5a, 0xdd, 0xf5, 0x13, 0x15, 0x1e, 0xc6, 0xd6, 0xcd, 0x7d, 0x52, 0xeb, 0xd6, 0x9a, 0xd9,
+	0x13, 0x95, 0x2f, 0x41, 0xa2, 0x12, 0xa1, 0x07, 0x73, 0x3e, 0x44, 0xa7, 0x9d, 0x4e, 0x93, 0xa4,
+	0x2e, 0xd5, 0x3e, 0x55, 0xa7, 0x49, 0xa0, 0xee, 0x80, 0x53, 0xa9, 0xdf, 0x10, 0x71, 0xa1, 0xec,
+	0x7a, 0x66, 0xcb, 0xf0, 0xc8, 0x0e, 0xa7, 0x59, 0xb1, 0xdd, 0xec, 0x7e, 0x11, 0x3e, 0xe7, 0x8e,
+	0x44, 0xce, 0xb9, 0xf8, 0x0a, 0x42, 0x2c, 0xe1, 0x7e, 0xd6, 0xb1, 0x3d, 0x03, 0x0e, 0xc2, 0x13,
+	0xb4, 0x85, 0x26, 0x60, 0x06, 0x2e, 0xa0, 0x7c, 0xbd, 0xe3, 0xf0, 0x60, 0x31, 0xc6, 0xb3, 0x2d,
+	0xf1, 0xad, 0x7e, 0x27, 0x8f, 0xae, 0x26, 0x43, 0x04, 0x33, 0x2c, 0xa2, 0xc9, 0xda, 0x81, 0xe1,
+	0x34, 0x08, 0x47, 0xa0, 0x30, 0x01, 0x88, 0x37, 0x31, 0x00, 0xd7, 0xd1, 0x4c, 0xcb, 0xb4, 0x74,
+	0x79, 0x10, 0x87, 0x79, 0xa6, 0x65, 0x5a, 0x2b, 0xfd, 0x71, 0xd7, 0xd0, 0x94, 0x43, 0x5c, 0xe2,
+	0x3c, 0x27, 0xba, 0x67, 0xb6, 0xfc, 0x33, 0x3b, 0xb4, 0xd1, 0x1c, 0x91, 0x9e, 0x12, 0x83, 0x89,
+	0x3d, 0x4b, 0x75, 0xc7, 0x86, 0xb0, 0xc3, 0xf8, 0xf7, 0x0b, 0x94, 0x2d, 0x3b, 0xac, 0x58, 0x68,
+	0x2e, 0x9c, 0xcf, 0xbf, 0xe4, 0x09, 0x29, 0x2a, 0x0d, 0xbb, 0x81, 0x5c, 0x9e, 0xc9, 0xeb, 0xa0,
+	0xf9, 0x60, 0x1a, 0x2f, 0xc9, 0xcc, 0x0d, 0x41, 0xe6, 0xb9, 0xe7, 0x52, 0x26, 0xdf, 0x17, 0xfb,
+	0x11, 0x62, 0xce, 0xc0, 0xe5, 0x8c, 0x0f, 0x41, 0x4e, 0x9e, 0xb2, 0x63, 0xac, 0x5d, 0x74, 0x21,
+	0x74, 0x30, 0xf1, 0x05, 0xe5, 0x87, 0x20, 0x68, 0x4e, 0x56, 0x48, 0x13, 0x42, 0x7f, 0x01, 0x21,
+	0xcf, 0xf6, 0x8c, 0xe6, 0xcb, 0x9e, 0x82, 0xa2, 0x72, 0x26, 0x18, 0x3f, 0xc6, 0xfc, 0x43, 0x94,
+	0x6f, 0xda, 0xb5, 0xa7, 0xfa, 0x3e, 0x21, 0xf3, 0x68, 0x08, 0xac, 0xc7, 0x9b, 0xfc, 0xf2, 0x89,
+	0x3a, 0x36, 0x31, 0x9c, 0x66, 0x57, 0xaf, 0x93, 0x26, 0x61, 0x4f, 0x45, 0x54, 0xc4, 0xe4, 0x30,
+	0x1c, 0x9b, 0xf1, 0x5d, 0x05, 0xb6, 0x54, 0x96, 0x6f, 0xa1, 0x9a, 0xed, 0x7a, 0xf3, 0x53, 0x43,
+	0xb3, 0x10, 0x8d, 0x0a, 0x6a, 0x13, 0x8e, 0x1e, 0x74, 0x3e, 0x88, 0xc3, 0x42, 0x4c, 0xe6, 0x60,
+	0x76, 0x1b, 0xe5, 0x1c, 0x46, 0x36, 0x30, 0xbd, 0x85, 0x71, 0xea, 0x2f, 0xc1, 0xa1, 0x21, 0x20,
+	0x0d, 0xe2, 0xd2, 0x32, 0x0d, 0x27, 0xb4, 0x19, 0x22, 0x1f, 0xdf, 0xee, 0x16, 0xe3, 0xa2, 0xb4,
+	0x4c, 0x3e, 0xe9, 0xf4, 0x3f, 0xd4, 0x4b, 0xe8, 0xa2, 0x9c, 0x17, 0xbf, 0x4f, 0x8c, 0xa6, 0x77,
+	0x20, 0x6a, 0xe4, 0x3a, 0xe2, 0x9e, 0x38, 0xd8, 0xe9, 0x6f, 0xb6, 0x39, 0x87, 0xb4, 0x6d, 0xc7,
+	0xf3, 0x6f, 0xf7, 0x63, 0xeb, 0xd1, 0x02, 0xa4, 0x74, 0xb8, 0x06, 0x64, 0xf8, 0x3c, 0xca, 0x1d,
+	0x10, 0xb3, 0x71, 0x20, 0x2e, 0x37, 0xe1, 0xeb, 0xe6, 0x4f, 0x46, 0xd0, 0x85, 0x84, 0x97, 0x33,
+	0x5c, 0x40, 0xe7, 0xab, 0xda, 0xd2, 0x4a, 0x59, 0xdf, 0xa9, 0x96, 0xb7, 0xf5, 0xdd, 0xcd, 0x9d,
+	0xed, 0xf2, 0x4a, 0x65, 0xad, 0x52, 0x5e, 0x9d, 0x3d, 0x15, 0xea, 0xdb, 0xde, 0x5d, 0x5e, 0xaf,
+	0xac, 0xe8, 0x5a, 0x79, 0x69, 0x75, 0x56, 0xc1, 0xf3, 0x68, 0x4e, 0xea, 0x5b, 0xda, 0xdc, 0xda,
+	0xfc, 0x68, 0x63, 0x6b, 0x77, 0x67, 0x76, 0x04, 0xcf, 0xa1, 0x59, 0xa9, 0x67, 0xeb, 0xc3, 0xcd,
+	0xb2, 0x36, 0x3b, 0x8a, 0xaf, 0xa0, 0x8b, 0xf2, 0xf8, 0x95, 0x95, 0xad, 0xdd, 0xcd, 0xaa, 0xbe,
+	0xbd, 0xb5, 0x5e, 0x59, 0xf9, 0x68, 0x76, 0x0c, 0x5f, 0x42, 0x17, 0xa4, 0xee, 0xc7, 0xda, 0xd6,
+	0xee, 0xb6, 0xe8, 0x3c, 0x1d, 0xa2, 0xe5, 0xcd, 0x7a, 0xf9, 0xe7, 0xb7, 0x2b, 0x5a, 0x79, 0x75,
+	0x36, 0x87, 0xaf, 0xa2, 0xcb, 0x52, 0xf7, 0x4e, 0x75, 0xa9, 0x5a, 0xde, 0x28, 0x6f, 0x56, 0xfd,
+	0x11, 0xe3, 0xf8, 0x15, 0xb4, 0x18, 0xe1, 0xbe, 0x51, 0xde, 0x58, 0x2e, 0x6b, 0xfa, 0x46, 0x65,
+	0x67, 0xa7, 0xb2, 0xf9, 0x78, 0x36, 0x1f, 0xc2, 0xbd, 0x56, 0xd9, 0x5c, 0x5a, 0x9f, 0x9d, 0x08,
+	0x91, 0x52, 0xe5, 0xcb, 0x9a, 0xfe, 0xc1, 0xee, 0x56, 0x75, 0xc9, 0x27, 0x45, 0x85, 0xb1, 0xaf,
+	0x7e, 0x73, 0xe1, 0xd4, 0xdd, 0x7f, 0xbf, 0x8b, 0x4e, 0xb3, 0xe9, 0xc5, 0x3d, 0x94, 0xe3, 0x75,
+	0x83, 0xf8, 0x7a, 0xe2, 0xb9, 0x33, 0x50, 0x3d, 0x59, 0x78, 0x6d, 0xe0, 0x38, 0xee, 0x24, 0xaa,
+	0xfa, 0x6b, 0xff, 0xf6, 0x93, 0xaf, 0x8f, 0x5c, 0xc6, 0x85, 0x52, 0x62, 0x31, 0x28, 0xfe, 0x0b,
+	0xf1, 0xc8, 0x15, 0xa9, 0x7d, 0xc4, 0x77, 0x06, 0xc8, 0x89, 0x96, 0x59, 0x16, 0xee, 0x1e, 0x87,
+	0x04, 0x50, 0x16, 0x19, 0xca, 0x1b, 0xf8, 0x7a, 0x32, 0xca, 0xd2, 0x91, 0x5f, 0xab, 0xd9, 0xc3,
+	0xbf, 0xa7, 0x20, 0xd4, 0xbf, 0xa7, 0xc6, 0x37, 0x13, 0x45, 0x46, 0x2a, 0x2e, 0x0b, 0xaf, 0x67,
+	0x1a, 0x0b, 0xb8, 0xee, 0x31, 0x5c, 0x25, 0x7c, 0x2b, 0x0e, 0xd7, 0x01, 0xdd, 0x6b, 0x78, 0x70,
+	0x29, 0x1d, 0x49, 0x71, 0xa7, 0x87, 0xff, 0x54, 0x41, 0xd3, 0xc1, 0x82, 0x4d, 0x5c, 0xcc, 0x20,
+	0x56, 0xba, 0xca, 0x38, 0x1e, 0xcc, 0xfb, 0x0c, 0xe6, 0x9b, 0xf8, 0xce, 0x00, 0x98, 0xfa, 0x5e,
+	0x57, 0x37, 0xeb, 0x3e, 0x58, 0xb3, 0xde, 0xc3, 0xbf, 0xad, 0xa0, 0x33, 0x7d, 0x8e, 0x9b, 0x6b,
+	0x55, 0xfc, 0x4a, 0xa2, 0xe4, 0x7e, 0x15, 0x51, 0x21, 0xd9, 0xe2, 0x91, 0xe2, 0x21, 0xf5, 0x0b,
+	0x0c, 0xdd, 0x6d, 0x5c, 0x1c, 0x84, 0xce, 0xda, 0xf7, 0x4a, 0x47, 0xa2, 0x38, 0xa9, 0x87, 0xbf,
+	0x0d, 0x93, 0x0c, 0xd9, 0x7c, 0xfa, 0x24, 0x07, 0x4e, 0x3b, 0x03, 0xac, 0x17, 0x3c, 0xb4, 0xa8,
+	0x2b, 0x0c, 0xdf, 0x43, 0xfc, 0x20, 0x11, 0x1f, 0x3f, 0x89, 0x04, 0x27, 0xb9, 0x74, 0x24, 0x1d,
+	0x97, 0xfa, 0x53, 0xde, 0xaf, 0x35, 0x1d, 0x30, 0xe5, 0x91, 0xa2, 0xd4, 0xe3, 0x81, 0x1e, 0x3c,
+	0xe5, 0x00, 0x0f, 0xa6, 0xdc, 0x2f, 0x77, 0xed, 0xe1, 0xbf, 0x53, 0xd0, 0x6c, 0xb8, 0x7a, 0x13,
+	0xdf, 0x4e, 0x15, 0x1e, 0x53, 0x06, 0x5b, 0xb8, 0x73, 0x0c, 0x0a, 0x00, 0xfd, 0x45, 0x06, 0x7a,
+	0x15, 0x2f, 0x27, 0x82, 0x76, 0x19, 0x59, 0x16, 0x83, 0x0b, 0xc7, 0xf5, 0x2b, 0xca, 0x4e, 0xea,
+	0xb8, 0x91, 0xd2, 0xb4, 0x0c, 0x8e, 0x2b, 0x10, 0x05, 0x1d, 0xf7, 0x37, 0x14, 0x34, 0x29, 0x95,
+	0x94, 0xe2, 0xe4, 0x89, 0x8d, 0x16, 0xb7, 0x16, 0xde, 0xc8, 0x36, 0x18, 0x20, 0xde, 0x60, 0x10,
+	0x55, 0x7c, 0x35, 0x0e, 0x62, 0xd3, 0x74, 0x3d, 0x58, 0x5b, 0x2e, 0xfe, 0x06, 0x80, 0x82, 0xba,
+	0xc6, 0x01, 0xa0, 0x82, 0x45, 0xa6, 0x03, 0x40, 0x85, 0x4a, 0x25, 0xd3, 0xed, 0xc6, 0x40, 0x71,
+	0xbb, 0xb9, 0xa1, 0xb0, 0xf9, 0x37, 0x0a, 0x3a, 0x17, 0x5b, 0x05, 0x8a, 0xef, 0x65, 0x91, 0x1f,
+	0xa9, 0x1a, 0x3d, 0x26, 0xec, 0x25, 0x06, 0xfb, 0x01, 0xbe, 0x3f, 0x08, 0x36, 0x5d, 0x53, 0x7e,
+	0x08, 0x0d, 0x44, 0xd3, 0xdf, 0x54, 0xd0, 0x94, 0x5f, 0x3b, 0x90, 0xd9, 0x27, 0x3f, 0x9f, 0x7e,
+	0xd9, 0x2c, 0xbb, 0xe4, 0xe0, 0x0d, 0x09, 0x2e, 0xd0, 0x83, 0x1e, 0xf9, 0x4f, 0x0a, 0x94, 0xe4,
+	0x84, 0xeb, 0x08, 0x53, 0xd6, 0x7d, 0x42, 0xd5, 0x63, 0xca, 0xba, 0x4f, 0x2a, 0x52, 0x54, 0x37,
+	0x18, 0xea, 0xc7, 0xb8, 0x1c, 0xbb, 0xbd, 0xf3, 0x8a, 0x81, 0x7d, 0xdb, 0x11, 0x77, 0xd7, 0xa5,
+	0x23, 0x51, 0xef, 0xd0, 0x2b, 0x1d, 0x45, 0xaa, 0x28, 0x7b, 0xf8, 0x9f, 0x15, 0x34, 0x1b, 0xae,
+	0xed, 0x4b, 0x51, 0x24, 0xa1, 0xc4, 0x31, 0x45, 0x91, 0xa4, 0xc2, 0x41, 0xb5, 0xca, 0x14, 0xd9,
+	0xc4, 0xeb, 0x71, 0x8a, 0x3c, 0x67, 0x54, 0xba, 0xf4, 0x9f, 0x71, 0x8e, 0x44, 0x89, 0x5f, 0x2f,
+	0x1c, 0xca, 0xa4, 0x6a, 0xbd, 0x1e, 0xfe, 0x57, 0x05, 0x7d, 0x2e, 0x52, 0x74, 0x97, 0x92, 0x7a,
+	0x25, 0x95, 0x1f, 0xa6, 0xa4, 0x5e, 0x89, 0x35, 0x7d, 0xea, 0x2e, 0x53, 0x69, 0x0b, 0x6f, 0xc4,
+	0xa9, 0x44, 0x38, 0xd9, 0x4b, 0xe8, 0xf4, 0x47, 0x0a, 0x9a, 0xf0, 0x57, 0x02, 0xfe, 0x7c, 0xea,
+	0x5e, 0x21, 0x57, 0xbf, 0x14, 0x6e, 0x66, 0x19, 0x9a, 0x65, 0xc5, 0xf6, 0x57, 0x43, 0xe9, 0x48,
+	0x7a, 0x90, 0xea, 0x89, 0x2f, 0x1e, 0x73, 0x68, 0x26, 0xd9, 0xaf, 0x9e, 0x4a, 0x49, 0x32, 0x22,
+	0x05, 0x60, 0x85, 0xd7, 0x33, 0x8d, 0xcd, 0xb2, 0x70, 0x59, 0x70, 0xe1, 0x77, 0x93, 0x41, 0xac,
+	0xf8, 0x9b, 0x0a, 0x9a, 0x09, 0x15, 0x23, 0xe1, 0xd2, 0x60, 0x0b, 0x05, 0x2a, 0xac, 0x0a, 0xb7,
+	0xb3, 0x13, 0x00, 0xda, 0x5b, 0x0c, 0xed, 0x6b, 0xf8, 0x67, 0x06, 0x84, 0x19, 0x28, 0xc8, 0xfa,
+	0x7b, 0x51, 0x88, 0x13, 0x2c, 0x34, 0x4a, 0xc9, 0x80, 0x62, 0x2b, 0x9f, 0x0a, 0xa5, 0xcc, 0xe3,
+	0x01, 0xe7, 0x3a, 0xc3, 0xb9, 0x86, 0x57, 0x07, 0x04, 0x16, 0x70, 0x83, 0xd8, 0xb0, 0x22, 0x5e,
+	0x0c, 0x7b, 0x74, 0x8b, 0x9c, 0x09, 0x95, 0x28, 0xa5, 0x38, 0x44, 0xa4, 0xfc, 0x29, 0xc5, 0x21,
+	0xa2, 0x35, 0x4f, 0xea, 0x5b, 0x0c, 0x7a, 0x11, 0xbf, 0x91, 0x02, 0x1d, 0x72, 0x37, 0xbf, 0xa6,
+	0xaa, 0x87, 0x7f, 0x5d, 0x41, 0x53, 0x72, 0x4d, 0x11, 0x4e, 0x3e, 0x08, 0x06, 0x8b, 0xa2, 0x0a,
+	0x37, 0x06, 0x0f, 0x04, 0x64, 0xaf, 0x32, 0x64, 0x0b, 0xf8, 0x72, 0xac, 0xab, 0xc2, 0xe5, 0x14,
+	0xfe, 0x4b, 0xf0, 0x4c, 0xa9, 0x54, 0x68, 0x80, 0x67, 0x46, 0x8b, 0x92, 0x06, 0x78, 0x66, 0x4c,
+	0x15, 0x92, 0xfa, 0x80, 0x81, 0xbb, 0x87, 0xdf, 0x1c, 0x74, 0x98, 0x60, 0x15, 0x47, 0xa1, 0x04,
+	0xe3, 0xaf, 0x84, 0x9f, 0x06, 0x8b, 0x87, 0x52, 0xfc, 0x34, 0xb6, 0x4a, 0x29, 0xc5, 0x4f, 0xe3,
+	0xab, 0x92, 0xd4, 0x77, 0x18, 0xea, 0xb7, 0xf0, 0xdd, 0x38, 0xd4, 0xa6, 0xcb, 0xcb, 0x38, 0x74,
+	0xa8, 0x54, 0x0a, 0x81, 0xfe, 0xbe, 0x02, 0x65, 0x64, 0xec, 0xc2, 0xa8, 0x5f, 0xce, 0x90, 0x62,
+	0xed, 0xf8, 0xc2, 0x89, 0x14, 0x6b, 0x27, 0x54, 0x4a, 0xa4, 0x5b, 0x9b, 0x5d, 0x7a, 0xe9, 0x50,
+	0x49, 0x41, 0x0f, 0xe7, 0x21, 0xe0, 0xff, 0x20, 0xae, 0x15, 0x22, 0x55, 0x09, 0x29, 0x7b, 0x5b,
+	0x52, 0xd9, 0x45, 0xca, 0xde, 0x96, 0x58, 0xf4, 0xa0, 0xae, 0x32, 0xf8, 0x8f, 0xf0, 0xbb, 0x71,
+	0xf0, 0xe5, 0x08, 0xe6, 0xea, 0xec, 0xd5, 0x5e, 0x04, 0x5f, 0xb3, 0xde, 0x2b, 0x1d, 0x41, 0x4f,
+	0x0f, 0x7f, 0x57, 0x41, 0xb3, 0xe1, 0xa7, 0xff, 0x94, 0xf4, 0x39, 0x5a, 0x12, 0x91, 0x92, 0x87,
+	0xc6, 0x54, 0x13, 0x64, 0x40, 0x1d, 0x82, 0x1b, 0xdd, 0xd7, 0xdc, 0x1e, 0x5d, 0x9f, 0x73, 0x71,
+	0xb5, 0x12, 0x29, 0x6e, 0x13, 0x5f, 0x55, 0x71, 0x4c, 0xf4, 0xa9, 0xae, 0x2e, 0xa3, 0x17, 0xd1,
+	0xcd, 0xaf, 0xd8, 0xe8, 0xe1, 0xaf, 0x8f, 0xa0, 0xeb, 0xd9, 0xaa, 0x04, 0xf0, 0x52, 0xca, 0x2d,
+	0x53, 0xb6, 0xa2, 0x89, 0xc2, 0xf2, 0x49, 0x58, 0x80, 0xb6, 0x7b, 0x4c, 0xdb, 0x5f, 0xc4, 0x1f,
+	0xc7, 0x5f, 0x5c, 0x05, 0x4a, 0x32, 0x44, 0x64, 0x0a, 0x95, 0x2f, 0x94, 0x8e, 0x42, 0xe3, 0x42,
+	0x89, 0x15, 0x4d, 0xde, 0x71, 0xf4, 0x65, 0x1f, 0xdf, 0xcd, 0x70, 0xb8, 0x09, 0xd5, 0x2c, 0x14,
+	0xde, 0x3c, 0x16, 0x4d, 0x96, 0x4d, 0x56, 0x3a, 0x17, 0xf9, 0x95, 0x05, 0xa9, 0xe7, 0x76, 0x9a,
+	0xec, 0x46, 0x5e, 0xdc, 0xf1, 0x9d, 0x0c, 0x77, 0x1f, 0xc1, 0xda, 0x80, 0x94, 0x80, 0x90, 0xf8,
+	0xa0, 0x9f, 0x9e, 0xec, 0xca, 0x27, 0x7a, 0x50, 0x25, 0x4d, 0x93, 0xd2, 0x11, 0x0c, 0xe2, 0x33,
+	0x14, 0x7d, 0x15, 0xc7, 0xe9, 0x08, 0x63, 0xdf, 0xe9, 0x53, 0x66, 0x28, 0xf9, 0xd9, 0x3d, 0x7d,
+	0x86, 0x98, 0x5a, 0xa1, 0x47, 0xf5, 0xd4, 0x19, 0xfa, 0x8e, 0x82, 0x66, 0x42, 0x2f, 0xd2, 0x29,
+	0x41, 0x23, 0xfe, 0xed, 0x3b, 0x65, 0xaf, 0x49, 0x78, 0xec, 0x4e, 0x0f, 0x1c, 0x00, 0xb7, 0x29,
+	0xa8, 0x42, 0x4b, 0xe4, 0x6f, 0x15, 0x74, 0x36, 0xe6, 0x05, 0x19, 0x27, 0x5b, 0x33, 0xf9, 0x49,
+	0xbc, 0xf0, 0xd6, 0xf1, 0x88, 0x00, 0xfe, 0x7b, 0x0c, 0xfe, 0x7d, 0xfc, 0xb3, 0xb1, 0xe7, 0x28,
+	0x20, 0xd4, 0xa1, 0x81, 0x3d, 0x8c, 0x85, 0x74, 0xf8, 0x96, 0x82, 0x26, 0xa5, 0x67, 0xa2, 0x94,
+	0x1d, 0x26, 0xfa, 0xf2, 0x95, 0x12, 0xa3, 0x63, 0x1e, 0xae, 0xd2, 0xb1, 0xca, 0x4f, 0x5a, 0x61,
+	0x3f, 0xe1, 0x7d, 0x3d, 0xfc, 0x3b, 0x0a, 0x3a, 0x13, 0x78, 0x59, 0xc2, 0xb7, 0x06, 0x05, 0xd3,
+	0xc0, 0xcb, 0x56, 0xa1, 0x98, 0x75, 0x38, 0x20, 0xbe, 0xc9, 0x10, 0xbf, 0x8a, 0xd5, 0xb4, 0x38,
+	0x7b, 0xc0, 0x68, 0x96, 0x2b, 0x3f, 0xf8, 0x74, 0x41, 0xf9, 0xe4, 0xd3, 0x05, 0xe5, 0xbf, 0x3f,
+	0x5d, 0x50, 0xbe, 0xf6, 0xd9, 0xc2, 0xa9, 0x4f, 0x3e, 0x5b, 0x38, 0xf5, 0x1f, 0x9f, 0x2d, 0x9c,
+	0xfa, 0xb8, 0x24, 0xbd, 0x3d, 0xee, 0x59, 0x7b, 0xb7, 0x6a, 0x07, 0x86, 0x69, 0xc9, 0x1c, 0x5f,
+	0x04, 0xff, 0x0c, 0xc6, 0x5e, 0x8e, 0xfd, 0x05, 0x8b, 0x37, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff,
+	0xb9, 0x49, 0xcc, 0xdb, 0x40, 0x44, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Parameters queries the parameters of the module.
+	QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
+	// Queries a bucket with specify name.
+	HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
+	// Queries a bucket by id
+	HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
+	// Queries a bucket with EIP712 standard metadata info
+	HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error)
+	// Queries a object with specify name.
+	HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
+	// Queries an object by id
+	HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
+	// Queries a shadow object with specify name.
+	HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error)
+	// Queries a object with EIP712 standard metadata info
+	HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error)
+	// Queries a list of bucket items.
+	ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
+	// Queries a group with EIP712 standard metadata info
+	HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error)
+	// Queries a policy which grants permission to account
+	QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error)
+	// Queries a list of VerifyPermission items.
+	VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error)
+	// Queries the trace of how the permission of the bucket/object's action is decided for the operator
+	ExplainPermission(ctx context.Context, in *QueryExplainPermissionRequest, opts ...grpc.CallOption) (*QueryExplainPermissionResponse, error)
+	// Queries a group with specify owner and name .
+	HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error)
+	// Queries a list of ListGroup items.
+	ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error)
+	// Queries a list of HeadGroupMember items.
+	HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error)
+	// Queries a policy that grants permission to a group
+	QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error)
+	// Queries a policy by policy id
+	QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error)
+	// Queries lock fee for storing an object
+	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
+	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
+	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error)
+	// Queries whether some members are in the group.
+	QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error)
+	// Queries whether some groups are exist.
+	QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
+	// Queries whether some groups are exist by id.
+	QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
+	// Queries the flow rate limit of a bucket for a payment account
+	QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	// Queries the retained versions of an object.
+	ListObjectVersions(ctx context.Context, in *QueryListObjectVersionsRequest, opts ...grpc.CallOption) (*QueryListObjectVersionsResponse, error)
+	// Queries a retained version of an object.
+	HeadObjectVersion(ctx context.Context, in *QueryHeadObjectVersionRequest, opts ...grpc.CallOption) (*QueryHeadObjectVersionResponse, error)
+	// Queries a composed object with its components.
+	HeadComposedObject(ctx context.Context, in *QueryHeadComposedObjectRequest, opts ...grpc.CallOption) (*QueryHeadComposedObjectResponse, error)
+	// Queries the lifecycle rules of a bucket.
+	BucketLifecycle(ctx context.Context, in *QueryBucketLifecycleRequest, opts ...grpc.CallOption) (*QueryBucketLifecycleResponse, error)
+	// Estimates the cost of storing an object in a bucket for a duration, including the flow rates, the lock fee and the
+	// early deletion fee.
+	EstimateStorageCost(ctx context.Context, in *QueryEstimateStorageCostRequest, opts ...grpc.CallOption) (*QueryEstimateStorageCostResponse, error)
+	// Queries the read quota a reader bought for a requester-pays bucket.
+	ReaderQuota(ctx context.Context, in *QueryReaderQuotaRequest, opts ...grpc.CallOption) (*QueryReaderQuotaResponse, error)
+	// Checks the payment data of all buckets and objects against the stream records, it is expensive and meant for
+	// node operators to investigate the payment data at a height. It is only served by the nodes enabling
+	// payment-check.query-enabled in app.toml.
+	PaymentHealth(ctx context.Context, in *QueryPaymentHealthRequest, opts ...grpc.CallOption) (*QueryPaymentHealthResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryQuotaUpdateTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, req.(*QueryQuoteUpdateTimeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGroupMembersExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupMembersExistRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
+	out := new(QueryParamsByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryParamsByTimestamp", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupMembersExist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryGroupMembersExist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupMembersExist(ctx, req.(*QueryGroupMembersExistRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGroupsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsExistRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
+	out := new(QueryHeadBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupsExist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryGroupsExist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupsExist(ctx, req.(*QueryGroupsExistRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGroupsExistById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsExistByIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
+	out := new(QueryHeadBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketById", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupsExistById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryGroupsExistById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupsExistById(ctx, req.(*QueryGroupsExistByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPaymentAccountBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountBucketFlowRateLimitRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error) {
+	out := new(QueryBucketNFTResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketNFT", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
+	out := new(QueryHeadObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit",
+	return out, nil
+}
+
+func (c *queryClient) HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
+	out := new(QueryHeadObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, req.(*QueryPaymentAccountBucketFlowRateLimitRequest))
+	return out, nil
+}
+
+func (c *queryClient) HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error) {
+	out := new(QueryHeadShadowObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadShadowObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.storage.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryParamsByTimestamp",
-			Handler:    _Query_QueryParamsByTimestamp_Handler,
-		},
-		{
-			MethodName: "HeadBucket",
-			Handler:    _Query_HeadBucket_Handler,
-		},
-		{
-			MethodName: "HeadBucketById",
-			Handler:    _Query_HeadBucketById_Handler,
-		},
-		{
-			MethodName: "HeadBucketNFT",
-			Handler:    _Query_HeadBucketNFT_Handler,
-		},
-		{
-			MethodName: "HeadObject",
-			Handler:    _Query_HeadObject_Handler,
-		},
-		{
-			MethodName: "HeadObjectById",
-			Handler:    _Query_HeadObjectById_Handler,
-		},
-		{
-			MethodName: "HeadShadowObject",
-			Handler:    _Query_HeadShadowObject_Handler,
-		},
-		{
-			MethodName: "HeadObjectNFT",
-			Handler:    _Query_HeadObjectNFT_Handler,
-		},
-		{
-			MethodName: "ListBuckets",
-			Handler:    _Query_ListBuckets_Handler,
-		},
-		{
-			MethodName: "ListObjects",
-			Handler:    _Query_ListObjects_Handler,
-		},
-		{
-			MethodName: "ListObjectsByBucketId",
-			Handler:    _Query_ListObjectsByBucketId_Handler,
-		},
-		{
-			MethodName: "HeadGroupNFT",
-			Handler:    _Query_HeadGroupNFT_Handler,
-		},
-		{
-			MethodName: "QueryPolicyForAccount",
-			Handler:    _Query_QueryPolicyForAccount_Handler,
-		},
-		{
-			MethodName: "VerifyPermission",
-			Handler:    _Query_VerifyPermission_Handler,
-		},
-		{
-			MethodName: "HeadGroup",
-			Handler:    _Query_HeadGroup_Handler,
-		},
-		{
-			MethodName: "ListGroups",
-			Handler:    _Query_ListGroups_Handler,
-		},
-		{
-			MethodName: "HeadGroupMember",
-			Handler:    _Query_HeadGroupMember_Handler,
-		},
-		{
-			MethodName: "QueryPolicyForGroup",
-			Handler:    _Query_QueryPolicyForGroup_Handler,
-		},
-		{
-			MethodName: "QueryPolicyById",
-			Handler:    _Query_QueryPolicyById_Handler,
-		},
-		{
-			MethodName: "QueryLockFee",
-			Handler:    _Query_QueryLockFee_Handler,
-		},
-		{
-			MethodName: "HeadBucketExtra",
-			Handler:    _Query_HeadBucketExtra_Handler,
-		},
-		{
-			MethodName: "QueryIsPriceChanged",
-			Handler:    _Query_QueryIsPriceChanged_Handler,
-		},
-		{
-			MethodName: "QueryQuotaUpdateTime",
-			Handler:    _Query_QueryQuotaUpdateTime_Handler,
-		},
-		{
-			MethodName: "QueryGroupMembersExist",
-			Handler:    _Query_QueryGroupMembersExist_Handler,
-		},
-		{
-			MethodName: "QueryGroupsExist",
-			Handler:    _Query_QueryGroupsExist_Handler,
-		},
-		{
-			MethodName: "QueryGroupsExistById",
-			Handler:    _Query_QueryGroupsExistById_Handler,
-		},
-		{
-			MethodName: "QueryPaymentAccountBucketFlowRateLimit",
-			Handler:    _Query_QueryPaymentAccountBucketFlowRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/storage/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error) {
+	out := new(QueryObjectNFTResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
+	out := new(QueryListBucketsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListBuckets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjectsByBucketId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error) {
+	out := new(QueryGroupNFTResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error) {
+	out := new(QueryPolicyForAccountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error) {
+	out := new(QueryVerifyPermissionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/VerifyPermission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExplainPermission(ctx context.Context, in *QueryExplainPermissionRequest, opts ...grpc.CallOption) (*QueryExplainPermissionResponse, error) {
+	out := new(QueryExplainPermissionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ExplainPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error) {
+	out := new(QueryHeadGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error) {
+	out := new(QueryListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListGroups", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryHeadBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error) {
+	out := new(QueryHeadGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error) {
+	out := new(QueryPolicyForGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyForGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error) {
+	out := new(QueryPolicyByIdResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPolicyById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeadBucketByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketId) > 0 {
-		i -= len(m.BucketId)
-		copy(dAtA[i:], m.BucketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error) {
+	out := new(QueryLockFeeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryLockFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error) {
+	out := new(QueryHeadBucketExtraResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadBucketExtra", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
+	out := new(QueryIsPriceChangedResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryIsPriceChanged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeadBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExtraInfo != nil {
-		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BucketInfo != nil {
-		{
-			size, err := m.BucketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error) {
+	out := new(QueryQuoteUpdateTimeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryQuotaUpdateTime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error) {
+	out := new(QueryGroupMembersExistResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupMembersExist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
+	out := new(QueryGroupsExistResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeadObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
+	out := new(QueryGroupsExistResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryGroupsExistById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadObjectByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	out := new(QueryPaymentAccountBucketFlowRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadObjectByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListObjectVersions(ctx context.Context, in *QueryListObjectVersionsRequest, opts ...grpc.CallOption) (*QueryListObjectVersionsResponse, error) {
+	out := new(QueryListObjectVersionsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ListObjectVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeadObjectByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectId) > 0 {
-		i -= len(m.ObjectId)
-		copy(dAtA[i:], m.ObjectId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) HeadObjectVersion(ctx context.Context, in *QueryHeadObjectVersionRequest, opts ...grpc.CallOption) (*QueryHeadObjectVersionResponse, error) {
+	out := new(QueryHeadObjectVersionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadObjectVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadShadowObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeadComposedObject(ctx context.Context, in *QueryHeadComposedObjectRequest, opts ...grpc.CallOption) (*QueryHeadComposedObjectResponse, error) {
+	out := new(QueryHeadComposedObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/HeadComposedObject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadShadowObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BucketLifecycle(ctx context.Context, in *QueryBucketLifecycleRequest, opts ...grpc.CallOption) (*QueryBucketLifecycleResponse, error) {
+	out := new(QueryBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/BucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeadShadowObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EstimateStorageCost(ctx context.Context, in *QueryEstimateStorageCostRequest, opts ...grpc.CallOption) (*QueryEstimateStorageCostResponse, error) {
+	out := new(QueryEstimateStorageCostResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/EstimateStorageCost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ReaderQuota(ctx context.Context, in *QueryReaderQuotaRequest, opts ...grpc.CallOption) (*QueryReaderQuotaResponse, error) {
+	out := new(QueryReaderQuotaResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/ReaderQuota", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeadObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentHealth(ctx context.Context, in *QueryPaymentHealthRequest, opts ...grpc.CallOption) (*QueryPaymentHealthResponse, error) {
+	out := new(QueryPaymentHealthResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/PaymentHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeadObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		{
-			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ObjectInfo != nil {
-		{
-			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Parameters queries the parameters of the module.
+	QueryParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	// Queries a bucket with specify name.
+	HeadBucket(context.Context, *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error)
+	// Queries a bucket by id
+	HeadBucketById(context.Context, *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error)
+	// Queries a bucket with EIP712 standard metadata info
+	HeadBucketNFT(context.Context, *QueryNFTRequest) (*QueryBucketNFTResponse, error)
+	// Queries a object with specify name.
+	HeadObject(context.Context, *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error)
+	// Queries an object by id
+	HeadObjectById(context.Context, *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error)
+	// Queries a shadow object with specify name.
+	HeadShadowObject(context.Context, *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error)
+	// Queries a object with EIP712 standard metadata info
+	HeadObjectNFT(context.Context, *QueryNFTRequest) (*QueryObjectNFTResponse, error)
+	// Queries a list of bucket items.
+	ListBuckets(context.Context, *QueryListBucketsRequest) (*QueryListBucketsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjects(context.Context, *QueryListObjectsRequest) (*QueryListObjectsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjectsByBucketId(context.Context, *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error)
+	// Queries a group with EIP712 standard metadata info
+	HeadGroupNFT(context.Context, *QueryNFTRequest) (*QueryGroupNFTResponse, error)
+	// Queries a policy which grants permission to account
+	QueryPolicyForAccount(context.Context, *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error)
+	// Queries a list of VerifyPermission items.
+	VerifyPermission(context.Context, *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error)
+	// Queries the trace of how the permission of the bucket/object's action is decided for the operator
+	ExplainPermission(context.Context, *QueryExplainPermissionRequest) (*QueryExplainPermissionResponse, error)
+	// Queries a group with specify owner and name .
+	HeadGroup(context.Context, *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error)
+	// Queries a list of ListGroup items.
+	ListGroups(context.Context, *QueryListGroupsRequest) (*QueryListGroupsResponse, error)
+	// Queries a list of HeadGroupMember items.
+	HeadGroupMember(context.Context, *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error)
+	// Queries a policy that grants permission to a group
+	QueryPolicyForGroup(context.Context, *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error)
+	// Queries a policy by policy id
+	QueryPolicyById(context.Context, *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error)
+	// Queries lock fee for storing an object
+	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
+	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
+	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryQuotaUpdateTime(context.Context, *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error)
+	// Queries whether some members are in the group.
+	QueryGroupMembersExist(context.Context, *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error)
+	// Queries whether some groups are exist.
+	QueryGroupsExist(context.Context, *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error)
+	// Queries whether some groups are exist by id.
+	QueryGroupsExistById(context.Context, *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error)
+	// Queries the flow rate limit of a bucket for a payment account
+	QueryPaymentAccountBucketFlowRateLimit(context.Context, *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	// Queries the retained versions of an object.
+	ListObjectVersions(context.Context, *QueryListObjectVersionsRequest) (*QueryListObjectVersionsResponse, error)
+	// Queries a retained version of an object.
+	HeadObjectVersion(context.Context, *QueryHeadObjectVersionRequest) (*QueryHeadObjectVersionResponse, error)
+	// Queries a composed object with its components.
+	HeadComposedObject(context.Context, *QueryHeadComposedObjectRequest) (*QueryHeadComposedObjectResponse, error)
+	// Queries the lifecycle rules of a bucket.
+	BucketLifecycle(context.Context, *QueryBucketLifecycleRequest) (*QueryBucketLifecycleResponse, error)
+	// Estimates the cost of storing an object in a bucket for a duration, including the flow rates, the lock fee and the
+	// early deletion fee.
+	EstimateStorageCost(context.Context, *QueryEstimateStorageCostRequest) (*QueryEstimateStorageCostResponse, error)
+	// Queries the read quota a reader bought for a requester-pays bucket.
+	ReaderQuota(context.Context, *QueryReaderQuotaRequest) (*QueryReaderQuotaResponse, error)
+	// Checks the payment data of all buckets and objects against the stream records, it is expensive and meant for
+	// node operators to investigate the payment data at a height. It is only served by the nodes enabling
+	// payment-check.query-enabled in app.toml.
+	PaymentHealth(context.Context, *QueryPaymentHealthRequest) (*QueryPaymentHealthResponse, error)
 }
 
-func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryHeadShadowObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryHeadShadowObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObjectInfo != nil {
-		{
-			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParamsByTimestamp not implemented")
 }
-
-func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) HeadBucket(ctx context.Context, req *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucket not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketById(ctx context.Context, req *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketById not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketNFT(ctx context.Context, req *QueryNFTRequest) (*QueryBucketNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketNFT not implemented")
+}
+func (*UnimplementedQueryServer) HeadObject(ctx context.Context, req *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
+}
+func (*UnimplementedQueryServer) HeadObjectById(ctx context.Context, req *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectById not implemented")
+}
+func (*UnimplementedQueryServer) HeadShadowObject(ctx context.Context, req *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadShadowObject not implemented")
+}
+func (*UnimplementedQueryServer) HeadObjectNFT(ctx context.Context, req *QueryNFTRequest) (*QueryObjectNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectNFT not implemented")
+}
+func (*UnimplementedQueryServer) ListBuckets(ctx context.Context, req *QueryListBucketsRequest) (*QueryListBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
+}
+func (*UnimplementedQueryServer) ListObjects(ctx context.Context, req *QueryListObjectsRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (*UnimplementedQueryServer) ListObjectsByBucketId(ctx context.Context, req *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByBucketId not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroupNFT(ctx context.Context, req *QueryNFTRequest) (*QueryGroupNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupNFT not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyForAccount(ctx context.Context, req *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForAccount not implemented")
+}
+func (*UnimplementedQueryServer) VerifyPermission(ctx context.Context, req *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPermission not implemented")
+}
+func (*UnimplementedQueryServer) ExplainPermission(ctx context.Context, req *QueryExplainPermissionRequest) (*QueryExplainPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroup(ctx context.Context, req *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroup not implemented")
+}
+func (*UnimplementedQueryServer) ListGroups(ctx context.Context, req *QueryListGroupsRequest) (*QueryListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroupMember(ctx context.Context, req *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupMember not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyForGroup(ctx context.Context, req *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForGroup not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyById(ctx context.Context, req *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyById not implemented")
+}
+func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLockFeeRequest) (*QueryLockFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLockFee not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
+}
+func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
+}
+func (*UnimplementedQueryServer) QueryQuotaUpdateTime(ctx context.Context, req *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuotaUpdateTime not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupMembersExist(ctx context.Context, req *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupMembersExist not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupsExist(ctx context.Context, req *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExist not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupsExistById(ctx context.Context, req *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExistById not implemented")
+}
+func (*UnimplementedQueryServer) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, req *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAccountBucketFlowRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) ListObjectVersions(ctx context.Context, req *QueryListObjectVersionsRequest) (*QueryListObjectVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectVersions not implemented")
+}
+func (*UnimplementedQueryServer) HeadObjectVersion(ctx context.Context, req *QueryHeadObjectVersionRequest) (*QueryHeadObjectVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectVersion not implemented")
+}
+func (*UnimplementedQueryServer) HeadComposedObject(ctx context.Context, req *QueryHeadComposedObjectRequest) (*QueryHeadComposedObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadComposedObject not implemented")
+}
+func (*UnimplementedQueryServer) BucketLifecycle(ctx context.Context, req *QueryBucketLifecycleRequest) (*QueryBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BucketLifecycle not implemented")
+}
+func (*UnimplementedQueryServer) EstimateStorageCost(ctx context.Context, req *QueryEstimateStorageCostRequest) (*QueryEstimateStorageCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateStorageCost not implemented")
+}
+func (*UnimplementedQueryServer) ReaderQuota(ctx context.Context, req *QueryReaderQuotaRequest) (*QueryReaderQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReaderQuota not implemented")
+}
+func (*UnimplementedQueryServer) PaymentHealth(ctx context.Context, req *QueryPaymentHealthRequest) (*QueryPaymentHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentHealth not implemented")
 }
 
-func (m *QueryListBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryListBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByTimestampRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParamsByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryParamsByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucket(ctx, req.(*QueryHeadBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_HeadBucketById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BucketInfos) > 0 {
-		for iNdEx := len(m.BucketInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BucketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketById(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadBucketById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketById(ctx, req.(*QueryHeadBucketByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadBucketNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadBucketNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObject(ctx, req.(*QueryHeadObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+func _Query_HeadObjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectById(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObjectById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectById(ctx, req.(*QueryHeadObjectByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadShadowObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadShadowObjectRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadShadowObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadShadowObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadShadowObject(ctx, req.(*QueryHeadShadowObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsByBucketIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadObjectNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObjectNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketId) > 0 {
-		i -= len(m.BucketId)
-		copy(dAtA[i:], m.BucketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ListBuckets(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBuckets(ctx, req.(*QueryListBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjects(ctx, req.(*QueryListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ListObjectsByBucketId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsByBucketIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjectsByBucketId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListObjectsByBucketId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjectsByBucketId(ctx, req.(*QueryListObjectsByBucketIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_HeadGroupNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObjectInfos) > 0 {
-		for iNdEx := len(m.ObjectInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ObjectInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroupNFT(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadGroupNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroupNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPolicyForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyForAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyForAccount(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPolicyForAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyForAccount(ctx, req.(*QueryPolicyForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_VerifyPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyPermissionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/VerifyPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyPermission(ctx, req.(*QueryVerifyPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBucketNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExplainPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExplainPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ExplainPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExplainPermission(ctx, req.(*QueryExplainPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBucketNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_HeadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroup(ctx, req.(*QueryHeadGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListGroupsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListGroups(ctx, req.(*QueryListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObjectNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroupMember(ctx, req.(*QueryHeadGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObjectNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryPolicyForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyForGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyForGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPolicyForGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyForGroup(ctx, req.(*QueryPolicyForGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPolicyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyByIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPolicyById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyById(ctx, req.(*QueryPolicyByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGroupNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryLockFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLockFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryLockFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLockFee(ctx, req.(*QueryLockFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketExtraRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketExtra(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadBucketExtra",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketExtra(ctx, req.(*QueryHeadBucketExtraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsPriceChangedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryIsPriceChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryIsPriceChanged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryIsPriceChanged(ctx, req.(*QueryIsPriceChangedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryQuotaUpdateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteUpdateTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryQuotaUpdateTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, req.(*QueryQuoteUpdateTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PrincipalAddress) > 0 {
-		i -= len(m.PrincipalAddress)
-		copy(dAtA[i:], m.PrincipalAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryGroupMembersExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMembersExistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupMembersExist(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryGroupMembersExist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupMembersExist(ctx, req.(*QueryGroupMembersExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryGroupsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsExistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupsExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryGroupsExist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupsExist(ctx, req.(*QueryGroupsExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryGroupsExistById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsExistByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupsExistById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryGroupsExistById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupsExistById(ctx, req.(*QueryGroupsExistByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Policy != nil {
-		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryPaymentAccountBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountBucketFlowRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryPaymentAccountBucketFlowRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, req.(*QueryPaymentAccountBucketFlowRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListObjectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectVersionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjectVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ListObjectVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjectVersions(ctx, req.(*QueryListObjectVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadObjectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadObjectVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectVersion(ctx, req.(*QueryHeadObjectVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActionType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
-		i--
-		dAtA[i] = 0x20
+func _Query_HeadComposedObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadComposedObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).HeadComposedObject(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/HeadComposedObject",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadComposedObject(ctx, req.(*QueryHeadComposedObjectRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBucketLifecycleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/BucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BucketLifecycle(ctx, req.(*QueryBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateStorageCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateStorageCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateStorageCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/EstimateStorageCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateStorageCost(ctx, req.(*QueryEstimateStorageCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Effect != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
-		i--
-		dAtA[i] = 0x8
+func _Query_ReaderQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReaderQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ReaderQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/ReaderQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReaderQuota(ctx, req.(*QueryReaderQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
+func _Query_PaymentHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/PaymentHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentHealth(ctx, req.(*QueryPaymentHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.storage.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryParamsByTimestamp",
+			Handler:    _Query_QueryParamsByTimestamp_Handler,
+		},
+		{
+			MethodName: "HeadBucket",
+			Handler:    _Query_HeadBucket_Handler,
+		},
+		{
+			MethodName: "HeadBucketById",
+			Handler:    _Query_HeadBucketById_Handler,
+		},
+		{
+			MethodName: "HeadBucketNFT",
+			Handler:    _Query_HeadBucketNFT_Handler,
+		},
+		{
+			MethodName: "HeadObject",
+			Handler:    _Query_HeadObject_Handler,
+		},
+		{
+			MethodName: "HeadObjectById",
+			Handler:    _Query_HeadObjectById_Handler,
+		},
+		{
+			MethodName: "HeadShadowObject",
+			Handler:    _Query_HeadShadowObject_Handler,
+		},
+		{
+			MethodName: "HeadObjectNFT",
+			Handler:    _Query_HeadObjectNFT_Handler,
+		},
+		{
+			MethodName: "ListBuckets",
+			Handler:    _Query_ListBuckets_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _Query_ListObjects_Handler,
+		},
+		{
+			MethodName: "ListObjectsByBucketId",
+			Handler:    _Query_ListObjectsByBucketId_Handler,
+		},
+		{
+			MethodName: "HeadGroupNFT",
+			Handler:    _Query_HeadGroupNFT_Handler,
+		},
+		{
+			MethodName: "QueryPolicyForAccount",
+			Handler:    _Query_QueryPolicyForAccount_Handler,
+		},
+		{
+			MethodName: "VerifyPermission",
+			Handler:    _Query_VerifyPermission_Handler,
+		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _Query_ExplainPermission_Handler,
+		},
+		{
+			MethodName: "HeadGroup",
+			Handler:    _Query_HeadGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _Query_ListGroups_Handler,
+		},
+		{
+			MethodName: "HeadGroupMember",
+			Handler:    _Query_HeadGroupMember_Handler,
+		},
+		{
+			MethodName: "QueryPolicyForGroup",
+			Handler:    _Query_QueryPolicyForGroup_Handler,
+		},
+		{
+			MethodName: "QueryPolicyById",
+			Handler:    _Query_QueryPolicyById_Handler,
+		},
+		{
+			MethodName: "QueryLockFee",
+			Handler:    _Query_QueryLockFee_Handler,
+		},
+		{
+			MethodName: "HeadBucketExtra",
+			Handler:    _Query_HeadBucketExtra_Handler,
+		},
+		{
+			MethodName: "QueryIsPriceChanged",
+			Handler:    _Query_QueryIsPriceChanged_Handler,
+		},
+		{
+			MethodName: "QueryQuotaUpdateTime",
+			Handler:    _Query_QueryQuotaUpdateTime_Handler,
+		},
+		{
+			MethodName: "QueryGroupMembersExist",
+			Handler:    _Query_QueryGroupMembersExist_Handler,
+		},
+		{
+			MethodName: "QueryGroupsExist",
+			Handler:    _Query_QueryGroupsExist_Handler,
+		},
+		{
+			MethodName: "QueryGroupsExistById",
+			Handler:    _Query_QueryGroupsExistById_Handler,
+		},
+		{
+			MethodName: "QueryPaymentAccountBucketFlowRateLimit",
+			Handler:    _Query_QueryPaymentAccountBucketFlowRateLimit_Handler,
+		},
+		{
+			MethodName: "ListObjectVersions",
+			Handler:    _Query_ListObjectVersions_Handler,
+		},
+		{
+			MethodName: "HeadObjectVersion",
+			Handler:    _Query_HeadObjectVersion_Handler,
+		},
+		{
+			MethodName: "HeadComposedObject",
+			Handler:    _Query_HeadComposedObject_Handler,
+		},
+		{
+			MethodName: "BucketLifecycle",
+			Handler:    _Query_BucketLifecycle_Handler,
+		},
+		{
+			MethodName: "EstimateStorageCost",
+			Handler:    _Query_EstimateStorageCost_Handler,
+		},
+		{
+			MethodName: "ReaderQuota",
+			Handler:    _Query_ReaderQuota_Handler,
+		},
+		{
+			MethodName: "PaymentHealth",
+			Handler:    _Query_PaymentHealth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/storage/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4707,34 +5270,20 @@ func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4744,32 +5293,30 @@ func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupInfo != nil {
-		{
-			size, err := m.GroupInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4779,39 +5326,25 @@ func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4821,46 +5354,30 @@ func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupInfos) > 0 {
-		for iNdEx := len(m.GroupInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GroupInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4870,41 +5387,57 @@ func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadBucketByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadBucketByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadBucketByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketId) > 0 {
+		i -= len(m.BucketId)
+		copy(dAtA[i:], m.BucketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4914,19 +5447,31 @@ func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupMember != nil {
+	if m.ExtraInfo != nil {
 		{
-			size, err := m.GroupMember.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BucketInfo != nil {
+		{
+			size, err := m.BucketInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4939,7 +5484,7 @@ func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4949,34 +5494,34 @@ func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PrincipalGroupId) > 0 {
-		i -= len(m.PrincipalGroupId)
-		copy(dAtA[i:], m.PrincipalGroupId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalGroupId)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4986,32 +5531,27 @@ func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
-		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadShadowObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5021,27 +5561,34 @@ func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PolicyId) > 0 {
-		i -= len(m.PolicyId)
-		copy(dAtA[i:], m.PolicyId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5051,19 +5598,31 @@ func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
+	if m.GlobalVirtualGroup != nil {
 		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5076,7 +5635,7 @@ func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5086,37 +5645,32 @@ func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PayloadSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.PrimarySpAddress) > 0 {
-		i -= len(m.PrimarySpAddress)
-		copy(dAtA[i:], m.PrimarySpAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5126,30 +5680,32 @@ func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5159,27 +5715,46 @@ func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketInfos) > 0 {
+		for iNdEx := len(m.BucketInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BucketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5189,19 +5764,47 @@ func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExtraInfo != nil {
+	if len(m.StartAfter) > 0 {
+		i -= len(m.StartAfter)
+		copy(dAtA[i:], m.StartAfter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartAfter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Delimiter) > 0 {
+		i -= len(m.Delimiter)
+		copy(dAtA[i:], m.Delimiter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delimiter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
 		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5214,7 +5817,7 @@ func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5224,27 +5827,39 @@ func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByBucketIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.BucketId) > 0 {
+		i -= len(m.BucketId)
+		copy(dAtA[i:], m.BucketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5254,110 +5869,55 @@ func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.NewValidatorTaxRate.Size()
-		i -= size
-		if _, err := m.NewValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.NewSecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.NewSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.NewPrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.NewPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.NewReadPrice.Size()
-		i -= size
-		if _, err := m.NewReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.CurrentValidatorTaxRate.Size()
-		i -= size
-		if _, err := m.CurrentValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.CurrentSecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.CurrentSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CurrentPrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.CurrentPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.CommonPrefixes) > 0 {
+		for iNdEx := len(m.CommonPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommonPrefixes[iNdEx])
+			copy(dAtA[i:], m.CommonPrefixes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CommonPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CurrentReadPrice.Size()
-		i -= size
-		if _, err := m.CurrentReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Changed {
 		i--
-		if m.Changed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectInfos) > 0 {
+		for iNdEx := len(m.ObjectInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObjectInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5367,27 +5927,27 @@ func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteUpdateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5397,25 +5957,32 @@ func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBucketNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBucketNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpdateAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5425,36 +5992,32 @@ func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersExistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObjectNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObjectNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5464,42 +6027,32 @@ func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersExistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGroupNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k := range m.Exists {
-			v := m.Exists[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5509,36 +6062,34 @@ func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupNames) > 0 {
-		for iNdEx := len(m.GroupNames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupNames[iNdEx])
-			copy(dAtA[i:], m.GroupNames[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupNames[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.PrincipalAddress) > 0 {
+		i -= len(m.PrincipalAddress)
+		copy(dAtA[i:], m.PrincipalAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5548,29 +6099,32 @@ func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupIds) > 0 {
-		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupIds[iNdEx])
-			copy(dAtA[i:], m.GroupIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5580,42 +6134,46 @@ func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k := range m.Exists {
-			v := m.Exists[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.ActionType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5625,41 +6183,74 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerifyPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExplainPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExplainPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ActionType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketOwner)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PaymentAccount) > 0 {
-		i -= len(m.PaymentAccount)
-		copy(dAtA[i:], m.PaymentAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExplainPermissionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5669,823 +6260,5726 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExplainPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExplainPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.FlowRateLimit.Size()
-		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Trace) > 0 {
+		for iNdEx := len(m.Trace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.IsSet {
-		i--
-		if m.IsSet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PermissionTraceStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *PermissionTraceStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PermissionTraceStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *QueryParamsByTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StatementIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StatementIndex))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryHeadBucketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PolicyId.Size()
+		i -= size
+		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryHeadBucketByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ResourceId.Size()
+		i -= size
+		if _, err := m.ResourceId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.BucketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.ResourceType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResourceType))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BucketInfo != nil {
-		l = m.BucketInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ExtraInfo != nil {
-		l = m.ExtraInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadObjectByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ObjectId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadShadowObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryHeadGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectInfo != nil {
-		l = m.ObjectInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GlobalVirtualGroup != nil {
-		l = m.GlobalVirtualGroup.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.GroupInfo != nil {
+		{
+			size, err := m.GroupInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadShadowObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectInfo != nil {
-		l = m.ObjectInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListBucketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryListGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListBucketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BucketInfos) > 0 {
-		for _, e := range m.BucketInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListObjectsByBucketIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryListGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListObjectsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ObjectInfos) > 0 {
-		for _, e := range m.ObjectInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupInfos) > 0 {
+		for iNdEx := len(m.GroupInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBucketNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryHeadGroupMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObjectNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadGroupMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryGroupNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PrincipalAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryHeadGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVerifyPermissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ActionType != 0 {
-		n += 1 + sovQuery(uint64(m.ActionType))
+	if m.GroupMember != nil {
+		{
+			size, err := m.GroupMember.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerifyPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Effect != 0 {
-		n += 1 + sovQuery(uint64(m.Effect))
+func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPolicyForGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyForGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PrincipalGroupId) > 0 {
+		i -= len(m.PrincipalGroupId)
+		copy(dAtA[i:], m.PrincipalGroupId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalGroupId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupInfo != nil {
-		l = m.GroupInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPolicyForGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.GroupInfos) > 0 {
-		for _, e := range m.GroupInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupMember != nil {
-		l = m.GroupMember.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPolicyByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPolicyByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PrincipalGroupId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PolicyId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPolicyByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrimarySpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.CreateAt != 0 {
-		n += 1 + sovQuery(uint64(m.CreateAt))
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovQuery(uint64(m.PayloadSize))
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLockFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExtraInfo != nil {
-		l = m.ExtraInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Changed {
-		n += 2
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.CurrentReadPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentPrimaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSecondaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentValidatorTaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewReadPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewPrimaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewSecondaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewValidatorTaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateAt != 0 {
-		n += 1 + sovQuery(uint64(m.UpdateAt))
-	}
-	return n
+func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ExtraInfo != nil {
+		{
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsPriceChangedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k, v := range m.Exists {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsPriceChangedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsPriceChangedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.NewValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.NewValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.GroupNames) > 0 {
-		for _, s := range m.GroupNames {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.NewSecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.NewSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryGroupsExistByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NewPrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.NewPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.GroupIds) > 0 {
-		for _, s := range m.GroupIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NewReadPrice.Size()
+		i -= size
+		if _, err := m.NewReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryGroupsExistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.CurrentValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.Exists) > 0 {
-		for k, v := range m.Exists {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentSecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.CurrentSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentPrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.CurrentPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentReadPrice.Size()
+		i -= size
+		if _, err := m.CurrentReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Changed {
+		i--
+		if m.Changed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteUpdateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteUpdateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteUpdateTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersExistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersExistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k := range m.Exists {
+			v := m.Exists[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupNames) > 0 {
+		for iNdEx := len(m.GroupNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupNames[iNdEx])
+			copy(dAtA[i:], m.GroupNames[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupIds) > 0 {
+		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupIds[iNdEx])
+			copy(dAtA[i:], m.GroupIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k := range m.Exists {
+			v := m.Exists[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.IsSet {
+		i--
+		if m.IsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListObjectVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListObjectVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListObjectVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListObjectVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListObjectVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListObjectVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectVersions) > 0 {
+		for iNdEx := len(m.ObjectVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObjectVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadObjectVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadObjectVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadObjectVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadObjectVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadObjectVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadObjectVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		{
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectVersion != nil {
+		{
+			size, err := m.ObjectVersion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadComposedObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadComposedObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadComposedObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadComposedObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadComposedObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadComposedObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ComposedObject != nil {
+		{
+			size, err := m.ComposedObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketLifecycleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketLifecycleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketLifecycleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateStorageCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateStorageCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateStorageCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadQuota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateStorageCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateStorageCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateStorageCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalCost.Size()
+		i -= size
+		if _, err := m.TotalCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.EarlyDeletionFee.Size()
+		i -= size
+		if _, err := m.EarlyDeletionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LockFee.Size()
+		i -= size
+		if _, err := m.LockFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TotalRate.Size()
+		i -= size
+		if _, err := m.TotalRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorTaxReadRate.Size()
+		i -= size
+		if _, err := m.ValidatorTaxReadRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ReadRate.Size()
+		i -= size
+		if _, err := m.ReadRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ValidatorTaxStoreRate.Size()
+		i -= size
+		if _, err := m.ValidatorTaxStoreRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SecondaryStoreRate.Size()
+		i -= size
+		if _, err := m.SecondaryStoreRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PrimaryStoreRate.Size()
+		i -= size
+		if _, err := m.PrimaryStoreRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReserveTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReserveTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinChargeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinChargeSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChargeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChargeSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReaderQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReaderQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReaderQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reader) > 0 {
+		i -= len(m.Reader)
+		copy(dAtA[i:], m.Reader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReaderQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReaderQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReaderQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReaderQuota != nil {
+		{
+			size, err := m.ReaderQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryParamsByTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeadBucketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketInfo != nil {
+		l = m.BucketInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExtraInfo != nil {
+		l = m.ExtraInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadShadowObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadShadowObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BucketInfos) > 0 {
+		for _, e := range m.BucketInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delimiter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StartAfter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsByBucketIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObjectInfos) > 0 {
+		for _, e := range m.ObjectInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CommonPrefixes) > 0 {
+		for _, s := range m.CommonPrefixes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObjectNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrincipalAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovQuery(uint64(m.ActionType))
+	}
+	return n
+}
+
+func (m *QueryVerifyPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	return n
+}
+
+func (m *QueryExplainPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovQuery(uint64(m.ActionType))
+	}
+	return n
+}
+
+func (m *QueryExplainPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	if len(m.Trace) > 0 {
+		for _, e := range m.Trace {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionTraceStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.ResourceType != 0 {
+		n += 1 + sovQuery(uint64(m.ResourceType))
+	}
+	l = m.ResourceId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PolicyId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GroupId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.StatementIndex != 0 {
+		n += 1 + sovQuery(uint64(m.StatementIndex))
+	}
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupInfo != nil {
+		l = m.GroupInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GroupInfos) > 0 {
+		for _, e := range m.GroupInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeadGroupMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupMember != nil {
+		l = m.GroupMember.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrincipalGroupId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreateAt))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	return n
+}
+
+func (m *QueryLockFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeadBucketExtraRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketExtraResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtraInfo != nil {
+		l = m.ExtraInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsPriceChangedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsPriceChangedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Changed {
+		n += 2
+	}
+	l = m.CurrentReadPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPrimaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSecondaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentValidatorTaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewReadPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewPrimaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewSecondaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewValidatorTaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQuoteUpdateTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateAt != 0 {
+		n += 1 + sovQuery(uint64(m.UpdateAt))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersExistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupMembersExistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k, v := range m.Exists {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GroupNames) > 0 {
+		for _, s := range m.GroupNames {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupIds) > 0 {
+		for _, s := range m.GroupIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k, v := range m.Exists {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsSet {
+		n += 2
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListObjectVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObjectVersions) > 0 {
+		for _, e := range m.ObjectVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectVersion != nil {
+		l = m.ObjectVersion.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadComposedObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadComposedObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ComposedObject != nil {
+		l = m.ComposedObject.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBucketLifecycleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateStorageCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovQuery(uint64(m.ReadQuota))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovQuery(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *QueryEstimateStorageCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChargeSize != 0 {
+		n += 1 + sovQuery(uint64(m.ChargeSize))
+	}
+	if m.MinChargeSize != 0 {
+		n += 1 + sovQuery(uint64(m.MinChargeSize))
+	}
+	if m.ReserveTime != 0 {
+		n += 1 + sovQuery(uint64(m.ReserveTime))
+	}
+	l = m.PrimaryStoreRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SecondaryStoreRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorTaxStoreRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReadRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorTaxReadRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EarlyDeletionFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalCost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReaderQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReaderQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReaderQuota != nil {
+		l = m.ReaderQuota.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPaymentHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketInfo == nil {
+				m.BucketInfo = &BucketInfo{}
+			}
+			if err := m.BucketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraInfo == nil {
+				m.ExtraInfo = &BucketExtraInfo{}
+			}
+			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ObjectInfo{}
+			}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ShadowObjectInfo{}
+			}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketInfos = append(m.BucketInfos, &BucketInfo{})
+			if err := m.BucketInfos[len(m.BucketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delimiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectInfos = append(m.ObjectInfos, &ObjectInfo{})
+			if err := m.ObjectInfos[len(m.ObjectInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonPrefixes = append(m.CommonPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBucketNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBucketNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &BucketMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObjectNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObjectNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &ObjectMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &GroupMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyForAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrincipalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyForAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= types1.ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= types1.ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = append(m.Trace, &PermissionTraceStep{})
+			if err := m.Trace[len(m.Trace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PaymentAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsSet {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PermissionTraceStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6508,12 +12002,190 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionTraceStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionTraceStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PermissionTraceStepType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			m.ResourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceType |= resource.ResourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementIndex", wireType)
+			}
+			m.StatementIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatementIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6535,7 +12207,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6558,17 +12230,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6578,24 +12250,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6618,7 +12321,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6641,17 +12344,17 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfo", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6661,11 +12364,28 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupInfo == nil {
+				m.GroupInfo = &GroupInfo{}
+			}
+			if err := m.GroupInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6687,7 +12407,7 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6710,15 +12430,15 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6745,10 +12465,45 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6770,7 +12525,7 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6793,17 +12548,17 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6813,23 +12568,61 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupInfos = append(m.GroupInfos, &GroupInfo{})
+			if err := m.GroupInfos[len(m.GroupInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6852,7 +12645,7 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6875,15 +12668,15 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6911,7 +12704,71 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketId = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6934,7 +12791,7 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6957,51 +12814,15 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BucketInfo == nil {
-				m.BucketInfo = &BucketInfo{}
-			}
-			if err := m.BucketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMember", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7028,10 +12849,10 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraInfo == nil {
-				m.ExtraInfo = &BucketExtraInfo{}
+			if m.GroupMember == nil {
+				m.GroupMember = &types1.GroupMember{}
 			}
-			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupMember.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7056,7 +12877,7 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7079,15 +12900,15 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7115,11 +12936,11 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalGroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7147,7 +12968,7 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PrincipalGroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7170,7 +12991,7 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7193,17 +13014,17 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7213,23 +13034,27 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7252,7 +13077,7 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7275,47 +13100,15 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7343,7 +13136,7 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7366,7 +13159,7 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7389,51 +13182,15 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectInfo == nil {
-				m.ObjectInfo = &ObjectInfo{}
-			}
-			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7460,10 +13217,10 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GlobalVirtualGroup == nil {
-				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
 			}
-			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7488,7 +13245,7 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7511,17 +13268,17 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7531,28 +13288,62 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectInfo == nil {
-				m.ObjectInfo = &ShadowObjectInfo{}
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
 			}
-			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7574,7 +13365,7 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7597,17 +13388,17 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBucketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7617,25 +13408,23 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7660,7 +13449,7 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7683,51 +13472,17 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBucketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketInfos = append(m.BucketInfos, &BucketInfo{})
-			if err := m.BucketInfos[len(m.BucketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7737,27 +13492,23 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7780,7 +13531,7 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7803,15 +13554,15 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7838,45 +13589,13 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.ExtraInfo == nil {
+				m.ExtraInfo = &InternalBucketInfo{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7898,7 +13617,7 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7921,51 +13640,15 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsPriceChangedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsPriceChangedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7993,7 +13676,7 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketId = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8016,7 +13699,7 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8039,17 +13722,37 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsPriceChangedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsPriceChangedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Changed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReadPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8059,31 +13762,31 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectInfos = append(m.ObjectInfos, &ObjectInfo{})
-			if err := m.ObjectInfos[len(m.ObjectInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrimaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8093,81 +13796,29 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CurrentPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8195,63 +13846,15 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CurrentSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBucketNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBucketNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValidatorTaxRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8261,83 +13864,65 @@ func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &BucketMetaData{}
-			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReadPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObjectNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObjectNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPrimaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8347,83 +13932,65 @@ func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &ObjectMetaData{}
-			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryStorePrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorTaxRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8433,25 +14000,23 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &GroupMetaData{}
-			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8476,7 +14041,7 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8499,47 +14064,15 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8567,7 +14100,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrincipalAddress = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8590,7 +14123,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8613,17 +14146,17 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
 			}
-			var msglen int
+			m.UpdateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8633,28 +14166,11 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdateAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8676,7 +14192,7 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8699,15 +14215,15 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPermissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersExistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8735,11 +14251,11 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8767,13 +14283,63 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMembersExistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMembersExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8783,43 +14349,107 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			if m.Exists == nil {
+				m.Exists = make(map[string]bool)
 			}
-			m.ActionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActionType |= types1.ActionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Exists[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8841,7 +14471,7 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8864,17 +14494,17 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			m.Effect = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8884,11 +14514,56 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Effect |= types1.Effect(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupNames = append(m.GroupNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8910,7 +14585,7 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8933,47 +14608,15 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9001,7 +14644,7 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9024,7 +14667,7 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9047,15 +14690,15 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9082,12 +14725,91 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupInfo == nil {
-				m.GroupInfo = &GroupInfo{}
+			if m.Exists == nil {
+				m.Exists = make(map[string]bool)
 			}
-			if err := m.GroupInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9110,7 +14832,7 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9133,17 +14855,17 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9153,31 +14875,59 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9205,7 +14955,7 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9228,7 +14978,7 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9251,17 +15001,17 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSet", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9271,33 +15021,17 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsSet = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9307,23 +15041,23 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupInfos = append(m.GroupInfos, &GroupInfo{})
-			if err := m.GroupInfos[len(m.GroupInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9348,7 +15082,7 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9371,17 +15105,17 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9391,27 +15125,31 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9439,11 +15177,11 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9471,7 +15209,7 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9494,7 +15232,7 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9517,15 +15255,15 @@ func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupMember", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectVersions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9552,10 +15290,44 @@ func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupMember == nil {
-				m.GroupMember = &types1.GroupMember{}
+			m.ObjectVersions = append(m.ObjectVersions, &ObjectVersion{})
+			if err := m.ObjectVersions[len(m.ObjectVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.GroupMember.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9580,7 +15352,7 @@ func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadObjectVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9603,15 +15375,15 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadObjectVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadObjectVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9639,11 +15411,11 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9671,8 +15443,27 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrincipalGroupId = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9694,7 +15485,7 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadObjectVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9717,15 +15508,15 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadObjectVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadObjectVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectVersion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9752,10 +15543,46 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
+			if m.ObjectVersion == nil {
+				m.ObjectVersion = &ObjectVersion{}
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9780,7 +15607,7 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadComposedObjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9803,15 +15630,15 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadComposedObjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadComposedObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9839,63 +15666,13 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyByIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9905,27 +15682,23 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9948,7 +15721,7 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadComposedObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9971,17 +15744,17 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadComposedObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadComposedObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9991,48 +15764,33 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ObjectInfo{}
 			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComposedObject", wireType)
 			}
-			m.PayloadSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10042,66 +15800,33 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ComposedObject == nil {
+				m.ComposedObject = &ComposedObject{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ComposedObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10111,23 +15836,23 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GlobalVirtualGroups = append(m.GlobalVirtualGroups, &types.GlobalVirtualGroup{})
+			if err := m.GlobalVirtualGroups[len(m.GlobalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10152,7 +15877,7 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketLifecycleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10175,10 +15900,10 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketLifecycleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketLifecycleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10234,7 +15959,7 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10257,15 +15982,15 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10292,10 +16017,8 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraInfo == nil {
-				m.ExtraInfo = &InternalBucketInfo{}
-			}
-			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10320,7 +16043,7 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateStorageCostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10343,10 +16066,10 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsPriceChangedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateStorageCostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsPriceChangedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateStorageCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10381,61 +16104,11 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsPriceChangedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsPriceChangedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			var v int
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10445,17 +16118,16 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Changed = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReadPrice", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
 			}
-			var stringLen uint64
+			m.ReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10465,31 +16137,16 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrimaryStorePrice", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10499,31 +16156,66 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateStorageCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSecondaryStorePrice", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateStorageCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateStorageCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSize", wireType)
 			}
-			var stringLen uint64
+			m.ChargeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10533,31 +16225,16 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChargeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValidatorTaxRate", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinChargeSize", wireType)
 			}
-			var stringLen uint64
+			m.MinChargeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10567,31 +16244,16 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinChargeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewReadPrice", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveTime", wireType)
 			}
-			var stringLen uint64
+			m.ReserveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10601,29 +16263,14 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReserveTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPrimaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStoreRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10651,13 +16298,13 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrimaryStoreRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStoreRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10685,13 +16332,13 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondaryStoreRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTaxStoreRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10719,63 +16366,13 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorTaxStoreRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10803,63 +16400,15 @@ func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ReadRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTaxReadRate", wireType)
 			}
-			m.UpdateAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10869,64 +16418,29 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersExistRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorTaxReadRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10954,11 +16468,13 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10986,63 +16502,49 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LockFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyDeletionFee", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EarlyDeletionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersExistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCost", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11052,106 +16554,25 @@ func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exists == nil {
-				m.Exists = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.TotalCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11174,7 +16595,7 @@ func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReaderQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11197,47 +16618,15 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReaderQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReaderQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11262,64 +16651,14 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupNames = append(m.GroupNames, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11347,7 +16686,7 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
+			m.Reader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11370,7 +16709,7 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReaderQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11393,15 +16732,15 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReaderQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReaderQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReaderQuota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11428,91 +16767,12 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exists == nil {
-				m.Exists = make(map[string]bool)
+			if m.ReaderQuota == nil {
+				m.ReaderQuota = &ReaderQuota{}
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ReaderQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11535,7 +16795,7 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11558,108 +16818,12 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11681,7 +16845,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11704,17 +16868,17 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSet", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11724,17 +16888,33 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsSet = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &PaymentHealthReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11744,26 +16924,11 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
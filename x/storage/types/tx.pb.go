@@ -4036,3362 +4036,3522 @@ func (m *MsgSetBucketFlowRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBucketFlowRateLimitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateBucket)(nil), "greenfield.storage.MsgCreateBucket")
-	proto.RegisterType((*MsgCreateBucketResponse)(nil), "greenfield.storage.MsgCreateBucketResponse")
-	proto.RegisterType((*MsgDeleteBucket)(nil), "greenfield.storage.MsgDeleteBucket")
-	proto.RegisterType((*MsgDeleteBucketResponse)(nil), "greenfield.storage.MsgDeleteBucketResponse")
-	proto.RegisterType((*MsgDiscontinueBucket)(nil), "greenfield.storage.MsgDiscontinueBucket")
-	proto.RegisterType((*MsgDiscontinueBucketResponse)(nil), "greenfield.storage.MsgDiscontinueBucketResponse")
-	proto.RegisterType((*MsgCreateObject)(nil), "greenfield.storage.MsgCreateObject")
-	proto.RegisterType((*MsgCreateObjectResponse)(nil), "greenfield.storage.MsgCreateObjectResponse")
-	proto.RegisterType((*MsgSealObject)(nil), "greenfield.storage.MsgSealObject")
-	proto.RegisterType((*MsgSealObjectResponse)(nil), "greenfield.storage.MsgSealObjectResponse")
-	proto.RegisterType((*MsgSealObjectV2)(nil), "greenfield.storage.MsgSealObjectV2")
-	proto.RegisterType((*MsgSealObjectV2Response)(nil), "greenfield.storage.MsgSealObjectV2Response")
-	proto.RegisterType((*MsgRejectSealObject)(nil), "greenfield.storage.MsgRejectSealObject")
-	proto.RegisterType((*MsgRejectSealObjectResponse)(nil), "greenfield.storage.MsgRejectSealObjectResponse")
-	proto.RegisterType((*MsgCopyObject)(nil), "greenfield.storage.MsgCopyObject")
-	proto.RegisterType((*MsgCopyObjectResponse)(nil), "greenfield.storage.MsgCopyObjectResponse")
-	proto.RegisterType((*MsgDeleteObject)(nil), "greenfield.storage.MsgDeleteObject")
-	proto.RegisterType((*MsgDeleteObjectResponse)(nil), "greenfield.storage.MsgDeleteObjectResponse")
-	proto.RegisterType((*MsgDiscontinueObject)(nil), "greenfield.storage.MsgDiscontinueObject")
-	proto.RegisterType((*MsgDiscontinueObjectResponse)(nil), "greenfield.storage.MsgDiscontinueObjectResponse")
-	proto.RegisterType((*MsgCreateGroup)(nil), "greenfield.storage.MsgCreateGroup")
-	proto.RegisterType((*MsgCreateGroupResponse)(nil), "greenfield.storage.MsgCreateGroupResponse")
-	proto.RegisterType((*MsgDeleteGroup)(nil), "greenfield.storage.MsgDeleteGroup")
-	proto.RegisterType((*MsgDeleteGroupResponse)(nil), "greenfield.storage.MsgDeleteGroupResponse")
-	proto.RegisterType((*MsgUpdateGroupMember)(nil), "greenfield.storage.MsgUpdateGroupMember")
-	proto.RegisterType((*MsgUpdateGroupMemberResponse)(nil), "greenfield.storage.MsgUpdateGroupMemberResponse")
-	proto.RegisterType((*MsgRenewGroupMember)(nil), "greenfield.storage.MsgRenewGroupMember")
-	proto.RegisterType((*MsgRenewGroupMemberResponse)(nil), "greenfield.storage.MsgRenewGroupMemberResponse")
-	proto.RegisterType((*MsgGroupMember)(nil), "greenfield.storage.MsgGroupMember")
-	proto.RegisterType((*MsgUpdateGroupExtra)(nil), "greenfield.storage.MsgUpdateGroupExtra")
-	proto.RegisterType((*MsgUpdateGroupExtraResponse)(nil), "greenfield.storage.MsgUpdateGroupExtraResponse")
-	proto.RegisterType((*MsgLeaveGroup)(nil), "greenfield.storage.MsgLeaveGroup")
-	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "greenfield.storage.MsgLeaveGroupResponse")
-	proto.RegisterType((*MsgUpdateBucketInfo)(nil), "greenfield.storage.MsgUpdateBucketInfo")
-	proto.RegisterType((*MsgUpdateBucketInfoResponse)(nil), "greenfield.storage.MsgUpdateBucketInfoResponse")
-	proto.RegisterType((*MsgCancelCreateObject)(nil), "greenfield.storage.MsgCancelCreateObject")
-	proto.RegisterType((*MsgCancelCreateObjectResponse)(nil), "greenfield.storage.MsgCancelCreateObjectResponse")
-	proto.RegisterType((*MsgPutPolicy)(nil), "greenfield.storage.MsgPutPolicy")
-	proto.RegisterType((*MsgPutPolicyResponse)(nil), "greenfield.storage.MsgPutPolicyResponse")
-	proto.RegisterType((*MsgDeletePolicy)(nil), "greenfield.storage.MsgDeletePolicy")
-	proto.RegisterType((*MsgDeletePolicyResponse)(nil), "greenfield.storage.MsgDeletePolicyResponse")
-	proto.RegisterType((*MsgMirrorObject)(nil), "greenfield.storage.MsgMirrorObject")
-	proto.RegisterType((*MsgMirrorObjectResponse)(nil), "greenfield.storage.MsgMirrorObjectResponse")
-	proto.RegisterType((*MsgMirrorBucket)(nil), "greenfield.storage.MsgMirrorBucket")
-	proto.RegisterType((*MsgUpdateObjectInfoResponse)(nil), "greenfield.storage.MsgUpdateObjectInfoResponse")
-	proto.RegisterType((*MsgUpdateObjectInfo)(nil), "greenfield.storage.MsgUpdateObjectInfo")
-	proto.RegisterType((*MsgMirrorBucketResponse)(nil), "greenfield.storage.MsgMirrorBucketResponse")
-	proto.RegisterType((*MsgMirrorGroup)(nil), "greenfield.storage.MsgMirrorGroup")
-	proto.RegisterType((*MsgMirrorGroupResponse)(nil), "greenfield.storage.MsgMirrorGroupResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.storage.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.storage.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgMigrateBucket)(nil), "greenfield.storage.MsgMigrateBucket")
-	proto.RegisterType((*MsgMigrateBucketResponse)(nil), "greenfield.storage.MsgMigrateBucketResponse")
-	proto.RegisterType((*MsgCompleteMigrateBucket)(nil), "greenfield.storage.MsgCompleteMigrateBucket")
-	proto.RegisterType((*MsgCompleteMigrateBucketResponse)(nil), "greenfield.storage.MsgCompleteMigrateBucketResponse")
-	proto.RegisterType((*MsgCancelMigrateBucket)(nil), "greenfield.storage.MsgCancelMigrateBucket")
-	proto.RegisterType((*MsgCancelMigrateBucketResponse)(nil), "greenfield.storage.MsgCancelMigrateBucketResponse")
-	proto.RegisterType((*MsgRejectMigrateBucket)(nil), "greenfield.storage.MsgRejectMigrateBucket")
-	proto.RegisterType((*MsgRejectMigrateBucketResponse)(nil), "greenfield.storage.MsgRejectMigrateBucketResponse")
-	proto.RegisterType((*MsgSetTag)(nil), "greenfield.storage.MsgSetTag")
-	proto.RegisterType((*MsgSetTagResponse)(nil), "greenfield.storage.MsgSetTagResponse")
-	proto.RegisterType((*MsgUpdateObjectContent)(nil), "greenfield.storage.MsgUpdateObjectContent")
-	proto.RegisterType((*MsgUpdateObjectContentResponse)(nil), "greenfield.storage.MsgUpdateObjectContentResponse")
-	proto.RegisterType((*MsgCancelUpdateObjectContent)(nil), "greenfield.storage.MsgCancelUpdateObjectContent")
-	proto.RegisterType((*MsgCancelUpdateObjectContentResponse)(nil), "greenfield.storage.MsgCancelUpdateObjectContentResponse")
-	proto.RegisterType((*MsgDelegateCreateObject)(nil), "greenfield.storage.MsgDelegateCreateObject")
-	proto.RegisterType((*MsgDelegateCreateObjectResponse)(nil), "greenfield.storage.MsgDelegateCreateObjectResponse")
-	proto.RegisterType((*MsgDelegateUpdateObjectContent)(nil), "greenfield.storage.MsgDelegateUpdateObjectContent")
-	proto.RegisterType((*MsgDelegateUpdateObjectContentResponse)(nil), "greenfield.storage.MsgDelegateUpdateObjectContentResponse")
-	proto.RegisterType((*MsgToggleSPAsDelegatedAgent)(nil), "greenfield.storage.MsgToggleSPAsDelegatedAgent")
-	proto.RegisterType((*MsgToggleSPAsDelegatedAgentResponse)(nil), "greenfield.storage.MsgToggleSPAsDelegatedAgentResponse")
-	proto.RegisterType((*MsgSetBucketFlowRateLimit)(nil), "greenfield.storage.MsgSetBucketFlowRateLimit")
-	proto.RegisterType((*MsgSetBucketFlowRateLimitResponse)(nil), "greenfield.storage.MsgSetBucketFlowRateLimitResponse")
+type MsgSetBucketVersioning struct {
+	// operator defines the account address of the operator, only the bucket owner can send the tx.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// enabled defines whether the previous contents of the updated objects are retained as object versions.
+	// Disabling it does not delete the versions retained already.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/storage/tx.proto", fileDescriptor_ddb71b028305a3cc) }
-
-var fileDescriptor_ddb71b028305a3cc = []byte{
-	// 2757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x4d, 0x6c, 0x1c, 0x57,
-	0x1d, 0xcf, 0xec, 0xae, 0xed, 0xf8, 0xbf, 0xfe, 0x48, 0x26, 0x6e, 0xb2, 0x59, 0x93, 0xf5, 0x66,
-	0xd3, 0xa6, 0xce, 0x97, 0x9d, 0xba, 0x69, 0x08, 0xa6, 0x42, 0xd8, 0x2e, 0x09, 0xab, 0xc6, 0x8d,
-	0x3b, 0x76, 0x8c, 0x54, 0x84, 0xb6, 0xe3, 0x9d, 0xe7, 0xc9, 0x90, 0xdd, 0x99, 0x61, 0x66, 0xd6,
-	0x8e, 0x8b, 0xd4, 0x03, 0x20, 0xf5, 0x54, 0x29, 0x52, 0x39, 0x70, 0x40, 0x08, 0x21, 0x21, 0x71,
-	0x42, 0x08, 0xf5, 0x8c, 0xb8, 0x54, 0x8a, 0x10, 0x87, 0xa8, 0x07, 0x84, 0x38, 0x84, 0x2a, 0x41,
-	0xaa, 0xb8, 0x72, 0xe1, 0x8a, 0xde, 0xc7, 0xbc, 0x79, 0x3b, 0x9f, 0xeb, 0xcd, 0xba, 0xb1, 0xc4,
-	0x29, 0xd9, 0x79, 0xbf, 0xf7, 0x7f, 0xff, 0xef, 0xf7, 0x7f, 0xff, 0xf7, 0x0c, 0xd3, 0xba, 0x83,
-	0x90, 0xb9, 0x6d, 0xa0, 0x96, 0x36, 0xef, 0x7a, 0x96, 0xa3, 0xea, 0x68, 0xde, 0x7b, 0x30, 0x67,
-	0x3b, 0x96, 0x67, 0xc9, 0x72, 0x30, 0x38, 0xc7, 0x06, 0xcb, 0xa7, 0x9a, 0x96, 0xdb, 0xb6, 0xdc,
-	0xf9, 0xb6, 0xab, 0xcf, 0xef, 0xbc, 0x86, 0xff, 0xa1, 0xe0, 0xf2, 0x69, 0x3a, 0xd0, 0x20, 0xbf,
-	0xe6, 0xe9, 0x0f, 0x36, 0x34, 0xa5, 0x5b, 0xba, 0x45, 0xbf, 0xe3, 0xff, 0xb1, 0xaf, 0x33, 0xba,
-	0x65, 0xe9, 0x2d, 0x34, 0x4f, 0x7e, 0x6d, 0x75, 0xb6, 0xe7, 0x3d, 0xa3, 0x8d, 0x5c, 0x4f, 0x6d,
-	0xdb, 0x0c, 0x50, 0x15, 0x78, 0x6b, 0x5a, 0xed, 0xb6, 0x65, 0xce, 0xab, 0xb6, 0xed, 0x58, 0x3b,
-	0x6a, 0x8b, 0x93, 0x88, 0x20, 0x76, 0x1d, 0xd5, 0xb6, 0x91, 0xc3, 0x00, 0x35, 0x01, 0x60, 0x23,
-	0xa7, 0x6d, 0xb8, 0xae, 0x61, 0x99, 0x0c, 0x1b, 0x43, 0xc4, 0x57, 0x41, 0x26, 0xc0, 0x56, 0x1d,
-	0xb5, 0xed, 0xcb, 0x57, 0x89, 0x53, 0xe2, 0x9e, 0x8d, 0xd8, 0x78, 0xed, 0x4f, 0x79, 0x98, 0x5c,
-	0x75, 0xf5, 0x15, 0x07, 0xa9, 0x1e, 0x5a, 0xee, 0x34, 0xef, 0x23, 0x4f, 0x5e, 0x80, 0x91, 0x26,
-	0xfe, 0x6d, 0x39, 0x25, 0xa9, 0x2a, 0xcd, 0x8e, 0x2e, 0x97, 0x3e, 0xff, 0xf4, 0xca, 0x14, 0x53,
-	0xdb, 0x92, 0xa6, 0x39, 0xc8, 0x75, 0xd7, 0x3d, 0xc7, 0x30, 0x75, 0xc5, 0x07, 0xca, 0x33, 0x50,
-	0xdc, 0x22, 0xb3, 0x1b, 0xa6, 0xda, 0x46, 0xa5, 0x1c, 0x9e, 0xa7, 0x00, 0xfd, 0xf4, 0x8e, 0xda,
-	0x46, 0xf2, 0x32, 0xc0, 0x8e, 0xe1, 0x1a, 0x5b, 0x46, 0xcb, 0xf0, 0xf6, 0x4a, 0xf9, 0xaa, 0x34,
-	0x3b, 0xb1, 0x50, 0x9b, 0x8b, 0x5a, 0x71, 0x6e, 0x93, 0xa3, 0x36, 0xf6, 0x6c, 0xa4, 0x08, 0xb3,
-	0xe4, 0x25, 0x98, 0xb4, 0xd5, 0xbd, 0x36, 0x32, 0xbd, 0x86, 0x4a, 0xd9, 0x28, 0x15, 0x32, 0x18,
-	0x9c, 0x60, 0x13, 0xd8, 0x57, 0xf9, 0x26, 0xc8, 0xb6, 0x63, 0xb4, 0x55, 0x67, 0xaf, 0xe1, 0xda,
-	0x9c, 0xca, 0x50, 0x06, 0x95, 0x63, 0x6c, 0xce, 0xba, 0xed, 0xd3, 0x79, 0x1b, 0x4e, 0x88, 0x74,
-	0x98, 0xed, 0x4b, 0xc3, 0x55, 0x69, 0xb6, 0xb8, 0x30, 0x2d, 0xca, 0xc5, 0xec, 0xb5, 0xc4, 0x20,
-	0xca, 0xf1, 0x80, 0x16, 0xfb, 0x24, 0x5f, 0x06, 0xb9, 0x79, 0x4f, 0x75, 0x74, 0xa4, 0x35, 0x1c,
-	0xa4, 0x6a, 0x8d, 0x1f, 0x75, 0x2c, 0x4f, 0x2d, 0x8d, 0x54, 0xa5, 0xd9, 0x82, 0x72, 0x8c, 0x8d,
-	0x28, 0x48, 0xd5, 0xde, 0xc5, 0xdf, 0x17, 0xc7, 0x7e, 0xf2, 0xe5, 0x1f, 0x2e, 0xfa, 0x8a, 0xaf,
-	0xad, 0xc3, 0xa9, 0x90, 0xfd, 0x14, 0xe4, 0xda, 0x96, 0xe9, 0x22, 0xf9, 0x06, 0x8c, 0x32, 0x9b,
-	0x18, 0x1a, 0xb3, 0xe4, 0xf4, 0xa3, 0x27, 0x33, 0x47, 0xfe, 0xf1, 0x64, 0xa6, 0x70, 0xd7, 0x30,
-	0xbd, 0xcf, 0x3f, 0xbd, 0x52, 0x64, 0xe2, 0xe2, 0x9f, 0xca, 0x51, 0x8a, 0xae, 0x6b, 0xb5, 0x5d,
-	0xe2, 0x14, 0x6f, 0xa1, 0x16, 0xe2, 0x4e, 0x71, 0x0d, 0x8e, 0x5a, 0x36, 0x72, 0x7a, 0xf2, 0x0a,
-	0x8e, 0xcc, 0x74, 0x8b, 0xc5, 0x71, 0x2c, 0x0c, 0xc7, 0xd7, 0x4e, 0x13, 0x69, 0xc4, 0x85, 0x7d,
-	0x69, 0x6a, 0x3f, 0x97, 0x60, 0x0a, 0x8f, 0x19, 0x6e, 0xd3, 0x32, 0x3d, 0xc3, 0xec, 0x1c, 0x2c,
-	0x67, 0xf2, 0x49, 0x18, 0x76, 0x90, 0xea, 0x5a, 0x26, 0x71, 0xd6, 0x51, 0x85, 0xfd, 0x0a, 0x73,
-	0x5c, 0x81, 0xaf, 0xc5, 0x71, 0xc5, 0xd9, 0xfe, 0x97, 0x18, 0x60, 0x77, 0xb6, 0x7e, 0x88, 0x9a,
-	0x07, 0x14, 0x60, 0x33, 0x50, 0xb4, 0x08, 0x79, 0x0a, 0xa0, 0x4c, 0x03, 0xfd, 0x44, 0x00, 0x67,
-	0x61, 0xcc, 0x56, 0xf7, 0x5a, 0x96, 0xaa, 0x35, 0x5c, 0xe3, 0x03, 0x44, 0x42, 0xa7, 0xa0, 0x14,
-	0xd9, 0xb7, 0x75, 0xe3, 0x83, 0x70, 0x90, 0x0e, 0xf5, 0x15, 0xa4, 0x67, 0x61, 0x0c, 0xab, 0x02,
-	0x07, 0x29, 0x4e, 0x34, 0x24, 0x24, 0x46, 0x95, 0x22, 0xfb, 0x86, 0xe1, 0x49, 0xc1, 0x33, 0xd2,
-	0x57, 0xf0, 0x5c, 0x80, 0x63, 0xe8, 0x81, 0x8d, 0xe5, 0x6e, 0xde, 0x43, 0xcd, 0xfb, 0x6e, 0xa7,
-	0xed, 0x96, 0x8e, 0x56, 0xf3, 0xb3, 0x63, 0xca, 0x24, 0xfd, 0xbe, 0xe2, 0x7f, 0x96, 0xdf, 0x86,
-	0x49, 0x07, 0x69, 0x1d, 0x53, 0x53, 0xcd, 0xe6, 0x1e, 0xe5, 0x6e, 0x34, 0x59, 0x46, 0x85, 0x43,
-	0x89, 0x8c, 0x13, 0x4e, 0xd7, 0xef, 0x94, 0x30, 0xa4, 0x56, 0x16, 0xc3, 0x90, 0x19, 0xa6, 0xc7,
-	0x30, 0xa4, 0xe8, 0xba, 0x56, 0xfb, 0x24, 0x07, 0xe3, 0xab, 0xae, 0xbe, 0x8e, 0xd4, 0x16, 0xf3,
-	0x9c, 0x03, 0xf2, 0xf5, 0x4c, 0xdf, 0x79, 0x03, 0x4e, 0xe9, 0x2d, 0x6b, 0x4b, 0x6d, 0x35, 0x76,
-	0x0c, 0xc7, 0xeb, 0xa8, 0xad, 0x86, 0xee, 0x58, 0x1d, 0x1b, 0x4b, 0x84, 0xdd, 0x68, 0x5c, 0x99,
-	0xa2, 0xc3, 0x9b, 0x74, 0xf4, 0x16, 0x1e, 0xac, 0x6b, 0xf2, 0x5b, 0x30, 0xe3, 0xa2, 0xa6, 0x65,
-	0x6a, 0xcc, 0xd4, 0x5b, 0x2d, 0xb7, 0xa1, 0xea, 0x7a, 0xc3, 0x35, 0x74, 0x53, 0xf5, 0x3a, 0x0e,
-	0xa2, 0xa9, 0x77, 0x4c, 0x99, 0xe6, 0xb0, 0x75, 0x7b, 0xb9, 0xe5, 0x2e, 0xe9, 0xfa, 0x3a, 0x87,
-	0x84, 0x23, 0xee, 0x14, 0xbc, 0xd4, 0xa5, 0x14, 0x1e, 0x6a, 0x7f, 0xce, 0x91, 0x50, 0x0b, 0x46,
-	0x36, 0x17, 0xfe, 0x2f, 0x15, 0x16, 0x1b, 0x12, 0xc3, 0xb1, 0x21, 0x11, 0x9f, 0x7f, 0x45, 0x0d,
-	0x72, 0xed, 0xfe, 0x52, 0x82, 0x13, 0xab, 0xae, 0xae, 0x20, 0xfc, 0xfd, 0xc5, 0xbb, 0x64, 0x98,
-	0xf3, 0x33, 0x30, 0x1d, 0xc3, 0x1d, 0xe7, 0xfe, 0xf7, 0x34, 0x94, 0x56, 0x2c, 0x7b, 0x8f, 0xf1,
-	0x5d, 0x0e, 0xf3, 0x2d, 0x70, 0x77, 0x1e, 0x26, 0x5d, 0xa7, 0xd9, 0x88, 0x72, 0x38, 0xee, 0x3a,
-	0xcd, 0xe5, 0x80, 0xc9, 0xf3, 0x30, 0xa9, 0xb9, 0x5e, 0x17, 0x8e, 0x32, 0x3a, 0xae, 0xb9, 0x5e,
-	0x37, 0x0e, 0xd3, 0x13, 0x05, 0x2a, 0x70, 0x7a, 0x77, 0x02, 0xaf, 0x61, 0xf4, 0x44, 0xdc, 0x10,
-	0xa7, 0x27, 0xe0, 0x14, 0x38, 0x85, 0x71, 0x7d, 0x56, 0x20, 0x53, 0x9a, 0xeb, 0xad, 0x85, 0xf3,
-	0x68, 0x58, 0x9f, 0xef, 0x92, 0x28, 0x0b, 0xf4, 0x35, 0x80, 0x74, 0xf6, 0x0b, 0x49, 0x28, 0x2b,
-	0x0e, 0x97, 0xf7, 0x88, 0x75, 0x47, 0xc8, 0x73, 0x1e, 0x47, 0xea, 0x8e, 0x83, 0x65, 0x7d, 0x11,
-	0x80, 0xeb, 0xd7, 0x2d, 0xe5, 0xab, 0xf9, 0x2c, 0x05, 0x8f, 0xfa, 0x0a, 0x76, 0x85, 0x9a, 0xa5,
-	0xb0, 0xaf, 0x9a, 0x25, 0x24, 0xf2, 0x47, 0x12, 0x4c, 0xf0, 0xdd, 0x8c, 0xa4, 0xa6, 0xbe, 0x4a,
-	0x96, 0x33, 0x00, 0x34, 0xe9, 0x09, 0x92, 0x8e, 0x92, 0x2f, 0x44, 0xd0, 0x29, 0x18, 0x42, 0x0f,
-	0x3c, 0x47, 0x65, 0xd6, 0xa1, 0x3f, 0x42, 0xdb, 0xea, 0x1a, 0x9c, 0xec, 0x66, 0x84, 0xbb, 0xe1,
-	0x75, 0x38, 0xca, 0x33, 0x6a, 0x0f, 0x5e, 0x38, 0xa2, 0xd3, 0x0c, 0x5b, 0xf3, 0x88, 0x68, 0xd4,
-	0xd2, 0x54, 0xb4, 0xfe, 0xec, 0x98, 0x2e, 0x5c, 0x58, 0xe3, 0x25, 0x22, 0x87, 0xb0, 0x2a, 0xd7,
-	0xf5, 0x67, 0x39, 0xe2, 0x5e, 0x77, 0x6d, 0xcd, 0x17, 0x71, 0x15, 0xb5, 0xb7, 0x90, 0xd3, 0x27,
-	0x5b, 0xdf, 0x80, 0x22, 0x65, 0xcb, 0xda, 0x35, 0x91, 0x43, 0xf9, 0x4a, 0x99, 0x48, 0x65, 0xb8,
-	0x83, 0xb1, 0x21, 0x89, 0xf2, 0x61, 0x73, 0x7d, 0x17, 0x26, 0xda, 0x84, 0x33, 0xb7, 0xe1, 0x59,
-	0xf8, 0xe4, 0x54, 0x2a, 0x54, 0xf3, 0xb3, 0xc5, 0xf8, 0xda, 0x69, 0xd5, 0xd5, 0x05, 0x59, 0x94,
-	0x31, 0x36, 0x73, 0xc3, 0x5a, 0xd2, 0xf0, 0x26, 0x77, 0x5c, 0xa0, 0xa4, 0x11, 0xa5, 0x94, 0x86,
-	0x88, 0xa3, 0x27, 0x73, 0x3a, 0xc9, 0x49, 0x50, 0x2d, 0xc6, 0xfb, 0x74, 0x44, 0x8d, 0x5c, 0xcf,
-	0xff, 0xf1, 0xb7, 0x2f, 0x13, 0xed, 0x1e, 0x66, 0x35, 0xbf, 0x09, 0x23, 0x4c, 0xd2, 0x7d, 0xe8,
-	0xd7, 0x9f, 0x92, 0xb4, 0x29, 0x76, 0xcb, 0xcc, 0x75, 0xf2, 0x31, 0x8d, 0x73, 0x51, 0x1d, 0x57,
-	0x61, 0x98, 0xd2, 0xca, 0x54, 0x06, 0xc3, 0xc9, 0x75, 0xc0, 0x45, 0x85, 0xe1, 0xa8, 0x9e, 0x61,
-	0x99, 0x0d, 0xcf, 0x60, 0xd1, 0x50, 0x5c, 0x28, 0xcf, 0xd1, 0x2e, 0xca, 0x9c, 0xdf, 0x45, 0x99,
-	0xdb, 0xf0, 0xbb, 0x28, 0xcb, 0x85, 0x87, 0xff, 0x9c, 0x91, 0x94, 0x89, 0x60, 0x22, 0x1e, 0xaa,
-	0xfd, 0x85, 0xda, 0x48, 0x30, 0xe2, 0x77, 0x70, 0x4e, 0x38, 0x74, 0x36, 0xe2, 0x99, 0xab, 0x20,
-	0x66, 0xae, 0x58, 0xdd, 0x87, 0x65, 0xe1, 0xba, 0xff, 0x9d, 0x44, 0x0a, 0x92, 0xdb, 0x48, 0xdd,
-	0x61, 0x79, 0x68, 0xff, 0xaa, 0x3f, 0x30, 0x09, 0x17, 0x8b, 0x58, 0x16, 0xb6, 0x0c, 0x2b, 0xb8,
-	0x03, 0x4e, 0x83, 0xad, 0x31, 0x27, 0xd8, 0x8b, 0x96, 0x3b, 0x75, 0x73, 0xdb, 0x3a, 0xa8, 0x9d,
-	0xf1, 0x76, 0x6c, 0x9b, 0x24, 0x4f, 0x9c, 0xad, 0x12, 0x53, 0xf0, 0xdc, 0xad, 0x9b, 0xde, 0xf5,
-	0x6b, 0x9b, 0x6a, 0xab, 0x83, 0xa2, 0x6d, 0x94, 0x41, 0x34, 0x93, 0x06, 0x70, 0x5c, 0x4e, 0xf3,
-	0x9a, 0x40, 0xa3, 0x5c, 0xe3, 0xbf, 0x92, 0x68, 0x59, 0xa6, 0x9a, 0x4d, 0xd4, 0xea, 0xea, 0x29,
-	0x1c, 0x92, 0x42, 0x6a, 0x06, 0xce, 0xc4, 0xf2, 0x27, 0x1e, 0xd2, 0xc6, 0x56, 0x5d, 0x7d, 0xad,
-	0xe3, 0xad, 0x59, 0x2d, 0xa3, 0xb9, 0xd7, 0x27, 0xe3, 0xdf, 0x82, 0x51, 0xdb, 0x31, 0xcc, 0xa6,
-	0x61, 0xab, 0x2d, 0x96, 0x6f, 0xaa, 0xa2, 0xe6, 0x83, 0x8e, 0xea, 0xdc, 0x9a, 0x8f, 0x53, 0x82,
-	0x29, 0xb8, 0xfa, 0x77, 0x90, 0x6b, 0x75, 0x9c, 0xa6, 0x2f, 0x14, 0xff, 0x2d, 0x7f, 0x1b, 0xc0,
-	0xf5, 0x54, 0x0f, 0x61, 0x53, 0xfb, 0x59, 0x38, 0x89, 0xf8, 0xba, 0x0f, 0x54, 0x84, 0x39, 0xf2,
-	0x6a, 0x34, 0x27, 0x8e, 0x64, 0xe6, 0xc4, 0xa3, 0x8f, 0x9e, 0xcc, 0x48, 0x71, 0x79, 0x31, 0xac,
-	0xe3, 0x35, 0x52, 0x31, 0x70, 0x0d, 0x8a, 0x95, 0xb9, 0x4d, 0xbe, 0xf8, 0xa7, 0xcc, 0xac, 0xca,
-	0x9c, 0xa2, 0xeb, 0x5a, 0xed, 0x8f, 0x62, 0x65, 0x7e, 0x58, 0xed, 0x12, 0x56, 0xc3, 0xba, 0x50,
-	0xb3, 0x0f, 0x4c, 0x13, 0xff, 0xa6, 0x9a, 0x58, 0x35, 0x1c, 0xc7, 0x72, 0x9e, 0x2b, 0xb4, 0x2e,
-	0x41, 0xce, 0xd0, 0x58, 0x4e, 0x4e, 0x5d, 0x3c, 0x67, 0x68, 0xe1, 0x38, 0xcc, 0x67, 0xc5, 0x61,
-	0x21, 0xd2, 0x70, 0xa8, 0xc1, 0xb8, 0x86, 0x5c, 0x7c, 0xe2, 0x57, 0x0d, 0x13, 0x8b, 0x3d, 0x44,
-	0xda, 0x0c, 0x45, 0xfc, 0x71, 0x05, 0x7f, 0xab, 0x6b, 0xf1, 0x87, 0x1e, 0x51, 0x54, 0x1e, 0xa5,
-	0x8f, 0x44, 0x35, 0x3c, 0x57, 0x9f, 0x75, 0xb0, 0x6a, 0x88, 0x48, 0x59, 0xc8, 0x94, 0x52, 0xcc,
-	0xa8, 0x54, 0xca, 0xae, 0x8c, 0xfa, 0x85, 0x58, 0x73, 0x04, 0xe3, 0x2f, 0xac, 0x71, 0xd4, 0xbd,
-	0xa7, 0x14, 0x06, 0xb1, 0xa7, 0x88, 0x76, 0x0e, 0x75, 0xa7, 0x3f, 0xa3, 0x15, 0x20, 0x1d, 0x7b,
-	0x9e, 0xe3, 0xd0, 0xbe, 0xcc, 0x9c, 0x51, 0x5e, 0xf5, 0x61, 0x64, 0x7a, 0xbe, 0x12, 0xc4, 0xe0,
-	0x12, 0x7e, 0x42, 0x3d, 0x99, 0xda, 0x77, 0x8d, 0x5c, 0x8d, 0xc9, 0xd7, 0x61, 0x54, 0xed, 0x78,
-	0xf7, 0x2c, 0x07, 0xab, 0x38, 0x4b, 0xc6, 0x00, 0x2a, 0xdf, 0x80, 0x61, 0x7a, 0xb9, 0x16, 0x54,
-	0xb8, 0x51, 0xbb, 0xd0, 0x35, 0x96, 0x0b, 0x58, 0x09, 0x0a, 0xc3, 0x2f, 0x4e, 0x60, 0x76, 0x03,
-	0x4a, 0xcc, 0x24, 0x22, 0x53, 0x9c, 0xe1, 0xff, 0x4a, 0x70, 0x8c, 0xc8, 0xa2, 0x3b, 0xea, 0x01,
-	0xdf, 0xbe, 0xc8, 0x17, 0xe0, 0x78, 0xa8, 0x8f, 0x64, 0x68, 0xc4, 0x1e, 0xe3, 0xca, 0x84, 0xd8,
-	0x24, 0xaa, 0x6b, 0x69, 0x2d, 0xa7, 0xc2, 0x80, 0x5a, 0x4e, 0x65, 0x28, 0x85, 0x05, 0x0f, 0x5a,
-	0x12, 0x39, 0x32, 0xb8, 0x62, 0xb5, 0x6d, 0x9c, 0xef, 0xbf, 0x12, 0xed, 0x2c, 0x43, 0x25, 0xb6,
-	0x87, 0xbb, 0xad, 0xb6, 0x8d, 0xd6, 0x5e, 0xa0, 0xaa, 0x72, 0xb4, 0x95, 0x7b, 0x93, 0x40, 0xea,
-	0x9a, 0xbc, 0x04, 0x63, 0xfa, 0x8e, 0xde, 0x68, 0xab, 0xb6, 0x6d, 0x98, 0xba, 0x5f, 0x4d, 0x54,
-	0xe2, 0x1c, 0xe7, 0xd6, 0xe6, 0xad, 0x55, 0x0a, 0x53, 0x8a, 0xfa, 0x8e, 0xce, 0xfe, 0x1f, 0x39,
-	0xd3, 0xd5, 0xa0, 0x9a, 0xa4, 0x08, 0xae, 0xad, 0x0f, 0x69, 0xdb, 0x84, 0x54, 0x61, 0x5f, 0x85,
-	0xaa, 0xc2, 0x3c, 0x56, 0xa1, 0x12, 0xbf, 0x7e, 0x88, 0x43, 0xda, 0xae, 0x7d, 0x71, 0x1c, 0xc6,
-	0xac, 0xcf, 0x39, 0xfc, 0x8d, 0x04, 0xa3, 0xa4, 0x17, 0xee, 0x6d, 0xa8, 0x7a, 0x9f, 0x5c, 0x89,
-	0xd5, 0x4c, 0x2e, 0x54, 0x65, 0x5e, 0x83, 0x82, 0xa7, 0xea, 0x2e, 0x3b, 0xbf, 0x54, 0xe3, 0x6f,
-	0xa0, 0x28, 0x76, 0x43, 0xd5, 0x5d, 0x85, 0xa0, 0xc3, 0x62, 0x9c, 0x80, 0xe3, 0x9c, 0x47, 0xce,
-	0xf9, 0xc3, 0x1c, 0x51, 0xae, 0xb8, 0xa5, 0xad, 0xd0, 0xdb, 0xb7, 0x17, 0xb6, 0xab, 0xf5, 0x70,
-	0xf7, 0x18, 0xbe, 0x37, 0x1c, 0x8a, 0xde, 0x1b, 0xf6, 0x7f, 0xaf, 0x41, 0xcd, 0x1d, 0xa3, 0x11,
-	0xae, 0xb4, 0xdf, 0x4a, 0xa4, 0x81, 0x44, 0x7d, 0xf6, 0x10, 0xa9, 0x2e, 0x2c, 0xc9, 0x79, 0x78,
-	0x39, 0x8d, 0x4d, 0x2e, 0xcf, 0xdf, 0xf2, 0xbc, 0x3c, 0xd6, 0x55, 0x0f, 0x0d, 0xe0, 0xac, 0x28,
-	0xb4, 0x80, 0x73, 0x7d, 0xde, 0x5a, 0xf7, 0x51, 0xd7, 0x86, 0x3d, 0x67, 0x28, 0xdb, 0x73, 0x62,
-	0x6e, 0x9c, 0xbb, 0xab, 0xaa, 0x91, 0xbe, 0x2e, 0xb6, 0x5f, 0xd4, 0x45, 0x73, 0xc8, 0x01, 0xbe,
-	0x0f, 0x33, 0x09, 0x76, 0x1d, 0xc0, 0x15, 0xcd, 0x5f, 0x73, 0x24, 0x50, 0x7c, 0xea, 0x83, 0x8b,
-	0x83, 0x05, 0x18, 0xe9, 0x10, 0x62, 0x3d, 0x38, 0x0f, 0x03, 0x1e, 0x1a, 0xe7, 0x89, 0x33, 0xfc,
-	0x48, 0x4f, 0x69, 0x67, 0x16, 0xce, 0xa7, 0x6b, 0x93, 0x87, 0xeb, 0x4f, 0x25, 0x72, 0x4c, 0xd9,
-	0xb0, 0x74, 0xbd, 0x85, 0xd6, 0xd7, 0x96, 0x5c, 0x7f, 0x92, 0xb6, 0xa4, 0x1f, 0x5c, 0xf6, 0x09,
-	0xf3, 0xfb, 0x0a, 0x9c, 0x4b, 0x61, 0x82, 0x33, 0xfb, 0x65, 0x0e, 0x4e, 0xd3, 0x6d, 0x87, 0xee,
-	0x99, 0x37, 0x5b, 0xd6, 0xae, 0xa2, 0x7a, 0xe8, 0xb6, 0xd1, 0x36, 0x0e, 0x2c, 0x51, 0x7e, 0x13,
-	0xc6, 0x18, 0x80, 0x76, 0x3b, 0xf3, 0x19, 0xa4, 0x19, 0x39, 0xda, 0xee, 0x1c, 0x40, 0xb3, 0x4f,
-	0x83, 0xc9, 0xed, 0x96, 0xb5, 0xdb, 0xc0, 0xa5, 0x42, 0xa3, 0x85, 0x25, 0x65, 0xcf, 0xc6, 0xde,
-	0x64, 0xa1, 0x75, 0x5e, 0x37, 0xbc, 0x7b, 0x9d, 0x2d, 0x5c, 0xfb, 0xb2, 0x37, 0x86, 0xec, 0x9f,
-	0x2b, 0xae, 0x76, 0x9f, 0x3d, 0xba, 0xab, 0x93, 0xe0, 0x03, 0xb6, 0x60, 0xdd, 0xf4, 0x94, 0xf1,
-	0x6d, 0x51, 0x79, 0x61, 0x83, 0x9c, 0x83, 0xb3, 0x89, 0x8a, 0xf6, 0xcd, 0xb1, 0xf0, 0xeb, 0x0a,
-	0xe4, 0x57, 0x5d, 0x5d, 0x7e, 0x1f, 0xc6, 0xba, 0xde, 0xf1, 0x9d, 0x4b, 0xb8, 0x39, 0x10, 0x41,
-	0xe5, 0x4b, 0x3d, 0x80, 0x78, 0x62, 0x79, 0x1f, 0xc6, 0xba, 0x1e, 0x85, 0x25, 0xad, 0x20, 0x82,
-	0x12, 0x57, 0x88, 0x7b, 0xe5, 0x25, 0xb7, 0xe0, 0x58, 0xa4, 0x9d, 0xfc, 0x6a, 0x02, 0x81, 0x30,
-	0xb0, 0x3c, 0xdf, 0x23, 0x50, 0x94, 0xa7, 0xab, 0xc5, 0x91, 0x24, 0x8f, 0x08, 0x4a, 0x94, 0x27,
-	0xee, 0x80, 0x2d, 0x5b, 0x70, 0x3c, 0xfa, 0x62, 0x6d, 0x36, 0x49, 0x23, 0x61, 0x64, 0xf9, 0x6a,
-	0xaf, 0x48, 0xbe, 0xe0, 0xcf, 0x24, 0x28, 0x25, 0x66, 0x91, 0x24, 0x05, 0x25, 0x4d, 0x28, 0x7f,
-	0x7d, 0x9f, 0x13, 0x44, 0xcd, 0x76, 0x95, 0x1c, 0xe9, 0xbe, 0x48, 0x41, 0x19, 0xbe, 0x18, 0xda,
-	0xe4, 0xde, 0x03, 0x10, 0x5e, 0xa1, 0x9c, 0x4d, 0x98, 0x1a, 0x40, 0xca, 0x17, 0x32, 0x21, 0x22,
-	0xf7, 0x5d, 0xaf, 0x88, 0xce, 0x65, 0x4e, 0xdd, 0x5c, 0x48, 0xe4, 0x3e, 0xee, 0x35, 0x0d, 0xf6,
-	0xf3, 0xc8, 0x4b, 0x9a, 0x24, 0x3f, 0x0f, 0x03, 0x13, 0xfd, 0x3c, 0xe9, 0xf5, 0x0b, 0xd6, 0x95,
-	0xf0, 0xf2, 0x25, 0x49, 0x57, 0x01, 0x24, 0x51, 0x57, 0x31, 0xef, 0x41, 0x78, 0x4e, 0xc8, 0xb0,
-	0xb4, 0x08, 0xca, 0xc8, 0x09, 0xa1, 0x15, 0x1c, 0x90, 0x63, 0x2e, 0x3c, 0x12, 0x59, 0x8c, 0x40,
-	0xcb, 0xaf, 0xf5, 0x0c, 0x8d, 0x66, 0x86, 0x0c, 0xa9, 0x44, 0x50, 0x46, 0x66, 0x08, 0xad, 0xd0,
-	0x9d, 0x19, 0xd8, 0x32, 0x3d, 0x64, 0x06, 0xb6, 0xd6, 0xd5, 0x5e, 0x91, 0xd1, 0xd4, 0x2a, 0x74,
-	0x39, 0xd3, 0x53, 0x6b, 0x00, 0xcc, 0x48, 0xad, 0xd1, 0xbe, 0xaa, 0xdc, 0x81, 0x13, 0x71, 0xd5,
-	0xe3, 0xc5, 0x1e, 0xe8, 0x30, 0x6c, 0x79, 0xa1, 0x77, 0x2c, 0x5f, 0xf6, 0x23, 0x09, 0x4e, 0x27,
-	0x9f, 0xe1, 0xae, 0xa6, 0x3a, 0x42, 0x1c, 0x0f, 0x37, 0xf6, 0x3b, 0x83, 0x73, 0xf2, 0x00, 0xa6,
-	0x62, 0x0f, 0x5f, 0x69, 0xae, 0x1f, 0x06, 0x97, 0x5f, 0xdf, 0x07, 0x98, 0xaf, 0xfc, 0xb1, 0x04,
-	0xd3, 0x69, 0x15, 0xfc, 0x42, 0x06, 0xd1, 0x38, 0x3d, 0x2c, 0xee, 0x7f, 0x0e, 0xe7, 0xe7, 0x07,
-	0x50, 0x14, 0x9f, 0x12, 0xd5, 0x52, 0xb3, 0x3c, 0xc1, 0x94, 0x2f, 0x66, 0x63, 0x44, 0xf2, 0xe2,
-	0x73, 0x9e, 0x5a, 0x6a, 0x6a, 0x49, 0x27, 0x1f, 0xf3, 0x40, 0x07, 0xc7, 0x69, 0xf4, 0x71, 0xce,
-	0x6c, 0xaa, 0x6b, 0x0a, 0xc8, 0xc4, 0x38, 0x4d, 0x7c, 0xa9, 0x12, 0xc4, 0xa9, 0xf0, 0x02, 0xe2,
-	0xd5, 0x6c, 0x2a, 0x04, 0x98, 0x11, 0xa7, 0xd1, 0x77, 0x08, 0x78, 0x6b, 0x10, 0xde, 0x20, 0x24,
-	0x6d, 0x0d, 0x01, 0x24, 0x71, 0x6b, 0x88, 0xbe, 0x0f, 0xc0, 0x96, 0x11, 0x6f, 0x16, 0x6a, 0xa9,
-	0xe9, 0x31, 0xdd, 0x32, 0x31, 0xad, 0x7d, 0xba, 0x87, 0x86, 0x9e, 0xf3, 0x24, 0xef, 0xa1, 0xdd,
-	0xc0, 0x94, 0x3d, 0x34, 0xfe, 0xb1, 0x8c, 0xfc, 0x3d, 0x18, 0x0d, 0x2e, 0xad, 0xab, 0x09, 0xb3,
-	0x39, 0xa2, 0x3c, 0x9b, 0x85, 0x88, 0x6e, 0xa0, 0x8c, 0x76, 0xfa, 0x06, 0xca, 0xc8, 0x5f, 0xea,
-	0x01, 0x24, 0xae, 0xd0, 0x75, 0xff, 0x71, 0x2e, 0xd5, 0x49, 0x28, 0x28, 0x71, 0x85, 0xb8, 0x4b,
-	0x0b, 0xb9, 0x09, 0xe3, 0xdd, 0x5d, 0xdc, 0x97, 0x13, 0xed, 0x28, 0xa0, 0xca, 0x97, 0x7b, 0x41,
-	0xf1, 0x45, 0x7e, 0x0c, 0x2f, 0xc5, 0xf7, 0xff, 0x2f, 0x27, 0x56, 0x2b, 0x31, 0xe8, 0xf2, 0xb5,
-	0xfd, 0xa0, 0xc5, 0xfd, 0x2c, 0xae, 0x9f, 0x7e, 0x31, 0x75, 0x7f, 0xe8, 0x5e, 0x78, 0xa1, 0x77,
-	0xac, 0xb8, 0x6c, 0x5c, 0x93, 0xfc, 0x62, 0x6a, 0x05, 0xd8, 0xdb, 0xb2, 0x29, 0xcd, 0x6f, 0xf9,
-	0x1d, 0x18, 0x66, 0x8d, 0xef, 0x33, 0x89, 0x55, 0x2d, 0x1e, 0x2e, 0xbf, 0x92, 0x3a, 0xcc, 0xe9,
-	0x7d, 0x08, 0x27, 0x13, 0xba, 0x05, 0x57, 0x92, 0x09, 0xc4, 0xc0, 0xcb, 0x6f, 0xec, 0x0b, 0xee,
-	0xaf, 0xbf, 0x5c, 0x7f, 0xf4, 0xb4, 0x22, 0x3d, 0x7e, 0x5a, 0x91, 0xbe, 0x78, 0x5a, 0x91, 0x1e,
-	0x3e, 0xab, 0x1c, 0x79, 0xfc, 0xac, 0x72, 0xe4, 0xef, 0xcf, 0x2a, 0x47, 0xde, 0x9b, 0x17, 0x4e,
-	0xed, 0x5b, 0xe6, 0xd6, 0x15, 0x72, 0xe9, 0x38, 0x2f, 0xfc, 0xd5, 0xdc, 0x83, 0xee, 0xbf, 0x9b,
-	0xdb, 0x1a, 0x26, 0x4f, 0x37, 0x5e, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x48, 0xd6,
-	0x34, 0x9f, 0x38, 0x00, 0x00,
+func (m *MsgSetBucketVersioning) Reset()         { *m = MsgSetBucketVersioning{} }
+func (m *MsgSetBucketVersioning) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketVersioning) ProtoMessage()    {}
+func (*MsgSetBucketVersioning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{73}
+}
+func (m *MsgSetBucketVersioning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketVersioning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketVersioning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBucketVersioning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketVersioning.Merge(m, src)
+}
+func (m *MsgSetBucketVersioning) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketVersioning) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketVersioning.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSetBucketVersioning proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetBucketVersioning) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// basic operation of bucket
-	CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error)
-	DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error)
-	UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error)
-	MirrorBucket(ctx context.Context, in *MsgMirrorBucket, opts ...grpc.CallOption) (*MsgMirrorBucketResponse, error)
-	DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error)
-	ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error)
-	// basic operation of object
-	CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error)
-	SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error)
-	SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error)
-	RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error)
-	CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error)
-	DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error)
-	CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error)
-	MirrorObject(ctx context.Context, in *MsgMirrorObject, opts ...grpc.CallOption) (*MsgMirrorObjectResponse, error)
-	DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error)
-	UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error)
-	UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error)
-	CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error)
-	DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error)
-	DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error)
-	// basic operation of group
-	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
-	DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error)
-	UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error)
-	UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error)
-	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
-	MirrorGroup(ctx context.Context, in *MsgMirrorGroup, opts ...grpc.CallOption) (*MsgMirrorGroupResponse, error)
-	RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error)
-	// basic operation of policy
-	PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error)
-	DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error)
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error)
-	CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error)
-	CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error)
-	RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error)
-	// Since: Manchurian upgrade
-	SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error)
-	SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error)
+func (m *MsgSetBucketVersioning) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetBucketVersioning) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgSetBucketVersioningResponse struct {
 }
 
-func (c *msgClient) CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error) {
-	out := new(MsgCreateBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketVersioningResponse) Reset()         { *m = MsgSetBucketVersioningResponse{} }
+func (m *MsgSetBucketVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketVersioningResponse) ProtoMessage()    {}
+func (*MsgSetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{74}
+}
+func (m *MsgSetBucketVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketVersioningResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketVersioningResponse.Merge(m, src)
+}
+func (m *MsgSetBucketVersioningResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketVersioningResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error) {
-	out := new(MsgDeleteBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetBucketVersioningResponse proto.InternalMessageInfo
+
+type MsgDeleteObjectVersion struct {
+	// operator defines the account address of the operator who has the DeleteObject permission of the object.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket where the object is stored.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name defines the name of the object.
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// version defines the version of the object to be deleted, the current version of the object can not be deleted.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (c *msgClient) UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error) {
-	out := new(MsgUpdateBucketInfoResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateBucketInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteObjectVersion) Reset()         { *m = MsgDeleteObjectVersion{} }
+func (m *MsgDeleteObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteObjectVersion) ProtoMessage()    {}
+func (*MsgDeleteObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{75}
+}
+func (m *MsgDeleteObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteObjectVersion.Merge(m, src)
+}
+func (m *MsgDeleteObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteObjectVersion.DiscardUnknown(m)
 }
 
-func (c *msgClient) MirrorBucket(ctx context.Context, in *MsgMirrorBucket, opts ...grpc.CallOption) (*MsgMirrorBucketResponse, error) {
-	out := new(MsgMirrorBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MirrorBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteObjectVersion proto.InternalMessageInfo
+
+func (m *MsgDeleteObjectVersion) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error) {
-	out := new(MsgDiscontinueBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DiscontinueBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteObjectVersion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error) {
-	out := new(MsgToggleSPAsDelegatedAgentResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/ToggleSPAsDelegatedAgent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteObjectVersion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error) {
-	out := new(MsgCreateObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreateObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error) {
-	out := new(MsgSealObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SealObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDeleteObjectVersionResponse struct {
 }
 
-func (c *msgClient) SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error) {
-	out := new(MsgSealObjectV2Response)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SealObjectV2", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteObjectVersionResponse) Reset()         { *m = MsgDeleteObjectVersionResponse{} }
+func (m *MsgDeleteObjectVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteObjectVersionResponse) ProtoMessage()    {}
+func (*MsgDeleteObjectVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{76}
+}
+func (m *MsgDeleteObjectVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteObjectVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteObjectVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteObjectVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteObjectVersionResponse.Merge(m, src)
+}
+func (m *MsgDeleteObjectVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteObjectVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteObjectVersionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error) {
-	out := new(MsgRejectSealObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RejectSealObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDeleteObjectVersionResponse proto.InternalMessageInfo
+
+type MsgSetBucketLifecycle struct {
+	// operator defines the account address of the operator, only the bucket owner can send the tx.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// rules defines the lifecycle rules of the bucket, they replace the existing rules.
+	// Empty rules remove the lifecycle configuration of the bucket.
+	Rules []LifecycleRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules"`
 }
 
-func (c *msgClient) CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error) {
-	out := new(MsgCopyObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CopyObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycle) Reset()         { *m = MsgSetBucketLifecycle{} }
+func (m *MsgSetBucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketLifecycle) ProtoMessage()    {}
+func (*MsgSetBucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{77}
+}
+func (m *MsgSetBucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketLifecycle.Merge(m, src)
+}
+func (m *MsgSetBucketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketLifecycle.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error) {
-	out := new(MsgDeleteObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBucketLifecycle proto.InternalMessageInfo
+
+func (m *MsgSetBucketLifecycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error) {
-	out := new(MsgCancelCreateObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CancelCreateObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycle) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MirrorObject(ctx context.Context, in *MsgMirrorObject, opts ...grpc.CallOption) (*MsgMirrorObjectResponse, error) {
-	out := new(MsgMirrorObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MirrorObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycle) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error) {
-	out := new(MsgDiscontinueObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DiscontinueObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetBucketLifecycleResponse struct {
 }
 
-func (c *msgClient) UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error) {
-	out := new(MsgUpdateObjectInfoResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateObjectInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycleResponse) Reset()         { *m = MsgSetBucketLifecycleResponse{} }
+func (m *MsgSetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketLifecycleResponse) ProtoMessage()    {}
+func (*MsgSetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{78}
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketLifecycleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error) {
-	out := new(MsgUpdateObjectContentResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateObjectContent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetBucketLifecycleResponse proto.InternalMessageInfo
+
+type MsgSetRetention struct {
+	// operator defines the account address of the operator, only the bucket owner can send the tx.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name defines the name of the object, empty means the retention is set on the bucket.
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// retention defines the new retention, RETENTION_MODE_NONE removes the retention.
+	Retention Retention `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention"`
 }
 
-func (c *msgClient) CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error) {
-	out := new(MsgCancelUpdateObjectContentResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CancelUpdateObjectContent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetention) Reset()         { *m = MsgSetRetention{} }
+func (m *MsgSetRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetention) ProtoMessage()    {}
+func (*MsgSetRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{79}
+}
+func (m *MsgSetRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetention.Merge(m, src)
+}
+func (m *MsgSetRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetention.DiscardUnknown(m)
 }
 
-func (c *msgClient) DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error) {
-	out := new(MsgDelegateCreateObjectResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DelegateCreateObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetRetention proto.InternalMessageInfo
+
+func (m *MsgSetRetention) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error) {
-	out := new(MsgDelegateUpdateObjectContentResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DelegateUpdateObjectContent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetention) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
-	out := new(MsgCreateGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreateGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetention) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error) {
-	out := new(MsgDeleteGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetention) GetRetention() Retention {
+	if m != nil {
+		return m.Retention
 	}
-	return out, nil
+	return Retention{}
 }
 
-func (c *msgClient) UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error) {
-	out := new(MsgUpdateGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetRetentionResponse struct {
 }
 
-func (c *msgClient) UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error) {
-	out := new(MsgUpdateGroupExtraResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateGroupExtra", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetentionResponse) Reset()         { *m = MsgSetRetentionResponse{} }
+func (m *MsgSetRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetentionResponse) ProtoMessage()    {}
+func (*MsgSetRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{80}
+}
+func (m *MsgSetRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetentionResponse.Merge(m, src)
+}
+func (m *MsgSetRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetentionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
-	out := new(MsgLeaveGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/LeaveGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetRetentionResponse proto.InternalMessageInfo
+
+type MsgSetLegalHold struct {
+	// operator defines the account address of the operator, only the bucket owner can send the tx.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name defines the name of the object, empty means the legal hold is set on the bucket.
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// legal_hold defines whether the legal hold is placed or released.
+	LegalHold bool `protobuf:"varint,4,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
 }
 
-func (c *msgClient) MirrorGroup(ctx context.Context, in *MsgMirrorGroup, opts ...grpc.CallOption) (*MsgMirrorGroupResponse, error) {
-	out := new(MsgMirrorGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MirrorGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetLegalHold) Reset()         { *m = MsgSetLegalHold{} }
+func (m *MsgSetLegalHold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLegalHold) ProtoMessage()    {}
+func (*MsgSetLegalHold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{81}
+}
+func (m *MsgSetLegalHold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLegalHold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLegalHold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetLegalHold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLegalHold.Merge(m, src)
+}
+func (m *MsgSetLegalHold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLegalHold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLegalHold.DiscardUnknown(m)
 }
 
-func (c *msgClient) RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error) {
-	out := new(MsgRenewGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RenewGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetLegalHold proto.InternalMessageInfo
+
+func (m *MsgSetLegalHold) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error) {
-	out := new(MsgPutPolicyResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/PutPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetLegalHold) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error) {
-	out := new(MsgDeletePolicyResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeletePolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetLegalHold) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetLegalHold) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error) {
-	out := new(MsgMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetLegalHoldResponse struct {
 }
 
-func (c *msgClient) CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error) {
-	out := new(MsgCompleteMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CompleteMigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetLegalHoldResponse) Reset()         { *m = MsgSetLegalHoldResponse{} }
+func (m *MsgSetLegalHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLegalHoldResponse) ProtoMessage()    {}
+func (*MsgSetLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{82}
+}
+func (m *MsgSetLegalHoldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLegalHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLegalHoldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetLegalHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLegalHoldResponse.Merge(m, src)
+}
+func (m *MsgSetLegalHoldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLegalHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLegalHoldResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error) {
-	out := new(MsgCancelMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CancelMigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetLegalHoldResponse proto.InternalMessageInfo
+
+type MsgComposeObject struct {
+	// operator defines the account address of the operator who has the CreateObject permission of the bucket
+	// and the DeleteObject permission of the source objects.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket where the source objects are stored.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name defines the name of the composed object.
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// source_object_names defines the sealed objects to be concatenated in order, they are consumed by the composed object.
+	SourceObjectNames []string `protobuf:"bytes,4,rep,name=source_object_names,json=sourceObjectNames,proto3" json:"source_object_names,omitempty"`
+	// content_type defines a standard MIME type describing the format of the composed object.
+	ContentType string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// visibility means the object is private or public. if private, only object owner or grantee can access it,
+	// otherwise every greenfield user can access it.
+	Visibility VisibilityType `protobuf:"varint,6,opt,name=visibility,proto3,enum=greenfield.storage.VisibilityType" json:"visibility,omitempty"`
+}
+
+func (m *MsgComposeObject) Reset()         { *m = MsgComposeObject{} }
+func (m *MsgComposeObject) String() string { return proto.CompactTextString(m) }
+func (*MsgComposeObject) ProtoMessage()    {}
+func (*MsgComposeObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{83}
+}
+func (m *MsgComposeObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgComposeObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgComposeObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgComposeObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgComposeObject.Merge(m, src)
+}
+func (m *MsgComposeObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgComposeObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgComposeObject.DiscardUnknown(m)
 }
 
-func (c *msgClient) RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error) {
-	out := new(MsgRejectMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RejectMigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgComposeObject proto.InternalMessageInfo
+
+func (m *MsgComposeObject) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error) {
-	out := new(MsgSetTagResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetTag", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgComposeObject) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error) {
-	out := new(MsgSetBucketFlowRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetBucketFlowRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgComposeObject) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// basic operation of bucket
-	CreateBucket(context.Context, *MsgCreateBucket) (*MsgCreateBucketResponse, error)
-	DeleteBucket(context.Context, *MsgDeleteBucket) (*MsgDeleteBucketResponse, error)
-	UpdateBucketInfo(context.Context, *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error)
-	MirrorBucket(context.Context, *MsgMirrorBucket) (*MsgMirrorBucketResponse, error)
-	DiscontinueBucket(context.Context, *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error)
-	ToggleSPAsDelegatedAgent(context.Context, *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error)
-	// basic operation of object
-	CreateObject(context.Context, *MsgCreateObject) (*MsgCreateObjectResponse, error)
-	SealObject(context.Context, *MsgSealObject) (*MsgSealObjectResponse, error)
-	SealObjectV2(context.Context, *MsgSealObjectV2) (*MsgSealObjectV2Response, error)
-	RejectSealObject(context.Context, *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error)
-	CopyObject(context.Context, *MsgCopyObject) (*MsgCopyObjectResponse, error)
-	DeleteObject(context.Context, *MsgDeleteObject) (*MsgDeleteObjectResponse, error)
-	CancelCreateObject(context.Context, *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error)
-	MirrorObject(context.Context, *MsgMirrorObject) (*MsgMirrorObjectResponse, error)
-	DiscontinueObject(context.Context, *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error)
-	UpdateObjectInfo(context.Context, *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error)
-	UpdateObjectContent(context.Context, *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error)
-	CancelUpdateObjectContent(context.Context, *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error)
-	DelegateCreateObject(context.Context, *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error)
-	DelegateUpdateObjectContent(context.Context, *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error)
-	// basic operation of group
-	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
-	DeleteGroup(context.Context, *MsgDeleteGroup) (*MsgDeleteGroupResponse, error)
-	UpdateGroupMember(context.Context, *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error)
-	UpdateGroupExtra(context.Context, *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error)
-	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
-	MirrorGroup(context.Context, *MsgMirrorGroup) (*MsgMirrorGroupResponse, error)
-	RenewGroupMember(context.Context, *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error)
-	// basic operation of policy
-	PutPolicy(context.Context, *MsgPutPolicy) (*MsgPutPolicyResponse, error)
-	DeletePolicy(context.Context, *MsgDeletePolicy) (*MsgDeletePolicyResponse, error)
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	MigrateBucket(context.Context, *MsgMigrateBucket) (*MsgMigrateBucketResponse, error)
-	CompleteMigrateBucket(context.Context, *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error)
-	CancelMigrateBucket(context.Context, *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error)
-	RejectMigrateBucket(context.Context, *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error)
-	// Since: Manchurian upgrade
-	SetTag(context.Context, *MsgSetTag) (*MsgSetTagResponse, error)
-	SetBucketFlowRateLimit(context.Context, *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error)
+func (m *MsgComposeObject) GetSourceObjectNames() []string {
+	if m != nil {
+		return m.SourceObjectNames
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgComposeObject) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) CreateBucket(ctx context.Context, req *MsgCreateBucket) (*MsgCreateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
+func (m *MsgComposeObject) GetVisibility() VisibilityType {
+	if m != nil {
+		return m.Visibility
+	}
+	return VISIBILITY_TYPE_UNSPECIFIED
 }
-func (*UnimplementedMsgServer) DeleteBucket(ctx context.Context, req *MsgDeleteBucket) (*MsgDeleteBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
+
+type MsgComposeObjectResponse struct {
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
 }
-func (*UnimplementedMsgServer) UpdateBucketInfo(ctx context.Context, req *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBucketInfo not implemented")
+
+func (m *MsgComposeObjectResponse) Reset()         { *m = MsgComposeObjectResponse{} }
+func (m *MsgComposeObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgComposeObjectResponse) ProtoMessage()    {}
+func (*MsgComposeObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{84}
 }
-func (*UnimplementedMsgServer) MirrorBucket(ctx context.Context, req *MsgMirrorBucket) (*MsgMirrorBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MirrorBucket not implemented")
+func (m *MsgComposeObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DiscontinueBucket(ctx context.Context, req *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueBucket not implemented")
+func (m *MsgComposeObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgComposeObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ToggleSPAsDelegatedAgent(ctx context.Context, req *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleSPAsDelegatedAgent not implemented")
+func (m *MsgComposeObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgComposeObjectResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateObject(ctx context.Context, req *MsgCreateObject) (*MsgCreateObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateObject not implemented")
+func (m *MsgComposeObjectResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SealObject(ctx context.Context, req *MsgSealObject) (*MsgSealObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SealObject not implemented")
+func (m *MsgComposeObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgComposeObjectResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SealObjectV2(ctx context.Context, req *MsgSealObjectV2) (*MsgSealObjectV2Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SealObjectV2 not implemented")
+
+var xxx_messageInfo_MsgComposeObjectResponse proto.InternalMessageInfo
+
+// BatchCreateObjectItem defines an object to be created by MsgBatchCreateObjects.
+type BatchCreateObjectItem struct {
+	// object_name defines the name of object
+	ObjectName string `protobuf:"bytes,1,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// payload_size defines size of the object's payload
+	PayloadSize uint64 `protobuf:"varint,2,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// visibility means the object is private or public. if private, only object owner or grantee can access it,
+	// otherwise every greenfield user can access it.
+	Visibility VisibilityType `protobuf:"varint,3,opt,name=visibility,proto3,enum=greenfield.storage.VisibilityType" json:"visibility,omitempty"`
+	// content_type defines a standard MIME type describing the format of the object.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// expect_checksums defines a list of hashes which was generate by redundancy algorithm.
+	ExpectChecksums [][]byte `protobuf:"bytes,5,rep,name=expect_checksums,json=expectChecksums,proto3" json:"expect_checksums,omitempty"`
+	// redundancy_type can be ec or replica
+	RedundancyType RedundancyType `protobuf:"varint,6,opt,name=redundancy_type,json=redundancyType,proto3,enum=greenfield.storage.RedundancyType" json:"redundancy_type,omitempty"`
 }
-func (*UnimplementedMsgServer) RejectSealObject(ctx context.Context, req *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectSealObject not implemented")
+
+func (m *BatchCreateObjectItem) Reset()         { *m = BatchCreateObjectItem{} }
+func (m *BatchCreateObjectItem) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateObjectItem) ProtoMessage()    {}
+func (*BatchCreateObjectItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{85}
 }
-func (*UnimplementedMsgServer) CopyObject(ctx context.Context, req *MsgCopyObject) (*MsgCopyObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CopyObject not implemented")
+func (m *BatchCreateObjectItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteObject(ctx context.Context, req *MsgDeleteObject) (*MsgDeleteObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+func (m *BatchCreateObjectItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateObjectItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelCreateObject(ctx context.Context, req *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelCreateObject not implemented")
+func (m *BatchCreateObjectItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateObjectItem.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MirrorObject(ctx context.Context, req *MsgMirrorObject) (*MsgMirrorObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MirrorObject not implemented")
+func (m *BatchCreateObjectItem) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DiscontinueObject(ctx context.Context, req *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueObject not implemented")
+func (m *BatchCreateObjectItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateObjectItem.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateObjectInfo(ctx context.Context, req *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectInfo not implemented")
+
+var xxx_messageInfo_BatchCreateObjectItem proto.InternalMessageInfo
+
+func (m *BatchCreateObjectItem) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpdateObjectContent(ctx context.Context, req *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectContent not implemented")
+
+func (m *BatchCreateObjectItem) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) CancelUpdateObjectContent(ctx context.Context, req *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelUpdateObjectContent not implemented")
+
+func (m *BatchCreateObjectItem) GetVisibility() VisibilityType {
+	if m != nil {
+		return m.Visibility
+	}
+	return VISIBILITY_TYPE_UNSPECIFIED
 }
-func (*UnimplementedMsgServer) DelegateCreateObject(ctx context.Context, req *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateCreateObject not implemented")
+
+func (m *BatchCreateObjectItem) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) DelegateUpdateObjectContent(ctx context.Context, req *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateUpdateObjectContent not implemented")
+
+func (m *BatchCreateObjectItem) GetExpectChecksums() [][]byte {
+	if m != nil {
+		return m.ExpectChecksums
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+
+func (m *BatchCreateObjectItem) GetRedundancyType() RedundancyType {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return REDUNDANCY_EC_TYPE
 }
-func (*UnimplementedMsgServer) DeleteGroup(ctx context.Context, req *MsgDeleteGroup) (*MsgDeleteGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+type MsgBatchCreateObjects struct {
+	// creator defines the account address of object uploader
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// bucket_name defines the name of the bucket where the objects are stored.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// objects defines the objects to be created, they are created all or none.
+	Objects []BatchCreateObjectItem `protobuf:"bytes,3,rep,name=objects,proto3" json:"objects"`
 }
-func (*UnimplementedMsgServer) UpdateGroupMember(ctx context.Context, req *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupMember not implemented")
+
+func (m *MsgBatchCreateObjects) Reset()         { *m = MsgBatchCreateObjects{} }
+func (m *MsgBatchCreateObjects) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateObjects) ProtoMessage()    {}
+func (*MsgBatchCreateObjects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{86}
 }
-func (*UnimplementedMsgServer) UpdateGroupExtra(ctx context.Context, req *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupExtra not implemented")
+func (m *MsgBatchCreateObjects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+func (m *MsgBatchCreateObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateObjects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) MirrorGroup(ctx context.Context, req *MsgMirrorGroup) (*MsgMirrorGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MirrorGroup not implemented")
+func (m *MsgBatchCreateObjects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateObjects.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RenewGroupMember(ctx context.Context, req *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewGroupMember not implemented")
+func (m *MsgBatchCreateObjects) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) PutPolicy(ctx context.Context, req *MsgPutPolicy) (*MsgPutPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutPolicy not implemented")
+func (m *MsgBatchCreateObjects) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateObjects.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeletePolicy(ctx context.Context, req *MsgDeletePolicy) (*MsgDeletePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+
+var xxx_messageInfo_MsgBatchCreateObjects proto.InternalMessageInfo
+
+func (m *MsgBatchCreateObjects) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+func (m *MsgBatchCreateObjects) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MigrateBucket(ctx context.Context, req *MsgMigrateBucket) (*MsgMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateBucket not implemented")
+
+func (m *MsgBatchCreateObjects) GetObjects() []BatchCreateObjectItem {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) CompleteMigrateBucket(ctx context.Context, req *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteMigrateBucket not implemented")
+
+type MsgBatchCreateObjectsResponse struct {
+	ObjectIds []Uint `protobuf:"bytes,1,rep,name=object_ids,json=objectIds,proto3,customtype=Uint" json:"object_ids"`
 }
-func (*UnimplementedMsgServer) CancelMigrateBucket(ctx context.Context, req *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelMigrateBucket not implemented")
+
+func (m *MsgBatchCreateObjectsResponse) Reset()         { *m = MsgBatchCreateObjectsResponse{} }
+func (m *MsgBatchCreateObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateObjectsResponse) ProtoMessage()    {}
+func (*MsgBatchCreateObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{87}
 }
-func (*UnimplementedMsgServer) RejectMigrateBucket(ctx context.Context, req *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectMigrateBucket not implemented")
+func (m *MsgBatchCreateObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetTag(ctx context.Context, req *MsgSetTag) (*MsgSetTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTag not implemented")
+func (m *MsgBatchCreateObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetBucketFlowRateLimit(ctx context.Context, req *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBucketFlowRateLimit not implemented")
+func (m *MsgBatchCreateObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateObjectsResponse.Merge(m, src)
+}
+func (m *MsgBatchCreateObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCreateObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateObjectsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgBatchCreateObjectsResponse proto.InternalMessageInfo
+
+type MsgBatchDeleteObjects struct {
+	// operator defines the account address of the operator who has the DeleteObject permission of the objects to be deleted.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket where the objects are stored.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_names defines the names of the objects to be deleted, they are deleted all or none.
+	ObjectNames []string `protobuf:"bytes,3,rep,name=object_names,json=objectNames,proto3" json:"object_names,omitempty"`
 }
 
-func _Msg_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBucket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CreateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBucket(ctx, req.(*MsgCreateBucket))
+func (m *MsgBatchDeleteObjects) Reset()         { *m = MsgBatchDeleteObjects{} }
+func (m *MsgBatchDeleteObjects) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchDeleteObjects) ProtoMessage()    {}
+func (*MsgBatchDeleteObjects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{88}
+}
+func (m *MsgBatchDeleteObjects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchDeleteObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchDeleteObjects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBatchDeleteObjects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchDeleteObjects.Merge(m, src)
+}
+func (m *MsgBatchDeleteObjects) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchDeleteObjects) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchDeleteObjects.DiscardUnknown(m)
 }
 
-func _Msg_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteBucket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DeleteBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteBucket(ctx, req.(*MsgDeleteBucket))
+var xxx_messageInfo_MsgBatchDeleteObjects proto.InternalMessageInfo
+
+func (m *MsgBatchDeleteObjects) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateBucketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBucketInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateBucketInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/UpdateBucketInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBucketInfo(ctx, req.(*MsgUpdateBucketInfo))
+func (m *MsgBatchDeleteObjects) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MirrorBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMirrorBucket)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgBatchDeleteObjects) GetObjectNames() []string {
+	if m != nil {
+		return m.ObjectNames
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MirrorBucket(ctx, in)
+	return nil
+}
+
+type MsgBatchDeleteObjectsResponse struct {
+}
+
+func (m *MsgBatchDeleteObjectsResponse) Reset()         { *m = MsgBatchDeleteObjectsResponse{} }
+func (m *MsgBatchDeleteObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchDeleteObjectsResponse) ProtoMessage()    {}
+func (*MsgBatchDeleteObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{89}
+}
+func (m *MsgBatchDeleteObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchDeleteObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchDeleteObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/MirrorBucket",
+}
+func (m *MsgBatchDeleteObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchDeleteObjectsResponse.Merge(m, src)
+}
+func (m *MsgBatchDeleteObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchDeleteObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchDeleteObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchDeleteObjectsResponse proto.InternalMessageInfo
+
+type MsgMintReadVoucher struct {
+	// operator defines the account address of the bucket owner who mints the voucher.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket to read from.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// holder defines the account address the voucher is sent to.
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// read_quota defines the read quota in bytes of the voucher.
+	ReadQuota uint64 `protobuf:"varint,4,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+	// duration defines the seconds the voucher is valid for, the read quota is prepaid for the whole duration.
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *MsgMintReadVoucher) Reset()         { *m = MsgMintReadVoucher{} }
+func (m *MsgMintReadVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgMintReadVoucher) ProtoMessage()    {}
+func (*MsgMintReadVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{90}
+}
+func (m *MsgMintReadVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintReadVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintReadVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MirrorBucket(ctx, req.(*MsgMirrorBucket))
+}
+func (m *MsgMintReadVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintReadVoucher.Merge(m, src)
+}
+func (m *MsgMintReadVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintReadVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintReadVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintReadVoucher proto.InternalMessageInfo
+
+func (m *MsgMintReadVoucher) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DiscontinueBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDiscontinueBucket)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgMintReadVoucher) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DiscontinueBucket(ctx, in)
+	return ""
+}
+
+func (m *MsgMintReadVoucher) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DiscontinueBucket",
+	return ""
+}
+
+func (m *MsgMintReadVoucher) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DiscontinueBucket(ctx, req.(*MsgDiscontinueBucket))
+	return 0
+}
+
+func (m *MsgMintReadVoucher) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ToggleSPAsDelegatedAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleSPAsDelegatedAgent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/ToggleSPAsDelegatedAgent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, req.(*MsgToggleSPAsDelegatedAgent))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgMintReadVoucherResponse struct {
+	// id defines the id of the minted voucher.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_CreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CreateObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateObject(ctx, req.(*MsgCreateObject))
+func (m *MsgMintReadVoucherResponse) Reset()         { *m = MsgMintReadVoucherResponse{} }
+func (m *MsgMintReadVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintReadVoucherResponse) ProtoMessage()    {}
+func (*MsgMintReadVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{91}
+}
+func (m *MsgMintReadVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintReadVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintReadVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMintReadVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintReadVoucherResponse.Merge(m, src)
+}
+func (m *MsgMintReadVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintReadVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintReadVoucherResponse.DiscardUnknown(m)
 }
 
-func _Msg_SealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSealObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SealObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/SealObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SealObject(ctx, req.(*MsgSealObject))
+var xxx_messageInfo_MsgMintReadVoucherResponse proto.InternalMessageInfo
+
+func (m *MsgMintReadVoucherResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SealObjectV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSealObjectV2)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SealObjectV2(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/SealObjectV2",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SealObjectV2(ctx, req.(*MsgSealObjectV2))
+type MsgRedeemReadVoucher struct {
+	// operator defines the operator account address of the primary sp of the bucket.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// voucher_id defines the id of the voucher to redeem.
+	VoucherId uint64 `protobuf:"varint,2,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	// read_bytes defines the bytes downloaded by the holder to debit from the voucher.
+	ReadBytes uint64 `protobuf:"varint,3,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// nonce defines the nonce of the redemption, which must be the next one of the voucher.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// holder_signature defines the signature of the holder over the voucher id, the read bytes and the nonce.
+	HolderSignature []byte `protobuf:"bytes,5,opt,name=holder_signature,json=holderSignature,proto3" json:"holder_signature,omitempty"`
+}
+
+func (m *MsgRedeemReadVoucher) Reset()         { *m = MsgRedeemReadVoucher{} }
+func (m *MsgRedeemReadVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemReadVoucher) ProtoMessage()    {}
+func (*MsgRedeemReadVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{92}
+}
+func (m *MsgRedeemReadVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemReadVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemReadVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRedeemReadVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemReadVoucher.Merge(m, src)
+}
+func (m *MsgRedeemReadVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemReadVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemReadVoucher.DiscardUnknown(m)
 }
 
-func _Msg_RejectSealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectSealObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectSealObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/RejectSealObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectSealObject(ctx, req.(*MsgRejectSealObject))
+var xxx_messageInfo_MsgRedeemReadVoucher proto.InternalMessageInfo
+
+func (m *MsgRedeemReadVoucher) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCopyObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CopyObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CopyObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CopyObject(ctx, req.(*MsgCopyObject))
+func (m *MsgRedeemReadVoucher) GetVoucherId() uint64 {
+	if m != nil {
+		return m.VoucherId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DeleteObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteObject(ctx, req.(*MsgDeleteObject))
+func (m *MsgRedeemReadVoucher) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelCreateObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelCreateObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CancelCreateObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelCreateObject(ctx, req.(*MsgCancelCreateObject))
+func (m *MsgRedeemReadVoucher) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_MirrorObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMirrorObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MirrorObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/MirrorObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MirrorObject(ctx, req.(*MsgMirrorObject))
+func (m *MsgRedeemReadVoucher) GetHolderSignature() []byte {
+	if m != nil {
+		return m.HolderSignature
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DiscontinueObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDiscontinueObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DiscontinueObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DiscontinueObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DiscontinueObject(ctx, req.(*MsgDiscontinueObject))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRedeemReadVoucherResponse struct {
 }
 
-func _Msg_UpdateObjectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateObjectInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateObjectInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/UpdateObjectInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateObjectInfo(ctx, req.(*MsgUpdateObjectInfo))
+func (m *MsgRedeemReadVoucherResponse) Reset()         { *m = MsgRedeemReadVoucherResponse{} }
+func (m *MsgRedeemReadVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemReadVoucherResponse) ProtoMessage()    {}
+func (*MsgRedeemReadVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{93}
+}
+func (m *MsgRedeemReadVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemReadVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemReadVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRedeemReadVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemReadVoucherResponse.Merge(m, src)
+}
+func (m *MsgRedeemReadVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemReadVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemReadVoucherResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateObjectContent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateObjectContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/UpdateObjectContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateObjectContent(ctx, req.(*MsgUpdateObjectContent))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRedeemReadVoucherResponse proto.InternalMessageInfo
+
+type MsgSetBucketRequesterPays struct {
+	// operator defines the account address of the operator, only the bucket owner can send the tx.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// enabled defines whether the readers pay for their own read quota.
+	// The charged read quota of the bucket must be zero to enable it.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func _Msg_CancelUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelUpdateObjectContent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelUpdateObjectContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CancelUpdateObjectContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelUpdateObjectContent(ctx, req.(*MsgCancelUpdateObjectContent))
+func (m *MsgSetBucketRequesterPays) Reset()         { *m = MsgSetBucketRequesterPays{} }
+func (m *MsgSetBucketRequesterPays) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketRequesterPays) ProtoMessage()    {}
+func (*MsgSetBucketRequesterPays) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{94}
+}
+func (m *MsgSetBucketRequesterPays) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketRequesterPays) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketRequesterPays.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetBucketRequesterPays) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketRequesterPays.Merge(m, src)
+}
+func (m *MsgSetBucketRequesterPays) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketRequesterPays) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketRequesterPays.DiscardUnknown(m)
 }
 
-func _Msg_DelegateCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateCreateObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateCreateObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DelegateCreateObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateCreateObject(ctx, req.(*MsgDelegateCreateObject))
+var xxx_messageInfo_MsgSetBucketRequesterPays proto.InternalMessageInfo
+
+func (m *MsgSetBucketRequesterPays) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DelegateUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateUpdateObjectContent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DelegateUpdateObjectContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, req.(*MsgDelegateUpdateObjectContent))
+func (m *MsgSetBucketRequesterPays) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CreateGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateGroup(ctx, req.(*MsgCreateGroup))
+func (m *MsgSetBucketRequesterPays) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DeleteGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteGroup(ctx, req.(*MsgDeleteGroup))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetBucketRequesterPaysResponse struct {
 }
 
-func _Msg_UpdateGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGroupMember)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/UpdateGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGroupMember(ctx, req.(*MsgUpdateGroupMember))
+func (m *MsgSetBucketRequesterPaysResponse) Reset()         { *m = MsgSetBucketRequesterPaysResponse{} }
+func (m *MsgSetBucketRequesterPaysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketRequesterPaysResponse) ProtoMessage()    {}
+func (*MsgSetBucketRequesterPaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{95}
+}
+func (m *MsgSetBucketRequesterPaysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketRequesterPaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketRequesterPaysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetBucketRequesterPaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketRequesterPaysResponse.Merge(m, src)
+}
+func (m *MsgSetBucketRequesterPaysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketRequesterPaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketRequesterPaysResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateGroupExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGroupExtra)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGroupExtra(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/UpdateGroupExtra",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGroupExtra(ctx, req.(*MsgUpdateGroupExtra))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetBucketRequesterPaysResponse proto.InternalMessageInfo
+
+type MsgPurchaseReaderQuota struct {
+	// reader defines the account address of the reader who buys the read quota.
+	Reader string `protobuf:"bytes,1,opt,name=reader,proto3" json:"reader,omitempty"`
+	// bucket_name defines the name of the requester-pays bucket to read from.
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// payment_address defines the payment account of the reader to pay for the read quota,
+	// if it is empty, the reader account itself pays.
+	PaymentAddress string `protobuf:"bytes,3,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// read_quota defines the traffic quota for read in bytes per month, zero cancels the read quota of the reader.
+	ReadQuota uint64 `protobuf:"varint,4,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
 }
 
-func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLeaveGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LeaveGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/LeaveGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+func (m *MsgPurchaseReaderQuota) Reset()         { *m = MsgPurchaseReaderQuota{} }
+func (m *MsgPurchaseReaderQuota) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseReaderQuota) ProtoMessage()    {}
+func (*MsgPurchaseReaderQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{96}
+}
+func (m *MsgPurchaseReaderQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseReaderQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseReaderQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPurchaseReaderQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseReaderQuota.Merge(m, src)
+}
+func (m *MsgPurchaseReaderQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseReaderQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseReaderQuota.DiscardUnknown(m)
 }
 
-func _Msg_MirrorGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMirrorGroup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MirrorGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/MirrorGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MirrorGroup(ctx, req.(*MsgMirrorGroup))
+var xxx_messageInfo_MsgPurchaseReaderQuota proto.InternalMessageInfo
+
+func (m *MsgPurchaseReaderQuota) GetReader() string {
+	if m != nil {
+		return m.Reader
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RenewGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRenewGroupMember)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RenewGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/RenewGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RenewGroupMember(ctx, req.(*MsgRenewGroupMember))
+func (m *MsgPurchaseReaderQuota) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPutPolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PutPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/PutPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PutPolicy(ctx, req.(*MsgPutPolicy))
+func (m *MsgPurchaseReaderQuota) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeletePolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeletePolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/DeletePolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeletePolicy(ctx, req.(*MsgDeletePolicy))
+func (m *MsgPurchaseReaderQuota) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgPurchaseReaderQuotaResponse struct {
 }
 
-func _Msg_MigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateBucket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/MigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateBucket(ctx, req.(*MsgMigrateBucket))
+func (m *MsgPurchaseReaderQuotaResponse) Reset()         { *m = MsgPurchaseReaderQuotaResponse{} }
+func (m *MsgPurchaseReaderQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseReaderQuotaResponse) ProtoMessage()    {}
+func (*MsgPurchaseReaderQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{97}
+}
+func (m *MsgPurchaseReaderQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseReaderQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseReaderQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPurchaseReaderQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseReaderQuotaResponse.Merge(m, src)
+}
+func (m *MsgPurchaseReaderQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseReaderQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseReaderQuotaResponse.DiscardUnknown(m)
 }
 
-func _Msg_CompleteMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteMigrateBucket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteMigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CompleteMigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteMigrateBucket(ctx, req.(*MsgCompleteMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgPurchaseReaderQuotaResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateBucket)(nil), "greenfield.storage.MsgCreateBucket")
+	proto.RegisterType((*MsgCreateBucketResponse)(nil), "greenfield.storage.MsgCreateBucketResponse")
+	proto.RegisterType((*MsgDeleteBucket)(nil), "greenfield.storage.MsgDeleteBucket")
+	proto.RegisterType((*MsgDeleteBucketResponse)(nil), "greenfield.storage.MsgDeleteBucketResponse")
+	proto.RegisterType((*MsgDiscontinueBucket)(nil), "greenfield.storage.MsgDiscontinueBucket")
+	proto.RegisterType((*MsgDiscontinueBucketResponse)(nil), "greenfield.storage.MsgDiscontinueBucketResponse")
+	proto.RegisterType((*MsgCreateObject)(nil), "greenfield.storage.MsgCreateObject")
+	proto.RegisterType((*MsgCreateObjectResponse)(nil), "greenfield.storage.MsgCreateObjectResponse")
+	proto.RegisterType((*MsgSealObject)(nil), "greenfield.storage.MsgSealObject")
+	proto.RegisterType((*MsgSealObjectResponse)(nil), "greenfield.storage.MsgSealObjectResponse")
+	proto.RegisterType((*MsgSealObjectV2)(nil), "greenfield.storage.MsgSealObjectV2")
+	proto.RegisterType((*MsgSealObjectV2Response)(nil), "greenfield.storage.MsgSealObjectV2Response")
+	proto.RegisterType((*MsgRejectSealObject)(nil), "greenfield.storage.MsgRejectSealObject")
+	proto.RegisterType((*MsgRejectSealObjectResponse)(nil), "greenfield.storage.MsgRejectSealObjectResponse")
+	proto.RegisterType((*MsgCopyObject)(nil), "greenfield.storage.MsgCopyObject")
+	proto.RegisterType((*MsgCopyObjectResponse)(nil), "greenfield.storage.MsgCopyObjectResponse")
+	proto.RegisterType((*MsgDeleteObject)(nil), "greenfield.storage.MsgDeleteObject")
+	proto.RegisterType((*MsgDeleteObjectResponse)(nil), "greenfield.storage.MsgDeleteObjectResponse")
+	proto.RegisterType((*MsgDiscontinueObject)(nil), "greenfield.storage.MsgDiscontinueObject")
+	proto.RegisterType((*MsgDiscontinueObjectResponse)(nil), "greenfield.storage.MsgDiscontinueObjectResponse")
+	proto.RegisterType((*MsgCreateGroup)(nil), "greenfield.storage.MsgCreateGroup")
+	proto.RegisterType((*MsgCreateGroupResponse)(nil), "greenfield.storage.MsgCreateGroupResponse")
+	proto.RegisterType((*MsgDeleteGroup)(nil), "greenfield.storage.MsgDeleteGroup")
+	proto.RegisterType((*MsgDeleteGroupResponse)(nil), "greenfield.storage.MsgDeleteGroupResponse")
+	proto.RegisterType((*MsgUpdateGroupMember)(nil), "greenfield.storage.MsgUpdateGroupMember")
+	proto.RegisterType((*MsgUpdateGroupMemberResponse)(nil), "greenfield.storage.MsgUpdateGroupMemberResponse")
+	proto.RegisterType((*MsgRenewGroupMember)(nil), "greenfield.storage.MsgRenewGroupMember")
+	proto.RegisterType((*MsgRenewGroupMemberResponse)(nil), "greenfield.storage.MsgRenewGroupMemberResponse")
+	proto.RegisterType((*MsgGroupMember)(nil), "greenfield.storage.MsgGroupMember")
+	proto.RegisterType((*MsgUpdateGroupExtra)(nil), "greenfield.storage.MsgUpdateGroupExtra")
+	proto.RegisterType((*MsgUpdateGroupExtraResponse)(nil), "greenfield.storage.MsgUpdateGroupExtraResponse")
+	proto.RegisterType((*MsgLeaveGroup)(nil), "greenfield.storage.MsgLeaveGroup")
+	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "greenfield.storage.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgUpdateBucketInfo)(nil), "greenfield.storage.MsgUpdateBucketInfo")
+	proto.RegisterType((*MsgUpdateBucketInfoResponse)(nil), "greenfield.storage.MsgUpdateBucketInfoResponse")
+	proto.RegisterType((*MsgCancelCreateObject)(nil), "greenfield.storage.MsgCancelCreateObject")
+	proto.RegisterType((*MsgCancelCreateObjectResponse)(nil), "greenfield.storage.MsgCancelCreateObjectResponse")
+	proto.RegisterType((*MsgPutPolicy)(nil), "greenfield.storage.MsgPutPolicy")
+	proto.RegisterType((*MsgPutPolicyResponse)(nil), "greenfield.storage.MsgPutPolicyResponse")
+	proto.RegisterType((*MsgDeletePolicy)(nil), "greenfield.storage.MsgDeletePolicy")
+	proto.RegisterType((*MsgDeletePolicyResponse)(nil), "greenfield.storage.MsgDeletePolicyResponse")
+	proto.RegisterType((*MsgMirrorObject)(nil), "greenfield.storage.MsgMirrorObject")
+	proto.RegisterType((*MsgMirrorObjectResponse)(nil), "greenfield.storage.MsgMirrorObjectResponse")
+	proto.RegisterType((*MsgMirrorBucket)(nil), "greenfield.storage.MsgMirrorBucket")
+	proto.RegisterType((*MsgUpdateObjectInfoResponse)(nil), "greenfield.storage.MsgUpdateObjectInfoResponse")
+	proto.RegisterType((*MsgUpdateObjectInfo)(nil), "greenfield.storage.MsgUpdateObjectInfo")
+	proto.RegisterType((*MsgMirrorBucketResponse)(nil), "greenfield.storage.MsgMirrorBucketResponse")
+	proto.RegisterType((*MsgMirrorGroup)(nil), "greenfield.storage.MsgMirrorGroup")
+	proto.RegisterType((*MsgMirrorGroupResponse)(nil), "greenfield.storage.MsgMirrorGroupResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.storage.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.storage.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgMigrateBucket)(nil), "greenfield.storage.MsgMigrateBucket")
+	proto.RegisterType((*MsgMigrateBucketResponse)(nil), "greenfield.storage.MsgMigrateBucketResponse")
+	proto.RegisterType((*MsgCompleteMigrateBucket)(nil), "greenfield.storage.MsgCompleteMigrateBucket")
+	proto.RegisterType((*MsgCompleteMigrateBucketResponse)(nil), "greenfield.storage.MsgCompleteMigrateBucketResponse")
+	proto.RegisterType((*MsgCancelMigrateBucket)(nil), "greenfield.storage.MsgCancelMigrateBucket")
+	proto.RegisterType((*MsgCancelMigrateBucketResponse)(nil), "greenfield.storage.MsgCancelMigrateBucketResponse")
+	proto.RegisterType((*MsgRejectMigrateBucket)(nil), "greenfield.storage.MsgRejectMigrateBucket")
+	proto.RegisterType((*MsgRejectMigrateBucketResponse)(nil), "greenfield.storage.MsgRejectMigrateBucketResponse")
+	proto.RegisterType((*MsgSetTag)(nil), "greenfield.storage.MsgSetTag")
+	proto.RegisterType((*MsgSetTagResponse)(nil), "greenfield.storage.MsgSetTagResponse")
+	proto.RegisterType((*MsgUpdateObjectContent)(nil), "greenfield.storage.MsgUpdateObjectContent")
+	proto.RegisterType((*MsgUpdateObjectContentResponse)(nil), "greenfield.storage.MsgUpdateObjectContentResponse")
+	proto.RegisterType((*MsgCancelUpdateObjectContent)(nil), "greenfield.storage.MsgCancelUpdateObjectContent")
+	proto.RegisterType((*MsgCancelUpdateObjectContentResponse)(nil), "greenfield.storage.MsgCancelUpdateObjectContentResponse")
+	proto.RegisterType((*MsgDelegateCreateObject)(nil), "greenfield.storage.MsgDelegateCreateObject")
+	proto.RegisterType((*MsgDelegateCreateObjectResponse)(nil), "greenfield.storage.MsgDelegateCreateObjectResponse")
+	proto.RegisterType((*MsgDelegateUpdateObjectContent)(nil), "greenfield.storage.MsgDelegateUpdateObjectContent")
+	proto.RegisterType((*MsgDelegateUpdateObjectContentResponse)(nil), "greenfield.storage.MsgDelegateUpdateObjectContentResponse")
+	proto.RegisterType((*MsgToggleSPAsDelegatedAgent)(nil), "greenfield.storage.MsgToggleSPAsDelegatedAgent")
+	proto.RegisterType((*MsgToggleSPAsDelegatedAgentResponse)(nil), "greenfield.storage.MsgToggleSPAsDelegatedAgentResponse")
+	proto.RegisterType((*MsgSetBucketFlowRateLimit)(nil), "greenfield.storage.MsgSetBucketFlowRateLimit")
+	proto.RegisterType((*MsgSetBucketFlowRateLimitResponse)(nil), "greenfield.storage.MsgSetBucketFlowRateLimitResponse")
+	proto.RegisterType((*MsgSetBucketVersioning)(nil), "greenfield.storage.MsgSetBucketVersioning")
+	proto.RegisterType((*MsgSetBucketVersioningResponse)(nil), "greenfield.storage.MsgSetBucketVersioningResponse")
+	proto.RegisterType((*MsgDeleteObjectVersion)(nil), "greenfield.storage.MsgDeleteObjectVersion")
+	proto.RegisterType((*MsgDeleteObjectVersionResponse)(nil), "greenfield.storage.MsgDeleteObjectVersionResponse")
+	proto.RegisterType((*MsgSetBucketLifecycle)(nil), "greenfield.storage.MsgSetBucketLifecycle")
+	proto.RegisterType((*MsgSetBucketLifecycleResponse)(nil), "greenfield.storage.MsgSetBucketLifecycleResponse")
+	proto.RegisterType((*MsgSetRetention)(nil), "greenfield.storage.MsgSetRetention")
+	proto.RegisterType((*MsgSetRetentionResponse)(nil), "greenfield.storage.MsgSetRetentionResponse")
+	proto.RegisterType((*MsgSetLegalHold)(nil), "greenfield.storage.MsgSetLegalHold")
+	proto.RegisterType((*MsgSetLegalHoldResponse)(nil), "greenfield.storage.MsgSetLegalHoldResponse")
+	proto.RegisterType((*MsgComposeObject)(nil), "greenfield.storage.MsgComposeObject")
+	proto.RegisterType((*MsgComposeObjectResponse)(nil), "greenfield.storage.MsgComposeObjectResponse")
+	proto.RegisterType((*BatchCreateObjectItem)(nil), "greenfield.storage.BatchCreateObjectItem")
+	proto.RegisterType((*MsgBatchCreateObjects)(nil), "greenfield.storage.MsgBatchCreateObjects")
+	proto.RegisterType((*MsgBatchCreateObjectsResponse)(nil), "greenfield.storage.MsgBatchCreateObjectsResponse")
+	proto.RegisterType((*MsgBatchDeleteObjects)(nil), "greenfield.storage.MsgBatchDeleteObjects")
+	proto.RegisterType((*MsgBatchDeleteObjectsResponse)(nil), "greenfield.storage.MsgBatchDeleteObjectsResponse")
+	proto.RegisterType((*MsgMintReadVoucher)(nil), "greenfield.storage.MsgMintReadVoucher")
+	proto.RegisterType((*MsgMintReadVoucherResponse)(nil), "greenfield.storage.MsgMintReadVoucherResponse")
+	proto.RegisterType((*MsgRedeemReadVoucher)(nil), "greenfield.storage.MsgRedeemReadVoucher")
+	proto.RegisterType((*MsgRedeemReadVoucherResponse)(nil), "greenfield.storage.MsgRedeemReadVoucherResponse")
+	proto.RegisterType((*MsgSetBucketRequesterPays)(nil), "greenfield.storage.MsgSetBucketRequesterPays")
+	proto.RegisterType((*MsgSetBucketRequesterPaysResponse)(nil), "greenfield.storage.MsgSetBucketRequesterPaysResponse")
+	proto.RegisterType((*MsgPurchaseReaderQuota)(nil), "greenfield.storage.MsgPurchaseReaderQuota")
+	proto.RegisterType((*MsgPurchaseReaderQuotaResponse)(nil), "greenfield.storage.MsgPurchaseReaderQuotaResponse")
 }
 
-func _Msg_CancelMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelMigrateBucket)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("greenfield/storage/tx.proto", fileDescriptor_ddb71b028305a3cc) }
+
+var fileDescriptor_ddb71b028305a3cc = []byte{
+	// 3516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5c, 0x4f, 0x6c, 0xdc, 0xc6,
+	0xd5, 0x37, 0x77, 0x57, 0x7f, 0xf6, 0xad, 0xfe, 0xd8, 0xb4, 0x12, 0xaf, 0xd7, 0x9f, 0x25, 0x79,
+	0x9d, 0x38, 0xb2, 0x62, 0x4b, 0x8e, 0xe2, 0xe4, 0xcb, 0xe7, 0x2f, 0x2d, 0x2a, 0x39, 0x4d, 0xb2,
+	0x88, 0x95, 0x28, 0x94, 0xa3, 0x02, 0x09, 0x8a, 0x0d, 0xb5, 0x1c, 0x53, 0x6c, 0xb8, 0x24, 0x43,
+	0x72, 0x65, 0x2b, 0x05, 0x72, 0x68, 0x0b, 0xe4, 0x14, 0x20, 0x40, 0x7a, 0x08, 0xd0, 0xa2, 0x28,
+	0x0a, 0x14, 0xe8, 0xa9, 0x08, 0x8a, 0x00, 0x45, 0x8b, 0xa2, 0xe8, 0x25, 0x80, 0x51, 0xf4, 0x10,
+	0xe4, 0x50, 0x14, 0x29, 0x90, 0x06, 0x49, 0xd1, 0xa0, 0xd7, 0x5e, 0x7a, 0x2d, 0x86, 0x33, 0x1c,
+	0x0e, 0xc9, 0x21, 0xb9, 0x5a, 0xaf, 0x22, 0x01, 0x3d, 0x49, 0x1c, 0xfe, 0x66, 0xe6, 0xfd, 0x9b,
+	0x37, 0x6f, 0xde, 0x3c, 0x2e, 0x9c, 0xd1, 0x5d, 0x84, 0xac, 0x5b, 0x06, 0x32, 0xb5, 0x65, 0xcf,
+	0xb7, 0x5d, 0x55, 0x47, 0xcb, 0xfe, 0x9d, 0x25, 0xc7, 0xb5, 0x7d, 0x5b, 0x96, 0xa3, 0x97, 0x4b,
+	0xf4, 0x65, 0xe3, 0x54, 0xc7, 0xf6, 0xba, 0xb6, 0xb7, 0xdc, 0xf5, 0xf4, 0xe5, 0xdd, 0x47, 0xf0,
+	0x1f, 0x02, 0x6e, 0x9c, 0x26, 0x2f, 0xda, 0xc1, 0xd3, 0x32, 0x79, 0xa0, 0xaf, 0x66, 0x74, 0x5b,
+	0xb7, 0x49, 0x3b, 0xfe, 0x8f, 0xb6, 0xce, 0xe9, 0xb6, 0xad, 0x9b, 0x68, 0x39, 0x78, 0xda, 0xee,
+	0xdd, 0x5a, 0xf6, 0x8d, 0x2e, 0xf2, 0x7c, 0xb5, 0xeb, 0x50, 0xc0, 0x3c, 0x47, 0x5b, 0xc7, 0xee,
+	0x76, 0x6d, 0x6b, 0x59, 0x75, 0x1c, 0xd7, 0xde, 0x55, 0x4d, 0x36, 0x44, 0x0a, 0x71, 0xdb, 0x55,
+	0x1d, 0x07, 0xb9, 0x14, 0xd0, 0xe4, 0x00, 0x0e, 0x72, 0xbb, 0x86, 0xe7, 0x19, 0xb6, 0x45, 0xb1,
+	0x82, 0x41, 0x42, 0x11, 0x14, 0x02, 0x1c, 0xd5, 0x55, 0xbb, 0x21, 0x7f, 0xb3, 0x22, 0x21, 0xee,
+	0x39, 0x88, 0xbe, 0x6f, 0xfe, 0xbe, 0x0c, 0xd3, 0xeb, 0x9e, 0x7e, 0xdd, 0x45, 0xaa, 0x8f, 0xd6,
+	0x7a, 0x9d, 0xd7, 0x90, 0x2f, 0xaf, 0xc0, 0x58, 0x07, 0x3f, 0xdb, 0x6e, 0x5d, 0x9a, 0x97, 0x16,
+	0xaa, 0x6b, 0xf5, 0x8f, 0x3f, 0xb8, 0x3c, 0x43, 0xc5, 0xb6, 0xaa, 0x69, 0x2e, 0xf2, 0xbc, 0x4d,
+	0xdf, 0x35, 0x2c, 0x5d, 0x09, 0x81, 0xf2, 0x1c, 0xd4, 0xb6, 0x83, 0xde, 0x6d, 0x4b, 0xed, 0xa2,
+	0x7a, 0x09, 0xf7, 0x53, 0x80, 0x34, 0x3d, 0xaf, 0x76, 0x91, 0xbc, 0x06, 0xb0, 0x6b, 0x78, 0xc6,
+	0xb6, 0x61, 0x1a, 0xfe, 0x5e, 0xbd, 0x3c, 0x2f, 0x2d, 0x4c, 0xad, 0x34, 0x97, 0xd2, 0x5a, 0x5c,
+	0xda, 0x62, 0xa8, 0x9b, 0x7b, 0x0e, 0x52, 0xb8, 0x5e, 0xf2, 0x2a, 0x4c, 0x3b, 0xea, 0x5e, 0x17,
+	0x59, 0x7e, 0x5b, 0x25, 0x64, 0xd4, 0x2b, 0x05, 0x04, 0x4e, 0xd1, 0x0e, 0xb4, 0x55, 0x7e, 0x1a,
+	0x64, 0xc7, 0x35, 0xba, 0xaa, 0xbb, 0xd7, 0xf6, 0x1c, 0x36, 0xca, 0x48, 0xc1, 0x28, 0xc7, 0x69,
+	0x9f, 0x4d, 0x27, 0x1c, 0xe7, 0x39, 0x38, 0xc9, 0x8f, 0x43, 0x75, 0x5f, 0x1f, 0x9d, 0x97, 0x16,
+	0x6a, 0x2b, 0x67, 0x78, 0xbe, 0xa8, 0xbe, 0x56, 0x29, 0x44, 0x39, 0x11, 0x8d, 0x45, 0x9b, 0xe4,
+	0x4b, 0x20, 0x77, 0x76, 0x54, 0x57, 0x47, 0x5a, 0xdb, 0x45, 0xaa, 0xd6, 0x7e, 0xbd, 0x67, 0xfb,
+	0x6a, 0x7d, 0x6c, 0x5e, 0x5a, 0xa8, 0x28, 0xc7, 0xe9, 0x1b, 0x05, 0xa9, 0xda, 0x8b, 0xb8, 0xfd,
+	0xda, 0xc4, 0xf7, 0xbe, 0x7c, 0x7f, 0x31, 0x14, 0x7c, 0x73, 0x13, 0x4e, 0x25, 0xf4, 0xa7, 0x20,
+	0xcf, 0xb1, 0x2d, 0x0f, 0xc9, 0x4f, 0x40, 0x95, 0xea, 0xc4, 0xd0, 0xa8, 0x26, 0xcf, 0xdc, 0xfd,
+	0x74, 0xee, 0xd8, 0x27, 0x9f, 0xce, 0x55, 0x5e, 0x32, 0x2c, 0xff, 0xe3, 0x0f, 0x2e, 0xd7, 0x28,
+	0xbb, 0xf8, 0x51, 0x19, 0x27, 0xe8, 0x96, 0xd6, 0xbc, 0x1d, 0x18, 0xc5, 0x53, 0xc8, 0x44, 0xcc,
+	0x28, 0xae, 0xc2, 0xb8, 0xed, 0x20, 0xb7, 0x2f, 0xab, 0x60, 0xc8, 0x42, 0xb3, 0xb8, 0x36, 0x89,
+	0x99, 0x61, 0xf8, 0xe6, 0xe9, 0x80, 0x1b, 0x7e, 0xe2, 0x90, 0x9b, 0xe6, 0x0f, 0x25, 0x98, 0xc1,
+	0xef, 0x0c, 0xaf, 0x63, 0x5b, 0xbe, 0x61, 0xf5, 0x0e, 0x96, 0x32, 0xf9, 0x7e, 0x18, 0x75, 0x91,
+	0xea, 0xd9, 0x56, 0x60, 0xac, 0x55, 0x85, 0x3e, 0x25, 0x29, 0x9e, 0x85, 0xff, 0x11, 0x51, 0xc5,
+	0xc8, 0xfe, 0x3b, 0xbf, 0xc0, 0x5e, 0xd8, 0xfe, 0x0e, 0xea, 0x1c, 0xd0, 0x02, 0x9b, 0x83, 0x9a,
+	0x1d, 0x0c, 0x4f, 0x00, 0x84, 0x68, 0x20, 0x4d, 0x01, 0xe0, 0x1c, 0x4c, 0x38, 0xea, 0x9e, 0x69,
+	0xab, 0x5a, 0xdb, 0x33, 0xde, 0x40, 0xc1, 0xd2, 0xa9, 0x28, 0x35, 0xda, 0xb6, 0x69, 0xbc, 0x91,
+	0x5c, 0xa4, 0x23, 0x03, 0x2d, 0xd2, 0x73, 0x30, 0x81, 0x45, 0x81, 0x17, 0x29, 0x76, 0x34, 0xc1,
+	0x92, 0xa8, 0x2a, 0x35, 0xda, 0x86, 0xe1, 0x59, 0x8b, 0x67, 0x6c, 0xa0, 0xc5, 0x73, 0x11, 0x8e,
+	0xa3, 0x3b, 0x0e, 0xe6, 0xbb, 0xb3, 0x83, 0x3a, 0xaf, 0x79, 0xbd, 0xae, 0x57, 0x1f, 0x9f, 0x2f,
+	0x2f, 0x4c, 0x28, 0xd3, 0xa4, 0xfd, 0x7a, 0xd8, 0x2c, 0x3f, 0x07, 0xd3, 0x2e, 0xd2, 0x7a, 0x96,
+	0xa6, 0x5a, 0x9d, 0x3d, 0x42, 0x5d, 0x35, 0x9b, 0x47, 0x85, 0x41, 0x03, 0x1e, 0xa7, 0xdc, 0xd8,
+	0x73, 0xce, 0x32, 0x24, 0x5a, 0xe6, 0x97, 0x21, 0x55, 0x4c, 0x9f, 0xcb, 0x90, 0xa0, 0x5b, 0x5a,
+	0xf3, 0xdd, 0x12, 0x4c, 0xae, 0x7b, 0xfa, 0x26, 0x52, 0x4d, 0x6a, 0x39, 0x07, 0x64, 0xeb, 0x85,
+	0xb6, 0xf3, 0x18, 0x9c, 0xd2, 0x4d, 0x7b, 0x5b, 0x35, 0xdb, 0xbb, 0x86, 0xeb, 0xf7, 0x54, 0xb3,
+	0xad, 0xbb, 0x76, 0xcf, 0xc1, 0x1c, 0x61, 0x33, 0x9a, 0x54, 0x66, 0xc8, 0xeb, 0x2d, 0xf2, 0xf6,
+	0x19, 0xfc, 0xb2, 0xa5, 0xc9, 0x4f, 0xc1, 0x9c, 0x87, 0x3a, 0xb6, 0xa5, 0x51, 0x55, 0x6f, 0x9b,
+	0x5e, 0x5b, 0xd5, 0xf5, 0xb6, 0x67, 0xe8, 0x96, 0xea, 0xf7, 0x5c, 0x44, 0x5c, 0xef, 0x84, 0x72,
+	0x86, 0xc1, 0x36, 0x9d, 0x35, 0xd3, 0x5b, 0xd5, 0xf5, 0x4d, 0x06, 0x49, 0xae, 0xb8, 0x53, 0x70,
+	0x5f, 0x4c, 0x28, 0x6c, 0xa9, 0xfd, 0xa1, 0x14, 0x2c, 0xb5, 0xe8, 0xcd, 0xd6, 0xca, 0x7f, 0xa5,
+	0xc0, 0x84, 0x4b, 0x62, 0x54, 0xb8, 0x24, 0xc4, 0xfe, 0x97, 0x97, 0x20, 0x93, 0xee, 0x8f, 0x25,
+	0x38, 0xb9, 0xee, 0xe9, 0x0a, 0xc2, 0xed, 0x87, 0x6f, 0x92, 0x49, 0xca, 0xcf, 0xc2, 0x19, 0x01,
+	0x75, 0x8c, 0xfa, 0x5f, 0x92, 0xa5, 0x74, 0xdd, 0x76, 0xf6, 0x28, 0xdd, 0x8d, 0x24, 0xdd, 0x1c,
+	0x75, 0x17, 0x60, 0xda, 0x73, 0x3b, 0xed, 0x34, 0x85, 0x93, 0x9e, 0xdb, 0x59, 0x8b, 0x88, 0xbc,
+	0x00, 0xd3, 0x9a, 0xe7, 0xc7, 0x70, 0x84, 0xd0, 0x49, 0xcd, 0xf3, 0xe3, 0x38, 0x3c, 0x1e, 0xcf,
+	0x50, 0x85, 0x8d, 0xf7, 0x42, 0x64, 0x35, 0x74, 0x3c, 0x1e, 0x37, 0xc2, 0xc6, 0xe3, 0x70, 0x0a,
+	0x9c, 0xc2, 0xb8, 0x01, 0x23, 0x90, 0x19, 0xcd, 0xf3, 0x37, 0x92, 0x7e, 0x34, 0x29, 0xcf, 0x17,
+	0x83, 0x55, 0x16, 0xc9, 0x6b, 0x08, 0xee, 0xec, 0x3d, 0x89, 0x0b, 0x2b, 0x8e, 0x96, 0xf5, 0xf0,
+	0x71, 0x47, 0xc2, 0x72, 0x3e, 0x4a, 0xc5, 0x1d, 0x07, 0x4b, 0xfa, 0x35, 0x00, 0x26, 0x5f, 0xaf,
+	0x5e, 0x9e, 0x2f, 0x17, 0x09, 0xb8, 0x1a, 0x0a, 0xd8, 0xe3, 0x62, 0x96, 0xca, 0xbe, 0x62, 0x96,
+	0x04, 0xcb, 0x6f, 0x49, 0x30, 0xc5, 0x76, 0xb3, 0xc0, 0x35, 0x0d, 0x14, 0xb2, 0x9c, 0x05, 0x20,
+	0x4e, 0x8f, 0xe3, 0xb4, 0x1a, 0xb4, 0x04, 0x8c, 0xce, 0xc0, 0x08, 0xba, 0xe3, 0xbb, 0x2a, 0xd5,
+	0x0e, 0x79, 0x48, 0x6c, 0xab, 0x1b, 0x70, 0x7f, 0x9c, 0x10, 0x66, 0x86, 0x8f, 0xc3, 0x38, 0xf3,
+	0xa8, 0x7d, 0x58, 0xe1, 0x98, 0x4e, 0x3c, 0x6c, 0xd3, 0x0f, 0x58, 0x23, 0x9a, 0x26, 0xac, 0x0d,
+	0xa6, 0xc7, 0x7c, 0xe6, 0x92, 0x12, 0xaf, 0x07, 0x7c, 0x70, 0xb3, 0x32, 0x59, 0x7f, 0x58, 0x0a,
+	0xcc, 0xeb, 0x25, 0x47, 0x0b, 0x59, 0x5c, 0x47, 0xdd, 0x6d, 0xe4, 0x0e, 0x48, 0xd6, 0xff, 0x41,
+	0x8d, 0x90, 0x65, 0xdf, 0xb6, 0x90, 0x4b, 0xe8, 0xca, 0xe9, 0x48, 0x78, 0x78, 0x01, 0x63, 0x13,
+	0x1c, 0x95, 0x93, 0xea, 0x7a, 0x16, 0xa6, 0xba, 0x01, 0x65, 0x5e, 0xdb, 0xb7, 0xf1, 0xc9, 0xa9,
+	0x5e, 0x99, 0x2f, 0x2f, 0xd4, 0xc4, 0xb1, 0xd3, 0xba, 0xa7, 0x73, 0xbc, 0x28, 0x13, 0xb4, 0xe7,
+	0x4d, 0x7b, 0x55, 0xc3, 0x9b, 0xdc, 0x09, 0x6e, 0x24, 0x2d, 0x10, 0x4a, 0x7d, 0x24, 0x30, 0xf4,
+	0x6c, 0x4a, 0xa7, 0xd9, 0x10, 0x44, 0x8a, 0x62, 0x9b, 0x4e, 0x89, 0x91, 0xc9, 0xf9, 0x5f, 0xe1,
+	0xf6, 0x65, 0xa1, 0xdb, 0x47, 0x59, 0xcc, 0x4f, 0xc2, 0x18, 0xe5, 0x74, 0x1f, 0xf2, 0x0d, 0xbb,
+	0x64, 0x6d, 0x8a, 0x71, 0x9e, 0x99, 0x4c, 0xde, 0x26, 0xeb, 0x9c, 0x17, 0xc7, 0x15, 0x18, 0x25,
+	0x63, 0x15, 0x0a, 0x83, 0xe2, 0xe4, 0x16, 0xe0, 0xa0, 0xc2, 0x70, 0x55, 0xdf, 0xb0, 0xad, 0xb6,
+	0x6f, 0xd0, 0xd5, 0x50, 0x5b, 0x69, 0x2c, 0x91, 0x2c, 0xca, 0x52, 0x98, 0x45, 0x59, 0xba, 0x19,
+	0x66, 0x51, 0xd6, 0x2a, 0xef, 0xfc, 0x6d, 0x4e, 0x52, 0xa6, 0xa2, 0x8e, 0xf8, 0x55, 0xf3, 0x8f,
+	0x44, 0x47, 0x9c, 0x12, 0xbf, 0x89, 0x7d, 0xc2, 0x91, 0xd3, 0x11, 0xf3, 0x5c, 0x15, 0xde, 0x73,
+	0x09, 0x65, 0x9f, 0xe4, 0x85, 0xc9, 0xfe, 0x17, 0x52, 0x10, 0x90, 0xdc, 0x40, 0xea, 0x2e, 0xf5,
+	0x43, 0xfb, 0x17, 0xfd, 0x81, 0x71, 0x78, 0xad, 0x86, 0x79, 0xa1, 0xd3, 0xd0, 0x80, 0x3b, 0xa2,
+	0x34, 0xda, 0x1a, 0x4b, 0x9c, 0xbe, 0x48, 0xb8, 0xd3, 0xb2, 0x6e, 0xd9, 0x07, 0xb5, 0x33, 0xde,
+	0x10, 0xa6, 0x49, 0xca, 0x81, 0xb1, 0xcd, 0x0a, 0x02, 0x9e, 0x97, 0x5a, 0x96, 0xff, 0xf8, 0xd5,
+	0x2d, 0xd5, 0xec, 0xa1, 0x74, 0x1a, 0x65, 0x18, 0xc9, 0xa4, 0x21, 0x1c, 0x97, 0xf3, 0xac, 0x26,
+	0x92, 0x28, 0x93, 0xf8, 0x4f, 0x24, 0x12, 0x96, 0xa9, 0x56, 0x07, 0x99, 0xb1, 0x9c, 0xc2, 0x11,
+	0x09, 0xa4, 0xe6, 0xe0, 0xac, 0x90, 0x3e, 0xfe, 0x90, 0x36, 0xb1, 0xee, 0xe9, 0x1b, 0x3d, 0x7f,
+	0xc3, 0x36, 0x8d, 0xce, 0xde, 0x80, 0x84, 0x7f, 0x1d, 0xaa, 0x8e, 0x6b, 0x58, 0x1d, 0xc3, 0x51,
+	0x4d, 0xea, 0x6f, 0xe6, 0x79, 0xc9, 0x47, 0x19, 0xd5, 0xa5, 0x8d, 0x10, 0xa7, 0x44, 0x5d, 0x70,
+	0xf4, 0xef, 0x22, 0xcf, 0xee, 0xb9, 0x9d, 0x90, 0x29, 0xf6, 0x2c, 0x7f, 0x03, 0xc0, 0xf3, 0x55,
+	0x1f, 0x61, 0x55, 0x87, 0x5e, 0x38, 0x6b, 0xf0, 0xcd, 0x10, 0xa8, 0x70, 0x7d, 0xe4, 0xf5, 0xb4,
+	0x4f, 0x1c, 0x2b, 0xf4, 0x89, 0xe3, 0x77, 0x3f, 0x9d, 0x93, 0x44, 0x7e, 0x31, 0x29, 0xe3, 0x8d,
+	0x20, 0x62, 0x60, 0x12, 0xe4, 0x23, 0x73, 0x27, 0x68, 0x09, 0x4f, 0x99, 0x45, 0x91, 0x39, 0x41,
+	0xb7, 0xb4, 0xe6, 0xaf, 0xf8, 0xc8, 0xfc, 0xa8, 0xea, 0x25, 0x29, 0x86, 0x4d, 0x2e, 0x66, 0x1f,
+	0x9a, 0x24, 0xfe, 0x49, 0x24, 0xb1, 0x6e, 0xb8, 0xae, 0xed, 0xde, 0xd3, 0xd2, 0x7a, 0x18, 0x4a,
+	0x86, 0x46, 0x7d, 0x72, 0xee, 0xe4, 0x25, 0x43, 0x4b, 0xae, 0xc3, 0x72, 0xd1, 0x3a, 0xac, 0xa4,
+	0x12, 0x0e, 0x4d, 0x98, 0xd4, 0x90, 0x87, 0x4f, 0xfc, 0xaa, 0x61, 0x61, 0xb6, 0x47, 0x82, 0x34,
+	0x43, 0x0d, 0x37, 0x5e, 0xc7, 0x6d, 0x2d, 0x4d, 0x7c, 0xe8, 0xe1, 0x59, 0x65, 0xab, 0xf4, 0x2e,
+	0x2f, 0x86, 0x7b, 0xca, 0xb3, 0x0e, 0x57, 0x0c, 0x29, 0x2e, 0x2b, 0x85, 0x5c, 0xf2, 0x1e, 0x95,
+	0x70, 0x19, 0xf3, 0xa8, 0x9f, 0xf1, 0x31, 0x47, 0xf4, 0xfe, 0xd0, 0x12, 0x47, 0xf1, 0x3d, 0xa5,
+	0x32, 0x8c, 0x3d, 0x85, 0xd7, 0x73, 0x22, 0x3b, 0xfd, 0x21, 0x89, 0x00, 0xc9, 0xbb, 0x7b, 0x39,
+	0x0e, 0xed, 0x4b, 0xcd, 0x05, 0xe1, 0xd5, 0x00, 0x4a, 0x26, 0xe7, 0x2b, 0x8e, 0x0d, 0xc6, 0xe1,
+	0xbb, 0xc4, 0x92, 0x89, 0x7e, 0x37, 0x82, 0xab, 0x31, 0xf9, 0x71, 0xa8, 0xaa, 0x3d, 0x7f, 0xc7,
+	0x76, 0xb1, 0x88, 0x8b, 0x78, 0x8c, 0xa0, 0xf2, 0x13, 0x30, 0x4a, 0x2e, 0xd7, 0xa2, 0x08, 0x37,
+	0xad, 0x17, 0x32, 0xc7, 0x5a, 0x05, 0x0b, 0x41, 0xa1, 0xf8, 0x6b, 0x53, 0x98, 0xdc, 0x68, 0x24,
+	0xaa, 0x12, 0x9e, 0x28, 0x46, 0xf0, 0xbf, 0x25, 0x38, 0x1e, 0xf0, 0xa2, 0xbb, 0xea, 0x01, 0xdf,
+	0xbe, 0xc8, 0x17, 0xe1, 0x44, 0x22, 0x8f, 0x64, 0x68, 0x81, 0x3e, 0x26, 0x95, 0x29, 0x3e, 0x49,
+	0xd4, 0xd2, 0xf2, 0x52, 0x4e, 0x95, 0x21, 0xa5, 0x9c, 0x1a, 0x50, 0x4f, 0x32, 0x1e, 0xa5, 0x24,
+	0x4a, 0xc1, 0xcb, 0xeb, 0x76, 0xd7, 0xc1, 0xfe, 0xfe, 0x2b, 0x91, 0xce, 0x1a, 0xcc, 0x0a, 0x73,
+	0xb8, 0xb7, 0xd4, 0xae, 0x61, 0xee, 0x45, 0xa2, 0x6a, 0xa4, 0x53, 0xb9, 0x4f, 0x07, 0x90, 0x96,
+	0x26, 0xaf, 0xc2, 0x84, 0xbe, 0xab, 0xb7, 0xbb, 0xaa, 0xe3, 0x18, 0x96, 0x1e, 0x46, 0x13, 0xb3,
+	0x22, 0xc3, 0x79, 0x66, 0xeb, 0x99, 0x75, 0x02, 0x53, 0x6a, 0xfa, 0xae, 0x4e, 0xff, 0x4f, 0x9d,
+	0xe9, 0x9a, 0x30, 0x9f, 0x25, 0x08, 0x26, 0xad, 0x37, 0x49, 0xda, 0x24, 0x88, 0xc2, 0xbe, 0x0a,
+	0x51, 0x25, 0x69, 0x9c, 0x87, 0x59, 0xf1, 0xfc, 0x09, 0x0a, 0x49, 0xba, 0xf6, 0xf0, 0x28, 0x14,
+	0xcc, 0xcf, 0x28, 0xfc, 0x99, 0x04, 0xd5, 0x20, 0x17, 0xee, 0xdf, 0x54, 0xf5, 0x01, 0xa9, 0xe2,
+	0xa3, 0x99, 0x52, 0x22, 0xca, 0xbc, 0x0a, 0x15, 0x5f, 0xd5, 0x3d, 0x7a, 0x7e, 0x99, 0x17, 0xdf,
+	0x40, 0x11, 0xec, 0x4d, 0x55, 0xf7, 0x94, 0x00, 0x9d, 0x64, 0xe3, 0x24, 0x9c, 0x60, 0x34, 0x32,
+	0xca, 0xdf, 0x29, 0x05, 0xc2, 0xe5, 0xb7, 0xb4, 0xeb, 0xe4, 0xf6, 0xed, 0xd0, 0x76, 0xb5, 0x3e,
+	0xee, 0x1e, 0x93, 0xf7, 0x86, 0x23, 0xe9, 0x7b, 0xc3, 0xc1, 0xef, 0x35, 0x88, 0xba, 0x05, 0x12,
+	0x61, 0x42, 0xfb, 0xb9, 0x14, 0x24, 0x90, 0x88, 0xcd, 0x1e, 0x21, 0xd1, 0x25, 0x39, 0xb9, 0x00,
+	0x0f, 0xe4, 0x91, 0xc9, 0xf8, 0xf9, 0x73, 0x99, 0x85, 0xc7, 0xba, 0xea, 0xa3, 0x21, 0x9c, 0x15,
+	0xb9, 0x14, 0x70, 0x69, 0xc0, 0x5b, 0xeb, 0x01, 0xe2, 0xda, 0xa4, 0xe5, 0x8c, 0x14, 0x5b, 0x8e,
+	0xe0, 0xc6, 0x39, 0x1e, 0x55, 0x8d, 0x0d, 0x74, 0xb1, 0x7d, 0x58, 0x17, 0xcd, 0x09, 0x03, 0x78,
+	0x05, 0xe6, 0x32, 0xf4, 0x3a, 0x84, 0x2b, 0x9a, 0x3f, 0x95, 0x82, 0x85, 0x12, 0x8e, 0x3e, 0xbc,
+	0x75, 0xb0, 0x02, 0x63, 0xbd, 0x60, 0xb0, 0x3e, 0x8c, 0x87, 0x02, 0x8f, 0x8c, 0xf1, 0x88, 0x14,
+	0x3f, 0xd6, 0x97, 0xdb, 0x59, 0x80, 0x0b, 0xf9, 0xd2, 0x64, 0xcb, 0xf5, 0xfb, 0x52, 0x70, 0x4c,
+	0xb9, 0x69, 0xeb, 0xba, 0x89, 0x36, 0x37, 0x56, 0xbd, 0xb0, 0x93, 0xb6, 0xaa, 0x1f, 0x9c, 0xf7,
+	0x49, 0xd2, 0xfb, 0x20, 0x9c, 0xcf, 0x21, 0x82, 0x11, 0xfb, 0x65, 0x09, 0x4e, 0x93, 0x6d, 0x87,
+	0xec, 0x99, 0x4f, 0x9b, 0xf6, 0x6d, 0x45, 0xf5, 0xd1, 0x0d, 0xa3, 0x6b, 0x1c, 0x98, 0xa3, 0xfc,
+	0x7f, 0x98, 0xa0, 0x00, 0x92, 0xed, 0x2c, 0x17, 0x0c, 0x4d, 0x87, 0x23, 0xe9, 0xce, 0x21, 0x24,
+	0xfb, 0x34, 0x98, 0xbe, 0x65, 0xda, 0xb7, 0xdb, 0x38, 0x54, 0x68, 0x9b, 0x98, 0x53, 0x5a, 0x36,
+	0xf6, 0x24, 0x5d, 0x5a, 0x17, 0x74, 0xc3, 0xdf, 0xe9, 0x6d, 0xe3, 0xd8, 0x97, 0xd6, 0x18, 0xd2,
+	0x3f, 0x97, 0x3d, 0xed, 0x35, 0x5a, 0x74, 0xd7, 0x0a, 0x16, 0x1f, 0xd0, 0x09, 0x5b, 0x96, 0xaf,
+	0x4c, 0xde, 0xe2, 0x85, 0x97, 0x54, 0xc8, 0x79, 0x38, 0x97, 0x29, 0x68, 0xa6, 0x8e, 0xf7, 0xa4,
+	0x60, 0xbf, 0x67, 0xa8, 0x2d, 0xe4, 0x7a, 0x86, 0x6d, 0x19, 0x96, 0x7e, 0x50, 0xba, 0xa8, 0xc3,
+	0x18, 0xb2, 0xd4, 0x6d, 0x13, 0x91, 0x10, 0x78, 0x5c, 0x09, 0x1f, 0xc5, 0xfb, 0xae, 0x80, 0x32,
+	0x46, 0xfc, 0x6f, 0x24, 0xee, 0x6a, 0x8c, 0x16, 0x1d, 0x10, 0xd4, 0xa1, 0x05, 0x2b, 0x75, 0x18,
+	0xdb, 0x25, 0x24, 0x04, 0x46, 0x52, 0x56, 0xc2, 0x47, 0x31, 0x77, 0x02, 0xd2, 0x19, 0x77, 0xbf,
+	0x93, 0x68, 0xb1, 0x0a, 0x15, 0xc0, 0x0d, 0xe3, 0x16, 0xea, 0xec, 0x75, 0x4c, 0x74, 0x50, 0xcc,
+	0x7d, 0x0d, 0x46, 0xdc, 0x9e, 0x89, 0xc8, 0xc5, 0x71, 0x6d, 0xe5, 0x9c, 0x68, 0xbf, 0x61, 0x44,
+	0x28, 0x3d, 0x13, 0xd1, 0x83, 0x2a, 0xe9, 0x25, 0xce, 0xe6, 0xa6, 0xa9, 0x67, 0xfc, 0xfd, 0x55,
+	0xa2, 0x25, 0x37, 0xbe, 0x82, 0xb0, 0x3f, 0x3b, 0x4c, 0xb5, 0xad, 0x42, 0xd5, 0x0d, 0x89, 0xa0,
+	0x67, 0xd2, 0xb3, 0xe2, 0xed, 0x96, 0x82, 0x28, 0xeb, 0x51, 0xaf, 0xac, 0x6a, 0x98, 0x88, 0x39,
+	0xc6, 0xf8, 0xaf, 0x19, 0xe3, 0x37, 0x90, 0xae, 0x9a, 0xcf, 0xda, 0xa6, 0x76, 0x68, 0x8c, 0x9f,
+	0x05, 0xc0, 0x6e, 0xda, 0x6c, 0xef, 0xd8, 0x26, 0x49, 0x96, 0x8c, 0x2b, 0x55, 0x33, 0x24, 0x2b,
+	0x93, 0x29, 0x46, 0x38, 0x63, 0xea, 0xfd, 0x52, 0x90, 0x7a, 0xc0, 0x67, 0x4b, 0xdb, 0x3b, 0xe4,
+	0x8b, 0x05, 0x79, 0x09, 0x4e, 0x92, 0xd3, 0x0f, 0x5f, 0x0e, 0x43, 0x0e, 0xd0, 0x55, 0xe5, 0x04,
+	0x79, 0x15, 0x95, 0xc4, 0x78, 0xfd, 0x9c, 0x1f, 0xe2, 0x51, 0xe0, 0xe8, 0x30, 0x72, 0x6b, 0x37,
+	0x59, 0x5a, 0x82, 0x49, 0x6c, 0x08, 0x61, 0xd8, 0x6f, 0x4b, 0x70, 0xdf, 0x9a, 0xea, 0x77, 0x76,
+	0xf8, 0xf0, 0xae, 0xe5, 0xa3, 0x6e, 0x52, 0x6c, 0x52, 0x61, 0xc8, 0x53, 0x2a, 0xaa, 0xf2, 0x2c,
+	0x0f, 0xa5, 0xca, 0xb3, 0xd2, 0x5f, 0xd8, 0x34, 0xd2, 0x77, 0xbc, 0x3c, 0x3a, 0x68, 0xbc, 0xdc,
+	0xfc, 0x90, 0xf8, 0xdc, 0x94, 0xfc, 0xbc, 0x83, 0xa9, 0xbb, 0x6d, 0xc1, 0x18, 0x11, 0x7f, 0xe8,
+	0x73, 0x2f, 0x8a, 0x68, 0x16, 0x6a, 0x93, 0x3a, 0xa0, 0xb0, 0x7f, 0xa2, 0xf6, 0xe5, 0x95, 0xc0,
+	0xf9, 0xa6, 0xd9, 0x60, 0xf6, 0x15, 0xaf, 0x14, 0x92, 0xf6, 0x53, 0x29, 0xd4, 0xfc, 0x29, 0x27,
+	0x24, 0x7e, 0x03, 0xf3, 0x0e, 0x6a, 0xbd, 0x9f, 0x83, 0x89, 0xd8, 0x3a, 0x0e, 0x0a, 0x9b, 0x94,
+	0x5a, 0x64, 0xb9, 0x19, 0x9b, 0x4f, 0x9a, 0xc2, 0x28, 0x0c, 0x95, 0x40, 0x0e, 0x12, 0x86, 0x38,
+	0x34, 0x55, 0xb5, 0x2d, 0xbb, 0xd7, 0xd9, 0x19, 0xb8, 0xa2, 0xa3, 0x90, 0x81, 0x2b, 0x30, 0x8a,
+	0xfd, 0x6b, 0x1f, 0x91, 0x27, 0xc5, 0x61, 0xbf, 0xcc, 0xdd, 0x53, 0x93, 0x94, 0x47, 0xd5, 0x65,
+	0x17, 0xd0, 0x0d, 0x18, 0xd7, 0x7a, 0xe4, 0x96, 0x8f, 0x1e, 0x4c, 0xd8, 0x73, 0x52, 0x14, 0x97,
+	0xa0, 0x91, 0x66, 0x94, 0xd9, 0xc1, 0x54, 0x90, 0x7b, 0x97, 0x82, 0x21, 0x4a, 0x86, 0xd6, 0xfc,
+	0x84, 0x54, 0xac, 0x29, 0x48, 0x43, 0xa8, 0x7b, 0xef, 0x92, 0x39, 0x0b, 0xb0, 0x4b, 0x06, 0x68,
+	0xd3, 0x14, 0x7f, 0x45, 0xa9, 0xd2, 0x96, 0x96, 0xc6, 0xb8, 0xdc, 0xde, 0xf3, 0x11, 0xc9, 0x66,
+	0x51, 0x2e, 0xd7, 0x70, 0x83, 0x3c, 0x03, 0x23, 0x96, 0x6d, 0x75, 0xc2, 0x94, 0x0f, 0x79, 0xc0,
+	0xbe, 0x81, 0x08, 0x29, 0xaa, 0x6c, 0xa5, 0x85, 0xad, 0xd3, 0xa4, 0x9d, 0x55, 0xb3, 0x8a, 0xeb,
+	0x7c, 0x52, 0xbc, 0x31, 0xa3, 0xf8, 0x91, 0x14, 0x3f, 0x9b, 0x28, 0xe8, 0xf5, 0x1e, 0xf2, 0x7c,
+	0xe4, 0x6e, 0xa8, 0x7b, 0xde, 0xa1, 0xc7, 0xc3, 0x89, 0x78, 0x3e, 0x46, 0x1c, 0x63, 0xe1, 0x13,
+	0x12, 0x12, 0x6f, 0xf4, 0xdc, 0xce, 0x8e, 0xea, 0x21, 0xcc, 0x25, 0x72, 0x89, 0xcd, 0x5c, 0x09,
+	0x0a, 0xfc, 0xb4, 0x7e, 0x6a, 0x44, 0x08, 0xae, 0x98, 0x76, 0xc1, 0xd1, 0xa8, 0xbc, 0xcf, 0xa3,
+	0x51, 0xbe, 0xa1, 0xd3, 0x62, 0x12, 0x42, 0x0f, 0x8d, 0x99, 0x05, 0xbc, 0x85, 0xec, 0xaf, 0xfc,
+	0x63, 0x01, 0xca, 0xeb, 0x9e, 0x2e, 0xbf, 0x0a, 0x13, 0xb1, 0xcf, 0x92, 0xce, 0x67, 0x14, 0x42,
+	0xf1, 0xa0, 0xc6, 0xc3, 0x7d, 0x80, 0xd8, 0xc2, 0x79, 0x15, 0x26, 0x62, 0xdf, 0xb8, 0x64, 0xcd,
+	0xc0, 0x83, 0x32, 0x67, 0x10, 0x7d, 0xb4, 0x22, 0x9b, 0x70, 0x3c, 0x55, 0x1d, 0xf3, 0x50, 0xc6,
+	0x00, 0x49, 0x60, 0x63, 0xb9, 0x4f, 0x20, 0xcf, 0x4f, 0xec, 0xc6, 0x36, 0x8b, 0x1f, 0x1e, 0x94,
+	0xc9, 0x8f, 0xe8, 0xbe, 0x50, 0xb6, 0xe1, 0x44, 0xfa, 0x03, 0x9c, 0x85, 0x2c, 0x89, 0x24, 0x91,
+	0x8d, 0x2b, 0xfd, 0x22, 0xd9, 0x84, 0x3f, 0x90, 0xa0, 0x9e, 0x99, 0x14, 0xc9, 0x12, 0x50, 0x56,
+	0x87, 0xc6, 0xff, 0xee, 0xb3, 0x03, 0x2f, 0xd9, 0x58, 0x06, 0x35, 0xdf, 0x16, 0x09, 0xa8, 0xc0,
+	0x16, 0x13, 0xc1, 0xe2, 0xcb, 0x00, 0x5c, 0x51, 0xfd, 0xb9, 0x8c, 0xae, 0x11, 0xa4, 0x71, 0xb1,
+	0x10, 0xc2, 0x53, 0x1f, 0xfb, 0x28, 0xe2, 0x7c, 0x61, 0xd7, 0xad, 0x95, 0x4c, 0xea, 0x45, 0x1f,
+	0x07, 0x60, 0x3b, 0x4f, 0x7d, 0x18, 0x90, 0x65, 0xe7, 0x49, 0x60, 0xa6, 0x9d, 0x67, 0x15, 0xf3,
+	0x63, 0x59, 0x71, 0x85, 0xfc, 0x59, 0xb2, 0x8a, 0x20, 0x99, 0xb2, 0x12, 0x94, 0xb7, 0x33, 0x9f,
+	0x50, 0xa0, 0x69, 0x1e, 0x54, 0xe0, 0x13, 0x12, 0x33, 0xb8, 0x20, 0x0b, 0xea, 0xb7, 0x32, 0x49,
+	0x4c, 0x41, 0x1b, 0x8f, 0xf4, 0x0d, 0x4d, 0x7b, 0x86, 0x02, 0xae, 0x78, 0x50, 0x81, 0x67, 0x48,
+	0xcc, 0x10, 0xf7, 0x0c, 0x74, 0x9a, 0x3e, 0x3c, 0x03, 0x9d, 0xeb, 0x4a, 0xbf, 0xc8, 0xb4, 0x6b,
+	0xe5, 0x8a, 0x36, 0xf2, 0x5d, 0x6b, 0x04, 0x2c, 0x70, 0xad, 0xe9, 0x32, 0x11, 0xb9, 0x07, 0x27,
+	0x45, 0xc9, 0xf0, 0xc5, 0x3e, 0xc6, 0xa1, 0xd8, 0xc6, 0x4a, 0xff, 0x58, 0x36, 0xed, 0x5b, 0x12,
+	0x9c, 0xce, 0xbe, 0x92, 0xba, 0x92, 0x6b, 0x08, 0x22, 0x1a, 0x9e, 0xd8, 0x6f, 0x0f, 0x46, 0xc9,
+	0x1d, 0x98, 0x11, 0xde, 0x25, 0xe5, 0x99, 0x7e, 0x12, 0xdc, 0x78, 0x74, 0x1f, 0x60, 0x36, 0xf3,
+	0xdb, 0x12, 0x9c, 0xc9, 0xbb, 0x90, 0x58, 0x29, 0x18, 0x54, 0x24, 0x87, 0x6b, 0xfb, 0xef, 0xc3,
+	0xe8, 0xf9, 0x36, 0xd4, 0xf8, 0x2f, 0x23, 0x9a, 0xb9, 0x5e, 0x3e, 0xc0, 0x34, 0x16, 0x8b, 0x31,
+	0xfc, 0xf0, 0xfc, 0xd7, 0x09, 0xcd, 0x5c, 0xd7, 0x92, 0x3f, 0xbc, 0xe0, 0x7b, 0x03, 0xbc, 0x4e,
+	0xd3, 0xdf, 0x1a, 0x2c, 0xe4, 0x9a, 0x26, 0x87, 0xcc, 0x5c, 0xa7, 0x99, 0x85, 0xf7, 0xd1, 0x3a,
+	0xe5, 0x0a, 0xba, 0x1f, 0x2a, 0x1e, 0x25, 0x00, 0x16, 0xac, 0xd3, 0x74, 0x59, 0x35, 0xde, 0x1a,
+	0xb8, 0x92, 0xea, 0xac, 0xad, 0x21, 0x82, 0x64, 0x6e, 0x0d, 0xe9, 0x72, 0x67, 0xac, 0x19, 0xbe,
+	0x50, 0xaa, 0x99, 0xeb, 0x1e, 0xf3, 0x35, 0x23, 0xa8, 0x54, 0x22, 0x7b, 0x68, 0xe2, 0xeb, 0x84,
+	0xec, 0x3d, 0x34, 0x0e, 0xcc, 0xd9, 0x43, 0xc5, 0xb5, 0xff, 0xf2, 0xb7, 0xa0, 0x1a, 0xd5, 0xe0,
+	0xce, 0x67, 0xf4, 0x66, 0x88, 0xc6, 0x42, 0x11, 0x22, 0xbd, 0x81, 0xd2, 0xb1, 0xf3, 0x37, 0x50,
+	0x3a, 0xfc, 0xc3, 0x7d, 0x80, 0xf8, 0x19, 0x62, 0xe5, 0x5c, 0xe7, 0x73, 0x8d, 0x84, 0x80, 0x32,
+	0x67, 0x10, 0xd5, 0x60, 0xc9, 0x1d, 0x98, 0x8c, 0x17, 0xa5, 0x3c, 0x90, 0xa9, 0x47, 0x0e, 0xd5,
+	0xb8, 0xd4, 0x0f, 0x8a, 0x4d, 0xf2, 0x5d, 0xb8, 0x4f, 0x5c, 0xce, 0x74, 0x29, 0x33, 0x5a, 0x11,
+	0xa0, 0x1b, 0x57, 0xf7, 0x83, 0xe6, 0xf7, 0x33, 0x51, 0x79, 0xd0, 0x62, 0xee, 0xfe, 0x10, 0x9f,
+	0x78, 0xa5, 0x7f, 0x2c, 0x3f, 0xad, 0xa8, 0xe6, 0x67, 0x31, 0x37, 0x02, 0xec, 0x6f, 0xda, 0x9c,
+	0x5a, 0x1e, 0xf9, 0x79, 0x18, 0xa5, 0x75, 0x3c, 0x67, 0x33, 0xa3, 0x5a, 0xfc, 0xba, 0xf1, 0x60,
+	0xee, 0x6b, 0x36, 0xde, 0x9b, 0x70, 0x7f, 0xc6, 0xe5, 0xe7, 0xe5, 0xec, 0x01, 0x04, 0xf0, 0xc6,
+	0x63, 0xfb, 0x82, 0xf3, 0x62, 0x14, 0xdd, 0xf6, 0x2d, 0x16, 0x8d, 0x16, 0x61, 0x33, 0xc5, 0x98,
+	0x73, 0x57, 0x87, 0xa7, 0x15, 0xdd, 0xd3, 0x2d, 0xf6, 0x11, 0xfd, 0x52, 0x6c, 0x63, 0xa5, 0x7f,
+	0x2c, 0x1f, 0x30, 0x0b, 0x2e, 0xd0, 0x2e, 0x16, 0x31, 0xc0, 0xa0, 0x99, 0x01, 0x73, 0xf6, 0xc5,
+	0x16, 0x39, 0x32, 0x71, 0x97, 0x5a, 0xd9, 0x47, 0xa6, 0x08, 0x94, 0x73, 0x64, 0x4a, 0xdf, 0x20,
+	0xd1, 0x19, 0xa2, 0xdb, 0xa3, 0x9c, 0x19, 0x18, 0x28, 0x6f, 0x86, 0xd4, 0x75, 0x0e, 0xf6, 0x62,
+	0xf1, 0xab, 0x9c, 0x07, 0x72, 0x5c, 0x05, 0x43, 0x35, 0x2e, 0xf5, 0x83, 0xe2, 0x95, 0x23, 0xc8,
+	0xb4, 0x67, 0x29, 0x27, 0x0d, 0xcd, 0x54, 0x4e, 0x4e, 0xe2, 0x3b, 0x9c, 0x33, 0x9e, 0xb8, 0xce,
+	0x9d, 0x33, 0x06, 0xcd, 0x9f, 0x53, 0x98, 0x6c, 0x96, 0x0d, 0x98, 0x4e, 0x26, 0x9a, 0x2f, 0x64,
+	0xba, 0xfb, 0x18, 0xae, 0xb1, 0xd4, 0x1f, 0x8e, 0x0f, 0xd1, 0xd2, 0xb9, 0xdb, 0x85, 0x4c, 0xb7,
+	0x97, 0x40, 0x66, 0x86, 0x68, 0x99, 0x39, 0xd3, 0x98, 0x3b, 0x8b, 0xe7, 0x4b, 0x0b, 0xdd, 0x59,
+	0x0c, 0x5e, 0xec, 0xce, 0x84, 0x09, 0x4f, 0xec, 0x57, 0x44, 0xc9, 0xce, 0xc5, 0xcc, 0x98, 0x23,
+	0x85, 0xcd, 0xf4, 0x2b, 0x39, 0x89, 0xc6, 0xb5, 0xd6, 0xdd, 0xcf, 0x67, 0xa5, 0x8f, 0x3e, 0x9f,
+	0x95, 0x3e, 0xfb, 0x7c, 0x56, 0x7a, 0xe7, 0x8b, 0xd9, 0x63, 0x1f, 0x7d, 0x31, 0x7b, 0xec, 0x2f,
+	0x5f, 0xcc, 0x1e, 0x7b, 0x79, 0x99, 0x2b, 0xe5, 0xd8, 0xb6, 0xb6, 0x2f, 0x07, 0x95, 0xe8, 0xcb,
+	0xdc, 0x4f, 0x29, 0xdd, 0x89, 0xff, 0x98, 0xd2, 0xf6, 0x68, 0xf0, 0x3d, 0xcf, 0xa3, 0xff, 0x09,
+	0x00, 0x00, 0xff, 0xff, 0xee, 0x62, 0xd5, 0x35, 0xb4, 0x4a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// basic operation of bucket
+	CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error)
+	DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error)
+	UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error)
+	MirrorBucket(ctx context.Context, in *MsgMirrorBucket, opts ...grpc.CallOption) (*MsgMirrorBucketResponse, error)
+	DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error)
+	ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error)
+	// basic operation of object
+	CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error)
+	SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error)
+	SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error)
+	RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error)
+	CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error)
+	DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error)
+	CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error)
+	MirrorObject(ctx context.Context, in *MsgMirrorObject, opts ...grpc.CallOption) (*MsgMirrorObjectResponse, error)
+	DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error)
+	UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error)
+	UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error)
+	CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error)
+	DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error)
+	DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error)
+	// basic operation of group
+	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
+	DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error)
+	UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error)
+	UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error)
+	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	MirrorGroup(ctx context.Context, in *MsgMirrorGroup, opts ...grpc.CallOption) (*MsgMirrorGroupResponse, error)
+	RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error)
+	// basic operation of policy
+	PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error)
+	DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error)
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error)
+	CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error)
+	CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error)
+	RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error)
+	// Since: Manchurian upgrade
+	SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error)
+	SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error)
+	// basic operation of object version
+	SetBucketVersioning(ctx context.Context, in *MsgSetBucketVersioning, opts ...grpc.CallOption) (*MsgSetBucketVersioningResponse, error)
+	DeleteObjectVersion(ctx context.Context, in *MsgDeleteObjectVersion, opts ...grpc.CallOption) (*MsgDeleteObjectVersionResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *MsgSetBucketLifecycle, opts ...grpc.CallOption) (*MsgSetBucketLifecycleResponse, error)
+	// basic operation of object lock
+	SetRetention(ctx context.Context, in *MsgSetRetention, opts ...grpc.CallOption) (*MsgSetRetentionResponse, error)
+	SetLegalHold(ctx context.Context, in *MsgSetLegalHold, opts ...grpc.CallOption) (*MsgSetLegalHoldResponse, error)
+	ComposeObject(ctx context.Context, in *MsgComposeObject, opts ...grpc.CallOption) (*MsgComposeObjectResponse, error)
+	// batch operation of object
+	BatchCreateObjects(ctx context.Context, in *MsgBatchCreateObjects, opts ...grpc.CallOption) (*MsgBatchCreateObjectsResponse, error)
+	BatchDeleteObjects(ctx context.Context, in *MsgBatchDeleteObjects, opts ...grpc.CallOption) (*MsgBatchDeleteObjectsResponse, error)
+	// basic operation of read voucher
+	MintReadVoucher(ctx context.Context, in *MsgMintReadVoucher, opts ...grpc.CallOption) (*MsgMintReadVoucherResponse, error)
+	RedeemReadVoucher(ctx context.Context, in *MsgRedeemReadVoucher, opts ...grpc.CallOption) (*MsgRedeemReadVoucherResponse, error)
+	SetBucketRequesterPays(ctx context.Context, in *MsgSetBucketRequesterPays, opts ...grpc.CallOption) (*MsgSetBucketRequesterPaysResponse, error)
+	PurchaseReaderQuota(ctx context.Context, in *MsgPurchaseReaderQuota, opts ...grpc.CallOption) (*MsgPurchaseReaderQuotaResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error) {
+	out := new(MsgCreateBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreateBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelMigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/CancelMigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelMigrateBucket(ctx, req.(*MsgCancelMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RejectMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectMigrateBucket)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error) {
+	out := new(MsgDeleteBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectMigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/RejectMigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectMigrateBucket(ctx, req.(*MsgRejectMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetTag)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error) {
+	out := new(MsgUpdateBucketInfoResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateBucketInfo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/SetTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetTag(ctx, req.(*MsgSetTag))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBucketFlowRateLimit)
-	if err := dec(in); err != nil {
+func (c *msgClient) MirrorBucket(ctx context.Context, in *MsgMirrorBucket, opts ...grpc.CallOption) (*MsgMirrorBucketResponse, error) {
+	out := new(MsgMirrorBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MirrorBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.storage.Msg/SetBucketFlowRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, req.(*MsgSetBucketFlowRateLimit))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.storage.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBucket",
-			Handler:    _Msg_CreateBucket_Handler,
-		},
-		{
-			MethodName: "DeleteBucket",
-			Handler:    _Msg_DeleteBucket_Handler,
-		},
-		{
-			MethodName: "UpdateBucketInfo",
-			Handler:    _Msg_UpdateBucketInfo_Handler,
-		},
-		{
-			MethodName: "MirrorBucket",
-			Handler:    _Msg_MirrorBucket_Handler,
-		},
-		{
-			MethodName: "DiscontinueBucket",
-			Handler:    _Msg_DiscontinueBucket_Handler,
-		},
-		{
-			MethodName: "ToggleSPAsDelegatedAgent",
-			Handler:    _Msg_ToggleSPAsDelegatedAgent_Handler,
-		},
-		{
-			MethodName: "CreateObject",
-			Handler:    _Msg_CreateObject_Handler,
-		},
-		{
-			MethodName: "SealObject",
-			Handler:    _Msg_SealObject_Handler,
-		},
-		{
-			MethodName: "SealObjectV2",
-			Handler:    _Msg_SealObjectV2_Handler,
-		},
-		{
-			MethodName: "RejectSealObject",
-			Handler:    _Msg_RejectSealObject_Handler,
-		},
-		{
-			MethodName: "CopyObject",
-			Handler:    _Msg_CopyObject_Handler,
-		},
-		{
-			MethodName: "DeleteObject",
-			Handler:    _Msg_DeleteObject_Handler,
-		},
-		{
-			MethodName: "CancelCreateObject",
-			Handler:    _Msg_CancelCreateObject_Handler,
-		},
-		{
-			MethodName: "MirrorObject",
-			Handler:    _Msg_MirrorObject_Handler,
-		},
-		{
-			MethodName: "DiscontinueObject",
-			Handler:    _Msg_DiscontinueObject_Handler,
-		},
-		{
-			MethodName: "UpdateObjectInfo",
-			Handler:    _Msg_UpdateObjectInfo_Handler,
-		},
-		{
-			MethodName: "UpdateObjectContent",
-			Handler:    _Msg_UpdateObjectContent_Handler,
-		},
-		{
-			MethodName: "CancelUpdateObjectContent",
-			Handler:    _Msg_CancelUpdateObjectContent_Handler,
-		},
-		{
-			MethodName: "DelegateCreateObject",
-			Handler:    _Msg_DelegateCreateObject_Handler,
-		},
-		{
-			MethodName: "DelegateUpdateObjectContent",
-			Handler:    _Msg_DelegateUpdateObjectContent_Handler,
-		},
-		{
-			MethodName: "CreateGroup",
-			Handler:    _Msg_CreateGroup_Handler,
-		},
-		{
-			MethodName: "DeleteGroup",
-			Handler:    _Msg_DeleteGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroupMember",
-			Handler:    _Msg_UpdateGroupMember_Handler,
-		},
-		{
-			MethodName: "UpdateGroupExtra",
-			Handler:    _Msg_UpdateGroupExtra_Handler,
-		},
-		{
-			MethodName: "LeaveGroup",
-			Handler:    _Msg_LeaveGroup_Handler,
-		},
-		{
-			MethodName: "MirrorGroup",
-			Handler:    _Msg_MirrorGroup_Handler,
-		},
-		{
-			MethodName: "RenewGroupMember",
-			Handler:    _Msg_RenewGroupMember_Handler,
-		},
-		{
-			MethodName: "PutPolicy",
-			Handler:    _Msg_PutPolicy_Handler,
-		},
-		{
-			MethodName: "DeletePolicy",
-			Handler:    _Msg_DeletePolicy_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "MigrateBucket",
-			Handler:    _Msg_MigrateBucket_Handler,
-		},
-		{
-			MethodName: "CompleteMigrateBucket",
-			Handler:    _Msg_CompleteMigrateBucket_Handler,
-		},
-		{
-			MethodName: "CancelMigrateBucket",
-			Handler:    _Msg_CancelMigrateBucket_Handler,
-		},
-		{
-			MethodName: "RejectMigrateBucket",
-			Handler:    _Msg_RejectMigrateBucket_Handler,
-		},
-		{
-			MethodName: "SetTag",
-			Handler:    _Msg_SetTag_Handler,
-		},
-		{
-			MethodName: "SetBucketFlowRateLimit",
-			Handler:    _Msg_SetBucketFlowRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/storage/tx.proto",
+	return out, nil
 }
 
-func (m *MsgCreateBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error) {
+	out := new(MsgDiscontinueBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DiscontinueBucket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error) {
+	out := new(MsgToggleSPAsDelegatedAgentResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/ToggleSPAsDelegatedAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.PrimarySpApproval != nil {
-		{
-			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.PrimarySpAddress) > 0 {
-		i -= len(m.PrimarySpAddress)
-		copy(dAtA[i:], m.PrimarySpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PrimarySpAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error) {
+	out := new(MsgCreateObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreateObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error) {
+	out := new(MsgSealObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SealObject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error) {
+	out := new(MsgSealObjectV2Response)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SealObjectV2", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error) {
+	out := new(MsgRejectSealObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RejectSealObject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error) {
+	out := new(MsgCopyObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CopyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error) {
+	out := new(MsgDeleteObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error) {
+	out := new(MsgCancelCreateObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CancelCreateObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MirrorObject(ctx context.Context, in *MsgMirrorObject, opts ...grpc.CallOption) (*MsgMirrorObjectResponse, error) {
+	out := new(MsgMirrorObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MirrorObject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error) {
+	out := new(MsgDiscontinueObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DiscontinueObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error) {
+	out := new(MsgUpdateObjectInfoResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateObjectInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error) {
+	out := new(MsgUpdateObjectContentResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateObjectContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error) {
+	out := new(MsgCancelUpdateObjectContentResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CancelUpdateObjectContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error) {
+	out := new(MsgDelegateCreateObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DelegateCreateObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error) {
+	out := new(MsgDelegateUpdateObjectContentResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DelegateUpdateObjectContent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
+	out := new(MsgCreateGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error) {
+	out := new(MsgDeleteGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error) {
+	out := new(MsgUpdateGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error) {
+	out := new(MsgUpdateGroupExtraResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateGroupExtra", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
+	out := new(MsgLeaveGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/LeaveGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MirrorGroup(ctx context.Context, in *MsgMirrorGroup, opts ...grpc.CallOption) (*MsgMirrorGroupResponse, error) {
+	out := new(MsgMirrorGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MirrorGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RedundancyType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x48
+func (c *msgClient) RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error) {
+	out := new(MsgRenewGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RenewGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+	return out, nil
+}
+
+func (c *msgClient) PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error) {
+	out := new(MsgPutPolicyResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/PutPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PrimarySpApproval != nil {
-		{
-			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error) {
+	out := new(MsgDeletePolicyResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeletePolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error) {
+	out := new(MsgMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MigrateBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSealObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error) {
+	out := new(MsgCompleteMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CompleteMigrateBucket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSealObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error) {
+	out := new(MsgCancelMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CancelMigrateBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SecondarySpBlsAggSignatures) > 0 {
-		i -= len(m.SecondarySpBlsAggSignatures)
-		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error) {
+	out := new(MsgRejectMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RejectMigrateBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSealObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error) {
+	out := new(MsgSetTagResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetTag", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error) {
+	out := new(MsgSetBucketFlowRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetBucketFlowRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetBucketVersioning(ctx context.Context, in *MsgSetBucketVersioning, opts ...grpc.CallOption) (*MsgSetBucketVersioningResponse, error) {
+	out := new(MsgSetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetBucketVersioning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSealObjectV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteObjectVersion(ctx context.Context, in *MsgDeleteObjectVersion, opts ...grpc.CallOption) (*MsgDeleteObjectVersionResponse, error) {
+	out := new(MsgDeleteObjectVersionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/DeleteObjectVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSealObjectV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBucketLifecycle(ctx context.Context, in *MsgSetBucketLifecycle, opts ...grpc.CallOption) (*MsgSetBucketLifecycleResponse, error) {
+	out := new(MsgSetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSealObjectV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.SecondarySpBlsAggSignatures) > 0 {
-		i -= len(m.SecondarySpBlsAggSignatures)
-		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetRetention(ctx context.Context, in *MsgSetRetention, opts ...grpc.CallOption) (*MsgSetRetentionResponse, error) {
+	out := new(MsgSetRetentionResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSealObjectV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetLegalHold(ctx context.Context, in *MsgSetLegalHold, opts ...grpc.CallOption) (*MsgSetLegalHoldResponse, error) {
+	out := new(MsgSetLegalHoldResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetLegalHold", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSealObjectV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ComposeObject(ctx context.Context, in *MsgComposeObject, opts ...grpc.CallOption) (*MsgComposeObjectResponse, error) {
+	out := new(MsgComposeObjectResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/ComposeObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSealObjectV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRejectSealObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchCreateObjects(ctx context.Context, in *MsgBatchCreateObjects, opts ...grpc.CallOption) (*MsgBatchCreateObjectsResponse, error) {
+	out := new(MsgBatchCreateObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/BatchCreateObjects", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRejectSealObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchDeleteObjects(ctx context.Context, in *MsgBatchDeleteObjects, opts ...grpc.CallOption) (*MsgBatchDeleteObjectsResponse, error) {
+	out := new(MsgBatchDeleteObjectsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/BatchDeleteObjects", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRejectSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCopyObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MintReadVoucher(ctx context.Context, in *MsgMintReadVoucher, opts ...grpc.CallOption) (*MsgMintReadVoucherResponse, error) {
+	out := new(MsgMintReadVoucherResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/MintReadVoucher", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCopyObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DstPrimarySpApproval != nil {
-		{
-			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.DstObjectName) > 0 {
-		i -= len(m.DstObjectName)
-		copy(dAtA[i:], m.DstObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DstObjectName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SrcObjectName) > 0 {
-		i -= len(m.SrcObjectName)
-		copy(dAtA[i:], m.SrcObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DstBucketName) > 0 {
-		i -= len(m.DstBucketName)
-		copy(dAtA[i:], m.DstBucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DstBucketName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SrcBucketName) > 0 {
-		i -= len(m.SrcBucketName)
-		copy(dAtA[i:], m.SrcBucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcBucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCopyObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedeemReadVoucher(ctx context.Context, in *MsgRedeemReadVoucher, opts ...grpc.CallOption) (*MsgRedeemReadVoucherResponse, error) {
+	out := new(MsgRedeemReadVoucherResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/RedeemReadVoucher", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCopyObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCopyObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) SetBucketRequesterPays(ctx context.Context, in *MsgSetBucketRequesterPays, opts ...grpc.CallOption) (*MsgSetBucketRequesterPaysResponse, error) {
+	out := new(MsgSetBucketRequesterPaysResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SetBucketRequesterPays", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PurchaseReaderQuota(ctx context.Context, in *MsgPurchaseReaderQuota, opts ...grpc.CallOption) (*MsgPurchaseReaderQuotaResponse, error) {
+	out := new(MsgPurchaseReaderQuotaResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/PurchaseReaderQuota", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// basic operation of bucket
+	CreateBucket(context.Context, *MsgCreateBucket) (*MsgCreateBucketResponse, error)
+	DeleteBucket(context.Context, *MsgDeleteBucket) (*MsgDeleteBucketResponse, error)
+	UpdateBucketInfo(context.Context, *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error)
+	MirrorBucket(context.Context, *MsgMirrorBucket) (*MsgMirrorBucketResponse, error)
+	DiscontinueBucket(context.Context, *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error)
+	ToggleSPAsDelegatedAgent(context.Context, *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error)
+	// basic operation of object
+	CreateObject(context.Context, *MsgCreateObject) (*MsgCreateObjectResponse, error)
+	SealObject(context.Context, *MsgSealObject) (*MsgSealObjectResponse, error)
+	SealObjectV2(context.Context, *MsgSealObjectV2) (*MsgSealObjectV2Response, error)
+	RejectSealObject(context.Context, *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error)
+	CopyObject(context.Context, *MsgCopyObject) (*MsgCopyObjectResponse, error)
+	DeleteObject(context.Context, *MsgDeleteObject) (*MsgDeleteObjectResponse, error)
+	CancelCreateObject(context.Context, *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error)
+	MirrorObject(context.Context, *MsgMirrorObject) (*MsgMirrorObjectResponse, error)
+	DiscontinueObject(context.Context, *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error)
+	UpdateObjectInfo(context.Context, *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error)
+	UpdateObjectContent(context.Context, *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error)
+	CancelUpdateObjectContent(context.Context, *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error)
+	DelegateCreateObject(context.Context, *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error)
+	DelegateUpdateObjectContent(context.Context, *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error)
+	// basic operation of group
+	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
+	DeleteGroup(context.Context, *MsgDeleteGroup) (*MsgDeleteGroupResponse, error)
+	UpdateGroupMember(context.Context, *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error)
+	UpdateGroupExtra(context.Context, *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error)
+	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	MirrorGroup(context.Context, *MsgMirrorGroup) (*MsgMirrorGroupResponse, error)
+	RenewGroupMember(context.Context, *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error)
+	// basic operation of policy
+	PutPolicy(context.Context, *MsgPutPolicy) (*MsgPutPolicyResponse, error)
+	DeletePolicy(context.Context, *MsgDeletePolicy) (*MsgDeletePolicyResponse, error)
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	MigrateBucket(context.Context, *MsgMigrateBucket) (*MsgMigrateBucketResponse, error)
+	CompleteMigrateBucket(context.Context, *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error)
+	CancelMigrateBucket(context.Context, *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error)
+	RejectMigrateBucket(context.Context, *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error)
+	// Since: Manchurian upgrade
+	SetTag(context.Context, *MsgSetTag) (*MsgSetTagResponse, error)
+	SetBucketFlowRateLimit(context.Context, *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error)
+	// basic operation of object version
+	SetBucketVersioning(context.Context, *MsgSetBucketVersioning) (*MsgSetBucketVersioningResponse, error)
+	DeleteObjectVersion(context.Context, *MsgDeleteObjectVersion) (*MsgDeleteObjectVersionResponse, error)
+	SetBucketLifecycle(context.Context, *MsgSetBucketLifecycle) (*MsgSetBucketLifecycleResponse, error)
+	// basic operation of object lock
+	SetRetention(context.Context, *MsgSetRetention) (*MsgSetRetentionResponse, error)
+	SetLegalHold(context.Context, *MsgSetLegalHold) (*MsgSetLegalHoldResponse, error)
+	ComposeObject(context.Context, *MsgComposeObject) (*MsgComposeObjectResponse, error)
+	// batch operation of object
+	BatchCreateObjects(context.Context, *MsgBatchCreateObjects) (*MsgBatchCreateObjectsResponse, error)
+	BatchDeleteObjects(context.Context, *MsgBatchDeleteObjects) (*MsgBatchDeleteObjectsResponse, error)
+	// basic operation of read voucher
+	MintReadVoucher(context.Context, *MsgMintReadVoucher) (*MsgMintReadVoucherResponse, error)
+	RedeemReadVoucher(context.Context, *MsgRedeemReadVoucher) (*MsgRedeemReadVoucherResponse, error)
+	SetBucketRequesterPays(context.Context, *MsgSetBucketRequesterPays) (*MsgSetBucketRequesterPaysResponse, error)
+	PurchaseReaderQuota(context.Context, *MsgPurchaseReaderQuota) (*MsgPurchaseReaderQuotaResponse, error)
 }
 
-func (m *MsgDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgDeleteObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateBucket(ctx context.Context, req *MsgCreateBucket) (*MsgCreateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
 }
-
-func (m *MsgDeleteObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteBucket(ctx context.Context, req *MsgDeleteBucket) (*MsgDeleteBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
 }
-
-func (m *MsgDeleteObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateBucketInfo(ctx context.Context, req *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBucketInfo not implemented")
 }
-
-func (m *MsgDiscontinueObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) MirrorBucket(ctx context.Context, req *MsgMirrorBucket) (*MsgMirrorBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MirrorBucket not implemented")
+}
+func (*UnimplementedMsgServer) DiscontinueBucket(ctx context.Context, req *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueBucket not implemented")
+}
+func (*UnimplementedMsgServer) ToggleSPAsDelegatedAgent(ctx context.Context, req *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleSPAsDelegatedAgent not implemented")
+}
+func (*UnimplementedMsgServer) CreateObject(ctx context.Context, req *MsgCreateObject) (*MsgCreateObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateObject not implemented")
+}
+func (*UnimplementedMsgServer) SealObject(ctx context.Context, req *MsgSealObject) (*MsgSealObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealObject not implemented")
+}
+func (*UnimplementedMsgServer) SealObjectV2(ctx context.Context, req *MsgSealObjectV2) (*MsgSealObjectV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealObjectV2 not implemented")
+}
+func (*UnimplementedMsgServer) RejectSealObject(ctx context.Context, req *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSealObject not implemented")
+}
+func (*UnimplementedMsgServer) CopyObject(ctx context.Context, req *MsgCopyObject) (*MsgCopyObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyObject not implemented")
+}
+func (*UnimplementedMsgServer) DeleteObject(ctx context.Context, req *MsgDeleteObject) (*MsgDeleteObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (*UnimplementedMsgServer) CancelCreateObject(ctx context.Context, req *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCreateObject not implemented")
+}
+func (*UnimplementedMsgServer) MirrorObject(ctx context.Context, req *MsgMirrorObject) (*MsgMirrorObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MirrorObject not implemented")
+}
+func (*UnimplementedMsgServer) DiscontinueObject(ctx context.Context, req *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueObject not implemented")
+}
+func (*UnimplementedMsgServer) UpdateObjectInfo(ctx context.Context, req *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectInfo not implemented")
+}
+func (*UnimplementedMsgServer) UpdateObjectContent(ctx context.Context, req *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectContent not implemented")
+}
+func (*UnimplementedMsgServer) CancelUpdateObjectContent(ctx context.Context, req *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpdateObjectContent not implemented")
+}
+func (*UnimplementedMsgServer) DelegateCreateObject(ctx context.Context, req *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateCreateObject not implemented")
+}
+func (*UnimplementedMsgServer) DelegateUpdateObjectContent(ctx context.Context, req *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateUpdateObjectContent not implemented")
+}
+func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (*UnimplementedMsgServer) DeleteGroup(ctx context.Context, req *MsgDeleteGroup) (*MsgDeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupMember(ctx context.Context, req *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupMember not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupExtra(ctx context.Context, req *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupExtra not implemented")
+}
+func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (*UnimplementedMsgServer) MirrorGroup(ctx context.Context, req *MsgMirrorGroup) (*MsgMirrorGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MirrorGroup not implemented")
+}
+func (*UnimplementedMsgServer) RenewGroupMember(ctx context.Context, req *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewGroupMember not implemented")
+}
+func (*UnimplementedMsgServer) PutPolicy(ctx context.Context, req *MsgPutPolicy) (*MsgPutPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutPolicy not implemented")
+}
+func (*UnimplementedMsgServer) DeletePolicy(ctx context.Context, req *MsgDeletePolicy) (*MsgDeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) MigrateBucket(ctx context.Context, req *MsgMigrateBucket) (*MsgMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) CompleteMigrateBucket(ctx context.Context, req *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) CancelMigrateBucket(ctx context.Context, req *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) RejectMigrateBucket(ctx context.Context, req *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) SetTag(ctx context.Context, req *MsgSetTag) (*MsgSetTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTag not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketFlowRateLimit(ctx context.Context, req *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketFlowRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketVersioning(ctx context.Context, req *MsgSetBucketVersioning) (*MsgSetBucketVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketVersioning not implemented")
+}
+func (*UnimplementedMsgServer) DeleteObjectVersion(ctx context.Context, req *MsgDeleteObjectVersion) (*MsgDeleteObjectVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObjectVersion not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketLifecycle(ctx context.Context, req *MsgSetBucketLifecycle) (*MsgSetBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketLifecycle not implemented")
+}
+func (*UnimplementedMsgServer) SetRetention(ctx context.Context, req *MsgSetRetention) (*MsgSetRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetention not implemented")
+}
+func (*UnimplementedMsgServer) SetLegalHold(ctx context.Context, req *MsgSetLegalHold) (*MsgSetLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLegalHold not implemented")
+}
+func (*UnimplementedMsgServer) ComposeObject(ctx context.Context, req *MsgComposeObject) (*MsgComposeObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComposeObject not implemented")
+}
+func (*UnimplementedMsgServer) BatchCreateObjects(ctx context.Context, req *MsgBatchCreateObjects) (*MsgBatchCreateObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateObjects not implemented")
+}
+func (*UnimplementedMsgServer) BatchDeleteObjects(ctx context.Context, req *MsgBatchDeleteObjects) (*MsgBatchDeleteObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteObjects not implemented")
+}
+func (*UnimplementedMsgServer) MintReadVoucher(ctx context.Context, req *MsgMintReadVoucher) (*MsgMintReadVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintReadVoucher not implemented")
+}
+func (*UnimplementedMsgServer) RedeemReadVoucher(ctx context.Context, req *MsgRedeemReadVoucher) (*MsgRedeemReadVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemReadVoucher not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketRequesterPays(ctx context.Context, req *MsgSetBucketRequesterPays) (*MsgSetBucketRequesterPaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketRequesterPays not implemented")
+}
+func (*UnimplementedMsgServer) PurchaseReaderQuota(ctx context.Context, req *MsgPurchaseReaderQuota) (*MsgPurchaseReaderQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseReaderQuota not implemented")
 }
 
-func (m *MsgDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBucket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObjectIds) > 0 {
-		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ObjectIds[iNdEx].Size()
-				i -= size
-				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBucket(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CreateBucket",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBucket(ctx, req.(*MsgCreateBucket))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDiscontinueObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DeleteBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBucket(ctx, req.(*MsgDeleteBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateBucketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBucketInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBucketInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/UpdateBucketInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBucketInfo(ctx, req.(*MsgUpdateBucketInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MirrorBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMirrorBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MirrorBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/MirrorBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MirrorBucket(ctx, req.(*MsgMirrorBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_DiscontinueBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDiscontinueBucket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DiscontinueBucket(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DiscontinueBucket",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DiscontinueBucket(ctx, req.(*MsgDiscontinueBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ToggleSPAsDelegatedAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleSPAsDelegatedAgent)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GroupId.Size()
-		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/ToggleSPAsDelegatedAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, req.(*MsgToggleSPAsDelegatedAgent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CreateObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateObject(ctx, req.(*MsgCreateObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSealObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SealObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SealObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SealObject(ctx, req.(*MsgSealObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_SealObjectV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSealObjectV2)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SealObjectV2(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SealObjectV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SealObjectV2(ctx, req.(*MsgSealObjectV2))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RejectSealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectSealObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RejectSealObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/RejectSealObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectSealObject(ctx, req.(*MsgRejectSealObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCopyObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CopyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CopyObject(ctx, req.(*MsgCopyObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DeleteObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteObject(ctx, req.(*MsgDeleteObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCreateObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCreateObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CancelCreateObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCreateObject(ctx, req.(*MsgCancelCreateObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MirrorObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMirrorObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MirrorObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/MirrorObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MirrorObject(ctx, req.(*MsgMirrorObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MembersToDelete) > 0 {
-		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MembersToDelete[iNdEx])
-			copy(dAtA[i:], m.MembersToDelete[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.MembersToAdd) > 0 {
-		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_DiscontinueObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDiscontinueObject)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).DiscontinueObject(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DiscontinueObject",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DiscontinueObject(ctx, req.(*MsgDiscontinueObject))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateObjectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObjectInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObjectInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/UpdateObjectInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObjectInfo(ctx, req.(*MsgUpdateObjectInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObjectContent)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObjectContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/UpdateObjectContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObjectContent(ctx, req.(*MsgUpdateObjectContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CancelUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpdateObjectContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpdateObjectContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CancelUpdateObjectContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpdateObjectContent(ctx, req.(*MsgCancelUpdateObjectContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_DelegateCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateCreateObject)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateCreateObject(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DelegateCreateObject",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateCreateObject(ctx, req.(*MsgDelegateCreateObject))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DelegateUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateUpdateObjectContent)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DelegateUpdateObjectContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, req.(*MsgDelegateUpdateObjectContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroup(ctx, req.(*MsgCreateGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteGroup(ctx, req.(*MsgDeleteGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/UpdateGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupMember(ctx, req.(*MsgUpdateGroupMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintTx(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x12
+func _Msg_UpdateGroupExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupExtra)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupExtra(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/UpdateGroupExtra",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupExtra(ctx, req.(*MsgUpdateGroupExtra))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveGroup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_MirrorGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMirrorGroup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).MirrorGroup(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/MirrorGroup",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MirrorGroup(ctx, req.(*MsgMirrorGroup))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtraResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RenewGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewGroupMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateGroupExtraResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateGroupExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RenewGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/RenewGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewGroupMember(ctx, req.(*MsgRenewGroupMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_PutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPutPolicy)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).PutPolicy(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/PutPolicy",
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PutPolicy(ctx, req.(*MsgPutPolicy))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeletePolicy)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgLeaveGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DeletePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeletePolicy(ctx, req.(*MsgDeletePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/MigrateBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateBucket(ctx, req.(*MsgMigrateBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_CompleteMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteMigrateBucket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChargedReadQuota != nil {
-		{
-			size, err := m.ChargedReadQuota.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteMigrateBucket(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CompleteMigrateBucket",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteMigrateBucket(ctx, req.(*MsgCompleteMigrateBucket))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMigrateBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMigrateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CancelMigrateBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMigrateBucket(ctx, req.(*MsgCancelMigrateBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RejectMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectMigrateBucket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectMigrateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/RejectMigrateBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectMigrateBucket(ctx, req.(*MsgRejectMigrateBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTag(ctx, req.(*MsgSetTag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketFlowRateLimit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetBucketFlowRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, req.(*MsgSetBucketFlowRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketVersioning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetBucketVersioning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketVersioning(ctx, req.(*MsgSetBucketVersioning))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_DeleteObjectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteObjectVersion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteObjectVersion(ctx, in)
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/DeleteObjectVersion",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteObjectVersion(ctx, req.(*MsgDeleteObjectVersion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketLifecycle)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketLifecycle(ctx, req.(*MsgSetBucketLifecycle))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRetention(ctx, req.(*MsgSetRetention))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLegalHold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLegalHold(ctx, req.(*MsgSetLegalHold))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPutPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ComposeObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgComposeObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ComposeObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/ComposeObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ComposeObject(ctx, req.(*MsgComposeObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPutPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BatchCreateObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateObjects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/BatchCreateObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateObjects(ctx, req.(*MsgBatchCreateObjects))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationTime != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintTx(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Statements) > 0 {
-		for iNdEx := len(m.Statements) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_BatchDeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchDeleteObjects)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).BatchDeleteObjects(ctx, in)
 	}
-	if m.Principal != nil {
-		{
-			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/BatchDeleteObjects",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchDeleteObjects(ctx, req.(*MsgBatchDeleteObjects))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPutPolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MintReadVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintReadVoucher)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgPutPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgPutPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.PolicyId.Size()
-		i -= size
-		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).MintReadVoucher(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/MintReadVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintReadVoucher(ctx, req.(*MsgMintReadVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeletePolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RedeemReadVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemReadVoucher)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeletePolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeletePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemReadVoucher(ctx, in)
 	}
-	if m.Principal != nil {
-		{
-			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/RedeemReadVoucher",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemReadVoucher(ctx, req.(*MsgRedeemReadVoucher))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeletePolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetBucketRequesterPays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketRequesterPays)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeletePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeletePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.PolicyId.Size()
-		i -= size
-		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketRequesterPays(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgMirrorObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SetBucketRequesterPays",
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMirrorObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketRequesterPays(ctx, req.(*MsgSetBucketRequesterPays))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMirrorObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_PurchaseReaderQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseReaderQuota)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseReaderQuota(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/PurchaseReaderQuota",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgMirrorObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseReaderQuota(ctx, req.(*MsgPurchaseReaderQuota))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMirrorObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMirrorObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.storage.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBucket",
+			Handler:    _Msg_CreateBucket_Handler,
+		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _Msg_DeleteBucket_Handler,
+		},
+		{
+			MethodName: "UpdateBucketInfo",
+			Handler:    _Msg_UpdateBucketInfo_Handler,
+		},
+		{
+			MethodName: "MirrorBucket",
+			Handler:    _Msg_MirrorBucket_Handler,
+		},
+		{
+			MethodName: "DiscontinueBucket",
+			Handler:    _Msg_DiscontinueBucket_Handler,
+		},
+		{
+			MethodName: "ToggleSPAsDelegatedAgent",
+			Handler:    _Msg_ToggleSPAsDelegatedAgent_Handler,
+		},
+		{
+			MethodName: "CreateObject",
+			Handler:    _Msg_CreateObject_Handler,
+		},
+		{
+			MethodName: "SealObject",
+			Handler:    _Msg_SealObject_Handler,
+		},
+		{
+			MethodName: "SealObjectV2",
+			Handler:    _Msg_SealObjectV2_Handler,
+		},
+		{
+			MethodName: "RejectSealObject",
+			Handler:    _Msg_RejectSealObject_Handler,
+		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _Msg_CopyObject_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _Msg_DeleteObject_Handler,
+		},
+		{
+			MethodName: "CancelCreateObject",
+			Handler:    _Msg_CancelCreateObject_Handler,
+		},
+		{
+			MethodName: "MirrorObject",
+			Handler:    _Msg_MirrorObject_Handler,
+		},
+		{
+			MethodName: "DiscontinueObject",
+			Handler:    _Msg_DiscontinueObject_Handler,
+		},
+		{
+			MethodName: "UpdateObjectInfo",
+			Handler:    _Msg_UpdateObjectInfo_Handler,
+		},
+		{
+			MethodName: "UpdateObjectContent",
+			Handler:    _Msg_UpdateObjectContent_Handler,
+		},
+		{
+			MethodName: "CancelUpdateObjectContent",
+			Handler:    _Msg_CancelUpdateObjectContent_Handler,
+		},
+		{
+			MethodName: "DelegateCreateObject",
+			Handler:    _Msg_DelegateCreateObject_Handler,
+		},
+		{
+			MethodName: "DelegateUpdateObjectContent",
+			Handler:    _Msg_DelegateUpdateObjectContent_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Msg_CreateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _Msg_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMember",
+			Handler:    _Msg_UpdateGroupMember_Handler,
+		},
+		{
+			MethodName: "UpdateGroupExtra",
+			Handler:    _Msg_UpdateGroupExtra_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _Msg_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "MirrorGroup",
+			Handler:    _Msg_MirrorGroup_Handler,
+		},
+		{
+			MethodName: "RenewGroupMember",
+			Handler:    _Msg_RenewGroupMember_Handler,
+		},
+		{
+			MethodName: "PutPolicy",
+			Handler:    _Msg_PutPolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _Msg_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "MigrateBucket",
+			Handler:    _Msg_MigrateBucket_Handler,
+		},
+		{
+			MethodName: "CompleteMigrateBucket",
+			Handler:    _Msg_CompleteMigrateBucket_Handler,
+		},
+		{
+			MethodName: "CancelMigrateBucket",
+			Handler:    _Msg_CancelMigrateBucket_Handler,
+		},
+		{
+			MethodName: "RejectMigrateBucket",
+			Handler:    _Msg_RejectMigrateBucket_Handler,
+		},
+		{
+			MethodName: "SetTag",
+			Handler:    _Msg_SetTag_Handler,
+		},
+		{
+			MethodName: "SetBucketFlowRateLimit",
+			Handler:    _Msg_SetBucketFlowRateLimit_Handler,
+		},
+		{
+			MethodName: "SetBucketVersioning",
+			Handler:    _Msg_SetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "DeleteObjectVersion",
+			Handler:    _Msg_DeleteObjectVersion_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _Msg_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "SetRetention",
+			Handler:    _Msg_SetRetention_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _Msg_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "ComposeObject",
+			Handler:    _Msg_ComposeObject_Handler,
+		},
+		{
+			MethodName: "BatchCreateObjects",
+			Handler:    _Msg_BatchCreateObjects_Handler,
+		},
+		{
+			MethodName: "BatchDeleteObjects",
+			Handler:    _Msg_BatchDeleteObjects_Handler,
+		},
+		{
+			MethodName: "MintReadVoucher",
+			Handler:    _Msg_MintReadVoucher_Handler,
+		},
+		{
+			MethodName: "RedeemReadVoucher",
+			Handler:    _Msg_RedeemReadVoucher_Handler,
+		},
+		{
+			MethodName: "SetBucketRequesterPays",
+			Handler:    _Msg_SetBucketRequesterPays_Handler,
+		},
+		{
+			MethodName: "PurchaseReaderQuota",
+			Handler:    _Msg_PurchaseReaderQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/storage/tx.proto",
 }
 
-func (m *MsgMirrorBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7401,49 +7561,70 @@ func (m *MsgMirrorBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMirrorBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMirrorBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChargedReadQuota))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x38
+	}
+	if m.PrimarySpApproval != nil {
+		{
+			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrimarySpAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7453,20 +7634,30 @@ func (m *MsgUpdateObjectInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7476,28 +7667,16 @@ func (m *MsgUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -7515,7 +7694,7 @@ func (m *MsgUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMirrorBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7525,12 +7704,12 @@ func (m *MsgMirrorBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMirrorBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMirrorBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7538,7 +7717,7 @@ func (m *MsgMirrorBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMirrorGroup) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7548,38 +7727,30 @@ func (m *MsgMirrorGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMirrorGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -7590,7 +7761,7 @@ func (m *MsgMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMirrorGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7600,12 +7771,12 @@ func (m *MsgMirrorGroupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMirrorGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMirrorGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7613,7 +7784,7 @@ func (m *MsgMirrorGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7623,37 +7794,84 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.RedundancyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x42
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.PrimarySpApproval != nil {
+		{
+			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7663,20 +7881,30 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7686,32 +7914,34 @@ func (m *MsgMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DstPrimarySpApproval != nil {
-		{
-			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.SecondarySpBlsAggSignatures) > 0 {
+		i -= len(m.SecondarySpBlsAggSignatures)
+		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.DstPrimarySpId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DstPrimarySpId))
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -7730,7 +7960,7 @@ func (m *MsgMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7740,12 +7970,12 @@ func (m *MsgMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7753,7 +7983,7 @@ func (m *MsgMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObjectV2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7763,34 +7993,43 @@ func (m *MsgCompleteMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCompleteMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GvgMappings) > 0 {
-		for iNdEx := len(m.GvgMappings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GvgMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+	if len(m.SecondarySpBlsAggSignatures) > 0 {
+		i -= len(m.SecondarySpBlsAggSignatures)
+		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x2a
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -7809,7 +8048,7 @@ func (m *MsgCompleteMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObjectV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7819,12 +8058,12 @@ func (m *MsgCompleteMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCompleteMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7832,7 +8071,7 @@ func (m *MsgCompleteMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgRejectSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7842,20 +8081,27 @@ func (m *MsgCancelMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7869,7 +8115,7 @@ func (m *MsgCancelMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRejectSealObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7879,12 +8125,12 @@ func (m *MsgCancelMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7892,7 +8138,7 @@ func (m *MsgCancelMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgCopyObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7902,20 +8148,53 @@ func (m *MsgRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCopyObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if m.DstPrimarySpApproval != nil {
+		{
+			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DstObjectName) > 0 {
+		i -= len(m.DstObjectName)
+		copy(dAtA[i:], m.DstObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstObjectName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SrcObjectName) > 0 {
+		i -= len(m.SrcObjectName)
+		copy(dAtA[i:], m.SrcObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DstBucketName) > 0 {
+		i -= len(m.DstBucketName)
+		copy(dAtA[i:], m.DstBucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstBucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcBucketName) > 0 {
+		i -= len(m.SrcBucketName)
+		copy(dAtA[i:], m.SrcBucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcBucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7929,7 +8208,7 @@ func (m *MsgRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCopyObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7939,20 +8218,30 @@ func (m *MsgRejectMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRejectMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCopyObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCopyObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTag) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7962,32 +8251,27 @@ func (m *MsgSetTag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTag) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8001,7 +8285,7 @@ func (m *MsgSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTagResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8011,12 +8295,12 @@ func (m *MsgSetTagResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTagResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8024,7 +8308,7 @@ func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8034,43 +8318,36 @@ func (m *MsgUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ObjectIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -8089,7 +8366,7 @@ func (m *MsgUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8099,12 +8376,12 @@ func (m *MsgUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8112,7 +8389,7 @@ func (m *MsgUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8122,41 +8399,41 @@ func (m *MsgCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8166,20 +8443,30 @@ func (m *MsgCancelUpdateObjectContentResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObject) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8189,79 +8476,34 @@ func (m *MsgDelegateCreateObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateCreateObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RedundancyType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x12
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8271,30 +8513,20 @@ func (m *MsgDelegateCreateObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8304,55 +8536,50 @@ func (m *MsgDelegateUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+	if len(m.MembersToDelete) > 0 {
+		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MembersToDelete[iNdEx])
+			copy(dAtA[i:], m.MembersToDelete[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.MembersToAdd) > 0 {
+		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Updater) > 0 {
-		i -= len(m.Updater)
-		copy(dAtA[i:], m.Updater)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Updater)))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8366,7 +8593,7 @@ func (m *MsgDelegateUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8376,12 +8603,12 @@ func (m *MsgDelegateUpdateObjectContentResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8389,7 +8616,7 @@ func (m *MsgDelegateUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+func (m *MsgRenewGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8399,20 +8626,41 @@ func (m *MsgToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8426,7 +8674,7 @@ func (m *MsgToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRenewGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8436,12 +8684,12 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8449,7 +8697,7 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *MsgGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8459,44 +8707,74 @@ func (m *MsgSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.FlowRateLimit.Size()
-		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ExpirationTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketOwner)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8510,7 +8788,7 @@ func (m *MsgSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupExtraResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8520,12 +8798,12 @@ func (m *MsgSetBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupExtraResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8533,1300 +8811,7154 @@ func (m *MsgSetBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PrimarySpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrimarySpApproval != nil {
-		l = m.PrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovTx(uint64(m.ChargedReadQuota))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BucketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgLeaveGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDiscontinueBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDiscontinueBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
-	}
 	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PrimarySpApproval != nil {
-		l = m.PrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ChargedReadQuota != nil {
+		{
+			size, err := m.ChargedReadQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTx(uint64(m.RedundancyType))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateBucketInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSealObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
-	}
-	l = len(m.SecondarySpBlsAggSignatures)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateBucketInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSealObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateBucketInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSealObjectV2) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
-	}
-	l = len(m.SecondarySpBlsAggSignatures)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgCancelCreateObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSealObjectV2Response) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectSealObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectSealObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCopyObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SrcBucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DstBucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SrcObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DstObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DstPrimarySpApproval != nil {
-		l = m.DstPrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCancelCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCopyObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPutPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgPutPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDiscontinueObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExpirationTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Statements) > 0 {
+		for iNdEx := len(m.Statements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.ObjectIds) > 0 {
-		for _, e := range m.ObjectIds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Principal != nil {
+		{
+			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDiscontinueObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPutPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPutPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPutPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.PolicyId.Size()
+		i -= size
+		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeletePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GroupId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeletePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Principal != nil {
+		{
+			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeletePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeletePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.MembersToAdd) > 0 {
-		for _, e := range m.MembersToAdd {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MembersToDelete) > 0 {
-		for _, s := range m.MembersToDelete {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.PolicyId.Size()
+		i -= size
+		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMirrorObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRenewGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMirrorObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMirrorObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DestChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRenewGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMirrorObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMirrorObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMirrorObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupExtra) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMirrorBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMirrorBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMirrorBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DestChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupExtraResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateObjectInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLeaveGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateObjectInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ChargedReadQuota != nil {
-		l = m.ChargedReadQuota.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBucketInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMirrorBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgMirrorBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelCreateObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMirrorBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPutPolicy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMirrorGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMirrorGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Principal != nil {
-		l = m.Principal.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.DestChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Statements) > 0 {
-		for _, e := range m.Statements {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPutPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMirrorGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PolicyId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeletePolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Principal != nil {
-		l = m.Principal.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgMirrorGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeletePolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMirrorGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PolicyId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMirrorObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DestChainId != 0 {
-		n += 1 + sovTx(uint64(m.DestChainId))
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMirrorObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMirrorBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.DestChainId != 0 {
-		n += 1 + sovTx(uint64(m.DestChainId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMirrorBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMirrorGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DstPrimarySpApproval != nil {
+		{
+			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DstPrimarySpId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DstPrimarySpId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.DestChainId != 0 {
-		n += 1 + sovTx(uint64(m.DestChainId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMirrorGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DstPrimarySpId != 0 {
-		n += 1 + sovTx(uint64(m.DstPrimarySpId))
-	}
-	if m.DstPrimarySpApproval != nil {
-		l = m.DstPrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCompleteMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCompleteMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCompleteMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCompleteMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GvgMappings) > 0 {
+		for iNdEx := len(m.GvgMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GvgMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovTx(uint64(m.GlobalVirtualGroupFamilyId))
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.GvgMappings) > 0 {
-		for _, e := range m.GvgMappings {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCompleteMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRejectMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetTag) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRejectMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRejectMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTx(uint64(m.RedundancyType))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Updater)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateCreateObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCreateObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RedundancyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	return n
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBucketFlowRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.BucketOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Updater) > 0 {
+		i -= len(m.Updater)
+		copy(dAtA[i:], m.Updater)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Updater)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketVersioning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketVersioning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketVersioning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketVersioningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketVersioningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketVersioningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteObjectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteObjectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteObjectVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteObjectVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteObjectVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLegalHold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLegalHold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLegalHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LegalHold {
+		i--
+		if m.LegalHold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLegalHoldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLegalHoldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLegalHoldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgComposeObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgComposeObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgComposeObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceObjectNames) > 0 {
+		for iNdEx := len(m.SourceObjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceObjectNames[iNdEx])
+			copy(dAtA[i:], m.SourceObjectNames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SourceObjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgComposeObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgComposeObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgComposeObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateObjectItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateObjectItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateObjectItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedundancyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCreateObjects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCreateObjects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCreateObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCreateObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCreateObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCreateObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ObjectIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchDeleteObjects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchDeleteObjects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchDeleteObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectNames) > 0 {
+		for iNdEx := len(m.ObjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectNames[iNdEx])
+			copy(dAtA[i:], m.ObjectNames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchDeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchDeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchDeleteObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintReadVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintReadVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintReadVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadQuota != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintReadVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintReadVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintReadVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemReadVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemReadVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemReadVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HolderSignature) > 0 {
+		i -= len(m.HolderSignature)
+		copy(dAtA[i:], m.HolderSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HolderSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VoucherId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VoucherId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemReadVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemReadVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemReadVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketRequesterPays) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketRequesterPays) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketRequesterPays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketRequesterPaysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketRequesterPaysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketRequesterPaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseReaderQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseReaderQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseReaderQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadQuota != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reader) > 0 {
+		i -= len(m.Reader)
+		copy(dAtA[i:], m.Reader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseReaderQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseReaderQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseReaderQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PrimarySpApproval != nil {
+		l = m.PrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.ChargedReadQuota))
+	}
+	return n
+}
+
+func (m *MsgCreateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDiscontinueBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDiscontinueBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PrimarySpApproval != nil {
+		l = m.PrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTx(uint64(m.RedundancyType))
+	}
+	return n
+}
+
+func (m *MsgCreateObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
+	}
+	l = len(m.SecondarySpBlsAggSignatures)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSealObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSealObjectV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
+	}
+	l = len(m.SecondarySpBlsAggSignatures)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSealObjectV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectSealObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCopyObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcBucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstBucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DstPrimarySpApproval != nil {
+		l = m.DstPrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCopyObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDiscontinueObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, e := range m.ObjectIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDiscontinueObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GroupId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MembersToAdd) > 0 {
+		for _, e := range m.MembersToAdd {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MembersToDelete) > 0 {
+		for _, s := range m.MembersToDelete {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRenewGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupExtra) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupExtraResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBucketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChargedReadQuota != nil {
+		l = m.ChargedReadQuota.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *MsgUpdateBucketInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelCreateObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Statements) > 0 {
+		for _, e := range m.Statements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPutPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PolicyId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeletePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeletePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PolicyId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMirrorObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovTx(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *MsgMirrorObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMirrorBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovTx(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *MsgUpdateObjectInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *MsgMirrorBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMirrorGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovTx(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *MsgMirrorGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DstPrimarySpId != 0 {
+		n += 1 + sovTx(uint64(m.DstPrimarySpId))
+	}
+	if m.DstPrimarySpApproval != nil {
+		l = m.DstPrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.GvgMappings) > 0 {
+		for _, e := range m.GvgMappings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateObjectContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpdateObjectContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTx(uint64(m.RedundancyType))
+	}
+	return n
+}
+
+func (m *MsgDelegateCreateObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketFlowRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketVersioning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBucketVersioningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteObjectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgDeleteObjectVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBucketLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Retention.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLegalHold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LegalHold {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetLegalHoldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgComposeObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SourceObjectNames) > 0 {
+		for _, s := range m.SourceObjectNames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *MsgComposeObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *BatchCreateObjectItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTx(uint64(m.RedundancyType))
+	}
+	return n
+}
+
+func (m *MsgBatchCreateObjects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCreateObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObjectIds) > 0 {
+		for _, e := range m.ObjectIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchDeleteObjects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ObjectNames) > 0 {
+		for _, s := range m.ObjectNames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchDeleteObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintReadVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.ReadQuota))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgMintReadVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRedeemReadVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VoucherId != 0 {
+		n += 1 + sovTx(uint64(m.VoucherId))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovTx(uint64(m.ReadBytes))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.HolderSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemReadVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketRequesterPays) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBucketRequesterPaysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPurchaseReaderQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.ReadQuota))
+	}
+	return n
+}
+
+func (m *MsgPurchaseReaderQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimarySpApproval == nil {
+				m.PrimarySpApproval = &common.Approval{}
+			}
+			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimarySpApproval == nil {
+				m.PrimarySpApproval = &common.Approval{}
+			}
+			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondarySpBlsAggSignatures == nil {
+				m.SecondarySpBlsAggSignatures = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObjectV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObjectV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondarySpBlsAggSignatures == nil {
+				m.SecondarySpBlsAggSignatures = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObjectV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObjectV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectSealObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectSealObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCopyObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DstPrimarySpApproval == nil {
+				m.DstPrimarySpApproval = &common.Approval{}
+			}
+			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCopyObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCopyObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Uint
+			m.ObjectIds = append(m.ObjectIds, v)
+			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extra = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSetBucketFlowRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9849,15 +15981,15 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9885,11 +16017,11 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9917,13 +16049,113 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Visibility = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9933,14 +16165,27 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9968,11 +16213,11 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10000,11 +16245,11 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10031,18 +16276,16 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrimarySpApproval == nil {
-				m.PrimarySpApproval = &common.Approval{}
-			}
-			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MembersToAdd = append(m.MembersToAdd, &MsgGroupMember{})
+			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
 			}
-			m.ChargedReadQuota = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10052,11 +16295,24 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10078,7 +16334,7 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10101,46 +16357,12 @@ func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10162,7 +16384,7 @@ func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10185,10 +16407,10 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10225,7 +16447,7 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10253,7 +16475,73 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &MsgGroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10276,7 +16564,7 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10299,10 +16587,10 @@ func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10326,7 +16614,7 @@ func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10349,15 +16637,15 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10385,45 +16673,13 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10433,74 +16689,28 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10522,7 +16732,7 @@ func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10545,15 +16755,15 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupExtra: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10581,11 +16791,11 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10613,11 +16823,11 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10645,49 +16855,11 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10715,95 +16887,8 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PrimarySpApproval == nil {
-				m.PrimarySpApproval = &common.Approval{}
-			}
-			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			m.Extra = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
-			}
-			m.RedundancyType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10825,7 +16910,7 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10848,46 +16933,12 @@ func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10909,7 +16960,7 @@ func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10932,47 +16983,15 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11000,11 +17019,11 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11032,32 +17051,13 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
-			}
-			m.GlobalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11067,25 +17067,23 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
-			if m.SecondarySpBlsAggSignatures == nil {
-				m.SecondarySpBlsAggSignatures = []byte{}
-			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11108,7 +17106,7 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11131,10 +17129,10 @@ func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11158,7 +17156,7 @@ func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11181,10 +17179,10 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObjectV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBucketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObjectV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11253,9 +17251,9 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11265,48 +17263,33 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			if m.ChargedReadQuota == nil {
+				m.ChargedReadQuota = &common.UInt64Value{}
 			}
-			m.GlobalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ChargedReadQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 5:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11316,31 +17299,29 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
-			if m.SecondarySpBlsAggSignatures == nil {
-				m.SecondarySpBlsAggSignatures = []byte{}
-			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
 			}
-			var byteLen int
+			m.Visibility = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11350,24 +17331,11 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Visibility |= VisibilityType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11389,7 +17357,7 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11412,10 +17380,10 @@ func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObjectV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObjectV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11439,7 +17407,7 @@ func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11462,10 +17430,10 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCreateObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11585,7 +17553,7 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11608,10 +17576,10 @@ func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectSealObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11635,7 +17603,7 @@ func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
+func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11658,10 +17626,10 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCopyObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPutPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11698,9 +17666,9 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11710,27 +17678,31 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
+			if m.Principal == nil {
+				m.Principal = &types.Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11758,13 +17730,13 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstBucketName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11774,59 +17746,29 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Statements = append(m.Statements, &types.Statement{})
+			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DstObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11853,10 +17795,10 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DstPrimarySpApproval == nil {
-				m.DstPrimarySpApproval = &common.Approval{}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
 			}
-			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11881,7 +17823,7 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11904,15 +17846,15 @@ func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCopyObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPutPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCopyObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPutPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11940,7 +17882,7 @@ func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11965,7 +17907,7 @@ func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
+func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11988,10 +17930,10 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeletePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeletePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12028,9 +17970,9 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12040,27 +17982,31 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if m.Principal == nil {
+				m.Principal = &types.Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12088,7 +18034,7 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12111,7 +18057,7 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12134,12 +18080,46 @@ func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeletePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeletePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12161,7 +18141,7 @@ func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
+func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12184,10 +18164,10 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMirrorObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMirrorObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12224,7 +18204,7 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12252,11 +18232,13 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12284,15 +18266,11 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v Uint
-			m.ObjectIds = append(m.ObjectIds, v)
-			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12320,8 +18298,27 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12343,7 +18340,7 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMirrorObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12366,10 +18363,10 @@ func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMirrorObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMirrorObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12393,7 +18390,7 @@ func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgMirrorBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12416,15 +18413,15 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMirrorBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMirrorBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12452,11 +18449,11 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12484,11 +18481,13 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12516,8 +18515,27 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12539,7 +18557,7 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12562,46 +18580,12 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12623,7 +18607,7 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12646,10 +18630,10 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12686,7 +18670,7 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12714,8 +18698,59 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12737,7 +18772,7 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMirrorBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12760,10 +18795,10 @@ func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMirrorBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMirrorBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12787,7 +18822,7 @@ func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
+func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12810,10 +18845,10 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMirrorGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMirrorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12850,7 +18885,7 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12878,7 +18913,9 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -12913,44 +18950,10 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MembersToAdd = append(m.MembersToAdd, &MsgGroupMember{})
-			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			var stringLen uint64
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12960,24 +18963,11 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12999,7 +18989,7 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMirrorGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13022,10 +19012,10 @@ func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMirrorGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMirrorGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13049,7 +19039,7 @@ func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13072,15 +19062,15 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13108,75 +19098,11 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13203,8 +19129,7 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &MsgGroupMember{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13229,7 +19154,7 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13252,10 +19177,10 @@ func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13279,7 +19204,7 @@ func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13302,15 +19227,15 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13338,11 +19263,62 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
+			}
+			m.DstPrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstPrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13369,13 +19345,63 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
+			if m.DstPrimarySpApproval == nil {
+				m.DstPrimarySpApproval = &common.Approval{}
+			}
+			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13397,7 +19423,7 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13420,10 +19446,10 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtra: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13460,7 +19486,7 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13488,13 +19514,13 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13504,29 +19530,16 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgMappings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13536,23 +19549,25 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			m.GvgMappings = append(m.GvgMappings, &GVGMapping{})
+			if err := m.GvgMappings[len(m.GvgMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13575,7 +19590,7 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13598,10 +19613,10 @@ func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13625,7 +19640,7 @@ func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13648,15 +19663,15 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13684,43 +19699,11 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13748,7 +19731,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13771,7 +19754,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13794,10 +19777,10 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13821,7 +19804,7 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13844,10 +19827,10 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13914,11 +19897,111 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13928,31 +20011,27 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChargedReadQuota == nil {
-				m.ChargedReadQuota = &common.UInt64Value{}
-			}
-			if err := m.ChargedReadQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13980,13 +20059,13 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			m.Visibility = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13996,11 +20075,28 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14022,7 +20118,7 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14045,10 +20141,10 @@ func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14072,7 +20168,7 @@ func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14095,10 +20191,10 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14197,6 +20293,89 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 			}
 			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14218,7 +20397,7 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14241,10 +20420,10 @@ func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14268,7 +20447,7 @@ func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14291,10 +20470,10 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPutPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14331,9 +20510,9 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14343,31 +20522,27 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Principal == nil {
-				m.Principal = &types.Principal{}
-			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14395,78 +20570,58 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statements = append(m.Statements, &types.Statement{})
-			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14488,7 +20643,7 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14511,15 +20666,15 @@ func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPutPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCreateObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPutPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14547,63 +20702,43 @@ func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeletePolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeletePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14631,13 +20766,13 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14647,31 +20782,46 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Principal == nil {
-				m.Principal = &types.Principal{}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14699,8 +20849,78 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14722,7 +20942,7 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14745,15 +20965,15 @@ func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeletePolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeletePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14781,7 +21001,7 @@ func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14806,7 +21026,7 @@ func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14829,15 +21049,47 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMirrorObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMirrorObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14865,11 +21117,11 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Updater = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14897,13 +21149,11 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14931,11 +21181,30 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14963,13 +21232,13 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
 			}
-			m.DestChainId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14979,11 +21248,24 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15005,7 +21287,7 @@ func (m *MsgMirrorObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMirrorObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15028,10 +21310,10 @@ func (m *MsgMirrorObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMirrorObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMirrorObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15055,7 +21337,7 @@ func (m *MsgMirrorObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMirrorBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15078,10 +21360,10 @@ func (m *MsgMirrorBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMirrorBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMirrorBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15117,40 +21399,6 @@ func (m *MsgMirrorBucket) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -15182,25 +21430,6 @@ func (m *MsgMirrorBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-			}
-			m.DestChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15222,7 +21451,7 @@ func (m *MsgMirrorBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15245,10 +21474,10 @@ func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15272,7 +21501,7 @@ func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15295,10 +21524,10 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15367,7 +21596,7 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15395,13 +21624,13 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
-			m.Visibility = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15411,11 +21640,58 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15437,7 +21713,7 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMirrorBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15460,10 +21736,10 @@ func (m *MsgMirrorBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMirrorBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMirrorBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15487,7 +21763,7 @@ func (m *MsgMirrorBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketVersioning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15510,10 +21786,10 @@ func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMirrorGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketVersioning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMirrorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketVersioning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15550,7 +21826,7 @@ func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15578,47 +21854,13 @@ func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.DestChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15628,11 +21870,12 @@ func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15654,7 +21897,7 @@ func (m *MsgMirrorGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMirrorGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketVersioningResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15677,10 +21920,10 @@ func (m *MsgMirrorGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMirrorGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketVersioningResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMirrorGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketVersioningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15704,7 +21947,7 @@ func (m *MsgMirrorGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteObjectVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15727,15 +21970,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteObjectVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15763,13 +22006,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15779,25 +22022,75 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15819,7 +22112,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteObjectVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15842,10 +22135,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteObjectVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteObjectVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15869,7 +22162,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15892,10 +22185,10 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketLifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15963,27 +22256,8 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
-			}
-			m.DstPrimarySpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPrimarySpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16010,10 +22284,8 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DstPrimarySpApproval == nil {
-				m.DstPrimarySpApproval = &common.Approval{}
-			}
-			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16038,7 +22310,7 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16061,10 +22333,10 @@ func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16088,7 +22360,7 @@ func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16111,10 +22383,10 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16182,10 +22454,10 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16195,14 +22467,27 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GvgMappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16229,8 +22514,7 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GvgMappings = append(m.GvgMappings, &GVGMapping{})
-			if err := m.GvgMappings[len(m.GvgMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16255,7 +22539,7 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16278,10 +22562,10 @@ func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16305,7 +22589,7 @@ func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLegalHold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16328,10 +22612,10 @@ func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLegalHold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLegalHold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16398,6 +22682,58 @@ func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegalHold = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16419,7 +22755,7 @@ func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLegalHoldResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16442,10 +22778,10 @@ func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLegalHoldResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLegalHoldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16469,7 +22805,7 @@ func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgComposeObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16492,10 +22828,10 @@ func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgComposeObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgComposeObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16550,121 +22886,21 @@ func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 				}
 			}
 			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRejectMigrateBucketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16692,11 +22928,11 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceObjectNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16724,13 +22960,13 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.SourceObjectNames = append(m.SourceObjectNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16740,28 +22976,43 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16783,7 +23034,7 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgComposeObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16806,12 +23057,46 @@ func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgComposeObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgComposeObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16833,7 +23118,7 @@ func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *BatchCreateObjectItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16856,15 +23141,15 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchCreateObjectItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchCreateObjectItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16892,11 +23177,49 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16921,14 +23244,115 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateObjects) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateObjects: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateObjects: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16956,30 +23380,11 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17007,13 +23412,13 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17023,23 +23428,25 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			m.Objects = append(m.Objects, BatchCreateObjectItem{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17062,7 +23469,7 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchCreateObjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17085,12 +23492,48 @@ func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchCreateObjectsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchCreateObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Uint
+			m.ObjectIds = append(m.ObjectIds, v)
+			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17112,7 +23555,7 @@ func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchDeleteObjects) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17135,10 +23578,10 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchDeleteObjects: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchDeleteObjects: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17207,7 +23650,7 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17235,7 +23678,7 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.ObjectNames = append(m.ObjectNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17258,7 +23701,7 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchDeleteObjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17281,10 +23724,10 @@ func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchDeleteObjectsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchDeleteObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17308,7 +23751,7 @@ func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
+func (m *MsgMintReadVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17331,10 +23774,10 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintReadVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintReadVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17370,38 +23813,6 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -17433,113 +23844,11 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17549,29 +23858,29 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
 			}
-			m.RedundancyType = 0
+			m.ReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17581,7 +23890,26 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				m.ReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17607,7 +23935,7 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintReadVoucherResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17630,17 +23958,17 @@ func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintReadVoucherResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintReadVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17650,26 +23978,11 @@ func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17691,7 +24004,7 @@ func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemReadVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17714,10 +24027,10 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemReadVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemReadVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17753,10 +24066,10 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
 			}
-			var stringLen uint64
+			m.VoucherId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17766,29 +24079,16 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VoucherId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Updater = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
 			}
-			var stringLen uint64
+			m.ReadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17798,80 +24098,16 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17881,27 +24117,14 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -17928,8 +24151,10 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			m.HolderSignature = append(m.HolderSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.HolderSignature == nil {
+				m.HolderSignature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17952,7 +24177,7 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemReadVoucherResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17975,10 +24200,10 @@ func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemReadVoucherResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemReadVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18002,7 +24227,7 @@ func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketRequesterPays) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18025,10 +24250,10 @@ func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketRequesterPays: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketRequesterPays: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18095,6 +24320,26 @@ func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18116,7 +24361,7 @@ func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketRequesterPaysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18139,10 +24384,10 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketRequesterPaysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketRequesterPaysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18166,7 +24411,7 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseReaderQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18189,15 +24434,15 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseReaderQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseReaderQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18225,7 +24470,7 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Reader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -18260,38 +24505,6 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
@@ -18323,11 +24536,11 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			}
 			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
 			}
-			var stringLen uint64
+			m.ReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18337,26 +24550,11 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18378,7 +24576,7 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseReaderQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18401,10 +24599,10 @@ func (m *MsgSetBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseReaderQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseReaderQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 )
@@ -146,3 +148,135 @@ func (o *ObjectInfo) GetLatestUpdatedTime() int64 {
 	}
 	return o.CreateAt
 }
+
+// NewObjectVersion creates a version from the current sealed content of the object.
+func NewObjectVersion(o *ObjectInfo) *ObjectVersion {
+	updatedBy := o.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = o.Creator
+	}
+	return &ObjectVersion{
+		ObjectId:            o.Id,
+		BucketName:          o.BucketName,
+		ObjectName:          o.ObjectName,
+		Version:             o.Version,
+		LocalVirtualGroupId: o.LocalVirtualGroupId,
+		PayloadSize:         o.PayloadSize,
+		ContentType:         o.ContentType,
+		RedundancyType:      o.RedundancyType,
+		Checksums:           o.Checksums,
+		UpdatedAt:           o.GetLatestUpdatedTime(),
+		UpdatedBy:           updatedBy,
+	}
+}
+
+// ToObjectInfo returns a copy of the object with the content replaced by the version, so that the version
+// can be charged and stored on virtual group the same way as a sealed object.
+func (v *ObjectVersion) ToObjectInfo(o *ObjectInfo) *ObjectInfo {
+	objectInfo := *o
+	objectInfo.LocalVirtualGroupId = v.LocalVirtualGroupId
+	objectInfo.PayloadSize = v.PayloadSize
+	objectInfo.ContentType = v.ContentType
+	objectInfo.RedundancyType = v.RedundancyType
+	objectInfo.Checksums = v.Checksums
+	objectInfo.UpdatedAt = v.UpdatedAt
+	objectInfo.UpdatedBy = v.UpdatedBy
+	objectInfo.Version = v.Version
+	objectInfo.ObjectStatus = OBJECT_STATUS_SEALED
+	objectInfo.IsUpdating = false
+	return &objectInfo
+}
+
+// NewObjectComponent creates a component from a sealed source object at the given offset of the composed object.
+func NewObjectComponent(o *ObjectInfo, offset uint64) *ObjectComponent {
+	return &ObjectComponent{
+		ObjectId:            o.Id,
+		ObjectName:          o.ObjectName,
+		LocalVirtualGroupId: o.LocalVirtualGroupId,
+		PayloadSize:         o.PayloadSize,
+		Offset:              offset,
+		RedundancyType:      o.RedundancyType,
+		Checksums:           o.Checksums,
+		UpdatedAt:           o.GetLatestUpdatedTime(),
+	}
+}
+
+// ToObjectInfo returns a copy of the composed object with the content replaced by the component, so that the
+// component can be charged and stored on virtual group the same way as a sealed object.
+func (c *ObjectComponent) ToObjectInfo(o *ObjectInfo) *ObjectInfo {
+	objectInfo := *o
+	objectInfo.LocalVirtualGroupId = c.LocalVirtualGroupId
+	objectInfo.PayloadSize = c.PayloadSize
+	objectInfo.RedundancyType = c.RedundancyType
+	objectInfo.Checksums = c.Checksums
+	objectInfo.UpdatedAt = c.UpdatedAt
+	objectInfo.ObjectStatus = OBJECT_STATUS_SEALED
+	objectInfo.IsUpdating = false
+	objectInfo.IsComposed = false
+	return &objectInfo
+}
+
+// LocateOffset returns the index of the component which holds the given offset of the composed object payload,
+// and the offset inside that component.
+func (c *ComposedObject) LocateOffset(offset uint64) (int, uint64, bool) {
+	// components are ordered by offset, so the first component ending after the offset holds it.
+	idx := sort.Search(len(c.Components), func(i int) bool {
+		return c.Components[i].Offset+c.Components[i].PayloadSize > offset
+	})
+	if idx == len(c.Components) {
+		return 0, 0, false
+	}
+	return idx, offset - c.Components[idx].Offset, true
+}
+
+// ExpireAt returns the earliest time any rule of the lifecycle applies to the object, false if none applies.
+func (l *BucketLifecycle) ExpireAt(objectInfo *ObjectInfo) (int64, bool) {
+	expireAt, found := int64(0), false
+	for _, rule := range l.Rules {
+		if !strings.HasPrefix(objectInfo.ObjectName, rule.Prefix) {
+			continue
+		}
+		var at int64
+		switch {
+		case objectInfo.ObjectStatus == OBJECT_STATUS_CREATED && rule.AbortIncompleteUploadHours > 0:
+			at = objectInfo.CreateAt + int64(rule.AbortIncompleteUploadHours)*3600
+		case objectInfo.ObjectStatus == OBJECT_STATUS_SEALED && rule.ExpirationDays > 0:
+			at = objectInfo.GetLatestUpdatedTime() + int64(rule.ExpirationDays)*86400
+		default:
+			continue
+		}
+		if !found || at < expireAt {
+			expireAt, found = at, true
+		}
+	}
+	return expireAt, found
+}
+
+// IsActive returns whether the retention protects the resource at the block time.
+func (r *Retention) IsActive(blockTime int64) bool {
+	return r != nil && r.Mode != RETENTION_MODE_NONE && r.RetainUntil > blockTime
+}
+
+// CanBeReplacedBy returns whether the retention can be replaced by the new one at the block time.
+// An active compliance retention can only be extended, it can not be shortened, downgraded or removed.
+func (r *Retention) CanBeReplacedBy(newRetention Retention, blockTime int64) bool {
+	if !r.IsActive(blockTime) || r.Mode != RETENTION_MODE_COMPLIANCE {
+		return true
+	}
+	return newRetention.Mode == RETENTION_MODE_COMPLIANCE && newRetention.RetainUntil >= r.RetainUntil
+}
+
+// IsLocked returns whether the bucket is protected by an active retention or a legal hold.
+func (m *BucketInfo) IsLocked(blockTime int64) bool {
+	return m.LegalHold || m.Retention.IsActive(blockTime)
+}
+
+// IsActive returns whether any object tracked by the locks may be protected at the block time.
+func (m *ObjectLocks) IsActive(blockTime int64) bool {
+	return m.LegalHoldCount > 0 || m.RetainUntil > blockTime
+}
+
+// IsLocked returns whether the object is protected by an active retention or a legal hold.
+func (m *ObjectInfo) IsLocked(blockTime int64) bool {
+	return m.LegalHold || m.Retention.IsActive(blockTime)
+}
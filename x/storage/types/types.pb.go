@@ -5,7 +5,7 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/bnb-chain/greenfield/x/payment/types"
+	types "github.com/bnb-chain/greenfield/x/payment/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +55,16 @@ type BucketInfo struct {
 	// sp_as_delegated_agent_disabled indicates that whether bucket owner disable SP as the upload agent.
 	// when a bucket is created, by default, this is false, means SP is allowed to create object for delegator
 	SpAsDelegatedAgentDisabled bool `protobuf:"varint,12,opt,name=sp_as_delegated_agent_disabled,json=spAsDelegatedAgentDisabled,proto3" json:"sp_as_delegated_agent_disabled,omitempty"`
+	// versioning_enabled indicates whether the previous contents of the objects in the bucket are retained as object versions
+	// when the objects are updated.
+	VersioningEnabled bool `protobuf:"varint,13,opt,name=versioning_enabled,json=versioningEnabled,proto3" json:"versioning_enabled,omitempty"`
+	// retention protects the bucket and all its objects from being deleted or updated until it expires.
+	Retention *Retention `protobuf:"bytes,14,opt,name=retention,proto3" json:"retention,omitempty" traits:"omit"`
+	// legal_hold protects the bucket and all its objects from being deleted or updated until it is released.
+	LegalHold bool `protobuf:"varint,15,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty" traits:"omit"`
+	// requester_pays indicates that the readers pay for their own read quota above the free quota instead of the bucket
+	// payment account, the charged_read_quota of the bucket is always zero then.
+	RequesterPays bool `protobuf:"varint,16,opt,name=requester_pays,json=requesterPays,proto3" json:"requester_pays,omitempty"`
 }
 
 func (m *BucketInfo) Reset()         { *m = BucketInfo{} }
@@ -167,6 +177,34 @@ func (m *BucketInfo) GetSpAsDelegatedAgentDisabled() bool {
 	return false
 }
 
+func (m *BucketInfo) GetVersioningEnabled() bool {
+	if m != nil {
+		return m.VersioningEnabled
+	}
+	return false
+}
+
+func (m *BucketInfo) GetRetention() *Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *BucketInfo) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+func (m *BucketInfo) GetRequesterPays() bool {
+	if m != nil {
+		return m.RequesterPays
+	}
+	return false
+}
+
 type InternalBucketInfo struct {
 	// the time of the payment price, used to calculate the charge rate of the bucket
 	PriceTime int64 `protobuf:"varint,1,opt,name=price_time,json=priceTime,proto3" json:"price_time,omitempty"`
@@ -278,6 +316,13 @@ type ObjectInfo struct {
 	UpdatedBy string `protobuf:"bytes,18,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	// version define the version of object
 	Version int64 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
+	// retention protects the object from being deleted or updated until it expires.
+	Retention *Retention `protobuf:"bytes,20,opt,name=retention,proto3" json:"retention,omitempty" traits:"omit"`
+	// legal_hold protects the object from being deleted or updated until it is released.
+	LegalHold bool `protobuf:"varint,21,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty" traits:"omit"`
+	// is_composed indicates whether the object is composed of other sealed objects, the components are
+	// stored as a ComposedObject with the same id.
+	IsComposed bool `protobuf:"varint,22,opt,name=is_composed,json=isComposed,proto3" json:"is_composed,omitempty"`
 }
 
 func (m *ObjectInfo) Reset()         { *m = ObjectInfo{} }
@@ -439,6 +484,307 @@ func (m *ObjectInfo) GetVersion() int64 {
 	return 0
 }
 
+func (m *ObjectInfo) GetRetention() *Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *ObjectInfo) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+func (m *ObjectInfo) GetIsComposed() bool {
+	if m != nil {
+		return m.IsComposed
+	}
+	return false
+}
+
+// ObjectVersion is a sealed previous content of an object, it is retained when the object is updated in a versioning enabled bucket.
+type ObjectVersion struct {
+	// object_id is the unique identifier of the object which the version belongs to
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// bucket_name is the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name is the name of object
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// version define the version of the object content
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// local_virtual_group_id defines the unique id of lvg which the version stored
+	LocalVirtualGroupId uint32 `protobuf:"varint,5,opt,name=local_virtual_group_id,json=localVirtualGroupId,proto3" json:"local_virtual_group_id,omitempty"`
+	// payload_size is the total size of the version payload
+	PayloadSize uint64 `protobuf:"varint,6,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// content_type define the format of the version which should be a standard MIME type.
+	ContentType string `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// redundancy_type define the type of the redundancy which can be multi-replication or EC.
+	RedundancyType RedundancyType `protobuf:"varint,8,opt,name=redundancy_type,json=redundancyType,proto3,enum=greenfield.storage.RedundancyType" json:"redundancy_type,omitempty"`
+	// checksums define the root hash of the pieces which stored in a SP.
+	Checksums [][]byte `protobuf:"bytes,9,rep,name=checksums,proto3" json:"checksums,omitempty"`
+	// updated_at define the block timestamp when the version was created, it is also used to calculate the store fee.
+	UpdatedAt int64 `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// updated_by defined the account address of the uploader of the version
+	UpdatedBy string `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (m *ObjectVersion) Reset()         { *m = ObjectVersion{} }
+func (m *ObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*ObjectVersion) ProtoMessage()    {}
+func (*ObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{3}
+}
+func (m *ObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectVersion.Merge(m, src)
+}
+func (m *ObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectVersion proto.InternalMessageInfo
+
+func (m *ObjectVersion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *ObjectVersion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *ObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ObjectVersion) GetLocalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.LocalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *ObjectVersion) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *ObjectVersion) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ObjectVersion) GetRedundancyType() RedundancyType {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return REDUNDANCY_EC_TYPE
+}
+
+func (m *ObjectVersion) GetChecksums() [][]byte {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
+func (m *ObjectVersion) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *ObjectVersion) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+// ObjectComponent is a sealed source object which is concatenated into a composed object.
+// The component keeps the local virtual group and the charge of the source object.
+type ObjectComponent struct {
+	// object_id is the unique identifier of the source object
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// object_name is the name of the source object
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// local_virtual_group_id defines the unique id of lvg which the component stored
+	LocalVirtualGroupId uint32 `protobuf:"varint,3,opt,name=local_virtual_group_id,json=localVirtualGroupId,proto3" json:"local_virtual_group_id,omitempty"`
+	// payload_size is the size of the component payload
+	PayloadSize uint64 `protobuf:"varint,4,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// offset is the offset of the component in the composed object payload
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// redundancy_type define the type of the redundancy which can be multi-replication or EC.
+	RedundancyType RedundancyType `protobuf:"varint,6,opt,name=redundancy_type,json=redundancyType,proto3,enum=greenfield.storage.RedundancyType" json:"redundancy_type,omitempty"`
+	// checksums define the root hash of the pieces which stored in a SP.
+	Checksums [][]byte `protobuf:"bytes,7,rep,name=checksums,proto3" json:"checksums,omitempty"`
+	// updated_at define the block timestamp when the component content was sealed, it is also used to calculate the store fee.
+	UpdatedAt int64 `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *ObjectComponent) Reset()         { *m = ObjectComponent{} }
+func (m *ObjectComponent) String() string { return proto.CompactTextString(m) }
+func (*ObjectComponent) ProtoMessage()    {}
+func (*ObjectComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{4}
+}
+func (m *ObjectComponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectComponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectComponent.Merge(m, src)
+}
+func (m *ObjectComponent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectComponent proto.InternalMessageInfo
+
+func (m *ObjectComponent) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *ObjectComponent) GetLocalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.LocalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *ObjectComponent) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *ObjectComponent) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ObjectComponent) GetRedundancyType() RedundancyType {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return REDUNDANCY_EC_TYPE
+}
+
+func (m *ObjectComponent) GetChecksums() [][]byte {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
+func (m *ObjectComponent) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// ComposedObject records the components of a composed object in the order of their offsets.
+type ComposedObject struct {
+	// object_id is the unique identifier of the composed object
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// components defines the components of the composed object
+	Components []*ObjectComponent `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (m *ComposedObject) Reset()         { *m = ComposedObject{} }
+func (m *ComposedObject) String() string { return proto.CompactTextString(m) }
+func (*ComposedObject) ProtoMessage()    {}
+func (*ComposedObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{5}
+}
+func (m *ComposedObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComposedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComposedObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComposedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComposedObject.Merge(m, src)
+}
+func (m *ComposedObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComposedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComposedObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComposedObject proto.InternalMessageInfo
+
+func (m *ComposedObject) GetComponents() []*ObjectComponent {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
 type GroupInfo struct {
 	// owner is the owner of the group. It can not changed once it created.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -458,7 +804,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{3}
+	return fileDescriptor_bf95fa2efdc74d97, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +877,7 @@ func (m *Trait) Reset()         { *m = Trait{} }
 func (m *Trait) String() string { return proto.CompactTextString(m) }
 func (*Trait) ProtoMessage()    {}
 func (*Trait) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{4}
+	return fileDescriptor_bf95fa2efdc74d97, []int{7}
 }
 func (m *Trait) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +937,7 @@ func (m *BucketMetaData) Reset()         { *m = BucketMetaData{} }
 func (m *BucketMetaData) String() string { return proto.CompactTextString(m) }
 func (*BucketMetaData) ProtoMessage()    {}
 func (*BucketMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{5}
+	return fileDescriptor_bf95fa2efdc74d97, []int{8}
 }
 func (m *BucketMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +1018,7 @@ func (m *ObjectMetaData) Reset()         { *m = ObjectMetaData{} }
 func (m *ObjectMetaData) String() string { return proto.CompactTextString(m) }
 func (*ObjectMetaData) ProtoMessage()    {}
 func (*ObjectMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{6}
+	return fileDescriptor_bf95fa2efdc74d97, []int{9}
 }
 func (m *ObjectMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +1099,7 @@ func (m *GroupMetaData) Reset()         { *m = GroupMetaData{} }
 func (m *GroupMetaData) String() string { return proto.CompactTextString(m) }
 func (*GroupMetaData) ProtoMessage()    {}
 func (*GroupMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{7}
+	return fileDescriptor_bf95fa2efdc74d97, []int{10}
 }
 func (m *GroupMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,7 +1172,7 @@ func (m *Ids) Reset()         { *m = Ids{} }
 func (m *Ids) String() string { return proto.CompactTextString(m) }
 func (*Ids) ProtoMessage()    {}
 func (*Ids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{8}
+	return fileDescriptor_bf95fa2efdc74d97, []int{11}
 }
 func (m *Ids) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +1211,7 @@ func (m *DeleteInfo) Reset()         { *m = DeleteInfo{} }
 func (m *DeleteInfo) String() string { return proto.CompactTextString(m) }
 func (*DeleteInfo) ProtoMessage()    {}
 func (*DeleteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{9}
+	return fileDescriptor_bf95fa2efdc74d97, []int{12}
 }
 func (m *DeleteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,7 +1273,7 @@ func (m *MigrationBucketInfo) Reset()         { *m = MigrationBucketInfo{} }
 func (m *MigrationBucketInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrationBucketInfo) ProtoMessage()    {}
 func (*MigrationBucketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{10}
+	return fileDescriptor_bf95fa2efdc74d97, []int{13}
 }
 func (m *MigrationBucketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,7 +1332,7 @@ func (m *ResourceTags) Reset()         { *m = ResourceTags{} }
 func (m *ResourceTags) String() string { return proto.CompactTextString(m) }
 func (*ResourceTags) ProtoMessage()    {}
 func (*ResourceTags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{11}
+	return fileDescriptor_bf95fa2efdc74d97, []int{14}
 }
 func (m *ResourceTags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1377,7 @@ func (m *ResourceTags_Tag) Reset()         { *m = ResourceTags_Tag{} }
 func (m *ResourceTags_Tag) String() string { return proto.CompactTextString(m) }
 func (*ResourceTags_Tag) ProtoMessage()    {}
 func (*ResourceTags_Tag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{11, 0}
+	return fileDescriptor_bf95fa2efdc74d97, []int{14, 0}
 }
 func (m *ResourceTags_Tag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1441,7 @@ func (m *ShadowObjectInfo) Reset()         { *m = ShadowObjectInfo{} }
 func (m *ShadowObjectInfo) String() string { return proto.CompactTextString(m) }
 func (*ShadowObjectInfo) ProtoMessage()    {}
 func (*ShadowObjectInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{12}
+	return fileDescriptor_bf95fa2efdc74d97, []int{15}
 }
 func (m *ShadowObjectInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1522,7 @@ func (m *BucketExtraInfo) Reset()         { *m = BucketExtraInfo{} }
 func (m *BucketExtraInfo) String() string { return proto.CompactTextString(m) }
 func (*BucketExtraInfo) ProtoMessage()    {}
 func (*BucketExtraInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{13}
+	return fileDescriptor_bf95fa2efdc74d97, []int{16}
 }
 func (m *BucketExtraInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,329 +1558,705 @@ func (m *BucketExtraInfo) GetIsRateLimited() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*BucketInfo)(nil), "greenfield.storage.BucketInfo")
-	proto.RegisterType((*InternalBucketInfo)(nil), "greenfield.storage.InternalBucketInfo")
-	proto.RegisterType((*ObjectInfo)(nil), "greenfield.storage.ObjectInfo")
-	proto.RegisterType((*GroupInfo)(nil), "greenfield.storage.GroupInfo")
-	proto.RegisterType((*Trait)(nil), "greenfield.storage.Trait")
-	proto.RegisterType((*BucketMetaData)(nil), "greenfield.storage.BucketMetaData")
-	proto.RegisterType((*ObjectMetaData)(nil), "greenfield.storage.ObjectMetaData")
-	proto.RegisterType((*GroupMetaData)(nil), "greenfield.storage.GroupMetaData")
-	proto.RegisterType((*Ids)(nil), "greenfield.storage.Ids")
-	proto.RegisterType((*DeleteInfo)(nil), "greenfield.storage.DeleteInfo")
-	proto.RegisterType((*MigrationBucketInfo)(nil), "greenfield.storage.MigrationBucketInfo")
-	proto.RegisterType((*ResourceTags)(nil), "greenfield.storage.ResourceTags")
-	proto.RegisterType((*ResourceTags_Tag)(nil), "greenfield.storage.ResourceTags.Tag")
-	proto.RegisterType((*ShadowObjectInfo)(nil), "greenfield.storage.ShadowObjectInfo")
-	proto.RegisterType((*BucketExtraInfo)(nil), "greenfield.storage.BucketExtraInfo")
+// LifecycleRule defines when the objects of a bucket are deleted automatically.
+type LifecycleRule struct {
+	// id is an optional identifier of the rule, it is used in events and logs.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// prefix defines the object name prefix the rule applies to, empty means all the objects of the bucket.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// expiration_days defines how many days after the last update a sealed object is deleted, 0 means never.
+	ExpirationDays uint32 `protobuf:"varint,3,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	// abort_incomplete_upload_hours defines how many hours after the creation an unsealed object is deleted, 0 means never.
+	AbortIncompleteUploadHours uint32 `protobuf:"varint,4,opt,name=abort_incomplete_upload_hours,json=abortIncompleteUploadHours,proto3" json:"abort_incomplete_upload_hours,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/storage/types.proto", fileDescriptor_bf95fa2efdc74d97) }
-
-var fileDescriptor_bf95fa2efdc74d97 = []byte{
-	// 1507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6e, 0x1b, 0x45,
-	0x17, 0xcf, 0x7a, 0xed, 0xc4, 0x3e, 0x8e, 0xe3, 0x64, 0x1b, 0x7d, 0xdd, 0xa6, 0xaa, 0xe3, 0x5a,
-	0xdf, 0x57, 0x59, 0x1f, 0x24, 0x56, 0xd3, 0xaa, 0x20, 0x54, 0x51, 0xc5, 0xf4, 0x8f, 0x2c, 0x5a,
-	0x10, 0x9b, 0xb4, 0x48, 0xdc, 0xac, 0xc6, 0xbb, 0x93, 0xcd, 0x90, 0xf5, 0x8e, 0x99, 0x99, 0x4d,
-	0xe3, 0x4a, 0x3c, 0x00, 0x5c, 0x71, 0xc1, 0x13, 0x20, 0xf1, 0x02, 0xa8, 0x0f, 0x51, 0x21, 0x21,
-	0x55, 0xbd, 0x42, 0x5c, 0x54, 0xa8, 0x7d, 0x03, 0x2e, 0xb8, 0x46, 0xf3, 0xc7, 0xee, 0xc6, 0x76,
-	0x9a, 0xa4, 0xb4, 0x57, 0xf1, 0x9c, 0xf9, 0x9d, 0x39, 0x73, 0x7e, 0xe7, 0xdf, 0x6c, 0xa0, 0x16,
-	0x31, 0x8c, 0x93, 0x1d, 0x82, 0xe3, 0xb0, 0xc5, 0x05, 0x65, 0x28, 0xc2, 0x2d, 0x31, 0xe8, 0x63,
-	0xbe, 0xde, 0x67, 0x54, 0x50, 0xc7, 0x79, 0xb5, 0xbf, 0x6e, 0xf6, 0x57, 0x6a, 0x01, 0xe5, 0x3d,
-	0xca, 0x5b, 0x5d, 0xc4, 0x71, 0x6b, 0xff, 0x72, 0x17, 0x0b, 0x74, 0xb9, 0x15, 0x50, 0x92, 0x68,
-	0x9d, 0x95, 0x73, 0x7a, 0xdf, 0x57, 0xab, 0x96, 0x5e, 0x98, 0xad, 0xe5, 0x88, 0x46, 0x54, 0xcb,
-	0xe5, 0x2f, 0x23, 0xbd, 0x98, 0xb9, 0x44, 0x1f, 0x0d, 0x7a, 0x38, 0x11, 0x2d, 0x9a, 0x0a, 0x7f,
-	0x27, 0xa6, 0x0f, 0x0d, 0xe4, 0xd2, 0x14, 0x08, 0x17, 0x0c, 0xa3, 0x9e, 0xcf, 0x70, 0x40, 0x59,
-	0x68, 0x70, 0xab, 0x53, 0xfc, 0x09, 0x68, 0xaf, 0x47, 0xcd, 0xe5, 0x1a, 0x3f, 0x15, 0x00, 0xda,
-	0x69, 0xb0, 0x87, 0x45, 0x27, 0xd9, 0xa1, 0xce, 0x3a, 0x14, 0xe8, 0xc3, 0x04, 0x33, 0xd7, 0xaa,
-	0x5b, 0xcd, 0x52, 0xdb, 0x7d, 0xf6, 0x78, 0x6d, 0xd9, 0xdc, 0x78, 0x33, 0x0c, 0x19, 0xe6, 0x7c,
-	0x4b, 0x30, 0x92, 0x44, 0x9e, 0x86, 0x39, 0xab, 0x50, 0xee, 0x2a, 0x6d, 0x3f, 0x41, 0x3d, 0xec,
-	0xe6, 0xa4, 0x96, 0x07, 0x5a, 0xf4, 0x19, 0xea, 0x61, 0xa7, 0x0d, 0xb0, 0x4f, 0x38, 0xe9, 0x92,
-	0x98, 0x88, 0x81, 0x6b, 0xd7, 0xad, 0xe6, 0xc2, 0x46, 0x63, 0x7d, 0x92, 0xc5, 0xf5, 0x07, 0x23,
-	0xd4, 0xf6, 0xa0, 0x8f, 0xbd, 0x8c, 0x96, 0xf3, 0x1e, 0xe4, 0x48, 0xe8, 0xe6, 0xd5, 0x8d, 0xce,
-	0x3f, 0x79, 0xbe, 0x3a, 0xf3, 0xc7, 0xf3, 0xd5, 0xfc, 0x7d, 0x92, 0x88, 0x67, 0x8f, 0xd7, 0xca,
-	0xe6, 0x76, 0x72, 0xe9, 0xe5, 0x48, 0xe8, 0xdc, 0x80, 0x32, 0xa7, 0x29, 0x0b, 0xb0, 0x2f, 0xe3,
-	0xe6, 0x16, 0x94, 0xc5, 0xda, 0x34, 0x8b, 0x5b, 0x0a, 0xa6, 0xad, 0xf1, 0xd1, 0x6f, 0xe7, 0x3c,
-	0x94, 0x02, 0x86, 0x91, 0xc0, 0x3e, 0x12, 0xee, 0x6c, 0xdd, 0x6a, 0xda, 0x5e, 0x51, 0x0b, 0x36,
-	0x85, 0xb3, 0x09, 0x55, 0x43, 0xb7, 0x8f, 0x34, 0x1f, 0xee, 0xdc, 0x31, 0x4c, 0x2d, 0x18, 0x05,
-	0x23, 0x75, 0xda, 0x50, 0x8b, 0x62, 0xda, 0x45, 0xb1, 0xbf, 0x4f, 0x98, 0x48, 0x51, 0xec, 0x47,
-	0x8c, 0xa6, 0x7d, 0x7f, 0x07, 0xf5, 0x48, 0x3c, 0xf0, 0x49, 0xe8, 0x16, 0xeb, 0x56, 0xb3, 0xe2,
-	0xad, 0x68, 0xd4, 0x03, 0x0d, 0xba, 0x23, 0x31, 0xb7, 0x15, 0xa4, 0x13, 0x3a, 0xef, 0x83, 0x13,
-	0xec, 0x22, 0x16, 0xe1, 0xd0, 0x67, 0x18, 0x85, 0xfe, 0x37, 0x29, 0x15, 0xc8, 0x2d, 0xd5, 0xad,
-	0x66, 0xde, 0x5b, 0x34, 0x3b, 0x1e, 0x46, 0xe1, 0x17, 0x52, 0xee, 0xdc, 0x82, 0x8a, 0x09, 0x12,
-	0x17, 0x48, 0xa4, 0xdc, 0x05, 0x45, 0x4a, 0x7d, 0x1a, 0x29, 0x3a, 0x17, 0xb6, 0x14, 0xce, 0x9b,
-	0xef, 0x66, 0x56, 0xce, 0x55, 0xc8, 0x0b, 0x14, 0x71, 0xb7, 0x5c, 0xb7, 0x9a, 0xe5, 0xe9, 0xda,
-	0x1e, 0x36, 0x44, 0xa2, 0x88, 0x7b, 0x0a, 0x2d, 0xdd, 0xe5, 0x7d, 0x1f, 0x71, 0x3f, 0xc4, 0x31,
-	0x8e, 0x90, 0xc0, 0xa1, 0x8f, 0x22, 0xc9, 0x5f, 0x48, 0x38, 0xea, 0xc6, 0x38, 0x74, 0xe7, 0xeb,
-	0x56, 0xb3, 0xe8, 0xad, 0xf0, 0xfe, 0x26, 0xbf, 0x39, 0xc4, 0x6c, 0x4a, 0xc8, 0x4d, 0x83, 0x68,
-	0xfc, 0x6d, 0x81, 0xd3, 0x49, 0x04, 0x66, 0x09, 0x8a, 0x33, 0xc9, 0x7a, 0x01, 0xa0, 0xcf, 0x88,
-	0x8c, 0x34, 0xe9, 0x61, 0x95, 0xb1, 0xb6, 0x57, 0x52, 0x92, 0x6d, 0xd2, 0xc3, 0xce, 0xff, 0x61,
-	0x49, 0x50, 0x81, 0x62, 0x5f, 0x13, 0xe2, 0x73, 0xf2, 0x48, 0x67, 0x68, 0xde, 0xab, 0xaa, 0x8d,
-	0x4f, 0x94, 0x7c, 0x8b, 0x3c, 0xc2, 0xce, 0x97, 0xb0, 0x1c, 0xd3, 0x60, 0x3c, 0x26, 0xdc, 0xb5,
-	0xeb, 0x76, 0xb3, 0xbc, 0xf1, 0xbf, 0x69, 0xbe, 0xde, 0x95, 0xf8, 0x6c, 0x74, 0x3c, 0x27, 0x1e,
-	0x17, 0x71, 0xe7, 0x3a, 0x9c, 0x4f, 0xf0, 0x81, 0xf0, 0xa7, 0x9c, 0xee, 0x9b, 0xa4, 0xae, 0x78,
-	0x67, 0x25, 0x64, 0xe2, 0xbc, 0x4e, 0xd8, 0xf8, 0x7e, 0x0e, 0xe0, 0xf3, 0xee, 0xd7, 0x38, 0x78,
-	0xb3, 0xea, 0xdc, 0x80, 0x39, 0x95, 0xb9, 0x94, 0xe9, 0xca, 0x7c, 0x8d, 0xc6, 0x10, 0x38, 0x5e,
-	0xd1, 0xf6, 0x44, 0x45, 0xaf, 0x42, 0x99, 0xaa, 0x2b, 0x69, 0x40, 0x5e, 0x03, 0xb4, 0x48, 0x01,
-	0x74, 0xb9, 0x16, 0x4e, 0x56, 0xae, 0x57, 0xe0, 0x3f, 0x47, 0x50, 0x33, 0xab, 0xa8, 0x39, 0x13,
-	0x4f, 0xd2, 0xe2, 0x5c, 0x84, 0xf9, 0x3e, 0x1a, 0xc4, 0x14, 0x85, 0x3a, 0xa8, 0x73, 0x2a, 0xa8,
-	0x65, 0x23, 0x53, 0x01, 0x3d, 0xdc, 0x77, 0x8a, 0x6f, 0xd4, 0x77, 0x2e, 0xc2, 0x7c, 0x40, 0x13,
-	0x21, 0x93, 0x55, 0xf5, 0x92, 0x92, 0x72, 0xb5, 0x6c, 0x64, 0x93, 0xcd, 0x02, 0xc6, 0x9a, 0xc5,
-	0x2d, 0xa8, 0x18, 0xa6, 0x4c, 0xdd, 0x95, 0x8f, 0xae, 0x3b, 0x1d, 0xe5, 0x61, 0xdd, 0xd1, 0xcc,
-	0xca, 0xf9, 0x14, 0xaa, 0x0c, 0x87, 0x69, 0x12, 0xa2, 0x24, 0x18, 0xe8, 0x9b, 0xcc, 0x1f, 0xed,
-	0x8f, 0x37, 0x82, 0x2a, 0x7f, 0x16, 0xd8, 0xa1, 0xf5, 0x78, 0x7b, 0xac, 0x9c, 0xba, 0x3d, 0xb6,
-	0xa0, 0x14, 0xec, 0xe2, 0x60, 0x8f, 0xa7, 0x3d, 0xee, 0x2e, 0xd4, 0xed, 0xe6, 0x7c, 0x7b, 0xe9,
-	0xaf, 0xe7, 0xab, 0x15, 0xc1, 0x10, 0x11, 0xfc, 0xa3, 0x06, 0xed, 0x11, 0xd1, 0xf0, 0x5e, 0x61,
-	0x46, 0x6d, 0xa3, 0x7a, 0xaa, 0xb6, 0xb1, 0x0a, 0x65, 0xc2, 0xfd, 0xb4, 0x1f, 0x22, 0x41, 0x92,
-	0xc8, 0x5d, 0x54, 0x3d, 0x02, 0x08, 0xbf, 0x6f, 0x24, 0xb2, 0xf8, 0xd5, 0xae, 0xec, 0x27, 0xc2,
-	0x5d, 0xd2, 0xc5, 0x6f, 0x24, 0x9b, 0xc2, 0xf9, 0xe0, 0xd5, 0x76, 0x77, 0xe0, 0x3a, 0xc7, 0x64,
-	0xff, 0x50, 0xb1, 0x3d, 0x70, 0x5c, 0x98, 0xdb, 0xc7, 0x8c, 0x13, 0x9a, 0xb8, 0x67, 0xd4, 0xa1,
-	0xc3, 0x65, 0xe3, 0xc7, 0x1c, 0x94, 0x74, 0x06, 0xbe, 0x49, 0x2d, 0x5e, 0x00, 0xd0, 0xa9, 0x9d,
-	0x19, 0x94, 0x25, 0x25, 0x51, 0x45, 0x33, 0x16, 0x17, 0xfb, 0xd4, 0x71, 0x39, 0xd5, 0x90, 0x5c,
-	0x86, 0x02, 0x3e, 0x10, 0x0c, 0xe9, 0x2a, 0xf5, 0xf4, 0x62, 0x14, 0xa9, 0xd9, 0xd3, 0x44, 0xaa,
-	0x71, 0x1d, 0x0a, 0xdb, 0x32, 0xf6, 0xd2, 0x43, 0x95, 0x04, 0xda, 0x03, 0x4b, 0x7b, 0xa8, 0x24,
-	0xea, 0x82, 0xcb, 0x50, 0xd8, 0x47, 0x71, 0x3a, 0xf4, 0x5d, 0x2f, 0x1a, 0xbf, 0x59, 0xb0, 0xa0,
-	0x5b, 0xfa, 0x3d, 0x2c, 0xd0, 0x4d, 0x24, 0x90, 0x53, 0x87, 0x72, 0x88, 0x79, 0xc0, 0x48, 0x5f,
-	0xc8, 0x28, 0xe8, 0x83, 0xb2, 0x22, 0x59, 0x98, 0xf8, 0x40, 0x8f, 0x03, 0x3f, 0x65, 0xb1, 0x39,
-	0xb1, 0x3c, 0x94, 0xdd, 0x67, 0xf1, 0xf1, 0x6d, 0x6c, 0x19, 0x0a, 0xa4, 0x87, 0xa2, 0x61, 0x03,
-	0xd3, 0x0b, 0xe7, 0x06, 0x00, 0x12, 0x82, 0x91, 0x6e, 0x2a, 0x30, 0x77, 0x0b, 0xaa, 0xfb, 0x9f,
-	0x9b, 0x46, 0x84, 0x72, 0xb9, 0x9d, 0x97, 0x44, 0x7b, 0x19, 0x15, 0xe5, 0x8f, 0xae, 0xe5, 0xb7,
-	0xee, 0x4f, 0xb6, 0xeb, 0xda, 0x13, 0x5d, 0xf7, 0x1d, 0xf9, 0xf3, 0xab, 0x05, 0x15, 0x95, 0xf4,
-	0x6f, 0xd7, 0x9d, 0xc3, 0xd5, 0x60, 0x8f, 0x57, 0xc3, 0x3b, 0x72, 0x66, 0x03, 0xec, 0x4e, 0xc8,
-	0x4d, 0xa9, 0x58, 0x75, 0xfb, 0x04, 0xa5, 0xd2, 0xf8, 0xc5, 0x02, 0x90, 0xcf, 0x12, 0x81, 0x55,
-	0xd9, 0x5f, 0x03, 0x93, 0x44, 0x3e, 0x09, 0xb9, 0x72, 0xbe, 0xbc, 0x71, 0x76, 0xda, 0x1d, 0x3a,
-	0x21, 0xf7, 0x4a, 0x1a, 0x2a, 0x6d, 0x5e, 0x03, 0x13, 0x2c, 0xa5, 0x97, 0x3b, 0x46, 0x4f, 0x43,
-	0xa5, 0xde, 0x55, 0x28, 0x0d, 0x27, 0x22, 0x57, 0x3c, 0xbd, 0x46, 0xad, 0x18, 0xe9, 0xf9, 0xc8,
-	0x1b, 0xcf, 0x2c, 0x38, 0x73, 0x8f, 0x44, 0x0c, 0xc9, 0x78, 0x64, 0x5e, 0x4c, 0x2b, 0x50, 0xe2,
-	0x2c, 0xf0, 0xb9, 0x1a, 0xb0, 0x96, 0x1a, 0xb0, 0x73, 0x9c, 0x05, 0x5b, 0x72, 0xa8, 0x76, 0xa0,
-	0x21, 0xf7, 0x8e, 0x79, 0x9b, 0xe6, 0x94, 0xd2, 0x05, 0xce, 0x82, 0x3b, 0x47, 0x3f, 0x4f, 0x57,
-	0xa0, 0x14, 0x72, 0x61, 0xcc, 0xd8, 0xda, 0x4c, 0xc8, 0x85, 0x32, 0xf3, 0x21, 0x94, 0x46, 0x04,
-	0x9e, 0xa4, 0x5d, 0x15, 0x87, 0x1c, 0x36, 0xbe, 0x85, 0xf9, 0x6c, 0xfb, 0x71, 0x3e, 0x36, 0xed,
-	0xca, 0x52, 0x89, 0xf0, 0xdf, 0xe3, 0xda, 0xd5, 0xfa, 0x36, 0x8a, 0x4c, 0x4e, 0x28, 0xbd, 0x95,
-	0x35, 0xb0, 0xb7, 0x51, 0xe4, 0x2c, 0x82, 0xbd, 0x87, 0x07, 0x26, 0x8f, 0xe5, 0xcf, 0x23, 0x3a,
-	0xd5, 0xcf, 0x39, 0x58, 0xdc, 0xda, 0x45, 0x21, 0x7d, 0x98, 0x79, 0x91, 0x5d, 0x85, 0x22, 0xed,
-	0x63, 0xa6, 0x9e, 0x58, 0xc7, 0x0d, 0x82, 0x11, 0xd2, 0x24, 0x60, 0xee, 0x64, 0xbd, 0x7a, 0xfc,
-	0x15, 0x62, 0x4f, 0xbe, 0x42, 0xc6, 0xdf, 0x43, 0xf9, 0xc9, 0xf7, 0xd0, 0xa1, 0xb1, 0x5d, 0x38,
-	0xc1, 0xd8, 0x3e, 0x3c, 0x5f, 0x67, 0xc7, 0xe7, 0x6b, 0x66, 0x4c, 0xce, 0x1d, 0x1e, 0x93, 0xdf,
-	0xe5, 0xa0, 0xaa, 0x53, 0xee, 0x96, 0x9c, 0x2a, 0x8a, 0xa6, 0x4b, 0x50, 0x25, 0xdc, 0x67, 0xf2,
-	0x9d, 0x14, 0x93, 0x1e, 0x11, 0x58, 0x67, 0x5f, 0xd1, 0xab, 0x10, 0xee, 0x21, 0x81, 0xef, 0x6a,
-	0xa1, 0x13, 0x42, 0x55, 0x7e, 0xe4, 0x66, 0x90, 0x86, 0xa5, 0xeb, 0x86, 0xa5, 0x4b, 0x11, 0x11,
-	0xbb, 0x69, 0x77, 0x3d, 0xa0, 0x3d, 0xf3, 0x25, 0x6d, 0xfe, 0xac, 0xf1, 0x70, 0xcf, 0x7c, 0xa9,
-	0x77, 0x14, 0x8f, 0x60, 0x78, 0xec, 0x24, 0xc2, 0xab, 0xc8, 0x43, 0x47, 0x76, 0x9c, 0x5d, 0x58,
-	0x0a, 0x52, 0xc6, 0x24, 0xa3, 0x23, 0x6b, 0x9a, 0xd6, 0x7f, 0x69, 0xa7, 0x6a, 0x8e, 0xbd, 0x6d,
-	0xcc, 0xb5, 0x3b, 0x4f, 0x5e, 0xd4, 0xac, 0xa7, 0x2f, 0x6a, 0xd6, 0x9f, 0x2f, 0x6a, 0xd6, 0x0f,
-	0x2f, 0x6b, 0x33, 0x4f, 0x5f, 0xd6, 0x66, 0x7e, 0x7f, 0x59, 0x9b, 0xf9, 0xaa, 0x95, 0x31, 0xd0,
-	0x4d, 0xba, 0x6b, 0xc1, 0x2e, 0x22, 0x49, 0x2b, 0xf3, 0xb5, 0x7e, 0x70, 0xf8, 0xff, 0x0f, 0xdd,
-	0x59, 0xf5, 0xbd, 0x7e, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x6e, 0xef, 0x57, 0xa2,
-	0x10, 0x00, 0x00,
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{17}
 }
-
-func (m *BucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
 }
 
-func (m *BucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpAsDelegatedAgentDisabled {
-		i--
-		if m.SpAsDelegatedAgentDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.BucketStatus != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BucketStatus))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x40
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *LifecycleRule) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *LifecycleRule) GetExpirationDays() uint32 {
+	if m != nil {
+		return m.ExpirationDays
 	}
-	if m.SourceType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortIncompleteUploadHours() uint32 {
+	if m != nil {
+		return m.AbortIncompleteUploadHours
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type BucketLifecycle struct {
+	// rules defines the lifecycle rules of the bucket
+	Rules []LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{18}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Visibility != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(m, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// LifecycleCursor records where the EndBlocker stopped enqueuing the existing objects of a bucket whose lifecycle
+// rules were set.
+type LifecycleCursor struct {
+	BucketId Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// object_key is the store key of the last scanned object of the bucket, empty means the scan starts from the first object.
+	ObjectKey []byte `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+}
+
+func (m *LifecycleCursor) Reset()         { *m = LifecycleCursor{} }
+func (m *LifecycleCursor) String() string { return proto.CompactTextString(m) }
+func (*LifecycleCursor) ProtoMessage()    {}
+func (*LifecycleCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{19}
+}
+func (m *LifecycleCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LifecycleCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *LifecycleCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleCursor.Merge(m, src)
+}
+func (m *LifecycleCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleCursor.DiscardUnknown(m)
 }
 
-func (m *InternalBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LifecycleCursor proto.InternalMessageInfo
+
+func (m *LifecycleCursor) GetObjectKey() []byte {
+	if m != nil {
+		return m.ObjectKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *InternalBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Retention defines the write-once-read-many protection of a bucket or an object.
+type Retention struct {
+	// mode defines whether the retention is governance or compliance
+	Mode RetentionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=greenfield.storage.RetentionMode" json:"mode,omitempty"`
+	// retain_until defines the timestamp in seconds until which the retention is active
+	RetainUntil int64 `protobuf:"varint,2,opt,name=retain_until,json=retainUntil,proto3" json:"retain_until,omitempty"`
 }
 
-func (m *InternalBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextLocalVirtualGroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.NextLocalVirtualGroupId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.LocalVirtualGroups) > 0 {
-		for iNdEx := len(m.LocalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LocalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *Retention) Reset()         { *m = Retention{} }
+func (m *Retention) String() string { return proto.CompactTextString(m) }
+func (*Retention) ProtoMessage()    {}
+func (*Retention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{20}
+}
+func (m *Retention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Retention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Retention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalChargeSize != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalChargeSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PriceTime != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PriceTime))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *Retention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retention.Merge(m, src)
+}
+func (m *Retention) XXX_Size() int {
+	return m.Size()
+}
+func (m *Retention) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retention proto.InternalMessageInfo
+
+func (m *Retention) GetMode() RetentionMode {
+	if m != nil {
+		return m.Mode
 	}
-	return len(dAtA) - i, nil
+	return RETENTION_MODE_NONE
 }
 
-func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retention) GetRetainUntil() int64 {
+	if m != nil {
+		return m.RetainUntil
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ObjectLocks tracks the retention and legal hold of the objects in a bucket, so that the bucket level operations
+// do not need to iterate the objects.
+type ObjectLocks struct {
+	// legal_hold_count defines the number of objects under legal hold
+	LegalHoldCount uint64 `protobuf:"varint,1,opt,name=legal_hold_count,json=legalHoldCount,proto3" json:"legal_hold_count,omitempty"`
+	// retain_until defines the latest retain until timestamp ever set on the objects, it is never decreased
+	RetainUntil int64 `protobuf:"varint,2,opt,name=retain_until,json=retainUntil,proto3" json:"retain_until,omitempty"`
 }
 
-func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+func (m *ObjectLocks) Reset()         { *m = ObjectLocks{} }
+func (m *ObjectLocks) String() string { return proto.CompactTextString(m) }
+func (*ObjectLocks) ProtoMessage()    {}
+func (*ObjectLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{21}
+}
+func (m *ObjectLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+}
+func (m *ObjectLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectLocks.Merge(m, src)
+}
+func (m *ObjectLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectLocks proto.InternalMessageInfo
+
+func (m *ObjectLocks) GetLegalHoldCount() uint64 {
+	if m != nil {
+		return m.LegalHoldCount
 	}
-	if m.IsUpdating {
+	return 0
+}
+
+func (m *ObjectLocks) GetRetainUntil() int64 {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return 0
+}
+
+// ReaderQuota defines the read quota a reader of a requester-pays bucket buys for itself.
+type ReaderQuota struct {
+	// bucket_id defines the id of the bucket to read from.
+	BucketId Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// reader defines the account address of the reader.
+	Reader string `protobuf:"bytes,2,opt,name=reader,proto3" json:"reader,omitempty"`
+	// payment_address defines the payment account of the reader which pays for the read quota.
+	PaymentAddress string `protobuf:"bytes,3,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// read_quota defines the traffic quota for read in bytes per month.
+	ReadQuota uint64 `protobuf:"varint,4,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+	// flows defines the out flows charged from the payment account for the read quota.
+	Flows []types.OutFlow `protobuf:"bytes,5,rep,name=flows,proto3" json:"flows"`
+}
+
+func (m *ReaderQuota) Reset()         { *m = ReaderQuota{} }
+func (m *ReaderQuota) String() string { return proto.CompactTextString(m) }
+func (*ReaderQuota) ProtoMessage()    {}
+func (*ReaderQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{22}
+}
+func (m *ReaderQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReaderQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReaderQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReaderQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReaderQuota.Merge(m, src)
+}
+func (m *ReaderQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReaderQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReaderQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReaderQuota proto.InternalMessageInfo
+
+func (m *ReaderQuota) GetReader() string {
+	if m != nil {
+		return m.Reader
+	}
+	return ""
+}
+
+func (m *ReaderQuota) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *ReaderQuota) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
+	}
+	return 0
+}
+
+func (m *ReaderQuota) GetFlows() []types.OutFlow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+// PaymentMismatch defines an account whose payment data breaks an invariant of the payment check.
+type PaymentMismatch struct {
+	Type    PaymentMismatchType `protobuf:"varint,1,opt,name=type,proto3,enum=greenfield.storage.PaymentMismatchType" json:"type,omitempty"`
+	Address string              `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// expected defines the value computed from the buckets and objects, i.e. lock balance or net flow rate.
+	Expected github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=expected,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"expected"`
+	// actual defines the value recorded in the stream record.
+	Actual github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=actual,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"actual"`
+	// known_issue defines whether it is one of the known lock balance issues, which are not treated as unhealthy.
+	KnownIssue bool `protobuf:"varint,5,opt,name=known_issue,json=knownIssue,proto3" json:"known_issue,omitempty"`
+	// buckets defines the names of the buckets contributing to the expected value.
+	Buckets     []string `protobuf:"bytes,6,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Description string   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *PaymentMismatch) Reset()         { *m = PaymentMismatch{} }
+func (m *PaymentMismatch) String() string { return proto.CompactTextString(m) }
+func (*PaymentMismatch) ProtoMessage()    {}
+func (*PaymentMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{23}
+}
+func (m *PaymentMismatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentMismatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentMismatch.Merge(m, src)
+}
+func (m *PaymentMismatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentMismatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentMismatch proto.InternalMessageInfo
+
+func (m *PaymentMismatch) GetType() PaymentMismatchType {
+	if m != nil {
+		return m.Type
+	}
+	return PAYMENT_MISMATCH_LOCK_BALANCE
+}
+
+func (m *PaymentMismatch) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PaymentMismatch) GetKnownIssue() bool {
+	if m != nil {
+		return m.KnownIssue
+	}
+	return false
+}
+
+func (m *PaymentMismatch) GetBuckets() []string {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *PaymentMismatch) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// PaymentHealthReport defines the result of checking the payment data of all buckets and objects against the stream records.
+type PaymentHealthReport struct {
+	// healthy is false if there are errors or mismatches which are not known issues.
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// errors defines the failures to check some buckets, the mismatches are not compared if there are any.
+	Errors     []string          `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Mismatches []PaymentMismatch `protobuf:"bytes,3,rep,name=mismatches,proto3" json:"mismatches"`
+	// total_netflow_rate defines the sum of the net flow rates of all stream records, which should be zero.
+	TotalNetflowRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_netflow_rate,json=totalNetflowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_netflow_rate"`
+}
+
+func (m *PaymentHealthReport) Reset()         { *m = PaymentHealthReport{} }
+func (m *PaymentHealthReport) String() string { return proto.CompactTextString(m) }
+func (*PaymentHealthReport) ProtoMessage()    {}
+func (*PaymentHealthReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{24}
+}
+func (m *PaymentHealthReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentHealthReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentHealthReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentHealthReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentHealthReport.Merge(m, src)
+}
+func (m *PaymentHealthReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentHealthReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentHealthReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentHealthReport proto.InternalMessageInfo
+
+func (m *PaymentHealthReport) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *PaymentHealthReport) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *PaymentHealthReport) GetMismatches() []PaymentMismatch {
+	if m != nil {
+		return m.Mismatches
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BucketInfo)(nil), "greenfield.storage.BucketInfo")
+	proto.RegisterType((*InternalBucketInfo)(nil), "greenfield.storage.InternalBucketInfo")
+	proto.RegisterType((*ObjectInfo)(nil), "greenfield.storage.ObjectInfo")
+	proto.RegisterType((*ObjectVersion)(nil), "greenfield.storage.ObjectVersion")
+	proto.RegisterType((*ObjectComponent)(nil), "greenfield.storage.ObjectComponent")
+	proto.RegisterType((*ComposedObject)(nil), "greenfield.storage.ComposedObject")
+	proto.RegisterType((*GroupInfo)(nil), "greenfield.storage.GroupInfo")
+	proto.RegisterType((*Trait)(nil), "greenfield.storage.Trait")
+	proto.RegisterType((*BucketMetaData)(nil), "greenfield.storage.BucketMetaData")
+	proto.RegisterType((*ObjectMetaData)(nil), "greenfield.storage.ObjectMetaData")
+	proto.RegisterType((*GroupMetaData)(nil), "greenfield.storage.GroupMetaData")
+	proto.RegisterType((*Ids)(nil), "greenfield.storage.Ids")
+	proto.RegisterType((*DeleteInfo)(nil), "greenfield.storage.DeleteInfo")
+	proto.RegisterType((*MigrationBucketInfo)(nil), "greenfield.storage.MigrationBucketInfo")
+	proto.RegisterType((*ResourceTags)(nil), "greenfield.storage.ResourceTags")
+	proto.RegisterType((*ResourceTags_Tag)(nil), "greenfield.storage.ResourceTags.Tag")
+	proto.RegisterType((*ShadowObjectInfo)(nil), "greenfield.storage.ShadowObjectInfo")
+	proto.RegisterType((*BucketExtraInfo)(nil), "greenfield.storage.BucketExtraInfo")
+	proto.RegisterType((*LifecycleRule)(nil), "greenfield.storage.LifecycleRule")
+	proto.RegisterType((*BucketLifecycle)(nil), "greenfield.storage.BucketLifecycle")
+	proto.RegisterType((*LifecycleCursor)(nil), "greenfield.storage.LifecycleCursor")
+	proto.RegisterType((*Retention)(nil), "greenfield.storage.Retention")
+	proto.RegisterType((*ObjectLocks)(nil), "greenfield.storage.ObjectLocks")
+	proto.RegisterType((*ReaderQuota)(nil), "greenfield.storage.ReaderQuota")
+	proto.RegisterType((*PaymentMismatch)(nil), "greenfield.storage.PaymentMismatch")
+	proto.RegisterType((*PaymentHealthReport)(nil), "greenfield.storage.PaymentHealthReport")
+}
+
+func init() { proto.RegisterFile("greenfield/storage/types.proto", fileDescriptor_bf95fa2efdc74d97) }
+
+var fileDescriptor_bf95fa2efdc74d97 = []byte{
+	// 2255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x19, 0x36, 0x3f, 0x45, 0xbe, 0x14, 0x49, 0x79, 0xac, 0x3a, 0x1b, 0xb9, 0x92, 0xe8, 0x4d, 0xe3,
+	0x08, 0x6d, 0x25, 0x25, 0x4a, 0x9a, 0x04, 0xad, 0xdb, 0x40, 0xb2, 0x9d, 0x98, 0x88, 0xed, 0xb8,
+	0x2b, 0xd9, 0x2d, 0x72, 0x59, 0x0c, 0x77, 0x47, 0xd4, 0x58, 0xcb, 0x1d, 0x66, 0x66, 0xd6, 0x16,
+	0x03, 0xf4, 0x07, 0xf4, 0x94, 0x1e, 0xf2, 0x0f, 0x8a, 0x1e, 0x7b, 0x29, 0xf2, 0x23, 0x82, 0x02,
+	0x05, 0x02, 0x9f, 0x8a, 0x1e, 0x8c, 0xc2, 0x06, 0xfa, 0x03, 0x7a, 0xe8, 0xa1, 0x87, 0xa2, 0x98,
+	0x8f, 0xa5, 0x56, 0x24, 0x25, 0x51, 0xb6, 0x72, 0x22, 0xe7, 0x9d, 0xe7, 0x9d, 0x8f, 0xf7, 0xe3,
+	0x99, 0x77, 0x66, 0x61, 0xa9, 0xcb, 0x09, 0x89, 0x77, 0x29, 0x89, 0xc2, 0x75, 0x21, 0x19, 0xc7,
+	0x5d, 0xb2, 0x2e, 0x07, 0x7d, 0x22, 0xd6, 0xfa, 0x9c, 0x49, 0x86, 0xd0, 0x61, 0xff, 0x9a, 0xed,
+	0x5f, 0x58, 0x0a, 0x98, 0xe8, 0x31, 0xb1, 0xde, 0xc1, 0x82, 0xac, 0x3f, 0x7e, 0xa7, 0x43, 0x24,
+	0x7e, 0x67, 0x3d, 0x60, 0x34, 0x36, 0x3a, 0x0b, 0xaf, 0x9b, 0x7e, 0x5f, 0xb7, 0xd6, 0x4d, 0xc3,
+	0x76, 0xcd, 0x77, 0x59, 0x97, 0x19, 0xb9, 0xfa, 0x67, 0xa5, 0x57, 0x33, 0x8b, 0xe8, 0xe3, 0x41,
+	0x8f, 0xc4, 0x72, 0x9d, 0x25, 0xd2, 0xdf, 0x8d, 0xd8, 0x13, 0x0b, 0xb9, 0x36, 0x01, 0x22, 0x24,
+	0x27, 0xb8, 0xe7, 0x73, 0x12, 0x30, 0x1e, 0x5a, 0xdc, 0xf2, 0x84, 0xfd, 0x04, 0xac, 0xd7, 0x63,
+	0x76, 0x71, 0xee, 0x7f, 0xcb, 0x00, 0x5b, 0x49, 0xb0, 0x4f, 0x64, 0x3b, 0xde, 0x65, 0x68, 0x0d,
+	0x4a, 0xec, 0x49, 0x4c, 0xb8, 0x93, 0x6b, 0xe5, 0x56, 0xaa, 0x5b, 0xce, 0xd3, 0x6f, 0x56, 0xe7,
+	0xed, 0x8a, 0x37, 0xc3, 0x90, 0x13, 0x21, 0xb6, 0x25, 0xa7, 0x71, 0xd7, 0x33, 0x30, 0xb4, 0x0c,
+	0xb5, 0x8e, 0xd6, 0xf6, 0x63, 0xdc, 0x23, 0x4e, 0x5e, 0x69, 0x79, 0x60, 0x44, 0xf7, 0x70, 0x8f,
+	0xa0, 0x2d, 0x80, 0xc7, 0x54, 0xd0, 0x0e, 0x8d, 0xa8, 0x1c, 0x38, 0x85, 0x56, 0x6e, 0xa5, 0xb1,
+	0xe1, 0xae, 0x8d, 0x5b, 0x71, 0xed, 0xe1, 0x10, 0xb5, 0x33, 0xe8, 0x13, 0x2f, 0xa3, 0x85, 0x7e,
+	0x02, 0x79, 0x1a, 0x3a, 0x45, 0xbd, 0xa2, 0x2b, 0xdf, 0x3e, 0x5b, 0xbe, 0xf0, 0x8f, 0x67, 0xcb,
+	0xc5, 0x07, 0x34, 0x96, 0x4f, 0xbf, 0x59, 0xad, 0xd9, 0xd5, 0xa9, 0xa6, 0x97, 0xa7, 0x21, 0xfa,
+	0x08, 0x6a, 0x82, 0x25, 0x3c, 0x20, 0xbe, 0xf2, 0x9b, 0x53, 0xd2, 0x33, 0x2e, 0x4d, 0x9a, 0x71,
+	0x5b, 0xc3, 0xcc, 0x6c, 0x62, 0xf8, 0x1f, 0x5d, 0x81, 0x6a, 0xc0, 0x09, 0x96, 0xc4, 0xc7, 0xd2,
+	0x29, 0xb7, 0x72, 0x2b, 0x05, 0xaf, 0x62, 0x04, 0x9b, 0x12, 0x6d, 0x42, 0xd3, 0x9a, 0xdb, 0xc7,
+	0xc6, 0x1e, 0xce, 0xcc, 0x29, 0x96, 0x6a, 0x58, 0x05, 0x2b, 0x45, 0x5b, 0xb0, 0xd4, 0x8d, 0x58,
+	0x07, 0x47, 0xfe, 0x63, 0xca, 0x65, 0x82, 0x23, 0xbf, 0xcb, 0x59, 0xd2, 0xf7, 0x77, 0x71, 0x8f,
+	0x46, 0x03, 0x9f, 0x86, 0x4e, 0xa5, 0x95, 0x5b, 0xa9, 0x7b, 0x0b, 0x06, 0xf5, 0xd0, 0x80, 0x3e,
+	0x51, 0x98, 0x8f, 0x35, 0xa4, 0x1d, 0xa2, 0x9f, 0x02, 0x0a, 0xf6, 0x30, 0xef, 0x92, 0xd0, 0xe7,
+	0x04, 0x87, 0xfe, 0x17, 0x09, 0x93, 0xd8, 0xa9, 0xb6, 0x72, 0x2b, 0x45, 0x6f, 0xce, 0xf6, 0x78,
+	0x04, 0x87, 0xbf, 0x56, 0x72, 0x74, 0x0b, 0xea, 0xd6, 0x49, 0x42, 0x62, 0x99, 0x08, 0x07, 0xb4,
+	0x51, 0x5a, 0x93, 0x8c, 0x62, 0x62, 0x61, 0x5b, 0xe3, 0xbc, 0xd9, 0x4e, 0xa6, 0x85, 0xde, 0x83,
+	0xa2, 0xc4, 0x5d, 0xe1, 0xd4, 0x5a, 0xb9, 0x95, 0xda, 0x64, 0x6d, 0x8f, 0x58, 0x43, 0xe2, 0xae,
+	0xf0, 0x34, 0x5a, 0x6d, 0x57, 0xf4, 0x7d, 0x2c, 0xfc, 0x90, 0x44, 0xa4, 0x8b, 0x25, 0x09, 0x7d,
+	0xdc, 0x55, 0xf6, 0x0b, 0xa9, 0xc0, 0x9d, 0x88, 0x84, 0xce, 0x6c, 0x2b, 0xb7, 0x52, 0xf1, 0x16,
+	0x44, 0x7f, 0x53, 0xdc, 0x4c, 0x31, 0x9b, 0x0a, 0x72, 0xd3, 0x22, 0xd0, 0x2a, 0xa0, 0xc7, 0x84,
+	0x0b, 0xca, 0x62, 0x1a, 0x77, 0x7d, 0x12, 0x1b, 0xbd, 0xba, 0xd6, 0xbb, 0x78, 0xd8, 0x73, 0xcb,
+	0x74, 0xa0, 0x7b, 0x50, 0xe5, 0x44, 0x92, 0x58, 0x52, 0x16, 0x3b, 0x0d, 0xbd, 0xda, 0xc5, 0xc9,
+	0xab, 0xb5, 0xa0, 0xad, 0x8b, 0xff, 0x7e, 0xb6, 0x5c, 0x97, 0x1c, 0x53, 0x29, 0x7e, 0xee, 0xb2,
+	0x1e, 0x95, 0xae, 0x77, 0x38, 0x04, 0x7a, 0x1b, 0x40, 0x2d, 0x2b, 0xf2, 0xf7, 0x58, 0x14, 0x3a,
+	0x4d, 0x35, 0xed, 0x44, 0x0d, 0x0d, 0xba, 0xcd, 0xa2, 0x10, 0xbd, 0x09, 0x0d, 0x4e, 0xbe, 0x48,
+	0x88, 0x90, 0x84, 0xfb, 0x7d, 0x3c, 0x10, 0xce, 0x9c, 0x5e, 0x6c, 0x7d, 0x28, 0xbd, 0x8f, 0x07,
+	0xc2, 0xfd, 0x4f, 0x0e, 0x50, 0x3b, 0x96, 0x84, 0xc7, 0x38, 0xca, 0x24, 0xe1, 0x22, 0x40, 0x9f,
+	0x53, 0x15, 0xc1, 0xb4, 0x47, 0x74, 0x26, 0x16, 0xbc, 0xaa, 0x96, 0xec, 0xd0, 0x1e, 0x41, 0x3f,
+	0x86, 0x8b, 0x92, 0x49, 0x1c, 0xf9, 0xc6, 0xd1, 0xbe, 0xa0, 0x5f, 0x9a, 0xcc, 0x2b, 0x7a, 0x4d,
+	0xdd, 0x71, 0x43, 0xcb, 0xb7, 0xe9, 0x97, 0x04, 0xfd, 0x06, 0xe6, 0x23, 0x16, 0x8c, 0xc6, 0x9a,
+	0x70, 0x0a, 0xad, 0xc2, 0x4a, 0x6d, 0xe3, 0xcd, 0x49, 0x56, 0xb9, 0xa3, 0xf0, 0xd9, 0xa8, 0xf3,
+	0x50, 0x34, 0x2a, 0x12, 0xe8, 0x3a, 0x5c, 0x89, 0xc9, 0x81, 0xf4, 0x27, 0x8c, 0xee, 0xdb, 0x64,
+	0xad, 0x7b, 0xaf, 0x29, 0xc8, 0xd8, 0x78, 0xed, 0xd0, 0xfd, 0x73, 0x05, 0xe0, 0xb3, 0xce, 0x23,
+	0x12, 0xbc, 0x1c, 0xeb, 0x6c, 0xc0, 0x8c, 0xce, 0x48, 0xc6, 0x0d, 0xe3, 0x9c, 0xa0, 0x91, 0x02,
+	0x47, 0x99, 0xaa, 0x30, 0xc6, 0x54, 0xcb, 0x50, 0x63, 0x7a, 0x49, 0x06, 0x50, 0x34, 0x00, 0x23,
+	0xd2, 0x00, 0x43, 0x43, 0xa5, 0xe9, 0x68, 0xe8, 0x5d, 0xb8, 0x7c, 0x8c, 0x69, 0xca, 0xda, 0x34,
+	0x97, 0xa2, 0x71, 0xb3, 0xa0, 0xab, 0x30, 0xdb, 0xc7, 0x83, 0x88, 0xe1, 0xd0, 0x38, 0x75, 0x46,
+	0x3b, 0xb5, 0x66, 0x65, 0xda, 0xa1, 0x47, 0xf9, 0xb4, 0xf2, 0x52, 0x7c, 0x7a, 0x15, 0x66, 0x03,
+	0x16, 0xab, 0xe8, 0x36, 0x1c, 0x59, 0xd5, 0x5b, 0xad, 0x59, 0xd9, 0x38, 0x09, 0xc2, 0x08, 0x09,
+	0xde, 0x82, 0xba, 0xb5, 0x94, 0xe5, 0x93, 0xda, 0xf1, 0x7c, 0x62, 0xbc, 0x9c, 0xf2, 0x09, 0xcb,
+	0xb4, 0xd0, 0xa7, 0xd0, 0xe4, 0x24, 0x4c, 0xe2, 0x10, 0xc7, 0xc1, 0xc0, 0xac, 0x64, 0xf6, 0xf8,
+	0xfd, 0x78, 0x43, 0xa8, 0xde, 0x4f, 0x83, 0x1f, 0x69, 0x8f, 0xd2, 0x7e, 0xfd, 0xcc, 0xb4, 0xbf,
+	0x0e, 0xd5, 0x60, 0x8f, 0x04, 0xfb, 0x22, 0xe9, 0x09, 0xa7, 0xd1, 0x2a, 0xac, 0xcc, 0x4e, 0xcc,
+	0xf1, 0x21, 0x66, 0x48, 0x87, 0xcd, 0x33, 0xd1, 0xe1, 0x32, 0xd4, 0xa8, 0xf0, 0x93, 0x7e, 0x88,
+	0x25, 0x8d, 0xbb, 0x96, 0x16, 0x80, 0x8a, 0x07, 0x56, 0xa2, 0x92, 0x5f, 0xf7, 0x2a, 0x9e, 0x94,
+	0xce, 0x45, 0x93, 0xfc, 0x56, 0xb2, 0x29, 0xd1, 0x07, 0x87, 0xdd, 0x9d, 0x81, 0x83, 0x4e, 0x89,
+	0xfe, 0x54, 0x71, 0x6b, 0x80, 0x1c, 0x98, 0xb1, 0x4c, 0xe9, 0x5c, 0xd2, 0x83, 0xa6, 0xcd, 0xa3,
+	0x74, 0x39, 0x7f, 0xde, 0x74, 0xf9, 0x83, 0x29, 0xe8, 0xd2, 0x18, 0x25, 0x60, 0xbd, 0x3e, 0x13,
+	0x24, 0x74, 0x2e, 0xa7, 0x46, 0xb9, 0x61, 0x25, 0xee, 0x8b, 0x02, 0xd4, 0x4d, 0x24, 0x3d, 0xb4,
+	0x8b, 0xfe, 0x10, 0xaa, 0x36, 0x06, 0x69, 0x68, 0x69, 0xe3, 0xc4, 0x9c, 0xac, 0x18, 0x74, 0x3b,
+	0x3c, 0xbd, 0x64, 0x19, 0x21, 0x82, 0xc2, 0x18, 0x11, 0x64, 0x4c, 0x59, 0x3c, 0x6a, 0xca, 0xe3,
+	0xb3, 0xbe, 0x34, 0x7d, 0xd6, 0x97, 0xc7, 0xb3, 0x7e, 0x34, 0x63, 0x67, 0xc6, 0x33, 0x76, 0x42,
+	0x36, 0x55, 0x5e, 0x3a, 0x9b, 0x7e, 0x98, 0x4d, 0x86, 0xaa, 0x4a, 0x86, 0x6c, 0xe4, 0x1f, 0x0d,
+	0x51, 0x38, 0x39, 0x44, 0x6b, 0x53, 0x87, 0xa8, 0xfb, 0xaf, 0x3c, 0x34, 0x8d, 0x97, 0xb5, 0xe3,
+	0x63, 0x12, 0xcb, 0x57, 0xf3, 0x73, 0xd6, 0x8d, 0xf9, 0x31, 0x37, 0x1e, 0xef, 0xac, 0xc2, 0xf4,
+	0xce, 0x2a, 0x8e, 0x3b, 0xeb, 0x32, 0x94, 0xd9, 0xee, 0xae, 0x20, 0x52, 0x3b, 0xbd, 0xe8, 0xd9,
+	0xd6, 0x24, 0x0f, 0x95, 0xcf, 0xc7, 0x43, 0x33, 0x27, 0x7b, 0xa8, 0x32, 0xe2, 0x21, 0xf7, 0xab,
+	0x1c, 0x34, 0xd2, 0xdc, 0x32, 0x06, 0x7f, 0x05, 0x3b, 0xdf, 0x00, 0x08, 0x52, 0x77, 0x09, 0x27,
+	0xaf, 0x0b, 0x8b, 0x37, 0x8e, 0x3f, 0x0a, 0x86, 0xae, 0xf5, 0x32, 0x6a, 0xee, 0xd7, 0x79, 0xa8,
+	0x1a, 0x13, 0xbf, 0x4c, 0x3d, 0xb0, 0x08, 0x60, 0x7c, 0x97, 0xf1, 0x74, 0x55, 0x4b, 0xb4, 0xa3,
+	0x47, 0xce, 0x86, 0xc2, 0x99, 0xcf, 0x86, 0x33, 0x5d, 0x40, 0xe6, 0xa1, 0x44, 0x0e, 0x24, 0xc7,
+	0xa6, 0x52, 0xf0, 0x4c, 0x63, 0x78, 0x5a, 0x94, 0xcf, 0x72, 0x5a, 0xb8, 0xd7, 0xa1, 0xb4, 0xa3,
+	0x48, 0x53, 0xed, 0x50, 0xb3, 0xa7, 0xd9, 0x41, 0xce, 0xec, 0x50, 0x4b, 0xf4, 0x02, 0xe7, 0xa1,
+	0xf4, 0x18, 0x47, 0x49, 0xba, 0x77, 0xd3, 0x70, 0xff, 0x96, 0x83, 0x86, 0x29, 0x2b, 0xef, 0x12,
+	0x89, 0x6f, 0x62, 0x89, 0x51, 0x0b, 0x6a, 0x21, 0x11, 0x01, 0xa7, 0x7d, 0xcd, 0xf6, 0x66, 0xa0,
+	0xac, 0x48, 0x05, 0x38, 0x39, 0x30, 0x25, 0xa9, 0x9f, 0xf0, 0xc8, 0x8e, 0x58, 0x4b, 0x65, 0x0f,
+	0x78, 0x74, 0x7a, 0x29, 0x35, 0x0f, 0x25, 0xda, 0xc3, 0xdd, 0xb4, 0x88, 0x32, 0x0d, 0xf4, 0x11,
+	0x00, 0x96, 0x92, 0xd3, 0x4e, 0x22, 0x89, 0x70, 0x4a, 0x3a, 0x50, 0x5e, 0x9f, 0x64, 0x08, 0xbd,
+	0xe5, 0xad, 0xa2, 0x32, 0xb4, 0x97, 0x51, 0xd1, 0xfb, 0x31, 0x41, 0x74, 0xee, 0xfb, 0x39, 0x99,
+	0xf0, 0xbf, 0xa7, 0xfd, 0xfc, 0x35, 0x07, 0x75, 0x1d, 0xf4, 0xe7, 0xbb, 0x9d, 0xa3, 0xd9, 0x50,
+	0x18, 0xcd, 0x86, 0xef, 0x69, 0x33, 0x1b, 0x50, 0x68, 0x87, 0xc2, 0xa6, 0x4a, 0xae, 0x55, 0x98,
+	0x22, 0x55, 0xdc, 0xbf, 0xe4, 0x00, 0xd4, 0x95, 0x4f, 0x12, 0x9d, 0xf6, 0xef, 0x83, 0x0d, 0x22,
+	0x9f, 0x86, 0x42, 0x6f, 0xbe, 0xb6, 0xf1, 0xda, 0xa4, 0x35, 0xb4, 0x43, 0xe1, 0x55, 0x0d, 0x54,
+	0xcd, 0xf9, 0x3e, 0xc0, 0x90, 0xbb, 0x84, 0xb6, 0xc8, 0x49, 0x7a, 0x29, 0x71, 0xa9, 0x0a, 0xae,
+	0x9a, 0x52, 0xbe, 0xd0, 0x76, 0x3a, 0x41, 0xad, 0xd2, 0x35, 0x07, 0x80, 0x70, 0x9f, 0xe6, 0xe0,
+	0xd2, 0x5d, 0xda, 0xe5, 0x58, 0x97, 0x42, 0x87, 0xb7, 0xb6, 0x05, 0xa8, 0x0a, 0x1e, 0xf8, 0xa2,
+	0x9f, 0x32, 0x68, 0xdd, 0x9b, 0x11, 0x3c, 0xd8, 0x56, 0xa7, 0x46, 0x1b, 0x5c, 0xd5, 0x77, 0xca,
+	0xbd, 0x3f, 0xaf, 0x95, 0x16, 0x05, 0x0f, 0x3e, 0x39, 0xfe, 0xea, 0xbf, 0x00, 0xd5, 0x50, 0x48,
+	0x3b, 0x8d, 0x39, 0xa8, 0x66, 0x42, 0x21, 0xf5, 0x34, 0x1f, 0x42, 0x75, 0x68, 0xc0, 0x69, 0xe8,
+	0xaa, 0x92, 0xda, 0xd0, 0xfd, 0x1d, 0xcc, 0x66, 0xe9, 0x07, 0xfd, 0xca, 0xd2, 0x55, 0x4e, 0x07,
+	0xc2, 0x8f, 0x4e, 0xa3, 0xab, 0xb5, 0x1d, 0xdc, 0xb5, 0x31, 0xa1, 0xf5, 0x16, 0x56, 0xa1, 0xb0,
+	0x83, 0xbb, 0x68, 0x0e, 0x0a, 0xfb, 0x64, 0x60, 0xe3, 0x58, 0xfd, 0x3d, 0x86, 0xa9, 0xfe, 0x94,
+	0x87, 0xb9, 0xed, 0x3d, 0x1c, 0xb2, 0x27, 0x99, 0x5b, 0xe1, 0x7b, 0x50, 0x61, 0x7d, 0xc2, 0xf5,
+	0x35, 0xef, 0xb4, 0x83, 0x60, 0x88, 0xb4, 0x01, 0x98, 0x9f, 0x8e, 0xab, 0x47, 0xeb, 0xaa, 0xc2,
+	0x78, 0x5d, 0x35, 0xc5, 0x81, 0x7f, 0xe4, 0xea, 0x50, 0x9a, 0xe2, 0xea, 0x70, 0xf4, 0x78, 0x2e,
+	0x8f, 0x16, 0x50, 0x99, 0xfa, 0x72, 0xe6, 0x48, 0x7d, 0xe9, 0xfe, 0x3e, 0x0f, 0x4d, 0x13, 0x72,
+	0xb7, 0xd4, 0xa9, 0xa2, 0xcd, 0x74, 0x0d, 0x9a, 0x54, 0xf8, 0x5c, 0xdd, 0xd5, 0x22, 0xda, 0xa3,
+	0x92, 0x98, 0xe8, 0xab, 0x78, 0x75, 0x2a, 0x3c, 0x2c, 0xc9, 0x1d, 0x23, 0x44, 0x21, 0x34, 0x77,
+	0x23, 0xf6, 0x24, 0x83, 0xb4, 0x56, 0xba, 0x6e, 0xad, 0x74, 0xad, 0x4b, 0xe5, 0x5e, 0xd2, 0x59,
+	0x0b, 0x58, 0xcf, 0xbe, 0x52, 0xda, 0x9f, 0x55, 0x11, 0xee, 0xdb, 0x57, 0xd0, 0xb6, 0xb6, 0x23,
+	0x58, 0x3b, 0xb6, 0x63, 0xe9, 0xd5, 0xd5, 0xa0, 0xc3, 0x79, 0xd0, 0x1e, 0x5c, 0x0c, 0x12, 0xce,
+	0x95, 0x45, 0x87, 0xb3, 0x19, 0xb3, 0xbe, 0xe2, 0x3c, 0x4d, 0x3b, 0xec, 0xc7, 0x76, 0x3a, 0xf7,
+	0x8f, 0x39, 0xa8, 0xdf, 0xa1, 0xbb, 0x24, 0x18, 0x04, 0x11, 0xf1, 0x92, 0x88, 0xa0, 0x86, 0xe5,
+	0x1e, 0xe5, 0x43, 0xe5, 0xdd, 0xcb, 0x50, 0xee, 0x73, 0xb2, 0x4b, 0x0f, 0x6c, 0xb0, 0xd9, 0x16,
+	0x7a, 0x0b, 0x9a, 0xe4, 0xa0, 0x4f, 0x4d, 0x06, 0xfb, 0x21, 0x1e, 0x08, 0x9b, 0x48, 0x8d, 0x43,
+	0xf1, 0x4d, 0x3c, 0x10, 0x68, 0x13, 0x16, 0x71, 0x87, 0x71, 0xe9, 0xd3, 0x58, 0xd5, 0x2a, 0x8a,
+	0xa8, 0xfc, 0xa4, 0xaf, 0x63, 0x61, 0x8f, 0x25, 0x5c, 0xd8, 0x67, 0x8e, 0x05, 0x0d, 0x6a, 0x0f,
+	0x31, 0x0f, 0x34, 0xe4, 0xb6, 0x42, 0xb8, 0xf7, 0x53, 0x87, 0x0d, 0x97, 0x8a, 0x7e, 0x09, 0x25,
+	0x9e, 0x44, 0x24, 0x4d, 0xae, 0xab, 0x13, 0x1f, 0x61, 0xb2, 0x1b, 0xb3, 0x99, 0x65, 0xb4, 0xdc,
+	0x47, 0xd0, 0x1c, 0xf6, 0xde, 0x48, 0xb8, 0x60, 0xfc, 0x68, 0xde, 0xe7, 0xce, 0x90, 0xf7, 0x2a,
+	0x12, 0x2d, 0x75, 0xaa, 0x3c, 0x55, 0x66, 0x9a, 0x4d, 0x19, 0xf2, 0x53, 0x32, 0x70, 0x09, 0x54,
+	0x87, 0xb7, 0x3e, 0xf4, 0x33, 0x28, 0xf6, 0x58, 0x68, 0xaa, 0x8f, 0xc6, 0xe4, 0x65, 0x0f, 0xc1,
+	0x77, 0x59, 0x48, 0x3c, 0x0d, 0x57, 0x09, 0xc4, 0x89, 0xc4, 0x34, 0xf6, 0x93, 0x58, 0x52, 0x73,
+	0x62, 0x15, 0xbc, 0x9a, 0x91, 0x3d, 0x50, 0x22, 0xf7, 0x73, 0xa8, 0x99, 0xbc, 0xbf, 0xc3, 0x82,
+	0x7d, 0x81, 0x56, 0x60, 0xee, 0xf0, 0x02, 0xe9, 0x07, 0x2c, 0x89, 0xa5, 0x9e, 0xb4, 0xe8, 0x35,
+	0x86, 0x77, 0xc6, 0x1b, 0x4a, 0x3a, 0xcd, 0xd8, 0x5f, 0xe7, 0xa1, 0xe6, 0x11, 0x1c, 0x12, 0x6e,
+	0x1e, 0x43, 0x5f, 0xde, 0x56, 0x6f, 0x43, 0x99, 0xeb, 0x81, 0x4e, 0x7d, 0x74, 0xb2, 0xb8, 0x49,
+	0xaf, 0xc5, 0x85, 0x33, 0xbe, 0x16, 0x2f, 0x02, 0x64, 0x5e, 0x78, 0x0d, 0xf9, 0x54, 0xf9, 0xf0,
+	0x69, 0xf7, 0x03, 0x28, 0xa9, 0x34, 0x4b, 0x4f, 0xec, 0x2b, 0x59, 0xa7, 0xd8, 0x91, 0xd6, 0x3e,
+	0x4b, 0x74, 0xd2, 0xa4, 0x51, 0xa4, 0xf1, 0xee, 0x57, 0x05, 0x68, 0xde, 0x37, 0x80, 0xbb, 0x54,
+	0xf4, 0xb0, 0x0c, 0xf6, 0xd0, 0x2f, 0xa0, 0x38, 0x2c, 0x2f, 0x1b, 0x1b, 0x6f, 0x4d, 0x72, 0xf0,
+	0x88, 0x8a, 0xae, 0x94, 0xb5, 0x12, 0xda, 0x80, 0x99, 0x74, 0x8f, 0xa7, 0xbe, 0xc9, 0x59, 0x20,
+	0xfa, 0x2d, 0x54, 0xc8, 0x41, 0x9f, 0x04, 0x8a, 0xb3, 0xce, 0x83, 0x23, 0x86, 0xa3, 0xa1, 0x1d,
+	0x28, 0xe3, 0x40, 0x1d, 0x9e, 0xf6, 0x18, 0x7c, 0xb5, 0x71, 0xed, 0x58, 0xaa, 0x50, 0xdc, 0x8f,
+	0xd9, 0x93, 0xd8, 0xa7, 0x42, 0x24, 0xe6, 0xdb, 0x42, 0xc5, 0x03, 0x2d, 0x6a, 0x2b, 0x89, 0x62,
+	0x6e, 0x13, 0x2e, 0xaa, 0xd0, 0x2f, 0xac, 0x54, 0xbd, 0xb4, 0x39, 0x5a, 0xd9, 0xcd, 0x8c, 0x55,
+	0x76, 0xee, 0xff, 0x72, 0x70, 0xc9, 0x9a, 0xf7, 0x36, 0xc1, 0x91, 0xdc, 0xf3, 0x48, 0x9f, 0x71,
+	0x7d, 0x1a, 0xec, 0xe9, 0xf6, 0xc0, 0xf2, 0x7a, 0xda, 0x54, 0xfc, 0x46, 0x38, 0x67, 0xdc, 0xdc,
+	0xba, 0xaa, 0x9e, 0x6d, 0xa1, 0x36, 0x40, 0xcf, 0x3a, 0x88, 0xa4, 0x4f, 0xbd, 0x6f, 0x4c, 0xe1,
+	0xcd, 0xb4, 0xaa, 0x3b, 0x54, 0x46, 0x8f, 0x00, 0x99, 0xb7, 0xe6, 0x98, 0xc8, 0x43, 0x3e, 0x3f,
+	0x0f, 0x9b, 0xce, 0xe9, 0x71, 0xef, 0x99, 0x61, 0x15, 0xa1, 0x6f, 0xb5, 0xbf, 0x7d, 0xbe, 0x94,
+	0xfb, 0xee, 0xf9, 0x52, 0xee, 0x9f, 0xcf, 0x97, 0x72, 0x7f, 0x78, 0xb1, 0x74, 0xe1, 0xbb, 0x17,
+	0x4b, 0x17, 0xfe, 0xfe, 0x62, 0xe9, 0xc2, 0xe7, 0xeb, 0x99, 0x19, 0x3a, 0x71, 0x67, 0x35, 0xd8,
+	0xc3, 0x34, 0x5e, 0xcf, 0x7c, 0xda, 0x3a, 0x38, 0xfa, 0xb1, 0xae, 0x53, 0xd6, 0x1f, 0xb7, 0xde,
+	0xfd, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x20, 0x41, 0x8b, 0xcf, 0x1b, 0x00, 0x00,
+}
+
+func (m *BucketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequesterPays {
 		i--
-		if m.IsUpdating {
+		if m.RequesterPays {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1544,9 +2266,19 @@ func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x80
 	}
-	if m.Tags != nil {
+	if m.LegalHold {
+		i--
+		if m.LegalHold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Retention != nil {
 		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1554,59 +2286,72 @@ func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x72
 	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x72
+	if m.VersioningEnabled {
+		i--
+		if m.VersioningEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
 		i--
 		dAtA[i] = 0x68
 	}
-	if m.RedundancyType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
+	if m.SpAsDelegatedAgentDisabled {
+		i--
+		if m.SpAsDelegatedAgentDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x60
 	}
-	if m.ObjectStatus != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ObjectStatus))
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x5a
 	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
+	if m.BucketStatus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BucketStatus))
 		i--
 		dAtA[i] = 0x50
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChargedReadQuota))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x48
 	}
-	if m.Visibility != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PaymentAddress)))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x3a
 	}
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
+	if m.CreateAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
 		i--
 		dAtA[i] = 0x30
 	}
+	if m.SourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.Id.Size()
 		i -= size
@@ -1616,26 +2361,17 @@ func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
+	dAtA[i] = 0x22
+	if m.Visibility != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Owner) > 0 {
@@ -1648,7 +2384,7 @@ func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+func (m *InternalBucketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1658,16 +2394,141 @@ func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *InternalBucketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InternalBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextLocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextLocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LocalVirtualGroups) > 0 {
+		for iNdEx := len(m.LocalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LocalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalChargeSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalChargeSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PriceTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.IsComposed {
+		i--
+		if m.IsComposed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.LegalHold {
+		i--
+		if m.LegalHold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Retention != nil {
+		{
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.IsUpdating {
+		i--
+		if m.IsUpdating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.Tags != nil {
 		{
 			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
@@ -1678,14 +2539,58 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x7a
 	}
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extra)))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x68
+	}
+	if m.RedundancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ObjectStatus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ObjectStatus))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x30
 	}
 	{
 		size := m.Id.Size()
@@ -1696,16 +2601,25 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if m.SourceType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
+	dAtA[i] = 0x2a
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1719,7 +2633,7 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Trait) Marshal() (dAtA []byte, err error) {
+func (m *ObjectVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1729,34 +2643,92 @@ func (m *Trait) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Trait) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Trait) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x5a
 	}
-	if len(m.TraitType) > 0 {
-		i -= len(m.TraitType)
-		copy(dAtA[i:], m.TraitType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TraitType)))
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x50
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RedundancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x30
 	}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BucketMetaData) Marshal() (dAtA []byte, err error) {
+func (m *ObjectComponent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1766,62 +2738,71 @@ func (m *BucketMetaData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BucketMetaData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectComponent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BucketMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+	if m.RedundancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x30
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
+	if m.Offset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Offset))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if len(m.ExternalUrl) > 0 {
-		i -= len(m.ExternalUrl)
-		copy(dAtA[i:], m.ExternalUrl)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+	if m.PayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectMetaData) Marshal() (dAtA []byte, err error) {
+func (m *ComposedObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1831,20 +2812,20 @@ func (m *ObjectMetaData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectMetaData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ComposedObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ComposedObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1852,41 +2833,23 @@ func (m *ObjectMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ExternalUrl) > 0 {
-		i -= len(m.ExternalUrl)
-		copy(dAtA[i:], m.ExternalUrl)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupMetaData) Marshal() (dAtA []byte, err error) {
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1896,62 +2859,68 @@ func (m *GroupMetaData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupMetaData) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2a
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extra)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.GroupName) > 0 {
 		i -= len(m.GroupName)
 		copy(dAtA[i:], m.GroupName)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ExternalUrl) > 0 {
-		i -= len(m.ExternalUrl)
-		copy(dAtA[i:], m.ExternalUrl)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Ids) Marshal() (dAtA []byte, err error) {
+func (m *Trait) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1961,29 +2930,261 @@ func (m *Ids) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Ids) MarshalTo(dAtA []byte) (int, error) {
+func (m *Trait) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ids) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Trait) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		for iNdEx := len(m.Id) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Id[iNdEx].Size()
-				i -= size
-				if _, err := m.Id[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraitType) > 0 {
+		i -= len(m.TraitType)
+		copy(dAtA[i:], m.TraitType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TraitType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketMetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketMetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectMetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectMetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ids) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ids) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ids) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		for iNdEx := len(m.Id) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Id[iNdEx].Size()
+				i -= size
+				if _, err := m.Id[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
@@ -2293,368 +3494,684 @@ func (m *BucketExtraInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LifecycleRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecycleRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecycleRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTypes(uint64(m.Visibility))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovTypes(uint64(m.SourceType))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTypes(uint64(m.CreateAt))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
-	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovTypes(uint64(m.ChargedReadQuota))
+	if m.AbortIncompleteUploadHours != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AbortIncompleteUploadHours))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.BucketStatus != 0 {
-		n += 1 + sovTypes(uint64(m.BucketStatus))
+	if m.ExpirationDays != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationDays))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SpAsDelegatedAgentDisabled {
-		n += 2
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InternalBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BucketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PriceTime != 0 {
-		n += 1 + sovTypes(uint64(m.PriceTime))
-	}
-	if m.TotalChargeSize != 0 {
-		n += 1 + sovTypes(uint64(m.TotalChargeSize))
-	}
-	if len(m.LocalVirtualGroups) > 0 {
-		for _, e := range m.LocalVirtualGroups {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.NextLocalVirtualGroupId != 0 {
-		n += 1 + sovTypes(uint64(m.NextLocalVirtualGroupId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LifecycleCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecycleCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecycleCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTypes(uint64(m.PayloadSize))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTypes(uint64(m.Visibility))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTypes(uint64(m.CreateAt))
-	}
-	if m.ObjectStatus != 0 {
-		n += 1 + sovTypes(uint64(m.ObjectStatus))
-	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTypes(uint64(m.RedundancyType))
-	}
-	if m.SourceType != 0 {
-		n += 1 + sovTypes(uint64(m.SourceType))
+	if len(m.ObjectKey) > 0 {
+		i -= len(m.ObjectKey)
+		copy(dAtA[i:], m.ObjectKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.IsUpdating {
-		n += 3
-	}
-	if m.UpdatedAt != 0 {
-		n += 2 + sovTypes(uint64(m.UpdatedAt))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 2 + sovTypes(uint64(m.Version))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Retention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Retention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Retention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.SourceType != 0 {
-		n += 1 + sovTypes(uint64(m.SourceType))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.RetainUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetainUntil))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Trait) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TraitType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.RetainUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetainUntil))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.LegalHoldCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LegalHoldCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BucketMetaData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReaderQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReaderQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReaderQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.ExternalUrl)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Reader) > 0 {
+		i -= len(m.Reader)
+		copy(dAtA[i:], m.Reader)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reader)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ObjectMetaData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PaymentMismatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentMismatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentMismatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.ExternalUrl)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Buckets[iNdEx])
+			copy(dAtA[i:], m.Buckets[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Buckets[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.KnownIssue {
+		i--
+		if m.KnownIssue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.Actual.Size()
+		i -= size
+		if _, err := m.Actual.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Expected.Size()
+		i -= size
+		if _, err := m.Expected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GroupMetaData) Size() (n int) {
+func (m *PaymentHealthReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentHealthReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentHealthReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalNetflowRate.Size()
+		i -= size
+		if _, err := m.TotalNetflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Mismatches) > 0 {
+		for iNdEx := len(m.Mismatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mismatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BucketInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Description)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.ExternalUrl)
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.GroupName)
+	if m.Visibility != 0 {
+		n += 1 + sovTypes(uint64(m.Visibility))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovTypes(uint64(m.SourceType))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreateAt))
+	}
+	l = len(m.PaymentAddress)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Image)
-	if l > 0 {
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.ChargedReadQuota))
+	}
+	if m.BucketStatus != 0 {
+		n += 1 + sovTypes(uint64(m.BucketStatus))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.SpAsDelegatedAgentDisabled {
+		n += 2
+	}
+	if m.VersioningEnabled {
+		n += 2
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LegalHold {
+		n += 2
+	}
+	if m.RequesterPays {
+		n += 3
 	}
 	return n
 }
 
-func (m *Ids) Size() (n int) {
+func (m *InternalBucketInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		for _, e := range m.Id {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.PriceTime != 0 {
+		n += 1 + sovTypes(uint64(m.PriceTime))
+	}
+	if m.TotalChargeSize != 0 {
+		n += 1 + sovTypes(uint64(m.TotalChargeSize))
+	}
+	if len(m.LocalVirtualGroups) > 0 {
+		for _, e := range m.LocalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.NextLocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.NextLocalVirtualGroupId))
 	}
 	return n
 }
 
-func (m *DeleteInfo) Size() (n int) {
+func (m *ObjectInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BucketIds != nil {
-		l = m.BucketIds.Size()
+	l = len(m.Owner)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.ObjectIds != nil {
-		l = m.ObjectIds.Size()
+	l = len(m.Creator)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.GroupIds != nil {
-		l = m.GroupIds.Size()
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTypes(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreateAt))
+	}
+	if m.ObjectStatus != 0 {
+		n += 1 + sovTypes(uint64(m.ObjectStatus))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyType))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovTypes(uint64(m.SourceType))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.IsUpdating {
+		n += 3
+	}
+	if m.UpdatedAt != 0 {
+		n += 2 + sovTypes(uint64(m.UpdatedAt))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 2 + sovTypes(uint64(m.Version))
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.LegalHold {
+		n += 3
+	}
+	if m.IsComposed {
+		n += 3
+	}
 	return n
 }
 
-func (m *MigrationBucketInfo) Size() (n int) {
+func (m *ObjectVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SrcSpId != 0 {
-		n += 1 + sovTypes(uint64(m.SrcSpId))
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.SrcGlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovTypes(uint64(m.SrcGlobalVirtualGroupFamilyId))
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.DstSpId != 0 {
-		n += 1 + sovTypes(uint64(m.DstSpId))
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
 	}
-	l = m.BucketId.Size()
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyType))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ObjectComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTypes(uint64(m.Offset))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyType))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
 	return n
 }
 
-func (m *ResourceTags) Size() (n int) {
+func (m *ComposedObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
 			l = e.Size()
 			n += 1 + l + sovTypes(uint64(l))
 		}
@@ -2662,13 +4179,43 @@ func (m *ResourceTags) Size() (n int) {
 	return n
 }
 
-func (m *ResourceTags_Tag) Size() (n int) {
+func (m *GroupInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovTypes(uint64(m.SourceType))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Trait) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraitType)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
@@ -2679,63 +4226,2194 @@ func (m *ResourceTags_Tag) Size() (n int) {
 	return n
 }
 
-func (m *ShadowObjectInfo) Size() (n int) {
+func (m *BucketMetaData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.ContentType)
+	l = len(m.ExternalUrl)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTypes(uint64(m.PayloadSize))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectMetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovTypes(uint64(m.UpdatedAt))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
-	}
-	return n
-}
+	return n
+}
+
+func (m *GroupMetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Ids) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		for _, e := range m.Id {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketIds != nil {
+		l = m.BucketIds.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ObjectIds != nil {
+		l = m.ObjectIds.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupIds != nil {
+		l = m.GroupIds.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrationBucketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SrcSpId))
+	}
+	if m.SrcGlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.SrcGlobalVirtualGroupFamilyId))
+	}
+	if m.DstSpId != 0 {
+		n += 1 + sovTypes(uint64(m.DstSpId))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ResourceTags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceTags_Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ShadowObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *BucketExtraInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRateLimited {
+		n += 2
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CurrentFlowRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *LifecycleRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpirationDays != 0 {
+		n += 1 + sovTypes(uint64(m.ExpirationDays))
+	}
+	if m.AbortIncompleteUploadHours != 0 {
+		n += 1 + sovTypes(uint64(m.AbortIncompleteUploadHours))
+	}
+	return n
+}
+
+func (m *BucketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LifecycleCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ObjectKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Retention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	if m.RetainUntil != 0 {
+		n += 1 + sovTypes(uint64(m.RetainUntil))
+	}
+	return n
+}
+
+func (m *ObjectLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LegalHoldCount != 0 {
+		n += 1 + sovTypes(uint64(m.LegalHoldCount))
+	}
+	if m.RetainUntil != 0 {
+		n += 1 + sovTypes(uint64(m.RetainUntil))
+	}
+	return n
+}
+
+func (m *ReaderQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Reader)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.ReadQuota))
+	}
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PaymentMismatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Expected.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Actual.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.KnownIssue {
+		n += 2
+	}
+	if len(m.Buckets) > 0 {
+		for _, s := range m.Buckets {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PaymentHealthReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Healthy {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Mismatches) > 0 {
+		for _, e := range m.Mismatches {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.TotalNetflowRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BucketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStatus", wireType)
+			}
+			m.BucketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketStatus |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpAsDelegatedAgentDisabled = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VersioningEnabled = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &Retention{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegalHold = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterPays", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequesterPays = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalBucketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTime", wireType)
+			}
+			m.PriceTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChargeSize", wireType)
+			}
+			m.TotalChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalVirtualGroups = append(m.LocalVirtualGroups, &LocalVirtualGroup{})
+			if err := m.LocalVirtualGroups[len(m.LocalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLocalVirtualGroupId", wireType)
+			}
+			m.NextLocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStatus", wireType)
+			}
+			m.ObjectStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectStatus |= ObjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUpdating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUpdating = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &Retention{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegalHold = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsComposed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsComposed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *BucketExtraInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsRateLimited {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.CurrentFlowRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BucketInfo) Unmarshal(dAtA []byte) error {
+func (m *ComposedObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2758,15 +6436,15 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComposedObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComposedObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2794,13 +6472,15 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2810,46 +6490,79 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Components = append(m.Components, &ObjectComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2877,51 +6590,11 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
-			}
-			m.SourceType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
-			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2949,13 +6622,13 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			m.SourceType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2965,16 +6638,16 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.SourceType |= SourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ChargedReadQuota = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2984,16 +6657,31 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketStatus", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.BucketStatus = 0
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3003,12 +6691,25 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketStatus |= BucketStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extra = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
@@ -3044,11 +6745,61 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trait) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trait: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trait: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitType", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3058,12 +6809,56 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SpAsDelegatedAgentDisabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3085,7 +6880,7 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3108,17 +6903,17 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InternalBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketMetaData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.PriceTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3128,16 +6923,29 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceTime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalChargeSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
 			}
-			m.TotalChargeSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3147,16 +6955,29 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalChargeSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3166,31 +6987,29 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalVirtualGroups = append(m.LocalVirtualGroups, &LocalVirtualGroup{})
-			if err := m.LocalVirtualGroups[len(m.LocalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextLocalVirtualGroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			m.NextLocalVirtualGroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3200,11 +7019,58 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextLocalVirtualGroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Trait{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3226,7 +7092,7 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3249,15 +7115,15 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectMetaData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3285,11 +7151,11 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3317,11 +7183,11 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3349,11 +7215,11 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3381,13 +7247,13 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3397,86 +7263,79 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attributes = append(m.Attributes, Trait{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LocalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3504,13 +7363,13 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
 			}
-			m.CreateAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3520,16 +7379,29 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStatus", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.ObjectStatus = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3539,16 +7411,29 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObjectStatus |= ObjectStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.RedundancyType = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3558,16 +7443,29 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.SourceType = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3577,16 +7475,81 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Trait{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ids) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ids: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ids: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3596,27 +7559,81 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			var v Uint
+			m.Id = append(m.Id, v)
+			if err := m.Id[len(m.Id)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3643,18 +7660,18 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
+			if m.BucketIds == nil {
+				m.BucketIds = &Ids{}
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsUpdating", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3664,36 +7681,33 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsUpdating = bool(v != 0)
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.UpdatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 18:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectIds == nil {
+				m.ObjectIds = &Ids{}
+			}
+			if err := m.ObjectIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3703,43 +7717,28 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if m.GroupIds == nil {
+				m.GroupIds = &Ids{}
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.GroupIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3761,7 +7760,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3784,17 +7783,17 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrationBucketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrationBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcSpId", wireType)
 			}
-			var stringLen uint64
+			m.SrcSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3804,29 +7803,16 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcGlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.SrcGlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3836,29 +7822,16 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstSpId", wireType)
 			}
-			m.SourceType = 0
+			m.DstSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3868,14 +7841,14 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				m.DstSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3903,43 +7876,61 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceTags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceTags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceTags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
@@ -3968,10 +7959,8 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
-			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, ResourceTags_Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3996,7 +7985,7 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trait) Unmarshal(dAtA []byte) error {
+func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4019,15 +8008,15 @@ func (m *Trait) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trait: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trait: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraitType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4055,7 +8044,7 @@ func (m *Trait) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraitType = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4110,7 +8099,7 @@ func (m *Trait) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
+func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,15 +8122,15 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BucketMetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShadowObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BucketMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShadowObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4169,11 +8158,11 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4201,11 +8190,13 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4233,13 +8224,32 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4249,29 +8259,29 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var msglen int
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4281,26 +8291,30 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Attributes = append(m.Attributes, Trait{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4322,7 +8336,7 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
+func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4345,81 +8359,17 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectMetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketExtraInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketExtraInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRateLimited", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4428,28 +8378,16 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.IsRateLimited = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4477,13 +8415,15 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4493,23 +8433,23 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Trait{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4534,7 +8474,7 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
+func (m *LifecycleRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4557,15 +8497,15 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: LifecycleRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4593,11 +8533,11 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4625,13 +8565,13 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDays", wireType)
 			}
-			var stringLen uint64
+			m.ExpirationDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4641,61 +8581,16 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpirationDays |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortIncompleteUploadHours", wireType)
 			}
-			var msglen int
+			m.AbortIncompleteUploadHours = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4705,26 +8600,11 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AbortIncompleteUploadHours |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Trait{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4746,7 +8626,7 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ids) Unmarshal(dAtA []byte) error {
+func (m *BucketLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4769,17 +8649,17 @@ func (m *Ids) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ids: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketLifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ids: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4789,25 +8669,23 @@ func (m *Ids) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v Uint
-			m.Id = append(m.Id, v)
-			if err := m.Id[len(m.Id)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4832,7 +8710,7 @@ func (m *Ids) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
+func (m *LifecycleCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4855,17 +8733,17 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: LifecycleCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LifecycleCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4875,69 +8753,31 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BucketIds == nil {
-				m.BucketIds = &Ids{}
-			}
-			if err := m.BucketIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectIds == nil {
-				m.ObjectIds = &Ids{}
-			}
-			if err := m.ObjectIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4947,26 +8787,24 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupIds == nil {
-				m.GroupIds = &Ids{}
-			}
-			if err := m.GroupIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ObjectKey = append(m.ObjectKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ObjectKey == nil {
+				m.ObjectKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4990,7 +8828,7 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *Retention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5013,17 +8851,17 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MigrationBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Retention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MigrationBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Retention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcSpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.SrcSpId = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5033,54 +8871,16 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcSpId |= uint32(b&0x7F) << shift
+				m.Mode |= RetentionMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcGlobalVirtualGroupFamilyId", wireType)
-			}
-			m.SrcGlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstSpId", wireType)
-			}
-			m.DstSpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstSpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainUntil", wireType)
 			}
-			var stringLen uint64
+			m.RetainUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5090,26 +8890,11 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RetainUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5131,7 +8916,7 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTags) Unmarshal(dAtA []byte) error {
+func (m *ObjectLocks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5154,17 +8939,17 @@ func (m *ResourceTags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTags: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectLocks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectLocks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHoldCount", wireType)
 			}
-			var msglen int
+			m.LegalHoldCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5174,26 +8959,30 @@ func (m *ResourceTags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LegalHoldCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainUntil", wireType)
 			}
-			m.Tags = append(m.Tags, ResourceTags_Tag{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RetainUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetainUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5215,7 +9004,7 @@ func (m *ResourceTags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
+func (m *ReaderQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5238,15 +9027,15 @@ func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReaderQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReaderQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5274,11 +9063,13 @@ func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5306,7 +9097,92 @@ func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Reader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
+			}
+			m.ReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, types.OutFlow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5329,7 +9205,7 @@ func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *PaymentMismatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5352,15 +9228,34 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShadowObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymentMismatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShadowObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymentMismatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PaymentMismatchType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5388,11 +9283,11 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5420,13 +9315,13 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Expected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5454,13 +9349,15 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			if err := m.Actual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownIssue", wireType)
 			}
-			m.PayloadSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5470,16 +9367,17 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.KnownIssue = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5489,29 +9387,29 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			m.Buckets = append(m.Buckets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.UpdatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5521,30 +9419,24 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5566,7 +9458,7 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
+func (m *PaymentHealthReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5589,15 +9481,15 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BucketExtraInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymentHealthReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BucketExtraInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymentHealthReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRateLimited", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5614,10 +9506,10 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsRateLimited = bool(v != 0)
+			m.Healthy = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5645,13 +9537,45 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mismatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mismatches = append(m.Mismatches, PaymentMismatch{})
+			if err := m.Mismatches[len(m.Mismatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNetflowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5679,7 +9603,7 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
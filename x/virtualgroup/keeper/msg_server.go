@@ -648,6 +648,7 @@ func (k msgServer) StorageProviderForcedExit(goCtx context.Context, msg *types.M
 	// Governance can put an SP into force exiting status no matter what status it is in.
 	sp.Status = sptypes.STATUS_FORCED_EXITING
 	k.spKeeper.SetStorageProvider(ctx, sp)
+	k.spKeeper.RecordForcedExit(ctx, sp.Id)
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStorageProviderForcedExit{
 		StorageProviderId: sp.Id,
 	}); err != nil {
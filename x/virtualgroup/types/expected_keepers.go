@@ -16,6 +16,7 @@ type SpKeeper interface {
 	Exit(ctx sdk.Context, sp *sptypes.StorageProvider) error
 	DepositDenomForSP(ctx sdk.Context) (res string)
 	GetAllStorageProviders(ctx sdk.Context) (sps []sptypes.StorageProvider)
+	RecordForcedExit(ctx sdk.Context, spId uint32)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
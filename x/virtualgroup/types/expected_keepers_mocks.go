@@ -124,6 +124,18 @@ func (mr *MockSpKeeperMockRecorder) GetStorageProviderByOperatorAddr(ctx, addr i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageProviderByOperatorAddr", reflect.TypeOf((*MockSpKeeper)(nil).GetStorageProviderByOperatorAddr), ctx, addr)
 }
 
+// RecordForcedExit mocks base method.
+func (m *MockSpKeeper) RecordForcedExit(ctx types0.Context, spId uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordForcedExit", ctx, spId)
+}
+
+// RecordForcedExit indicates an expected call of RecordForcedExit.
+func (mr *MockSpKeeperMockRecorder) RecordForcedExit(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordForcedExit", reflect.TypeOf((*MockSpKeeper)(nil).RecordForcedExit), ctx, spId)
+}
+
 // SetStorageProvider mocks base method.
 func (m *MockSpKeeper) SetStorageProvider(ctx types0.Context, sp *types.StorageProvider) {
 	m.ctrl.T.Helper()